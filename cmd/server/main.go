@@ -12,6 +12,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	_ "time/tzdata" // location time zones must resolve even where the host has no zoneinfo
 
 	"github.com/mohamedfawas/employee_management_system/internal/app"
 	"github.com/mohamedfawas/employee_management_system/internal/config"
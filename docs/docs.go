@@ -15,21 +15,21 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/employees": {
+        "/approval-workflows": {
             "get": {
-                "description": "Retrieve a list of all employees in the system",
+                "description": "Lists the approval workflows with their steps, by request type",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "employees"
+                    "Approvals"
                 ],
-                "summary": "Get all employees",
+                "summary": "List approval workflows",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.GetAllEmployeesResponseWrapper"
+                            "$ref": "#/definitions/v1.ApprovalWorkflowListResponseWrapper"
                         }
                     },
                     "500": {
@@ -41,7 +41,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Creates a new employee and stores it in the database.",
+                "description": "Creates the approval chain of a request type. Once it exists, changes of that type are submitted for approval instead of being made directly. Steps run stage by stage; steps sharing a stage run in parallel.",
                 "consumes": [
                     "application/json"
                 ],
@@ -49,17 +49,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Employees"
+                    "Approvals"
                 ],
-                "summary": "Create a new employee",
+                "summary": "Create an approval workflow",
                 "parameters": [
                     {
-                        "description": "Employee create payload",
+                        "description": "Approval workflow payload",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.CreateEmployeeRequest"
+                            "$ref": "#/definitions/v1.ApprovalWorkflowRequest"
                         }
                     }
                 ],
@@ -67,7 +67,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.CreateEmployeeResponseWrapper"
+                            "$ref": "#/definitions/v1.ApprovalWorkflowResponseWrapper"
                         }
                     },
                     "400": {
@@ -76,6 +76,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/apiresponse.StandardResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -85,20 +97,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/employees/{id}": {
+        "/approval-workflows/{id}": {
             "get": {
-                "description": "Fetch a single employee using the ID provided in the URL path",
+                "description": "Returns an approval workflow with its steps",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Employees"
+                    "Approvals"
                 ],
-                "summary": "Get employee by ID",
+                "summary": "Get an approval workflow",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Employee ID",
+                        "description": "Approval workflow ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -108,7 +120,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.GetEmployeeByIdResponseWrapper"
+                            "$ref": "#/definitions/v1.ApprovalWorkflowResponseWrapper"
                         }
                     },
                     "400": {
@@ -132,7 +144,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update employee details by ID",
+                "description": "Replaces a workflow's details and steps. Requests already submitted keep their tasks.",
                 "consumes": [
                     "application/json"
                 ],
@@ -140,24 +152,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Employees"
+                    "Approvals"
                 ],
-                "summary": "Update an employee",
+                "summary": "Update an approval workflow",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Employee ID",
+                        "description": "Approval workflow ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update employee payload",
+                        "description": "Approval workflow payload",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.UpdateEmployeeRequest"
+                            "$ref": "#/definitions/v1.ApprovalWorkflowRequest"
                         }
                     }
                 ],
@@ -165,7 +177,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.UpdateEmployeeResponseWrapper"
+                            "$ref": "#/definitions/v1.ApprovalWorkflowResponseWrapper"
                         }
                     },
                     "400": {
@@ -180,6 +192,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/apiresponse.StandardResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -189,18 +207,18 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Remove an employee record by its ID",
+                "description": "Removes an approval workflow, so changes of its type no longer need approval. Requests already submitted still go through their tasks.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "employees"
+                    "Approvals"
                 ],
-                "summary": "Delete an employee",
+                "summary": "Delete an approval workflow",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Employee ID",
+                        "description": "Approval workflow ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -230,153 +248,21577 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "apiresponse.ErrorInfo": {
-            "type": "object",
-            "properties": {
-                "code": {
-                    "type": "string"
-                },
-                "details": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
-                },
-                "message": {
-                    "type": "string"
-                }
-            }
-        },
-        "apiresponse.StandardResponse": {
-            "type": "object",
-            "properties": {
-                "data": {},
-                "error": {
-                    "$ref": "#/definitions/apiresponse.ErrorInfo"
-                },
-                "message": {
-                    "type": "string"
-                },
-                "request_id": {
-                    "type": "string"
-                },
-                "success": {
-                    "type": "boolean"
-                },
-                "timestamp": {
-                    "type": "string"
-                }
-            }
         },
-        "v1.CreateEmployeeRequest": {
-            "type": "object",
-            "properties": {
-                "hired_date": {
-                    "description": "Date when the employee was hired (YYYY-MM-DD)\nexample: 2024-01-15",
-                    "type": "string"
-                },
-                "name": {
-                    "description": "Employee full name\nexample: John Doe",
-                    "type": "string"
-                },
-                "position": {
-                    "description": "Job position assigned to the employee\nexample: Software Engineer",
-                    "type": "string"
-                },
-                "salary": {
-                    "description": "Monthly salary of the employee\nexample: 60000",
-                    "type": "integer"
+        "/approvals": {
+            "get": {
+                "description": "Lists the approval tasks an employee may decide now, oldest request first: tasks assigned to them, position tasks of the position they hold and the same for everyone who delegated to them today",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Approvals"
+                ],
+                "summary": "Get an approver's inbox",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Approver's employee ID",
+                        "name": "approver_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ApprovalTaskListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
                 }
             }
         },
-        "v1.CreateEmployeeResponse": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "description": "example: 2024-01-15T10:30:00Z",
-                    "type": "string"
-                },
-                "hired_date": {
-                    "description": "example: 2024-01-15",
-                    "type": "string"
-                },
-                "id": {
-                    "description": "Employee ID generated by the system\nexample: 1",
-                    "type": "integer"
-                },
-                "name": {
-                    "description": "example: John Doe",
-                    "type": "string"
-                },
-                "position": {
-                    "description": "example: Software Engineer",
-                    "type": "string"
-                },
-                "salary": {
-                    "description": "example: 60000",
-                    "type": "integer"
+        "/approvals/requests": {
+            "get": {
+                "description": "Lists approval requests with their tasks, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Approvals"
+                ],
+                "summary": "List approval requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "salary_change, termination, expense or leave",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "pending, approved, rejected, cancelled or failed",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Employee the requests are about",
+                        "name": "employee_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ApprovalRequestListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
                 }
             }
         },
-        "v1.CreateEmployeeResponseWrapper": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "$ref": "#/definitions/v1.CreateEmployeeResponse"
-                },
-                "message": {
-                    "type": "string"
-                },
-                "request_id": {
-                    "type": "string"
-                },
-                "success": {
-                    "type": "boolean"
-                },
-                "timestamp": {
-                    "type": "string"
+        "/approvals/requests/{id}": {
+            "get": {
+                "description": "Returns an approval request with its tasks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Approvals"
+                ],
+                "summary": "Get an approval request",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Approval request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ApprovalRequestResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
                 }
             }
         },
-        "v1.GetAllEmployeesResponse": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "description": "example: 2024-01-15T10:30:00Z",
-                    "type": "string"
-                },
-                "hired_date": {
-                    "description": "example: 2024-01-15",
-                    "type": "string"
-                },
+        "/approvals/requests/{id}/cancel": {
+            "post": {
+                "description": "Withdraws a pending approval request; the change is not made",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Approvals"
+                ],
+                "summary": "Cancel an approval request",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Approval request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ApprovalRequestResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/approvals/tasks/{id}/approve": {
+            "post": {
+                "description": "Approves a task in the approver's inbox. The last approval of a stage starts the next one; the last approval of the request makes the change, and a change that cannot be made leaves the request failed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Approvals"
+                ],
+                "summary": "Approve an approval task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Approval task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ApprovalDecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ApprovalRequestResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/approvals/tasks/{id}/reject": {
+            "post": {
+                "description": "Rejects a task in the approver's inbox, which rejects the whole request; the change is not made.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Approvals"
+                ],
+                "summary": "Reject an approval task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Approval task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ApprovalDecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ApprovalRequestResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/assets": {
+            "get": {
+                "description": "Lists the asset inventory ordered by name, with the employee holding each asset",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Assets"
+                ],
+                "summary": "List assets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "laptop, desktop, monitor, phone, tablet, badge, key or other",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "available, assigned, in_repair, lost or retired",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AssetListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a piece of company equipment to the inventory, available unless another status is given",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Assets"
+                ],
+                "summary": "Add an asset",
+                "parameters": [
+                    {
+                        "description": "Asset payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.AssetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AssetResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/assets/{id}": {
+            "get": {
+                "description": "Returns an asset with the employee holding it, if any",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Assets"
+                ],
+                "summary": "Get an asset",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AssetResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Changes an asset's details. The status of an assigned asset only changes by returning it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Assets"
+                ],
+                "summary": "Update an asset",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Asset payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.AssetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AssetResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes an asset with its assignment history. Assigned assets have to be returned first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Assets"
+                ],
+                "summary": "Delete an asset",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/assets/{id}/assign": {
+            "post": {
+                "description": "Hands an available asset to an employee, today unless a date is given. An asset is held by one employee at a time.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Assets"
+                ],
+                "summary": "Assign an asset to an employee",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Assignment payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.AssetAssignmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AssetAssignmentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/assets/{id}/assignments": {
+            "get": {
+                "description": "Lists who held the asset and when, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Assets"
+                ],
+                "summary": "Get an asset's assignment history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AssetAssignmentListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/assets/{id}/return": {
+            "post": {
+                "description": "Takes an assigned asset back, today unless a date is given. The asset becomes available again unless it is reported in repair, lost or retired.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Assets"
+                ],
+                "summary": "Return an asset",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Return payload",
+                        "name": "payload",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AssetReturnRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AssetAssignmentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/benefit-enrollment-windows": {
+            "get": {
+                "description": "Lists the enrollment windows, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "List enrollment windows",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.EnrollmentWindowListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a period employees may enroll in benefit plans in, for coverage starting on coverage_starts_on. Windows may not overlap",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "Open an enrollment window",
+                "parameters": [
+                    {
+                        "description": "Enrollment window payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.EnrollmentWindowRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.EnrollmentWindowResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/benefit-enrollment-windows/{id}": {
+            "delete": {
+                "description": "Deletes an enrollment window. Enrollments made in it are kept",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "Delete an enrollment window",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Enrollment window ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/benefit-plans": {
+            "get": {
+                "description": "Lists the benefit plans ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "List benefit plans",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BenefitPlanListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a benefit plan with monthly contributions and eligibility rules. Employees are eligible when their employment status is listed, they have been employed for min_tenure_months when coverage starts and they work at one of the plan's locations, if it lists any",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "Create a benefit plan",
+                "parameters": [
+                    {
+                        "description": "Benefit plan payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.BenefitPlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BenefitPlanResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/benefit-plans/{id}": {
+            "get": {
+                "description": "Returns a benefit plan by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "Get a benefit plan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Benefit plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BenefitPlanResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces a benefit plan's details. New contributions apply to existing enrollments from the next deductions on, new eligibility rules only to new enrollments",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "Update a benefit plan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Benefit plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Benefit plan payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.BenefitPlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BenefitPlanResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a benefit plan nobody has enrolled in",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "Delete a benefit plan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Benefit plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/benefits/deductions": {
+            "get": {
+                "description": "Lists what payroll deducts in a month for every enrollment covering some day of it, at the plans' current contributions. Deductions are not prorated",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "List benefit deductions for payroll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll month (YYYY-MM)",
+                        "name": "period",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only this employee",
+                        "name": "employee_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BenefitDeductionListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/candidates/{id}": {
+            "get": {
+                "description": "Returns a candidate with their stage and offer",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "Get a candidate",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Candidate ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CandidateResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Changes the contact details and notes of a candidate",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "Update a candidate",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Candidate ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Candidate payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CandidateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CandidateResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a candidate and their interviews",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "Delete a candidate",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Candidate ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/candidates/{id}/accept-offer": {
+            "post": {
+                "description": "Hires a candidate on their offer. The employee is created with the candidate's name and contact details, the requisition's position, department, location and hiring manager, and the offered salary and start date; employees starting later are created as candidates and become active on their start date.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "Accept a candidate's offer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Candidate ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Offer acceptance payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.OfferAcceptanceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GetEmployeeByIdResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/candidates/{id}/interviews": {
+            "get": {
+                "description": "Lists the interviews of a candidate by time",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "List the interviews of a candidate",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Candidate ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InterviewListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Schedules an interview with a candidate still in the pipeline, moving applied or screened candidates to the interview stage. Times are in UTC.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "Schedule an interview",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Candidate ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Interview payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.InterviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InterviewResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/candidates/{id}/offer": {
+            "post": {
+                "description": "Makes, or revises, the offer of a candidate and moves them to the offer stage",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "Make a candidate an offer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Candidate ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Offer payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.OfferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CandidateResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/candidates/{id}/stage": {
+            "post": {
+                "description": "Moves a candidate to screening, interview, rejected or withdrawn. Candidates reach the offer stage with an offer and the hired stage by accepting it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "Move a candidate along the pipeline",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Candidate ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Stage payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CandidateStageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CandidateResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/certifications/expiring": {
+            "get": {
+                "description": "Lists the certifications of current employees expiring between today and the end of the window, soonest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certifications"
+                ],
+                "summary": "Report expiring certifications",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Window in days, 1 to 365; defaults to the configured notice window",
+                        "name": "within_days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CertificationListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/certifications/{id}": {
+            "get": {
+                "description": "Retrieve a certification",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certifications"
+                ],
+                "summary": "Get a certification",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Certification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CertificationResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Changes a certification, e.g. after renewal. A new expiry date makes the holder due for another expiry notice.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certifications"
+                ],
+                "summary": "Update a certification",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Certification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Certification payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CertificationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CertificationResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a certification with its evidence",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certifications"
+                ],
+                "summary": "Delete a certification",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Certification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/certifications/{id}/evidence": {
+            "get": {
+                "description": "Lists the files attached to a certification, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certifications"
+                ],
+                "summary": "List a certification's evidence",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Certification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.EvidenceListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Uploads a PDF, PNG or JPEG file of up to 5 MB, such as a scan of the certificate, as the \"file\" field of a multipart form. The type is detected from the contents.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certifications"
+                ],
+                "summary": "Attach evidence to a certification",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Certification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Evidence file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.EvidenceResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/certifications/{id}/evidence/{evidenceId}": {
+            "get": {
+                "description": "Returns an evidence file as it was uploaded",
+                "produces": [
+                    "application/pdf",
+                    "image/png",
+                    "image/jpeg"
+                ],
+                "tags": [
+                    "Certifications"
+                ],
+                "summary": "Download certification evidence",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Certification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Evidence ID",
+                        "name": "evidenceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a file attached to a certification",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certifications"
+                ],
+                "summary": "Delete certification evidence",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Certification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Evidence ID",
+                        "name": "evidenceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/checklist-tasks/{id}": {
+            "put": {
+                "description": "Changes the owner and due date of an open task. Leaving the owner empty unassigns it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "Assign a checklist task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Checklist task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Owner and due date",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.AssignChecklistTaskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChecklistTaskResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/checklist-tasks/{id}/complete": {
+            "post": {
+                "description": "Marks a task done. The checklist completes with its last task; an offboarding cannot complete while the employee still holds company assets.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "Complete a checklist task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Checklist task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChecklistResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/checklist-tasks/{id}/reopen": {
+            "post": {
+                "description": "Marks a completed task open again, reopening its checklist if it was complete",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "Reopen a checklist task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Checklist task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChecklistResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/checklist-templates": {
+            "get": {
+                "description": "Lists the checklist templates with their tasks, by kind and name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "List checklist templates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "onboarding or offboarding",
+                        "name": "kind",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChecklistTemplateListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates an onboarding or offboarding template, optionally limited to a position and/or department. New hires and terminations get a checklist from the most specific matching template, position before department.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "Create a checklist template",
+                "parameters": [
+                    {
+                        "description": "Checklist template payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChecklistTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChecklistTemplateResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/checklist-templates/{id}": {
+            "get": {
+                "description": "Returns a checklist template with its tasks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "Get a checklist template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Checklist template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChecklistTemplateResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces a template's details and tasks. Checklists already started from it keep their tasks.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "Update a checklist template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Checklist template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Checklist template payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChecklistTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChecklistTemplateResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a checklist template. Checklists already started from it are kept.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "Delete a checklist template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Checklist template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/checklists": {
+            "get": {
+                "description": "Lists onboarding and offboarding checklists with their progress, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "Track checklist progress",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "onboarding or offboarding",
+                        "name": "kind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only checklists with open tasks",
+                        "name": "open",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChecklistListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/checklists/{id}": {
+            "get": {
+                "description": "Returns an onboarding or offboarding checklist with its tasks and progress",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "Get a checklist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Checklist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChecklistResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/custom-fields": {
+            "get": {
+                "description": "Lists the custom employee fields ordered by key",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CustomFields"
+                ],
+                "summary": "List custom employee fields",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CustomFieldListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Defines a field employees can hold a value of in custom_fields. Values are checked against the field's type, options and validation whenever an employee is created or updated, and required fields must have one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CustomFields"
+                ],
+                "summary": "Define a custom employee field",
+                "parameters": [
+                    {
+                        "description": "Custom field payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CustomFieldRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CustomFieldResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/custom-fields/{id}": {
+            "get": {
+                "description": "Returns a custom employee field by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CustomFields"
+                ],
+                "summary": "Get a custom employee field",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Custom field ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CustomFieldResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces a custom field's label, required flag, options and validation; its key and type stay the same. Values saved before are checked against the new rules when the employee is next updated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CustomFields"
+                ],
+                "summary": "Update a custom employee field",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Custom field ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Custom field payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CustomFieldRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CustomFieldResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a custom field along with every employee's value of it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CustomFields"
+                ],
+                "summary": "Delete a custom employee field",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Custom field ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/departments": {
+            "get": {
+                "description": "Lists the departments ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Departments"
+                ],
+                "summary": "List departments",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.DepartmentListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a department employees can be assigned to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Departments"
+                ],
+                "summary": "Create a department",
+                "parameters": [
+                    {
+                        "description": "Department payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.DepartmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.DepartmentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/departments/{id}": {
+            "get": {
+                "description": "Fetch a single department",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Departments"
+                ],
+                "summary": "Get a department",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Department ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.DepartmentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Rename a department",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Departments"
+                ],
+                "summary": "Update a department",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Department ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Department payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.DepartmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.DepartmentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a department that no employee is assigned to",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Departments"
+                ],
+                "summary": "Delete a department",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Department ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees": {
+            "get": {
+                "description": "Retrieve a list of all employees in the system. Custom fields filter the list with custom.\u003ckey\u003e=\u003cvalue\u003e, e.g. custom.t_shirt_size=M; multi_select fields take comma separated options an employee must all have selected",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Get all employees",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma separated employment statuses, e.g. active,on_leave",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only direct reports of this manager",
+                        "name": "manager_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only employees whose primary location this is",
+                        "name": "location_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated tags employees must all have, e.g. remote,new hire",
+                        "name": "tag",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GetAllEmployeesResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new employee and stores it in the database.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employees"
+                ],
+                "summary": "Create a new employee",
+                "parameters": [
+                    {
+                        "description": "Employee create payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateEmployeeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateEmployeeResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}": {
+            "get": {
+                "description": "Fetch a single employee using the ID provided in the URL path",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employees"
+                ],
+                "summary": "Get employee by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GetEmployeeByIdResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update employee details by ID. The salary and pay period must match the current ones, salary changes are requested through POST /employees/{id}/compensation. An empty pay period keeps the current one.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employees"
+                ],
+                "summary": "Update an employee",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update employee payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UpdateEmployeeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.UpdateEmployeeResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove an employee record by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Delete an employee",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/assets": {
+            "get": {
+                "description": "Lists the assets the employee holds and held before, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Assets"
+                ],
+                "summary": "List an employee's assets",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only assets the employee still holds",
+                        "name": "held",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AssetAssignmentListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/attendance": {
+            "get": {
+                "description": "Lists the employee's shifts clocked in from one date to another inclusive",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "List shifts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AttendanceEntryListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/attendance/clock-in": {
+            "post": {
+                "description": "Opens a shift for an active employee, now or at the given time",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "Clock in",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Punch payload",
+                        "name": "payload",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PunchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AttendanceEntryResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/attendance/clock-out": {
+            "post": {
+                "description": "Closes the employee's open shift, now or at the given time",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "Clock out",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Punch payload",
+                        "name": "payload",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PunchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AttendanceEntryResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/attendance/{entryId}": {
+            "put": {
+                "description": "Replaces the clock-in, clock-out and note of a shift, e.g. to fill in a missing clock-out. Weeks that are submitted or approved cannot be changed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "Correct a shift",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Attendance entry ID",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Shift payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.AttendanceEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AttendanceEntryResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/benefit-plans": {
+            "get": {
+                "description": "Lists the benefit plans whose eligibility rules the employee meets today",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "List the benefit plans an employee is eligible for",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BenefitPlanListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/benefits": {
+            "get": {
+                "description": "Lists an employee's benefit enrollments, current and past, latest coverage first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "List an employee's benefit enrollments",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BenefitEnrollmentListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Enrolls an employee in a benefit plan they are eligible for, with the dependents to cover. Coverage starts with the open enrollment window's coverage, or today for employees hired in the last 30 days. Employees hold one plan of each type other than other",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "Enroll an employee in a benefit plan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Enrollment payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.BenefitEnrollmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BenefitEnrollmentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/benefits/{enrollmentId}": {
+            "get": {
+                "description": "Returns one of an employee's benefit enrollments",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "Get a benefit enrollment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Enrollment ID",
+                        "name": "enrollmentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BenefitEnrollmentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes an enrollment whose coverage has not started yet; started coverage is ended instead",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "Withdraw a benefit enrollment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Enrollment ID",
+                        "name": "enrollmentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/benefits/{enrollmentId}/end": {
+            "post": {
+                "description": "Ends coverage that has started at the end of the current month, the last month its contributions are deducted for",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "End a benefit enrollment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Enrollment ID",
+                        "name": "enrollmentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BenefitEnrollmentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/certifications": {
+            "get": {
+                "description": "Lists the employee's certifications, soonest to expire first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certifications"
+                ],
+                "summary": "List an employee's certifications",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CertificationListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Records a certification the employee holds, with its issue and, if it has one, expiry date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certifications"
+                ],
+                "summary": "Record a certification",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Certification payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CertificationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CertificationResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/checklist-tasks": {
+            "get": {
+                "description": "Lists the open onboarding and offboarding tasks assigned to an employee, by due date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "List an employee's checklist tasks",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChecklistTaskListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/checklists": {
+            "get": {
+                "description": "Lists an employee's onboarding and offboarding checklists with their progress, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "List an employee's checklists",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChecklistListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Starts an onboarding checklist for a current employee or an offboarding checklist for a terminated one, from the most specific matching template. Checklists start by themselves on hiring and termination; this is for templates added later or checklists restarted after completion.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklists"
+                ],
+                "summary": "Start a checklist for an employee",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Checklist kind",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.StartChecklistRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChecklistResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/compensation": {
+            "get": {
+                "description": "Lists every applied, scheduled and cancelled salary change of an employee, ordered by effective date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Compensation"
+                ],
+                "summary": "Get compensation timeline",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CompensationHistoryResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Records a salary change. Changes effective today or earlier are applied immediately; future-dated ones are applied by a background job on the effective date. The new salary is checked against the band of the position and rejected or flagged depending on the band policy.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Compensation"
+                ],
+                "summary": "Change an employee's salary",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Compensation change payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ScheduleCompensationChangeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CompensationChangeResponseWrapper"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ApprovalRequestResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/compensation/{changeId}": {
+            "delete": {
+                "description": "Cancels a future-dated salary change that has not been applied yet",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Compensation"
+                ],
+                "summary": "Cancel a scheduled salary change",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Compensation change ID",
+                        "name": "changeId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/delegations": {
+            "get": {
+                "description": "Lists the delegations an employee made, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Approvals"
+                ],
+                "summary": "List an employee's approval delegations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Delegator's employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ApprovalDelegationListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Lets another employee decide everything the employee may approve between two dates, e.g. while they are on leave",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Approvals"
+                ],
+                "summary": "Delegate approvals",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Delegator's employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Delegation payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ApprovalDelegationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ApprovalDelegationResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/delegations/{delegationId}": {
+            "delete": {
+                "description": "Ends a delegation; tasks the delegate has not decided go back to the delegator alone",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Approvals"
+                ],
+                "summary": "Delete an approval delegation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Delegator's employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Approval delegation ID",
+                        "name": "delegationId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/dependents": {
+            "get": {
+                "description": "Lists the dependents of an employee ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "List an employee's dependents",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.DependentListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a dependent benefit plans covering dependents may cover",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "Add a dependent",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dependent payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.DependentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.DependentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/dependents/{dependentId}": {
+            "put": {
+                "description": "Replaces a dependent's details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "Update a dependent",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Dependent ID",
+                        "name": "dependentId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dependent payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.DependentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.DependentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a dependent, who is no longer covered by the employee's enrollments",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Benefits"
+                ],
+                "summary": "Delete a dependent",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Dependent ID",
+                        "name": "dependentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/documents": {
+            "get": {
+                "description": "Lists the documents kept with an employee, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Documents"
+                ],
+                "summary": "List an employee's documents",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.DocumentListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Stores a PDF, image, plain text or Word file as the \"file\" field of a multipart form, with its category. The type is detected from the contents and the size is limited by configuration (10 MB by default).",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Documents"
+                ],
+                "summary": "Upload an employee document",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Document file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "contract, identity, offer_letter or other",
+                        "name": "category",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.DocumentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/documents/{documentId}": {
+            "get": {
+                "description": "Streams a document as it was uploaded",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Documents"
+                ],
+                "summary": "Download an employee document",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Document ID",
+                        "name": "documentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a document and its stored file",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Documents"
+                ],
+                "summary": "Delete an employee document",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Document ID",
+                        "name": "documentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/emergency-contacts": {
+            "get": {
+                "description": "Lists the emergency contacts of an employee, primary contact first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "EmergencyContacts"
+                ],
+                "summary": "List emergency contacts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.EmergencyContactListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds an emergency contact to an employee. Marking it primary unsets the previous primary contact.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "EmergencyContacts"
+                ],
+                "summary": "Add an emergency contact",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Emergency contact payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.EmergencyContactRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.EmergencyContactResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/emergency-contacts/{contactId}": {
+            "get": {
+                "description": "Fetch a single emergency contact of an employee",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "EmergencyContacts"
+                ],
+                "summary": "Get an emergency contact",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Emergency contact ID",
+                        "name": "contactId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.EmergencyContactResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the details of an emergency contact",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "EmergencyContacts"
+                ],
+                "summary": "Update an emergency contact",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Emergency contact ID",
+                        "name": "contactId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Emergency contact payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.EmergencyContactRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.EmergencyContactResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove an emergency contact from an employee",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "EmergencyContacts"
+                ],
+                "summary": "Delete an emergency contact",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Emergency contact ID",
+                        "name": "contactId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/enrollments": {
+            "get": {
+                "description": "Lists the training courses the employee is or was enrolled in, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Training"
+                ],
+                "summary": "List an employee's enrollments",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.EnrollmentListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/expenses": {
+            "get": {
+                "description": "Lists an employee's expense claims without their items, most recent first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "List an employee's expense claims",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "draft, submitted, approved, rejected or reimbursed",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseClaimListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Drafts an expense claim with its items, all in the claim's currency. The items of a category may not exceed its limit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Create an expense claim",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Expense claim payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseClaimRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseClaimResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/expenses/{expenseId}": {
+            "get": {
+                "description": "Returns an expense claim with its items",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Get an expense claim",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Expense claim ID",
+                        "name": "expenseId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseClaimResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces the details and items of a draft or rejected claim, which becomes a draft again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Update an expense claim",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Expense claim ID",
+                        "name": "expenseId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Expense claim payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseClaimRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseClaimResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a draft or rejected claim with its items and receipts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Delete an expense claim",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Expense claim ID",
+                        "name": "expenseId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/expenses/{expenseId}/approve": {
+            "post": {
+                "description": "Approves a submitted claim, which payroll can then reimburse. Only the employee's manager may decide when one is set, and claims with an approval request are decided through it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Approve an expense claim",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Expense claim ID",
+                        "name": "expenseId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseDecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseClaimResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/expenses/{expenseId}/receipts": {
+            "get": {
+                "description": "Lists the receipts attached to an expense claim, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "List receipts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Expense claim ID",
+                        "name": "expenseId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseReceiptListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Attaches a PDF or image as the \"file\" field of a multipart form to a draft or rejected claim. The type is detected from the contents and the size is limited by configuration (10 MB by default).",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Upload a receipt",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Expense claim ID",
+                        "name": "expenseId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Receipt file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseReceiptResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/expenses/{expenseId}/receipts/{receiptId}": {
+            "get": {
+                "description": "Streams a receipt as it was uploaded",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Download a receipt",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Expense claim ID",
+                        "name": "expenseId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Receipt ID",
+                        "name": "receiptId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a receipt from a draft or rejected claim",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Delete a receipt",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Expense claim ID",
+                        "name": "expenseId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Receipt ID",
+                        "name": "receiptId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/expenses/{expenseId}/reimburse": {
+            "post": {
+                "description": "Marks an approved claim paid back, optionally by a payroll run. Claims held by a payroll run are reimbursed when the run is locked instead.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Reimburse an expense claim",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Expense claim ID",
+                        "name": "expenseId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reimbursement payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseReimbursementRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseClaimResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/expenses/{expenseId}/reject": {
+            "post": {
+                "description": "Rejects a submitted claim, which the employee may correct and submit again. Only the employee's manager may decide when one is set, and claims with an approval request are decided through it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Reject an expense claim",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Expense claim ID",
+                        "name": "expenseId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseDecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseClaimResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/expenses/{expenseId}/submit": {
+            "post": {
+                "description": "Sends a draft or rejected claim for approval, through the expense approval workflow when there is one and to the employee's manager otherwise. Categories requiring receipts need one attached",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Submit an expense claim",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Expense claim ID",
+                        "name": "expenseId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseClaimResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/goal-rollup": {
+            "get": {
+                "description": "Returns the goal progress of the employee and, nested, of everyone reporting to them directly or indirectly, with team averages rolled up through the management hierarchy. Cancelled goals are left out.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "Roll up goal progress",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GoalRollupResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/goals": {
+            "get": {
+                "description": "Lists the goals owned by the employee with their key results and progress, by due date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "List an employee's goals",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GoalListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/interviews": {
+            "get": {
+                "description": "Lists the scheduled interviews an employee conducts that have not ended yet, by time",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "List the upcoming interviews of an employee",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InterviewListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/leave-balances": {
+            "get": {
+                "description": "Returns the employee's balance for every leave type that tracks one, accrued up to today",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "Get leave balances",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Calendar year, defaults to the current year",
+                        "name": "year",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LeaveBalanceListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/leave-requests": {
+            "get": {
+                "description": "Lists an employee's leave requests, most recent first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "List leave requests",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LeaveRequestListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Files a pending leave request. Dates are inclusive and may not overlap another pending or approved request. The request goes to the leave approval workflow when there is one, otherwise the manager decides it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "Request leave",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Leave request payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateLeaveRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LeaveRequestResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/leave-requests/{requestId}": {
+            "get": {
+                "description": "Fetch a single leave request of an employee",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "Get a leave request",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Leave request ID",
+                        "name": "requestId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LeaveRequestResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/leave-requests/{requestId}/approve": {
+            "post": {
+                "description": "Approves a pending request and deducts its days from the balance. Only the employee's manager may decide when one is set, and requests in an approval workflow are decided there.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "Approve a leave request",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Leave request ID",
+                        "name": "requestId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.LeaveDecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LeaveRequestResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/leave-requests/{requestId}/cancel": {
+            "post": {
+                "description": "Withdraws a pending or approved request. Approved days go back to the balance.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "Cancel a leave request",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Leave request ID",
+                        "name": "requestId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LeaveRequestResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/leave-requests/{requestId}/reject": {
+            "post": {
+                "description": "Rejects a pending request. Only the employee's manager may decide when one is set, and requests in an approval workflow are decided there.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "Reject a leave request",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Leave request ID",
+                        "name": "requestId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.LeaveDecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LeaveRequestResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/locations": {
+            "get": {
+                "description": "Lists every applied, scheduled and cancelled primary location assignment of an employee, ordered by effective date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Get location timeline",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LocationHistoryResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Changes an employee's primary location. Assignments effective today or earlier at the new location are applied immediately; future-dated ones are applied by a background job on the effective date.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Move an employee to another location",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Location assignment payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.LocationAssignmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LocationAssignmentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/locations/{assignmentId}": {
+            "delete": {
+                "description": "Cancels a future-dated location assignment that has not been applied yet",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Cancel a scheduled location assignment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Location assignment ID",
+                        "name": "assignmentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/pay-preview": {
+            "get": {
+                "description": "Prorates the employee's monthly pay over the days they were employed, split where their salary changed. Scheduled salary changes are included.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Compensation"
+                ],
+                "summary": "Preview an employee's pay for a month",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month (YYYY-MM), defaults to the current month",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "calendar_days or working_days, defaults to the configured basis",
+                        "name": "basis",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayPreviewResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/payslips": {
+            "get": {
+                "description": "Lists the employee's payslips of finalized and locked payroll runs, latest period first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "List an employee's payslips",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayslipListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/rehire": {
+            "post": {
+                "description": "Brings a terminated employee back as active. The rehire date becomes the new hired date.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employees"
+                ],
+                "summary": "Rehire an employee",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rehire payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.RehireEmployeeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GetEmployeeByIdResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/reviews": {
+            "get": {
+                "description": "Lists the employee's reviews across cycles, latest cycle first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "List an employee's reviews",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReviewListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/salary": {
+            "get": {
+                "description": "Converts an employee's salary with the latest stored exchange rates. Defaults to the reporting currency.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ExchangeRates"
+                ],
+                "summary": "Get salary in another currency",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Target ISO 4217 currency, defaults to the reporting currency",
+                        "name": "currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SalaryConversionResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/shifts": {
+            "get": {
+                "description": "Lists the employee's shifts starting from one date to another inclusive",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shifts"
+                ],
+                "summary": "List rostered shifts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ShiftListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/shifts.ics": {
+            "get": {
+                "description": "iCalendar feed of the employee's upcoming shifts, for subscribing from a calendar app. Calendar apps are told to show the shifts in the time zone of the employee's location.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "Shifts"
+                ],
+                "summary": "Shift calendar feed",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "iCalendar file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/shifts/{shiftId}": {
+            "delete": {
+                "description": "Takes the employee off a rostered shift",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shifts"
+                ],
+                "summary": "Remove a shift",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Shift ID",
+                        "name": "shiftId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/skills": {
+            "get": {
+                "description": "Lists the skills an employee holds with their proficiency levels",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "Get an employee's skills",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.EmployeeSkillListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/skills/{skillId}": {
+            "put": {
+                "description": "Records the employee's proficiency in a skill, replacing any earlier level",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "Set an employee's skill level",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Skill ID",
+                        "name": "skillId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Employee skill payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.EmployeeSkillRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.EmployeeSkillResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a skill from an employee's profile",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "Remove an employee's skill",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Skill ID",
+                        "name": "skillId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/status": {
+            "put": {
+                "description": "Moves an employee between candidate, active, on_leave and suspended. Use the terminate and rehire endpoints for terminations.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employees"
+                ],
+                "summary": "Change employment status",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status change payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChangeEmploymentStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GetEmployeeByIdResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/status-history": {
+            "get": {
+                "description": "Lists every status change of an employee, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employees"
+                ],
+                "summary": "Get employment status history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.EmploymentStatusHistoryResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/tags": {
+            "get": {
+                "description": "Lists the tags of an employee in alphabetical order",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "List an employee's tags",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TagListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds free-form tags to an employee and returns all their tags. Tags are trimmed and lower-cased, up to 50 characters long and without commas; tags the employee already has are skipped",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Tag an employee",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tags payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.TagsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TagListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/tags/{tag}": {
+            "delete": {
+                "description": "Removes one tag from an employee",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Remove a tag from an employee",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/terminate": {
+            "post": {
+                "description": "Ends the employment of an employee. The employee record is kept and stays queryable. When terminations go through an approval workflow, the termination is submitted for approval instead and the pending approval request is returned with status 202. Company assets the employee still holds are counted in unreturned_assets, and in the summary of the approval request.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employees"
+                ],
+                "summary": "Terminate an employee",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Termination payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.TerminateEmployeeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GetEmployeeByIdResponseWrapper"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ApprovalRequestResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/timesheets": {
+            "get": {
+                "description": "Lists the employee's submitted timesheets, latest week first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "List submitted timesheets",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TimesheetListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/timesheets/{week}": {
+            "get": {
+                "description": "Aggregates the employee's shifts for the week (Monday to Sunday) containing the given date, with overtime and missing punches",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "Get a weekly timesheet",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Any date in the week (YYYY-MM-DD)",
+                        "name": "week",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.WeeklyTimesheetResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/timesheets/{week}/approve": {
+            "post": {
+                "description": "Approves a submitted timesheet. Only the employee's manager may decide when one is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "Approve a weekly timesheet",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Any date in the week (YYYY-MM-DD)",
+                        "name": "week",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.TimesheetDecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TimesheetResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/timesheets/{week}/reject": {
+            "post": {
+                "description": "Sends a submitted timesheet back to the employee and unlocks its shifts. Only the employee's manager may decide when one is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "Reject a weekly timesheet",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Any date in the week (YYYY-MM-DD)",
+                        "name": "week",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.TimesheetDecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TimesheetResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/timesheets/{week}/submit": {
+            "post": {
+                "description": "Submits the week containing the given date for approval and locks its shifts. Shifts without a clock-out must be corrected first; a rejected week can be resubmitted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "Submit a weekly timesheet",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Any date in the week (YYYY-MM-DD)",
+                        "name": "week",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.WeeklyTimesheetResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/working-days": {
+            "get": {
+                "description": "Counts the weekdays from one date to another inclusive, minus the public holidays of the employee's location",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holidays"
+                ],
+                "summary": "Count an employee's working days",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.WorkingDaysResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/enrollments/{id}/cancel": {
+            "post": {
+                "description": "Withdraws the employee from a course they have not completed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Training"
+                ],
+                "summary": "Cancel an enrollment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Enrollment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.EnrollmentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/enrollments/{id}/complete": {
+            "post": {
+                "description": "Records that the employee completed the course, today unless a date is given. Courses with a certification validity issue a certification dated on completion.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Training"
+                ],
+                "summary": "Complete an enrollment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Enrollment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Completion payload",
+                        "name": "payload",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/v1.EnrollmentCompletionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.EnrollmentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/exchange-rates": {
+            "get": {
+                "description": "Lists stored exchange rates grouped by currency pair, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ExchangeRates"
+                ],
+                "summary": "List exchange rates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExchangeRateListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Stores the rate between two currencies from an effective date onwards",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ExchangeRates"
+                ],
+                "summary": "Add an exchange rate",
+                "parameters": [
+                    {
+                        "description": "Exchange rate payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateExchangeRateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExchangeRateResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/expense-categories": {
+            "get": {
+                "description": "Lists the expense categories ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "List expense categories",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseCategoryListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a category employees claim expenses in, optionally with a per-claim limit and requiring receipts unless receipt_required is false",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Create an expense category",
+                "parameters": [
+                    {
+                        "description": "Expense category payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseCategoryResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/expense-categories/{id}": {
+            "get": {
+                "description": "Returns an expense category by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Get an expense category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Expense category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseCategoryResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces an expense category's details. Claims already submitted are not checked against a new limit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Update an expense category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Expense category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Expense category payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseCategoryResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes an expense category no claim uses",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Delete an expense category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Expense category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses": {
+            "get": {
+                "description": "Lists the expense claims of all employees without their items, most recent first. Payroll picks the claims to reimburse up with status=approved.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "List expense claims",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "draft, submitted, approved, rejected or reimbursed",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only claims of this employee",
+                        "name": "employee_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only claims of employees in this department",
+                        "name": "department_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseClaimListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/expenses/report": {
+            "get": {
+                "description": "Totals approved and reimbursed expenses dated from one date to another inclusive by department and month, in the reporting currency at the rates of the end of each month",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Expenses"
+                ],
+                "summary": "Report expenses by department and month",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First expense date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last expense date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only this department",
+                        "name": "department_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseReportResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/goals": {
+            "get": {
+                "description": "Lists the goals owned by the company, by due date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "List company goals",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GoalListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a goal with 1 to 10 key results, owned by an employee or, without an employee, by the company. It can be aligned to a company goal or a goal of the owner's manager.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "Create a goal",
+                "parameters": [
+                    {
+                        "description": "Goal payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.GoalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GoalResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/goals/{id}": {
+            "get": {
+                "description": "Retrieve a goal with its key results and their progress",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "Get a goal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GoalResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Changes a goal's details, alignment and status. The owner and key results are left as they are.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "Update a goal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Goal payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.GoalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GoalResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a goal with its key results and check-ins. Goals aligned to it must be realigned or deleted first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "Delete a goal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/goals/{id}/aligned": {
+            "get": {
+                "description": "Lists the goals aligned directly to a goal, by due date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "List aligned goals",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GoalListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/goals/{id}/check-ins": {
+            "get": {
+                "description": "Lists the check-ins on the goal's key results, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "List a goal's check-ins",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GoalCheckInListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Records the current value of one of an active goal's key results",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "Check in on a goal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Check-in payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.GoalCheckInRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GoalCheckInResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/goals/{id}/key-results": {
+            "post": {
+                "description": "Adds a key result to an active goal, starting at its start value",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Goals"
+                ],
+                "summary": "Add a key result",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Key result payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.KeyResultRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GoalResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/holiday-calendars": {
+            "get": {
+                "description": "Lists the holiday calendars ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holidays"
+                ],
+                "summary": "List holiday calendars",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.HolidayCalendarListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds an empty holiday calendar; add holidays one by one or import an iCalendar file",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holidays"
+                ],
+                "summary": "Create a holiday calendar",
+                "parameters": [
+                    {
+                        "description": "Holiday calendar payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.HolidayCalendarRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.HolidayCalendarResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/holiday-calendars/{id}": {
+            "get": {
+                "description": "Fetch a single holiday calendar",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holidays"
+                ],
+                "summary": "Get a holiday calendar",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Holiday calendar ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.HolidayCalendarResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Rename a holiday calendar or change its country",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holidays"
+                ],
+                "summary": "Update a holiday calendar",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Holiday calendar ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Holiday calendar payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.HolidayCalendarRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.HolidayCalendarResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a holiday calendar and its holidays. Calendars still assigned to a location cannot be deleted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holidays"
+                ],
+                "summary": "Delete a holiday calendar",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Holiday calendar ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/holiday-calendars/{id}/holidays": {
+            "get": {
+                "description": "Lists the holidays of a calendar for one year, by date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holidays"
+                ],
+                "summary": "List holidays",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Holiday calendar ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Calendar year, defaults to the current year",
+                        "name": "year",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.HolidayListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a single holiday to a calendar",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holidays"
+                ],
+                "summary": "Add a holiday",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Holiday calendar ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Holiday payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.HolidayRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.HolidayResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/holiday-calendars/{id}/holidays/{holidayId}": {
+            "delete": {
+                "description": "Remove a holiday from a calendar",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holidays"
+                ],
+                "summary": "Delete a holiday",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Holiday calendar ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Holiday ID",
+                        "name": "holidayId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/holiday-calendars/{id}/import": {
+            "post": {
+                "description": "Adds every day covered by the events of an .ics file to the calendar. Send the file as the request body (text/calendar) or as the \"file\" field of a multipart form. Yearly recurring events are expanded up to the end of next year; dates already in the calendar are renamed.",
+                "consumes": [
+                    "text/calendar",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holidays"
+                ],
+                "summary": "Import holidays from an iCalendar file",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Holiday calendar ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "iCalendar file",
+                        "name": "file",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.HolidayImportResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/interviews/{id}": {
+            "put": {
+                "description": "Changes the interviewer, time or duration of a scheduled interview. Times are in UTC.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "Reschedule an interview",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Interview ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Interview payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.InterviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InterviewResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/interviews/{id}/cancel": {
+            "post": {
+                "description": "Cancels a scheduled interview",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "Cancel an interview",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Interview ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InterviewResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/interviews/{id}/feedback": {
+            "post": {
+                "description": "Records the interviewer's rating, recommendation and feedback and completes the interview",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "Submit interview feedback",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Interview ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Feedback payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.InterviewFeedbackRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InterviewResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/leave-types": {
+            "get": {
+                "description": "Lists all leave types ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "List leave types",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LeaveTypeListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a leave type with its accrual policy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "Create a leave type",
+                "parameters": [
+                    {
+                        "description": "Leave type payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.LeaveTypeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LeaveTypeResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/leave-types/{id}": {
+            "get": {
+                "description": "Fetch a single leave type",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "Get a leave type",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Leave type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LeaveTypeResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the name and accrual policy of a leave type. Balances are recomputed the next time they are read.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "Update a leave type",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Leave type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Leave type payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.LeaveTypeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LeaveTypeResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a leave type that no leave request refers to",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "Delete a leave type",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Leave type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/locations": {
+            "get": {
+                "description": "Lists the locations ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "List locations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LocationListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds an office location, optionally observing a holiday calendar",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Create a location",
+                "parameters": [
+                    {
+                        "description": "Location payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.LocationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LocationResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/locations/{id}": {
+            "get": {
+                "description": "Fetch a single location",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Get a location",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LocationResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Rename a location or change the holiday calendar it observes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Update a location",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Location payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.LocationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LocationResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a location that no employee is assigned to",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Delete a location",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll-periods": {
+            "get": {
+                "description": "Lists payroll periods, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "List payroll periods",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollPeriodListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a monthly payroll period with its pay date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Open a payroll period",
+                "parameters": [
+                    {
+                        "description": "Payroll period payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollPeriodRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollPeriodResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll-periods/{id}": {
+            "get": {
+                "description": "Retrieve a payroll period by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Get a payroll period",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payroll period ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollPeriodResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll-rules": {
+            "get": {
+                "description": "Lists payroll rules, including inactive ones, in the order they are applied",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "List payroll rules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollRuleListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds an earning, deduction or tax applied to every payslip, either as a fixed amount or a percentage",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Create a payroll rule",
+                "parameters": [
+                    {
+                        "description": "Payroll rule payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollRuleResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll-rules/{id}": {
+            "get": {
+                "description": "Retrieve a payroll rule by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Get a payroll rule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payroll rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollRuleResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Changes a payroll rule for future calculations. Existing payslips keep their amounts.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Update a payroll rule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payroll rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payroll rule payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollRuleResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a payroll rule. Payslips already calculated keep their lines.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Delete a payroll rule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payroll rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll-runs": {
+            "get": {
+                "description": "Lists payroll runs, latest period first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "List payroll runs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollRunListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Calculates a draft run of the period with a payslip for every employee employed during it, using current salaries and active payroll rules. Pay is prorated for employees who joined, left or had a salary change during the period. Benefit contributions are deducted, and expense claims approved by the end of the period are paid back unless another run already holds them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Start a payroll run",
+                "parameters": [
+                    {
+                        "description": "Payroll run payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollRunRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollRunResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll-runs/{id}": {
+            "get": {
+                "description": "Retrieve a payroll run by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Get a payroll run",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payroll run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollRunResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a draft payroll run with its payslips",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Delete a payroll run",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payroll run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll-runs/{id}/finalize": {
+            "post": {
+                "description": "Finalizes a draft run; its payslips become visible to employees and can no longer be recalculated",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Finalize a payroll run",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payroll run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollRunResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll-runs/{id}/lock": {
+            "post": {
+                "description": "Closes a finalized run for good, e.g. once it has been paid. A locked run cannot be reopened or deleted. The expense claims the run pays back are marked reimbursed as of the pay date.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Lock a payroll run",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payroll run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollRunResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll-runs/{id}/payslips": {
+            "get": {
+                "description": "Lists the run's payslips by employee name, without their lines",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "List the payslips of a payroll run",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payroll run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayslipListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll-runs/{id}/payslips/{payslipId}": {
+            "get": {
+                "description": "Retrieve a payslip of a payroll run with its lines",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Get a payslip",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payroll run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Payslip ID",
+                        "name": "payslipId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayslipResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll-runs/{id}/payslips/{payslipId}/pdf": {
+            "get": {
+                "description": "Renders a payslip of a payroll run as a one-page PDF document",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Download a payslip as PDF",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payroll run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Payslip ID",
+                        "name": "payslipId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll-runs/{id}/recalculate": {
+            "post": {
+                "description": "Recalculates every payslip of a draft run with current salaries and payroll rules",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Recalculate a payroll run",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payroll run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollRunResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll-runs/{id}/reopen": {
+            "post": {
+                "description": "Turns a finalized run back into a draft so it can be recalculated",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Reopen a payroll run",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payroll run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayrollRunResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/positions": {
+            "get": {
+                "description": "Lists the positions catalog ordered by job level",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Positions"
+                ],
+                "summary": "List positions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PositionListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a position to the catalog, optionally with a salary band",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Positions"
+                ],
+                "summary": "Create a position",
+                "parameters": [
+                    {
+                        "description": "Position payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.PositionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PositionResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/positions/{id}": {
+            "get": {
+                "description": "Fetch a single position with its salary band",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Positions"
+                ],
+                "summary": "Get a position",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Position ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PositionResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the title, level and salary band of a position. Existing salaries are not re-validated; see the out-of-band report.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Positions"
+                ],
+                "summary": "Update a position",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Position ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Position payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.PositionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PositionResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a position that is no longer assigned to any employee",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Positions"
+                ],
+                "summary": "Delete a position",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Position ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/missing-clock-outs": {
+            "get": {
+                "description": "Lists shifts that are still open after the maximum shift length, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "List missing clock-outs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AttendanceEntryListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/out-of-band-salaries": {
+            "get": {
+                "description": "Lists current employees whose salary today lies outside the band of their position",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Positions"
+                ],
+                "summary": "Out-of-band salaries report",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.OutOfBandEmployeeListResponseWrapper"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/requisitions": {
+            "get": {
+                "description": "Lists job requisitions, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "List job requisitions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "open, on_hold, filled or closed",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.RequisitionListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Opens a requisition to hire for a position. Employees hired through it get its position, department, location and hiring manager.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "Create a job requisition",
+                "parameters": [
+                    {
+                        "description": "Job requisition payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.RequisitionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.RequisitionResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/requisitions/{id}": {
+            "get": {
+                "description": "Returns a job requisition with the number of candidates hired through it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "Get a job requisition",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job requisition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.RequisitionResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Changes a job requisition for future hires. Openings cannot drop below the candidates already hired.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "Update a job requisition",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job requisition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Job requisition payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.RequisitionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.RequisitionResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a job requisition without candidates; close requisitions with candidates instead",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "Delete a job requisition",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job requisition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/requisitions/{id}/candidates": {
+            "get": {
+                "description": "Lists the candidates of a job requisition, latest applicant first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "List the candidates of a job requisition",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job requisition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "applied, screening, interview, offer, hired, rejected or withdrawn",
+                        "name": "stage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CandidateListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds an applicant to an open job requisition, in the applied stage",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recruiting"
+                ],
+                "summary": "Add a candidate to a job requisition",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job requisition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Candidate payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CandidateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CandidateResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/review-cycles": {
+            "get": {
+                "description": "Lists review cycles without their questions, latest period first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "List review cycles",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReviewCycleListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a draft review cycle with its questions, rating scale and the deadlines of the self, peer and manager review stages",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Create a review cycle",
+                "parameters": [
+                    {
+                        "description": "Review cycle payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReviewCycleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReviewCycleResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/review-cycles/{id}": {
+            "get": {
+                "description": "Retrieve a review cycle with its questions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Get a review cycle",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review cycle ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReviewCycleResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces a draft review cycle, including its questions. Launched cycles can no longer be changed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Update a review cycle",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review cycle ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Review cycle payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReviewCycleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReviewCycleResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a draft review cycle with its questions. Launched cycles cannot be deleted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Delete a review cycle",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review cycle ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/review-cycles/{id}/calibration": {
+            "get": {
+                "description": "Aggregates the cycle's self, peer and manager ratings by department, with the distribution of manager ratings over completed reviews",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Get a review cycle's calibration",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review cycle ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReviewCalibrationResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/review-cycles/{id}/close": {
+            "post": {
+                "description": "Closes an active cycle and locks its completed reviews; reviews still in progress stay unfinished",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Close a review cycle",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review cycle ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReviewCycleResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/review-cycles/{id}/launch": {
+            "post": {
+                "description": "Activates a draft cycle and opens a review for every active or on-leave employee hired by the end of its period, with a self review and a manager review for employees who have a manager",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Launch a review cycle",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review cycle ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReviewCycleResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/review-cycles/{id}/reviews": {
+            "get": {
+                "description": "Lists the reviews of a cycle with their ratings, ordered by employee name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "List a review cycle's reviews",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review cycle ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReviewListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reviews/{id}": {
+            "get": {
+                "description": "Retrieve a review with its self, peer and manager submissions and their answers",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Get a review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReviewResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reviews/{id}/lock": {
+            "post": {
+                "description": "Locks a completed review, e.g. after calibration; locked reviews can no longer change",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Lock a review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReviewResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reviews/{id}/peers": {
+            "post": {
+                "description": "Asks other employees for a peer review of an in-progress review. Employees already asked are skipped.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Ask for peer reviews",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Peer reviewers payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.PeerReviewersRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReviewResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reviews/{id}/submissions": {
+            "post": {
+                "description": "Records a self, peer or manager review before the deadline of its stage. Every question of the cycle must be answered once. The manager review completes the review.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Submit a review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Review submission payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReviewSubmissionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReviewResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rosters": {
+            "post": {
+                "description": "Assigns employees to a shift template on the selected weekdays of a date range. Shifts may not fall on approved leave, overlap other shifts or leave less than the minimum rest between shifts; when any does, nothing is rostered and the conflicts are listed in the error details. A dry run returns the conflicts without rostering.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shifts"
+                ],
+                "summary": "Roster employees on a shift",
+                "parameters": [
+                    {
+                        "description": "Roster payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.RosterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.RosterResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/segments": {
+            "get": {
+                "description": "Lists the saved employee segments ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Segments"
+                ],
+                "summary": "List employee segments",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SegmentListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Saves a named employee list filter anyone can use by its ID. Members are not stored: they are whoever matches the filter when the segment is used",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Segments"
+                ],
+                "summary": "Save an employee segment",
+                "parameters": [
+                    {
+                        "description": "Segment payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.SegmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SegmentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/segments/{id}": {
+            "get": {
+                "description": "Returns a saved employee segment by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Segments"
+                ],
+                "summary": "Get an employee segment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Segment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SegmentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces a segment's name, description and filter",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Segments"
+                ],
+                "summary": "Update an employee segment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Segment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Segment payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.SegmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SegmentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a saved segment; its members are not affected",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Segments"
+                ],
+                "summary": "Delete an employee segment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Segment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/segments/{id}/announcements": {
+            "post": {
+                "description": "Sends an announcement to the work email of every employee currently in a segment and returns how many employees it went to. Members without a work email are skipped.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Segments"
+                ],
+                "summary": "Send an announcement to a segment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Segment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Announcement payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.AnnouncementRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AnnouncementSentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/segments/{id}/members": {
+            "get": {
+                "description": "Evaluates a segment, listing the employees matching its filter now",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Segments"
+                ],
+                "summary": "List a segment's members",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Segment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GetAllEmployeesResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/segments/{id}/members.csv": {
+            "get": {
+                "description": "Evaluates a segment and downloads the employees matching its filter now as a CSV file, one row per employee. Tags are separated by semicolons.",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "Segments"
+                ],
+                "summary": "Export a segment's members",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Segment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "CSV file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/segments/{id}/tags": {
+            "post": {
+                "description": "Adds tags to every employee currently in a segment, skipping tags members already have, and returns how many tags were added",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Segments"
+                ],
+                "summary": "Tag a segment's members",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Segment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tags payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.TagsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SegmentMembersTaggedResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/shift-templates": {
+            "get": {
+                "description": "Lists shift templates by start time",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shifts"
+                ],
+                "summary": "List shift templates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ShiftTemplateListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a reusable shift pattern. Times are UTC; a template ending at or before its start runs overnight.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shifts"
+                ],
+                "summary": "Create a shift template",
+                "parameters": [
+                    {
+                        "description": "Shift template payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ShiftTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ShiftTemplateResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/shift-templates/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shifts"
+                ],
+                "summary": "Get a shift template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shift template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ShiftTemplateResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Changes a shift template. Shifts already rostered keep their times.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shifts"
+                ],
+                "summary": "Update a shift template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shift template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Shift template payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ShiftTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ShiftTemplateResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a shift template that no shift is rostered on",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shifts"
+                ],
+                "summary": "Delete a shift template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shift template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/skill-categories": {
+            "get": {
+                "description": "Lists the categories of the skills taxonomy ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "List skill categories",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SkillCategoryListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a category to the skills taxonomy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "Create a skill category",
+                "parameters": [
+                    {
+                        "description": "Skill category payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.SkillCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SkillCategoryResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/skill-categories/{id}": {
+            "put": {
+                "description": "Rename a skill category",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "Update a skill category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Skill category payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.SkillCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SkillCategoryResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a skill category that has no skills",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "Delete a skill category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/skills": {
+            "get": {
+                "description": "Lists the skills ordered by category and name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "List skills",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Only skills of this category",
+                        "name": "category_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SkillListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a skill to a category of the skills taxonomy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "Create a skill",
+                "parameters": [
+                    {
+                        "description": "Skill payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.SkillRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SkillResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/skills/search": {
+            "post": {
+                "description": "Finds employees holding the required skills at or above the minimum levels.\nEmployees meeting more requirements rank first, then those exceeding the levels by more.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "Search employees by skills",
+                "parameters": [
+                    {
+                        "description": "Skill search payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.SkillSearchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SkillMatchListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/skills/{id}": {
+            "get": {
+                "description": "Retrieve a skill with its category",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "Get a skill",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SkillResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Rename a skill, change its description or move it to another category",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "Update a skill",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Skill payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.SkillRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SkillResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a skill that no employee holds",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Skills"
+                ],
+                "summary": "Delete a skill",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "description": "Lists the tags in use with how many employees have each",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "List tags",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TagCountListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{tag}": {
+            "delete": {
+                "description": "Removes a tag from every employee having it. Segments filtering on the tag are left as they are",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Delete a tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/training-courses": {
+            "get": {
+                "description": "Lists the training courses ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Training"
+                ],
+                "summary": "List training courses",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TrainingCourseListResponseWrapper"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a course employees can be enrolled in. Courses with a certification validity issue a certification on completion.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Training"
+                ],
+                "summary": "Create a training course",
+                "parameters": [
+                    {
+                        "description": "Training course payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.TrainingCourseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TrainingCourseResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/training-courses/{id}": {
+            "get": {
+                "description": "Retrieve a training course",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Training"
+                ],
+                "summary": "Get a training course",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Training course ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TrainingCourseResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Changes a training course. Certifications already issued keep their expiry date.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Training"
+                ],
+                "summary": "Update a training course",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Training course ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Training course payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.TrainingCourseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TrainingCourseResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a training course nobody has enrolled in",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Training"
+                ],
+                "summary": "Delete a training course",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Training course ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/training-courses/{id}/enrollments": {
+            "get": {
+                "description": "Lists the enrollments in a training course, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Training"
+                ],
+                "summary": "List a course's enrollments",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Training course ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.EnrollmentListResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Enrolls an employee in a training course, optionally with a due date. An employee can only have one open enrollment per course.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Training"
+                ],
+                "summary": "Enroll an employee in a course",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Training course ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Enrollment payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.EnrollmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.EnrollmentResponseWrapper"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apiresponse.StandardResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "apiresponse.ErrorInfo": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "apiresponse.StandardResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "error": {
+                    "$ref": "#/definitions/apiresponse.ErrorInfo"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AddressDTO": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "description": "example: London",
+                    "type": "string"
+                },
+                "country": {
+                    "description": "ISO 3166-1 alpha-2 country code\nexample: GB",
+                    "type": "string"
+                },
+                "line1": {
+                    "description": "example: 221B Baker Street",
+                    "type": "string"
+                },
+                "line2": {
+                    "description": "example: Flat 2",
+                    "type": "string"
+                },
+                "postal_code": {
+                    "description": "example: NW1 6XE",
+                    "type": "string"
+                },
+                "state": {
+                    "description": "example: Greater London",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AnnouncementRequest": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "description": "example: The office is closed this Friday for maintenance, please work from home.",
+                    "type": "string"
+                },
+                "subject": {
+                    "description": "example: Office closed on Friday",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AnnouncementSentResponse": {
+            "type": "object",
+            "properties": {
+                "recipients": {
+                    "description": "Members the announcement went to, not counting those without a work email\nexample: 12",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.AnnouncementSentResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.AnnouncementSentResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ApprovalDecisionRequest": {
+            "type": "object",
+            "properties": {
+                "approver_id": {
+                    "description": "Employee deciding, who must have the task in their inbox\nexample: 7",
+                    "type": "integer"
+                },
+                "comment": {
+                    "description": "example: Within budget",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ApprovalDelegationListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ApprovalDelegationResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ApprovalDelegationRequest": {
+            "type": "object",
+            "properties": {
+                "delegate_id": {
+                    "description": "Employee deciding in the delegator's place\nexample: 9",
+                    "type": "integer"
+                },
+                "end_date": {
+                    "description": "Last day of the delegation\nexample: 2025-08-15",
+                    "type": "string"
+                },
+                "start_date": {
+                    "description": "example: 2025-08-01",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ApprovalDelegationResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "In effect today\nexample: true",
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "description": "example: 2025-07-28 10:00:00",
+                    "type": "string"
+                },
+                "delegate_id": {
+                    "description": "example: 9",
+                    "type": "integer"
+                },
+                "delegate_name": {
+                    "description": "example: Mary Major",
+                    "type": "string"
+                },
+                "delegator_id": {
+                    "description": "example: 7",
+                    "type": "integer"
+                },
+                "delegator_name": {
+                    "description": "example: John Smith",
+                    "type": "string"
+                },
+                "end_date": {
+                    "description": "example: 2025-08-15",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "start_date": {
+                    "description": "example: 2025-08-01",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ApprovalDelegationResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.ApprovalDelegationResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ApprovalRequestListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ApprovalRequestResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ApprovalRequestResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2025-03-10 09:00:00",
+                    "type": "string"
+                },
+                "current_stage": {
+                    "description": "Stage waiting for decisions, 0 once decided\nexample: 1",
+                    "type": "integer"
+                },
+                "decided_at": {
+                    "description": "example: 2025-03-11 14:00:00",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 12",
+                    "type": "integer"
+                },
+                "employee_name": {
+                    "description": "example: Jane Doe",
+                    "type": "string"
+                },
+                "failure_reason": {
+                    "description": "example: Termination date must not be before the hire date",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "payload": {
+                    "description": "The change, made once the request is approved",
+                    "type": "object"
+                },
+                "reference": {
+                    "description": "example: employee:12",
+                    "type": "string"
+                },
+                "requested_by": {
+                    "description": "example: 7",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "One of pending, approved, rejected, cancelled or failed (approved, but the change could not be made)\nexample: pending",
+                    "type": "string"
+                },
+                "summary": {
+                    "description": "example: Change the salary of Jane Doe from 5000.00 USD to 5500.00 USD effective 2025-04-01: Annual raise",
+                    "type": "string"
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ApprovalTaskResponse"
+                    }
+                },
+                "type": {
+                    "description": "example: salary_change",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ApprovalRequestResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.ApprovalRequestResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ApprovalStepRequest": {
+            "type": "object",
+            "properties": {
+                "approver_id": {
+                    "description": "Position of position steps or employee of employee steps, empty for manager steps\nexample: 4",
+                    "type": "integer"
+                },
+                "approver_type": {
+                    "description": "One of manager (of the employee the request is about), position (any holder of the\nposition) or employee\nexample: position",
+                    "type": "string"
+                },
+                "escalate_to_id": {
+                    "description": "Receives the step on timeout; without it the step goes to the manager of its assignee\nexample: 3",
+                    "type": "integer"
+                },
+                "stage": {
+                    "description": "Steps run stage by stage from the lowest; steps sharing a stage run in parallel\nexample: 1",
+                    "type": "integer"
+                },
+                "timeout_hours": {
+                    "description": "Hours the step may stay undecided before it is escalated, 0 to 720; 0 never escalates\nexample: 48",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ApprovalStepResponse": {
+            "type": "object",
+            "properties": {
+                "approver_id": {
+                    "description": "example: 4",
+                    "type": "integer"
+                },
+                "approver_type": {
+                    "description": "example: position",
+                    "type": "string"
+                },
+                "escalate_to_id": {
+                    "description": "example: 3",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "stage": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "timeout_hours": {
+                    "description": "example: 48",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ApprovalTaskListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ApprovalTaskResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ApprovalTaskResponse": {
+            "type": "object",
+            "properties": {
+                "approver_type": {
+                    "description": "example: position",
+                    "type": "string"
+                },
+                "assignee_id": {
+                    "description": "Empty for position tasks until they are escalated\nexample: 7",
+                    "type": "integer"
+                },
+                "assignee_name": {
+                    "description": "example: John Smith",
+                    "type": "string"
+                },
+                "comment": {
+                    "description": "example: Within budget",
+                    "type": "string"
+                },
+                "decided_at": {
+                    "description": "example: 2025-03-11 14:00:00",
+                    "type": "string"
+                },
+                "decided_by": {
+                    "description": "Approver or their delegate\nexample: 7",
+                    "type": "integer"
+                },
+                "due_at": {
+                    "description": "example: 2025-03-12 09:00:00",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "Employee the request is about\nexample: 12",
+                    "type": "integer"
+                },
+                "employee_name": {
+                    "description": "example: Jane Doe",
+                    "type": "string"
+                },
+                "escalated_at": {
+                    "description": "example: 2025-03-12 09:15:00",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "overdue": {
+                    "description": "Still undecided past its timeout\nexample: false",
+                    "type": "boolean"
+                },
+                "position": {
+                    "description": "example: HR Director",
+                    "type": "string"
+                },
+                "position_id": {
+                    "description": "Position whose holders may decide the task\nexample: 4",
+                    "type": "integer"
+                },
+                "request_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "request_type": {
+                    "description": "example: salary_change",
+                    "type": "string"
+                },
+                "stage": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "One of waiting, pending, approved, rejected, skipped or cancelled\nexample: pending",
+                    "type": "string"
+                },
+                "summary": {
+                    "description": "example: Change the salary of Jane Doe from 5000.00 USD to 5500.00 USD effective 2025-04-01: Annual raise",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ApprovalWorkflowListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ApprovalWorkflowResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ApprovalWorkflowRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "example: Salary change approval",
+                    "type": "string"
+                },
+                "request_type": {
+                    "description": "One of salary_change, termination, expense or leave\nexample: salary_change",
+                    "type": "string"
+                },
+                "steps": {
+                    "description": "1 to 20 steps",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ApprovalStepRequest"
+                    }
+                }
+            }
+        },
+        "v1.ApprovalWorkflowResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2025-01-10 09:00:00",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: Salary change approval",
+                    "type": "string"
+                },
+                "request_type": {
+                    "description": "example: salary_change",
+                    "type": "string"
+                },
+                "steps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ApprovalStepResponse"
+                    }
+                },
+                "updated_at": {
+                    "description": "example: 2025-01-10 09:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ApprovalWorkflowResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.ApprovalWorkflowResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AssetAssignmentListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.AssetAssignmentResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AssetAssignmentRequest": {
+            "type": "object",
+            "properties": {
+                "assigned_on": {
+                    "description": "Defaults to today\nexample: 2025-01-06",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "notes": {
+                    "description": "example: Handed over with charger",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AssetAssignmentResponse": {
+            "type": "object",
+            "properties": {
+                "asset_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "asset_name": {
+                    "description": "example: MacBook Pro 14",
+                    "type": "string"
+                },
+                "asset_type": {
+                    "description": "example: laptop",
+                    "type": "string"
+                },
+                "assigned_on": {
+                    "description": "example: 2025-01-06",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "example: 2025-01-06 09:00:00",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "employee_name": {
+                    "description": "example: John Doe",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "notes": {
+                    "description": "example: Handed over with charger",
+                    "type": "string"
+                },
+                "return_notes": {
+                    "description": "example: Screen scratched",
+                    "type": "string"
+                },
+                "returned_on": {
+                    "description": "Empty while the employee still holds the asset\nexample: 2025-06-30",
+                    "type": "string"
+                },
+                "serial_number": {
+                    "description": "example: C02XK1JHJG5J",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-06-30 17:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AssetAssignmentResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.AssetAssignmentResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AssetListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.AssetResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AssetRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "example: MacBook Pro 14",
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "example: 16 GB RAM, 512 GB SSD",
+                    "type": "string"
+                },
+                "purchase_date": {
+                    "description": "example: 2024-11-15",
+                    "type": "string"
+                },
+                "serial_number": {
+                    "description": "example: C02XK1JHJG5J",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "One of available, in_repair, lost, retired; defaults to available for new assets and is\nleft unchanged otherwise. Assets are assigned and returned through their own endpoints.\nexample: available",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "One of laptop, desktop, monitor, phone, tablet, badge, key, other\nexample: laptop",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AssetResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2024-11-20 08:00:00",
+                    "type": "string"
+                },
+                "holder_id": {
+                    "description": "Employee holding the asset\nexample: 1",
+                    "type": "integer"
+                },
+                "holder_name": {
+                    "description": "example: John Doe",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: MacBook Pro 14",
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "example: 16 GB RAM, 512 GB SSD",
+                    "type": "string"
+                },
+                "purchase_date": {
+                    "description": "example: 2024-11-15",
+                    "type": "string"
+                },
+                "serial_number": {
+                    "description": "example: C02XK1JHJG5J",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "One of available, assigned, in_repair, lost, retired\nexample: assigned",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "example: laptop",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2024-11-20 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AssetResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.AssetResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AssetReturnRequest": {
+            "type": "object",
+            "properties": {
+                "notes": {
+                    "description": "example: Screen scratched",
+                    "type": "string"
+                },
+                "returned_on": {
+                    "description": "Defaults to today\nexample: 2025-06-30",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status the asset is left in: available (default), in_repair, lost or retired\nexample: available",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AssignChecklistTaskRequest": {
+            "type": "object",
+            "properties": {
+                "due_on": {
+                    "description": "example: 2025-03-03",
+                    "type": "string"
+                },
+                "owner_id": {
+                    "description": "Empty to leave the task unassigned\nexample: 7",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.AttendanceEntryListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.AttendanceEntryResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AttendanceEntryRequest": {
+            "type": "object",
+            "properties": {
+                "clock_in": {
+                    "description": "example: 2025-03-03 09:00:00",
+                    "type": "string"
+                },
+                "clock_out": {
+                    "description": "Omit to leave the shift open\nexample: 2025-03-03 17:30:00",
+                    "type": "string"
+                },
+                "note": {
+                    "description": "example: Forgot to clock out",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AttendanceEntryResponse": {
+            "type": "object",
+            "properties": {
+                "clock_in": {
+                    "description": "example: 2025-03-03 09:00:00",
+                    "type": "string"
+                },
+                "clock_out": {
+                    "description": "example: 2025-03-03 17:30:00",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "example: 2025-03-03 09:00:00",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "note": {
+                    "description": "example: On site at client",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-03-03 17:30:00",
+                    "type": "string"
+                },
+                "worked_minutes": {
+                    "description": "example: 510",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.AttendanceEntryResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.AttendanceEntryResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BenefitDeductionListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BenefitDeductionResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BenefitDeductionResponse": {
+            "type": "object",
+            "properties": {
+                "dependent_count": {
+                    "description": "example: 2",
+                    "type": "integer"
+                },
+                "employee_contribution": {
+                    "description": "Deducted from the employee's pay, dependents included",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.MoneyDTO"
+                        }
+                    ]
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "employee_name": {
+                    "description": "example: John Doe",
+                    "type": "string"
+                },
+                "employer_contribution": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "enrollment_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "plan_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "plan_name": {
+                    "description": "example: Family health insurance",
+                    "type": "string"
+                },
+                "plan_type": {
+                    "description": "example: health",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BenefitEnrollmentListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BenefitEnrollmentResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BenefitEnrollmentRequest": {
+            "type": "object",
+            "properties": {
+                "dependent_ids": {
+                    "description": "Dependents of the employee to cover, for plans covering dependents\nexample: [1,2]",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "plan_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.BenefitEnrollmentResponse": {
+            "type": "object",
+            "properties": {
+                "coverage_ends_on": {
+                    "description": "Last day of coverage; empty while the enrollment is open\nexample: 2026-06-30",
+                    "type": "string"
+                },
+                "coverage_starts_on": {
+                    "description": "example: 2026-01-01",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "example: 2025-11-12 09:00:00",
+                    "type": "string"
+                },
+                "dependent_ids": {
+                    "description": "example: [1,2]",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "employee_name": {
+                    "description": "example: John Doe",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "plan": {
+                    "$ref": "#/definitions/v1.BenefitPlanResponse"
+                },
+                "updated_at": {
+                    "description": "example: 2025-11-12 09:00:00",
+                    "type": "string"
+                },
+                "window_id": {
+                    "description": "Enrollment window the employee enrolled in; empty for new hires\nexample: 1",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.BenefitEnrollmentResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.BenefitEnrollmentResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BenefitPlanListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BenefitPlanResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BenefitPlanRequest": {
+            "type": "object",
+            "properties": {
+                "covers_dependents": {
+                    "description": "example: true",
+                    "type": "boolean"
+                },
+                "currency": {
+                    "description": "example: USD",
+                    "type": "string"
+                },
+                "dependent_contribution": {
+                    "description": "Deducted every month for each covered dependent\nexample: 5000",
+                    "type": "integer"
+                },
+                "description": {
+                    "description": "example: Covers hospital stays and outpatient care",
+                    "type": "string"
+                },
+                "eligible_statuses": {
+                    "description": "Employment statuses that may enroll; defaults to active\nexample: [\"active\",\"on_leave\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "employee_contribution": {
+                    "description": "Deducted from the employee's pay every month\nexample: 12000",
+                    "type": "integer"
+                },
+                "employer_contribution": {
+                    "description": "Paid by the company every month\nexample: 36000",
+                    "type": "integer"
+                },
+                "location_ids": {
+                    "description": "Locations the plan is limited to; empty for every location\nexample: [1,2]",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "min_tenure_months": {
+                    "description": "Months employees must have been employed for when coverage starts\nexample: 3",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: Family health insurance",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "One of health, dental, vision, life, disability, pension, other\nexample: health",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BenefitPlanResponse": {
+            "type": "object",
+            "properties": {
+                "covers_dependents": {
+                    "description": "example: true",
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "description": "example: 2025-01-06 09:00:00",
+                    "type": "string"
+                },
+                "dependent_contribution": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "description": {
+                    "description": "example: Covers hospital stays and outpatient care",
+                    "type": "string"
+                },
+                "eligible_statuses": {
+                    "description": "example: [\"active\",\"on_leave\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "employee_contribution": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "employer_contribution": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "location_ids": {
+                    "description": "example: [1,2]",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "min_tenure_months": {
+                    "description": "example: 3",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: Family health insurance",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "example: health",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-01-06 09:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BenefitPlanResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.BenefitPlanResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CandidateListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CandidateResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CandidateRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "example: alex.morgan@example.com",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "example: Alex Morgan",
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "example: Referred by the platform team",
+                    "type": "string"
+                },
+                "phone": {
+                    "description": "E.164 format\nexample: +14155552671",
+                    "type": "string"
+                },
+                "source": {
+                    "description": "Where the candidate came from\nexample: referral",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CandidateResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2025-03-01 08:00:00",
+                    "type": "string"
+                },
+                "email": {
+                    "description": "example: alex.morgan@example.com",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "Employee created once the offer was accepted\nexample: 12",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: Alex Morgan",
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "example: Referred by the platform team",
+                    "type": "string"
+                },
+                "offer": {
+                    "$ref": "#/definitions/v1.OfferResponse"
+                },
+                "phone": {
+                    "description": "example: +14155552671",
+                    "type": "string"
+                },
+                "requisition_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "requisition_title": {
+                    "description": "example: Backend engineer",
+                    "type": "string"
+                },
+                "source": {
+                    "description": "example: referral",
+                    "type": "string"
+                },
+                "stage": {
+                    "description": "One of applied, screening, interview, offer, hired, rejected or withdrawn\nexample: interview",
+                    "type": "string"
+                },
+                "stage_changed_at": {
+                    "description": "example: 2025-03-04 10:00:00",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-03-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CandidateResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.CandidateResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CandidateStageRequest": {
+            "type": "object",
+            "properties": {
+                "stage": {
+                    "description": "One of screening, interview, rejected or withdrawn\nexample: screening",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CertificationListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CertificationResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CertificationRequest": {
+            "type": "object",
+            "properties": {
+                "credential_id": {
+                    "description": "example: NSC-48213",
+                    "type": "string"
+                },
+                "expires_on": {
+                    "description": "Leave empty for a certification that never expires\nexample: 2027-05-10",
+                    "type": "string"
+                },
+                "issued_on": {
+                    "description": "example: 2024-05-10",
+                    "type": "string"
+                },
+                "issuer": {
+                    "description": "example: National Safety Council",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "example: Certified Forklift Operator",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CertificationResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2025-03-01 08:00:00",
+                    "type": "string"
+                },
+                "credential_id": {
+                    "description": "example: NSC-48213",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "employee_name": {
+                    "description": "example: John Doe",
+                    "type": "string"
+                },
+                "enrollment_id": {
+                    "description": "Course enrollment that issued the certification\nexample: 1",
+                    "type": "integer"
+                },
+                "evidence_count": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "expired": {
+                    "description": "example: false",
+                    "type": "boolean"
+                },
+                "expires_on": {
+                    "description": "example: 2027-05-10",
+                    "type": "string"
+                },
+                "expiry_notified_on": {
+                    "description": "When the holder was notified of the upcoming expiry\nexample: 2027-04-10",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "issued_on": {
+                    "description": "example: 2024-05-10",
+                    "type": "string"
+                },
+                "issuer": {
+                    "description": "example: National Safety Council",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "example: Certified Forklift Operator",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-03-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CertificationResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.CertificationResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ChangeEmploymentStatusRequest": {
+            "type": "object",
+            "properties": {
+                "effective_date": {
+                    "description": "Date the new status takes effect (YYYY-MM-DD)\nexample: 2024-03-01",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "example: Pending investigation",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "One of candidate, active, on_leave, suspended\nexample: suspended",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ChecklistListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ChecklistResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ChecklistProgressResponse": {
+            "type": "object",
+            "properties": {
+                "completed_tasks": {
+                    "description": "example: 6",
+                    "type": "integer"
+                },
+                "overdue_tasks": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "percent_complete": {
+                    "description": "example: 75",
+                    "type": "number"
+                },
+                "total_tasks": {
+                    "description": "example: 8",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ChecklistResponse": {
+            "type": "object",
+            "properties": {
+                "completed_at": {
+                    "description": "Set once every task is done\nexample: 2025-03-14 17:00:00",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "example: 2025-02-20 10:00:00",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 12",
+                    "type": "integer"
+                },
+                "employee_name": {
+                    "description": "example: Jane Doe",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "kind": {
+                    "description": "example: onboarding",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "example: Engineering onboarding",
+                    "type": "string"
+                },
+                "progress": {
+                    "$ref": "#/definitions/v1.ChecklistProgressResponse"
+                },
+                "start_date": {
+                    "description": "Hire or termination date the due dates count from\nexample: 2025-03-01",
+                    "type": "string"
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ChecklistTaskResponse"
+                    }
+                },
+                "template_id": {
+                    "description": "Empty once the template is deleted\nexample: 1",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ChecklistResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.ChecklistResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ChecklistTaskListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ChecklistTaskResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ChecklistTaskResponse": {
+            "type": "object",
+            "properties": {
+                "checklist_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "completed_at": {
+                    "description": "example: 2025-02-25 16:30:00",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "example: Order the laptop and create email, chat and VPN accounts",
+                    "type": "string"
+                },
+                "due_on": {
+                    "description": "example: 2025-02-26",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "Employee joining or leaving\nexample: 12",
+                    "type": "integer"
+                },
+                "employee_name": {
+                    "description": "example: Jane Doe",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "kind": {
+                    "description": "example: onboarding",
+                    "type": "string"
+                },
+                "overdue": {
+                    "description": "Still open after the due date\nexample: false",
+                    "type": "boolean"
+                },
+                "owner_id": {
+                    "description": "Empty while unassigned\nexample: 7",
+                    "type": "integer"
+                },
+                "owner_name": {
+                    "description": "example: John Smith",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "example: Set up laptop and accounts",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ChecklistTaskResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.ChecklistTaskResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ChecklistTemplateListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ChecklistTemplateResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ChecklistTemplateRequest": {
+            "type": "object",
+            "properties": {
+                "department_id": {
+                    "description": "Limits the template to employees in the department\nexample: 1",
+                    "type": "integer"
+                },
+                "kind": {
+                    "description": "One of onboarding or offboarding\nexample: onboarding",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "example: Engineering onboarding",
+                    "type": "string"
+                },
+                "position_id": {
+                    "description": "Limits the template to employees in the position\nexample: 2",
+                    "type": "integer"
+                },
+                "tasks": {
+                    "description": "1 to 50 tasks, in the order they are listed on checklists",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ChecklistTemplateTaskRequest"
+                    }
+                }
+            }
+        },
+        "v1.ChecklistTemplateResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2025-01-10 09:00:00",
+                    "type": "string"
+                },
+                "department_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "kind": {
+                    "description": "example: onboarding",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "example: Engineering onboarding",
+                    "type": "string"
+                },
+                "position_id": {
+                    "description": "example: 2",
+                    "type": "integer"
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ChecklistTemplateTaskResponse"
+                    }
+                },
+                "updated_at": {
+                    "description": "example: 2025-01-10 09:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ChecklistTemplateResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.ChecklistTemplateResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ChecklistTemplateTaskRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "example: Order the laptop and create email, chat and VPN accounts",
+                    "type": "string"
+                },
+                "due_days": {
+                    "description": "Days from the hire or termination date the task is due, -365 to 365\nexample: -3",
+                    "type": "integer"
+                },
+                "owner_id": {
+                    "description": "Owner of designated tasks, empty otherwise\nexample: 7",
+                    "type": "integer"
+                },
+                "owner_role": {
+                    "description": "One of employee (the person joining or leaving), manager (their manager) or designated\nexample: designated",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "example: Set up laptop and accounts",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ChecklistTemplateTaskResponse": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "example: Order the laptop and create email, chat and VPN accounts",
+                    "type": "string"
+                },
+                "due_days": {
+                    "description": "example: -3",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "owner_id": {
+                    "description": "example: 7",
+                    "type": "integer"
+                },
+                "owner_role": {
+                    "description": "example: designated",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "example: Set up laptop and accounts",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CompensationChangeResponse": {
+            "type": "object",
+            "properties": {
+                "applied_at": {
+                    "description": "example: 2025-04-01 00:00:05",
+                    "type": "string"
+                },
+                "approved_by": {
+                    "description": "example: Jane Smith",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "example: 2025-03-10 09:00:00",
+                    "type": "string"
+                },
+                "effective_date": {
+                    "description": "example: 2025-04-01",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "new_salary": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "old_salary": {
+                    "description": "Empty for the initial salary",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.MoneyDTO"
+                        }
+                    ]
+                },
+                "reason": {
+                    "description": "example: Annual raise",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "One of scheduled, applied, cancelled\nexample: scheduled",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CompensationChangeResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.CompensationChangeResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CompensationHistoryResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CompensationChangeResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CreateEmployeeRequest": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "description": "Postal address",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.AddressDTO"
+                        }
+                    ]
+                },
+                "custom_fields": {
+                    "description": "Values of custom fields by key, required ones included. Dates are YYYY-MM-DD, multi_select\nvalues are arrays of options.",
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "date_of_birth": {
+                    "description": "Date of birth (YYYY-MM-DD)\nexample: 1990-05-20",
+                    "type": "string"
+                },
+                "department_id": {
+                    "description": "example: 3",
+                    "type": "integer"
+                },
+                "hired_date": {
+                    "description": "Date when the employee was hired (YYYY-MM-DD)\nexample: 2024-01-15",
+                    "type": "string"
+                },
+                "location_id": {
+                    "description": "ID of the office location whose holiday calendar applies to the employee\nexample: 2",
+                    "type": "integer"
+                },
+                "manager_id": {
+                    "description": "ID of the employee this employee reports to\nexample: 7",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Employee full name\nexample: John Doe",
+                    "type": "string"
+                },
+                "pay_period": {
+                    "description": "One of monthly, annual, hourly (default monthly)\nexample: monthly",
+                    "type": "string"
+                },
+                "personal_email": {
+                    "description": "Personal email, unique across employees\nexample: john.doe@gmail.com",
+                    "type": "string"
+                },
+                "phone": {
+                    "description": "Phone number in E.164 format\nexample: +14155552671",
+                    "type": "string"
+                },
+                "position_id": {
+                    "description": "ID of the position in the positions catalog\nexample: 1",
+                    "type": "integer"
+                },
+                "salary": {
+                    "description": "Salary of the employee per pay period, in minor currency units",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.MoneyDTO"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Initial employment status: candidate or active (default active)\nexample: active",
+                    "type": "string"
+                },
+                "work_email": {
+                    "description": "Work email, unique across employees\nexample: john.doe@company.com",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CreateEmployeeResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "$ref": "#/definitions/v1.AddressDTO"
+                },
+                "created_at": {
+                    "description": "example: 2024-01-15T10:30:00Z",
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "description": "Values of custom fields by key",
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "date_of_birth": {
+                    "description": "example: 1990-05-20",
+                    "type": "string"
+                },
+                "department_id": {
+                    "description": "example: 3",
+                    "type": "integer"
+                },
+                "hired_date": {
+                    "description": "example: 2024-01-15",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Employee ID generated by the system\nexample: 1",
+                    "type": "integer"
+                },
+                "location_id": {
+                    "description": "example: 2",
+                    "type": "integer"
+                },
+                "manager_id": {
+                    "description": "example: 7",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: John Doe",
+                    "type": "string"
+                },
+                "pay_period": {
+                    "description": "example: monthly",
+                    "type": "string"
+                },
+                "personal_email": {
+                    "description": "example: john.doe@gmail.com",
+                    "type": "string"
+                },
+                "phone": {
+                    "description": "example: +14155552671",
+                    "type": "string"
+                },
+                "position": {
+                    "description": "example: Software Engineer",
+                    "type": "string"
+                },
+                "position_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "salary": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "salary_out_of_band": {
+                    "description": "True when the salary was saved outside the position's band\nexample: false",
+                    "type": "boolean"
+                },
+                "status": {
+                    "description": "example: active",
+                    "type": "string"
+                },
+                "tags": {
+                    "description": "example: [\"new hire\",\"remote\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "termination_date": {
+                    "description": "example: 2025-06-30",
+                    "type": "string"
+                },
+                "termination_reason": {
+                    "description": "example: Resigned",
+                    "type": "string"
+                },
+                "work_email": {
+                    "description": "example: john.doe@company.com",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CreateEmployeeResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.CreateEmployeeResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CreateExchangeRateRequest": {
+            "type": "object",
+            "properties": {
+                "base_currency": {
+                    "description": "example: USD",
+                    "type": "string"
+                },
+                "effective_date": {
+                    "description": "example: 2025-01-01",
+                    "type": "string"
+                },
+                "quote_currency": {
+                    "description": "example: EUR",
+                    "type": "string"
+                },
+                "rate": {
+                    "description": "Units of the quote currency worth one unit of the base currency\nexample: 0.92",
+                    "type": "number"
+                }
+            }
+        },
+        "v1.CreateLeaveRequestRequest": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "description": "Last day of leave (YYYY-MM-DD), inclusive\nexample: 2025-08-08",
+                    "type": "string"
+                },
+                "leave_type_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "reason": {
+                    "description": "example: Family holiday",
+                    "type": "string"
+                },
+                "start_date": {
+                    "description": "First day of leave (YYYY-MM-DD)\nexample: 2025-08-04",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CustomFieldListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CustomFieldResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CustomFieldRequest": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "description": "Key employees' values are saved under, in snake_case\nexample: t_shirt_size",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "example: T-shirt size",
+                    "type": "string"
+                },
+                "options": {
+                    "description": "Values a select or multi_select field may take\nexample: [\"S\",\"M\",\"L\",\"XL\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "required": {
+                    "description": "example: false",
+                    "type": "boolean"
+                },
+                "type": {
+                    "description": "One of text, number, boolean, date, select, multi_select\nexample: select",
+                    "type": "string"
+                },
+                "validation": {
+                    "$ref": "#/definitions/v1.CustomFieldValidationDTO"
+                }
+            }
+        },
+        "v1.CustomFieldResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2025-01-06 09:00:00",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "key": {
+                    "description": "example: t_shirt_size",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "example: T-shirt size",
+                    "type": "string"
+                },
+                "options": {
+                    "description": "example: [\"S\",\"M\",\"L\",\"XL\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "required": {
+                    "description": "example: false",
+                    "type": "boolean"
+                },
+                "type": {
+                    "description": "example: select",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-01-06 09:00:00",
+                    "type": "string"
+                },
+                "validation": {
+                    "$ref": "#/definitions/v1.CustomFieldValidationDTO"
+                }
+            }
+        },
+        "v1.CustomFieldResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.CustomFieldResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CustomFieldValidationDTO": {
+            "type": "object",
+            "properties": {
+                "max": {
+                    "description": "Largest number allowed\nexample: 100",
+                    "type": "number"
+                },
+                "max_length": {
+                    "description": "Longest text allowed\nexample: 40",
+                    "type": "integer"
+                },
+                "min": {
+                    "description": "Smallest number allowed\nexample: 0",
+                    "type": "number"
+                },
+                "min_length": {
+                    "description": "Shortest text allowed\nexample: 2",
+                    "type": "integer"
+                },
+                "pattern": {
+                    "description": "Regular expression the whole text must match\nexample: ^[A-Z]{2}[0-9]{4}$",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.DepartmentCalibrationResponse": {
+            "type": "object",
+            "properties": {
+                "average_manager_rating": {
+                    "description": "example: 2.1",
+                    "type": "number"
+                },
+                "average_peer_rating": {
+                    "description": "example: 2.2",
+                    "type": "number"
+                },
+                "average_self_rating": {
+                    "description": "example: 2.4",
+                    "type": "number"
+                },
+                "completed": {
+                    "description": "Completed or locked reviews\nexample: 10",
+                    "type": "integer"
+                },
+                "department_id": {
+                    "description": "Empty for employees without a department\nexample: 1",
+                    "type": "integer"
+                },
+                "department_name": {
+                    "description": "example: Engineering",
+                    "type": "string"
+                },
+                "distribution": {
+                    "description": "Completed reviews per manager rating, from rating 1 up\nexample: [1,7,2]",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "reviews": {
+                    "description": "example: 12",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.DepartmentListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.DepartmentResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.DepartmentRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "example: Engineering",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.DepartmentResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: Engineering",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.DepartmentResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.DepartmentResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.DependentListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.DependentResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.DependentRequest": {
+            "type": "object",
+            "properties": {
+                "date_of_birth": {
+                    "description": "example: 1990-04-12",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "example: Jane Doe",
+                    "type": "string"
+                },
+                "relationship": {
+                    "description": "One of spouse, partner, child, other\nexample: spouse",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.DependentResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2025-01-06 09:00:00",
+                    "type": "string"
+                },
+                "date_of_birth": {
+                    "description": "example: 1990-04-12",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: Jane Doe",
+                    "type": "string"
+                },
+                "relationship": {
+                    "description": "example: spouse",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-01-06 09:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.DependentResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.DependentResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.DocumentListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.DocumentResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.DocumentResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "One of contract, identity, offer_letter or other\nexample: contract",
+                    "type": "string"
+                },
+                "checksum_sha256": {
+                    "description": "Hex SHA-256 of the contents\nexample: 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+                    "type": "string"
+                },
+                "content_type": {
+                    "description": "Detected from the contents\nexample: application/pdf",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "file_name": {
+                    "description": "example: employment-contract.pdf",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "size": {
+                    "description": "In bytes\nexample: 245760",
+                    "type": "integer"
+                },
+                "uploaded_at": {
+                    "description": "example: 2025-03-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.DocumentResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.DocumentResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EmergencyContactListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.EmergencyContactResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EmergencyContactRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "example: jane.doe@gmail.com",
+                    "type": "string"
+                },
+                "is_primary": {
+                    "description": "Marks this contact as the primary one; other contacts lose the flag\nexample: true",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "example: Jane Doe",
+                    "type": "string"
+                },
+                "phone": {
+                    "description": "Phone number in E.164 format\nexample: +14155552672",
+                    "type": "string"
+                },
+                "relationship": {
+                    "description": "example: Spouse",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EmergencyContactResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2024-01-15 10:30:00",
+                    "type": "string"
+                },
+                "email": {
+                    "description": "example: jane.doe@gmail.com",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "is_primary": {
+                    "description": "example: true",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "example: Jane Doe",
+                    "type": "string"
+                },
+                "phone": {
+                    "description": "example: +14155552672",
+                    "type": "string"
+                },
+                "relationship": {
+                    "description": "example: Spouse",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2024-01-15 10:30:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EmergencyContactResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.EmergencyContactResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EmployeeSkillListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.EmployeeSkillResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EmployeeSkillRequest": {
+            "type": "object",
+            "properties": {
+                "last_verified_on": {
+                    "description": "When the level was last confirmed; empty when it never was\nexample: 2025-03-01",
+                    "type": "string"
+                },
+                "level": {
+                    "description": "From 1 (novice) to 5 (expert)\nexample: 4",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.EmployeeSkillResponse": {
+            "type": "object",
+            "properties": {
+                "category_name": {
+                    "description": "example: Programming languages",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "last_verified_on": {
+                    "description": "example: 2025-03-01",
+                    "type": "string"
+                },
+                "level": {
+                    "description": "example: 4",
+                    "type": "integer"
+                },
+                "level_name": {
+                    "description": "One of novice, beginner, intermediate, advanced or expert\nexample: advanced",
+                    "type": "string"
+                },
+                "skill_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "skill_name": {
+                    "description": "example: Go",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-03-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EmployeeSkillResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.EmployeeSkillResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EmploymentStatusChangeResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2024-06-01 10:30:00",
+                    "type": "string"
+                },
+                "effective_date": {
+                    "description": "example: 2024-06-30",
+                    "type": "string"
+                },
+                "from_status": {
+                    "description": "Empty for the first entry\nexample: active",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "reason": {
+                    "description": "example: Resigned",
+                    "type": "string"
+                },
+                "to_status": {
+                    "description": "example: terminated",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EmploymentStatusHistoryResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.EmploymentStatusChangeResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EnrollmentCompletionRequest": {
+            "type": "object",
+            "properties": {
+                "completed_on": {
+                    "description": "Defaults to today\nexample: 2025-04-15",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EnrollmentListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.EnrollmentResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EnrollmentRequest": {
+            "type": "object",
+            "properties": {
+                "due_on": {
+                    "description": "example: 2025-04-30",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "enrolled_on": {
+                    "description": "Defaults to today\nexample: 2025-03-01",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EnrollmentResponse": {
+            "type": "object",
+            "properties": {
+                "certification_id": {
+                    "description": "Certification issued on completion\nexample: 1",
+                    "type": "integer"
+                },
+                "completed_on": {
+                    "description": "example: 2025-04-15",
+                    "type": "string"
+                },
+                "course_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "course_name": {
+                    "description": "example: Forklift safety",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "example: 2025-03-01 08:00:00",
+                    "type": "string"
+                },
+                "due_on": {
+                    "description": "example: 2025-04-30",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "employee_name": {
+                    "description": "example: John Doe",
+                    "type": "string"
+                },
+                "enrolled_on": {
+                    "description": "example: 2025-03-01",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "overdue": {
+                    "description": "Still open after the due date\nexample: false",
+                    "type": "boolean"
+                },
+                "status": {
+                    "description": "One of enrolled, completed or cancelled\nexample: enrolled",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-03-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EnrollmentResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.EnrollmentResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EnrollmentWindowListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.EnrollmentWindowResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EnrollmentWindowRequest": {
+            "type": "object",
+            "properties": {
+                "coverage_starts_on": {
+                    "description": "example: 2026-01-01",
+                    "type": "string"
+                },
+                "ends_on": {
+                    "description": "example: 2025-11-30",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "example: Open enrollment 2026",
+                    "type": "string"
+                },
+                "starts_on": {
+                    "description": "example: 2025-11-01",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EnrollmentWindowResponse": {
+            "type": "object",
+            "properties": {
+                "coverage_starts_on": {
+                    "description": "example: 2026-01-01",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "example: 2025-10-01 09:00:00",
+                    "type": "string"
+                },
+                "ends_on": {
+                    "description": "example: 2025-11-30",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: Open enrollment 2026",
+                    "type": "string"
+                },
+                "starts_on": {
+                    "description": "example: 2025-11-01",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EnrollmentWindowResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.EnrollmentWindowResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EvidenceListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.EvidenceResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EvidenceResponse": {
+            "type": "object",
+            "properties": {
+                "certification_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "content_type": {
+                    "description": "One of application/pdf, image/png or image/jpeg\nexample: application/pdf",
+                    "type": "string"
+                },
+                "file_name": {
+                    "description": "example: forklift-certificate.pdf",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "size": {
+                    "description": "In bytes\nexample: 184320",
+                    "type": "integer"
+                },
+                "uploaded_at": {
+                    "description": "example: 2025-03-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EvidenceResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.EvidenceResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExchangeRateListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ExchangeRateResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExchangeRateResponse": {
+            "type": "object",
+            "properties": {
+                "base_currency": {
+                    "description": "example: USD",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                },
+                "effective_date": {
+                    "description": "example: 2025-01-01",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "quote_currency": {
+                    "description": "example: EUR",
+                    "type": "string"
+                },
+                "rate": {
+                    "description": "example: 0.92",
+                    "type": "number"
+                }
+            }
+        },
+        "v1.ExchangeRateResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.ExchangeRateResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExpenseCategoryListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ExpenseCategoryResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExpenseCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "example: Flights, trains and taxis",
+                    "type": "string"
+                },
+                "limit": {
+                    "description": "Most a single claim may hold of the category; omit for no limit",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.MoneyDTO"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "example: Travel",
+                    "type": "string"
+                },
+                "receipt_required": {
+                    "description": "Whether claims with expenses of the category need a receipt; defaults to true\nexample: true",
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1.ExpenseCategoryResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2025-01-06 09:00:00",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "example: Flights, trains and taxis",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "limit": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "name": {
+                    "description": "example: Travel",
+                    "type": "string"
+                },
+                "receipt_required": {
+                    "description": "example: true",
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "description": "example: 2025-01-06 09:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExpenseCategoryResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.ExpenseCategoryResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExpenseClaimListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ExpenseClaimResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExpenseClaimRequest": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "description": "Currency of all items\nexample: EUR",
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ExpenseItemRequest"
+                    }
+                },
+                "title": {
+                    "description": "example: Customer visit in Berlin",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExpenseClaimResponse": {
+            "type": "object",
+            "properties": {
+                "approval_request_id": {
+                    "description": "Approval request deciding the claim, when an approval workflow decides it\nexample: 12",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "description": "example: 2025-03-13 18:00:00",
+                    "type": "string"
+                },
+                "decided_at": {
+                    "description": "example: 2025-03-15 09:00:00",
+                    "type": "string"
+                },
+                "decided_by": {
+                    "description": "example: 7",
+                    "type": "integer"
+                },
+                "decision_comment": {
+                    "description": "example: Fine, thanks",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "employee_name": {
+                    "description": "example: John Doe",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ExpenseItemResponse"
+                    }
+                },
+                "payroll_run_id": {
+                    "description": "example: 3",
+                    "type": "integer"
+                },
+                "receipt_count": {
+                    "description": "example: 2",
+                    "type": "integer"
+                },
+                "reimbursed_on": {
+                    "description": "example: 2025-03-31",
+                    "type": "string"
+                },
+                "reimbursement_reference": {
+                    "description": "example: TRX-2025-0331-17",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "One of draft, submitted, approved, rejected, reimbursed\nexample: approved",
+                    "type": "string"
+                },
+                "submitted_at": {
+                    "description": "example: 2025-03-14 10:00:00",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "example: Customer visit in Berlin",
+                    "type": "string"
+                },
+                "total": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "updated_at": {
+                    "description": "example: 2025-03-15 09:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExpenseClaimResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.ExpenseClaimResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExpenseDecisionRequest": {
+            "type": "object",
+            "properties": {
+                "approver_id": {
+                    "description": "ID of the deciding employee; must be the claimant's manager when one is set\nexample: 7",
+                    "type": "integer"
+                },
+                "comment": {
+                    "description": "example: Fine, thanks",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExpenseItemRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Amount in minor units of the claim's currency\nexample: 4550",
+                    "type": "integer"
+                },
+                "category_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "description": {
+                    "description": "example: Taxi to the airport",
+                    "type": "string"
+                },
+                "expense_date": {
+                    "description": "example: 2025-03-12",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExpenseItemResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "category_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "category_name": {
+                    "description": "example: Travel",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "example: Taxi to the airport",
+                    "type": "string"
+                },
+                "expense_date": {
+                    "description": "example: 2025-03-12",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ExpenseReceiptListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ExpenseReceiptResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExpenseReceiptResponse": {
+            "type": "object",
+            "properties": {
+                "checksum": {
+                    "description": "Hex SHA-256 of the contents\nexample: 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+                    "type": "string"
+                },
+                "claim_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "content_type": {
+                    "description": "example: application/pdf",
+                    "type": "string"
+                },
+                "file_name": {
+                    "description": "example: taxi.pdf",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "size": {
+                    "description": "example: 48213",
+                    "type": "integer"
+                },
+                "uploaded_at": {
+                    "description": "example: 2025-03-13 18:05:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExpenseReceiptResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.ExpenseReceiptResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExpenseReimbursementRequest": {
+            "type": "object",
+            "properties": {
+                "payroll_run_id": {
+                    "description": "Payroll run paying the claim back, if any\nexample: 3",
+                    "type": "integer"
+                },
+                "reference": {
+                    "description": "example: TRX-2025-0331-17",
+                    "type": "string"
+                },
+                "reimbursed_on": {
+                    "description": "Defaults to today\nexample: 2025-03-31",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExpenseReportResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ExpenseReportRowResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExpenseReportRowResponse": {
+            "type": "object",
+            "properties": {
+                "claim_count": {
+                    "description": "example: 4",
+                    "type": "integer"
+                },
+                "department_id": {
+                    "description": "Empty for employees without a department\nexample: 2",
+                    "type": "integer"
+                },
+                "department_name": {
+                    "description": "example: Sales",
+                    "type": "string"
+                },
+                "period": {
+                    "description": "Month, as its first day\nexample: 2025-03-01",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Total in the reporting currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.MoneyDTO"
+                        }
+                    ]
+                }
+            }
+        },
+        "v1.GetAllEmployeesResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "$ref": "#/definitions/v1.AddressDTO"
+                },
+                "created_at": {
+                    "description": "example: 2024-01-15T10:30:00Z",
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "description": "Values of custom fields by key",
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "date_of_birth": {
+                    "description": "example: 1990-05-20",
+                    "type": "string"
+                },
+                "department_id": {
+                    "description": "example: 3",
+                    "type": "integer"
+                },
+                "hired_date": {
+                    "description": "example: 2024-01-15",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "location_id": {
+                    "description": "example: 2",
+                    "type": "integer"
+                },
+                "manager_id": {
+                    "description": "example: 7",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: John Doe",
+                    "type": "string"
+                },
+                "pay_period": {
+                    "description": "example: monthly",
+                    "type": "string"
+                },
+                "personal_email": {
+                    "description": "example: john.doe@gmail.com",
+                    "type": "string"
+                },
+                "phone": {
+                    "description": "example: +14155552671",
+                    "type": "string"
+                },
+                "position": {
+                    "description": "example: Software Engineer",
+                    "type": "string"
+                },
+                "position_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "salary": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "salary_out_of_band": {
+                    "description": "True when the salary was saved outside the position's band\nexample: false",
+                    "type": "boolean"
+                },
+                "status": {
+                    "description": "example: active",
+                    "type": "string"
+                },
+                "tags": {
+                    "description": "example: [\"new hire\",\"remote\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "termination_date": {
+                    "description": "example: 2025-06-30",
+                    "type": "string"
+                },
+                "termination_reason": {
+                    "description": "example: Resigned",
+                    "type": "string"
+                },
+                "work_email": {
+                    "description": "example: john.doe@company.com",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.GetAllEmployeesResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.GetAllEmployeesResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.GetEmployeeByIdResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "$ref": "#/definitions/v1.AddressDTO"
+                },
+                "created_at": {
+                    "description": "example: 2024-01-15T10:30:00Z",
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "description": "Values of custom fields by key",
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "date_of_birth": {
+                    "description": "example: 1990-05-20",
+                    "type": "string"
+                },
+                "department_id": {
+                    "description": "example: 3",
+                    "type": "integer"
+                },
+                "hired_date": {
+                    "description": "example: 2024-01-15",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "location_id": {
+                    "description": "example: 2",
+                    "type": "integer"
+                },
+                "manager_id": {
+                    "description": "example: 7",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: John Doe",
+                    "type": "string"
+                },
+                "pay_period": {
+                    "description": "example: monthly",
+                    "type": "string"
+                },
+                "personal_email": {
+                    "description": "example: john.doe@gmail.com",
+                    "type": "string"
+                },
+                "phone": {
+                    "description": "example: +14155552671",
+                    "type": "string"
+                },
+                "position": {
+                    "description": "example: Software Engineer",
+                    "type": "string"
+                },
+                "position_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "salary": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "salary_out_of_band": {
+                    "description": "True when the salary was saved outside the position's band\nexample: false",
+                    "type": "boolean"
+                },
+                "status": {
+                    "description": "example: active",
+                    "type": "string"
+                },
+                "tags": {
+                    "description": "example: [\"new hire\",\"remote\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "termination_date": {
+                    "description": "example: 2025-06-30",
+                    "type": "string"
+                },
+                "termination_reason": {
+                    "description": "example: Resigned",
+                    "type": "string"
+                },
+                "unreturned_assets": {
+                    "description": "Company assets the employee still holds, only returned on termination\nexample: 2",
+                    "type": "integer"
+                },
+                "work_email": {
+                    "description": "example: john.doe@company.com",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.GetEmployeeByIdResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.GetEmployeeByIdResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.GoalCheckInListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.GoalCheckInResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.GoalCheckInRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "example: Two deals signed this week",
+                    "type": "string"
+                },
+                "key_result_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "value": {
+                    "description": "New current value of the key result\nexample: 9",
+                    "type": "number"
+                }
+            }
+        },
+        "v1.GoalCheckInResponse": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "example: Two deals signed this week",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "example: 2025-03-01 08:00:00",
+                    "type": "string"
+                },
+                "goal_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "key_result_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "key_result_title": {
+                    "description": "example: Close enterprise deals",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "example: 9",
+                    "type": "number"
+                }
+            }
+        },
+        "v1.GoalCheckInResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.GoalCheckInResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.GoalListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.GoalResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.GoalRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "example: Expand into the enterprise segment this half",
+                    "type": "string"
+                },
+                "due_date": {
+                    "description": "example: 2025-06-30",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "Owner of the goal; empty for a company goal. Ignored on update.\nexample: 1",
+                    "type": "integer"
+                },
+                "key_results": {
+                    "description": "1 to 10 key results; required on create, ignored on update",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.KeyResultRequest"
+                    }
+                },
+                "parent_id": {
+                    "description": "Company goal, or goal of the owner's manager, this goal contributes to\nexample: 3",
+                    "type": "integer"
+                },
+                "start_date": {
+                    "description": "example: 2025-01-01",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "One of active, completed or cancelled; defaults to active\nexample: active",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "example: Grow enterprise revenue",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.GoalResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "example: Expand into the enterprise segment this half",
+                    "type": "string"
+                },
+                "due_date": {
+                    "description": "example: 2025-06-30",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "Empty for a company goal\nexample: 1",
+                    "type": "integer"
+                },
+                "employee_name": {
+                    "description": "example: John Doe",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "key_results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.KeyResultResponse"
+                    }
+                },
+                "parent_id": {
+                    "description": "example: 3",
+                    "type": "integer"
+                },
+                "progress": {
+                    "description": "100 once completed, otherwise the average progress of the key results\nexample: 75",
+                    "type": "number"
+                },
+                "start_date": {
+                    "description": "example: 2025-01-01",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "example: active",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "example: Grow enterprise revenue",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-03-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.GoalResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.GoalResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.GoalRollupResponse": {
+            "type": "object",
+            "properties": {
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "employee_name": {
+                    "description": "example: Jane Smith",
+                    "type": "string"
+                },
+                "goals": {
+                    "description": "The employee's own goals, excluding cancelled ones\nexample: 2",
+                    "type": "integer"
+                },
+                "progress": {
+                    "description": "Average progress of the employee's own goals\nexample: 62.5",
+                    "type": "number"
+                },
+                "reports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.GoalRollupResponse"
+                    }
+                },
+                "team_goals": {
+                    "description": "Goals of the employee and everyone reporting to them\nexample: 9",
+                    "type": "integer"
+                },
+                "team_progress": {
+                    "description": "Average progress of the team's goals\nexample: 48.33",
+                    "type": "number"
+                }
+            }
+        },
+        "v1.GoalRollupResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.GoalRollupResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.HolidayCalendarListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.HolidayCalendarResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.HolidayCalendarRequest": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "description": "ISO 3166-1 alpha-2 country code, optional\nexample: IN",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "example: India public holidays",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.HolidayCalendarResponse": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "description": "example: IN",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: India public holidays",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.HolidayCalendarResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.HolidayCalendarResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.HolidayImportResponse": {
+            "type": "object",
+            "properties": {
+                "imported": {
+                    "description": "Number of holidays added or renamed\nexample: 14",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.HolidayImportResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.HolidayImportResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.HolidayListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.HolidayResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.HolidayRequest": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "description": "example: 2025-08-15",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "example: Independence Day",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.HolidayResponse": {
+            "type": "object",
+            "properties": {
+                "calendar_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "date": {
+                    "description": "example: 2025-08-15",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: Independence Day",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.HolidayResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.HolidayResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.InterviewFeedbackRequest": {
+            "type": "object",
+            "properties": {
+                "feedback": {
+                    "description": "example: Solid system design, light on testing",
+                    "type": "string"
+                },
+                "rating": {
+                    "description": "1 to 5\nexample: 4",
+                    "type": "integer"
+                },
+                "recommendation": {
+                    "description": "One of strong_yes, yes, no or strong_no\nexample: yes",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.InterviewListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.InterviewResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.InterviewRequest": {
+            "type": "object",
+            "properties": {
+                "duration_minutes": {
+                    "description": "15 to 480 minutes (default 60)\nexample: 45",
+                    "type": "integer"
+                },
+                "interviewer_id": {
+                    "description": "example: 7",
+                    "type": "integer"
+                },
+                "scheduled_at": {
+                    "description": "example: 2025-03-10 14:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.InterviewResponse": {
+            "type": "object",
+            "properties": {
+                "candidate_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "candidate_name": {
+                    "description": "example: Alex Morgan",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "example: 2025-03-01 08:00:00",
+                    "type": "string"
+                },
+                "duration_minutes": {
+                    "description": "example: 45",
+                    "type": "integer"
+                },
+                "feedback": {
+                    "description": "example: Solid system design, light on testing",
+                    "type": "string"
+                },
+                "feedback_at": {
+                    "description": "example: 2025-03-10 15:10:00",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "interviewer_id": {
+                    "description": "example: 7",
+                    "type": "integer"
+                },
+                "interviewer_name": {
+                    "description": "example: Jane Smith",
+                    "type": "string"
+                },
+                "rating": {
+                    "description": "example: 4",
+                    "type": "integer"
+                },
+                "recommendation": {
+                    "description": "example: yes",
+                    "type": "string"
+                },
+                "requisition_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "scheduled_at": {
+                    "description": "example: 2025-03-10 14:00:00",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "One of scheduled, completed or cancelled\nexample: completed",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-03-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.InterviewResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.InterviewResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.KeyResultRequest": {
+            "type": "object",
+            "properties": {
+                "start_value": {
+                    "description": "example: 0",
+                    "type": "number"
+                },
+                "target_value": {
+                    "description": "May be below the start value, e.g. for reducing costs\nexample: 12",
+                    "type": "number"
+                },
+                "title": {
+                    "description": "example: Close enterprise deals",
+                    "type": "string"
+                },
+                "unit": {
+                    "description": "example: deals",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.KeyResultResponse": {
+            "type": "object",
+            "properties": {
+                "current_value": {
+                    "description": "example: 9",
+                    "type": "number"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "progress": {
+                    "description": "Percentage from start to target value\nexample: 75",
+                    "type": "number"
+                },
+                "start_value": {
+                    "description": "example: 0",
+                    "type": "number"
+                },
+                "target_value": {
+                    "description": "example: 12",
+                    "type": "number"
+                },
+                "title": {
+                    "description": "example: Close enterprise deals",
+                    "type": "string"
+                },
+                "unit": {
+                    "description": "example: deals",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LeaveBalanceListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.LeaveBalanceResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LeaveBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "accrued_days": {
+                    "description": "example: 12",
+                    "type": "number"
+                },
+                "carried_over_days": {
+                    "description": "example: 3",
+                    "type": "number"
+                },
+                "leave_type_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "remaining_days": {
+                    "description": "example: 10",
+                    "type": "number"
+                },
+                "updated_at": {
+                    "description": "example: 2025-06-30 08:00:00",
+                    "type": "string"
+                },
+                "used_days": {
+                    "description": "example: 5",
+                    "type": "number"
+                },
+                "year": {
+                    "description": "example: 2025",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.LeaveDecisionRequest": {
+            "type": "object",
+            "properties": {
+                "approver_id": {
+                    "description": "ID of the deciding employee; must be the requester's manager when one is set\nexample: 7",
+                    "type": "integer"
+                },
+                "comment": {
+                    "description": "example: Enjoy your time off",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LeaveRequestListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.LeaveRequestResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LeaveRequestResponse": {
+            "type": "object",
+            "properties": {
+                "approval_request_id": {
+                    "description": "Approval request deciding the leave, when an approval workflow decides it\nexample: 12",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "description": "example: 2025-06-30 08:00:00",
+                    "type": "string"
+                },
+                "days": {
+                    "description": "Working days deducted from the balance\nexample: 5",
+                    "type": "number"
+                },
+                "decided_at": {
+                    "description": "example: 2025-07-01 09:00:00",
+                    "type": "string"
+                },
+                "decided_by": {
+                    "description": "example: 7",
+                    "type": "integer"
+                },
+                "decision_comment": {
+                    "description": "example: Enjoy your time off",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "end_date": {
+                    "description": "example: 2025-08-08",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "leave_type_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "reason": {
+                    "description": "example: Family holiday",
+                    "type": "string"
+                },
+                "start_date": {
+                    "description": "example: 2025-08-04",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "One of pending, approved, rejected, cancelled\nexample: approved",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LeaveRequestResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.LeaveRequestResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LeaveTypeListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.LeaveTypeResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LeaveTypeRequest": {
+            "type": "object",
+            "properties": {
+                "accrual_policy": {
+                    "description": "One of none (no balance kept), annual or monthly\nexample: monthly",
+                    "type": "string"
+                },
+                "days_per_year": {
+                    "description": "Days granted over a full year of service\nexample: 24",
+                    "type": "number"
+                },
+                "is_paid": {
+                    "description": "Defaults to true\nexample: true",
+                    "type": "boolean"
+                },
+                "max_carry_over_days": {
+                    "description": "Unused days that move to the next year\nexample: 5",
+                    "type": "number"
+                },
+                "name": {
+                    "description": "example: Annual leave",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LeaveTypeResponse": {
+            "type": "object",
+            "properties": {
+                "accrual_policy": {
+                    "description": "example: monthly",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                },
+                "days_per_year": {
+                    "description": "example: 24",
+                    "type": "number"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "is_paid": {
+                    "description": "example: true",
+                    "type": "boolean"
+                },
+                "max_carry_over_days": {
+                    "description": "example: 5",
+                    "type": "number"
+                },
+                "name": {
+                    "description": "example: Annual leave",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LeaveTypeResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.LeaveTypeResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LocationAssignmentRequest": {
+            "type": "object",
+            "properties": {
+                "effective_date": {
+                    "description": "Date the employee moves (YYYY-MM-DD) at the new location; future dates are applied by a\nbackground job\nexample: 2025-04-01",
+                    "type": "string"
+                },
+                "location_id": {
+                    "description": "example: 2",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.LocationAssignmentResponse": {
+            "type": "object",
+            "properties": {
+                "applied_at": {
+                    "description": "example: 2025-04-01 00:00:05",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "example: 2025-03-10 09:00:00",
+                    "type": "string"
+                },
+                "effective_date": {
+                    "description": "example: 2025-04-01",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "location_id": {
+                    "description": "example: 2",
+                    "type": "integer"
+                },
+                "location_name": {
+                    "description": "example: Bengaluru office",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "One of scheduled, applied, cancelled\nexample: scheduled",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LocationAssignmentResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.LocationAssignmentResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LocationHistoryResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.LocationAssignmentResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LocationListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.LocationResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LocationRequest": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "description": "Office address, omit for remote locations",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.AddressDTO"
+                        }
+                    ]
+                },
+                "holiday_calendar_id": {
+                    "description": "Holiday calendar observed at the location, omit for weekends only\nexample: 1",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: Bengaluru office",
+                    "type": "string"
+                },
+                "remote": {
+                    "description": "Marks a location grouping employees working remotely\nexample: false",
+                    "type": "boolean"
+                },
+                "time_zone": {
+                    "description": "IANA time zone used for dates at the location, defaults to UTC\nexample: Asia/Kolkata",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LocationResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "$ref": "#/definitions/v1.AddressDTO"
+                },
+                "created_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                },
+                "holiday_calendar_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: Bengaluru office",
+                    "type": "string"
+                },
+                "remote": {
+                    "description": "example: false",
+                    "type": "boolean"
+                },
+                "time_zone": {
+                    "description": "example: Asia/Kolkata",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LocationResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.LocationResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.MoneyDTO": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "example: 6000000",
+                    "type": "integer"
+                },
+                "currency": {
+                    "description": "example: USD",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.OfferAcceptanceRequest": {
+            "type": "object",
+            "properties": {
+                "custom_fields": {
+                    "description": "Values of the new employee's custom fields by key, required ones included",
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "manager_id": {
+                    "description": "Defaults to the requisition's hiring manager\nexample: 7",
+                    "type": "integer"
+                },
+                "work_email": {
+                    "description": "example: alex.morgan@company.com",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.OfferRequest": {
+            "type": "object",
+            "properties": {
+                "pay_period": {
+                    "description": "One of monthly, annual, hourly (default monthly)\nexample: monthly",
+                    "type": "string"
+                },
+                "salary": {
+                    "description": "Salary per pay period, in minor currency units",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.MoneyDTO"
+                        }
+                    ]
+                },
+                "start_date": {
+                    "description": "example: 2025-05-01",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.OfferResponse": {
+            "type": "object",
+            "properties": {
+                "pay_period": {
+                    "description": "example: monthly",
+                    "type": "string"
+                },
+                "salary": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "start_date": {
+                    "description": "example: 2025-05-01",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.OutOfBandEmployeeListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.OutOfBandEmployeeResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.OutOfBandEmployeeResponse": {
+            "type": "object",
+            "properties": {
+                "annual_band_max": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "annual_band_min": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "annual_salary": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "deviation": {
+                    "description": "Either below or above\nexample: above",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: John Doe",
+                    "type": "string"
+                },
+                "pay_period": {
+                    "description": "example: monthly",
+                    "type": "string"
+                },
+                "position": {
+                    "description": "example: Software Engineer",
+                    "type": "string"
+                },
+                "position_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "salary": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                }
+            }
+        },
+        "v1.PayPreviewResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Total base pay in the currency of the current salary",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.MoneyDTO"
+                        }
+                    ]
+                },
+                "basis": {
+                    "description": "One of calendar_days or working_days\nexample: working_days",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "month_days": {
+                    "description": "Days of the month on the basis\nexample: 21",
+                    "type": "integer"
+                },
+                "paid_days": {
+                    "description": "Days of the month the employee was employed\nexample: 16",
+                    "type": "integer"
+                },
+                "pay_period": {
+                    "description": "example: monthly",
+                    "type": "string"
+                },
+                "period": {
+                    "description": "example: 2025-03",
+                    "type": "string"
+                },
+                "prorated": {
+                    "description": "example: true",
+                    "type": "boolean"
+                },
+                "segments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PaySegmentResponse"
+                    }
+                }
+            }
+        },
+        "v1.PayPreviewResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.PayPreviewResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PaySegmentResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "days": {
+                    "description": "Calendar or working days covered, depending on the basis\nexample: 16",
+                    "type": "integer"
+                },
+                "end_date": {
+                    "description": "example: 2025-03-31",
+                    "type": "string"
+                },
+                "salary": {
+                    "description": "Salary per pay period in effect during the segment",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.MoneyDTO"
+                        }
+                    ]
+                },
+                "start_date": {
+                    "description": "example: 2025-03-10",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PayrollPeriodListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PayrollPeriodResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PayrollPeriodRequest": {
+            "type": "object",
+            "properties": {
+                "pay_date": {
+                    "description": "example: 2025-03-31",
+                    "type": "string"
+                },
+                "period": {
+                    "description": "Month of the period\nexample: 2025-03",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PayrollPeriodResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2025-03-01 08:00:00",
+                    "type": "string"
+                },
+                "end_date": {
+                    "description": "example: 2025-03-31",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "pay_date": {
+                    "description": "example: 2025-03-31",
+                    "type": "string"
+                },
+                "period": {
+                    "description": "example: 2025-03",
+                    "type": "string"
+                },
+                "start_date": {
+                    "description": "example: 2025-03-01",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PayrollPeriodResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.PayrollPeriodResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PayrollRuleListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PayrollRuleResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PayrollRuleRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Fixed rules only, in minor units; converted to the salary currency when it differs",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.MoneyDTO"
+                        }
+                    ]
+                },
+                "is_active": {
+                    "description": "Defaults to true\nexample: true",
+                    "type": "boolean"
+                },
+                "kind": {
+                    "description": "One of earning, deduction or tax\nexample: deduction",
+                    "type": "string"
+                },
+                "method": {
+                    "description": "fixed (amount per period) or percentage (rate)\nexample: percentage",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "example: Provident fund",
+                    "type": "string"
+                },
+                "rate": {
+                    "description": "Percentage rules only; of the base pay for earnings, the gross pay for deductions and the\ngross pay after deductions for taxes\nexample: 12",
+                    "type": "number"
+                }
+            }
+        },
+        "v1.PayrollRuleResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "created_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "is_active": {
+                    "description": "example: true",
+                    "type": "boolean"
+                },
+                "kind": {
+                    "description": "example: deduction",
+                    "type": "string"
+                },
+                "method": {
+                    "description": "example: percentage",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "example: Provident fund",
+                    "type": "string"
+                },
+                "rate": {
+                    "description": "example: 12",
+                    "type": "number"
+                },
+                "updated_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PayrollRuleResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.PayrollRuleResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PayrollRunListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PayrollRunResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PayrollRunRequest": {
+            "type": "object",
+            "properties": {
+                "payroll_period_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.PayrollRunResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2025-03-28 08:00:00",
+                    "type": "string"
+                },
+                "finalized_at": {
+                    "description": "example: 2025-03-29 10:00:00",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "locked_at": {
+                    "description": "example: 2025-03-31 18:00:00",
+                    "type": "string"
+                },
+                "payroll_period_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "payslip_count": {
+                    "description": "example: 42",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "One of draft, finalized or locked\nexample: draft",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-03-28 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PayrollRunResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.PayrollRunResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PayslipLineResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "expense_claim_id": {
+                    "description": "The expense claim a reimbursement pays back\nexample: 3",
+                    "type": "integer"
+                },
+                "kind": {
+                    "description": "One of base, earning, deduction, tax or reimbursement\nexample: deduction",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "example: Provident fund",
+                    "type": "string"
+                },
+                "payroll_rule_id": {
+                    "description": "Empty for the base pay, benefit deductions, reimbursements and once the rule has been deleted\nexample: 1",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.PayslipListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PayslipResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PayslipResponse": {
+            "type": "object",
+            "properties": {
+                "base_pay": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "created_at": {
+                    "description": "example: 2025-03-28 08:00:00",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "employee_name": {
+                    "description": "example: John Doe",
+                    "type": "string"
+                },
+                "gross_pay": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PayslipLineResponse"
+                    }
+                },
+                "net_pay": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "pay_date": {
+                    "description": "example: 2025-03-31",
+                    "type": "string"
+                },
+                "pay_period": {
+                    "description": "example: monthly",
+                    "type": "string"
+                },
+                "payroll_run_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "period": {
+                    "description": "example: 2025-03",
+                    "type": "string"
+                },
+                "position": {
+                    "description": "example: Software Engineer",
+                    "type": "string"
+                },
+                "salary": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "total_deductions": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "total_reimbursements": {
+                    "description": "Expense claims paid back on top of the pay, untaxed",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.MoneyDTO"
+                        }
+                    ]
+                },
+                "total_tax": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                }
+            }
+        },
+        "v1.PayslipResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.PayslipResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PeerReviewersRequest": {
+            "type": "object",
+            "properties": {
+                "reviewer_ids": {
+                    "description": "example: [3,4]",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "v1.PositionListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PositionResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PositionRequest": {
+            "type": "object",
+            "properties": {
+                "job_level": {
+                    "description": "Seniority level, 1 being the most junior (default 1)\nexample: 2",
+                    "type": "integer"
+                },
+                "max_salary": {
+                    "description": "Upper end of the salary band per pay period, in the same currency as min_salary",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.MoneyDTO"
+                        }
+                    ]
+                },
+                "min_salary": {
+                    "description": "Lower end of the salary band per pay period. Omit both ends for a position without a band.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.MoneyDTO"
+                        }
+                    ]
+                },
+                "pay_period": {
+                    "description": "Pay period the band is expressed in: monthly, annual or hourly (default monthly)\nexample: monthly",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "example: Software Engineer",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PositionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "job_level": {
+                    "description": "example: 2",
+                    "type": "integer"
+                },
+                "max_salary": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "min_salary": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "pay_period": {
+                    "description": "example: monthly",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "example: Software Engineer",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PositionResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.PositionResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PunchRequest": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "description": "Only used when clocking in\nexample: On site at client",
+                    "type": "string"
+                },
+                "time": {
+                    "description": "Punch time in UTC, defaults to now\nexample: 2025-03-03 09:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.RehireEmployeeRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "description": "example: Returning after sabbatical",
+                    "type": "string"
+                },
+                "rehire_date": {
+                    "description": "First day of the new employment (YYYY-MM-DD)\nexample: 2025-01-06",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.RequisitionListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.RequisitionResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.RequisitionRequest": {
+            "type": "object",
+            "properties": {
+                "department_id": {
+                    "description": "example: 3",
+                    "type": "integer"
+                },
+                "description": {
+                    "description": "example: Builds and runs the payroll services",
+                    "type": "string"
+                },
+                "hiring_manager_id": {
+                    "description": "Manager of the new hires\nexample: 7",
+                    "type": "integer"
+                },
+                "location_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "openings": {
+                    "description": "example: 2",
+                    "type": "integer"
+                },
+                "position_id": {
+                    "description": "Position new hires get\nexample: 2",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "One of open, on_hold, filled or closed (default open)\nexample: open",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "example: Backend engineer",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.RequisitionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2025-03-01 08:00:00",
+                    "type": "string"
+                },
+                "department_id": {
+                    "description": "example: 3",
+                    "type": "integer"
+                },
+                "description": {
+                    "description": "example: Builds and runs the payroll services",
+                    "type": "string"
+                },
+                "hired": {
+                    "description": "Candidates hired so far\nexample: 1",
+                    "type": "integer"
+                },
+                "hiring_manager_id": {
+                    "description": "example: 7",
+                    "type": "integer"
+                },
+                "hiring_manager_name": {
+                    "description": "example: Jane Smith",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "location_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "openings": {
+                    "description": "example: 2",
+                    "type": "integer"
+                },
+                "position": {
+                    "description": "example: Software Engineer",
+                    "type": "string"
+                },
+                "position_id": {
+                    "description": "example: 2",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "example: open",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "example: Backend engineer",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-03-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.RequisitionResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.RequisitionResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ReviewAnswerDTO": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "Required for text questions\nexample: Delivered the billing migration ahead of schedule",
+                    "type": "string"
+                },
+                "question_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "rating": {
+                    "description": "Rating questions only, from 1 to the number of rating labels\nexample: 2",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ReviewCalibrationResponse": {
+            "type": "object",
+            "properties": {
+                "departments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.DepartmentCalibrationResponse"
+                    }
+                },
+                "rating_labels": {
+                    "description": "example: [\"Needs improvement\",\"Meets expectations\",\"Exceeds expectations\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "review_cycle_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "total": {
+                    "$ref": "#/definitions/v1.DepartmentCalibrationResponse"
+                }
+            }
+        },
+        "v1.ReviewCalibrationResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.ReviewCalibrationResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ReviewCycleListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ReviewCycleResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ReviewCycleRequest": {
+            "type": "object",
+            "properties": {
+                "manager_review_deadline": {
+                    "description": "example: 2025-07-31",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "example: 2025 H1",
+                    "type": "string"
+                },
+                "peer_review_deadline": {
+                    "description": "example: 2025-07-20",
+                    "type": "string"
+                },
+                "period_end": {
+                    "description": "example: 2025-06-30",
+                    "type": "string"
+                },
+                "period_start": {
+                    "description": "example: 2025-01-01",
+                    "type": "string"
+                },
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ReviewQuestionDTO"
+                    }
+                },
+                "rating_labels": {
+                    "description": "Rating scale from lowest to highest; rating 1 is the first label\nexample: [\"Needs improvement\",\"Meets expectations\",\"Exceeds expectations\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "self_review_deadline": {
+                    "description": "example: 2025-07-10",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ReviewCycleResponse": {
+            "type": "object",
+            "properties": {
+                "closed_at": {
+                    "description": "example: 2025-08-15 08:00:00",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "example: 2025-06-01 08:00:00",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "launched_at": {
+                    "description": "example: 2025-07-01 08:00:00",
+                    "type": "string"
+                },
+                "manager_review_deadline": {
+                    "description": "example: 2025-07-31",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "example: 2025 H1",
+                    "type": "string"
+                },
+                "peer_review_deadline": {
+                    "description": "example: 2025-07-20",
+                    "type": "string"
+                },
+                "period_end": {
+                    "description": "example: 2025-06-30",
+                    "type": "string"
+                },
+                "period_start": {
+                    "description": "example: 2025-01-01",
+                    "type": "string"
+                },
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ReviewQuestionDTO"
+                    }
+                },
+                "rating_labels": {
+                    "description": "example: [\"Needs improvement\",\"Meets expectations\",\"Exceeds expectations\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "self_review_deadline": {
+                    "description": "example: 2025-07-10",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "One of draft, active or closed\nexample: active",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-06-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ReviewCycleResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.ReviewCycleResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ReviewListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ReviewResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ReviewQuestionDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "Ignored in requests\nexample: 1",
+                    "type": "integer"
+                },
+                "kind": {
+                    "description": "rating (answered on the cycle's scale) or text\nexample: rating",
+                    "type": "string"
+                },
+                "text": {
+                    "description": "example: How well did the employee meet their goals?",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ReviewResponse": {
+            "type": "object",
+            "properties": {
+                "completed_at": {
+                    "description": "example: 2025-07-25 14:00:00",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "example: 2025-07-01 08:00:00",
+                    "type": "string"
+                },
+                "department_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "department_name": {
+                    "description": "example: Engineering",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "employee_name": {
+                    "description": "example: John Doe",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "locked_at": {
+                    "description": "example: 2025-08-15 08:00:00",
+                    "type": "string"
+                },
+                "manager_id": {
+                    "description": "Manager when the cycle was launched\nexample: 2",
+                    "type": "integer"
+                },
+                "manager_rating": {
+                    "description": "example: 2",
+                    "type": "number"
+                },
+                "peer_rating": {
+                    "description": "example: 2.25",
+                    "type": "number"
+                },
+                "review_cycle_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "self_rating": {
+                    "description": "example: 2.5",
+                    "type": "number"
+                },
+                "status": {
+                    "description": "One of in_progress, completed or locked\nexample: completed",
+                    "type": "string"
+                },
+                "submissions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ReviewSubmissionResponse"
+                    }
+                },
+                "updated_at": {
+                    "description": "example: 2025-07-25 14:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ReviewResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.ReviewResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ReviewSubmissionRequest": {
+            "type": "object",
+            "properties": {
+                "answers": {
+                    "description": "One answer per question of the cycle",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ReviewAnswerDTO"
+                    }
+                },
+                "reviewer_id": {
+                    "description": "example: 2",
+                    "type": "integer"
+                },
+                "role": {
+                    "description": "One of self, peer or manager\nexample: manager",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ReviewSubmissionResponse": {
+            "type": "object",
+            "properties": {
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ReviewAnswerDTO"
+                    }
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "reviewer_id": {
+                    "description": "example: 2",
+                    "type": "integer"
+                },
+                "reviewer_name": {
+                    "description": "example: Jane Smith",
+                    "type": "string"
+                },
+                "role": {
+                    "description": "example: manager",
+                    "type": "string"
+                },
+                "submitted_at": {
+                    "description": "Empty while the submission is pending\nexample: 2025-07-25 14:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.RosterRequest": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "description": "Only check the roster for conflicts\nexample: false",
+                    "type": "boolean"
+                },
+                "employee_ids": {
+                    "description": "example: [1,2,3]",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "from": {
+                    "description": "example: 2025-03-03",
+                    "type": "string"
+                },
+                "shift_template_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "to": {
+                    "description": "example: 2025-03-30",
+                    "type": "string"
+                },
+                "weekdays": {
+                    "description": "ISO weekdays to roster, 1 (Monday) to 7 (Sunday); omit for every day\nexample: [1,2,3,4,5]",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "v1.RosterResponse": {
+            "type": "object",
+            "properties": {
+                "conflicts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ShiftConflictResponse"
+                    }
+                },
+                "shifts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ShiftResponse"
+                    }
+                }
+            }
+        },
+        "v1.RosterResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.RosterResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SalaryConversionResponse": {
+            "type": "object",
+            "properties": {
+                "converted": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "pay_period": {
+                    "description": "example: monthly",
+                    "type": "string"
+                },
+                "rate": {
+                    "description": "example: 0.92",
+                    "type": "number"
+                },
+                "rate_date": {
+                    "description": "Effective date of the newest rate used\nexample: 2025-01-01",
+                    "type": "string"
+                },
+                "salary": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                }
+            }
+        },
+        "v1.SalaryConversionResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.SalaryConversionResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ScheduleCompensationChangeRequest": {
+            "type": "object",
+            "properties": {
+                "approved_by": {
+                    "description": "Required unless salary changes go through an approval workflow, which records the approvers\ninstead\nexample: Jane Smith",
+                    "type": "string"
+                },
+                "effective_date": {
+                    "description": "Date the new salary applies from (YYYY-MM-DD); future dates are applied by a background job\nexample: 2025-04-01",
+                    "type": "string"
+                },
+                "new_salary": {
+                    "description": "New salary per pay period, in minor currency units",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.MoneyDTO"
+                        }
+                    ]
+                },
+                "reason": {
+                    "description": "example: Annual raise",
+                    "type": "string"
+                },
+                "requested_by": {
+                    "description": "Employee asking for the change, recorded on the approval request\nexample: 7",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.SegmentFilterDTO": {
+            "type": "object",
+            "properties": {
+                "custom_fields": {
+                    "description": "Custom field values by key, as taken by the custom.\u003ckey\u003e filters of the employee list\nexample: {\"t_shirt_size\":\"M\"}",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "location_id": {
+                    "description": "Only employees whose primary location this is\nexample: 2",
+                    "type": "integer"
+                },
+                "manager_id": {
+                    "description": "Only direct reports of this manager\nexample: 7",
+                    "type": "integer"
+                },
+                "statuses": {
+                    "description": "example: [\"active\",\"on_leave\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tags": {
+                    "description": "Only employees with every one of these tags\nexample: [\"remote\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "v1.SegmentListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.SegmentResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SegmentMembersTaggedResponse": {
+            "type": "object",
+            "properties": {
+                "tags_added": {
+                    "description": "Tags added, not counting those members already had\nexample: 8",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.SegmentMembersTaggedResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.SegmentMembersTaggedResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SegmentRequest": {
+            "type": "object",
+            "properties": {
+                "created_by": {
+                    "description": "Employee saving the segment, ignored on update\nexample: 7",
+                    "type": "integer"
+                },
+                "description": {
+                    "description": "example: Engineers working from home, for remote work announcements",
+                    "type": "string"
+                },
+                "filter": {
+                    "$ref": "#/definitions/v1.SegmentFilterDTO"
+                },
+                "name": {
+                    "description": "example: Remote engineers",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SegmentResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2025-01-06 09:00:00",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "example: 7",
+                    "type": "integer"
+                },
+                "description": {
+                    "description": "example: Engineers working from home, for remote work announcements",
+                    "type": "string"
+                },
+                "filter": {
+                    "$ref": "#/definitions/v1.SegmentFilterDTO"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: Remote engineers",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-01-06 09:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SegmentResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.SegmentResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ShiftConflictResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "description": "example: 2025-03-04",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 2",
+                    "type": "integer"
+                },
+                "reason": {
+                    "description": "One of not_employed, on_leave, overlapping_shift, insufficient_rest\nexample: on_leave",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ShiftListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ShiftResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ShiftResponse": {
+            "type": "object",
+            "properties": {
+                "break_minutes": {
+                    "description": "example: 30",
+                    "type": "integer"
+                },
+                "date": {
+                    "description": "example: 2025-03-03",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "ends_at": {
+                    "description": "example: 2025-03-03 14:30:00",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "shift_template_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "shift_template_name": {
+                    "description": "example: Early support",
+                    "type": "string"
+                },
+                "starts_at": {
+                    "description": "example: 2025-03-03 06:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ShiftTemplateListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ShiftTemplateResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ShiftTemplateRequest": {
+            "type": "object",
+            "properties": {
+                "break_minutes": {
+                    "description": "example: 30",
+                    "type": "integer"
+                },
+                "end_time": {
+                    "description": "UTC wall clock time, at or before start_time for an overnight shift\nexample: 14:30",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "example: Early support",
+                    "type": "string"
+                },
+                "start_time": {
+                    "description": "UTC wall clock time\nexample: 06:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ShiftTemplateResponse": {
+            "type": "object",
+            "properties": {
+                "break_minutes": {
+                    "description": "example: 30",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                },
+                "duration_minutes": {
+                    "description": "Shift length including the break\nexample: 510",
+                    "type": "integer"
+                },
+                "end_time": {
+                    "description": "example: 14:30",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: Early support",
+                    "type": "string"
+                },
+                "start_time": {
+                    "description": "example: 06:00",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ShiftTemplateResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.ShiftTemplateResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SkillCategoryListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.SkillCategoryResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SkillCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "example: Programming languages",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SkillCategoryResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: Programming languages",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SkillCategoryResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.SkillCategoryResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SkillListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.SkillResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SkillMatchDetailResponse": {
+            "type": "object",
+            "properties": {
+                "last_verified_on": {
+                    "description": "example: 2025-03-01",
+                    "type": "string"
+                },
+                "level": {
+                    "description": "Empty when the employee lacks the skill\nexample: 4",
+                    "type": "integer"
+                },
+                "met": {
+                    "description": "example: true",
+                    "type": "boolean"
+                },
+                "min_level": {
+                    "description": "example: 3",
+                    "type": "integer"
+                },
+                "skill_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "skill_name": {
+                    "description": "example: Go",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SkillMatchListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.SkillMatchResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SkillMatchResponse": {
+            "type": "object",
+            "properties": {
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "employee_name": {
+                    "description": "example: John Doe",
+                    "type": "string"
+                },
+                "matched": {
+                    "description": "Requirements met at or above their minimum level\nexample: 2",
+                    "type": "integer"
+                },
+                "position": {
+                    "description": "example: Software Engineer",
+                    "type": "string"
+                },
+                "required": {
+                    "description": "example: 3",
+                    "type": "integer"
+                },
+                "score": {
+                    "description": "Average of how far each requirement is met, as a percentage\nexample: 88.89",
+                    "type": "number"
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.SkillMatchDetailResponse"
+                    }
+                }
+            }
+        },
+        "v1.SkillRequest": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "description": {
+                    "description": "example: Building backend services in Go",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "example: Go",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SkillRequirementDTO": {
+            "type": "object",
+            "properties": {
+                "min_level": {
+                    "description": "From 1 (novice) to 5 (expert)\nexample: 3",
+                    "type": "integer"
+                },
+                "skill_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.SkillResponse": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "category_name": {
+                    "description": "example: Programming languages",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "example: Building backend services in Go",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "example: Go",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "example: 2025-01-01 08:00:00",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SkillResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.SkillResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SkillSearchRequest": {
+            "type": "object",
+            "properties": {
+                "full_match_only": {
+                    "description": "Leave out employees missing any requirement\nexample: false",
+                    "type": "boolean"
+                },
+                "limit": {
+                    "description": "Defaults to 20, at most 100\nexample: 20",
+                    "type": "integer"
+                },
+                "requirements": {
+                    "description": "1 to 20 distinct skills",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.SkillRequirementDTO"
+                    }
+                },
+                "verified_since": {
+                    "description": "Only count levels verified on or after this date\nexample: 2024-01-01",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.StartChecklistRequest": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "description": "One of onboarding or offboarding\nexample: onboarding",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.TagCountListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.TagCountResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.TagCountResponse": {
+            "type": "object",
+            "properties": {
+                "employees": {
+                    "description": "example: 12",
+                    "type": "integer"
+                },
+                "tag": {
+                    "description": "example: remote",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.TagListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.TagsRequest": {
+            "type": "object",
+            "properties": {
+                "tags": {
+                    "description": "Tags are trimmed and lower-cased\nexample: [\"new hire\",\"remote\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "v1.TerminateEmployeeRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "description": "example: Resigned",
+                    "type": "string"
+                },
+                "requested_by": {
+                    "description": "Employee asking for the termination, recorded on the approval request when terminations\nneed approval\nexample: 7",
+                    "type": "integer"
+                },
+                "termination_date": {
+                    "description": "Last day of employment (YYYY-MM-DD)\nexample: 2024-06-30",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.TimesheetDayResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "description": "example: 2025-03-03",
+                    "type": "string"
+                },
+                "on_leave": {
+                    "description": "example: false",
+                    "type": "boolean"
+                },
+                "overtime_minutes": {
+                    "description": "example: 30",
+                    "type": "integer"
+                },
+                "regular_minutes": {
+                    "description": "example: 480",
+                    "type": "integer"
+                },
+                "rest_day": {
+                    "description": "Weekend or public holiday\nexample: false",
+                    "type": "boolean"
+                },
+                "worked_minutes": {
+                    "description": "example: 510",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.TimesheetDecisionRequest": {
+            "type": "object",
+            "properties": {
+                "approver_id": {
+                    "description": "ID of the deciding employee; must be the employee's manager when one is set\nexample: 7",
+                    "type": "integer"
+                },
+                "comment": {
+                    "description": "example: Please add Tuesday's hours",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.TimesheetIssueResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "description": "example: 2025-03-04",
+                    "type": "string"
+                },
+                "entry_id": {
+                    "description": "The open shift, for missing clock-outs\nexample: 12",
+                    "type": "integer"
+                },
+                "kind": {
+                    "description": "Either missing_clock_out or no_punches\nexample: no_punches",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.TimesheetListResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.TimesheetResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.TimesheetResponse": {
+            "type": "object",
+            "properties": {
+                "decided_at": {
+                    "description": "example: 2025-03-10 09:00:00",
+                    "type": "string"
+                },
+                "decided_by": {
+                    "description": "example: 7",
+                    "type": "integer"
+                },
+                "decision_comment": {
+                    "description": "example: Looks good",
+                    "type": "string"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
                 "id": {
                     "description": "example: 1",
                     "type": "integer"
                 },
-                "name": {
-                    "description": "example: John Doe",
+                "overtime_minutes": {
+                    "description": "example: 30",
+                    "type": "integer"
+                },
+                "regular_minutes": {
+                    "description": "example: 2400",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "One of submitted, approved or rejected\nexample: submitted",
                     "type": "string"
                 },
-                "position": {
-                    "description": "example: Software Engineer",
+                "submitted_at": {
+                    "description": "example: 2025-03-08 18:00:00",
                     "type": "string"
                 },
-                "salary": {
-                    "description": "example: 60000",
+                "total_minutes": {
+                    "description": "example: 2430",
                     "type": "integer"
+                },
+                "week_start": {
+                    "description": "example: 2025-03-03",
+                    "type": "string"
                 }
             }
         },
-        "v1.GetAllEmployeesResponseWrapper": {
+        "v1.TimesheetResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.TimesheetResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.TrainingCourseListResponseWrapper": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/v1.GetAllEmployeesResponse"
+                        "$ref": "#/definitions/v1.TrainingCourseResponse"
                     }
                 },
                 "message": {
@@ -393,40 +21835,73 @@ const docTemplate = `{
                 }
             }
         },
-        "v1.GetEmployeeByIdResponse": {
+        "v1.TrainingCourseRequest": {
+            "type": "object",
+            "properties": {
+                "certification_valid_months": {
+                    "description": "Completing the course issues a certification valid for this many months, 0 for one that\nnever expires; leave empty for courses that issue none\nexample: 36",
+                    "type": "integer"
+                },
+                "description": {
+                    "description": "example: Safe operation of counterbalance forklifts",
+                    "type": "string"
+                },
+                "duration_hours": {
+                    "description": "example: 16",
+                    "type": "number"
+                },
+                "name": {
+                    "description": "example: Forklift safety",
+                    "type": "string"
+                },
+                "provider": {
+                    "description": "example: National Safety Council",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.TrainingCourseResponse": {
             "type": "object",
             "properties": {
+                "certification_valid_months": {
+                    "description": "example: 36",
+                    "type": "integer"
+                },
                 "created_at": {
-                    "description": "example: 2024-01-15T10:30:00Z",
+                    "description": "example: 2025-03-01 08:00:00",
                     "type": "string"
                 },
-                "hired_date": {
-                    "description": "example: 2024-01-15",
+                "description": {
+                    "description": "example: Safe operation of counterbalance forklifts",
                     "type": "string"
                 },
+                "duration_hours": {
+                    "description": "example: 16",
+                    "type": "number"
+                },
                 "id": {
                     "description": "example: 1",
                     "type": "integer"
                 },
                 "name": {
-                    "description": "example: John Doe",
+                    "description": "example: Forklift safety",
                     "type": "string"
                 },
-                "position": {
-                    "description": "example: Software Engineer",
+                "provider": {
+                    "description": "example: National Safety Council",
                     "type": "string"
                 },
-                "salary": {
-                    "description": "example: 60000",
-                    "type": "integer"
+                "updated_at": {
+                    "description": "example: 2025-03-01 08:00:00",
+                    "type": "string"
                 }
             }
         },
-        "v1.GetEmployeeByIdResponseWrapper": {
+        "v1.TrainingCourseResponseWrapper": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/v1.GetEmployeeByIdResponse"
+                    "$ref": "#/definitions/v1.TrainingCourseResponse"
                 },
                 "message": {
                     "type": "string"
@@ -445,27 +21920,92 @@ const docTemplate = `{
         "v1.UpdateEmployeeRequest": {
             "type": "object",
             "properties": {
+                "address": {
+                    "description": "Postal address",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.AddressDTO"
+                        }
+                    ]
+                },
+                "custom_fields": {
+                    "description": "Values of custom fields by key, required ones included. Dates are YYYY-MM-DD, multi_select\nvalues are arrays of options.",
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "date_of_birth": {
+                    "description": "Date of birth (YYYY-MM-DD)\nexample: 1990-05-20",
+                    "type": "string"
+                },
+                "department_id": {
+                    "description": "ID of the employee's department, omit for none\nexample: 3",
+                    "type": "integer"
+                },
                 "hired_date": {
                     "description": "example: 2024-01-15",
                     "type": "string"
                 },
+                "location_id": {
+                    "description": "ID of the employee's office location, omit for none\nexample: 2",
+                    "type": "integer"
+                },
+                "manager_id": {
+                    "description": "ID of the employee this employee reports to, omit for none\nexample: 7",
+                    "type": "integer"
+                },
                 "name": {
                     "description": "example: John Doe Updated",
                     "type": "string"
                 },
-                "position": {
-                    "description": "example: Senior Software Engineer",
+                "pay_period": {
+                    "description": "Current pay period of the employee, empty keeps it\nexample: monthly",
                     "type": "string"
                 },
-                "salary": {
-                    "description": "example: 75000",
+                "personal_email": {
+                    "description": "Personal email, unique across employees\nexample: john.doe@gmail.com",
+                    "type": "string"
+                },
+                "phone": {
+                    "description": "Phone number in E.164 format\nexample: +14155552671",
+                    "type": "string"
+                },
+                "position_id": {
+                    "description": "example: 2",
                     "type": "integer"
+                },
+                "salary": {
+                    "description": "Current salary of the employee, changes go through the compensation endpoint",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.MoneyDTO"
+                        }
+                    ]
+                },
+                "work_email": {
+                    "description": "Work email, unique across employees\nexample: john.doe@company.com",
+                    "type": "string"
                 }
             }
         },
         "v1.UpdateEmployeeResponse": {
             "type": "object",
             "properties": {
+                "address": {
+                    "$ref": "#/definitions/v1.AddressDTO"
+                },
+                "custom_fields": {
+                    "description": "Values of custom fields by key",
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "date_of_birth": {
+                    "description": "example: 1990-05-20",
+                    "type": "string"
+                },
+                "department_id": {
+                    "description": "example: 3",
+                    "type": "integer"
+                },
                 "hired_date": {
                     "description": "example: 2024-01-15",
                     "type": "string"
@@ -474,21 +22014,71 @@ const docTemplate = `{
                     "description": "example: 1",
                     "type": "integer"
                 },
+                "location_id": {
+                    "description": "example: 2",
+                    "type": "integer"
+                },
+                "manager_id": {
+                    "description": "example: 7",
+                    "type": "integer"
+                },
                 "name": {
                     "description": "example: John Doe Updated",
                     "type": "string"
                 },
+                "pay_period": {
+                    "description": "example: monthly",
+                    "type": "string"
+                },
+                "personal_email": {
+                    "description": "example: john.doe@gmail.com",
+                    "type": "string"
+                },
+                "phone": {
+                    "description": "example: +14155552671",
+                    "type": "string"
+                },
                 "position": {
                     "description": "example: Senior Software Engineer",
                     "type": "string"
                 },
-                "salary": {
-                    "description": "example: 75000",
+                "position_id": {
+                    "description": "example: 2",
                     "type": "integer"
                 },
+                "salary": {
+                    "$ref": "#/definitions/v1.MoneyDTO"
+                },
+                "salary_out_of_band": {
+                    "description": "True when the salary was saved outside the position's band\nexample: false",
+                    "type": "boolean"
+                },
+                "status": {
+                    "description": "example: active",
+                    "type": "string"
+                },
+                "tags": {
+                    "description": "example: [\"new hire\",\"remote\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "termination_date": {
+                    "description": "example: 2025-06-30",
+                    "type": "string"
+                },
+                "termination_reason": {
+                    "description": "example: Resigned",
+                    "type": "string"
+                },
                 "updated_at": {
                     "description": "example: 2024-02-01T12:00:00Z",
                     "type": "string"
+                },
+                "work_email": {
+                    "description": "example: john.doe@company.com",
+                    "type": "string"
                 }
             }
         },
@@ -511,6 +22101,127 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "v1.WeeklyTimesheetResponse": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.TimesheetDayResponse"
+                    }
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "issues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.TimesheetIssueResponse"
+                    }
+                },
+                "overtime_minutes": {
+                    "description": "example: 30",
+                    "type": "integer"
+                },
+                "regular_minutes": {
+                    "description": "example: 2400",
+                    "type": "integer"
+                },
+                "submission": {
+                    "description": "Present once the week has been submitted",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.TimesheetResponse"
+                        }
+                    ]
+                },
+                "total_minutes": {
+                    "description": "example: 2430",
+                    "type": "integer"
+                },
+                "week_start": {
+                    "description": "example: 2025-03-03",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.WeeklyTimesheetResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.WeeklyTimesheetResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.WorkingDaysResponse": {
+            "type": "object",
+            "properties": {
+                "calendar_days": {
+                    "description": "example: 31",
+                    "type": "integer"
+                },
+                "employee_id": {
+                    "description": "example: 1",
+                    "type": "integer"
+                },
+                "from": {
+                    "description": "example: 2025-08-01",
+                    "type": "string"
+                },
+                "holidays": {
+                    "description": "Holidays falling on weekdays",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.HolidayResponse"
+                    }
+                },
+                "to": {
+                    "description": "example: 2025-08-31",
+                    "type": "string"
+                },
+                "weekend_days": {
+                    "description": "example: 10",
+                    "type": "integer"
+                },
+                "working_days": {
+                    "description": "example: 20",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.WorkingDaysResponseWrapper": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/v1.WorkingDaysResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
         }
     }
 }`
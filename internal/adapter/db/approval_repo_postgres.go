@@ -0,0 +1,919 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const approvalWorkflowColumns = `
+	id, name, request_type, created_at, updated_at`
+
+const approvalStepColumns = `
+	id, workflow_id, stage, approver_type, approver_id, timeout_hours, escalate_to_id`
+
+// approvalRequestColumns selects a request r with its employee's name from e, joined by
+// approvalRequestFrom.
+const approvalRequestColumns = `
+	r.id, r.request_type, r.reference, r.employee_id, e.name, r.requested_by, r.summary, r.payload,
+	r.status, r.current_stage, COALESCE(r.failure_reason, ''), r.created_at, r.decided_at`
+
+const approvalRequestFrom = `
+	FROM approval_requests r
+	JOIN employees e ON e.id = r.employee_id`
+
+// approvalTaskColumns selects a task t with its request r, the request's employee e, the task's
+// assignee a and position p, joined by approvalTaskFrom.
+const approvalTaskColumns = `
+	t.id, t.request_id, r.request_type, r.employee_id, e.name, r.summary, t.stage, t.approver_type,
+	t.assignee_id, COALESCE(a.name, ''), t.position_id, COALESCE(p.title, ''), t.status, t.timeout_hours,
+	t.escalate_to_id, t.due_at, t.escalated_at, t.decided_by, COALESCE(t.comment, ''), t.decided_at`
+
+const approvalTaskFrom = `
+	FROM approval_tasks t
+	JOIN approval_requests r ON r.id = t.request_id
+	JOIN employees e ON e.id = r.employee_id
+	LEFT JOIN employees a ON a.id = t.assignee_id
+	LEFT JOIN positions p ON p.id = t.position_id`
+
+// approvalDelegationColumns selects a delegation d with the names of its delegator o and
+// delegate g, joined by approvalDelegationFrom.
+const approvalDelegationColumns = `
+	d.id, d.delegator_id, o.name, d.delegate_id, g.name, d.start_date, d.end_date, d.created_at`
+
+const approvalDelegationFrom = `
+	FROM approval_delegations d
+	JOIN employees o ON o.id = d.delegator_id
+	JOIN employees g ON g.id = d.delegate_id`
+
+type ApprovalRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewApprovalRepository(pool *pgxpool.Pool) repository.ApprovalRepository {
+	return &ApprovalRepoPostgres{pool: pool}
+}
+
+func scanApprovalWorkflow(row pgx.Row) (*entity.ApprovalWorkflow, error) {
+	var workflow entity.ApprovalWorkflow
+	err := row.Scan(
+		&workflow.ID,
+		&workflow.Name,
+		&workflow.RequestType,
+		&workflow.CreatedAt,
+		&workflow.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	workflow.Steps = []entity.ApprovalStep{}
+	return &workflow, nil
+}
+
+func scanApprovalStep(row pgx.Row) (*entity.ApprovalStep, error) {
+	var step entity.ApprovalStep
+	err := row.Scan(
+		&step.ID,
+		&step.WorkflowID,
+		&step.Stage,
+		&step.ApproverType,
+		&step.ApproverID,
+		&step.TimeoutHours,
+		&step.EscalateToID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &step, nil
+}
+
+func scanApprovalRequest(row pgx.Row) (*entity.ApprovalRequest, error) {
+	var request entity.ApprovalRequest
+	err := row.Scan(
+		&request.ID,
+		&request.Type,
+		&request.Reference,
+		&request.EmployeeID,
+		&request.EmployeeName,
+		&request.RequestedBy,
+		&request.Summary,
+		&request.Payload,
+		&request.Status,
+		&request.CurrentStage,
+		&request.FailureReason,
+		&request.CreatedAt,
+		&request.DecidedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	request.Tasks = []entity.ApprovalTask{}
+	return &request, nil
+}
+
+func scanApprovalTask(row pgx.Row) (*entity.ApprovalTask, error) {
+	var task entity.ApprovalTask
+	err := row.Scan(
+		&task.ID,
+		&task.RequestID,
+		&task.RequestType,
+		&task.EmployeeID,
+		&task.EmployeeName,
+		&task.Summary,
+		&task.Stage,
+		&task.ApproverType,
+		&task.AssigneeID,
+		&task.AssigneeName,
+		&task.PositionID,
+		&task.Position,
+		&task.Status,
+		&task.TimeoutHours,
+		&task.EscalateToID,
+		&task.DueAt,
+		&task.EscalatedAt,
+		&task.DecidedBy,
+		&task.Comment,
+		&task.DecidedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+func scanApprovalDelegation(row pgx.Row) (*entity.ApprovalDelegation, error) {
+	var delegation entity.ApprovalDelegation
+	err := row.Scan(
+		&delegation.ID,
+		&delegation.DelegatorID,
+		&delegation.DelegatorName,
+		&delegation.DelegateID,
+		&delegation.DelegateName,
+		&delegation.StartDate,
+		&delegation.EndDate,
+		&delegation.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &delegation, nil
+}
+
+func mapApprovalWorkflowWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "approval_workflows_request_type_key" {
+		return appError.ErrApprovalWorkflowExists
+	}
+	if foreignKeyViolationConstraint(err) == "approval_steps_escalate_to_id_fkey" {
+		return appError.ErrEmployeeNotFound
+	}
+	return err
+}
+
+func mapApprovalRequestWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "approval_requests_pending_key" {
+		return appError.ErrApprovalAlreadyPending
+	}
+	switch foreignKeyViolationConstraint(err) {
+	case "approval_requests_employee_id_fkey", "approval_requests_requested_by_fkey",
+		"approval_tasks_assignee_id_fkey", "approval_tasks_escalate_to_id_fkey", "approval_tasks_decided_by_fkey":
+		return appError.ErrEmployeeNotFound
+	case "approval_tasks_position_id_fkey":
+		return appError.ErrPositionNotFound
+	}
+	return err
+}
+
+func (r *ApprovalRepoPostgres) CreateWorkflow(ctx context.Context, workflow *entity.ApprovalWorkflow) (*entity.ApprovalWorkflow, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var workflowID int
+	err = tx.QueryRow(ctx, `
+		INSERT INTO approval_workflows (name, request_type)
+		VALUES ($1, $2)
+		RETURNING id
+	`, workflow.Name, workflow.RequestType).Scan(&workflowID)
+	if err != nil {
+		return nil, mapApprovalWorkflowWriteError(err)
+	}
+
+	if err := insertApprovalSteps(ctx, tx, workflowID, workflow.Steps); err != nil {
+		return nil, err
+	}
+	createdWorkflow, err := getApprovalWorkflow(ctx, tx, `id = $1`, workflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return createdWorkflow, nil
+}
+
+func (r *ApprovalRepoPostgres) GetWorkflowById(ctx context.Context, id int) (*entity.ApprovalWorkflow, error) {
+	workflow, err := getApprovalWorkflow(ctx, r.pool, `id = $1`, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return workflow, nil
+}
+
+func (r *ApprovalRepoPostgres) GetWorkflowByType(ctx context.Context, requestType entity.ApprovalType) (*entity.ApprovalWorkflow, error) {
+	workflow, err := getApprovalWorkflow(ctx, r.pool, `request_type = $1`, requestType)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return workflow, nil
+}
+
+func (r *ApprovalRepoPostgres) GetWorkflows(ctx context.Context) ([]*entity.ApprovalWorkflow, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+approvalWorkflowColumns+`
+		FROM approval_workflows
+		ORDER BY request_type
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	workflows := []*entity.ApprovalWorkflow{}
+	workflowsByID := map[int]*entity.ApprovalWorkflow{}
+	for rows.Next() {
+		workflow, err := scanApprovalWorkflow(rows)
+		if err != nil {
+			return nil, err
+		}
+		workflows = append(workflows, workflow)
+		workflowsByID[workflow.ID] = workflow
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(workflows) == 0 {
+		return workflows, nil
+	}
+
+	ids := make([]int, 0, len(workflows))
+	for _, workflow := range workflows {
+		ids = append(ids, workflow.ID)
+	}
+	steps, err := queryApprovalSteps(ctx, r.pool, `workflow_id = ANY($1)`, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, step := range steps {
+		workflow := workflowsByID[step.WorkflowID]
+		workflow.Steps = append(workflow.Steps, *step)
+	}
+	return workflows, nil
+}
+
+func (r *ApprovalRepoPostgres) UpdateWorkflow(ctx context.Context, workflow *entity.ApprovalWorkflow) (*entity.ApprovalWorkflow, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		UPDATE approval_workflows
+		SET name = $1,
+			request_type = $2,
+			updated_at = NOW()
+		WHERE id = $3
+	`, workflow.Name, workflow.RequestType, workflow.ID)
+	if err != nil {
+		return nil, mapApprovalWorkflowWriteError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM approval_steps WHERE workflow_id = $1`, workflow.ID); err != nil {
+		return nil, err
+	}
+	if err := insertApprovalSteps(ctx, tx, workflow.ID, workflow.Steps); err != nil {
+		return nil, err
+	}
+	updatedWorkflow, err := getApprovalWorkflow(ctx, tx, `id = $1`, workflow.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return updatedWorkflow, nil
+}
+
+func (r *ApprovalRepoPostgres) DeleteWorkflow(ctx context.Context, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM approval_workflows WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrApprovalWorkflowNotFound
+	}
+	return nil
+}
+
+func (r *ApprovalRepoPostgres) CreateRequest(ctx context.Context, request *entity.ApprovalRequest) (*entity.ApprovalRequest, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var requestID int
+	err = tx.QueryRow(ctx, `
+		INSERT INTO approval_requests (request_type, reference, employee_id, requested_by, summary, payload, status, current_stage)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`,
+		request.Type,
+		request.Reference,
+		request.EmployeeID,
+		request.RequestedBy,
+		request.Summary,
+		request.Payload,
+		request.Status,
+		request.CurrentStage,
+	).Scan(&requestID)
+	if err != nil {
+		return nil, mapApprovalRequestWriteError(err)
+	}
+
+	for _, task := range request.Tasks {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO approval_tasks (request_id, stage, approver_type, assignee_id, position_id, status,
+				timeout_hours, escalate_to_id, due_at)
+			VALUES ($1, $2, $3, $4, $5, $6::varchar, $7, $8,
+				CASE WHEN $6 = 'pending' AND $7 > 0 THEN NOW() + make_interval(hours => $7) END)
+		`,
+			requestID,
+			task.Stage,
+			task.ApproverType,
+			task.AssigneeID,
+			task.PositionID,
+			task.Status,
+			task.TimeoutHours,
+			task.EscalateToID,
+		)
+		if err != nil {
+			return nil, mapApprovalRequestWriteError(err)
+		}
+	}
+	createdRequest, err := getApprovalRequest(ctx, tx, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return createdRequest, nil
+}
+
+func (r *ApprovalRepoPostgres) GetRequestById(ctx context.Context, id int) (*entity.ApprovalRequest, error) {
+	request, err := getApprovalRequest(ctx, r.pool, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return request, nil
+}
+
+// buildApprovalRequestFilter turns filter into a WHERE clause (empty when nothing is filtered) and
+// its arguments.
+func buildApprovalRequestFilter(filter entity.ApprovalRequestFilter) (string, []any) {
+	conditions := []string{}
+	args := []any{}
+
+	if filter.EmployeeID > 0 {
+		args = append(args, filter.EmployeeID)
+		conditions = append(conditions, fmt.Sprintf("r.employee_id = $%d", len(args)))
+	}
+	if filter.Type != "" {
+		args = append(args, filter.Type)
+		conditions = append(conditions, fmt.Sprintf("r.request_type = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("r.status = $%d", len(args)))
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func (r *ApprovalRepoPostgres) GetRequests(ctx context.Context, filter entity.ApprovalRequestFilter) ([]*entity.ApprovalRequest, error) {
+	where, args := buildApprovalRequestFilter(filter)
+	query := `
+		SELECT ` + approvalRequestColumns + approvalRequestFrom + `
+		` + where + `
+		ORDER BY r.created_at DESC, r.id DESC
+	`
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	requests := []*entity.ApprovalRequest{}
+	requestsByID := map[int]*entity.ApprovalRequest{}
+	for rows.Next() {
+		request, err := scanApprovalRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+		requestsByID[request.ID] = request
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(requests) == 0 {
+		return requests, nil
+	}
+
+	ids := make([]int, 0, len(requests))
+	for _, request := range requests {
+		ids = append(ids, request.ID)
+	}
+	tasks, err := queryApprovalTasks(ctx, r.pool, `t.request_id = ANY($1)`, `t.request_id, t.stage, t.id`, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		request := requestsByID[task.RequestID]
+		request.Tasks = append(request.Tasks, *task)
+	}
+	return requests, nil
+}
+
+func (r *ApprovalRepoPostgres) CancelRequest(ctx context.Context, id int) (*entity.ApprovalRequest, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := lockPendingApprovalRequest(ctx, tx, id); err != nil {
+		return nil, err
+	}
+	if err := closeApprovalRequest(ctx, tx, id, entity.ApprovalCancelled); err != nil {
+		return nil, err
+	}
+	cancelledRequest, err := getApprovalRequest(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return cancelledRequest, nil
+}
+
+func (r *ApprovalRepoPostgres) SetRequestFailed(ctx context.Context, id int, reason string) error {
+	result, err := r.pool.Exec(ctx, `
+		UPDATE approval_requests
+		SET status = 'failed',
+			failure_reason = $1
+		WHERE id = $2 AND status = 'approved'
+	`, reason, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrApprovalRequestNotFound
+	}
+	return nil
+}
+
+func (r *ApprovalRepoPostgres) GetTaskById(ctx context.Context, id int) (*entity.ApprovalTask, error) {
+	tasks, err := queryApprovalTasks(ctx, r.pool, `t.id = $1`, `t.id`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+	return tasks[0], nil
+}
+
+func (r *ApprovalRepoPostgres) GetInbox(ctx context.Context, approverID int, asOf time.Time) ([]*entity.ApprovalTask, error) {
+	// The approver acts for themselves and for everyone who delegated to them that day. Position
+	// tasks are open to every holder of the position who is still employed.
+	where := `t.status = 'pending'
+		AND r.status = 'pending'
+		AND t.stage = r.current_stage
+		AND r.employee_id <> $1
+		AND (t.assignee_id IN (SELECT id FROM approval_principals)
+			OR (t.assignee_id IS NULL AND t.position_id IN (
+				SELECT position_id
+				FROM employees
+				WHERE id IN (SELECT id FROM approval_principals) AND status <> 'terminated'
+			)))`
+	rows, err := r.pool.Query(ctx, `
+		WITH approval_principals AS (
+			SELECT $1::int AS id
+			UNION
+			SELECT delegator_id
+			FROM approval_delegations
+			WHERE delegate_id = $1 AND $2::date BETWEEN start_date AND end_date
+		)
+		SELECT `+approvalTaskColumns+approvalTaskFrom+`
+		WHERE `+where+`
+		ORDER BY r.created_at, t.id
+	`, approverID, asOf)
+	if err != nil {
+		return nil, err
+	}
+	return collectApprovalTasks(rows)
+}
+
+func (r *ApprovalRepoPostgres) DecideTask(ctx context.Context, decision entity.ApprovalDecision) (*entity.ApprovalRequest, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var requestID int
+	err = tx.QueryRow(ctx, `SELECT request_id FROM approval_tasks WHERE id = $1`, decision.TaskID).Scan(&requestID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, appError.ErrApprovalTaskNotFound
+		}
+		return nil, err
+	}
+
+	// Locking the request first keeps two approvers of the same stage from each seeing the other's
+	// task still pending and neither moving the request along.
+	currentStage, err := lockPendingApprovalRequest(ctx, tx, requestID)
+	if err != nil {
+		if errors.Is(err, appError.ErrApprovalRequestClosed) {
+			return nil, appError.ErrApprovalTaskClosed
+		}
+		return nil, err
+	}
+
+	status := entity.ApprovalTaskRejected
+	if decision.Approve {
+		status = entity.ApprovalTaskApproved
+	}
+	result, err := tx.Exec(ctx, `
+		UPDATE approval_tasks
+		SET status = $1,
+			decided_by = $2,
+			comment = NULLIF($3, ''),
+			decided_at = NOW(),
+			due_at = NULL
+		WHERE id = $4 AND status = 'pending' AND stage = $5
+	`, status, decision.ApproverID, decision.Comment, decision.TaskID, currentStage)
+	if err != nil {
+		return nil, mapApprovalRequestWriteError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, appError.ErrApprovalTaskClosed
+	}
+
+	if !decision.Approve {
+		if err := closeApprovalRequest(ctx, tx, requestID, entity.ApprovalRejected); err != nil {
+			return nil, err
+		}
+	} else if err := advanceApprovalRequest(ctx, tx, requestID, currentStage); err != nil {
+		return nil, err
+	}
+	decidedRequest, err := getApprovalRequest(ctx, tx, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return decidedRequest, nil
+}
+
+func (r *ApprovalRepoPostgres) GetOverdueTasks(ctx context.Context, asOf time.Time) ([]*entity.ApprovalTask, error) {
+	return queryApprovalTasks(ctx, r.pool, `t.status = 'pending'
+		AND r.status = 'pending'
+		AND t.stage = r.current_stage
+		AND t.due_at <= $1`, `t.due_at, t.id`, asOf)
+}
+
+func (r *ApprovalRepoPostgres) EscalateTask(ctx context.Context, id int, assigneeID *int) (*entity.ApprovalTask, error) {
+	result, err := r.pool.Exec(ctx, `
+		UPDATE approval_tasks
+		SET assignee_id = COALESCE($1, assignee_id),
+			escalated_at = NOW(),
+			due_at = CASE WHEN $1::int IS NOT NULL AND timeout_hours > 0 THEN NOW() + make_interval(hours => timeout_hours) END
+		WHERE id = $2 AND status = 'pending'
+	`, assigneeID, id)
+	if err != nil {
+		return nil, mapApprovalRequestWriteError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+	return r.GetTaskById(ctx, id)
+}
+
+func (r *ApprovalRepoPostgres) CreateDelegation(ctx context.Context, delegation *entity.ApprovalDelegation) (*entity.ApprovalDelegation, error) {
+	var delegationID int
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO approval_delegations (delegator_id, delegate_id, start_date, end_date)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`,
+		delegation.DelegatorID,
+		delegation.DelegateID,
+		delegation.StartDate,
+		delegation.EndDate,
+	).Scan(&delegationID)
+	if err != nil {
+		switch foreignKeyViolationConstraint(err) {
+		case "approval_delegations_delegator_id_fkey", "approval_delegations_delegate_id_fkey":
+			return nil, appError.ErrEmployeeNotFound
+		}
+		return nil, err
+	}
+	return r.GetDelegationById(ctx, delegationID)
+}
+
+func (r *ApprovalRepoPostgres) GetDelegationById(ctx context.Context, id int) (*entity.ApprovalDelegation, error) {
+	query := `
+		SELECT ` + approvalDelegationColumns + approvalDelegationFrom + `
+		WHERE d.id = $1
+	`
+	delegation, err := scanApprovalDelegation(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return delegation, nil
+}
+
+func (r *ApprovalRepoPostgres) GetDelegations(ctx context.Context, delegatorID int) ([]*entity.ApprovalDelegation, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+approvalDelegationColumns+approvalDelegationFrom+`
+		WHERE d.delegator_id = $1
+		ORDER BY d.start_date DESC, d.id DESC
+	`, delegatorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	delegations := []*entity.ApprovalDelegation{}
+	for rows.Next() {
+		delegation, err := scanApprovalDelegation(rows)
+		if err != nil {
+			return nil, err
+		}
+		delegations = append(delegations, delegation)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return delegations, nil
+}
+
+func (r *ApprovalRepoPostgres) DeleteDelegation(ctx context.Context, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM approval_delegations WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrApprovalDelegationNotFound
+	}
+	return nil
+}
+
+func insertApprovalSteps(ctx context.Context, tx pgx.Tx, workflowID int, steps []entity.ApprovalStep) error {
+	for number, step := range steps {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO approval_steps (workflow_id, step_number, stage, approver_type, approver_id, timeout_hours, escalate_to_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`,
+			workflowID,
+			number+1,
+			step.Stage,
+			step.ApproverType,
+			step.ApproverID,
+			step.TimeoutHours,
+			step.EscalateToID,
+		)
+		if err != nil {
+			return mapApprovalWorkflowWriteError(err)
+		}
+	}
+	return nil
+}
+
+// lockPendingApprovalRequest locks the request for the rest of tx and returns its current stage.
+func lockPendingApprovalRequest(ctx context.Context, tx pgx.Tx, id int) (int, error) {
+	var status entity.ApprovalRequestStatus
+	var currentStage int
+	err := tx.QueryRow(ctx, `
+		SELECT status, current_stage
+		FROM approval_requests
+		WHERE id = $1
+		FOR UPDATE
+	`, id).Scan(&status, &currentStage)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, appError.ErrApprovalRequestNotFound
+		}
+		return 0, err
+	}
+	if status != entity.ApprovalPending {
+		return 0, appError.ErrApprovalRequestClosed
+	}
+	return currentStage, nil
+}
+
+// closeApprovalRequest decides the request with status and cancels its open tasks.
+func closeApprovalRequest(ctx context.Context, tx pgx.Tx, id int, status entity.ApprovalRequestStatus) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE approval_tasks
+		SET status = 'cancelled',
+			due_at = NULL
+		WHERE request_id = $1 AND status IN ('waiting', 'pending')
+	`, id)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `
+		UPDATE approval_requests
+		SET status = $1,
+			current_stage = 0,
+			decided_at = NOW()
+		WHERE id = $2
+	`, status, id)
+	return err
+}
+
+// advanceApprovalRequest starts the request's next stage once currentStage has no pending tasks
+// left, and approves the request when there is no next stage.
+func advanceApprovalRequest(ctx context.Context, tx pgx.Tx, id, currentStage int) error {
+	var pending bool
+	err := tx.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM approval_tasks WHERE request_id = $1 AND stage = $2 AND status = 'pending')
+	`, id, currentStage).Scan(&pending)
+	if err != nil || pending {
+		return err
+	}
+
+	var nextStage *int
+	err = tx.QueryRow(ctx, `
+		SELECT MIN(stage) FROM approval_tasks WHERE request_id = $1 AND status = 'waiting'
+	`, id).Scan(&nextStage)
+	if err != nil {
+		return err
+	}
+	if nextStage == nil {
+		_, err := tx.Exec(ctx, `
+			UPDATE approval_requests
+			SET status = 'approved',
+				current_stage = 0,
+				decided_at = NOW()
+			WHERE id = $1
+		`, id)
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE approval_tasks
+		SET status = 'pending',
+			due_at = CASE WHEN timeout_hours > 0 THEN NOW() + make_interval(hours => timeout_hours) END
+		WHERE request_id = $1 AND stage = $2 AND status = 'waiting'
+	`, id, *nextStage)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `UPDATE approval_requests SET current_stage = $1 WHERE id = $2`, *nextStage, id)
+	return err
+}
+
+func getApprovalWorkflow(ctx context.Context, q querier, where string, args ...any) (*entity.ApprovalWorkflow, error) {
+	query := `
+		SELECT ` + approvalWorkflowColumns + `
+		FROM approval_workflows
+		WHERE ` + where
+	workflow, err := scanApprovalWorkflow(q.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, err
+	}
+
+	steps, err := queryApprovalSteps(ctx, q, `workflow_id = $1`, workflow.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, step := range steps {
+		workflow.Steps = append(workflow.Steps, *step)
+	}
+	return workflow, nil
+}
+
+func queryApprovalSteps(ctx context.Context, q querier, where string, args ...any) ([]*entity.ApprovalStep, error) {
+	rows, err := q.Query(ctx, `
+		SELECT `+approvalStepColumns+`
+		FROM approval_steps
+		WHERE `+where+`
+		ORDER BY workflow_id, stage, step_number
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	steps := []*entity.ApprovalStep{}
+	for rows.Next() {
+		step, err := scanApprovalStep(rows)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return steps, nil
+}
+
+func getApprovalRequest(ctx context.Context, q querier, id int) (*entity.ApprovalRequest, error) {
+	query := `
+		SELECT ` + approvalRequestColumns + approvalRequestFrom + `
+		WHERE r.id = $1
+	`
+	request, err := scanApprovalRequest(q.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := queryApprovalTasks(ctx, q, `t.request_id = $1`, `t.stage, t.id`, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		request.Tasks = append(request.Tasks, *task)
+	}
+	return request, nil
+}
+
+func queryApprovalTasks(ctx context.Context, q querier, where, orderBy string, args ...any) ([]*entity.ApprovalTask, error) {
+	rows, err := q.Query(ctx, `
+		SELECT `+approvalTaskColumns+approvalTaskFrom+`
+		WHERE `+where+`
+		ORDER BY `+orderBy, args...)
+	if err != nil {
+		return nil, err
+	}
+	return collectApprovalTasks(rows)
+}
+
+func collectApprovalTasks(rows pgx.Rows) ([]*entity.ApprovalTask, error) {
+	defer rows.Close()
+
+	tasks := []*entity.ApprovalTask{}
+	for rows.Next() {
+		task, err := scanApprovalTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
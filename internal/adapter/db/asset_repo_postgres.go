@@ -0,0 +1,363 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// assetColumns selects an asset a with its open assignment aa and the employee e holding it,
+// joined by assetFrom. The stored status never says assigned, the open assignment does.
+const assetColumns = `
+	a.id, a.name, a.type, a.serial_number, a.purchase_date,
+	CASE WHEN aa.id IS NULL THEN a.status ELSE 'assigned' END, COALESCE(a.notes, ''), aa.employee_id,
+	COALESCE(e.name, ''), a.created_at, a.updated_at`
+
+const assetFrom = `
+	FROM assets a
+	LEFT JOIN asset_assignments aa ON aa.asset_id = a.id AND aa.returned_on IS NULL
+	LEFT JOIN employees e ON e.id = aa.employee_id`
+
+// assetAssignmentColumns selects an assignment aa with its asset a and employee e, joined by
+// assetAssignmentFrom.
+const assetAssignmentColumns = `
+	aa.id, aa.asset_id, a.name, a.type, a.serial_number, aa.employee_id, e.name, aa.assigned_on, aa.returned_on,
+	COALESCE(aa.notes, ''), COALESCE(aa.return_notes, ''), aa.created_at, aa.updated_at`
+
+const assetAssignmentFrom = `
+	FROM asset_assignments aa
+	JOIN assets a ON a.id = aa.asset_id
+	JOIN employees e ON e.id = aa.employee_id`
+
+type AssetRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewAssetRepository(pool *pgxpool.Pool) repository.AssetRepository {
+	return &AssetRepoPostgres{pool: pool}
+}
+
+func scanAsset(row pgx.Row) (*entity.Asset, error) {
+	var asset entity.Asset
+	err := row.Scan(
+		&asset.ID,
+		&asset.Name,
+		&asset.Type,
+		&asset.SerialNumber,
+		&asset.PurchaseDate,
+		&asset.Status,
+		&asset.Notes,
+		&asset.HolderID,
+		&asset.HolderName,
+		&asset.CreatedAt,
+		&asset.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &asset, nil
+}
+
+func scanAssetAssignment(row pgx.Row) (*entity.AssetAssignment, error) {
+	var assignment entity.AssetAssignment
+	err := row.Scan(
+		&assignment.ID,
+		&assignment.AssetID,
+		&assignment.AssetName,
+		&assignment.AssetType,
+		&assignment.SerialNumber,
+		&assignment.EmployeeID,
+		&assignment.EmployeeName,
+		&assignment.AssignedOn,
+		&assignment.ReturnedOn,
+		&assignment.Notes,
+		&assignment.ReturnNotes,
+		&assignment.CreatedAt,
+		&assignment.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+func mapAssetWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "assets_serial_number_key" {
+		return appError.ErrAssetAlreadyExists
+	}
+	return err
+}
+
+func (r *AssetRepoPostgres) CreateAsset(ctx context.Context, asset *entity.Asset) (*entity.Asset, error) {
+	query := `
+		INSERT INTO assets (name, type, serial_number, purchase_date, status, notes)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''))
+		RETURNING id
+	`
+	var assetID int
+	err := r.pool.QueryRow(ctx, query,
+		asset.Name,
+		asset.Type,
+		asset.SerialNumber,
+		asset.PurchaseDate,
+		asset.Status,
+		asset.Notes,
+	).Scan(&assetID)
+	if err != nil {
+		return nil, mapAssetWriteError(err)
+	}
+	return r.GetAssetById(ctx, assetID)
+}
+
+func (r *AssetRepoPostgres) GetAssetById(ctx context.Context, id int) (*entity.Asset, error) {
+	query := `
+		SELECT ` + assetColumns + assetFrom + `
+		WHERE a.id = $1
+	`
+	asset, err := scanAsset(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return asset, nil
+}
+
+// buildAssetFilter turns filter into a WHERE clause (empty when nothing is filtered) and its arguments.
+func buildAssetFilter(filter entity.AssetFilter) (string, []any) {
+	conditions := []string{}
+	args := []any{}
+
+	if filter.Type != "" {
+		args = append(args, filter.Type)
+		conditions = append(conditions, fmt.Sprintf("a.type = $%d", len(args)))
+	}
+	switch filter.Status {
+	case "":
+	case entity.AssetStatusAssigned:
+		conditions = append(conditions, "aa.id IS NOT NULL")
+	default:
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("aa.id IS NULL AND a.status = $%d", len(args)))
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func (r *AssetRepoPostgres) GetAssets(ctx context.Context, filter entity.AssetFilter) ([]*entity.Asset, error) {
+	where, args := buildAssetFilter(filter)
+	query := `
+		SELECT ` + assetColumns + assetFrom + `
+		` + where + `
+		ORDER BY a.name, a.id
+	`
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	assets := []*entity.Asset{}
+	for rows.Next() {
+		asset, err := scanAsset(rows)
+		if err != nil {
+			return nil, err
+		}
+		assets = append(assets, asset)
+	}
+	return assets, rows.Err()
+}
+
+func (r *AssetRepoPostgres) UpdateAsset(ctx context.Context, asset *entity.Asset) (*entity.Asset, error) {
+	query := `
+		UPDATE assets
+		SET name = $1,
+			type = $2,
+			serial_number = $3,
+			purchase_date = $4,
+			status = $5,
+			notes = NULLIF($6, ''),
+			updated_at = NOW()
+		WHERE id = $7
+	`
+	result, err := r.pool.Exec(ctx, query,
+		asset.Name,
+		asset.Type,
+		asset.SerialNumber,
+		asset.PurchaseDate,
+		asset.Status,
+		asset.Notes,
+		asset.ID,
+	)
+	if err != nil {
+		return nil, mapAssetWriteError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+	return r.GetAssetById(ctx, asset.ID)
+}
+
+func (r *AssetRepoPostgres) DeleteAsset(ctx context.Context, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM assets WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrAssetNotFound
+	}
+	return nil
+}
+
+func (r *AssetRepoPostgres) AssignAsset(ctx context.Context, assignment *entity.AssetAssignment) (*entity.AssetAssignment, error) {
+	// Only available assets are handed out; the open assignment index keeps two employees from
+	// getting the same asset at once.
+	query := `
+		INSERT INTO asset_assignments (asset_id, employee_id, assigned_on, notes)
+		SELECT id, $2, $3, NULLIF($4, '')
+		FROM assets
+		WHERE id = $1 AND status = $5
+		RETURNING id
+	`
+	var assignmentID int
+	err := r.pool.QueryRow(ctx, query,
+		assignment.AssetID,
+		assignment.EmployeeID,
+		assignment.AssignedOn,
+		assignment.Notes,
+		entity.AssetStatusAvailable,
+	).Scan(&assignmentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		if uniqueViolationConstraint(err) == "asset_assignments_open_key" {
+			return nil, appError.ErrAssetAssigned
+		}
+		if foreignKeyViolationConstraint(err) == "asset_assignments_employee_id_fkey" {
+			return nil, appError.ErrEmployeeNotFound
+		}
+		return nil, err
+	}
+	return getAssetAssignment(ctx, r.pool, assignmentID)
+}
+
+func (r *AssetRepoPostgres) ReturnAsset(ctx context.Context, assignment *entity.AssetAssignment,
+	status entity.AssetStatus) (*entity.AssetAssignment, error) {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var assignmentID int
+	err = tx.QueryRow(ctx, `
+		UPDATE asset_assignments
+		SET returned_on = $1,
+			return_notes = NULLIF($2, ''),
+			updated_at = NOW()
+		WHERE asset_id = $3 AND returned_on IS NULL
+		RETURNING id
+	`, assignment.ReturnedOn, assignment.ReturnNotes, assignment.AssetID).Scan(&assignmentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE assets
+		SET status = $1,
+			updated_at = NOW()
+		WHERE id = $2
+	`, status, assignment.AssetID)
+	if err != nil {
+		return nil, err
+	}
+
+	returnedAssignment, err := getAssetAssignment(ctx, tx, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return returnedAssignment, nil
+}
+
+// buildAssetAssignmentFilter turns filter into a WHERE clause (empty when nothing is filtered) and
+// its arguments.
+func buildAssetAssignmentFilter(filter entity.AssetAssignmentFilter) (string, []any) {
+	conditions := []string{}
+	args := []any{}
+
+	if filter.AssetID > 0 {
+		args = append(args, filter.AssetID)
+		conditions = append(conditions, fmt.Sprintf("aa.asset_id = $%d", len(args)))
+	}
+	if filter.EmployeeID > 0 {
+		args = append(args, filter.EmployeeID)
+		conditions = append(conditions, fmt.Sprintf("aa.employee_id = $%d", len(args)))
+	}
+	if filter.OpenOnly {
+		conditions = append(conditions, "aa.returned_on IS NULL")
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func (r *AssetRepoPostgres) GetAssignments(ctx context.Context, filter entity.AssetAssignmentFilter) ([]*entity.AssetAssignment, error) {
+	where, args := buildAssetAssignmentFilter(filter)
+	query := `
+		SELECT ` + assetAssignmentColumns + assetAssignmentFrom + `
+		` + where + `
+		ORDER BY aa.assigned_on DESC, aa.id DESC
+	`
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	assignments := []*entity.AssetAssignment{}
+	for rows.Next() {
+		assignment, err := scanAssetAssignment(rows)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, assignment)
+	}
+	return assignments, rows.Err()
+}
+
+func getAssetAssignment(ctx context.Context, q querier, id int) (*entity.AssetAssignment, error) {
+	query := `
+		SELECT ` + assetAssignmentColumns + assetAssignmentFrom + `
+		WHERE aa.id = $1
+	`
+	assignment, err := scanAssetAssignment(q.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return assignment, nil
+}
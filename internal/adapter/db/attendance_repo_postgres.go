@@ -0,0 +1,314 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const attendanceEntryColumns = `
+	id, employee_id, clock_in, clock_out, COALESCE(note, ''), created_at, updated_at`
+
+const timesheetColumns = `
+	id, employee_id, week_start, status, total_minutes, regular_minutes, overtime_minutes,
+	submitted_at, decided_by, decided_at, COALESCE(decision_comment, ''), created_at, updated_at`
+
+type AttendanceRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewAttendanceRepository(pool *pgxpool.Pool) repository.AttendanceRepository {
+	return &AttendanceRepoPostgres{pool: pool}
+}
+
+func scanAttendanceEntry(row pgx.Row) (*entity.AttendanceEntry, error) {
+	var entry entity.AttendanceEntry
+	err := row.Scan(
+		&entry.ID,
+		&entry.EmployeeID,
+		&entry.ClockIn,
+		&entry.ClockOut,
+		&entry.Note,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func scanTimesheet(row pgx.Row) (*entity.Timesheet, error) {
+	var timesheet entity.Timesheet
+	err := row.Scan(
+		&timesheet.ID,
+		&timesheet.EmployeeID,
+		&timesheet.WeekStart,
+		&timesheet.Status,
+		&timesheet.TotalMinutes,
+		&timesheet.RegularMinutes,
+		&timesheet.OvertimeMinutes,
+		&timesheet.SubmittedAt,
+		&timesheet.DecidedBy,
+		&timesheet.DecidedAt,
+		&timesheet.DecisionComment,
+		&timesheet.CreatedAt,
+		&timesheet.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &timesheet, nil
+}
+
+func mapAttendanceWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "attendance_entries_open_key" {
+		return appError.ErrAlreadyClockedIn
+	}
+	return err
+}
+
+func (r *AttendanceRepoPostgres) CreateAttendanceEntry(ctx context.Context,
+	entry *entity.AttendanceEntry) (*entity.AttendanceEntry, error) {
+
+	query := `
+		INSERT INTO attendance_entries (employee_id, clock_in, clock_out, note)
+		VALUES ($1, $2, $3, NULLIF($4, ''))
+		RETURNING ` + attendanceEntryColumns
+
+	createdEntry, err := scanAttendanceEntry(r.pool.QueryRow(ctx, query,
+		entry.EmployeeID,
+		entry.ClockIn,
+		entry.ClockOut,
+		entry.Note,
+	))
+	if err != nil {
+		return nil, mapAttendanceWriteError(err)
+	}
+	return createdEntry, nil
+}
+
+func (r *AttendanceRepoPostgres) GetAttendanceEntryById(ctx context.Context, employeeID, id int) (*entity.AttendanceEntry, error) {
+	query := `
+		SELECT ` + attendanceEntryColumns + `
+		FROM attendance_entries
+		WHERE id = $1 AND employee_id = $2
+	`
+	entry, err := scanAttendanceEntry(r.pool.QueryRow(ctx, query, id, employeeID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (r *AttendanceRepoPostgres) GetOpenAttendanceEntry(ctx context.Context, employeeID int) (*entity.AttendanceEntry, error) {
+	query := `
+		SELECT ` + attendanceEntryColumns + `
+		FROM attendance_entries
+		WHERE employee_id = $1 AND clock_out IS NULL
+	`
+	entry, err := scanAttendanceEntry(r.pool.QueryRow(ctx, query, employeeID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (r *AttendanceRepoPostgres) GetAttendanceEntries(ctx context.Context, employeeID int,
+	start, end time.Time) ([]*entity.AttendanceEntry, error) {
+
+	query := `
+		SELECT ` + attendanceEntryColumns + `
+		FROM attendance_entries
+		WHERE employee_id = $1 AND clock_in >= $2 AND clock_in < $3
+		ORDER BY clock_in
+	`
+	return r.queryAttendanceEntries(ctx, query, employeeID, start, end)
+}
+
+func (r *AttendanceRepoPostgres) GetOverlappingAttendanceEntries(ctx context.Context, employeeID, excludeID int,
+	start time.Time, end *time.Time) ([]*entity.AttendanceEntry, error) {
+
+	query := `
+		SELECT ` + attendanceEntryColumns + `
+		FROM attendance_entries
+		WHERE employee_id = $1
+			AND id <> $2
+			AND clock_in < COALESCE($4, 'infinity'::TIMESTAMP)
+			AND COALESCE(clock_out, 'infinity'::TIMESTAMP) > $3
+		ORDER BY clock_in
+	`
+	return r.queryAttendanceEntries(ctx, query, employeeID, excludeID, start, end)
+}
+
+func (r *AttendanceRepoPostgres) UpdateAttendanceEntry(ctx context.Context,
+	entry *entity.AttendanceEntry) (*entity.AttendanceEntry, error) {
+
+	query := `
+		UPDATE attendance_entries
+		SET clock_in = $1,
+			clock_out = $2,
+			note = NULLIF($3, ''),
+			updated_at = NOW()
+		WHERE id = $4 AND employee_id = $5
+		RETURNING ` + attendanceEntryColumns
+
+	updatedEntry, err := scanAttendanceEntry(r.pool.QueryRow(ctx, query,
+		entry.ClockIn,
+		entry.ClockOut,
+		entry.Note,
+		entry.ID,
+		entry.EmployeeID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, mapAttendanceWriteError(err)
+	}
+	return updatedEntry, nil
+}
+
+func (r *AttendanceRepoPostgres) GetOpenAttendanceEntriesBefore(ctx context.Context,
+	cutoff time.Time) ([]*entity.AttendanceEntry, error) {
+
+	query := `
+		SELECT ` + attendanceEntryColumns + `
+		FROM attendance_entries
+		WHERE clock_out IS NULL AND clock_in < $1
+		ORDER BY clock_in
+	`
+	return r.queryAttendanceEntries(ctx, query, cutoff)
+}
+
+func (r *AttendanceRepoPostgres) GetTimesheet(ctx context.Context, employeeID int, weekStart time.Time) (*entity.Timesheet, error) {
+	query := `
+		SELECT ` + timesheetColumns + `
+		FROM timesheets
+		WHERE employee_id = $1 AND week_start = $2
+	`
+	timesheet, err := scanTimesheet(r.pool.QueryRow(ctx, query, employeeID, weekStart))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return timesheet, nil
+}
+
+func (r *AttendanceRepoPostgres) GetTimesheets(ctx context.Context, employeeID int) ([]*entity.Timesheet, error) {
+	query := `
+		SELECT ` + timesheetColumns + `
+		FROM timesheets
+		WHERE employee_id = $1
+		ORDER BY week_start DESC
+	`
+	rows, err := r.pool.Query(ctx, query, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	timesheets := []*entity.Timesheet{}
+	for rows.Next() {
+		timesheet, err := scanTimesheet(rows)
+		if err != nil {
+			return nil, err
+		}
+		timesheets = append(timesheets, timesheet)
+	}
+	return timesheets, rows.Err()
+}
+
+func (r *AttendanceRepoPostgres) SubmitTimesheet(ctx context.Context, timesheet *entity.Timesheet) (*entity.Timesheet, error) {
+	query := `
+		INSERT INTO timesheets (employee_id, week_start, status, total_minutes, regular_minutes, overtime_minutes)
+		VALUES ($1, $2, 'submitted', $3, $4, $5)
+		ON CONFLICT ON CONSTRAINT timesheets_employee_week_key
+		DO UPDATE SET status = 'submitted',
+			total_minutes = EXCLUDED.total_minutes,
+			regular_minutes = EXCLUDED.regular_minutes,
+			overtime_minutes = EXCLUDED.overtime_minutes,
+			submitted_at = NOW(),
+			decided_by = NULL,
+			decided_at = NULL,
+			decision_comment = NULL,
+			updated_at = NOW()
+		WHERE timesheets.status = 'rejected'
+		RETURNING ` + timesheetColumns
+
+	submittedTimesheet, err := scanTimesheet(r.pool.QueryRow(ctx, query,
+		timesheet.EmployeeID,
+		timesheet.WeekStart,
+		timesheet.TotalMinutes,
+		timesheet.RegularMinutes,
+		timesheet.OvertimeMinutes,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return submittedTimesheet, nil
+}
+
+func (r *AttendanceRepoPostgres) DecideTimesheet(ctx context.Context, timesheet *entity.Timesheet) (*entity.Timesheet, error) {
+	query := `
+		UPDATE timesheets
+		SET status = $1,
+			decided_by = $2,
+			decided_at = NOW(),
+			decision_comment = NULLIF($3, ''),
+			updated_at = NOW()
+		WHERE id = $4 AND status = 'submitted'
+		RETURNING ` + timesheetColumns
+
+	decidedTimesheet, err := scanTimesheet(r.pool.QueryRow(ctx, query,
+		timesheet.Status,
+		timesheet.DecidedBy,
+		timesheet.DecisionComment,
+		timesheet.ID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return decidedTimesheet, nil
+}
+
+func (r *AttendanceRepoPostgres) queryAttendanceEntries(ctx context.Context, query string,
+	args ...any) ([]*entity.AttendanceEntry, error) {
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []*entity.AttendanceEntry{}
+	for rows.Next() {
+		entry, err := scanAttendanceEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
@@ -0,0 +1,680 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// benefitPlanColumns selects a plan p with the locations it is limited to.
+const benefitPlanColumns = `
+	p.id, p.name, p.type, COALESCE(p.description, ''), p.currency, p.employee_contribution,
+	p.employer_contribution, p.covers_dependents, p.dependent_contribution, p.eligible_statuses,
+	p.min_tenure_months,
+	ARRAY(SELECT l.location_id FROM benefit_plan_locations l WHERE l.plan_id = p.id ORDER BY l.location_id),
+	p.created_at, p.updated_at`
+
+const benefitWindowColumns = `
+	id, name, starts_on, ends_on, coverage_starts_on, created_at`
+
+const dependentColumns = `
+	id, employee_id, name, relationship, date_of_birth, created_at, updated_at`
+
+// benefitEnrollmentColumns selects an enrollment be with its employee e and plan p, joined by
+// benefitEnrollmentFrom.
+const benefitEnrollmentColumns = `
+	be.id, be.employee_id, e.name, be.plan_id, be.window_id, be.coverage_starts_on, be.coverage_ends_on,
+	ARRAY(SELECT d.dependent_id FROM benefit_enrollment_dependents d WHERE d.enrollment_id = be.id ORDER BY d.dependent_id),
+	be.created_at, be.updated_at,` + benefitPlanColumns
+
+const benefitEnrollmentFrom = `
+	FROM benefit_enrollments be
+	JOIN employees e ON e.id = be.employee_id
+	JOIN benefit_plans p ON p.id = be.plan_id`
+
+type BenefitRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewBenefitRepository(pool *pgxpool.Pool) repository.BenefitRepository {
+	return &BenefitRepoPostgres{pool: pool}
+}
+
+// benefitPlanFields returns the scan targets of benefitPlanColumns. finish converts the scanned
+// statuses once the row has been scanned.
+func benefitPlanFields(plan *entity.BenefitPlan) (fields []any, finish func()) {
+	var statuses []string
+	fields = []any{
+		&plan.ID,
+		&plan.Name,
+		&plan.Type,
+		&plan.Description,
+		&plan.Currency,
+		&plan.EmployeeContribution,
+		&plan.EmployerContribution,
+		&plan.CoversDependents,
+		&plan.DependentContribution,
+		&statuses,
+		&plan.Eligibility.MinTenureMonths,
+		&plan.Eligibility.LocationIDs,
+		&plan.CreatedAt,
+		&plan.UpdatedAt,
+	}
+	finish = func() {
+		plan.Eligibility.Statuses = make([]entity.EmploymentStatus, 0, len(statuses))
+		for _, status := range statuses {
+			plan.Eligibility.Statuses = append(plan.Eligibility.Statuses, entity.EmploymentStatus(status))
+		}
+	}
+	return fields, finish
+}
+
+func scanBenefitPlan(row pgx.Row) (*entity.BenefitPlan, error) {
+	var plan entity.BenefitPlan
+	fields, finish := benefitPlanFields(&plan)
+	if err := row.Scan(fields...); err != nil {
+		return nil, err
+	}
+	finish()
+	return &plan, nil
+}
+
+func scanBenefitWindow(row pgx.Row) (*entity.BenefitEnrollmentWindow, error) {
+	var window entity.BenefitEnrollmentWindow
+	err := row.Scan(
+		&window.ID,
+		&window.Name,
+		&window.StartsOn,
+		&window.EndsOn,
+		&window.CoverageStartsOn,
+		&window.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &window, nil
+}
+
+func scanDependent(row pgx.Row) (*entity.Dependent, error) {
+	var dependent entity.Dependent
+	err := row.Scan(
+		&dependent.ID,
+		&dependent.EmployeeID,
+		&dependent.Name,
+		&dependent.Relationship,
+		&dependent.DateOfBirth,
+		&dependent.CreatedAt,
+		&dependent.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &dependent, nil
+}
+
+func scanBenefitEnrollment(row pgx.Row) (*entity.BenefitEnrollment, error) {
+	enrollment := entity.BenefitEnrollment{Plan: &entity.BenefitPlan{}}
+	planFields, finish := benefitPlanFields(enrollment.Plan)
+	fields := append([]any{
+		&enrollment.ID,
+		&enrollment.EmployeeID,
+		&enrollment.EmployeeName,
+		&enrollment.PlanID,
+		&enrollment.WindowID,
+		&enrollment.CoverageStartsOn,
+		&enrollment.CoverageEndsOn,
+		&enrollment.DependentIDs,
+		&enrollment.CreatedAt,
+		&enrollment.UpdatedAt,
+	}, planFields...)
+	if err := row.Scan(fields...); err != nil {
+		return nil, err
+	}
+	finish()
+	return &enrollment, nil
+}
+
+func mapBenefitPlanWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "benefit_plans_name_key" {
+		return appError.ErrBenefitPlanAlreadyExists
+	}
+	if foreignKeyViolationConstraint(err) == "benefit_plan_locations_location_id_fkey" {
+		return appError.ErrLocationNotFound
+	}
+	return err
+}
+
+func eligibleStatusStrings(statuses []entity.EmploymentStatus) []string {
+	values := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		values = append(values, string(status))
+	}
+	return values
+}
+
+func (r *BenefitRepoPostgres) CreatePlan(ctx context.Context, plan *entity.BenefitPlan) (*entity.BenefitPlan, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var planID int
+	err = tx.QueryRow(ctx, `
+		INSERT INTO benefit_plans (name, type, description, currency, employee_contribution, employer_contribution,
+			covers_dependents, dependent_contribution, eligible_statuses, min_tenure_months)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id
+	`,
+		plan.Name,
+		plan.Type,
+		plan.Description,
+		plan.Currency,
+		plan.EmployeeContribution,
+		plan.EmployerContribution,
+		plan.CoversDependents,
+		plan.DependentContribution,
+		eligibleStatusStrings(plan.Eligibility.Statuses),
+		plan.Eligibility.MinTenureMonths,
+	).Scan(&planID)
+	if err != nil {
+		return nil, mapBenefitPlanWriteError(err)
+	}
+	if err := insertBenefitPlanLocations(ctx, tx, planID, plan.Eligibility.LocationIDs); err != nil {
+		return nil, mapBenefitPlanWriteError(err)
+	}
+	createdPlan, err := scanBenefitPlan(tx.QueryRow(ctx, `SELECT `+benefitPlanColumns+` FROM benefit_plans p WHERE p.id = $1`, planID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return createdPlan, nil
+}
+
+func (r *BenefitRepoPostgres) GetPlanById(ctx context.Context, id int) (*entity.BenefitPlan, error) {
+	query := `
+		SELECT ` + benefitPlanColumns + `
+		FROM benefit_plans p
+		WHERE p.id = $1
+	`
+	plan, err := scanBenefitPlan(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (r *BenefitRepoPostgres) GetAllPlans(ctx context.Context) ([]*entity.BenefitPlan, error) {
+	query := `
+		SELECT ` + benefitPlanColumns + `
+		FROM benefit_plans p
+		ORDER BY p.type, p.name
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	plans := []*entity.BenefitPlan{}
+	for rows.Next() {
+		plan, err := scanBenefitPlan(rows)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+	return plans, rows.Err()
+}
+
+func (r *BenefitRepoPostgres) UpdatePlan(ctx context.Context, plan *entity.BenefitPlan) (*entity.BenefitPlan, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		UPDATE benefit_plans
+		SET name = $1,
+			type = $2,
+			description = NULLIF($3, ''),
+			currency = $4,
+			employee_contribution = $5,
+			employer_contribution = $6,
+			covers_dependents = $7,
+			dependent_contribution = $8,
+			eligible_statuses = $9,
+			min_tenure_months = $10,
+			updated_at = NOW()
+		WHERE id = $11
+	`,
+		plan.Name,
+		plan.Type,
+		plan.Description,
+		plan.Currency,
+		plan.EmployeeContribution,
+		plan.EmployerContribution,
+		plan.CoversDependents,
+		plan.DependentContribution,
+		eligibleStatusStrings(plan.Eligibility.Statuses),
+		plan.Eligibility.MinTenureMonths,
+		plan.ID,
+	)
+	if err != nil {
+		return nil, mapBenefitPlanWriteError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM benefit_plan_locations WHERE plan_id = $1`, plan.ID); err != nil {
+		return nil, err
+	}
+	if err := insertBenefitPlanLocations(ctx, tx, plan.ID, plan.Eligibility.LocationIDs); err != nil {
+		return nil, mapBenefitPlanWriteError(err)
+	}
+	updatedPlan, err := scanBenefitPlan(tx.QueryRow(ctx, `SELECT `+benefitPlanColumns+` FROM benefit_plans p WHERE p.id = $1`, plan.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return updatedPlan, nil
+}
+
+func (r *BenefitRepoPostgres) DeletePlan(ctx context.Context, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM benefit_plans WHERE id = $1`, id)
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "benefit_enrollments_plan_id_fkey" {
+			return appError.ErrBenefitPlanInUse
+		}
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrBenefitPlanNotFound
+	}
+	return nil
+}
+
+func (r *BenefitRepoPostgres) CreateWindow(ctx context.Context, window *entity.BenefitEnrollmentWindow) (*entity.BenefitEnrollmentWindow, error) {
+	query := `
+		INSERT INTO benefit_enrollment_windows (name, starts_on, ends_on, coverage_starts_on)
+		VALUES ($1, $2, $3, $4)
+		RETURNING ` + benefitWindowColumns
+
+	return scanBenefitWindow(r.pool.QueryRow(ctx, query,
+		window.Name,
+		window.StartsOn,
+		window.EndsOn,
+		window.CoverageStartsOn,
+	))
+}
+
+func (r *BenefitRepoPostgres) GetWindowById(ctx context.Context, id int) (*entity.BenefitEnrollmentWindow, error) {
+	query := `
+		SELECT ` + benefitWindowColumns + `
+		FROM benefit_enrollment_windows
+		WHERE id = $1
+	`
+	window, err := scanBenefitWindow(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return window, nil
+}
+
+func (r *BenefitRepoPostgres) GetWindows(ctx context.Context) ([]*entity.BenefitEnrollmentWindow, error) {
+	query := `
+		SELECT ` + benefitWindowColumns + `
+		FROM benefit_enrollment_windows
+		ORDER BY starts_on DESC
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	windows := []*entity.BenefitEnrollmentWindow{}
+	for rows.Next() {
+		window, err := scanBenefitWindow(rows)
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, window)
+	}
+	return windows, rows.Err()
+}
+
+func (r *BenefitRepoPostgres) GetOverlappingWindow(ctx context.Context, from, to time.Time) (*entity.BenefitEnrollmentWindow, error) {
+	query := `
+		SELECT ` + benefitWindowColumns + `
+		FROM benefit_enrollment_windows
+		WHERE starts_on <= $2 AND ends_on >= $1
+		ORDER BY starts_on
+		LIMIT 1
+	`
+	window, err := scanBenefitWindow(r.pool.QueryRow(ctx, query, from, to))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return window, nil
+}
+
+func (r *BenefitRepoPostgres) DeleteWindow(ctx context.Context, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM benefit_enrollment_windows WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrEnrollmentWindowNotFound
+	}
+	return nil
+}
+
+func (r *BenefitRepoPostgres) CreateDependent(ctx context.Context, dependent *entity.Dependent) (*entity.Dependent, error) {
+	query := `
+		INSERT INTO benefit_dependents (employee_id, name, relationship, date_of_birth)
+		VALUES ($1, $2, $3, $4)
+		RETURNING ` + dependentColumns
+
+	createdDependent, err := scanDependent(r.pool.QueryRow(ctx, query,
+		dependent.EmployeeID,
+		dependent.Name,
+		dependent.Relationship,
+		dependent.DateOfBirth,
+	))
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "benefit_dependents_employee_id_fkey" {
+			return nil, appError.ErrEmployeeNotFound
+		}
+		return nil, err
+	}
+	return createdDependent, nil
+}
+
+func (r *BenefitRepoPostgres) GetDependentById(ctx context.Context, employeeID, id int) (*entity.Dependent, error) {
+	query := `
+		SELECT ` + dependentColumns + `
+		FROM benefit_dependents
+		WHERE id = $1 AND employee_id = $2
+	`
+	dependent, err := scanDependent(r.pool.QueryRow(ctx, query, id, employeeID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return dependent, nil
+}
+
+func (r *BenefitRepoPostgres) GetDependents(ctx context.Context, employeeID int) ([]*entity.Dependent, error) {
+	query := `
+		SELECT ` + dependentColumns + `
+		FROM benefit_dependents
+		WHERE employee_id = $1
+		ORDER BY name, id
+	`
+	rows, err := r.pool.Query(ctx, query, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dependents := []*entity.Dependent{}
+	for rows.Next() {
+		dependent, err := scanDependent(rows)
+		if err != nil {
+			return nil, err
+		}
+		dependents = append(dependents, dependent)
+	}
+	return dependents, rows.Err()
+}
+
+func (r *BenefitRepoPostgres) UpdateDependent(ctx context.Context, dependent *entity.Dependent) (*entity.Dependent, error) {
+	query := `
+		UPDATE benefit_dependents
+		SET name = $1,
+			relationship = $2,
+			date_of_birth = $3,
+			updated_at = NOW()
+		WHERE id = $4 AND employee_id = $5
+		RETURNING ` + dependentColumns
+
+	updatedDependent, err := scanDependent(r.pool.QueryRow(ctx, query,
+		dependent.Name,
+		dependent.Relationship,
+		dependent.DateOfBirth,
+		dependent.ID,
+		dependent.EmployeeID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return updatedDependent, nil
+}
+
+func (r *BenefitRepoPostgres) DeleteDependent(ctx context.Context, employeeID, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM benefit_dependents WHERE id = $1 AND employee_id = $2`, id, employeeID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrDependentNotFound
+	}
+	return nil
+}
+
+func (r *BenefitRepoPostgres) CreateEnrollment(ctx context.Context, enrollment *entity.BenefitEnrollment) (*entity.BenefitEnrollment, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var enrollmentID int
+	err = tx.QueryRow(ctx, `
+		INSERT INTO benefit_enrollments (employee_id, plan_id, window_id, coverage_starts_on)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`, enrollment.EmployeeID, enrollment.PlanID, enrollment.WindowID, enrollment.CoverageStartsOn).Scan(&enrollmentID)
+	if err != nil {
+		if uniqueViolationConstraint(err) == "benefit_enrollments_open_key" {
+			return nil, appError.ErrBenefitAlreadyEnrolled
+		}
+		switch foreignKeyViolationConstraint(err) {
+		case "benefit_enrollments_plan_id_fkey":
+			return nil, appError.ErrBenefitPlanNotFound
+		case "benefit_enrollments_employee_id_fkey":
+			return nil, appError.ErrEmployeeNotFound
+		}
+		return nil, err
+	}
+
+	for _, dependentID := range enrollment.DependentIDs {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO benefit_enrollment_dependents (enrollment_id, dependent_id)
+			VALUES ($1, $2)
+		`, enrollmentID, dependentID)
+		if err != nil {
+			if foreignKeyViolationConstraint(err) == "benefit_enrollment_dependents_dependent_id_fkey" {
+				return nil, appError.ErrDependentNotFound
+			}
+			return nil, err
+		}
+	}
+	createdEnrollment, err := getBenefitEnrollment(ctx, tx, enrollment.EmployeeID, enrollmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return createdEnrollment, nil
+}
+
+func (r *BenefitRepoPostgres) GetEnrollmentById(ctx context.Context, employeeID, id int) (*entity.BenefitEnrollment, error) {
+	enrollment, err := getBenefitEnrollment(ctx, r.pool, employeeID, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return enrollment, nil
+}
+
+func (r *BenefitRepoPostgres) GetEnrollments(ctx context.Context, employeeID int) ([]*entity.BenefitEnrollment, error) {
+	query := `
+		SELECT ` + benefitEnrollmentColumns + benefitEnrollmentFrom + `
+		WHERE be.employee_id = $1
+		ORDER BY be.coverage_starts_on DESC, be.id DESC
+	`
+	rows, err := r.pool.Query(ctx, query, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	enrollments := []*entity.BenefitEnrollment{}
+	for rows.Next() {
+		enrollment, err := scanBenefitEnrollment(rows)
+		if err != nil {
+			return nil, err
+		}
+		enrollments = append(enrollments, enrollment)
+	}
+	return enrollments, rows.Err()
+}
+
+func (r *BenefitRepoPostgres) EndEnrollment(ctx context.Context, employeeID, id int,
+	coverageEndsOn time.Time) (*entity.BenefitEnrollment, error) {
+
+	result, err := r.pool.Exec(ctx, `
+		UPDATE benefit_enrollments
+		SET coverage_ends_on = $1,
+			updated_at = NOW()
+		WHERE id = $2 AND employee_id = $3 AND coverage_ends_on IS NULL
+	`, coverageEndsOn, id, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+	return r.GetEnrollmentById(ctx, employeeID, id)
+}
+
+func (r *BenefitRepoPostgres) DeleteEnrollment(ctx context.Context, employeeID, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM benefit_enrollments WHERE id = $1 AND employee_id = $2`, id, employeeID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrBenefitEnrollmentNotFound
+	}
+	return nil
+}
+
+func (r *BenefitRepoPostgres) GetDeductions(ctx context.Context, from, to time.Time,
+	employeeID int) ([]*entity.BenefitDeduction, error) {
+
+	query := `
+		SELECT be.id, be.employee_id, e.name, p.id, p.name, p.type, p.currency,
+			(SELECT COUNT(*) FROM benefit_enrollment_dependents d WHERE d.enrollment_id = be.id),
+			p.employee_contribution, p.dependent_contribution, p.employer_contribution
+		` + benefitEnrollmentFrom + `
+		WHERE be.coverage_starts_on <= $2
+			AND (be.coverage_ends_on IS NULL OR be.coverage_ends_on >= $1)
+			AND e.hired_date <= $2
+			AND (e.termination_date IS NULL OR e.termination_date >= $1)
+			AND ($3 = 0 OR be.employee_id = $3)
+		ORDER BY e.name, be.employee_id, p.type, p.name
+	`
+	rows, err := r.pool.Query(ctx, query, from, to, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deductions := []*entity.BenefitDeduction{}
+	for rows.Next() {
+		var deduction entity.BenefitDeduction
+		var currency string
+		var employeeContribution, dependentContribution, employerContribution int64
+		err := rows.Scan(
+			&deduction.EnrollmentID,
+			&deduction.EmployeeID,
+			&deduction.EmployeeName,
+			&deduction.PlanID,
+			&deduction.PlanName,
+			&deduction.PlanType,
+			&currency,
+			&deduction.DependentCount,
+			&employeeContribution,
+			&dependentContribution,
+			&employerContribution,
+		)
+		if err != nil {
+			return nil, err
+		}
+		deduction.EmployeeContribution = entity.Money{
+			Amount:   employeeContribution + int64(deduction.DependentCount)*dependentContribution,
+			Currency: currency,
+		}
+		deduction.EmployerContribution = entity.Money{Amount: employerContribution, Currency: currency}
+		deductions = append(deductions, &deduction)
+	}
+	return deductions, rows.Err()
+}
+
+func insertBenefitPlanLocations(ctx context.Context, tx pgx.Tx, planID int, locationIDs []int) error {
+	for _, locationID := range locationIDs {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO benefit_plan_locations (plan_id, location_id)
+			VALUES ($1, $2)
+			ON CONFLICT DO NOTHING
+		`, planID, locationID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getBenefitEnrollment returns an enrollment of the employee with its plan, or pgx.ErrNoRows.
+func getBenefitEnrollment(ctx context.Context, q querier, employeeID, id int) (*entity.BenefitEnrollment, error) {
+	query := `
+		SELECT ` + benefitEnrollmentColumns + benefitEnrollmentFrom + `
+		WHERE be.id = $1 AND be.employee_id = $2
+	`
+	return scanBenefitEnrollment(q.QueryRow(ctx, query, id, employeeID))
+}
@@ -0,0 +1,322 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// certificationColumns selects a certification c with the name of its holder e and the number of
+// evidence files, joined by certificationFrom.
+const certificationColumns = `
+	c.id, c.employee_id, e.name, c.name, COALESCE(c.issuer, ''), COALESCE(c.credential_id, ''), c.issued_on,
+	c.expires_on, c.enrollment_id, c.expiry_notified_on,
+	(SELECT COUNT(*) FROM certification_evidence ce WHERE ce.certification_id = c.id), c.created_at, c.updated_at`
+
+const certificationFrom = `
+	FROM certifications c
+	JOIN employees e ON e.id = c.employee_id`
+
+const evidenceColumns = `id, certification_id, file_name, content_type, size_bytes, uploaded_at`
+
+type CertificationRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewCertificationRepository(pool *pgxpool.Pool) repository.CertificationRepository {
+	return &CertificationRepoPostgres{pool: pool}
+}
+
+func scanCertification(row pgx.Row) (*entity.Certification, error) {
+	var certification entity.Certification
+	err := row.Scan(
+		&certification.ID,
+		&certification.EmployeeID,
+		&certification.EmployeeName,
+		&certification.Name,
+		&certification.Issuer,
+		&certification.CredentialID,
+		&certification.IssuedOn,
+		&certification.ExpiresOn,
+		&certification.EnrollmentID,
+		&certification.ExpiryNotifiedOn,
+		&certification.EvidenceCount,
+		&certification.CreatedAt,
+		&certification.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &certification, nil
+}
+
+func scanEvidence(row pgx.Row) (*entity.CertificationEvidence, error) {
+	var evidence entity.CertificationEvidence
+	err := row.Scan(
+		&evidence.ID,
+		&evidence.CertificationID,
+		&evidence.FileName,
+		&evidence.ContentType,
+		&evidence.Size,
+		&evidence.UploadedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &evidence, nil
+}
+
+// insertCertification adds the certification and returns its id. Training completion issues
+// certifications inside its own transaction, hence the querier.
+func insertCertification(ctx context.Context, q querier, certification *entity.Certification) (int, error) {
+	query := `
+		INSERT INTO certifications (employee_id, name, issuer, credential_id, issued_on, expires_on, enrollment_id)
+		VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), $5, $6, $7)
+		RETURNING id
+	`
+	var certificationID int
+	err := q.QueryRow(ctx, query,
+		certification.EmployeeID,
+		certification.Name,
+		certification.Issuer,
+		certification.CredentialID,
+		certification.IssuedOn,
+		certification.ExpiresOn,
+		certification.EnrollmentID,
+	).Scan(&certificationID)
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "certifications_employee_id_fkey" {
+			return 0, appError.ErrEmployeeNotFound
+		}
+		return 0, err
+	}
+	return certificationID, nil
+}
+
+func (r *CertificationRepoPostgres) CreateCertification(ctx context.Context, certification *entity.Certification) (*entity.Certification, error) {
+	certificationID, err := insertCertification(ctx, r.pool, certification)
+	if err != nil {
+		return nil, err
+	}
+	return r.GetCertificationById(ctx, certificationID)
+}
+
+func (r *CertificationRepoPostgres) GetCertificationById(ctx context.Context, id int) (*entity.Certification, error) {
+	query := `
+		SELECT ` + certificationColumns + certificationFrom + `
+		WHERE c.id = $1
+	`
+	certification, err := scanCertification(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return certification, nil
+}
+
+// buildCertificationFilter turns filter into a WHERE clause (empty when nothing is filtered) and its arguments.
+func buildCertificationFilter(filter entity.CertificationFilter) (string, []any) {
+	conditions := []string{}
+	args := []any{}
+
+	if filter.EmployeeID > 0 {
+		args = append(args, filter.EmployeeID)
+		conditions = append(conditions, fmt.Sprintf("c.employee_id = $%d", len(args)))
+	}
+	if filter.ExpiresFrom != nil {
+		args = append(args, *filter.ExpiresFrom)
+		conditions = append(conditions, fmt.Sprintf("c.expires_on >= $%d", len(args)))
+	}
+	if filter.ExpiresTo != nil {
+		args = append(args, *filter.ExpiresTo)
+		conditions = append(conditions, fmt.Sprintf("c.expires_on <= $%d", len(args)))
+	}
+	if filter.NotNotified {
+		conditions = append(conditions, "c.expiry_notified_on IS NULL")
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			statuses = append(statuses, string(status))
+		}
+		args = append(args, statuses)
+		conditions = append(conditions, fmt.Sprintf("e.status = ANY($%d)", len(args)))
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func (r *CertificationRepoPostgres) GetCertifications(ctx context.Context, filter entity.CertificationFilter) ([]*entity.Certification, error) {
+	where, args := buildCertificationFilter(filter)
+	query := `
+		SELECT ` + certificationColumns + certificationFrom + `
+		` + where + `
+		ORDER BY c.expires_on NULLS LAST, e.name, c.name
+	`
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	certifications := []*entity.Certification{}
+	for rows.Next() {
+		certification, err := scanCertification(rows)
+		if err != nil {
+			return nil, err
+		}
+		certifications = append(certifications, certification)
+	}
+	return certifications, rows.Err()
+}
+
+func (r *CertificationRepoPostgres) UpdateCertification(ctx context.Context, certification *entity.Certification) (*entity.Certification, error) {
+	// Every SET expression sees the old row, so the notice is cleared against the previous expiry.
+	query := `
+		UPDATE certifications
+		SET name = $1,
+			issuer = NULLIF($2, ''),
+			credential_id = NULLIF($3, ''),
+			issued_on = $4,
+			expires_on = $5,
+			expiry_notified_on = CASE WHEN expires_on IS DISTINCT FROM $5 THEN NULL ELSE expiry_notified_on END,
+			updated_at = NOW()
+		WHERE id = $6
+	`
+	result, err := r.pool.Exec(ctx, query,
+		certification.Name,
+		certification.Issuer,
+		certification.CredentialID,
+		certification.IssuedOn,
+		certification.ExpiresOn,
+		certification.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+	return r.GetCertificationById(ctx, certification.ID)
+}
+
+func (r *CertificationRepoPostgres) DeleteCertification(ctx context.Context, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM certifications WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrCertificationNotFound
+	}
+	return nil
+}
+
+func (r *CertificationRepoPostgres) MarkExpiryNotified(ctx context.Context, id int, notifiedOn time.Time) error {
+	query := `
+		UPDATE certifications
+		SET expiry_notified_on = $1
+		WHERE id = $2
+	`
+	_, err := r.pool.Exec(ctx, query, notifiedOn, id)
+	return err
+}
+
+func (r *CertificationRepoPostgres) AddEvidence(ctx context.Context, evidence *entity.CertificationEvidence) (*entity.CertificationEvidence, error) {
+	query := `
+		INSERT INTO certification_evidence (certification_id, file_name, content_type, size_bytes, content)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + evidenceColumns
+
+	addedEvidence, err := scanEvidence(r.pool.QueryRow(ctx, query,
+		evidence.CertificationID,
+		evidence.FileName,
+		evidence.ContentType,
+		evidence.Size,
+		evidence.Content,
+	))
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "certification_evidence_certification_id_fkey" {
+			return nil, appError.ErrCertificationNotFound
+		}
+		return nil, err
+	}
+	return addedEvidence, nil
+}
+
+func (r *CertificationRepoPostgres) GetEvidence(ctx context.Context, certificationID int) ([]*entity.CertificationEvidence, error) {
+	query := `
+		SELECT ` + evidenceColumns + `
+		FROM certification_evidence
+		WHERE certification_id = $1
+		ORDER BY uploaded_at, id
+	`
+	rows, err := r.pool.Query(ctx, query, certificationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	evidence := []*entity.CertificationEvidence{}
+	for rows.Next() {
+		file, err := scanEvidence(rows)
+		if err != nil {
+			return nil, err
+		}
+		evidence = append(evidence, file)
+	}
+	return evidence, rows.Err()
+}
+
+func (r *CertificationRepoPostgres) GetEvidenceById(ctx context.Context, certificationID, id int) (*entity.CertificationEvidence, error) {
+	query := `
+		SELECT ` + evidenceColumns + `, content
+		FROM certification_evidence
+		WHERE certification_id = $1 AND id = $2
+	`
+	var evidence entity.CertificationEvidence
+	err := r.pool.QueryRow(ctx, query, certificationID, id).Scan(
+		&evidence.ID,
+		&evidence.CertificationID,
+		&evidence.FileName,
+		&evidence.ContentType,
+		&evidence.Size,
+		&evidence.UploadedAt,
+		&evidence.Content,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &evidence, nil
+}
+
+func (r *CertificationRepoPostgres) DeleteEvidence(ctx context.Context, certificationID, id int) error {
+	query := `
+		DELETE FROM certification_evidence
+		WHERE certification_id = $1 AND id = $2
+	`
+	result, err := r.pool.Exec(ctx, query, certificationID, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrEvidenceNotFound
+	}
+	return nil
+}
@@ -0,0 +1,685 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const checklistTemplateColumns = `
+	t.id, t.name, t.kind, t.position_id, t.department_id, t.created_at, t.updated_at`
+
+const checklistTemplateTaskColumns = `
+	id, template_id, title, COALESCE(description, ''), owner_role, owner_id, due_days`
+
+// checklistColumns selects a checklist c with its employee's name from e, joined by checklistFrom.
+const checklistColumns = `
+	c.id, c.employee_id, e.name, c.template_id, c.kind, c.name, c.start_date, c.created_at, c.completed_at`
+
+const checklistFrom = `
+	FROM checklists c
+	JOIN employees e ON e.id = c.employee_id`
+
+// checklistTaskColumns selects a task t with its checklist c, the checklist's employee e and the
+// task's owner o, joined by checklistTaskFrom.
+const checklistTaskColumns = `
+	t.id, t.checklist_id, c.kind, c.employee_id, e.name, t.title, COALESCE(t.description, ''),
+	t.owner_id, COALESCE(o.name, ''), t.due_on, t.completed_at, t.created_at, t.updated_at`
+
+const checklistTaskFrom = `
+	FROM checklist_tasks t
+	JOIN checklists c ON c.id = t.checklist_id
+	JOIN employees e ON e.id = c.employee_id
+	LEFT JOIN employees o ON o.id = t.owner_id`
+
+type ChecklistRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewChecklistRepository(pool *pgxpool.Pool) repository.ChecklistRepository {
+	return &ChecklistRepoPostgres{pool: pool}
+}
+
+func scanChecklistTemplate(row pgx.Row) (*entity.ChecklistTemplate, error) {
+	var template entity.ChecklistTemplate
+	err := row.Scan(
+		&template.ID,
+		&template.Name,
+		&template.Kind,
+		&template.PositionID,
+		&template.DepartmentID,
+		&template.CreatedAt,
+		&template.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	template.Tasks = []entity.ChecklistTemplateTask{}
+	return &template, nil
+}
+
+func scanChecklistTemplateTask(row pgx.Row) (*entity.ChecklistTemplateTask, error) {
+	var task entity.ChecklistTemplateTask
+	err := row.Scan(
+		&task.ID,
+		&task.TemplateID,
+		&task.Title,
+		&task.Description,
+		&task.OwnerRole,
+		&task.OwnerID,
+		&task.DueDays,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+func scanChecklist(row pgx.Row) (*entity.Checklist, error) {
+	var checklist entity.Checklist
+	err := row.Scan(
+		&checklist.ID,
+		&checklist.EmployeeID,
+		&checklist.EmployeeName,
+		&checklist.TemplateID,
+		&checklist.Kind,
+		&checklist.Name,
+		&checklist.StartDate,
+		&checklist.CreatedAt,
+		&checklist.CompletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	checklist.Tasks = []entity.ChecklistTask{}
+	return &checklist, nil
+}
+
+func scanChecklistTask(row pgx.Row) (*entity.ChecklistTask, error) {
+	var task entity.ChecklistTask
+	err := row.Scan(
+		&task.ID,
+		&task.ChecklistID,
+		&task.ChecklistKind,
+		&task.EmployeeID,
+		&task.EmployeeName,
+		&task.Title,
+		&task.Description,
+		&task.OwnerID,
+		&task.OwnerName,
+		&task.DueOn,
+		&task.CompletedAt,
+		&task.CreatedAt,
+		&task.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+func mapChecklistTemplateWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "checklist_templates_scope_key" {
+		return appError.ErrChecklistTemplateExists
+	}
+	switch foreignKeyViolationConstraint(err) {
+	case "checklist_templates_position_id_fkey":
+		return appError.ErrPositionNotFound
+	case "checklist_templates_department_id_fkey":
+		return appError.ErrDepartmentNotFound
+	case "checklist_template_tasks_owner_id_fkey":
+		return appError.ErrEmployeeNotFound
+	}
+	return err
+}
+
+func mapChecklistWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "checklists_open_key" {
+		return appError.ErrChecklistAlreadyOpen
+	}
+	switch foreignKeyViolationConstraint(err) {
+	case "checklists_employee_id_fkey", "checklist_tasks_owner_id_fkey":
+		return appError.ErrEmployeeNotFound
+	}
+	return err
+}
+
+func (r *ChecklistRepoPostgres) CreateTemplate(ctx context.Context, template *entity.ChecklistTemplate) (*entity.ChecklistTemplate, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var templateID int
+	err = tx.QueryRow(ctx, `
+		INSERT INTO checklist_templates (name, kind, position_id, department_id)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`,
+		template.Name,
+		template.Kind,
+		template.PositionID,
+		template.DepartmentID,
+	).Scan(&templateID)
+	if err != nil {
+		return nil, mapChecklistTemplateWriteError(err)
+	}
+
+	if err := insertChecklistTemplateTasks(ctx, tx, templateID, template.Tasks); err != nil {
+		return nil, err
+	}
+	createdTemplate, err := getChecklistTemplate(ctx, tx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return createdTemplate, nil
+}
+
+func (r *ChecklistRepoPostgres) GetTemplateById(ctx context.Context, id int) (*entity.ChecklistTemplate, error) {
+	template, err := getChecklistTemplate(ctx, r.pool, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return template, nil
+}
+
+func (r *ChecklistRepoPostgres) GetTemplates(ctx context.Context, kind entity.ChecklistKind) ([]*entity.ChecklistTemplate, error) {
+	where, args := "", []any{}
+	if kind != "" {
+		where, args = "WHERE t.kind = $1", append(args, kind)
+	}
+	query := `
+		SELECT ` + checklistTemplateColumns + `
+		FROM checklist_templates t
+		` + where + `
+		ORDER BY t.kind, t.name, t.id
+	`
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	templates := []*entity.ChecklistTemplate{}
+	templatesByID := map[int]*entity.ChecklistTemplate{}
+	for rows.Next() {
+		template, err := scanChecklistTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+		templatesByID[template.ID] = template
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(templates) == 0 {
+		return templates, nil
+	}
+
+	ids := make([]int, 0, len(templates))
+	for _, template := range templates {
+		ids = append(ids, template.ID)
+	}
+	tasks, err := queryChecklistTemplateTasks(ctx, r.pool, `template_id = ANY($1)`, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		template := templatesByID[task.TemplateID]
+		template.Tasks = append(template.Tasks, *task)
+	}
+	return templates, nil
+}
+
+func (r *ChecklistRepoPostgres) UpdateTemplate(ctx context.Context, template *entity.ChecklistTemplate) (*entity.ChecklistTemplate, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		UPDATE checklist_templates
+		SET name = $1,
+			kind = $2,
+			position_id = $3,
+			department_id = $4,
+			updated_at = NOW()
+		WHERE id = $5
+	`,
+		template.Name,
+		template.Kind,
+		template.PositionID,
+		template.DepartmentID,
+		template.ID,
+	)
+	if err != nil {
+		return nil, mapChecklistTemplateWriteError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM checklist_template_tasks WHERE template_id = $1`, template.ID); err != nil {
+		return nil, err
+	}
+	if err := insertChecklistTemplateTasks(ctx, tx, template.ID, template.Tasks); err != nil {
+		return nil, err
+	}
+	updatedTemplate, err := getChecklistTemplate(ctx, tx, template.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return updatedTemplate, nil
+}
+
+func (r *ChecklistRepoPostgres) DeleteTemplate(ctx context.Context, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM checklist_templates WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrChecklistTemplateNotFound
+	}
+	return nil
+}
+
+func (r *ChecklistRepoPostgres) FindTemplate(ctx context.Context, kind entity.ChecklistKind, positionID int,
+	departmentID *int) (*entity.ChecklistTemplate, error) {
+
+	// Templates limited to a position or department sort before those that are not, so the first
+	// row is the most specific match.
+	var templateID int
+	err := r.pool.QueryRow(ctx, `
+		SELECT id
+		FROM checklist_templates
+		WHERE kind = $1
+			AND (position_id IS NULL OR position_id = $2)
+			AND (department_id IS NULL OR department_id = $3)
+		ORDER BY position_id IS NULL, department_id IS NULL
+		LIMIT 1
+	`, kind, positionID, departmentID).Scan(&templateID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.GetTemplateById(ctx, templateID)
+}
+
+func (r *ChecklistRepoPostgres) CreateChecklist(ctx context.Context, checklist *entity.Checklist) (*entity.Checklist, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var checklistID int
+	err = tx.QueryRow(ctx, `
+		INSERT INTO checklists (employee_id, template_id, kind, name, start_date)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`,
+		checklist.EmployeeID,
+		checklist.TemplateID,
+		checklist.Kind,
+		checklist.Name,
+		checklist.StartDate,
+	).Scan(&checklistID)
+	if err != nil {
+		return nil, mapChecklistWriteError(err)
+	}
+
+	for number, task := range checklist.Tasks {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO checklist_tasks (checklist_id, task_number, title, description, owner_id, due_on)
+			VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6)
+		`,
+			checklistID,
+			number+1,
+			task.Title,
+			task.Description,
+			task.OwnerID,
+			task.DueOn,
+		)
+		if err != nil {
+			return nil, mapChecklistWriteError(err)
+		}
+	}
+	createdChecklist, err := getChecklist(ctx, tx, checklistID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return createdChecklist, nil
+}
+
+func (r *ChecklistRepoPostgres) GetChecklistById(ctx context.Context, id int) (*entity.Checklist, error) {
+	checklist, err := getChecklist(ctx, r.pool, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return checklist, nil
+}
+
+// buildChecklistFilter turns filter into a WHERE clause (empty when nothing is filtered) and its
+// arguments.
+func buildChecklistFilter(filter entity.ChecklistFilter) (string, []any) {
+	conditions := []string{}
+	args := []any{}
+
+	if filter.EmployeeID > 0 {
+		args = append(args, filter.EmployeeID)
+		conditions = append(conditions, fmt.Sprintf("c.employee_id = $%d", len(args)))
+	}
+	if filter.Kind != "" {
+		args = append(args, filter.Kind)
+		conditions = append(conditions, fmt.Sprintf("c.kind = $%d", len(args)))
+	}
+	if filter.OpenOnly {
+		conditions = append(conditions, "c.completed_at IS NULL")
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func (r *ChecklistRepoPostgres) GetChecklists(ctx context.Context, filter entity.ChecklistFilter) ([]*entity.Checklist, error) {
+	where, args := buildChecklistFilter(filter)
+	query := `
+		SELECT ` + checklistColumns + checklistFrom + `
+		` + where + `
+		ORDER BY c.start_date DESC, c.id DESC
+	`
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	checklists := []*entity.Checklist{}
+	checklistsByID := map[int]*entity.Checklist{}
+	for rows.Next() {
+		checklist, err := scanChecklist(rows)
+		if err != nil {
+			return nil, err
+		}
+		checklists = append(checklists, checklist)
+		checklistsByID[checklist.ID] = checklist
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(checklists) == 0 {
+		return checklists, nil
+	}
+
+	ids := make([]int, 0, len(checklists))
+	for _, checklist := range checklists {
+		ids = append(ids, checklist.ID)
+	}
+	tasks, err := queryChecklistTasks(ctx, r.pool, `t.checklist_id = ANY($1)`, `t.checklist_id, t.task_number`, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		checklist := checklistsByID[task.ChecklistID]
+		checklist.Tasks = append(checklist.Tasks, *task)
+	}
+	return checklists, nil
+}
+
+func (r *ChecklistRepoPostgres) GetTaskById(ctx context.Context, id int) (*entity.ChecklistTask, error) {
+	tasks, err := queryChecklistTasks(ctx, r.pool, `t.id = $1`, `t.id`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+	return tasks[0], nil
+}
+
+func (r *ChecklistRepoPostgres) GetOwnerTasks(ctx context.Context, ownerID int) ([]*entity.ChecklistTask, error) {
+	return queryChecklistTasks(ctx, r.pool, `t.owner_id = $1 AND t.completed_at IS NULL`, `t.due_on, t.id`, ownerID)
+}
+
+func (r *ChecklistRepoPostgres) UpdateTask(ctx context.Context, task *entity.ChecklistTask) (*entity.ChecklistTask, error) {
+	result, err := r.pool.Exec(ctx, `
+		UPDATE checklist_tasks
+		SET owner_id = $1,
+			due_on = $2,
+			updated_at = NOW()
+		WHERE id = $3
+	`, task.OwnerID, task.DueOn, task.ID)
+	if err != nil {
+		return nil, mapChecklistWriteError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+	return r.GetTaskById(ctx, task.ID)
+}
+
+func (r *ChecklistRepoPostgres) SetTaskCompletion(ctx context.Context, id int, completedAt *time.Time) (*entity.Checklist, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var checklistID int
+	err = tx.QueryRow(ctx, `
+		UPDATE checklist_tasks
+		SET completed_at = $1,
+			updated_at = NOW()
+		WHERE id = $2
+		RETURNING checklist_id
+	`, completedAt, id).Scan(&checklistID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, appError.ErrChecklistTaskNotFound
+		}
+		return nil, err
+	}
+	if completedAt != nil {
+		if err := ensureAssetsReturned(ctx, tx, checklistID); err != nil {
+			return nil, err
+		}
+	}
+
+	// The checklist is complete while none of its tasks is open; it keeps the time it was first
+	// completed at.
+	_, err = tx.Exec(ctx, `
+		UPDATE checklists
+		SET completed_at = CASE
+			WHEN EXISTS (SELECT 1 FROM checklist_tasks WHERE checklist_id = $1 AND completed_at IS NULL) THEN NULL
+			ELSE COALESCE(completed_at, NOW())
+		END
+		WHERE id = $1
+	`, checklistID)
+	if err != nil {
+		return nil, mapChecklistWriteError(err)
+	}
+	checklist, err := getChecklist(ctx, tx, checklistID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return checklist, nil
+}
+
+// ensureAssetsReturned keeps the last open task of an offboarding from completing it while the
+// leaving employee still holds company assets. The checklist and employee are locked so that
+// completing its other tasks and assigning the employee assets wait for the check; assignments
+// take a key share lock on the employee through their foreign key.
+func ensureAssetsReturned(ctx context.Context, tx pgx.Tx, checklistID int) error {
+	var kind entity.ChecklistKind
+	var employeeID int
+	err := tx.QueryRow(ctx, `
+		SELECT c.kind, c.employee_id
+		FROM checklists c
+		JOIN employees e ON e.id = c.employee_id
+		WHERE c.id = $1
+		FOR UPDATE OF c, e
+	`, checklistID).Scan(&kind, &employeeID)
+	if err != nil {
+		return err
+	}
+	if kind != entity.ChecklistKindOffboarding {
+		return nil
+	}
+
+	var holdsAssets bool
+	err = tx.QueryRow(ctx, `
+		SELECT NOT EXISTS (SELECT 1 FROM checklist_tasks WHERE checklist_id = $1 AND completed_at IS NULL)
+			AND EXISTS (SELECT 1 FROM asset_assignments WHERE employee_id = $2 AND returned_on IS NULL)
+	`, checklistID, employeeID).Scan(&holdsAssets)
+	if err != nil {
+		return err
+	}
+	if holdsAssets {
+		return appError.ErrEmployeeHoldsAssets
+	}
+	return nil
+}
+
+func insertChecklistTemplateTasks(ctx context.Context, tx pgx.Tx, templateID int, tasks []entity.ChecklistTemplateTask) error {
+	for number, task := range tasks {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO checklist_template_tasks (template_id, task_number, title, description, owner_role, owner_id, due_days)
+			VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, $7)
+		`,
+			templateID,
+			number+1,
+			task.Title,
+			task.Description,
+			task.OwnerRole,
+			task.OwnerID,
+			task.DueDays,
+		)
+		if err != nil {
+			return mapChecklistTemplateWriteError(err)
+		}
+	}
+	return nil
+}
+
+func getChecklistTemplate(ctx context.Context, q querier, id int) (*entity.ChecklistTemplate, error) {
+	query := `
+		SELECT ` + checklistTemplateColumns + `
+		FROM checklist_templates t
+		WHERE t.id = $1
+	`
+	template, err := scanChecklistTemplate(q.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := queryChecklistTemplateTasks(ctx, q, `template_id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		template.Tasks = append(template.Tasks, *task)
+	}
+	return template, nil
+}
+
+func queryChecklistTemplateTasks(ctx context.Context, q querier, where string, args ...any) ([]*entity.ChecklistTemplateTask, error) {
+	rows, err := q.Query(ctx, `
+		SELECT `+checklistTemplateTaskColumns+`
+		FROM checklist_template_tasks
+		WHERE `+where+`
+		ORDER BY template_id, task_number
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := []*entity.ChecklistTemplateTask{}
+	for rows.Next() {
+		task, err := scanChecklistTemplateTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, rows.Err()
+}
+
+func getChecklist(ctx context.Context, q querier, id int) (*entity.Checklist, error) {
+	query := `
+		SELECT ` + checklistColumns + checklistFrom + `
+		WHERE c.id = $1
+	`
+	checklist, err := scanChecklist(q.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := queryChecklistTasks(ctx, q, `t.checklist_id = $1`, `t.task_number`, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		checklist.Tasks = append(checklist.Tasks, *task)
+	}
+	return checklist, nil
+}
+
+func queryChecklistTasks(ctx context.Context, q querier, where, orderBy string, args ...any) ([]*entity.ChecklistTask, error) {
+	rows, err := q.Query(ctx, `
+		SELECT `+checklistTaskColumns+checklistTaskFrom+`
+		WHERE `+where+`
+		ORDER BY `+orderBy, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := []*entity.ChecklistTask{}
+	for rows.Next() {
+		task, err := scanChecklistTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, rows.Err()
+}
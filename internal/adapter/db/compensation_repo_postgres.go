@@ -0,0 +1,242 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const compensationChangeColumns = `
+	id, employee_id, effective_date, COALESCE(old_amount, 0), COALESCE(old_currency, ''), new_amount, new_currency, reason,
+	COALESCE(approved_by, ''), status, applied_at, created_at`
+
+type CompensationRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewCompensationRepository(pool *pgxpool.Pool) repository.CompensationRepository {
+	return &CompensationRepoPostgres{pool: pool}
+}
+
+func scanCompensationChange(row pgx.Row) (*entity.CompensationChange, error) {
+	var change entity.CompensationChange
+	err := row.Scan(
+		&change.ID,
+		&change.EmployeeID,
+		&change.EffectiveDate,
+		&change.OldSalary.Amount,
+		&change.OldSalary.Currency,
+		&change.NewSalary.Amount,
+		&change.NewSalary.Currency,
+		&change.Reason,
+		&change.ApprovedBy,
+		&change.Status,
+		&change.AppliedAt,
+		&change.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
+
+// insertAppliedCompensationChange records a change that has already been applied to the employee row.
+// It is shared with the employee repository so that salary edits land in the timeline atomically.
+func insertAppliedCompensationChange(ctx context.Context, tx pgx.Tx, change *entity.CompensationChange) (*entity.CompensationChange, error) {
+	query := `
+		INSERT INTO compensation_changes (
+			employee_id, effective_date, old_amount, old_currency, new_amount, new_currency, reason, approved_by,
+			status, applied_at
+		)
+		VALUES ($1, $2, NULLIF($3, 0), NULLIF($4, ''), $5, $6, $7, NULLIF($8, ''), 'applied', NOW())
+		RETURNING ` + compensationChangeColumns
+
+	return scanCompensationChange(tx.QueryRow(ctx, query,
+		change.EmployeeID,
+		change.EffectiveDate,
+		change.OldSalary.Amount,
+		change.OldSalary.Currency,
+		change.NewSalary.Amount,
+		change.NewSalary.Currency,
+		change.Reason,
+		change.ApprovedBy,
+	))
+}
+
+// lockEmployeeSalary reads the current salary of an employee and locks the row until the transaction ends.
+func lockEmployeeSalary(ctx context.Context, tx pgx.Tx, employeeID int) (entity.Money, error) {
+	var salary entity.Money
+	err := tx.QueryRow(ctx, `
+		SELECT salary_amount, salary_currency FROM employees WHERE id = $1 FOR UPDATE
+	`, employeeID).Scan(&salary.Amount, &salary.Currency)
+	return salary, err
+}
+
+func setEmployeeSalary(ctx context.Context, tx pgx.Tx, employeeID int, salary entity.Money, outOfBand bool) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE employees
+		SET salary_amount = $1, salary_currency = $2, salary_out_of_band = $3, updated_at = NOW()
+		WHERE id = $4
+	`, salary.Amount, salary.Currency, outOfBand, employeeID)
+	return err
+}
+
+func (r *CompensationRepoPostgres) CreateScheduledChange(ctx context.Context, change *entity.CompensationChange) (*entity.CompensationChange, error) {
+	query := `
+		INSERT INTO compensation_changes (
+			employee_id, effective_date, old_amount, old_currency, new_amount, new_currency, reason, approved_by,
+			status
+		)
+		VALUES ($1, $2, NULLIF($3, 0), NULLIF($4, ''), $5, $6, $7, NULLIF($8, ''), 'scheduled')
+		RETURNING ` + compensationChangeColumns
+
+	return scanCompensationChange(r.pool.QueryRow(ctx, query,
+		change.EmployeeID,
+		change.EffectiveDate,
+		change.OldSalary.Amount,
+		change.OldSalary.Currency,
+		change.NewSalary.Amount,
+		change.NewSalary.Currency,
+		change.Reason,
+		change.ApprovedBy,
+	))
+}
+
+func (r *CompensationRepoPostgres) ApplyChange(ctx context.Context, change *entity.CompensationChange,
+	salaryOutOfBand bool) (*entity.CompensationChange, error) {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	oldSalary, err := lockEmployeeSalary(ctx, tx, change.EmployeeID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	change.OldSalary = oldSalary
+	appliedChange, err := insertAppliedCompensationChange(ctx, tx, change)
+	if err != nil {
+		return nil, err
+	}
+	if err := setEmployeeSalary(ctx, tx, change.EmployeeID, change.NewSalary, salaryOutOfBand); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return appliedChange, nil
+}
+
+func (r *CompensationRepoPostgres) ApplyScheduledChange(ctx context.Context, id int,
+	salaryOutOfBand bool) (*entity.CompensationChange, error) {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	change, err := scanCompensationChange(tx.QueryRow(ctx, `
+		SELECT `+compensationChangeColumns+`
+		FROM compensation_changes
+		WHERE id = $1 AND status = 'scheduled'
+		FOR UPDATE
+	`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	oldSalary, err := lockEmployeeSalary(ctx, tx, change.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	appliedChange, err := scanCompensationChange(tx.QueryRow(ctx, `
+		UPDATE compensation_changes
+		SET status = 'applied', old_amount = $1, old_currency = $2, applied_at = NOW()
+		WHERE id = $3
+		RETURNING `+compensationChangeColumns, oldSalary.Amount, oldSalary.Currency, change.ID))
+	if err != nil {
+		return nil, err
+	}
+	if err := setEmployeeSalary(ctx, tx, change.EmployeeID, change.NewSalary, salaryOutOfBand); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return appliedChange, nil
+}
+
+func (r *CompensationRepoPostgres) GetDueScheduledChanges(ctx context.Context, asOf time.Time) ([]*entity.CompensationChange, error) {
+	query := `
+		SELECT ` + compensationChangeColumns + `
+		FROM compensation_changes
+		WHERE status = 'scheduled' AND effective_date <= $1
+		ORDER BY effective_date, id
+	`
+	return r.queryChanges(ctx, query, asOf)
+}
+
+func (r *CompensationRepoPostgres) GetChangesByEmployeeId(ctx context.Context, employeeID int) ([]*entity.CompensationChange, error) {
+	query := `
+		SELECT ` + compensationChangeColumns + `
+		FROM compensation_changes
+		WHERE employee_id = $1
+		ORDER BY effective_date, id
+	`
+	return r.queryChanges(ctx, query, employeeID)
+}
+
+func (r *CompensationRepoPostgres) queryChanges(ctx context.Context, query string, args ...any) ([]*entity.CompensationChange, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := []*entity.CompensationChange{}
+	for rows.Next() {
+		change, err := scanCompensationChange(rows)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, rows.Err()
+}
+
+func (r *CompensationRepoPostgres) CancelScheduledChange(ctx context.Context, employeeID, id int) error {
+	query := `
+		UPDATE compensation_changes
+		SET status = 'cancelled'
+		WHERE employee_id = $1 AND id = $2 AND status = 'scheduled'
+	`
+	result, err := r.pool.Exec(ctx, query, employeeID, id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return appError.ErrScheduledCompensationChangeNotFound
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const customFieldDefinitionColumns = `
+	id, key, label, type, required, options,
+	min_length, max_length, COALESCE(pattern, ''), min_value, max_value,
+	created_at, updated_at`
+
+type CustomFieldRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewCustomFieldRepository(pool *pgxpool.Pool) repository.CustomFieldRepository {
+	return &CustomFieldRepoPostgres{pool: pool}
+}
+
+func scanCustomFieldDefinition(row pgx.Row) (*entity.CustomFieldDefinition, error) {
+	var definition entity.CustomFieldDefinition
+	err := row.Scan(
+		&definition.ID,
+		&definition.Key,
+		&definition.Label,
+		&definition.Type,
+		&definition.Required,
+		&definition.Options,
+		&definition.Validation.MinLength,
+		&definition.Validation.MaxLength,
+		&definition.Validation.Pattern,
+		&definition.Validation.Min,
+		&definition.Validation.Max,
+		&definition.CreatedAt,
+		&definition.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &definition, nil
+}
+
+func (r *CustomFieldRepoPostgres) CreateDefinition(ctx context.Context,
+	definition *entity.CustomFieldDefinition) (*entity.CustomFieldDefinition, error) {
+
+	query := `
+		INSERT INTO custom_field_definitions (
+			key, label, type, required, options, min_length, max_length, pattern, min_value, max_value
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), $9, $10)
+		RETURNING ` + customFieldDefinitionColumns
+
+	createdDefinition, err := scanCustomFieldDefinition(r.pool.QueryRow(ctx, query,
+		definition.Key,
+		definition.Label,
+		definition.Type,
+		definition.Required,
+		definition.Options,
+		definition.Validation.MinLength,
+		definition.Validation.MaxLength,
+		definition.Validation.Pattern,
+		definition.Validation.Min,
+		definition.Validation.Max,
+	))
+	if err != nil {
+		if uniqueViolationConstraint(err) == "custom_field_definitions_key_key" {
+			return nil, appError.ErrCustomFieldDefinitionAlreadyExists
+		}
+		return nil, err
+	}
+	return createdDefinition, nil
+}
+
+func (r *CustomFieldRepoPostgres) GetDefinitionById(ctx context.Context, id int) (*entity.CustomFieldDefinition, error) {
+	query := `
+		SELECT ` + customFieldDefinitionColumns + `
+		FROM custom_field_definitions
+		WHERE id = $1
+	`
+	definition, err := scanCustomFieldDefinition(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return definition, nil
+}
+
+func (r *CustomFieldRepoPostgres) GetAllDefinitions(ctx context.Context) ([]*entity.CustomFieldDefinition, error) {
+	query := `
+		SELECT ` + customFieldDefinitionColumns + `
+		FROM custom_field_definitions
+		ORDER BY key
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	definitions := []*entity.CustomFieldDefinition{}
+	for rows.Next() {
+		definition, err := scanCustomFieldDefinition(rows)
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, definition)
+	}
+	return definitions, rows.Err()
+}
+
+func (r *CustomFieldRepoPostgres) UpdateDefinition(ctx context.Context,
+	definition *entity.CustomFieldDefinition) (*entity.CustomFieldDefinition, error) {
+
+	query := `
+		UPDATE custom_field_definitions
+		SET label = $1,
+			required = $2,
+			options = $3,
+			min_length = $4,
+			max_length = $5,
+			pattern = NULLIF($6, ''),
+			min_value = $7,
+			max_value = $8,
+			updated_at = NOW()
+		WHERE id = $9
+		RETURNING ` + customFieldDefinitionColumns
+
+	updatedDefinition, err := scanCustomFieldDefinition(r.pool.QueryRow(ctx, query,
+		definition.Label,
+		definition.Required,
+		definition.Options,
+		definition.Validation.MinLength,
+		definition.Validation.MaxLength,
+		definition.Validation.Pattern,
+		definition.Validation.Min,
+		definition.Validation.Max,
+		definition.ID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return updatedDefinition, nil
+}
+
+func (r *CustomFieldRepoPostgres) DeleteDefinition(ctx context.Context, id int) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var key string
+	err = tx.QueryRow(ctx, `DELETE FROM custom_field_definitions WHERE id = $1 RETURNING key`, id).Scan(&key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return appError.ErrCustomFieldDefinitionNotFound
+		}
+		return err
+	}
+
+	// The values would otherwise be rejected as unknown fields on the employees' next update.
+	_, err = tx.Exec(ctx, `
+		UPDATE employees
+		SET custom_fields = custom_fields - $1::text
+		WHERE custom_fields ? $1::text
+	`, key)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
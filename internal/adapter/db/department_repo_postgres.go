@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const departmentColumns = `id, name, created_at, updated_at`
+
+type DepartmentRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewDepartmentRepository(pool *pgxpool.Pool) repository.DepartmentRepository {
+	return &DepartmentRepoPostgres{pool: pool}
+}
+
+func scanDepartment(row pgx.Row) (*entity.Department, error) {
+	var department entity.Department
+	err := row.Scan(
+		&department.ID,
+		&department.Name,
+		&department.CreatedAt,
+		&department.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &department, nil
+}
+
+func mapDepartmentWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "departments_name_key" {
+		return appError.ErrDepartmentAlreadyExists
+	}
+	return err
+}
+
+func (r *DepartmentRepoPostgres) CreateDepartment(ctx context.Context, department *entity.Department) (*entity.Department, error) {
+	query := `
+		INSERT INTO departments (name)
+		VALUES ($1)
+		RETURNING ` + departmentColumns
+
+	createdDepartment, err := scanDepartment(r.pool.QueryRow(ctx, query, department.Name))
+	if err != nil {
+		return nil, mapDepartmentWriteError(err)
+	}
+	return createdDepartment, nil
+}
+
+func (r *DepartmentRepoPostgres) GetDepartmentById(ctx context.Context, id int) (*entity.Department, error) {
+	query := `
+		SELECT ` + departmentColumns + `
+		FROM departments
+		WHERE id = $1
+	`
+	department, err := scanDepartment(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return department, nil
+}
+
+func (r *DepartmentRepoPostgres) GetAllDepartments(ctx context.Context) ([]*entity.Department, error) {
+	query := `
+		SELECT ` + departmentColumns + `
+		FROM departments
+		ORDER BY name
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	departments := []*entity.Department{}
+	for rows.Next() {
+		department, err := scanDepartment(rows)
+		if err != nil {
+			return nil, err
+		}
+		departments = append(departments, department)
+	}
+	return departments, rows.Err()
+}
+
+func (r *DepartmentRepoPostgres) UpdateDepartment(ctx context.Context, department *entity.Department) (*entity.Department, error) {
+	query := `
+		UPDATE departments
+		SET name = $1,
+			updated_at = NOW()
+		WHERE id = $2
+		RETURNING ` + departmentColumns
+
+	updatedDepartment, err := scanDepartment(r.pool.QueryRow(ctx, query, department.Name, department.ID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, mapDepartmentWriteError(err)
+	}
+	return updatedDepartment, nil
+}
+
+func (r *DepartmentRepoPostgres) DeleteDepartment(ctx context.Context, id int) error {
+	query := `
+		DELETE FROM departments
+		WHERE id = $1
+	`
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "employees_department_id_fkey" {
+			return appError.ErrDepartmentInUse
+		}
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return appError.ErrDepartmentNotFound
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const documentColumns = `
+	id, employee_id, category, file_name, content_type, size_bytes, checksum_sha256, storage_key, uploaded_at`
+
+type DocumentRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewDocumentRepository(pool *pgxpool.Pool) repository.DocumentRepository {
+	return &DocumentRepoPostgres{pool: pool}
+}
+
+func scanDocument(row pgx.Row) (*entity.EmployeeDocument, error) {
+	var document entity.EmployeeDocument
+	err := row.Scan(
+		&document.ID,
+		&document.EmployeeID,
+		&document.Category,
+		&document.FileName,
+		&document.ContentType,
+		&document.Size,
+		&document.Checksum,
+		&document.StorageKey,
+		&document.UploadedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &document, nil
+}
+
+func (r *DocumentRepoPostgres) CreateDocument(ctx context.Context, document *entity.EmployeeDocument) (*entity.EmployeeDocument, error) {
+	query := `
+		INSERT INTO employee_documents (employee_id, category, file_name, content_type, size_bytes, checksum_sha256, storage_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + documentColumns
+
+	createdDocument, err := scanDocument(r.pool.QueryRow(ctx, query,
+		document.EmployeeID,
+		document.Category,
+		document.FileName,
+		document.ContentType,
+		document.Size,
+		document.Checksum,
+		document.StorageKey,
+	))
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "employee_documents_employee_id_fkey" {
+			return nil, appError.ErrEmployeeNotFound
+		}
+		return nil, err
+	}
+	return createdDocument, nil
+}
+
+func (r *DocumentRepoPostgres) GetDocuments(ctx context.Context, employeeID int) ([]*entity.EmployeeDocument, error) {
+	query := `
+		SELECT ` + documentColumns + `
+		FROM employee_documents
+		WHERE employee_id = $1
+		ORDER BY uploaded_at DESC, id DESC
+	`
+	rows, err := r.pool.Query(ctx, query, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	documents := []*entity.EmployeeDocument{}
+	for rows.Next() {
+		document, err := scanDocument(rows)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, document)
+	}
+	return documents, rows.Err()
+}
+
+func (r *DocumentRepoPostgres) GetDocumentById(ctx context.Context, employeeID, id int) (*entity.EmployeeDocument, error) {
+	query := `
+		SELECT ` + documentColumns + `
+		FROM employee_documents
+		WHERE employee_id = $1 AND id = $2
+	`
+	document, err := scanDocument(r.pool.QueryRow(ctx, query, employeeID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return document, nil
+}
+
+func (r *DocumentRepoPostgres) DeleteDocument(ctx context.Context, employeeID, id int) error {
+	query := `
+		DELETE FROM employee_documents
+		WHERE employee_id = $1 AND id = $2
+	`
+	result, err := r.pool.Exec(ctx, query, employeeID, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrDocumentNotFound
+	}
+	return nil
+}
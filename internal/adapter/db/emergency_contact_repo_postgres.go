@@ -0,0 +1,193 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const emergencyContactColumns = `
+	id, employee_id, name, relationship, phone, COALESCE(email, ''), is_primary, created_at, updated_at`
+
+type EmergencyContactRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewEmergencyContactRepository(pool *pgxpool.Pool) repository.EmergencyContactRepository {
+	return &EmergencyContactRepoPostgres{pool: pool}
+}
+
+func scanEmergencyContact(row pgx.Row) (*entity.EmergencyContact, error) {
+	var contact entity.EmergencyContact
+	err := row.Scan(
+		&contact.ID,
+		&contact.EmployeeID,
+		&contact.Name,
+		&contact.Relationship,
+		&contact.Phone,
+		&contact.Email,
+		&contact.IsPrimary,
+		&contact.CreatedAt,
+		&contact.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &contact, nil
+}
+
+// clearPrimaryContact unsets the primary flag on every other contact of the employee,
+// so that at most one contact is primary at any time.
+func clearPrimaryContact(ctx context.Context, tx pgx.Tx, employeeID, exceptID int) error {
+	query := `
+		UPDATE emergency_contacts
+		SET is_primary = FALSE, updated_at = NOW()
+		WHERE employee_id = $1 AND id <> $2 AND is_primary
+	`
+	_, err := tx.Exec(ctx, query, employeeID, exceptID)
+	return err
+}
+
+func (r *EmergencyContactRepoPostgres) CreateEmergencyContact(ctx context.Context, contact *entity.EmergencyContact) (*entity.EmergencyContact, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO emergency_contacts (employee_id, name, relationship, phone, email, is_primary)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6)
+		RETURNING ` + emergencyContactColumns
+
+	createdContact, err := scanEmergencyContact(tx.QueryRow(ctx, query,
+		contact.EmployeeID,
+		contact.Name,
+		contact.Relationship,
+		contact.Phone,
+		contact.Email,
+		contact.IsPrimary,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if createdContact.IsPrimary {
+		if err := clearPrimaryContact(ctx, tx, createdContact.EmployeeID, createdContact.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return createdContact, nil
+}
+
+func (r *EmergencyContactRepoPostgres) GetEmergencyContactById(ctx context.Context, employeeID, id int) (*entity.EmergencyContact, error) {
+	query := `
+		SELECT ` + emergencyContactColumns + `
+		FROM emergency_contacts
+		WHERE employee_id = $1 AND id = $2
+	`
+	contact, err := scanEmergencyContact(r.pool.QueryRow(ctx, query, employeeID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return contact, nil
+}
+
+func (r *EmergencyContactRepoPostgres) GetEmergencyContactsByEmployeeId(ctx context.Context, employeeID int) ([]*entity.EmergencyContact, error) {
+	query := `
+		SELECT ` + emergencyContactColumns + `
+		FROM emergency_contacts
+		WHERE employee_id = $1
+		ORDER BY is_primary DESC, id
+	`
+	rows, err := r.pool.Query(ctx, query, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	contacts := []*entity.EmergencyContact{}
+	for rows.Next() {
+		contact, err := scanEmergencyContact(rows)
+		if err != nil {
+			return nil, err
+		}
+		contacts = append(contacts, contact)
+	}
+	return contacts, rows.Err()
+}
+
+func (r *EmergencyContactRepoPostgres) UpdateEmergencyContact(ctx context.Context, contact *entity.EmergencyContact) (*entity.EmergencyContact, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE emergency_contacts
+		SET name = $1,
+			relationship = $2,
+			phone = $3,
+			email = NULLIF($4, ''),
+			is_primary = $5,
+			updated_at = NOW()
+		WHERE employee_id = $6 AND id = $7
+		RETURNING ` + emergencyContactColumns
+
+	updatedContact, err := scanEmergencyContact(tx.QueryRow(ctx, query,
+		contact.Name,
+		contact.Relationship,
+		contact.Phone,
+		contact.Email,
+		contact.IsPrimary,
+		contact.EmployeeID,
+		contact.ID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if updatedContact.IsPrimary {
+		if err := clearPrimaryContact(ctx, tx, updatedContact.EmployeeID, updatedContact.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return updatedContact, nil
+}
+
+func (r *EmergencyContactRepoPostgres) DeleteEmergencyContact(ctx context.Context, employeeID, id int) error {
+	query := `
+		DELETE FROM emergency_contacts
+		WHERE employee_id = $1 AND id = $2
+	`
+	result, err := r.pool.Exec(ctx, query, employeeID, id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return appError.ErrEmergencyContactNotFound
+	}
+	return nil
+}
@@ -3,6 +3,9 @@ package db
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -12,6 +15,20 @@ import (
 	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
 )
 
+// employeeColumns is the select list shared by every query that returns a full employee row.
+// Optional profile columns are coalesced so they can be scanned into plain strings, and the
+// position title and the tags are looked up with subqueries so the list also works in RETURNING
+// clauses.
+const employeeColumns = `
+	id, name, position_id, (SELECT title FROM positions WHERE positions.id = employees.position_id),
+	salary_amount, salary_currency, pay_period, salary_out_of_band, manager_id, location_id, department_id, hired_date,
+	COALESCE(work_email, ''), COALESCE(personal_email, ''), COALESCE(phone, ''),
+	COALESCE(address_line1, ''), COALESCE(address_line2, ''), COALESCE(city, ''),
+	COALESCE(state, ''), COALESCE(postal_code, ''), COALESCE(country, ''),
+	date_of_birth, status, termination_date, COALESCE(termination_reason, ''), custom_fields,
+	ARRAY(SELECT tag FROM employee_tags WHERE employee_tags.employee_id = employees.id ORDER BY tag),
+	created_at, updated_at`
+
 type EmployeeRepoPostgres struct {
 	pool *pgxpool.Pool
 }
@@ -20,52 +37,167 @@ func NewEmployeeRepository(pool *pgxpool.Pool) repository.EmployeeRepository {
 	return &EmployeeRepoPostgres{pool: pool}
 }
 
+func scanEmployee(row pgx.Row) (*entity.Employee, error) {
+	var employee entity.Employee
+	err := row.Scan(
+		&employee.ID,
+		&employee.Name,
+		&employee.PositionID,
+		&employee.Position,
+		&employee.Salary.Amount,
+		&employee.Salary.Currency,
+		&employee.PayPeriod,
+		&employee.SalaryOutOfBand,
+		&employee.ManagerID,
+		&employee.LocationID,
+		&employee.DepartmentID,
+		&employee.HiredDate,
+		&employee.WorkEmail,
+		&employee.PersonalEmail,
+		&employee.Phone,
+		&employee.Address.Line1,
+		&employee.Address.Line2,
+		&employee.Address.City,
+		&employee.Address.State,
+		&employee.Address.PostalCode,
+		&employee.Address.Country,
+		&employee.DateOfBirth,
+		&employee.Status,
+		&employee.TerminationDate,
+		&employee.TerminationReason,
+		&employee.CustomFields,
+		&employee.Tags,
+		&employee.CreatedAt,
+		&employee.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &employee, nil
+}
+
+// mapEmployeeWriteError translates unique violations on the email columns and missing
+// positions, managers, locations or departments into app errors.
+func mapEmployeeWriteError(err error) error {
+	switch uniqueViolationConstraint(err) {
+	case "employees_work_email_key":
+		return appError.ErrWorkEmailAlreadyExists
+	case "employees_personal_email_key":
+		return appError.ErrPersonalEmailAlreadyExists
+	}
+	switch foreignKeyViolationConstraint(err) {
+	case "employees_position_id_fkey":
+		return appError.ErrPositionNotFound
+	case "employees_manager_id_fkey":
+		return appError.ErrManagerNotFound
+	case "employees_location_id_fkey":
+		return appError.ErrLocationNotFound
+	case "employees_department_id_fkey":
+		return appError.ErrDepartmentNotFound
+	}
+	return err
+}
+
 func (r *EmployeeRepoPostgres) CreateEmployee(ctx context.Context, employee *entity.Employee) (*entity.Employee, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
-		INSERT INTO employees (name, position, salary, hired_date) 
-		VALUES ($1, $2, $3, $4) 
-		RETURNING id, name, position, salary, hired_date, created_at
-	`
+		INSERT INTO employees (
+			name, position_id, salary_amount, salary_currency, pay_period, hired_date,
+			work_email, personal_email, phone,
+			address_line1, address_line2, city, state, postal_code, country,
+			date_of_birth, status, salary_out_of_band, manager_id, location_id, department_id, custom_fields
+		)
+		VALUES (
+			$1, $2, $3, $4, $5, $6,
+			NULLIF($7, ''), NULLIF($8, ''), NULLIF($9, ''),
+			NULLIF($10, ''), NULLIF($11, ''), NULLIF($12, ''), NULLIF($13, ''), NULLIF($14, ''), NULLIF($15, ''),
+			$16, $17, $18, $19, $20, $21, COALESCE($22::jsonb, '{}')
+		)
+		RETURNING ` + employeeColumns
 
-	var createdEmployee entity.Employee
-	row := r.pool.QueryRow(ctx, query,
+	row := tx.QueryRow(ctx, query,
 		employee.Name,
-		employee.Position,
-		employee.Salary,
-		employee.HiredDate)
-
-	err := row.Scan(
-		&createdEmployee.ID,
-		&createdEmployee.Name,
-		&createdEmployee.Position,
-		&createdEmployee.Salary,
-		&createdEmployee.HiredDate,
-		&createdEmployee.CreatedAt)
+		employee.PositionID,
+		employee.Salary.Amount,
+		employee.Salary.Currency,
+		employee.PayPeriod,
+		employee.HiredDate,
+		employee.WorkEmail,
+		employee.PersonalEmail,
+		employee.Phone,
+		employee.Address.Line1,
+		employee.Address.Line2,
+		employee.Address.City,
+		employee.Address.State,
+		employee.Address.PostalCode,
+		employee.Address.Country,
+		employee.DateOfBirth,
+		employee.Status,
+		employee.SalaryOutOfBand,
+		employee.ManagerID,
+		employee.LocationID,
+		employee.DepartmentID,
+		employee.CustomFields)
 
+	createdEmployee, err := scanEmployee(row)
 	if err != nil {
+		return nil, mapEmployeeWriteError(err)
+	}
+
+	// The initial status opens the employee's status history.
+	initialStatus := &entity.EmploymentStatusChange{
+		EmployeeID:    createdEmployee.ID,
+		ToStatus:      createdEmployee.Status,
+		EffectiveDate: createdEmployee.HiredDate,
+		Reason:        "created",
+	}
+	if err := insertEmploymentStatusChange(ctx, tx, initialStatus); err != nil {
+		return nil, err
+	}
+
+	// Likewise the starting salary opens the compensation timeline.
+	initialSalary := &entity.CompensationChange{
+		EmployeeID:    createdEmployee.ID,
+		EffectiveDate: createdEmployee.HiredDate,
+		NewSalary:     createdEmployee.Salary,
+		Reason:        "initial salary",
+	}
+	if _, err := insertAppliedCompensationChange(ctx, tx, initialSalary); err != nil {
 		return nil, err
 	}
-	return &createdEmployee, nil
+
+	// And the starting location opens the location timeline.
+	if createdEmployee.LocationID != nil {
+		initialLocation := &entity.LocationAssignment{
+			EmployeeID:    createdEmployee.ID,
+			LocationID:    *createdEmployee.LocationID,
+			EffectiveDate: createdEmployee.HiredDate,
+		}
+		if _, err := insertAppliedLocationAssignment(ctx, tx, initialLocation); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return createdEmployee, nil
 }
 
 func (r *EmployeeRepoPostgres) GetEmployeeById(ctx context.Context, id int) (*entity.Employee, error) {
 	query := `
-		SELECT id, name, position, salary, hired_date, created_at, updated_at 
+		SELECT ` + employeeColumns + `
 		FROM employees 
 		WHERE id = $1
 	`
 	row := r.pool.QueryRow(ctx, query, id)
 
-	var employee entity.Employee
-	err := row.Scan(
-		&employee.ID,
-		&employee.Name,
-		&employee.Position,
-		&employee.Salary,
-		&employee.HiredDate,
-		&employee.CreatedAt,
-		&employee.UpdatedAt,
-	)
+	employee, err := scanEmployee(row)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
@@ -73,72 +205,177 @@ func (r *EmployeeRepoPostgres) GetEmployeeById(ctx context.Context, id int) (*en
 		return nil, err
 	}
 
-	return &employee, nil
+	return employee, nil
 }
 
-func (r *EmployeeRepoPostgres) GetAllEmployees(ctx context.Context) ([]*entity.Employee, error) {
+// buildEmployeeFilter turns filter into a WHERE clause (empty when nothing is filtered) and its arguments.
+func buildEmployeeFilter(filter entity.EmployeeFilter) (string, []any) {
+	conditions := []string{}
+	args := []any{}
+
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			statuses = append(statuses, string(status))
+		}
+		args = append(args, statuses)
+		conditions = append(conditions, fmt.Sprintf("status = ANY($%d)", len(args)))
+	}
+	if filter.ManagerID > 0 {
+		args = append(args, filter.ManagerID)
+		conditions = append(conditions, fmt.Sprintf("manager_id = $%d", len(args)))
+	}
+	if filter.LocationID > 0 {
+		args = append(args, filter.LocationID)
+		conditions = append(conditions, fmt.Sprintf("location_id = $%d", len(args)))
+	}
+	if len(filter.Tags) > 0 {
+		// The tags are distinct, so matching as many of them as there are means matching them all.
+		args = append(args, filter.Tags)
+		conditions = append(conditions, fmt.Sprintf(
+			"(SELECT COUNT(*) FROM employee_tags WHERE employee_tags.employee_id = employees.id AND tag = ANY($%d)) = cardinality($%d::text[])",
+			len(args), len(args)))
+	}
+	if len(filter.CustomFields) > 0 {
+		args = append(args, filter.CustomFields)
+		conditions = append(conditions, fmt.Sprintf("custom_fields @> $%d::jsonb", len(args)))
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func (r *EmployeeRepoPostgres) GetAllEmployees(ctx context.Context, filter entity.EmployeeFilter) ([]*entity.Employee, error) {
+	where, args := buildEmployeeFilter(filter)
+	query := `
+		SELECT ` + employeeColumns + ` FROM employees
+		` + where + `
+		ORDER BY id
+	`
+	return r.queryEmployees(ctx, query, args...)
+}
+
+func (r *EmployeeRepoPostgres) GetReports(ctx context.Context, managerID int) ([]*entity.Employee, error) {
+	// UNION rather than UNION ALL stops the walk should manager_id ever form a loop.
 	query := `
-		SELECT id, name, position, salary, hired_date, created_at FROM employees
+		WITH RECURSIVE reports AS (
+			SELECT id FROM employees WHERE manager_id = $1
+			UNION
+			SELECT e.id FROM employees e JOIN reports r ON e.manager_id = r.id
+		)
+		SELECT ` + employeeColumns + ` FROM employees
+		WHERE id IN (SELECT id FROM reports) AND id <> $1
+		ORDER BY id
 	`
-	rows, err := r.pool.Query(ctx, query)
+	return r.queryEmployees(ctx, query, managerID)
+}
+
+func (r *EmployeeRepoPostgres) queryEmployees(ctx context.Context, query string, args ...any) ([]*entity.Employee, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 	employees := []*entity.Employee{}
 	for rows.Next() {
-		var employee entity.Employee
-		err := rows.Scan(
-			&employee.ID,
-			&employee.Name,
-			&employee.Position,
-			&employee.Salary,
-			&employee.HiredDate,
-			&employee.CreatedAt)
+		employee, err := scanEmployee(rows)
 		if err != nil {
 			return nil, err
 		}
-		employees = append(employees, &employee)
+		employees = append(employees, employee)
 	}
-	return employees, nil
+	return employees, rows.Err()
 }
 
-func (r *EmployeeRepoPostgres) UpdateEmployee(ctx context.Context, employee *entity.Employee) (*entity.Employee, error) {
+func (r *EmployeeRepoPostgres) UpdateEmployee(ctx context.Context, employee *entity.Employee,
+	locationDate time.Time) (*entity.Employee, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Salary and pay period are left alone here; the salary only changes through the compensation
+	// timeline.
+	var oldLocationID *int
+	err = tx.QueryRow(ctx, `SELECT location_id FROM employees WHERE id = $1 FOR UPDATE`, employee.ID).Scan(&oldLocationID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
 	query := `
         UPDATE employees 
         SET name = $1,
-            position = $2,
-            salary = $3,
-            hired_date = $4,
+            position_id = $2,
+            hired_date = $3,
+            work_email = NULLIF($4, ''),
+            personal_email = NULLIF($5, ''),
+            phone = NULLIF($6, ''),
+            address_line1 = NULLIF($7, ''),
+            address_line2 = NULLIF($8, ''),
+            city = NULLIF($9, ''),
+            state = NULLIF($10, ''),
+            postal_code = NULLIF($11, ''),
+            country = NULLIF($12, ''),
+            date_of_birth = $13,
+            salary_out_of_band = $14,
+            manager_id = $15,
+            location_id = $16,
+            department_id = $17,
+            custom_fields = COALESCE($18::jsonb, '{}'),
             updated_at = NOW()
-        WHERE id = $5
-        RETURNING id, name, position, salary, hired_date, created_at, updated_at
-    `
-	row := r.pool.QueryRow(ctx, query,
+        WHERE id = $19
+        RETURNING ` + employeeColumns
+
+	row := tx.QueryRow(ctx, query,
 		employee.Name,
-		employee.Position,
-		employee.Salary,
+		employee.PositionID,
 		employee.HiredDate,
+		employee.WorkEmail,
+		employee.PersonalEmail,
+		employee.Phone,
+		employee.Address.Line1,
+		employee.Address.Line2,
+		employee.Address.City,
+		employee.Address.State,
+		employee.Address.PostalCode,
+		employee.Address.Country,
+		employee.DateOfBirth,
+		employee.SalaryOutOfBand,
+		employee.ManagerID,
+		employee.LocationID,
+		employee.DepartmentID,
+		employee.CustomFields,
 		employee.ID,
 	)
 
-	var updatedEmployee entity.Employee
-	err := row.Scan(
-		&updatedEmployee.ID,
-		&updatedEmployee.Name,
-		&updatedEmployee.Position,
-		&updatedEmployee.Salary,
-		&updatedEmployee.HiredDate,
-		&updatedEmployee.CreatedAt,
-		&updatedEmployee.UpdatedAt,
-	)
+	updatedEmployee, err := scanEmployee(row)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+		return nil, mapEmployeeWriteError(err)
+	}
+
+	// Moving the employee in place still keeps the previous location in the location timeline.
+	if newLocationID := updatedEmployee.LocationID; newLocationID != nil &&
+		(oldLocationID == nil || *oldLocationID != *newLocationID) {
+		locationChange := &entity.LocationAssignment{
+			EmployeeID:    updatedEmployee.ID,
+			LocationID:    *newLocationID,
+			EffectiveDate: locationDate,
 		}
+		if _, err := insertAppliedLocationAssignment(ctx, tx, locationChange); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
-	return &updatedEmployee, nil
+	return updatedEmployee, nil
 }
 
 func (r *EmployeeRepoPostgres) DeleteEmployee(ctx context.Context, id int) error {
@@ -157,3 +394,97 @@ func (r *EmployeeRepoPostgres) DeleteEmployee(ctx context.Context, id int) error
 	}
 	return nil
 }
+
+func insertEmploymentStatusChange(ctx context.Context, tx pgx.Tx, change *entity.EmploymentStatusChange) error {
+	query := `
+		INSERT INTO employment_status_changes (employee_id, from_status, to_status, effective_date, reason)
+		VALUES ($1, NULLIF($2, ''), $3, $4, NULLIF($5, ''))
+	`
+	_, err := tx.Exec(ctx, query,
+		change.EmployeeID,
+		change.FromStatus,
+		change.ToStatus,
+		change.EffectiveDate,
+		change.Reason,
+	)
+	return err
+}
+
+func (r *EmployeeRepoPostgres) UpdateEmploymentStatus(ctx context.Context, employee *entity.Employee,
+	change *entity.EmploymentStatusChange) (*entity.Employee, error) {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE employees
+		SET status = $1,
+			hired_date = $2,
+			termination_date = $3,
+			termination_reason = NULLIF($4, ''),
+			updated_at = NOW()
+		WHERE id = $5 AND status = $6
+		RETURNING ` + employeeColumns
+
+	row := tx.QueryRow(ctx, query,
+		employee.Status,
+		employee.HiredDate,
+		employee.TerminationDate,
+		employee.TerminationReason,
+		employee.ID,
+		change.FromStatus,
+	)
+
+	updatedEmployee, err := scanEmployee(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := insertEmploymentStatusChange(ctx, tx, change); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return updatedEmployee, nil
+}
+
+func (r *EmployeeRepoPostgres) GetEmploymentStatusChanges(ctx context.Context, employeeID int) ([]*entity.EmploymentStatusChange, error) {
+	query := `
+		SELECT id, employee_id, COALESCE(from_status, ''), to_status, effective_date, COALESCE(reason, ''), created_at
+		FROM employment_status_changes
+		WHERE employee_id = $1
+		ORDER BY effective_date, id
+	`
+	rows, err := r.pool.Query(ctx, query, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := []*entity.EmploymentStatusChange{}
+	for rows.Next() {
+		var change entity.EmploymentStatusChange
+		err := rows.Scan(
+			&change.ID,
+			&change.EmployeeID,
+			&change.FromStatus,
+			&change.ToStatus,
+			&change.EffectiveDate,
+			&change.Reason,
+			&change.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, &change)
+	}
+	return changes, rows.Err()
+}
@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const exchangeRateColumns = `id, base_currency, quote_currency, rate::FLOAT8, effective_date, created_at`
+
+type ExchangeRateRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewExchangeRateRepository(pool *pgxpool.Pool) repository.ExchangeRateRepository {
+	return &ExchangeRateRepoPostgres{pool: pool}
+}
+
+func scanExchangeRate(row pgx.Row) (*entity.ExchangeRate, error) {
+	var rate entity.ExchangeRate
+	err := row.Scan(
+		&rate.ID,
+		&rate.BaseCurrency,
+		&rate.QuoteCurrency,
+		&rate.Rate,
+		&rate.EffectiveDate,
+		&rate.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rate, nil
+}
+
+func (r *ExchangeRateRepoPostgres) CreateExchangeRate(ctx context.Context, rate *entity.ExchangeRate) (*entity.ExchangeRate, error) {
+	query := `
+		INSERT INTO exchange_rates (base_currency, quote_currency, rate, effective_date)
+		VALUES ($1, $2, $3, $4)
+		RETURNING ` + exchangeRateColumns
+
+	createdRate, err := scanExchangeRate(r.pool.QueryRow(ctx, query,
+		rate.BaseCurrency,
+		rate.QuoteCurrency,
+		rate.Rate,
+		rate.EffectiveDate,
+	))
+	if err != nil {
+		if uniqueViolationConstraint(err) == "exchange_rates_pair_date_key" {
+			return nil, appError.ErrExchangeRateAlreadyExists
+		}
+		return nil, err
+	}
+	return createdRate, nil
+}
+
+func (r *ExchangeRateRepoPostgres) GetExchangeRates(ctx context.Context) ([]*entity.ExchangeRate, error) {
+	query := `
+		SELECT ` + exchangeRateColumns + `
+		FROM exchange_rates
+		ORDER BY base_currency, quote_currency, effective_date DESC
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rates := []*entity.ExchangeRate{}
+	for rows.Next() {
+		rate, err := scanExchangeRate(rows)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+	return rates, rows.Err()
+}
+
+func (r *ExchangeRateRepoPostgres) GetLatestRate(ctx context.Context, baseCurrency, quoteCurrency string,
+	asOf time.Time) (*entity.ExchangeRate, error) {
+
+	query := `
+		SELECT ` + exchangeRateColumns + `
+		FROM exchange_rates
+		WHERE base_currency = $1 AND quote_currency = $2 AND effective_date <= $3
+		ORDER BY effective_date DESC
+		LIMIT 1
+	`
+	rate, err := scanExchangeRate(r.pool.QueryRow(ctx, query, baseCurrency, quoteCurrency, asOf))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return rate, nil
+}
@@ -0,0 +1,607 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const expenseCategoryColumns = `
+	id, name, COALESCE(description, ''), COALESCE(limit_amount, 0), COALESCE(limit_currency, ''), receipt_required,
+	created_at, updated_at`
+
+// expenseClaimColumns selects a claim c with its employee e, joined by expenseClaimFrom, and the
+// total of its items.
+const expenseClaimColumns = `
+	c.id, c.employee_id, e.name, c.title, c.currency, c.status,
+	(SELECT COALESCE(SUM(i.amount), 0) FROM expense_items i WHERE i.claim_id = c.id),
+	(SELECT COUNT(*) FROM expense_receipts r WHERE r.claim_id = c.id),
+	c.submitted_at, c.approval_request_id, c.decided_by, c.decided_at, COALESCE(c.decision_comment, ''),
+	c.reimbursed_on, c.payroll_run_id, COALESCE(c.reimbursement_reference, ''), c.created_at, c.updated_at`
+
+const expenseClaimFrom = `
+	FROM expense_claims c
+	JOIN employees e ON e.id = c.employee_id`
+
+const expenseReceiptColumns = `
+	id, claim_id, file_name, content_type, size_bytes, checksum_sha256, storage_key, uploaded_at`
+
+type ExpenseRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewExpenseRepository(pool *pgxpool.Pool) repository.ExpenseRepository {
+	return &ExpenseRepoPostgres{pool: pool}
+}
+
+func scanExpenseCategory(row pgx.Row) (*entity.ExpenseCategory, error) {
+	var category entity.ExpenseCategory
+	err := row.Scan(
+		&category.ID,
+		&category.Name,
+		&category.Description,
+		&category.Limit.Amount,
+		&category.Limit.Currency,
+		&category.ReceiptRequired,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+func scanExpenseClaim(row pgx.Row) (*entity.ExpenseClaim, error) {
+	var claim entity.ExpenseClaim
+	err := row.Scan(
+		&claim.ID,
+		&claim.EmployeeID,
+		&claim.EmployeeName,
+		&claim.Title,
+		&claim.Currency,
+		&claim.Status,
+		&claim.Total.Amount,
+		&claim.ReceiptCount,
+		&claim.SubmittedAt,
+		&claim.ApprovalRequestID,
+		&claim.DecidedBy,
+		&claim.DecidedAt,
+		&claim.DecisionComment,
+		&claim.ReimbursedOn,
+		&claim.PayrollRunID,
+		&claim.ReimbursementReference,
+		&claim.CreatedAt,
+		&claim.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	claim.Total.Currency = claim.Currency
+	return &claim, nil
+}
+
+func scanExpenseReceipt(row pgx.Row) (*entity.ExpenseReceipt, error) {
+	var receipt entity.ExpenseReceipt
+	err := row.Scan(
+		&receipt.ID,
+		&receipt.ClaimID,
+		&receipt.FileName,
+		&receipt.ContentType,
+		&receipt.Size,
+		&receipt.Checksum,
+		&receipt.StorageKey,
+		&receipt.UploadedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &receipt, nil
+}
+
+func mapExpenseCategoryWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "expense_categories_name_key" {
+		return appError.ErrExpenseCategoryAlreadyExists
+	}
+	return err
+}
+
+func mapExpenseClaimWriteError(err error) error {
+	if foreignKeyViolationConstraint(err) == "expense_items_category_id_fkey" {
+		return appError.ErrExpenseCategoryNotFound
+	}
+	return err
+}
+
+func (r *ExpenseRepoPostgres) CreateCategory(ctx context.Context, category *entity.ExpenseCategory) (*entity.ExpenseCategory, error) {
+	query := `
+		INSERT INTO expense_categories (name, description, limit_amount, limit_currency, receipt_required)
+		VALUES ($1, NULLIF($2, ''), NULLIF($3, 0), NULLIF($4, ''), $5)
+		RETURNING ` + expenseCategoryColumns
+
+	createdCategory, err := scanExpenseCategory(r.pool.QueryRow(ctx, query,
+		category.Name,
+		category.Description,
+		category.Limit.Amount,
+		category.Limit.Currency,
+		category.ReceiptRequired,
+	))
+	if err != nil {
+		return nil, mapExpenseCategoryWriteError(err)
+	}
+	return createdCategory, nil
+}
+
+func (r *ExpenseRepoPostgres) GetCategoryById(ctx context.Context, id int) (*entity.ExpenseCategory, error) {
+	query := `
+		SELECT ` + expenseCategoryColumns + `
+		FROM expense_categories
+		WHERE id = $1
+	`
+	category, err := scanExpenseCategory(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return category, nil
+}
+
+func (r *ExpenseRepoPostgres) GetAllCategories(ctx context.Context) ([]*entity.ExpenseCategory, error) {
+	query := `
+		SELECT ` + expenseCategoryColumns + `
+		FROM expense_categories
+		ORDER BY name
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	categories := []*entity.ExpenseCategory{}
+	for rows.Next() {
+		category, err := scanExpenseCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, category)
+	}
+	return categories, rows.Err()
+}
+
+func (r *ExpenseRepoPostgres) UpdateCategory(ctx context.Context, category *entity.ExpenseCategory) (*entity.ExpenseCategory, error) {
+	query := `
+		UPDATE expense_categories
+		SET name = $1,
+			description = NULLIF($2, ''),
+			limit_amount = NULLIF($3, 0),
+			limit_currency = NULLIF($4, ''),
+			receipt_required = $5,
+			updated_at = NOW()
+		WHERE id = $6
+		RETURNING ` + expenseCategoryColumns
+
+	updatedCategory, err := scanExpenseCategory(r.pool.QueryRow(ctx, query,
+		category.Name,
+		category.Description,
+		category.Limit.Amount,
+		category.Limit.Currency,
+		category.ReceiptRequired,
+		category.ID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, mapExpenseCategoryWriteError(err)
+	}
+	return updatedCategory, nil
+}
+
+func (r *ExpenseRepoPostgres) DeleteCategory(ctx context.Context, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM expense_categories WHERE id = $1`, id)
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "expense_items_category_id_fkey" {
+			return appError.ErrExpenseCategoryInUse
+		}
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrExpenseCategoryNotFound
+	}
+	return nil
+}
+
+func (r *ExpenseRepoPostgres) CreateClaim(ctx context.Context, claim *entity.ExpenseClaim) (*entity.ExpenseClaim, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var claimID int
+	err = tx.QueryRow(ctx, `
+		INSERT INTO expense_claims (employee_id, title, currency, status)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`, claim.EmployeeID, claim.Title, claim.Currency, entity.ExpenseClaimDraft).Scan(&claimID)
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "expense_claims_employee_id_fkey" {
+			return nil, appError.ErrEmployeeNotFound
+		}
+		return nil, err
+	}
+	if err := insertExpenseItems(ctx, tx, claimID, claim.Items); err != nil {
+		return nil, mapExpenseClaimWriteError(err)
+	}
+	createdClaim, err := getExpenseClaim(ctx, tx, claim.EmployeeID, claimID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return createdClaim, nil
+}
+
+func (r *ExpenseRepoPostgres) GetClaimById(ctx context.Context, employeeID, id int) (*entity.ExpenseClaim, error) {
+	claim, err := getExpenseClaim(ctx, r.pool, employeeID, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return claim, nil
+}
+
+// buildExpenseClaimFilter turns filter into a WHERE clause (empty when nothing is filtered) and its
+// arguments.
+func buildExpenseClaimFilter(filter entity.ExpenseClaimFilter) (string, []any) {
+	conditions := []string{}
+	args := []any{}
+
+	if filter.EmployeeID > 0 {
+		args = append(args, filter.EmployeeID)
+		conditions = append(conditions, fmt.Sprintf("c.employee_id = $%d", len(args)))
+	}
+	if filter.DepartmentID > 0 {
+		args = append(args, filter.DepartmentID)
+		conditions = append(conditions, fmt.Sprintf("e.department_id = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("c.status = $%d", len(args)))
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func (r *ExpenseRepoPostgres) GetClaims(ctx context.Context, filter entity.ExpenseClaimFilter) ([]*entity.ExpenseClaim, error) {
+	where, args := buildExpenseClaimFilter(filter)
+	query := `
+		SELECT ` + expenseClaimColumns + expenseClaimFrom + `
+		` + where + `
+		ORDER BY c.created_at DESC, c.id DESC
+	`
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	claims := []*entity.ExpenseClaim{}
+	for rows.Next() {
+		claim, err := scanExpenseClaim(rows)
+		if err != nil {
+			return nil, err
+		}
+		claims = append(claims, claim)
+	}
+	return claims, rows.Err()
+}
+
+func (r *ExpenseRepoPostgres) UpdateClaim(ctx context.Context, claim *entity.ExpenseClaim) (*entity.ExpenseClaim, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Correcting a rejected claim drafts it anew, without the old decision.
+	result, err := tx.Exec(ctx, `
+		UPDATE expense_claims
+		SET title = $1,
+			currency = $2,
+			status = $3,
+			submitted_at = NULL,
+			approval_request_id = NULL,
+			decided_by = NULL,
+			decided_at = NULL,
+			decision_comment = NULL,
+			updated_at = NOW()
+		WHERE id = $4 AND employee_id = $5 AND status IN ($3, $6)
+	`,
+		claim.Title,
+		claim.Currency,
+		entity.ExpenseClaimDraft,
+		claim.ID,
+		claim.EmployeeID,
+		entity.ExpenseClaimRejected,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM expense_items WHERE claim_id = $1`, claim.ID); err != nil {
+		return nil, err
+	}
+	if err := insertExpenseItems(ctx, tx, claim.ID, claim.Items); err != nil {
+		return nil, mapExpenseClaimWriteError(err)
+	}
+	updatedClaim, err := getExpenseClaim(ctx, tx, claim.EmployeeID, claim.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return updatedClaim, nil
+}
+
+func (r *ExpenseRepoPostgres) UpdateClaimStatus(ctx context.Context, claim *entity.ExpenseClaim,
+	from entity.ExpenseClaimStatus) (*entity.ExpenseClaim, error) {
+
+	result, err := r.pool.Exec(ctx, `
+		UPDATE expense_claims
+		SET status = $1,
+			submitted_at = $2,
+			approval_request_id = $3,
+			decided_by = $4,
+			decided_at = $5,
+			decision_comment = NULLIF($6, ''),
+			reimbursed_on = $7,
+			payroll_run_id = $8,
+			reimbursement_reference = NULLIF($9, ''),
+			updated_at = NOW()
+		WHERE id = $10 AND employee_id = $11 AND status = $12
+	`,
+		claim.Status,
+		claim.SubmittedAt,
+		claim.ApprovalRequestID,
+		claim.DecidedBy,
+		claim.DecidedAt,
+		claim.DecisionComment,
+		claim.ReimbursedOn,
+		claim.PayrollRunID,
+		claim.ReimbursementReference,
+		claim.ID,
+		claim.EmployeeID,
+		from,
+	)
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "expense_claims_payroll_run_id_fkey" {
+			return nil, appError.ErrPayrollRunNotFound
+		}
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+	return r.GetClaimById(ctx, claim.EmployeeID, claim.ID)
+}
+
+func (r *ExpenseRepoPostgres) DeleteClaim(ctx context.Context, employeeID, id int) error {
+	result, err := r.pool.Exec(ctx, `
+		DELETE FROM expense_claims
+		WHERE id = $1 AND employee_id = $2 AND status IN ($3, $4)
+	`, id, employeeID, entity.ExpenseClaimDraft, entity.ExpenseClaimRejected)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrExpenseClaimNotEditable
+	}
+	return nil
+}
+
+func (r *ExpenseRepoPostgres) CreateReceipt(ctx context.Context, receipt *entity.ExpenseReceipt) (*entity.ExpenseReceipt, error) {
+	query := `
+		INSERT INTO expense_receipts (claim_id, file_name, content_type, size_bytes, checksum_sha256, storage_key)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + expenseReceiptColumns
+
+	createdReceipt, err := scanExpenseReceipt(r.pool.QueryRow(ctx, query,
+		receipt.ClaimID,
+		receipt.FileName,
+		receipt.ContentType,
+		receipt.Size,
+		receipt.Checksum,
+		receipt.StorageKey,
+	))
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "expense_receipts_claim_id_fkey" {
+			return nil, appError.ErrExpenseClaimNotFound
+		}
+		return nil, err
+	}
+	return createdReceipt, nil
+}
+
+func (r *ExpenseRepoPostgres) GetReceipts(ctx context.Context, claimID int) ([]*entity.ExpenseReceipt, error) {
+	query := `
+		SELECT ` + expenseReceiptColumns + `
+		FROM expense_receipts
+		WHERE claim_id = $1
+		ORDER BY uploaded_at, id
+	`
+	rows, err := r.pool.Query(ctx, query, claimID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	receipts := []*entity.ExpenseReceipt{}
+	for rows.Next() {
+		receipt, err := scanExpenseReceipt(rows)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, rows.Err()
+}
+
+func (r *ExpenseRepoPostgres) GetReceiptById(ctx context.Context, claimID, id int) (*entity.ExpenseReceipt, error) {
+	query := `
+		SELECT ` + expenseReceiptColumns + `
+		FROM expense_receipts
+		WHERE id = $1 AND claim_id = $2
+	`
+	receipt, err := scanExpenseReceipt(r.pool.QueryRow(ctx, query, id, claimID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return receipt, nil
+}
+
+func (r *ExpenseRepoPostgres) DeleteReceipt(ctx context.Context, claimID, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM expense_receipts WHERE id = $1 AND claim_id = $2`, id, claimID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrExpenseReceiptNotFound
+	}
+	return nil
+}
+
+func (r *ExpenseRepoPostgres) GetReport(ctx context.Context, filter entity.ExpenseReportFilter) ([]*entity.ExpenseReportRow, error) {
+	args := []any{entity.ExpenseClaimApproved, entity.ExpenseClaimReimbursed, filter.From, filter.To}
+	departmentCondition := ""
+	if filter.DepartmentID > 0 {
+		args = append(args, filter.DepartmentID)
+		departmentCondition = fmt.Sprintf("AND e.department_id = $%d", len(args))
+	}
+
+	// Claims count in every month one of their items falls in.
+	query := `
+		SELECT e.department_id, COALESCE(d.name, ''), date_trunc('month', i.expense_date)::date,
+			COUNT(DISTINCT c.id), SUM(i.amount), c.currency
+		FROM expense_items i
+		JOIN expense_claims c ON c.id = i.claim_id
+		JOIN employees e ON e.id = c.employee_id
+		LEFT JOIN departments d ON d.id = e.department_id
+		WHERE c.status IN ($1, $2) AND i.expense_date BETWEEN $3 AND $4 ` + departmentCondition + `
+		GROUP BY e.department_id, d.name, 3, c.currency
+		ORDER BY 3, d.name NULLS LAST, e.department_id, c.currency
+	`
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	report := []*entity.ExpenseReportRow{}
+	for rows.Next() {
+		var row entity.ExpenseReportRow
+		err := rows.Scan(
+			&row.DepartmentID,
+			&row.DepartmentName,
+			&row.Period,
+			&row.ClaimCount,
+			&row.Total.Amount,
+			&row.Total.Currency,
+		)
+		if err != nil {
+			return nil, err
+		}
+		report = append(report, &row)
+	}
+	return report, rows.Err()
+}
+
+func insertExpenseItems(ctx context.Context, tx pgx.Tx, claimID int, items []entity.ExpenseItem) error {
+	for number, item := range items {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO expense_items (claim_id, item_number, category_id, expense_date, description, amount)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`,
+			claimID,
+			number+1,
+			item.CategoryID,
+			item.ExpenseDate,
+			item.Description,
+			item.Amount.Amount,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getExpenseClaim returns a claim of the employee with its items, or pgx.ErrNoRows.
+func getExpenseClaim(ctx context.Context, q querier, employeeID, id int) (*entity.ExpenseClaim, error) {
+	query := `
+		SELECT ` + expenseClaimColumns + expenseClaimFrom + `
+		WHERE c.id = $1 AND c.employee_id = $2
+	`
+	claim, err := scanExpenseClaim(q.QueryRow(ctx, query, id, employeeID))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.Query(ctx, `
+		SELECT i.id, i.claim_id, i.category_id, ec.name, i.expense_date, i.description, i.amount
+		FROM expense_items i
+		JOIN expense_categories ec ON ec.id = i.category_id
+		WHERE i.claim_id = $1
+		ORDER BY i.item_number
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	claim.Items = []entity.ExpenseItem{}
+	for rows.Next() {
+		var item entity.ExpenseItem
+		err := rows.Scan(
+			&item.ID,
+			&item.ClaimID,
+			&item.CategoryID,
+			&item.CategoryName,
+			&item.ExpenseDate,
+			&item.Description,
+			&item.Amount.Amount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		item.Amount.Currency = claim.Currency
+		claim.Items = append(claim.Items, item)
+	}
+	return claim, rows.Err()
+}
@@ -0,0 +1,434 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// goalColumns selects a goal g with its owner's name from e, joined by goalFrom.
+const goalColumns = `
+	g.id, g.employee_id, COALESCE(e.name, ''), g.parent_id, g.title, COALESCE(g.description, ''),
+	g.start_date, g.due_date, g.status, g.created_at, g.updated_at`
+
+const goalFrom = `
+	FROM goals g
+	LEFT JOIN employees e ON e.id = g.employee_id`
+
+const keyResultColumns = `
+	id, goal_id, title, COALESCE(unit, ''), start_value::FLOAT8, target_value::FLOAT8, current_value::FLOAT8,
+	created_at, updated_at`
+
+type GoalRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewGoalRepository(pool *pgxpool.Pool) repository.GoalRepository {
+	return &GoalRepoPostgres{pool: pool}
+}
+
+func scanGoal(row pgx.Row) (*entity.Goal, error) {
+	var goal entity.Goal
+	err := row.Scan(
+		&goal.ID,
+		&goal.EmployeeID,
+		&goal.EmployeeName,
+		&goal.ParentID,
+		&goal.Title,
+		&goal.Description,
+		&goal.StartDate,
+		&goal.DueDate,
+		&goal.Status,
+		&goal.CreatedAt,
+		&goal.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	goal.KeyResults = []entity.KeyResult{}
+	return &goal, nil
+}
+
+func scanKeyResult(row pgx.Row) (*entity.KeyResult, error) {
+	var keyResult entity.KeyResult
+	err := row.Scan(
+		&keyResult.ID,
+		&keyResult.GoalID,
+		&keyResult.Title,
+		&keyResult.Unit,
+		&keyResult.StartValue,
+		&keyResult.TargetValue,
+		&keyResult.CurrentValue,
+		&keyResult.CreatedAt,
+		&keyResult.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &keyResult, nil
+}
+
+func mapGoalWriteError(err error) error {
+	switch foreignKeyViolationConstraint(err) {
+	case "goals_employee_id_fkey":
+		return appError.ErrEmployeeNotFound
+	case "goals_parent_id_fkey":
+		return appError.ErrInvalidGoalParent
+	}
+	return err
+}
+
+func (r *GoalRepoPostgres) CreateGoal(ctx context.Context, goal *entity.Goal) (*entity.Goal, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var goalID int
+	err = tx.QueryRow(ctx, `
+		INSERT INTO goals (employee_id, parent_id, title, description, start_date, due_date, status)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, $7)
+		RETURNING id
+	`,
+		goal.EmployeeID,
+		goal.ParentID,
+		goal.Title,
+		goal.Description,
+		goal.StartDate,
+		goal.DueDate,
+		goal.Status,
+	).Scan(&goalID)
+	if err != nil {
+		return nil, mapGoalWriteError(err)
+	}
+
+	for number, keyResult := range goal.KeyResults {
+		keyResult.GoalID = goalID
+		if _, err := insertKeyResult(ctx, tx, &keyResult, number+1); err != nil {
+			return nil, err
+		}
+	}
+	createdGoal, err := getGoal(ctx, tx, goalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return createdGoal, nil
+}
+
+func (r *GoalRepoPostgres) GetGoalById(ctx context.Context, id int) (*entity.Goal, error) {
+	goal, err := getGoal(ctx, r.pool, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return goal, nil
+}
+
+// buildGoalFilter turns filter into a WHERE clause (empty when nothing is filtered) and its arguments.
+func buildGoalFilter(filter entity.GoalFilter) (string, []any) {
+	conditions := []string{}
+	args := []any{}
+
+	if len(filter.EmployeeIDs) > 0 {
+		args = append(args, filter.EmployeeIDs)
+		conditions = append(conditions, fmt.Sprintf("g.employee_id = ANY($%d)", len(args)))
+	}
+	if filter.CompanyOnly {
+		conditions = append(conditions, "g.employee_id IS NULL")
+	}
+	if filter.ParentID > 0 {
+		args = append(args, filter.ParentID)
+		conditions = append(conditions, fmt.Sprintf("g.parent_id = $%d", len(args)))
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func (r *GoalRepoPostgres) GetGoals(ctx context.Context, filter entity.GoalFilter) ([]*entity.Goal, error) {
+	where, args := buildGoalFilter(filter)
+	query := `
+		SELECT ` + goalColumns + goalFrom + `
+		` + where + `
+		ORDER BY g.due_date, g.id
+	`
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	goals := []*entity.Goal{}
+	goalsByID := map[int]*entity.Goal{}
+	for rows.Next() {
+		goal, err := scanGoal(rows)
+		if err != nil {
+			return nil, err
+		}
+		goals = append(goals, goal)
+		goalsByID[goal.ID] = goal
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(goals) == 0 {
+		return goals, nil
+	}
+
+	ids := make([]int, 0, len(goals))
+	for _, goal := range goals {
+		ids = append(ids, goal.ID)
+	}
+	keyResults, err := queryKeyResults(ctx, r.pool, `goal_id = ANY($1)`, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, keyResult := range keyResults {
+		goal := goalsByID[keyResult.GoalID]
+		goal.KeyResults = append(goal.KeyResults, *keyResult)
+	}
+	return goals, nil
+}
+
+func (r *GoalRepoPostgres) UpdateGoal(ctx context.Context, goal *entity.Goal) (*entity.Goal, error) {
+	query := `
+		UPDATE goals
+		SET parent_id = $1,
+			title = $2,
+			description = NULLIF($3, ''),
+			start_date = $4,
+			due_date = $5,
+			status = $6,
+			updated_at = NOW()
+		WHERE id = $7
+	`
+	result, err := r.pool.Exec(ctx, query,
+		goal.ParentID,
+		goal.Title,
+		goal.Description,
+		goal.StartDate,
+		goal.DueDate,
+		goal.Status,
+		goal.ID,
+	)
+	if err != nil {
+		return nil, mapGoalWriteError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+	return r.GetGoalById(ctx, goal.ID)
+}
+
+func (r *GoalRepoPostgres) DeleteGoal(ctx context.Context, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM goals WHERE id = $1`, id)
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "goals_parent_id_fkey" {
+			return appError.ErrGoalHasAlignedGoals
+		}
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrGoalNotFound
+	}
+	return nil
+}
+
+func (r *GoalRepoPostgres) AddKeyResult(ctx context.Context, keyResult *entity.KeyResult) (*entity.KeyResult, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the goal so concurrent additions do not share a number.
+	var goalID int
+	err = tx.QueryRow(ctx, `SELECT id FROM goals WHERE id = $1 FOR UPDATE`, keyResult.GoalID).Scan(&goalID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, appError.ErrGoalNotFound
+		}
+		return nil, err
+	}
+	var number int
+	err = tx.QueryRow(ctx, `
+		SELECT COALESCE(MAX(key_result_number), 0) + 1
+		FROM key_results
+		WHERE goal_id = $1
+	`, goalID).Scan(&number)
+	if err != nil {
+		return nil, err
+	}
+
+	createdKeyResult, err := insertKeyResult(ctx, tx, keyResult, number)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec(ctx, `UPDATE goals SET updated_at = NOW() WHERE id = $1`, keyResult.GoalID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return createdKeyResult, nil
+}
+
+func (r *GoalRepoPostgres) CreateCheckIn(ctx context.Context, checkIn *entity.GoalCheckIn) (*entity.GoalCheckIn, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		UPDATE key_results
+		SET current_value = $1,
+			updated_at = NOW()
+		WHERE id = $2
+	`, checkIn.Value, checkIn.KeyResultID)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, appError.ErrKeyResultNotFound
+	}
+
+	var checkInID int
+	err = tx.QueryRow(ctx, `
+		INSERT INTO goal_check_ins (key_result_id, value, comment)
+		VALUES ($1, $2, NULLIF($3, ''))
+		RETURNING id
+	`, checkIn.KeyResultID, checkIn.Value, checkIn.Comment).Scan(&checkInID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec(ctx, `
+		UPDATE goals SET updated_at = NOW()
+		WHERE id = (SELECT goal_id FROM key_results WHERE id = $1)
+	`, checkIn.KeyResultID); err != nil {
+		return nil, err
+	}
+
+	createdCheckIns, err := queryCheckIns(ctx, tx, `c.id = $1`, checkInID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return createdCheckIns[0], nil
+}
+
+func (r *GoalRepoPostgres) GetCheckIns(ctx context.Context, goalID int) ([]*entity.GoalCheckIn, error) {
+	return queryCheckIns(ctx, r.pool, `k.goal_id = $1`, goalID)
+}
+
+func getGoal(ctx context.Context, q querier, id int) (*entity.Goal, error) {
+	query := `
+		SELECT ` + goalColumns + goalFrom + `
+		WHERE g.id = $1
+	`
+	goal, err := scanGoal(q.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, err
+	}
+
+	keyResults, err := queryKeyResults(ctx, q, `goal_id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, keyResult := range keyResults {
+		goal.KeyResults = append(goal.KeyResults, *keyResult)
+	}
+	return goal, nil
+}
+
+func queryKeyResults(ctx context.Context, q querier, where string, args ...any) ([]*entity.KeyResult, error) {
+	rows, err := q.Query(ctx, `
+		SELECT `+keyResultColumns+`
+		FROM key_results
+		WHERE `+where+`
+		ORDER BY goal_id, key_result_number
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keyResults := []*entity.KeyResult{}
+	for rows.Next() {
+		keyResult, err := scanKeyResult(rows)
+		if err != nil {
+			return nil, err
+		}
+		keyResults = append(keyResults, keyResult)
+	}
+	return keyResults, rows.Err()
+}
+
+func queryCheckIns(ctx context.Context, q querier, where string, args ...any) ([]*entity.GoalCheckIn, error) {
+	rows, err := q.Query(ctx, `
+		SELECT c.id, k.goal_id, c.key_result_id, k.title, c.value::FLOAT8, COALESCE(c.comment, ''), c.created_at
+		FROM goal_check_ins c
+		JOIN key_results k ON k.id = c.key_result_id
+		WHERE `+where+`
+		ORDER BY c.created_at DESC, c.id DESC
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	checkIns := []*entity.GoalCheckIn{}
+	for rows.Next() {
+		var checkIn entity.GoalCheckIn
+		err := rows.Scan(
+			&checkIn.ID,
+			&checkIn.GoalID,
+			&checkIn.KeyResultID,
+			&checkIn.KeyResultTitle,
+			&checkIn.Value,
+			&checkIn.Comment,
+			&checkIn.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		checkIns = append(checkIns, &checkIn)
+	}
+	return checkIns, rows.Err()
+}
+
+func insertKeyResult(ctx context.Context, tx pgx.Tx, keyResult *entity.KeyResult, number int) (*entity.KeyResult, error) {
+	query := `
+		INSERT INTO key_results (goal_id, title, unit, start_value, target_value, current_value, key_result_number)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $4, $6)
+		RETURNING ` + keyResultColumns
+	return scanKeyResult(tx.QueryRow(ctx, query,
+		keyResult.GoalID,
+		keyResult.Title,
+		keyResult.Unit,
+		keyResult.StartValue,
+		keyResult.TargetValue,
+		number,
+	))
+}
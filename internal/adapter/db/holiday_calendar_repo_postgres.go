@@ -0,0 +1,240 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const holidayCalendarColumns = `id, name, COALESCE(country, ''), created_at, updated_at`
+
+const holidayColumns = `id, calendar_id, date, name, created_at`
+
+type HolidayCalendarRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewHolidayCalendarRepository(pool *pgxpool.Pool) repository.HolidayCalendarRepository {
+	return &HolidayCalendarRepoPostgres{pool: pool}
+}
+
+func scanHolidayCalendar(row pgx.Row) (*entity.HolidayCalendar, error) {
+	var calendar entity.HolidayCalendar
+	err := row.Scan(
+		&calendar.ID,
+		&calendar.Name,
+		&calendar.Country,
+		&calendar.CreatedAt,
+		&calendar.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &calendar, nil
+}
+
+func scanHoliday(row pgx.Row) (*entity.Holiday, error) {
+	var holiday entity.Holiday
+	err := row.Scan(
+		&holiday.ID,
+		&holiday.CalendarID,
+		&holiday.Date,
+		&holiday.Name,
+		&holiday.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &holiday, nil
+}
+
+func (r *HolidayCalendarRepoPostgres) CreateHolidayCalendar(ctx context.Context,
+	calendar *entity.HolidayCalendar) (*entity.HolidayCalendar, error) {
+
+	query := `
+		INSERT INTO holiday_calendars (name, country)
+		VALUES ($1, NULLIF($2, ''))
+		RETURNING ` + holidayCalendarColumns
+
+	createdCalendar, err := scanHolidayCalendar(r.pool.QueryRow(ctx, query, calendar.Name, calendar.Country))
+	if err != nil {
+		if uniqueViolationConstraint(err) == "holiday_calendars_name_key" {
+			return nil, appError.ErrHolidayCalendarAlreadyExists
+		}
+		return nil, err
+	}
+	return createdCalendar, nil
+}
+
+func (r *HolidayCalendarRepoPostgres) GetHolidayCalendarById(ctx context.Context, id int) (*entity.HolidayCalendar, error) {
+	query := `
+		SELECT ` + holidayCalendarColumns + `
+		FROM holiday_calendars
+		WHERE id = $1
+	`
+	calendar, err := scanHolidayCalendar(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return calendar, nil
+}
+
+func (r *HolidayCalendarRepoPostgres) GetAllHolidayCalendars(ctx context.Context) ([]*entity.HolidayCalendar, error) {
+	query := `
+		SELECT ` + holidayCalendarColumns + `
+		FROM holiday_calendars
+		ORDER BY name
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	calendars := []*entity.HolidayCalendar{}
+	for rows.Next() {
+		calendar, err := scanHolidayCalendar(rows)
+		if err != nil {
+			return nil, err
+		}
+		calendars = append(calendars, calendar)
+	}
+	return calendars, rows.Err()
+}
+
+func (r *HolidayCalendarRepoPostgres) UpdateHolidayCalendar(ctx context.Context,
+	calendar *entity.HolidayCalendar) (*entity.HolidayCalendar, error) {
+
+	query := `
+		UPDATE holiday_calendars
+		SET name = $1,
+			country = NULLIF($2, ''),
+			updated_at = NOW()
+		WHERE id = $3
+		RETURNING ` + holidayCalendarColumns
+
+	updatedCalendar, err := scanHolidayCalendar(r.pool.QueryRow(ctx, query, calendar.Name, calendar.Country, calendar.ID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		if uniqueViolationConstraint(err) == "holiday_calendars_name_key" {
+			return nil, appError.ErrHolidayCalendarAlreadyExists
+		}
+		return nil, err
+	}
+	return updatedCalendar, nil
+}
+
+func (r *HolidayCalendarRepoPostgres) DeleteHolidayCalendar(ctx context.Context, id int) error {
+	query := `
+		DELETE FROM holiday_calendars
+		WHERE id = $1
+	`
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "locations_holiday_calendar_id_fkey" {
+			return appError.ErrHolidayCalendarInUse
+		}
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return appError.ErrHolidayCalendarNotFound
+	}
+	return nil
+}
+
+func (r *HolidayCalendarRepoPostgres) CreateHoliday(ctx context.Context, holiday *entity.Holiday) (*entity.Holiday, error) {
+	query := `
+		INSERT INTO holidays (calendar_id, date, name)
+		VALUES ($1, $2, $3)
+		RETURNING ` + holidayColumns
+
+	createdHoliday, err := scanHoliday(r.pool.QueryRow(ctx, query, holiday.CalendarID, holiday.Date, holiday.Name))
+	if err != nil {
+		if uniqueViolationConstraint(err) == "holidays_calendar_date_key" {
+			return nil, appError.ErrHolidayAlreadyExists
+		}
+		return nil, err
+	}
+	return createdHoliday, nil
+}
+
+func (r *HolidayCalendarRepoPostgres) GetHolidays(ctx context.Context, calendarID int, start, end time.Time) ([]*entity.Holiday, error) {
+	query := `
+		SELECT ` + holidayColumns + `
+		FROM holidays
+		WHERE calendar_id = $1 AND date BETWEEN $2 AND $3
+		ORDER BY date
+	`
+	rows, err := r.pool.Query(ctx, query, calendarID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	holidays := []*entity.Holiday{}
+	for rows.Next() {
+		holiday, err := scanHoliday(rows)
+		if err != nil {
+			return nil, err
+		}
+		holidays = append(holidays, holiday)
+	}
+	return holidays, rows.Err()
+}
+
+func (r *HolidayCalendarRepoPostgres) DeleteHoliday(ctx context.Context, calendarID, id int) error {
+	query := `
+		DELETE FROM holidays
+		WHERE id = $1 AND calendar_id = $2
+	`
+	result, err := r.pool.Exec(ctx, query, id, calendarID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return appError.ErrHolidayNotFound
+	}
+	return nil
+}
+
+func (r *HolidayCalendarRepoPostgres) UpsertHolidays(ctx context.Context, calendarID int, holidays []*entity.Holiday) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO holidays (calendar_id, date, name)
+		VALUES ($1, $2, $3)
+		ON CONFLICT ON CONSTRAINT holidays_calendar_date_key
+		DO UPDATE SET name = EXCLUDED.name
+	`
+	written := 0
+	for _, holiday := range holidays {
+		result, err := tx.Exec(ctx, query, calendarID, holiday.Date, holiday.Name)
+		if err != nil {
+			return 0, err
+		}
+		written += int(result.RowsAffected())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return written, nil
+}
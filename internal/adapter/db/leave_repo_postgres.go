@@ -0,0 +1,525 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const leaveTypeColumns = `
+	id, name, accrual_policy, days_per_year::FLOAT8, max_carry_over_days::FLOAT8, is_paid, created_at, updated_at`
+
+const leaveBalanceColumns = `
+	id, employee_id, leave_type_id, year, accrued_days::FLOAT8, carried_over_days::FLOAT8, used_days::FLOAT8, updated_at`
+
+const leaveRequestColumns = `
+	id, employee_id, leave_type_id, start_date, end_date, days::FLOAT8, COALESCE(reason, ''), status,
+	approval_request_id, decided_by, decided_at, COALESCE(decision_comment, ''), status_applied, created_at, updated_at`
+
+type LeaveRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewLeaveRepository(pool *pgxpool.Pool) repository.LeaveRepository {
+	return &LeaveRepoPostgres{pool: pool}
+}
+
+func scanLeaveType(row pgx.Row) (*entity.LeaveType, error) {
+	var leaveType entity.LeaveType
+	err := row.Scan(
+		&leaveType.ID,
+		&leaveType.Name,
+		&leaveType.AccrualPolicy,
+		&leaveType.DaysPerYear,
+		&leaveType.MaxCarryOverDays,
+		&leaveType.IsPaid,
+		&leaveType.CreatedAt,
+		&leaveType.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &leaveType, nil
+}
+
+func scanLeaveBalance(row pgx.Row) (*entity.LeaveBalance, error) {
+	var balance entity.LeaveBalance
+	err := row.Scan(
+		&balance.ID,
+		&balance.EmployeeID,
+		&balance.LeaveTypeID,
+		&balance.Year,
+		&balance.AccruedDays,
+		&balance.CarriedOverDays,
+		&balance.UsedDays,
+		&balance.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &balance, nil
+}
+
+func scanLeaveRequest(row pgx.Row) (*entity.LeaveRequest, error) {
+	var request entity.LeaveRequest
+	err := row.Scan(
+		&request.ID,
+		&request.EmployeeID,
+		&request.LeaveTypeID,
+		&request.StartDate,
+		&request.EndDate,
+		&request.Days,
+		&request.Reason,
+		&request.Status,
+		&request.ApprovalRequestID,
+		&request.DecidedBy,
+		&request.DecidedAt,
+		&request.DecisionComment,
+		&request.StatusApplied,
+		&request.CreatedAt,
+		&request.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+func mapLeaveTypeWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "leave_types_name_key" {
+		return appError.ErrLeaveTypeAlreadyExists
+	}
+	return err
+}
+
+func (r *LeaveRepoPostgres) CreateLeaveType(ctx context.Context, leaveType *entity.LeaveType) (*entity.LeaveType, error) {
+	query := `
+		INSERT INTO leave_types (name, accrual_policy, days_per_year, max_carry_over_days, is_paid)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + leaveTypeColumns
+
+	createdType, err := scanLeaveType(r.pool.QueryRow(ctx, query,
+		leaveType.Name,
+		leaveType.AccrualPolicy,
+		leaveType.DaysPerYear,
+		leaveType.MaxCarryOverDays,
+		leaveType.IsPaid,
+	))
+	if err != nil {
+		return nil, mapLeaveTypeWriteError(err)
+	}
+	return createdType, nil
+}
+
+func (r *LeaveRepoPostgres) GetLeaveTypeById(ctx context.Context, id int) (*entity.LeaveType, error) {
+	query := `
+		SELECT ` + leaveTypeColumns + `
+		FROM leave_types
+		WHERE id = $1
+	`
+	leaveType, err := scanLeaveType(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return leaveType, nil
+}
+
+func (r *LeaveRepoPostgres) GetAllLeaveTypes(ctx context.Context) ([]*entity.LeaveType, error) {
+	query := `
+		SELECT ` + leaveTypeColumns + `
+		FROM leave_types
+		ORDER BY name
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	leaveTypes := []*entity.LeaveType{}
+	for rows.Next() {
+		leaveType, err := scanLeaveType(rows)
+		if err != nil {
+			return nil, err
+		}
+		leaveTypes = append(leaveTypes, leaveType)
+	}
+	return leaveTypes, rows.Err()
+}
+
+func (r *LeaveRepoPostgres) UpdateLeaveType(ctx context.Context, leaveType *entity.LeaveType) (*entity.LeaveType, error) {
+	query := `
+		UPDATE leave_types
+		SET name = $1,
+			accrual_policy = $2,
+			days_per_year = $3,
+			max_carry_over_days = $4,
+			is_paid = $5,
+			updated_at = NOW()
+		WHERE id = $6
+		RETURNING ` + leaveTypeColumns
+
+	updatedType, err := scanLeaveType(r.pool.QueryRow(ctx, query,
+		leaveType.Name,
+		leaveType.AccrualPolicy,
+		leaveType.DaysPerYear,
+		leaveType.MaxCarryOverDays,
+		leaveType.IsPaid,
+		leaveType.ID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, mapLeaveTypeWriteError(err)
+	}
+	return updatedType, nil
+}
+
+func (r *LeaveRepoPostgres) DeleteLeaveType(ctx context.Context, id int) error {
+	query := `
+		DELETE FROM leave_types
+		WHERE id = $1
+	`
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "leave_requests_leave_type_id_fkey" {
+			return appError.ErrLeaveTypeInUse
+		}
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return appError.ErrLeaveTypeNotFound
+	}
+	return nil
+}
+
+func (r *LeaveRepoPostgres) GetLeaveBalance(ctx context.Context, employeeID, leaveTypeID, year int) (*entity.LeaveBalance, error) {
+	query := `
+		SELECT ` + leaveBalanceColumns + `
+		FROM leave_balances
+		WHERE employee_id = $1 AND leave_type_id = $2 AND year = $3
+	`
+	balance, err := scanLeaveBalance(r.pool.QueryRow(ctx, query, employeeID, leaveTypeID, year))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return balance, nil
+}
+
+func (r *LeaveRepoPostgres) GetLeaveBalances(ctx context.Context, employeeID, year int) ([]*entity.LeaveBalance, error) {
+	query := `
+		SELECT ` + leaveBalanceColumns + `
+		FROM leave_balances
+		WHERE employee_id = $1 AND year = $2
+		ORDER BY leave_type_id
+	`
+	rows, err := r.pool.Query(ctx, query, employeeID, year)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	balances := []*entity.LeaveBalance{}
+	for rows.Next() {
+		balance, err := scanLeaveBalance(rows)
+		if err != nil {
+			return nil, err
+		}
+		balances = append(balances, balance)
+	}
+	return balances, rows.Err()
+}
+
+func (r *LeaveRepoPostgres) UpsertLeaveBalance(ctx context.Context, balance *entity.LeaveBalance) (*entity.LeaveBalance, error) {
+	query := `
+		INSERT INTO leave_balances (employee_id, leave_type_id, year, accrued_days, carried_over_days)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT ON CONSTRAINT leave_balances_employee_type_year_key
+		DO UPDATE SET accrued_days = EXCLUDED.accrued_days,
+			carried_over_days = EXCLUDED.carried_over_days,
+			updated_at = NOW()
+		RETURNING ` + leaveBalanceColumns
+
+	return scanLeaveBalance(r.pool.QueryRow(ctx, query,
+		balance.EmployeeID,
+		balance.LeaveTypeID,
+		balance.Year,
+		balance.AccruedDays,
+		balance.CarriedOverDays,
+	))
+}
+
+func (r *LeaveRepoPostgres) CreateLeaveRequest(ctx context.Context, request *entity.LeaveRequest) (*entity.LeaveRequest, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Locking the employee row serializes concurrent requests, so the overlap check below holds.
+	var employeeID int
+	err = tx.QueryRow(ctx, `SELECT id FROM employees WHERE id = $1 FOR UPDATE`, request.EmployeeID).Scan(&employeeID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, appError.ErrEmployeeNotFound
+		}
+		return nil, err
+	}
+
+	var overlaps bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM leave_requests
+			WHERE employee_id = $1
+				AND status IN ('pending', 'approved')
+				AND start_date <= $3 AND end_date >= $2
+		)
+	`, request.EmployeeID, request.StartDate, request.EndDate).Scan(&overlaps)
+	if err != nil {
+		return nil, err
+	}
+	if overlaps {
+		return nil, appError.ErrLeaveRequestOverlaps
+	}
+
+	query := `
+		INSERT INTO leave_requests (employee_id, leave_type_id, start_date, end_date, days, reason)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''))
+		RETURNING ` + leaveRequestColumns
+
+	createdRequest, err := scanLeaveRequest(tx.QueryRow(ctx, query,
+		request.EmployeeID,
+		request.LeaveTypeID,
+		request.StartDate,
+		request.EndDate,
+		request.Days,
+		request.Reason,
+	))
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "leave_requests_leave_type_id_fkey" {
+			return nil, appError.ErrLeaveTypeNotFound
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return createdRequest, nil
+}
+
+func (r *LeaveRepoPostgres) GetLeaveRequestById(ctx context.Context, employeeID, id int) (*entity.LeaveRequest, error) {
+	query := `
+		SELECT ` + leaveRequestColumns + `
+		FROM leave_requests
+		WHERE id = $1 AND employee_id = $2
+	`
+	request, err := scanLeaveRequest(r.pool.QueryRow(ctx, query, id, employeeID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return request, nil
+}
+
+func (r *LeaveRepoPostgres) GetLeaveRequestsByEmployeeId(ctx context.Context, employeeID int) ([]*entity.LeaveRequest, error) {
+	query := `
+		SELECT ` + leaveRequestColumns + `
+		FROM leave_requests
+		WHERE employee_id = $1
+		ORDER BY start_date DESC, id DESC
+	`
+	return r.queryLeaveRequests(ctx, query, employeeID)
+}
+
+// adjustUsedDays adds days (negative to give them back) to the used days of a balance.
+// Deductions only succeed while the balance covers them.
+func adjustUsedDays(ctx context.Context, tx pgx.Tx, request *entity.LeaveRequest, days float64) error {
+	result, err := tx.Exec(ctx, `
+		UPDATE leave_balances
+		SET used_days = GREATEST(used_days + $1, 0), updated_at = NOW()
+		WHERE employee_id = $2 AND leave_type_id = $3 AND year = $4
+			AND ($1 <= 0 OR accrued_days + carried_over_days - used_days >= $1)
+	`, days, request.EmployeeID, request.LeaveTypeID, request.StartDate.Year())
+	if err != nil {
+		return err
+	}
+	if days > 0 && result.RowsAffected() == 0 {
+		return appError.ErrInsufficientLeaveBalance
+	}
+	return nil
+}
+
+func (r *LeaveRepoPostgres) SetLeaveRequestApproval(ctx context.Context, id int,
+	approvalRequestID *int) (*entity.LeaveRequest, error) {
+
+	query := `
+		UPDATE leave_requests
+		SET approval_request_id = $1, updated_at = NOW()
+		WHERE id = $2 AND status = 'pending'
+		RETURNING ` + leaveRequestColumns
+
+	request, err := scanLeaveRequest(r.pool.QueryRow(ctx, query, approvalRequestID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return request, nil
+}
+
+func (r *LeaveRepoPostgres) ApproveLeaveRequest(ctx context.Context, request *entity.LeaveRequest,
+	deductBalance bool) (*entity.LeaveRequest, error) {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	approvedRequest, err := scanLeaveRequest(tx.QueryRow(ctx, `
+		UPDATE leave_requests
+		SET status = 'approved', decided_by = $1, decided_at = NOW(), decision_comment = NULLIF($2, ''), updated_at = NOW()
+		WHERE id = $3 AND status = 'pending'
+		RETURNING `+leaveRequestColumns, request.DecidedBy, request.DecisionComment, request.ID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if deductBalance {
+		if err := adjustUsedDays(ctx, tx, approvedRequest, approvedRequest.Days); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return approvedRequest, nil
+}
+
+func (r *LeaveRepoPostgres) RejectLeaveRequest(ctx context.Context, request *entity.LeaveRequest) (*entity.LeaveRequest, error) {
+	query := `
+		UPDATE leave_requests
+		SET status = 'rejected', decided_by = $1, decided_at = NOW(), decision_comment = NULLIF($2, ''), updated_at = NOW()
+		WHERE id = $3 AND status = 'pending'
+		RETURNING ` + leaveRequestColumns
+
+	rejectedRequest, err := scanLeaveRequest(r.pool.QueryRow(ctx, query, request.DecidedBy, request.DecisionComment, request.ID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return rejectedRequest, nil
+}
+
+func (r *LeaveRepoPostgres) CancelLeaveRequest(ctx context.Context, request *entity.LeaveRequest,
+	restoreBalance bool) (*entity.LeaveRequest, error) {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var previousStatus entity.LeaveRequestStatus
+	err = tx.QueryRow(ctx, `
+		SELECT status FROM leave_requests
+		WHERE id = $1 AND status IN ('pending', 'approved')
+		FOR UPDATE
+	`, request.ID).Scan(&previousStatus)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	cancelledRequest, err := scanLeaveRequest(tx.QueryRow(ctx, `
+		UPDATE leave_requests
+		SET status = 'cancelled', updated_at = NOW()
+		WHERE id = $1
+		RETURNING `+leaveRequestColumns, request.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if restoreBalance && previousStatus == entity.LeaveRequestStatusApproved {
+		if err := adjustUsedDays(ctx, tx, cancelledRequest, -cancelledRequest.Days); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return cancelledRequest, nil
+}
+
+func (r *LeaveRepoPostgres) GetLeaveRequestsToStart(ctx context.Context, asOf time.Time) ([]*entity.LeaveRequest, error) {
+	query := `
+		SELECT ` + leaveRequestColumns + `
+		FROM leave_requests
+		WHERE status = 'approved' AND NOT status_applied AND start_date <= $1 AND end_date >= $1
+		ORDER BY start_date, id
+	`
+	return r.queryLeaveRequests(ctx, query, asOf)
+}
+
+func (r *LeaveRepoPostgres) GetLeaveRequestsToEnd(ctx context.Context, asOf time.Time) ([]*entity.LeaveRequest, error) {
+	query := `
+		SELECT ` + leaveRequestColumns + `
+		FROM leave_requests
+		WHERE status_applied AND (end_date < $1 OR status <> 'approved')
+		ORDER BY end_date, id
+	`
+	return r.queryLeaveRequests(ctx, query, asOf)
+}
+
+func (r *LeaveRepoPostgres) SetLeaveStatusApplied(ctx context.Context, id int, applied bool) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE leave_requests SET status_applied = $1, updated_at = NOW() WHERE id = $2
+	`, applied, id)
+	return err
+}
+
+func (r *LeaveRepoPostgres) queryLeaveRequests(ctx context.Context, query string, args ...any) ([]*entity.LeaveRequest, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	requests := []*entity.LeaveRequest{}
+	for rows.Next() {
+		request, err := scanLeaveRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, rows.Err()
+}
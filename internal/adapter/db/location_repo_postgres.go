@@ -0,0 +1,377 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const locationColumns = `
+	id, name, COALESCE(address_line1, ''), COALESCE(address_line2, ''), COALESCE(city, ''), COALESCE(state, ''),
+	COALESCE(postal_code, ''), COALESCE(country, ''), time_zone, remote, holiday_calendar_id, created_at, updated_at`
+
+// locationAssignmentColumns selects an assignment a with the name of its location l.
+const locationAssignmentColumns = `
+	a.id, a.employee_id, a.location_id, l.name, a.effective_date, a.status, a.applied_at, a.created_at`
+
+type LocationRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewLocationRepository(pool *pgxpool.Pool) repository.LocationRepository {
+	return &LocationRepoPostgres{pool: pool}
+}
+
+func scanLocation(row pgx.Row) (*entity.Location, error) {
+	var location entity.Location
+	err := row.Scan(
+		&location.ID,
+		&location.Name,
+		&location.Address.Line1,
+		&location.Address.Line2,
+		&location.Address.City,
+		&location.Address.State,
+		&location.Address.PostalCode,
+		&location.Address.Country,
+		&location.TimeZone,
+		&location.Remote,
+		&location.HolidayCalendarID,
+		&location.CreatedAt,
+		&location.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &location, nil
+}
+
+func mapLocationWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "locations_name_key" {
+		return appError.ErrLocationAlreadyExists
+	}
+	if foreignKeyViolationConstraint(err) == "locations_holiday_calendar_id_fkey" {
+		return appError.ErrHolidayCalendarNotFound
+	}
+	return err
+}
+
+func (r *LocationRepoPostgres) CreateLocation(ctx context.Context, location *entity.Location) (*entity.Location, error) {
+	query := `
+		INSERT INTO locations (
+			name, address_line1, address_line2, city, state, postal_code, country, time_zone, remote,
+			holiday_calendar_id
+		)
+		VALUES ($1, NULLIF($2, ''), NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, ''), NULLIF($6, ''), NULLIF($7, ''),
+			$8, $9, $10)
+		RETURNING ` + locationColumns
+
+	createdLocation, err := scanLocation(r.pool.QueryRow(ctx, query,
+		location.Name,
+		location.Address.Line1,
+		location.Address.Line2,
+		location.Address.City,
+		location.Address.State,
+		location.Address.PostalCode,
+		location.Address.Country,
+		location.TimeZone,
+		location.Remote,
+		location.HolidayCalendarID,
+	))
+	if err != nil {
+		return nil, mapLocationWriteError(err)
+	}
+	return createdLocation, nil
+}
+
+func (r *LocationRepoPostgres) GetLocationById(ctx context.Context, id int) (*entity.Location, error) {
+	query := `
+		SELECT ` + locationColumns + `
+		FROM locations
+		WHERE id = $1
+	`
+	location, err := scanLocation(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return location, nil
+}
+
+func (r *LocationRepoPostgres) GetAllLocations(ctx context.Context) ([]*entity.Location, error) {
+	query := `
+		SELECT ` + locationColumns + `
+		FROM locations
+		ORDER BY name
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	locations := []*entity.Location{}
+	for rows.Next() {
+		location, err := scanLocation(rows)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, location)
+	}
+	return locations, rows.Err()
+}
+
+func (r *LocationRepoPostgres) UpdateLocation(ctx context.Context, location *entity.Location) (*entity.Location, error) {
+	query := `
+		UPDATE locations
+		SET name = $1,
+			address_line1 = NULLIF($2, ''),
+			address_line2 = NULLIF($3, ''),
+			city = NULLIF($4, ''),
+			state = NULLIF($5, ''),
+			postal_code = NULLIF($6, ''),
+			country = NULLIF($7, ''),
+			time_zone = $8,
+			remote = $9,
+			holiday_calendar_id = $10,
+			updated_at = NOW()
+		WHERE id = $11
+		RETURNING ` + locationColumns
+
+	updatedLocation, err := scanLocation(r.pool.QueryRow(ctx, query,
+		location.Name,
+		location.Address.Line1,
+		location.Address.Line2,
+		location.Address.City,
+		location.Address.State,
+		location.Address.PostalCode,
+		location.Address.Country,
+		location.TimeZone,
+		location.Remote,
+		location.HolidayCalendarID,
+		location.ID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, mapLocationWriteError(err)
+	}
+	return updatedLocation, nil
+}
+
+func (r *LocationRepoPostgres) DeleteLocation(ctx context.Context, id int) error {
+	query := `
+		DELETE FROM locations
+		WHERE id = $1
+	`
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		switch foreignKeyViolationConstraint(err) {
+		case "employees_location_id_fkey", "location_assignments_location_id_fkey", "benefit_plan_locations_location_id_fkey":
+			return appError.ErrLocationInUse
+		}
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return appError.ErrLocationNotFound
+	}
+	return nil
+}
+
+func scanLocationAssignment(row pgx.Row) (*entity.LocationAssignment, error) {
+	var assignment entity.LocationAssignment
+	err := row.Scan(
+		&assignment.ID,
+		&assignment.EmployeeID,
+		&assignment.LocationID,
+		&assignment.LocationName,
+		&assignment.EffectiveDate,
+		&assignment.Status,
+		&assignment.AppliedAt,
+		&assignment.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+func mapLocationAssignmentWriteError(err error) error {
+	switch foreignKeyViolationConstraint(err) {
+	case "location_assignments_employee_id_fkey":
+		return appError.ErrEmployeeNotFound
+	case "location_assignments_location_id_fkey":
+		return appError.ErrLocationNotFound
+	}
+	return err
+}
+
+// getLocationAssignment reads an assignment with its location name.
+func getLocationAssignment(ctx context.Context, q querier, id int) (*entity.LocationAssignment, error) {
+	return scanLocationAssignment(q.QueryRow(ctx, `
+		SELECT `+locationAssignmentColumns+`
+		FROM location_assignments a
+		JOIN locations l ON l.id = a.location_id
+		WHERE a.id = $1
+	`, id))
+}
+
+// insertAppliedLocationAssignment records an assignment that has already been applied to the
+// employee row.
+func insertAppliedLocationAssignment(ctx context.Context, tx pgx.Tx, assignment *entity.LocationAssignment) (*entity.LocationAssignment, error) {
+	var assignmentID int
+	err := tx.QueryRow(ctx, `
+		INSERT INTO location_assignments (employee_id, location_id, effective_date, status, applied_at)
+		VALUES ($1, $2, $3, 'applied', NOW())
+		RETURNING id
+	`, assignment.EmployeeID, assignment.LocationID, assignment.EffectiveDate).Scan(&assignmentID)
+	if err != nil {
+		return nil, mapLocationAssignmentWriteError(err)
+	}
+	return getLocationAssignment(ctx, tx, assignmentID)
+}
+
+func setEmployeeLocation(ctx context.Context, tx pgx.Tx, employeeID, locationID int) (bool, error) {
+	result, err := tx.Exec(ctx, `
+		UPDATE employees SET location_id = $1, updated_at = NOW() WHERE id = $2
+	`, locationID, employeeID)
+	if err != nil {
+		return false, mapEmployeeWriteError(err)
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+func (r *LocationRepoPostgres) CreateScheduledAssignment(ctx context.Context,
+	assignment *entity.LocationAssignment) (*entity.LocationAssignment, error) {
+
+	var assignmentID int
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO location_assignments (employee_id, location_id, effective_date, status)
+		VALUES ($1, $2, $3, 'scheduled')
+		RETURNING id
+	`, assignment.EmployeeID, assignment.LocationID, assignment.EffectiveDate).Scan(&assignmentID)
+	if err != nil {
+		return nil, mapLocationAssignmentWriteError(err)
+	}
+	return getLocationAssignment(ctx, r.pool, assignmentID)
+}
+
+func (r *LocationRepoPostgres) ApplyAssignment(ctx context.Context,
+	assignment *entity.LocationAssignment) (*entity.LocationAssignment, error) {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	updated, err := setEmployeeLocation(ctx, tx, assignment.EmployeeID, assignment.LocationID)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, nil
+	}
+	appliedAssignment, err := insertAppliedLocationAssignment(ctx, tx, assignment)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return appliedAssignment, nil
+}
+
+func (r *LocationRepoPostgres) ApplyScheduledAssignment(ctx context.Context, id int) (*entity.LocationAssignment, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var employeeID, locationID int
+	err = tx.QueryRow(ctx, `
+		UPDATE location_assignments
+		SET status = 'applied', applied_at = NOW()
+		WHERE id = $1 AND status = 'scheduled'
+		RETURNING employee_id, location_id
+	`, id).Scan(&employeeID, &locationID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if _, err := setEmployeeLocation(ctx, tx, employeeID, locationID); err != nil {
+		return nil, err
+	}
+	appliedAssignment, err := getLocationAssignment(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return appliedAssignment, nil
+}
+
+func (r *LocationRepoPostgres) GetDueScheduledAssignments(ctx context.Context, asOf time.Time) ([]*entity.LocationAssignment, error) {
+	return r.queryAssignments(ctx, `a.status = 'scheduled' AND a.effective_date <= $1`, asOf)
+}
+
+func (r *LocationRepoPostgres) GetAssignmentsByEmployeeId(ctx context.Context, employeeID int) ([]*entity.LocationAssignment, error) {
+	return r.queryAssignments(ctx, `a.employee_id = $1`, employeeID)
+}
+
+func (r *LocationRepoPostgres) queryAssignments(ctx context.Context, where string, args ...any) ([]*entity.LocationAssignment, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+locationAssignmentColumns+`
+		FROM location_assignments a
+		JOIN locations l ON l.id = a.location_id
+		WHERE `+where+`
+		ORDER BY a.effective_date, a.id
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	assignments := []*entity.LocationAssignment{}
+	for rows.Next() {
+		assignment, err := scanLocationAssignment(rows)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, assignment)
+	}
+	return assignments, rows.Err()
+}
+
+func (r *LocationRepoPostgres) CancelScheduledAssignment(ctx context.Context, employeeID, id int) error {
+	query := `
+		UPDATE location_assignments
+		SET status = 'cancelled'
+		WHERE employee_id = $1 AND id = $2 AND status = 'scheduled'
+	`
+	result, err := r.pool.Exec(ctx, query, employeeID, id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return appError.ErrLocationAssignmentNotFound
+	}
+	return nil
+}
@@ -0,0 +1,669 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const payrollRuleColumns = `
+	id, name, kind, method, amount, currency, rate::FLOAT8, is_active, created_at, updated_at`
+
+const payrollPeriodColumns = `id, year, month, pay_date, created_at`
+
+const payrollRunColumns = `
+	r.id, r.payroll_period_id, r.status,
+	(SELECT COUNT(*) FROM payslips ps WHERE ps.payroll_run_id = r.id),
+	r.created_at, r.updated_at, r.finalized_at, r.locked_at`
+
+const payslipColumns = `
+	s.id, s.payroll_run_id, p.id, p.year, p.month, p.pay_date, p.created_at,
+	s.employee_id, s.employee_name, COALESCE(s.position, ''), s.salary_amount, s.currency, s.pay_period,
+	s.base_pay, s.gross_pay, s.total_deductions, s.total_tax, s.total_reimbursements, s.net_pay, s.created_at`
+
+const payslipFrom = `
+	FROM payslips s
+	JOIN payroll_runs r ON r.id = s.payroll_run_id
+	JOIN payroll_periods p ON p.id = r.payroll_period_id`
+
+type PayrollRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewPayrollRepository(pool *pgxpool.Pool) repository.PayrollRepository {
+	return &PayrollRepoPostgres{pool: pool}
+}
+
+func scanPayrollRule(row pgx.Row) (*entity.PayrollRule, error) {
+	var rule entity.PayrollRule
+	var amount *int64
+	var currency *string
+	var rate *float64
+	err := row.Scan(
+		&rule.ID,
+		&rule.Name,
+		&rule.Kind,
+		&rule.Method,
+		&amount,
+		&currency,
+		&rate,
+		&rule.IsActive,
+		&rule.CreatedAt,
+		&rule.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if amount != nil && currency != nil {
+		rule.Amount = entity.Money{Amount: *amount, Currency: *currency}
+	}
+	if rate != nil {
+		rule.Rate = *rate
+	}
+	return &rule, nil
+}
+
+func scanPayrollPeriod(row pgx.Row) (*entity.PayrollPeriod, error) {
+	var period entity.PayrollPeriod
+	err := row.Scan(
+		&period.ID,
+		&period.Year,
+		&period.Month,
+		&period.PayDate,
+		&period.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &period, nil
+}
+
+func scanPayrollRun(row pgx.Row) (*entity.PayrollRun, error) {
+	var run entity.PayrollRun
+	err := row.Scan(
+		&run.ID,
+		&run.PayrollPeriodID,
+		&run.Status,
+		&run.PayslipCount,
+		&run.CreatedAt,
+		&run.UpdatedAt,
+		&run.FinalizedAt,
+		&run.LockedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+func scanPayslip(row pgx.Row) (*entity.Payslip, error) {
+	var payslip entity.Payslip
+	var period entity.PayrollPeriod
+	var basePay, grossPay, totalDeductions, totalTax, totalReimbursements, netPay int64
+	err := row.Scan(
+		&payslip.ID,
+		&payslip.PayrollRunID,
+		&period.ID,
+		&period.Year,
+		&period.Month,
+		&period.PayDate,
+		&period.CreatedAt,
+		&payslip.EmployeeID,
+		&payslip.EmployeeName,
+		&payslip.Position,
+		&payslip.Salary.Amount,
+		&payslip.Salary.Currency,
+		&payslip.PayPeriod,
+		&basePay,
+		&grossPay,
+		&totalDeductions,
+		&totalTax,
+		&totalReimbursements,
+		&netPay,
+		&payslip.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	currency := payslip.Salary.Currency
+	payslip.Period = &period
+	payslip.BasePay = entity.Money{Amount: basePay, Currency: currency}
+	payslip.GrossPay = entity.Money{Amount: grossPay, Currency: currency}
+	payslip.TotalDeductions = entity.Money{Amount: totalDeductions, Currency: currency}
+	payslip.TotalTax = entity.Money{Amount: totalTax, Currency: currency}
+	payslip.TotalReimbursements = entity.Money{Amount: totalReimbursements, Currency: currency}
+	payslip.NetPay = entity.Money{Amount: netPay, Currency: currency}
+	return &payslip, nil
+}
+
+func mapPayrollRuleWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "payroll_rules_name_key" {
+		return appError.ErrPayrollRuleAlreadyExists
+	}
+	return err
+}
+
+// payrollRuleValues returns the fixed amount, currency and rate columns of a rule, NULL where
+// they do not apply to its method.
+func payrollRuleValues(rule *entity.PayrollRule) (*int64, *string, *float64) {
+	if rule.Method == entity.PayrollRuleMethodFixed {
+		return &rule.Amount.Amount, &rule.Amount.Currency, nil
+	}
+	return nil, nil, &rule.Rate
+}
+
+func (r *PayrollRepoPostgres) CreatePayrollRule(ctx context.Context, rule *entity.PayrollRule) (*entity.PayrollRule, error) {
+	query := `
+		INSERT INTO payroll_rules (name, kind, method, amount, currency, rate, is_active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + payrollRuleColumns
+
+	amount, currency, rate := payrollRuleValues(rule)
+	createdRule, err := scanPayrollRule(r.pool.QueryRow(ctx, query,
+		rule.Name,
+		rule.Kind,
+		rule.Method,
+		amount,
+		currency,
+		rate,
+		rule.IsActive,
+	))
+	if err != nil {
+		return nil, mapPayrollRuleWriteError(err)
+	}
+	return createdRule, nil
+}
+
+func (r *PayrollRepoPostgres) GetPayrollRuleById(ctx context.Context, id int) (*entity.PayrollRule, error) {
+	query := `
+		SELECT ` + payrollRuleColumns + `
+		FROM payroll_rules
+		WHERE id = $1
+	`
+	rule, err := scanPayrollRule(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (r *PayrollRepoPostgres) GetAllPayrollRules(ctx context.Context) ([]*entity.PayrollRule, error) {
+	return r.queryPayrollRules(ctx, `
+		SELECT `+payrollRuleColumns+`
+		FROM payroll_rules
+		ORDER BY id
+	`)
+}
+
+func (r *PayrollRepoPostgres) GetActivePayrollRules(ctx context.Context) ([]*entity.PayrollRule, error) {
+	return r.queryPayrollRules(ctx, `
+		SELECT `+payrollRuleColumns+`
+		FROM payroll_rules
+		WHERE is_active
+		ORDER BY id
+	`)
+}
+
+func (r *PayrollRepoPostgres) UpdatePayrollRule(ctx context.Context, rule *entity.PayrollRule) (*entity.PayrollRule, error) {
+	query := `
+		UPDATE payroll_rules
+		SET name = $1,
+			kind = $2,
+			method = $3,
+			amount = $4,
+			currency = $5,
+			rate = $6,
+			is_active = $7,
+			updated_at = NOW()
+		WHERE id = $8
+		RETURNING ` + payrollRuleColumns
+
+	amount, currency, rate := payrollRuleValues(rule)
+	updatedRule, err := scanPayrollRule(r.pool.QueryRow(ctx, query,
+		rule.Name,
+		rule.Kind,
+		rule.Method,
+		amount,
+		currency,
+		rate,
+		rule.IsActive,
+		rule.ID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, mapPayrollRuleWriteError(err)
+	}
+	return updatedRule, nil
+}
+
+func (r *PayrollRepoPostgres) DeletePayrollRule(ctx context.Context, id int) error {
+	query := `
+		DELETE FROM payroll_rules
+		WHERE id = $1
+	`
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return appError.ErrPayrollRuleNotFound
+	}
+	return nil
+}
+
+func (r *PayrollRepoPostgres) CreatePayrollPeriod(ctx context.Context, period *entity.PayrollPeriod) (*entity.PayrollPeriod, error) {
+	query := `
+		INSERT INTO payroll_periods (year, month, pay_date)
+		VALUES ($1, $2, $3)
+		RETURNING ` + payrollPeriodColumns
+
+	createdPeriod, err := scanPayrollPeriod(r.pool.QueryRow(ctx, query, period.Year, int(period.Month), period.PayDate))
+	if err != nil {
+		if uniqueViolationConstraint(err) == "payroll_periods_year_month_key" {
+			return nil, appError.ErrPayrollPeriodAlreadyExists
+		}
+		return nil, err
+	}
+	return createdPeriod, nil
+}
+
+func (r *PayrollRepoPostgres) GetPayrollPeriodById(ctx context.Context, id int) (*entity.PayrollPeriod, error) {
+	query := `
+		SELECT ` + payrollPeriodColumns + `
+		FROM payroll_periods
+		WHERE id = $1
+	`
+	period, err := scanPayrollPeriod(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return period, nil
+}
+
+func (r *PayrollRepoPostgres) GetAllPayrollPeriods(ctx context.Context) ([]*entity.PayrollPeriod, error) {
+	query := `
+		SELECT ` + payrollPeriodColumns + `
+		FROM payroll_periods
+		ORDER BY year DESC, month DESC
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	periods := []*entity.PayrollPeriod{}
+	for rows.Next() {
+		period, err := scanPayrollPeriod(rows)
+		if err != nil {
+			return nil, err
+		}
+		periods = append(periods, period)
+	}
+	return periods, rows.Err()
+}
+
+func (r *PayrollRepoPostgres) CreatePayrollRun(ctx context.Context, run *entity.PayrollRun,
+	payslips []*entity.Payslip) (*entity.PayrollRun, error) {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var runID int
+	err = tx.QueryRow(ctx, `
+		INSERT INTO payroll_runs (payroll_period_id, status)
+		VALUES ($1, $2)
+		RETURNING id
+	`, run.PayrollPeriodID, run.Status).Scan(&runID)
+	if err != nil {
+		if uniqueViolationConstraint(err) == "payroll_runs_period_key" {
+			return nil, appError.ErrPayrollRunAlreadyExists
+		}
+		if foreignKeyViolationConstraint(err) == "payroll_runs_payroll_period_id_fkey" {
+			return nil, appError.ErrPayrollPeriodNotFound
+		}
+		return nil, err
+	}
+
+	if err := insertPayslips(ctx, tx, runID, payslips); err != nil {
+		return nil, err
+	}
+	createdRun, err := getPayrollRun(ctx, tx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return createdRun, nil
+}
+
+func (r *PayrollRepoPostgres) GetPayrollRunById(ctx context.Context, id int) (*entity.PayrollRun, error) {
+	run, err := getPayrollRun(ctx, r.pool, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return run, nil
+}
+
+func (r *PayrollRepoPostgres) GetAllPayrollRuns(ctx context.Context) ([]*entity.PayrollRun, error) {
+	query := `
+		SELECT ` + payrollRunColumns + `
+		FROM payroll_runs r
+		JOIN payroll_periods p ON p.id = r.payroll_period_id
+		ORDER BY p.year DESC, p.month DESC
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	runs := []*entity.PayrollRun{}
+	for rows.Next() {
+		run, err := scanPayrollRun(rows)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+	return runs, rows.Err()
+}
+
+func (r *PayrollRepoPostgres) ReplacePayslips(ctx context.Context, runID int, payslips []*entity.Payslip) (*entity.PayrollRun, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		UPDATE payroll_runs
+		SET updated_at = NOW()
+		WHERE id = $1 AND status = 'draft'
+	`, runID)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM payslips WHERE payroll_run_id = $1`, runID); err != nil {
+		return nil, err
+	}
+	// The new payslips hold the claims they pay back again.
+	_, err = tx.Exec(ctx, `
+		UPDATE expense_claims
+		SET payroll_run_id = NULL, updated_at = NOW()
+		WHERE payroll_run_id = $1 AND status = 'approved'
+	`, runID)
+	if err != nil {
+		return nil, err
+	}
+	if err := insertPayslips(ctx, tx, runID, payslips); err != nil {
+		return nil, err
+	}
+	updatedRun, err := getPayrollRun(ctx, tx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return updatedRun, nil
+}
+
+func (r *PayrollRepoPostgres) UpdatePayrollRunStatus(ctx context.Context, id int, from,
+	to entity.PayrollRunStatus) (*entity.PayrollRun, error) {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		WITH updated AS (
+			UPDATE payroll_runs
+			SET status = $3,
+				finalized_at = CASE WHEN $3 = 'finalized' THEN NOW() WHEN $3 = 'draft' THEN NULL ELSE finalized_at END,
+				locked_at = CASE WHEN $3 = 'locked' THEN NOW() ELSE locked_at END,
+				updated_at = NOW()
+			WHERE id = $1 AND status = $2
+			RETURNING *
+		)
+		SELECT ` + payrollRunColumns + `
+		FROM updated r
+	`
+	run, err := scanPayrollRun(tx.QueryRow(ctx, query, id, from, to))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Locking the run pays it, and with it the expense claims it holds, on the pay date.
+	if to == entity.PayrollRunStatusLocked {
+		_, err := tx.Exec(ctx, `
+			UPDATE expense_claims c
+			SET status = 'reimbursed', reimbursed_on = p.pay_date, updated_at = NOW()
+			FROM payroll_runs r
+			JOIN payroll_periods p ON p.id = r.payroll_period_id
+			WHERE r.id = $1 AND c.payroll_run_id = r.id AND c.status = 'approved'
+		`, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+func (r *PayrollRepoPostgres) DeletePayrollRun(ctx context.Context, id int) error {
+	query := `
+		DELETE FROM payroll_runs
+		WHERE id = $1 AND status = 'draft'
+	`
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() > 0 {
+		return nil
+	}
+
+	// Nothing was deleted: tell a missing run apart from one that is no longer a draft.
+	var exists bool
+	if err := r.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM payroll_runs WHERE id = $1)`, id).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return appError.ErrPayrollRunNotDraft
+	}
+	return appError.ErrPayrollRunNotFound
+}
+
+func (r *PayrollRepoPostgres) GetPayslips(ctx context.Context, runID int) ([]*entity.Payslip, error) {
+	return r.queryPayslips(ctx, `
+		SELECT `+payslipColumns+payslipFrom+`
+		WHERE s.payroll_run_id = $1
+		ORDER BY s.employee_name, s.employee_id
+	`, runID)
+}
+
+func (r *PayrollRepoPostgres) GetPayslipById(ctx context.Context, runID, id int) (*entity.Payslip, error) {
+	query := `
+		SELECT ` + payslipColumns + payslipFrom + `
+		WHERE s.id = $1 AND s.payroll_run_id = $2
+	`
+	payslip, err := scanPayslip(r.pool.QueryRow(ctx, query, id, runID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT payroll_rule_id, expense_claim_id, kind, name, amount
+		FROM payslip_lines
+		WHERE payslip_id = $1
+		ORDER BY line_number
+	`, payslip.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	payslip.Lines = []entity.PayslipLine{}
+	for rows.Next() {
+		line := entity.PayslipLine{Amount: entity.Money{Currency: payslip.Salary.Currency}}
+		if err := rows.Scan(&line.PayrollRuleID, &line.ExpenseClaimID, &line.Kind, &line.Name, &line.Amount.Amount); err != nil {
+			return nil, err
+		}
+		payslip.Lines = append(payslip.Lines, line)
+	}
+	return payslip, rows.Err()
+}
+
+func (r *PayrollRepoPostgres) GetEmployeePayslips(ctx context.Context, employeeID int) ([]*entity.Payslip, error) {
+	return r.queryPayslips(ctx, `
+		SELECT `+payslipColumns+payslipFrom+`
+		WHERE s.employee_id = $1 AND r.status IN ('finalized', 'locked')
+		ORDER BY p.year DESC, p.month DESC
+	`, employeeID)
+}
+
+func (r *PayrollRepoPostgres) queryPayrollRules(ctx context.Context, query string, args ...any) ([]*entity.PayrollRule, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := []*entity.PayrollRule{}
+	for rows.Next() {
+		rule, err := scanPayrollRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
+
+func (r *PayrollRepoPostgres) queryPayslips(ctx context.Context, query string, args ...any) ([]*entity.Payslip, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	payslips := []*entity.Payslip{}
+	for rows.Next() {
+		payslip, err := scanPayslip(rows)
+		if err != nil {
+			return nil, err
+		}
+		payslips = append(payslips, payslip)
+	}
+	return payslips, rows.Err()
+}
+
+// querier is satisfied by both the pool and a transaction.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func getPayrollRun(ctx context.Context, q querier, id int) (*entity.PayrollRun, error) {
+	query := `
+		SELECT ` + payrollRunColumns + `
+		FROM payroll_runs r
+		WHERE r.id = $1
+	`
+	return scanPayrollRun(q.QueryRow(ctx, query, id))
+}
+
+func insertPayslips(ctx context.Context, tx pgx.Tx, runID int, payslips []*entity.Payslip) error {
+	for _, payslip := range payslips {
+		var payslipID int
+		err := tx.QueryRow(ctx, `
+			INSERT INTO payslips (payroll_run_id, employee_id, employee_name, position, salary_amount, currency,
+				pay_period, base_pay, gross_pay, total_deductions, total_tax, total_reimbursements, net_pay)
+			VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, $7, $8, $9, $10, $11, $12, $13)
+			RETURNING id
+		`,
+			runID,
+			payslip.EmployeeID,
+			payslip.EmployeeName,
+			payslip.Position,
+			payslip.Salary.Amount,
+			payslip.Salary.Currency,
+			payslip.PayPeriod,
+			payslip.BasePay.Amount,
+			payslip.GrossPay.Amount,
+			payslip.TotalDeductions.Amount,
+			payslip.TotalTax.Amount,
+			payslip.TotalReimbursements.Amount,
+			payslip.NetPay.Amount,
+		).Scan(&payslipID)
+		if err != nil {
+			return err
+		}
+
+		for number, line := range payslip.Lines {
+			_, err := tx.Exec(ctx, `
+				INSERT INTO payslip_lines (payslip_id, payroll_rule_id, expense_claim_id, kind, name, amount, line_number)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)
+			`, payslipID, line.PayrollRuleID, line.ExpenseClaimID, line.Kind, line.Name, line.Amount.Amount, number+1)
+			if err != nil {
+				return err
+			}
+
+			if line.ExpenseClaimID != nil {
+				result, err := tx.Exec(ctx, `
+					UPDATE expense_claims
+					SET payroll_run_id = $1, updated_at = NOW()
+					WHERE id = $2 AND status = 'approved' AND (payroll_run_id IS NULL OR payroll_run_id = $1)
+				`, runID, *line.ExpenseClaimID)
+				if err != nil {
+					return err
+				}
+				// Another run took the claim, or it was reimbursed, since the payslip was calculated.
+				if result.RowsAffected() == 0 {
+					return appError.ErrPayrollExpenseClaimTaken
+				}
+			}
+		}
+	}
+	return nil
+}
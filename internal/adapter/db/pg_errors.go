@@ -0,0 +1,32 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	pgUniqueViolationCode     = "23505"
+	pgForeignKeyViolationCode = "23503"
+)
+
+// uniqueViolationConstraint returns the name of the violated unique constraint,
+// or an empty string when err is not a unique violation.
+func uniqueViolationConstraint(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolationCode {
+		return pgErr.ConstraintName
+	}
+	return ""
+}
+
+// foreignKeyViolationConstraint returns the name of the violated foreign key constraint,
+// or an empty string when err is not a foreign key violation.
+func foreignKeyViolationConstraint(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolationCode {
+		return pgErr.ConstraintName
+	}
+	return ""
+}
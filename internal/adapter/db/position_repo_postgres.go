@@ -0,0 +1,166 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// positionColumns is the select list for a full position row. A missing band is scanned as zero money.
+const positionColumns = `
+	id, title, job_level, COALESCE(min_salary, 0), COALESCE(max_salary, 0), COALESCE(currency, ''),
+	pay_period, created_at, updated_at`
+
+type PositionRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewPositionRepository(pool *pgxpool.Pool) repository.PositionRepository {
+	return &PositionRepoPostgres{pool: pool}
+}
+
+func scanPosition(row pgx.Row) (*entity.Position, error) {
+	var position entity.Position
+	var currency string
+	err := row.Scan(
+		&position.ID,
+		&position.Title,
+		&position.JobLevel,
+		&position.MinSalary.Amount,
+		&position.MaxSalary.Amount,
+		&currency,
+		&position.PayPeriod,
+		&position.CreatedAt,
+		&position.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if position.HasBand() {
+		position.MinSalary.Currency = currency
+		position.MaxSalary.Currency = currency
+	}
+	return &position, nil
+}
+
+func mapPositionWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "positions_title_key" {
+		return appError.ErrPositionAlreadyExists
+	}
+	return err
+}
+
+func (r *PositionRepoPostgres) CreatePosition(ctx context.Context, position *entity.Position) (*entity.Position, error) {
+	query := `
+		INSERT INTO positions (title, job_level, min_salary, max_salary, currency, pay_period)
+		VALUES ($1, $2, NULLIF($3, 0), NULLIF($4, 0), NULLIF($5, ''), $6)
+		RETURNING ` + positionColumns
+
+	createdPosition, err := scanPosition(r.pool.QueryRow(ctx, query,
+		position.Title,
+		position.JobLevel,
+		position.MinSalary.Amount,
+		position.MaxSalary.Amount,
+		position.MinSalary.Currency,
+		position.PayPeriod,
+	))
+	if err != nil {
+		return nil, mapPositionWriteError(err)
+	}
+	return createdPosition, nil
+}
+
+func (r *PositionRepoPostgres) GetPositionById(ctx context.Context, id int) (*entity.Position, error) {
+	query := `
+		SELECT ` + positionColumns + `
+		FROM positions
+		WHERE id = $1
+	`
+	position, err := scanPosition(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return position, nil
+}
+
+func (r *PositionRepoPostgres) GetAllPositions(ctx context.Context) ([]*entity.Position, error) {
+	query := `
+		SELECT ` + positionColumns + `
+		FROM positions
+		ORDER BY job_level, title
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	positions := []*entity.Position{}
+	for rows.Next() {
+		position, err := scanPosition(rows)
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, position)
+	}
+	return positions, rows.Err()
+}
+
+func (r *PositionRepoPostgres) UpdatePosition(ctx context.Context, position *entity.Position) (*entity.Position, error) {
+	query := `
+		UPDATE positions
+		SET title = $1,
+			job_level = $2,
+			min_salary = NULLIF($3, 0),
+			max_salary = NULLIF($4, 0),
+			currency = NULLIF($5, ''),
+			pay_period = $6,
+			updated_at = NOW()
+		WHERE id = $7
+		RETURNING ` + positionColumns
+
+	updatedPosition, err := scanPosition(r.pool.QueryRow(ctx, query,
+		position.Title,
+		position.JobLevel,
+		position.MinSalary.Amount,
+		position.MaxSalary.Amount,
+		position.MinSalary.Currency,
+		position.PayPeriod,
+		position.ID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, mapPositionWriteError(err)
+	}
+	return updatedPosition, nil
+}
+
+func (r *PositionRepoPostgres) DeletePosition(ctx context.Context, id int) error {
+	query := `
+		DELETE FROM positions
+		WHERE id = $1
+	`
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "employees_position_id_fkey" {
+			return appError.ErrPositionInUse
+		}
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return appError.ErrPositionNotFound
+	}
+	return nil
+}
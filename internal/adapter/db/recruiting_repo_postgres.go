@@ -0,0 +1,587 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// requisitionColumns selects a requisition r with its position p and hiring manager m, joined by
+// requisitionFrom, and the number of candidates hired through it.
+const requisitionColumns = `
+	r.id, r.title, r.position_id, p.title, r.department_id, r.location_id, r.hiring_manager_id,
+	COALESCE(m.name, ''), r.openings,
+	(SELECT COUNT(*) FROM candidates h WHERE h.requisition_id = r.id AND h.stage = 'hired'),
+	r.status, COALESCE(r.description, ''), r.created_at, r.updated_at`
+
+const requisitionFrom = `
+	FROM job_requisitions r
+	JOIN positions p ON p.id = r.position_id
+	LEFT JOIN employees m ON m.id = r.hiring_manager_id`
+
+// candidateColumns selects a candidate c with its requisition r, joined by candidateFrom.
+const candidateColumns = `
+	c.id, c.requisition_id, r.title, c.name, c.email, COALESCE(c.phone, ''), COALESCE(c.source, ''),
+	COALESCE(c.notes, ''), c.stage, c.stage_changed_at, c.offer_salary_amount, c.offer_salary_currency,
+	c.offer_pay_period, c.offer_start_date, c.employee_id, c.created_at, c.updated_at`
+
+const candidateFrom = `
+	FROM candidates c
+	JOIN job_requisitions r ON r.id = c.requisition_id`
+
+// interviewColumns selects an interview i with its candidate c and interviewer e, joined by
+// interviewFrom.
+const interviewColumns = `
+	i.id, i.candidate_id, c.name, c.requisition_id, i.interviewer_id, e.name, i.scheduled_at,
+	i.duration_minutes, i.status, i.rating, COALESCE(i.recommendation, ''), COALESCE(i.feedback, ''),
+	i.feedback_at, i.created_at, i.updated_at`
+
+const interviewFrom = `
+	FROM interviews i
+	JOIN candidates c ON c.id = i.candidate_id
+	JOIN employees e ON e.id = i.interviewer_id`
+
+type RecruitingRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewRecruitingRepository(pool *pgxpool.Pool) repository.RecruitingRepository {
+	return &RecruitingRepoPostgres{pool: pool}
+}
+
+func scanRequisition(row pgx.Row) (*entity.JobRequisition, error) {
+	var requisition entity.JobRequisition
+	err := row.Scan(
+		&requisition.ID,
+		&requisition.Title,
+		&requisition.PositionID,
+		&requisition.Position,
+		&requisition.DepartmentID,
+		&requisition.LocationID,
+		&requisition.HiringManagerID,
+		&requisition.HiringManagerName,
+		&requisition.Openings,
+		&requisition.Hired,
+		&requisition.Status,
+		&requisition.Description,
+		&requisition.CreatedAt,
+		&requisition.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &requisition, nil
+}
+
+func scanCandidate(row pgx.Row) (*entity.Candidate, error) {
+	var candidate entity.Candidate
+	var offerAmount *int64
+	var offerCurrency, offerPayPeriod *string
+	var offerStartDate *time.Time
+	err := row.Scan(
+		&candidate.ID,
+		&candidate.RequisitionID,
+		&candidate.RequisitionTitle,
+		&candidate.Name,
+		&candidate.Email,
+		&candidate.Phone,
+		&candidate.Source,
+		&candidate.Notes,
+		&candidate.Stage,
+		&candidate.StageChangedAt,
+		&offerAmount,
+		&offerCurrency,
+		&offerPayPeriod,
+		&offerStartDate,
+		&candidate.EmployeeID,
+		&candidate.CreatedAt,
+		&candidate.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if offerAmount != nil && offerCurrency != nil && offerPayPeriod != nil && offerStartDate != nil {
+		candidate.Offer = &entity.JobOffer{
+			Salary:    entity.Money{Amount: *offerAmount, Currency: *offerCurrency},
+			PayPeriod: entity.PayPeriod(*offerPayPeriod),
+			StartDate: *offerStartDate,
+		}
+	}
+	return &candidate, nil
+}
+
+func scanInterview(row pgx.Row) (*entity.Interview, error) {
+	var interview entity.Interview
+	err := row.Scan(
+		&interview.ID,
+		&interview.CandidateID,
+		&interview.CandidateName,
+		&interview.RequisitionID,
+		&interview.InterviewerID,
+		&interview.InterviewerName,
+		&interview.ScheduledAt,
+		&interview.DurationMinutes,
+		&interview.Status,
+		&interview.Rating,
+		&interview.Recommendation,
+		&interview.Feedback,
+		&interview.FeedbackAt,
+		&interview.CreatedAt,
+		&interview.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &interview, nil
+}
+
+func mapRequisitionWriteError(err error) error {
+	switch foreignKeyViolationConstraint(err) {
+	case "job_requisitions_position_id_fkey":
+		return appError.ErrPositionNotFound
+	case "job_requisitions_department_id_fkey":
+		return appError.ErrDepartmentNotFound
+	case "job_requisitions_location_id_fkey":
+		return appError.ErrLocationNotFound
+	case "job_requisitions_hiring_manager_id_fkey":
+		return appError.ErrEmployeeNotFound
+	}
+	return err
+}
+
+func mapCandidateWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "candidates_requisition_email_key" {
+		return appError.ErrCandidateExists
+	}
+	switch foreignKeyViolationConstraint(err) {
+	case "candidates_requisition_id_fkey":
+		return appError.ErrRequisitionNotFound
+	case "candidates_employee_id_fkey":
+		return appError.ErrEmployeeNotFound
+	}
+	return err
+}
+
+func mapInterviewWriteError(err error) error {
+	switch foreignKeyViolationConstraint(err) {
+	case "interviews_candidate_id_fkey":
+		return appError.ErrCandidateNotFound
+	case "interviews_interviewer_id_fkey":
+		return appError.ErrEmployeeNotFound
+	}
+	return err
+}
+
+func (r *RecruitingRepoPostgres) CreateRequisition(ctx context.Context, requisition *entity.JobRequisition) (*entity.JobRequisition, error) {
+	var requisitionID int
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO job_requisitions (title, position_id, department_id, location_id, hiring_manager_id, openings,
+			status, description)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''))
+		RETURNING id
+	`,
+		requisition.Title,
+		requisition.PositionID,
+		requisition.DepartmentID,
+		requisition.LocationID,
+		requisition.HiringManagerID,
+		requisition.Openings,
+		requisition.Status,
+		requisition.Description,
+	).Scan(&requisitionID)
+	if err != nil {
+		return nil, mapRequisitionWriteError(err)
+	}
+	return r.GetRequisitionById(ctx, requisitionID)
+}
+
+func (r *RecruitingRepoPostgres) GetRequisitionById(ctx context.Context, id int) (*entity.JobRequisition, error) {
+	query := `
+		SELECT ` + requisitionColumns + requisitionFrom + `
+		WHERE r.id = $1
+	`
+	requisition, err := scanRequisition(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return requisition, nil
+}
+
+func (r *RecruitingRepoPostgres) GetRequisitions(ctx context.Context, status entity.RequisitionStatus) ([]*entity.JobRequisition, error) {
+	where, args := "", []any{}
+	if status != "" {
+		where, args = "WHERE r.status = $1", append(args, status)
+	}
+	query := `
+		SELECT ` + requisitionColumns + requisitionFrom + `
+		` + where + `
+		ORDER BY r.created_at DESC, r.id DESC
+	`
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	requisitions := []*entity.JobRequisition{}
+	for rows.Next() {
+		requisition, err := scanRequisition(rows)
+		if err != nil {
+			return nil, err
+		}
+		requisitions = append(requisitions, requisition)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return requisitions, nil
+}
+
+func (r *RecruitingRepoPostgres) UpdateRequisition(ctx context.Context, requisition *entity.JobRequisition) (*entity.JobRequisition, error) {
+	result, err := r.pool.Exec(ctx, `
+		UPDATE job_requisitions
+		SET title = $1,
+			position_id = $2,
+			department_id = $3,
+			location_id = $4,
+			hiring_manager_id = $5,
+			openings = $6,
+			status = $7,
+			description = NULLIF($8, ''),
+			updated_at = NOW()
+		WHERE id = $9
+	`,
+		requisition.Title,
+		requisition.PositionID,
+		requisition.DepartmentID,
+		requisition.LocationID,
+		requisition.HiringManagerID,
+		requisition.Openings,
+		requisition.Status,
+		requisition.Description,
+		requisition.ID,
+	)
+	if err != nil {
+		return nil, mapRequisitionWriteError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+	return r.GetRequisitionById(ctx, requisition.ID)
+}
+
+func (r *RecruitingRepoPostgres) DeleteRequisition(ctx context.Context, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM job_requisitions WHERE id = $1`, id)
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "candidates_requisition_id_fkey" {
+			return appError.ErrRequisitionHasCandidates
+		}
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrRequisitionNotFound
+	}
+	return nil
+}
+
+func (r *RecruitingRepoPostgres) CreateCandidate(ctx context.Context, candidate *entity.Candidate) (*entity.Candidate, error) {
+	var candidateID int
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO candidates (requisition_id, name, email, phone, source, notes, stage)
+		VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), NULLIF($6, ''), $7)
+		RETURNING id
+	`,
+		candidate.RequisitionID,
+		candidate.Name,
+		candidate.Email,
+		candidate.Phone,
+		candidate.Source,
+		candidate.Notes,
+		candidate.Stage,
+	).Scan(&candidateID)
+	if err != nil {
+		return nil, mapCandidateWriteError(err)
+	}
+	return r.GetCandidateById(ctx, candidateID)
+}
+
+func (r *RecruitingRepoPostgres) GetCandidateById(ctx context.Context, id int) (*entity.Candidate, error) {
+	query := `
+		SELECT ` + candidateColumns + candidateFrom + `
+		WHERE c.id = $1
+	`
+	candidate, err := scanCandidate(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return candidate, nil
+}
+
+// buildCandidateFilter turns filter into a WHERE clause (empty when nothing is filtered) and its
+// arguments.
+func buildCandidateFilter(filter entity.CandidateFilter) (string, []any) {
+	conditions := []string{}
+	args := []any{}
+
+	if filter.RequisitionID > 0 {
+		args = append(args, filter.RequisitionID)
+		conditions = append(conditions, fmt.Sprintf("c.requisition_id = $%d", len(args)))
+	}
+	if filter.Stage != "" {
+		args = append(args, filter.Stage)
+		conditions = append(conditions, fmt.Sprintf("c.stage = $%d", len(args)))
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func (r *RecruitingRepoPostgres) GetCandidates(ctx context.Context, filter entity.CandidateFilter) ([]*entity.Candidate, error) {
+	where, args := buildCandidateFilter(filter)
+	query := `
+		SELECT ` + candidateColumns + candidateFrom + `
+		` + where + `
+		ORDER BY c.created_at DESC, c.id DESC
+	`
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	candidates := []*entity.Candidate{}
+	for rows.Next() {
+		candidate, err := scanCandidate(rows)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, candidate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return candidates, nil
+}
+
+func (r *RecruitingRepoPostgres) UpdateCandidate(ctx context.Context, candidate *entity.Candidate) (*entity.Candidate, error) {
+	result, err := r.pool.Exec(ctx, `
+		UPDATE candidates
+		SET name = $1,
+			email = $2,
+			phone = NULLIF($3, ''),
+			source = NULLIF($4, ''),
+			notes = NULLIF($5, ''),
+			updated_at = NOW()
+		WHERE id = $6
+	`,
+		candidate.Name,
+		candidate.Email,
+		candidate.Phone,
+		candidate.Source,
+		candidate.Notes,
+		candidate.ID,
+	)
+	if err != nil {
+		return nil, mapCandidateWriteError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+	return r.GetCandidateById(ctx, candidate.ID)
+}
+
+func (r *RecruitingRepoPostgres) UpdateCandidateStage(ctx context.Context, candidate *entity.Candidate,
+	from entity.CandidateStage) (*entity.Candidate, error) {
+
+	var offerAmount *int64
+	var offerCurrency, offerPayPeriod *string
+	var offerStartDate *time.Time
+	if offer := candidate.Offer; offer != nil {
+		offerAmount = &offer.Salary.Amount
+		offerCurrency = &offer.Salary.Currency
+		payPeriod := string(offer.PayPeriod)
+		offerPayPeriod = &payPeriod
+		offerStartDate = &offer.StartDate
+	}
+
+	result, err := r.pool.Exec(ctx, `
+		UPDATE candidates
+		SET stage = $1,
+			stage_changed_at = CASE WHEN stage = $1 THEN stage_changed_at ELSE NOW() END,
+			offer_salary_amount = $2,
+			offer_salary_currency = $3,
+			offer_pay_period = $4,
+			offer_start_date = $5,
+			employee_id = $6,
+			updated_at = NOW()
+		WHERE id = $7 AND stage = $8
+	`,
+		candidate.Stage,
+		offerAmount,
+		offerCurrency,
+		offerPayPeriod,
+		offerStartDate,
+		candidate.EmployeeID,
+		candidate.ID,
+		from,
+	)
+	if err != nil {
+		return nil, mapCandidateWriteError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+	return r.GetCandidateById(ctx, candidate.ID)
+}
+
+func (r *RecruitingRepoPostgres) DeleteCandidate(ctx context.Context, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM candidates WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrCandidateNotFound
+	}
+	return nil
+}
+
+func (r *RecruitingRepoPostgres) GetStartingHires(ctx context.Context, date time.Time) ([]*entity.Employee, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+employeeColumns+` FROM employees
+		WHERE status = 'candidate' AND hired_date <= $1
+			AND id IN (SELECT employee_id FROM candidates WHERE stage = 'hired')
+		ORDER BY hired_date, id
+	`, date)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	employees := []*entity.Employee{}
+	for rows.Next() {
+		employee, err := scanEmployee(rows)
+		if err != nil {
+			return nil, err
+		}
+		employees = append(employees, employee)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return employees, nil
+}
+
+func (r *RecruitingRepoPostgres) CreateInterview(ctx context.Context, interview *entity.Interview) (*entity.Interview, error) {
+	var interviewID int
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO interviews (candidate_id, interviewer_id, scheduled_at, duration_minutes, status)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`,
+		interview.CandidateID,
+		interview.InterviewerID,
+		interview.ScheduledAt,
+		interview.DurationMinutes,
+		interview.Status,
+	).Scan(&interviewID)
+	if err != nil {
+		return nil, mapInterviewWriteError(err)
+	}
+	return r.GetInterviewById(ctx, interviewID)
+}
+
+func (r *RecruitingRepoPostgres) GetInterviewById(ctx context.Context, id int) (*entity.Interview, error) {
+	interviews, err := r.queryInterviews(ctx, `i.id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(interviews) == 0 {
+		return nil, nil
+	}
+	return interviews[0], nil
+}
+
+func (r *RecruitingRepoPostgres) GetCandidateInterviews(ctx context.Context, candidateID int) ([]*entity.Interview, error) {
+	return r.queryInterviews(ctx, `i.candidate_id = $1`, candidateID)
+}
+
+func (r *RecruitingRepoPostgres) GetInterviewerSchedule(ctx context.Context, interviewerID int, from time.Time) ([]*entity.Interview, error) {
+	return r.queryInterviews(ctx, `i.interviewer_id = $1
+		AND i.status = 'scheduled'
+		AND i.scheduled_at + make_interval(mins => i.duration_minutes) > $2`, interviewerID, from)
+}
+
+func (r *RecruitingRepoPostgres) UpdateInterview(ctx context.Context, interview *entity.Interview) (*entity.Interview, error) {
+	result, err := r.pool.Exec(ctx, `
+		UPDATE interviews
+		SET interviewer_id = $1,
+			scheduled_at = $2,
+			duration_minutes = $3,
+			status = $4,
+			rating = $5,
+			recommendation = NULLIF($6, ''),
+			feedback = NULLIF($7, ''),
+			feedback_at = $8,
+			updated_at = NOW()
+		WHERE id = $9
+	`,
+		interview.InterviewerID,
+		interview.ScheduledAt,
+		interview.DurationMinutes,
+		interview.Status,
+		interview.Rating,
+		interview.Recommendation,
+		interview.Feedback,
+		interview.FeedbackAt,
+		interview.ID,
+	)
+	if err != nil {
+		return nil, mapInterviewWriteError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+	return r.GetInterviewById(ctx, interview.ID)
+}
+
+func (r *RecruitingRepoPostgres) queryInterviews(ctx context.Context, where string, args ...any) ([]*entity.Interview, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+interviewColumns+interviewFrom+`
+		WHERE `+where+`
+		ORDER BY i.scheduled_at, i.id
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	interviews := []*entity.Interview{}
+	for rows.Next() {
+		interview, err := scanInterview(rows)
+		if err != nil {
+			return nil, err
+		}
+		interviews = append(interviews, interview)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return interviews, nil
+}
@@ -0,0 +1,612 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const reviewCycleColumns = `
+	id, name, period_start, period_end, self_review_deadline, peer_review_deadline, manager_review_deadline,
+	rating_labels, status, created_at, updated_at, launched_at, closed_at`
+
+// reviewColumns selects a review with its average rating per role from rv, joined to the
+// employee e and their department d by reviewFrom.
+const reviewColumns = `
+	rv.id, rv.review_cycle_id, rv.employee_id, e.name, e.department_id, COALESCE(d.name, ''), rv.manager_id, rv.status,
+	` + reviewRatingSubquery + `'self'),
+	` + reviewRatingSubquery + `'peer'),
+	` + reviewRatingSubquery + `'manager'),
+	rv.created_at, rv.updated_at, rv.completed_at, rv.locked_at`
+
+const reviewRatingSubquery = `(
+		SELECT AVG(a.rating)::FLOAT8
+		FROM review_answers a
+		JOIN review_submissions s ON s.id = a.review_submission_id
+		WHERE s.review_id = rv.id AND s.role = `
+
+const reviewFrom = `
+	JOIN employees e ON e.id = rv.employee_id
+	LEFT JOIN departments d ON d.id = e.department_id`
+
+type ReviewRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewReviewRepository(pool *pgxpool.Pool) repository.ReviewRepository {
+	return &ReviewRepoPostgres{pool: pool}
+}
+
+func scanReviewCycle(row pgx.Row) (*entity.ReviewCycle, error) {
+	var cycle entity.ReviewCycle
+	err := row.Scan(
+		&cycle.ID,
+		&cycle.Name,
+		&cycle.PeriodStart,
+		&cycle.PeriodEnd,
+		&cycle.SelfReviewDeadline,
+		&cycle.PeerReviewDeadline,
+		&cycle.ManagerReviewDeadline,
+		&cycle.RatingLabels,
+		&cycle.Status,
+		&cycle.CreatedAt,
+		&cycle.UpdatedAt,
+		&cycle.LaunchedAt,
+		&cycle.ClosedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &cycle, nil
+}
+
+func scanReview(row pgx.Row) (*entity.Review, error) {
+	var review entity.Review
+	err := row.Scan(
+		&review.ID,
+		&review.ReviewCycleID,
+		&review.EmployeeID,
+		&review.EmployeeName,
+		&review.DepartmentID,
+		&review.DepartmentName,
+		&review.ManagerID,
+		&review.Status,
+		&review.SelfRating,
+		&review.PeerRating,
+		&review.ManagerRating,
+		&review.CreatedAt,
+		&review.UpdatedAt,
+		&review.CompletedAt,
+		&review.LockedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+func mapReviewCycleWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "review_cycles_name_key" {
+		return appError.ErrReviewCycleAlreadyExists
+	}
+	return err
+}
+
+func (r *ReviewRepoPostgres) CreateReviewCycle(ctx context.Context, cycle *entity.ReviewCycle) (*entity.ReviewCycle, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var cycleID int
+	err = tx.QueryRow(ctx, `
+		INSERT INTO review_cycles (name, period_start, period_end, self_review_deadline, peer_review_deadline,
+			manager_review_deadline, rating_labels)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`,
+		cycle.Name,
+		cycle.PeriodStart,
+		cycle.PeriodEnd,
+		cycle.SelfReviewDeadline,
+		cycle.PeerReviewDeadline,
+		cycle.ManagerReviewDeadline,
+		cycle.RatingLabels,
+	).Scan(&cycleID)
+	if err != nil {
+		return nil, mapReviewCycleWriteError(err)
+	}
+
+	if err := insertReviewQuestions(ctx, tx, cycleID, cycle.Questions); err != nil {
+		return nil, err
+	}
+	createdCycle, err := getReviewCycle(ctx, tx, cycleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return createdCycle, nil
+}
+
+func (r *ReviewRepoPostgres) GetReviewCycleById(ctx context.Context, id int) (*entity.ReviewCycle, error) {
+	cycle, err := getReviewCycle(ctx, r.pool, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return cycle, nil
+}
+
+func (r *ReviewRepoPostgres) GetAllReviewCycles(ctx context.Context) ([]*entity.ReviewCycle, error) {
+	query := `
+		SELECT ` + reviewCycleColumns + `
+		FROM review_cycles
+		ORDER BY period_end DESC, id DESC
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cycles := []*entity.ReviewCycle{}
+	for rows.Next() {
+		cycle, err := scanReviewCycle(rows)
+		if err != nil {
+			return nil, err
+		}
+		cycles = append(cycles, cycle)
+	}
+	return cycles, rows.Err()
+}
+
+func (r *ReviewRepoPostgres) UpdateReviewCycle(ctx context.Context, cycle *entity.ReviewCycle) (*entity.ReviewCycle, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		UPDATE review_cycles
+		SET name = $1,
+			period_start = $2,
+			period_end = $3,
+			self_review_deadline = $4,
+			peer_review_deadline = $5,
+			manager_review_deadline = $6,
+			rating_labels = $7,
+			updated_at = NOW()
+		WHERE id = $8 AND status = 'draft'
+	`,
+		cycle.Name,
+		cycle.PeriodStart,
+		cycle.PeriodEnd,
+		cycle.SelfReviewDeadline,
+		cycle.PeerReviewDeadline,
+		cycle.ManagerReviewDeadline,
+		cycle.RatingLabels,
+		cycle.ID,
+	)
+	if err != nil {
+		return nil, mapReviewCycleWriteError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM review_questions WHERE review_cycle_id = $1`, cycle.ID); err != nil {
+		return nil, err
+	}
+	if err := insertReviewQuestions(ctx, tx, cycle.ID, cycle.Questions); err != nil {
+		return nil, err
+	}
+	updatedCycle, err := getReviewCycle(ctx, tx, cycle.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return updatedCycle, nil
+}
+
+func (r *ReviewRepoPostgres) DeleteReviewCycle(ctx context.Context, id int) error {
+	query := `
+		DELETE FROM review_cycles
+		WHERE id = $1 AND status = 'draft'
+	`
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() > 0 {
+		return nil
+	}
+
+	// Nothing was deleted: tell a missing cycle apart from one that was launched.
+	var exists bool
+	if err := r.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM review_cycles WHERE id = $1)`, id).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return appError.ErrReviewCycleNotDraft
+	}
+	return appError.ErrReviewCycleNotFound
+}
+
+func (r *ReviewRepoPostgres) LaunchReviewCycle(ctx context.Context, id int, reviews []*entity.Review) (*entity.ReviewCycle, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		UPDATE review_cycles
+		SET status = 'active',
+			launched_at = NOW(),
+			updated_at = NOW()
+		WHERE id = $1 AND status = 'draft'
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+
+	for _, review := range reviews {
+		var reviewID int
+		err := tx.QueryRow(ctx, `
+			INSERT INTO reviews (review_cycle_id, employee_id, manager_id)
+			VALUES ($1, $2, $3)
+			RETURNING id
+		`, id, review.EmployeeID, review.ManagerID).Scan(&reviewID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, submission := range review.Submissions {
+			_, err := tx.Exec(ctx, `
+				INSERT INTO review_submissions (review_id, reviewer_id, role)
+				VALUES ($1, $2, $3)
+			`, reviewID, submission.ReviewerID, submission.Role)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	launchedCycle, err := getReviewCycle(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return launchedCycle, nil
+}
+
+func (r *ReviewRepoPostgres) CloseReviewCycle(ctx context.Context, id int) (*entity.ReviewCycle, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		UPDATE review_cycles
+		SET status = 'closed',
+			closed_at = NOW(),
+			updated_at = NOW()
+		WHERE id = $1 AND status = 'active'
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE reviews
+		SET status = 'locked',
+			locked_at = NOW(),
+			updated_at = NOW()
+		WHERE review_cycle_id = $1 AND status = 'completed'
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	closedCycle, err := getReviewCycle(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return closedCycle, nil
+}
+
+func (r *ReviewRepoPostgres) GetReviews(ctx context.Context, cycleID int) ([]*entity.Review, error) {
+	return r.queryReviews(ctx, `
+		SELECT `+reviewColumns+`
+		FROM reviews rv`+reviewFrom+`
+		WHERE rv.review_cycle_id = $1
+		ORDER BY e.name, rv.employee_id
+	`, cycleID)
+}
+
+func (r *ReviewRepoPostgres) GetEmployeeReviews(ctx context.Context, employeeID int) ([]*entity.Review, error) {
+	return r.queryReviews(ctx, `
+		SELECT `+reviewColumns+`
+		FROM reviews rv`+reviewFrom+`
+		JOIN review_cycles c ON c.id = rv.review_cycle_id
+		WHERE rv.employee_id = $1
+		ORDER BY c.period_end DESC, rv.id DESC
+	`, employeeID)
+}
+
+func (r *ReviewRepoPostgres) GetReviewById(ctx context.Context, id int) (*entity.Review, error) {
+	query := `
+		SELECT ` + reviewColumns + `
+		FROM reviews rv` + reviewFrom + `
+		WHERE rv.id = $1
+	`
+	review, err := scanReview(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT s.id, s.review_id, s.reviewer_id, e.name, s.role, s.submitted_at, s.created_at
+		FROM review_submissions s
+		JOIN employees e ON e.id = s.reviewer_id
+		WHERE s.review_id = $1
+		ORDER BY CASE s.role WHEN 'self' THEN 1 WHEN 'peer' THEN 2 ELSE 3 END, s.id
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	review.Submissions = []entity.ReviewSubmission{}
+	submissionIndex := map[int]int{}
+	for rows.Next() {
+		var submission entity.ReviewSubmission
+		err := rows.Scan(
+			&submission.ID,
+			&submission.ReviewID,
+			&submission.ReviewerID,
+			&submission.ReviewerName,
+			&submission.Role,
+			&submission.SubmittedAt,
+			&submission.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		submission.Answers = []entity.ReviewAnswer{}
+		submissionIndex[submission.ID] = len(review.Submissions)
+		review.Submissions = append(review.Submissions, submission)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	answerRows, err := r.pool.Query(ctx, `
+		SELECT a.review_submission_id, a.review_question_id, a.rating, COALESCE(a.comment, '')
+		FROM review_answers a
+		JOIN review_submissions s ON s.id = a.review_submission_id
+		JOIN review_questions q ON q.id = a.review_question_id
+		WHERE s.review_id = $1
+		ORDER BY q.question_number
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer answerRows.Close()
+
+	for answerRows.Next() {
+		var submissionID int
+		var answer entity.ReviewAnswer
+		if err := answerRows.Scan(&submissionID, &answer.QuestionID, &answer.Rating, &answer.Comment); err != nil {
+			return nil, err
+		}
+		submission := &review.Submissions[submissionIndex[submissionID]]
+		submission.Answers = append(submission.Answers, answer)
+	}
+	return review, answerRows.Err()
+}
+
+func (r *ReviewRepoPostgres) AddPeerReviewers(ctx context.Context, reviewID int, reviewerIDs []int) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for _, reviewerID := range reviewerIDs {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO review_submissions (review_id, reviewer_id, role)
+			VALUES ($1, $2, 'peer')
+			ON CONFLICT ON CONSTRAINT review_submissions_review_reviewer_role_key DO NOTHING
+		`, reviewID, reviewerID)
+		if err != nil {
+			if foreignKeyViolationConstraint(err) == "review_submissions_reviewer_id_fkey" {
+				return appError.ErrEmployeeNotFound
+			}
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *ReviewRepoPostgres) SubmitReview(ctx context.Context, submission *entity.ReviewSubmission) (bool, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the review so it cannot be locked or completed while the answers are written.
+	var status entity.ReviewStatus
+	err = tx.QueryRow(ctx, `SELECT status FROM reviews WHERE id = $1 FOR UPDATE`, submission.ReviewID).Scan(&status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	if status != entity.ReviewStatusInProgress {
+		return false, nil
+	}
+
+	// Reviewers asked at launch or as peers already have a pending row; a stand-in manager of an
+	// employee without one does not.
+	var submissionID int
+	err = tx.QueryRow(ctx, `
+		INSERT INTO review_submissions (review_id, reviewer_id, role, submitted_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT ON CONSTRAINT review_submissions_review_reviewer_role_key
+		DO UPDATE SET submitted_at = NOW()
+		WHERE review_submissions.submitted_at IS NULL
+		RETURNING id
+	`, submission.ReviewID, submission.ReviewerID, submission.Role).Scan(&submissionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		if foreignKeyViolationConstraint(err) == "review_submissions_reviewer_id_fkey" {
+			return false, appError.ErrEmployeeNotFound
+		}
+		return false, err
+	}
+
+	for _, answer := range submission.Answers {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO review_answers (review_submission_id, review_question_id, rating, comment)
+			VALUES ($1, $2, $3, NULLIF($4, ''))
+		`, submissionID, answer.QuestionID, answer.Rating, answer.Comment)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	query := `UPDATE reviews SET updated_at = NOW() WHERE id = $1`
+	if submission.Role == entity.ReviewerRoleManager {
+		query = `UPDATE reviews SET status = 'completed', completed_at = NOW(), updated_at = NOW() WHERE id = $1`
+	}
+	if _, err := tx.Exec(ctx, query, submission.ReviewID); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *ReviewRepoPostgres) LockReview(ctx context.Context, id int) (*entity.Review, error) {
+	query := `
+		WITH updated AS (
+			UPDATE reviews
+			SET status = 'locked',
+				locked_at = NOW(),
+				updated_at = NOW()
+			WHERE id = $1 AND status = 'completed'
+			RETURNING *
+		)
+		SELECT ` + reviewColumns + `
+		FROM updated rv` + reviewFrom
+	review, err := scanReview(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return review, nil
+}
+
+func (r *ReviewRepoPostgres) queryReviews(ctx context.Context, query string, args ...any) ([]*entity.Review, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviews := []*entity.Review{}
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+	return reviews, rows.Err()
+}
+
+func getReviewCycle(ctx context.Context, q querier, id int) (*entity.ReviewCycle, error) {
+	query := `
+		SELECT ` + reviewCycleColumns + `
+		FROM review_cycles
+		WHERE id = $1
+	`
+	cycle, err := scanReviewCycle(q.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.Query(ctx, `
+		SELECT id, text, kind
+		FROM review_questions
+		WHERE review_cycle_id = $1
+		ORDER BY question_number
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cycle.Questions = []entity.ReviewQuestion{}
+	for rows.Next() {
+		var question entity.ReviewQuestion
+		if err := rows.Scan(&question.ID, &question.Text, &question.Kind); err != nil {
+			return nil, err
+		}
+		cycle.Questions = append(cycle.Questions, question)
+	}
+	return cycle, rows.Err()
+}
+
+func insertReviewQuestions(ctx context.Context, tx pgx.Tx, cycleID int, questions []entity.ReviewQuestion) error {
+	for number, question := range questions {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO review_questions (review_cycle_id, text, kind, question_number)
+			VALUES ($1, $2, $3, $4)
+		`, cycleID, question.Text, question.Kind, number+1)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
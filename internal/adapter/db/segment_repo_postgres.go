@@ -0,0 +1,252 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const employeeSegmentColumns = `
+	id, name, COALESCE(description, ''), filter, created_by, created_at, updated_at`
+
+// segmentFilter is how a segment's filter is stored in the filter column.
+type segmentFilter struct {
+	Statuses     []entity.EmploymentStatus `json:"statuses,omitempty"`
+	ManagerID    int                       `json:"manager_id,omitempty"`
+	LocationID   int                       `json:"location_id,omitempty"`
+	Tags         []string                  `json:"tags,omitempty"`
+	CustomFields map[string]any            `json:"custom_fields,omitempty"`
+}
+
+type SegmentRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewSegmentRepository(pool *pgxpool.Pool) repository.SegmentRepository {
+	return &SegmentRepoPostgres{pool: pool}
+}
+
+func scanEmployeeSegment(row pgx.Row) (*entity.EmployeeSegment, error) {
+	var segment entity.EmployeeSegment
+	var filter segmentFilter
+	err := row.Scan(
+		&segment.ID,
+		&segment.Name,
+		&segment.Description,
+		&filter,
+		&segment.CreatedBy,
+		&segment.CreatedAt,
+		&segment.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	segment.Filter = entity.EmployeeFilter{
+		Statuses:     filter.Statuses,
+		ManagerID:    filter.ManagerID,
+		LocationID:   filter.LocationID,
+		Tags:         filter.Tags,
+		CustomFields: filter.CustomFields,
+	}
+	return &segment, nil
+}
+
+func toSegmentFilter(filter entity.EmployeeFilter) segmentFilter {
+	return segmentFilter{
+		Statuses:     filter.Statuses,
+		ManagerID:    filter.ManagerID,
+		LocationID:   filter.LocationID,
+		Tags:         filter.Tags,
+		CustomFields: filter.CustomFields,
+	}
+}
+
+func mapEmployeeSegmentWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "employee_segments_name_key" {
+		return appError.ErrSegmentAlreadyExists
+	}
+	if foreignKeyViolationConstraint(err) == "employee_segments_created_by_fkey" {
+		return appError.ErrEmployeeNotFound
+	}
+	return err
+}
+
+func (r *SegmentRepoPostgres) GetEmployeeTags(ctx context.Context, employeeID int) ([]string, error) {
+	rows, err := r.pool.Query(ctx, `SELECT tag FROM employee_tags WHERE employee_id = $1 ORDER BY tag`, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := []string{}
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, rows.Err()
+}
+
+func (r *SegmentRepoPostgres) AddEmployeeTags(ctx context.Context, employeeIDs []int, tags []string) (int, error) {
+	query := `
+		INSERT INTO employee_tags (employee_id, tag)
+		SELECT employee_id, tag
+		FROM unnest($1::int[]) AS employee_id, unnest($2::text[]) AS tag
+		ON CONFLICT (employee_id, tag) DO NOTHING
+	`
+	result, err := r.pool.Exec(ctx, query, employeeIDs, tags)
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "employee_tags_employee_id_fkey" {
+			return 0, appError.ErrEmployeeNotFound
+		}
+		return 0, err
+	}
+	return int(result.RowsAffected()), nil
+}
+
+func (r *SegmentRepoPostgres) RemoveEmployeeTag(ctx context.Context, employeeID int, tag string) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM employee_tags WHERE employee_id = $1 AND tag = $2`, employeeID, tag)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrTagNotFound
+	}
+	return nil
+}
+
+func (r *SegmentRepoPostgres) GetAllTags(ctx context.Context) ([]*entity.TagCount, error) {
+	query := `
+		SELECT tag, COUNT(*)
+		FROM employee_tags
+		GROUP BY tag
+		ORDER BY tag
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := []*entity.TagCount{}
+	for rows.Next() {
+		var tag entity.TagCount
+		if err := rows.Scan(&tag.Tag, &tag.Employees); err != nil {
+			return nil, err
+		}
+		tags = append(tags, &tag)
+	}
+	return tags, rows.Err()
+}
+
+func (r *SegmentRepoPostgres) DeleteTag(ctx context.Context, tag string) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM employee_tags WHERE tag = $1`, tag)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrTagNotFound
+	}
+	return nil
+}
+
+func (r *SegmentRepoPostgres) CreateSegment(ctx context.Context, segment *entity.EmployeeSegment) (*entity.EmployeeSegment, error) {
+	query := `
+		INSERT INTO employee_segments (name, description, filter, created_by)
+		VALUES ($1, NULLIF($2, ''), $3, $4)
+		RETURNING ` + employeeSegmentColumns
+
+	createdSegment, err := scanEmployeeSegment(r.pool.QueryRow(ctx, query,
+		segment.Name,
+		segment.Description,
+		toSegmentFilter(segment.Filter),
+		segment.CreatedBy,
+	))
+	if err != nil {
+		return nil, mapEmployeeSegmentWriteError(err)
+	}
+	return createdSegment, nil
+}
+
+func (r *SegmentRepoPostgres) GetSegmentById(ctx context.Context, id int) (*entity.EmployeeSegment, error) {
+	query := `
+		SELECT ` + employeeSegmentColumns + `
+		FROM employee_segments
+		WHERE id = $1
+	`
+	segment, err := scanEmployeeSegment(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return segment, nil
+}
+
+func (r *SegmentRepoPostgres) GetAllSegments(ctx context.Context) ([]*entity.EmployeeSegment, error) {
+	query := `
+		SELECT ` + employeeSegmentColumns + `
+		FROM employee_segments
+		ORDER BY name
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	segments := []*entity.EmployeeSegment{}
+	for rows.Next() {
+		segment, err := scanEmployeeSegment(rows)
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, segment)
+	}
+	return segments, rows.Err()
+}
+
+func (r *SegmentRepoPostgres) UpdateSegment(ctx context.Context, segment *entity.EmployeeSegment) (*entity.EmployeeSegment, error) {
+	query := `
+		UPDATE employee_segments
+		SET name = $1,
+			description = NULLIF($2, ''),
+			filter = $3,
+			updated_at = NOW()
+		WHERE id = $4
+		RETURNING ` + employeeSegmentColumns
+
+	updatedSegment, err := scanEmployeeSegment(r.pool.QueryRow(ctx, query,
+		segment.Name,
+		segment.Description,
+		toSegmentFilter(segment.Filter),
+		segment.ID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, mapEmployeeSegmentWriteError(err)
+	}
+	return updatedSegment, nil
+}
+
+func (r *SegmentRepoPostgres) DeleteSegment(ctx context.Context, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM employee_segments WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrSegmentNotFound
+	}
+	return nil
+}
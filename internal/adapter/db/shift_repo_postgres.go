@@ -0,0 +1,298 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// Times of day are stored as TIME and handled as minutes after midnight.
+const shiftTemplateColumns = `
+	id, name, (EXTRACT(EPOCH FROM start_time) / 60)::INT, (EXTRACT(EPOCH FROM end_time) / 60)::INT,
+	break_minutes, created_at, updated_at`
+
+const shiftColumns = `
+	s.id, s.employee_id, s.shift_template_id, t.name, s.date, s.starts_at, s.ends_at, s.break_minutes,
+	s.created_at, s.updated_at`
+
+type ShiftRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewShiftRepository(pool *pgxpool.Pool) repository.ShiftRepository {
+	return &ShiftRepoPostgres{pool: pool}
+}
+
+func scanShiftTemplate(row pgx.Row) (*entity.ShiftTemplate, error) {
+	var template entity.ShiftTemplate
+	err := row.Scan(
+		&template.ID,
+		&template.Name,
+		&template.StartTime,
+		&template.EndTime,
+		&template.BreakMinutes,
+		&template.CreatedAt,
+		&template.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+func scanShift(row pgx.Row) (*entity.Shift, error) {
+	var shift entity.Shift
+	err := row.Scan(
+		&shift.ID,
+		&shift.EmployeeID,
+		&shift.ShiftTemplateID,
+		&shift.ShiftTemplateName,
+		&shift.Date,
+		&shift.StartsAt,
+		&shift.EndsAt,
+		&shift.BreakMinutes,
+		&shift.CreatedAt,
+		&shift.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &shift, nil
+}
+
+func mapShiftTemplateWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "shift_templates_name_key" {
+		return appError.ErrShiftTemplateAlreadyExists
+	}
+	return err
+}
+
+func (r *ShiftRepoPostgres) CreateShiftTemplate(ctx context.Context, template *entity.ShiftTemplate) (*entity.ShiftTemplate, error) {
+	query := `
+		INSERT INTO shift_templates (name, start_time, end_time, break_minutes)
+		VALUES ($1, ($2 * INTERVAL '1 minute')::TIME, ($3 * INTERVAL '1 minute')::TIME, $4)
+		RETURNING ` + shiftTemplateColumns
+
+	createdTemplate, err := scanShiftTemplate(r.pool.QueryRow(ctx, query,
+		template.Name,
+		int(template.StartTime),
+		int(template.EndTime),
+		template.BreakMinutes,
+	))
+	if err != nil {
+		return nil, mapShiftTemplateWriteError(err)
+	}
+	return createdTemplate, nil
+}
+
+func (r *ShiftRepoPostgres) GetShiftTemplateById(ctx context.Context, id int) (*entity.ShiftTemplate, error) {
+	query := `
+		SELECT ` + shiftTemplateColumns + `
+		FROM shift_templates
+		WHERE id = $1
+	`
+	template, err := scanShiftTemplate(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return template, nil
+}
+
+func (r *ShiftRepoPostgres) GetAllShiftTemplates(ctx context.Context) ([]*entity.ShiftTemplate, error) {
+	query := `
+		SELECT ` + shiftTemplateColumns + `
+		FROM shift_templates
+		ORDER BY start_time, name
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	templates := []*entity.ShiftTemplate{}
+	for rows.Next() {
+		template, err := scanShiftTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, rows.Err()
+}
+
+func (r *ShiftRepoPostgres) UpdateShiftTemplate(ctx context.Context, template *entity.ShiftTemplate) (*entity.ShiftTemplate, error) {
+	query := `
+		UPDATE shift_templates
+		SET name = $1,
+			start_time = ($2 * INTERVAL '1 minute')::TIME,
+			end_time = ($3 * INTERVAL '1 minute')::TIME,
+			break_minutes = $4,
+			updated_at = NOW()
+		WHERE id = $5
+		RETURNING ` + shiftTemplateColumns
+
+	updatedTemplate, err := scanShiftTemplate(r.pool.QueryRow(ctx, query,
+		template.Name,
+		int(template.StartTime),
+		int(template.EndTime),
+		template.BreakMinutes,
+		template.ID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, mapShiftTemplateWriteError(err)
+	}
+	return updatedTemplate, nil
+}
+
+func (r *ShiftRepoPostgres) DeleteShiftTemplate(ctx context.Context, id int) error {
+	query := `
+		DELETE FROM shift_templates
+		WHERE id = $1
+	`
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "shifts_shift_template_id_fkey" {
+			return appError.ErrShiftTemplateInUse
+		}
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return appError.ErrShiftTemplateNotFound
+	}
+	return nil
+}
+
+func (r *ShiftRepoPostgres) CreateShifts(ctx context.Context, shifts []*entity.Shift) ([]*entity.Shift, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Locking the employee rows, in id order to avoid deadlocks, serializes concurrent rosters
+	// for the same employees so the overlap checks below hold.
+	employeeIDs := []int{}
+	seen := map[int]bool{}
+	for _, shift := range shifts {
+		if !seen[shift.EmployeeID] {
+			seen[shift.EmployeeID] = true
+			employeeIDs = append(employeeIDs, shift.EmployeeID)
+		}
+	}
+	sort.Ints(employeeIDs)
+	for _, employeeID := range employeeIDs {
+		var id int
+		err = tx.QueryRow(ctx, `SELECT id FROM employees WHERE id = $1 FOR UPDATE`, employeeID).Scan(&id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, appError.ErrEmployeeNotFound
+			}
+			return nil, err
+		}
+	}
+
+	query := `
+		WITH inserted AS (
+			INSERT INTO shifts (employee_id, shift_template_id, date, starts_at, ends_at, break_minutes)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING *
+		)
+		SELECT ` + shiftColumns + `
+		FROM inserted s
+		JOIN shift_templates t ON t.id = s.shift_template_id
+	`
+	createdShifts := []*entity.Shift{}
+	for _, shift := range shifts {
+		var overlaps bool
+		err = tx.QueryRow(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM shifts
+				WHERE employee_id = $1 AND starts_at < $3 AND ends_at > $2
+			)
+		`, shift.EmployeeID, shift.StartsAt, shift.EndsAt).Scan(&overlaps)
+		if err != nil {
+			return nil, err
+		}
+		if overlaps {
+			return nil, appError.ErrShiftOverlaps
+		}
+
+		createdShift, err := scanShift(tx.QueryRow(ctx, query,
+			shift.EmployeeID,
+			shift.ShiftTemplateID,
+			shift.Date,
+			shift.StartsAt,
+			shift.EndsAt,
+			shift.BreakMinutes,
+		))
+		if err != nil {
+			if foreignKeyViolationConstraint(err) == "shifts_shift_template_id_fkey" {
+				return nil, appError.ErrShiftTemplateNotFound
+			}
+			return nil, err
+		}
+		createdShifts = append(createdShifts, createdShift)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return createdShifts, nil
+}
+
+func (r *ShiftRepoPostgres) GetShifts(ctx context.Context, employeeID int, start, end time.Time) ([]*entity.Shift, error) {
+	query := `
+		SELECT ` + shiftColumns + `
+		FROM shifts s
+		JOIN shift_templates t ON t.id = s.shift_template_id
+		WHERE s.employee_id = $1 AND s.starts_at < $3 AND s.ends_at > $2
+		ORDER BY s.starts_at
+	`
+	rows, err := r.pool.Query(ctx, query, employeeID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	shifts := []*entity.Shift{}
+	for rows.Next() {
+		shift, err := scanShift(rows)
+		if err != nil {
+			return nil, err
+		}
+		shifts = append(shifts, shift)
+	}
+	return shifts, rows.Err()
+}
+
+func (r *ShiftRepoPostgres) DeleteShift(ctx context.Context, employeeID, id int) error {
+	query := `
+		DELETE FROM shifts
+		WHERE id = $1 AND employee_id = $2
+	`
+	result, err := r.pool.Exec(ctx, query, id, employeeID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return appError.ErrShiftNotFound
+	}
+	return nil
+}
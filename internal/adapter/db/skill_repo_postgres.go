@@ -0,0 +1,348 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const skillCategoryColumns = `id, name, created_at, updated_at`
+
+// skillColumns selects a skill s with the name of its category c.
+const skillColumns = `
+	s.id, s.skill_category_id, c.name, s.name, COALESCE(s.description, ''), s.created_at, s.updated_at`
+
+// employeeSkillColumns selects an employee skill es with its employee e, their position p, the
+// skill s and its category c, joined by employeeSkillFrom.
+const employeeSkillColumns = `
+	es.employee_id, e.name, COALESCE(p.title, ''), es.skill_id, s.name, c.name, es.level, es.last_verified_on,
+	es.created_at, es.updated_at`
+
+const employeeSkillFrom = `
+	FROM employee_skills es
+	JOIN employees e ON e.id = es.employee_id
+	LEFT JOIN positions p ON p.id = e.position_id
+	JOIN skills s ON s.id = es.skill_id
+	JOIN skill_categories c ON c.id = s.skill_category_id`
+
+type SkillRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewSkillRepository(pool *pgxpool.Pool) repository.SkillRepository {
+	return &SkillRepoPostgres{pool: pool}
+}
+
+func scanSkillCategory(row pgx.Row) (*entity.SkillCategory, error) {
+	var category entity.SkillCategory
+	err := row.Scan(
+		&category.ID,
+		&category.Name,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+func scanSkill(row pgx.Row) (*entity.Skill, error) {
+	var skill entity.Skill
+	err := row.Scan(
+		&skill.ID,
+		&skill.CategoryID,
+		&skill.CategoryName,
+		&skill.Name,
+		&skill.Description,
+		&skill.CreatedAt,
+		&skill.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &skill, nil
+}
+
+func scanEmployeeSkill(row pgx.Row) (*entity.EmployeeSkill, error) {
+	var employeeSkill entity.EmployeeSkill
+	err := row.Scan(
+		&employeeSkill.EmployeeID,
+		&employeeSkill.EmployeeName,
+		&employeeSkill.Position,
+		&employeeSkill.SkillID,
+		&employeeSkill.SkillName,
+		&employeeSkill.CategoryName,
+		&employeeSkill.Level,
+		&employeeSkill.LastVerifiedOn,
+		&employeeSkill.CreatedAt,
+		&employeeSkill.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &employeeSkill, nil
+}
+
+func mapSkillWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "skills_name_key" {
+		return appError.ErrSkillAlreadyExists
+	}
+	if foreignKeyViolationConstraint(err) == "skills_skill_category_id_fkey" {
+		return appError.ErrSkillCategoryNotFound
+	}
+	return err
+}
+
+func (r *SkillRepoPostgres) CreateSkillCategory(ctx context.Context, category *entity.SkillCategory) (*entity.SkillCategory, error) {
+	query := `
+		INSERT INTO skill_categories (name)
+		VALUES ($1)
+		RETURNING ` + skillCategoryColumns
+
+	createdCategory, err := scanSkillCategory(r.pool.QueryRow(ctx, query, category.Name))
+	if err != nil {
+		if uniqueViolationConstraint(err) == "skill_categories_name_key" {
+			return nil, appError.ErrSkillCategoryAlreadyExists
+		}
+		return nil, err
+	}
+	return createdCategory, nil
+}
+
+func (r *SkillRepoPostgres) GetAllSkillCategories(ctx context.Context) ([]*entity.SkillCategory, error) {
+	query := `
+		SELECT ` + skillCategoryColumns + `
+		FROM skill_categories
+		ORDER BY name
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	categories := []*entity.SkillCategory{}
+	for rows.Next() {
+		category, err := scanSkillCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, category)
+	}
+	return categories, rows.Err()
+}
+
+func (r *SkillRepoPostgres) UpdateSkillCategory(ctx context.Context, category *entity.SkillCategory) (*entity.SkillCategory, error) {
+	query := `
+		UPDATE skill_categories
+		SET name = $1,
+			updated_at = NOW()
+		WHERE id = $2
+		RETURNING ` + skillCategoryColumns
+
+	updatedCategory, err := scanSkillCategory(r.pool.QueryRow(ctx, query, category.Name, category.ID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		if uniqueViolationConstraint(err) == "skill_categories_name_key" {
+			return nil, appError.ErrSkillCategoryAlreadyExists
+		}
+		return nil, err
+	}
+	return updatedCategory, nil
+}
+
+func (r *SkillRepoPostgres) DeleteSkillCategory(ctx context.Context, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM skill_categories WHERE id = $1`, id)
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "skills_skill_category_id_fkey" {
+			return appError.ErrSkillCategoryInUse
+		}
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrSkillCategoryNotFound
+	}
+	return nil
+}
+
+func (r *SkillRepoPostgres) CreateSkill(ctx context.Context, skill *entity.Skill) (*entity.Skill, error) {
+	query := `
+		INSERT INTO skills (skill_category_id, name, description)
+		VALUES ($1, $2, NULLIF($3, ''))
+		RETURNING id
+	`
+	var skillID int
+	if err := r.pool.QueryRow(ctx, query, skill.CategoryID, skill.Name, skill.Description).Scan(&skillID); err != nil {
+		return nil, mapSkillWriteError(err)
+	}
+	return r.GetSkillById(ctx, skillID)
+}
+
+func (r *SkillRepoPostgres) GetSkillById(ctx context.Context, id int) (*entity.Skill, error) {
+	query := `
+		SELECT ` + skillColumns + `
+		FROM skills s
+		JOIN skill_categories c ON c.id = s.skill_category_id
+		WHERE s.id = $1
+	`
+	skill, err := scanSkill(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return skill, nil
+}
+
+func (r *SkillRepoPostgres) GetAllSkills(ctx context.Context, categoryID int) ([]*entity.Skill, error) {
+	query := `
+		SELECT ` + skillColumns + `
+		FROM skills s
+		JOIN skill_categories c ON c.id = s.skill_category_id
+		WHERE $1 <= 0 OR s.skill_category_id = $1
+		ORDER BY c.name, s.name
+	`
+	rows, err := r.pool.Query(ctx, query, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	skills := []*entity.Skill{}
+	for rows.Next() {
+		skill, err := scanSkill(rows)
+		if err != nil {
+			return nil, err
+		}
+		skills = append(skills, skill)
+	}
+	return skills, rows.Err()
+}
+
+func (r *SkillRepoPostgres) UpdateSkill(ctx context.Context, skill *entity.Skill) (*entity.Skill, error) {
+	query := `
+		UPDATE skills
+		SET skill_category_id = $1,
+			name = $2,
+			description = NULLIF($3, ''),
+			updated_at = NOW()
+		WHERE id = $4
+	`
+	result, err := r.pool.Exec(ctx, query, skill.CategoryID, skill.Name, skill.Description, skill.ID)
+	if err != nil {
+		return nil, mapSkillWriteError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+	return r.GetSkillById(ctx, skill.ID)
+}
+
+func (r *SkillRepoPostgres) DeleteSkill(ctx context.Context, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM skills WHERE id = $1`, id)
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "employee_skills_skill_id_fkey" {
+			return appError.ErrSkillInUse
+		}
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrSkillNotFound
+	}
+	return nil
+}
+
+func (r *SkillRepoPostgres) SetEmployeeSkill(ctx context.Context, employeeSkill *entity.EmployeeSkill) (*entity.EmployeeSkill, error) {
+	query := `
+		INSERT INTO employee_skills (employee_id, skill_id, level, last_verified_on)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (employee_id, skill_id) DO UPDATE
+		SET level = EXCLUDED.level,
+			last_verified_on = EXCLUDED.last_verified_on,
+			updated_at = NOW()
+	`
+	_, err := r.pool.Exec(ctx, query,
+		employeeSkill.EmployeeID,
+		employeeSkill.SkillID,
+		employeeSkill.Level,
+		employeeSkill.LastVerifiedOn,
+	)
+	if err != nil {
+		switch foreignKeyViolationConstraint(err) {
+		case "employee_skills_employee_id_fkey":
+			return nil, appError.ErrEmployeeNotFound
+		case "employee_skills_skill_id_fkey":
+			return nil, appError.ErrSkillNotFound
+		}
+		return nil, err
+	}
+
+	employeeSkills, err := r.queryEmployeeSkills(ctx, `es.employee_id = $1 AND es.skill_id = $2`,
+		employeeSkill.EmployeeID, employeeSkill.SkillID)
+	if err != nil {
+		return nil, err
+	}
+	return employeeSkills[0], nil
+}
+
+func (r *SkillRepoPostgres) GetEmployeeSkills(ctx context.Context, employeeID int) ([]*entity.EmployeeSkill, error) {
+	return r.queryEmployeeSkills(ctx, `es.employee_id = $1`, employeeID)
+}
+
+func (r *SkillRepoPostgres) DeleteEmployeeSkill(ctx context.Context, employeeID, skillID int) error {
+	query := `
+		DELETE FROM employee_skills
+		WHERE employee_id = $1 AND skill_id = $2
+	`
+	result, err := r.pool.Exec(ctx, query, employeeID, skillID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrEmployeeSkillNotFound
+	}
+	return nil
+}
+
+func (r *SkillRepoPostgres) GetSkillHolders(ctx context.Context, skillIDs []int,
+	statuses []entity.EmploymentStatus) ([]*entity.EmployeeSkill, error) {
+
+	statusValues := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		statusValues = append(statusValues, string(status))
+	}
+	return r.queryEmployeeSkills(ctx, `es.skill_id = ANY($1) AND e.status = ANY($2)`, skillIDs, statusValues)
+}
+
+func (r *SkillRepoPostgres) queryEmployeeSkills(ctx context.Context, where string, args ...any) ([]*entity.EmployeeSkill, error) {
+	query := `
+		SELECT ` + employeeSkillColumns + employeeSkillFrom + `
+		WHERE ` + where + `
+		ORDER BY c.name, s.name, e.name
+	`
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	employeeSkills := []*entity.EmployeeSkill{}
+	for rows.Next() {
+		employeeSkill, err := scanEmployeeSkill(rows)
+		if err != nil {
+			return nil, err
+		}
+		employeeSkills = append(employeeSkills, employeeSkill)
+	}
+	return employeeSkills, rows.Err()
+}
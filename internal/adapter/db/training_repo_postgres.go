@@ -0,0 +1,340 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const trainingCourseColumns = `
+	id, name, COALESCE(description, ''), COALESCE(provider, ''), duration_hours::float8, certification_valid_months,
+	created_at, updated_at`
+
+// enrollmentColumns selects an enrollment te with its course tc, its employee e and the
+// certification it issued, joined by enrollmentFrom.
+const enrollmentColumns = `
+	te.id, te.course_id, tc.name, te.employee_id, e.name, te.status, te.enrolled_on, te.due_on, te.completed_on,
+	(SELECT MIN(ct.id) FROM certifications ct WHERE ct.enrollment_id = te.id), te.created_at, te.updated_at`
+
+const enrollmentFrom = `
+	FROM training_enrollments te
+	JOIN training_courses tc ON tc.id = te.course_id
+	JOIN employees e ON e.id = te.employee_id`
+
+type TrainingRepoPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewTrainingRepository(pool *pgxpool.Pool) repository.TrainingRepository {
+	return &TrainingRepoPostgres{pool: pool}
+}
+
+func scanTrainingCourse(row pgx.Row) (*entity.TrainingCourse, error) {
+	var course entity.TrainingCourse
+	err := row.Scan(
+		&course.ID,
+		&course.Name,
+		&course.Description,
+		&course.Provider,
+		&course.DurationHours,
+		&course.CertificationValidMonths,
+		&course.CreatedAt,
+		&course.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &course, nil
+}
+
+func scanEnrollment(row pgx.Row) (*entity.TrainingEnrollment, error) {
+	var enrollment entity.TrainingEnrollment
+	err := row.Scan(
+		&enrollment.ID,
+		&enrollment.CourseID,
+		&enrollment.CourseName,
+		&enrollment.EmployeeID,
+		&enrollment.EmployeeName,
+		&enrollment.Status,
+		&enrollment.EnrolledOn,
+		&enrollment.DueOn,
+		&enrollment.CompletedOn,
+		&enrollment.CertificationID,
+		&enrollment.CreatedAt,
+		&enrollment.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &enrollment, nil
+}
+
+func mapTrainingCourseWriteError(err error) error {
+	if uniqueViolationConstraint(err) == "training_courses_name_key" {
+		return appError.ErrTrainingCourseAlreadyExists
+	}
+	return err
+}
+
+func (r *TrainingRepoPostgres) CreateCourse(ctx context.Context, course *entity.TrainingCourse) (*entity.TrainingCourse, error) {
+	query := `
+		INSERT INTO training_courses (name, description, provider, duration_hours, certification_valid_months)
+		VALUES ($1, NULLIF($2, ''), NULLIF($3, ''), $4, $5)
+		RETURNING ` + trainingCourseColumns
+
+	createdCourse, err := scanTrainingCourse(r.pool.QueryRow(ctx, query,
+		course.Name,
+		course.Description,
+		course.Provider,
+		course.DurationHours,
+		course.CertificationValidMonths,
+	))
+	if err != nil {
+		return nil, mapTrainingCourseWriteError(err)
+	}
+	return createdCourse, nil
+}
+
+func (r *TrainingRepoPostgres) GetCourseById(ctx context.Context, id int) (*entity.TrainingCourse, error) {
+	query := `
+		SELECT ` + trainingCourseColumns + `
+		FROM training_courses
+		WHERE id = $1
+	`
+	course, err := scanTrainingCourse(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return course, nil
+}
+
+func (r *TrainingRepoPostgres) GetAllCourses(ctx context.Context) ([]*entity.TrainingCourse, error) {
+	query := `
+		SELECT ` + trainingCourseColumns + `
+		FROM training_courses
+		ORDER BY name
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	courses := []*entity.TrainingCourse{}
+	for rows.Next() {
+		course, err := scanTrainingCourse(rows)
+		if err != nil {
+			return nil, err
+		}
+		courses = append(courses, course)
+	}
+	return courses, rows.Err()
+}
+
+func (r *TrainingRepoPostgres) UpdateCourse(ctx context.Context, course *entity.TrainingCourse) (*entity.TrainingCourse, error) {
+	query := `
+		UPDATE training_courses
+		SET name = $1,
+			description = NULLIF($2, ''),
+			provider = NULLIF($3, ''),
+			duration_hours = $4,
+			certification_valid_months = $5,
+			updated_at = NOW()
+		WHERE id = $6
+		RETURNING ` + trainingCourseColumns
+
+	updatedCourse, err := scanTrainingCourse(r.pool.QueryRow(ctx, query,
+		course.Name,
+		course.Description,
+		course.Provider,
+		course.DurationHours,
+		course.CertificationValidMonths,
+		course.ID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, mapTrainingCourseWriteError(err)
+	}
+	return updatedCourse, nil
+}
+
+func (r *TrainingRepoPostgres) DeleteCourse(ctx context.Context, id int) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM training_courses WHERE id = $1`, id)
+	if err != nil {
+		if foreignKeyViolationConstraint(err) == "training_enrollments_course_id_fkey" {
+			return appError.ErrTrainingCourseInUse
+		}
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return appError.ErrTrainingCourseNotFound
+	}
+	return nil
+}
+
+func (r *TrainingRepoPostgres) CreateEnrollment(ctx context.Context, enrollment *entity.TrainingEnrollment) (*entity.TrainingEnrollment, error) {
+	query := `
+		INSERT INTO training_enrollments (course_id, employee_id, enrolled_on, due_on)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`
+	var enrollmentID int
+	err := r.pool.QueryRow(ctx, query,
+		enrollment.CourseID,
+		enrollment.EmployeeID,
+		enrollment.EnrolledOn,
+		enrollment.DueOn,
+	).Scan(&enrollmentID)
+	if err != nil {
+		if uniqueViolationConstraint(err) == "training_enrollments_open_key" {
+			return nil, appError.ErrAlreadyEnrolled
+		}
+		switch foreignKeyViolationConstraint(err) {
+		case "training_enrollments_course_id_fkey":
+			return nil, appError.ErrTrainingCourseNotFound
+		case "training_enrollments_employee_id_fkey":
+			return nil, appError.ErrEmployeeNotFound
+		}
+		return nil, err
+	}
+	return r.GetEnrollmentById(ctx, enrollmentID)
+}
+
+func (r *TrainingRepoPostgres) GetEnrollmentById(ctx context.Context, id int) (*entity.TrainingEnrollment, error) {
+	return getEnrollment(ctx, r.pool, id)
+}
+
+// buildEnrollmentFilter turns filter into a WHERE clause (empty when nothing is filtered) and its arguments.
+func buildEnrollmentFilter(filter entity.EnrollmentFilter) (string, []any) {
+	conditions := []string{}
+	args := []any{}
+
+	if filter.CourseID > 0 {
+		args = append(args, filter.CourseID)
+		conditions = append(conditions, fmt.Sprintf("te.course_id = $%d", len(args)))
+	}
+	if filter.EmployeeID > 0 {
+		args = append(args, filter.EmployeeID)
+		conditions = append(conditions, fmt.Sprintf("te.employee_id = $%d", len(args)))
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func (r *TrainingRepoPostgres) GetEnrollments(ctx context.Context, filter entity.EnrollmentFilter) ([]*entity.TrainingEnrollment, error) {
+	where, args := buildEnrollmentFilter(filter)
+	query := `
+		SELECT ` + enrollmentColumns + enrollmentFrom + `
+		` + where + `
+		ORDER BY te.enrolled_on DESC, te.id DESC
+	`
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	enrollments := []*entity.TrainingEnrollment{}
+	for rows.Next() {
+		enrollment, err := scanEnrollment(rows)
+		if err != nil {
+			return nil, err
+		}
+		enrollments = append(enrollments, enrollment)
+	}
+	return enrollments, rows.Err()
+}
+
+func (r *TrainingRepoPostgres) CompleteEnrollment(ctx context.Context, enrollment *entity.TrainingEnrollment,
+	certification *entity.Certification) (*entity.TrainingEnrollment, error) {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE training_enrollments
+		SET status = $1,
+			completed_on = $2,
+			updated_at = NOW()
+		WHERE id = $3 AND status = $4
+	`
+	result, err := tx.Exec(ctx, query,
+		entity.EnrollmentStatusCompleted,
+		enrollment.CompletedOn,
+		enrollment.ID,
+		entity.EnrollmentStatusEnrolled,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+
+	if certification != nil {
+		certification.EnrollmentID = &enrollment.ID
+		if _, err := insertCertification(ctx, tx, certification); err != nil {
+			return nil, err
+		}
+	}
+
+	completedEnrollment, err := getEnrollment(ctx, tx, enrollment.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return completedEnrollment, nil
+}
+
+func (r *TrainingRepoPostgres) CancelEnrollment(ctx context.Context, id int) (*entity.TrainingEnrollment, error) {
+	query := `
+		UPDATE training_enrollments
+		SET status = $1,
+			updated_at = NOW()
+		WHERE id = $2 AND status = $3
+	`
+	result, err := r.pool.Exec(ctx, query, entity.EnrollmentStatusCancelled, id, entity.EnrollmentStatusEnrolled)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, nil
+	}
+	return r.GetEnrollmentById(ctx, id)
+}
+
+func getEnrollment(ctx context.Context, q querier, id int) (*entity.TrainingEnrollment, error) {
+	query := `
+		SELECT ` + enrollmentColumns + enrollmentFrom + `
+		WHERE te.id = $1
+	`
+	enrollment, err := scanEnrollment(q.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return enrollment, nil
+}
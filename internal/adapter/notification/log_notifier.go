@@ -0,0 +1,21 @@
+package notificationadapter
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	domainnotification "github.com/mohamedfawas/employee_management_system/internal/domain/notification"
+)
+
+// LogNotifier writes notices to the log, for setups without a notification webhook.
+type LogNotifier struct{}
+
+func NewLogNotifier() domainnotification.Notifier {
+	return &LogNotifier{}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, message domainnotification.Message) error {
+	log.Printf("[NOTIFY] to=%s subject=%q: %s", strings.Join(message.To, ","), message.Subject, message.Body)
+	return nil
+}
@@ -0,0 +1,59 @@
+package notificationadapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	domainnotification "github.com/mohamedfawas/employee_management_system/internal/domain/notification"
+)
+
+// WebhookNotifier posts notices as JSON to a URL, leaving delivery (email, chat) to the receiver.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+type webhookPayload struct {
+	To      []string `json:"to"`
+	Subject string   `json:"subject"`
+	Body    string   `json:"body"`
+}
+
+func NewWebhookNotifier(url string, timeout time.Duration) domainnotification.Notifier {
+	return &WebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, message domainnotification.Message) error {
+	payload, err := json.Marshal(webhookPayload{
+		To:      message.To,
+		Subject: message.Subject,
+		Body:    message.Body,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("notification webhook answered %s", resp.Status)
+	}
+	return nil
+}
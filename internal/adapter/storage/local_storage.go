@@ -0,0 +1,86 @@
+package storageadapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	domainstorage "github.com/mohamedfawas/employee_management_system/internal/domain/storage"
+)
+
+// LocalStorage keeps blobs as files under a root directory, one file per key.
+type LocalStorage struct {
+	root string
+}
+
+func NewLocalStorage(root string) (domainstorage.BlobStorage, error) {
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("create storage directory %s: %w", root, err)
+	}
+	return &LocalStorage{root: root}, nil
+}
+
+// path maps key to a file under the root, refusing keys that would escape it.
+func (s *LocalStorage) path(key string) (string, error) {
+	name := filepath.FromSlash(key)
+	if !filepath.IsLocal(name) {
+		return "", fmt.Errorf("invalid storage key %q", key)
+	}
+	return filepath.Join(s.root, name), nil
+}
+
+// Put writes to a temporary file first so readers never see a partly written blob.
+func (s *LocalStorage) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name()) // fails harmlessly once renamed
+
+	if _, err := io.Copy(file, content); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+func (s *LocalStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, domainstorage.ErrBlobNotFound
+		}
+		return nil, err
+	}
+	return file, nil
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
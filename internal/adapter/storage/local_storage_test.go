@@ -0,0 +1,83 @@
+package storageadapter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	domainstorage "github.com/mohamedfawas/employee_management_system/internal/domain/storage"
+)
+
+func TestLocalStoragePathRejectsEscapingKeys(t *testing.T) {
+	root := t.TempDir()
+	storage := &LocalStorage{root: root}
+
+	for _, key := range []string{"../secret", "a/../../secret", "..", "/etc/passwd", ""} {
+		if path, err := storage.path(key); err == nil {
+			t.Errorf("path(%q) = %s, want an error", key, path)
+		}
+	}
+
+	path, err := storage.path("employees/7/contract.pdf")
+	if err != nil {
+		t.Fatalf("path: %v", err)
+	}
+	if want := filepath.Join(root, "employees", "7", "contract.pdf"); path != want {
+		t.Errorf("path = %s, want %s", path, want)
+	}
+}
+
+func TestLocalStoragePutRejectsEscapingKeys(t *testing.T) {
+	parent := t.TempDir()
+	storage, err := NewLocalStorage(filepath.Join(parent, "blobs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = storage.Put(context.Background(), "../outside.txt", strings.NewReader("x"), 1, "text/plain")
+	if err == nil {
+		t.Fatal("Put succeeded, want an error")
+	}
+	if _, err := os.Stat(filepath.Join(parent, "outside.txt")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("file written outside the root: %v", err)
+	}
+}
+
+func TestLocalStoragePutGetDelete(t *testing.T) {
+	storage, err := NewLocalStorage(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	key := "employees/7/contract.pdf"
+
+	if err := storage.Put(ctx, key, strings.NewReader("contract"), 8, "application/pdf"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	reader, err := storage.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	body, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != "contract" {
+		t.Errorf("Get = %q, want contract", body)
+	}
+
+	if err := storage.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := storage.Get(ctx, key); !errors.Is(err, domainstorage.ErrBlobNotFound) {
+		t.Errorf("Get after Delete = %v, want ErrBlobNotFound", err)
+	}
+	if err := storage.Delete(ctx, key); err != nil {
+		t.Errorf("Delete of a missing blob = %v, want nil", err)
+	}
+}
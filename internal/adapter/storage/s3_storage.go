@@ -0,0 +1,171 @@
+package storageadapter
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	domainstorage "github.com/mohamedfawas/employee_management_system/internal/domain/storage"
+)
+
+// S3Config points S3Storage at a bucket of AWS S3 or an S3-compatible server such as MinIO.
+type S3Config struct {
+	Endpoint        string // e.g. https://s3.eu-west-1.amazonaws.com or http://localhost:9000
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// UsePathStyle addresses objects as <endpoint>/<bucket>/<key> instead of
+	// <bucket>.<endpoint host>/<key>, as most self-hosted servers expect.
+	UsePathStyle bool
+	Timeout      time.Duration
+}
+
+// S3Storage keeps blobs as objects of one bucket, talking to the S3 REST API with requests signed
+// by Signature Version 4.
+type S3Storage struct {
+	endpoint *url.URL
+	config   S3Config
+	client   *http.Client
+}
+
+func NewS3Storage(config S3Config) (domainstorage.BlobStorage, error) {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, fmt.Errorf("invalid S3 endpoint %q", config.Endpoint)
+	}
+	if config.Bucket == "" || config.Region == "" {
+		return nil, fmt.Errorf("S3 bucket and region are required")
+	}
+	return &S3Storage{
+		endpoint: endpoint,
+		config:   config,
+		client:   &http.Client{Timeout: config.Timeout},
+	}, nil
+}
+
+// objectURL returns the URL of the object stored under key.
+func (s *S3Storage) objectURL(key string) *url.URL {
+	objectURL := *s.endpoint
+	path := strings.TrimSuffix(objectURL.Path, "/")
+	if s.config.UsePathStyle {
+		path += "/" + s.config.Bucket
+	} else {
+		objectURL.Host = s.config.Bucket + "." + objectURL.Host
+	}
+	objectURL.Path = path + "/" + key
+	objectURL.RawPath = escapeS3Path(objectURL.Path)
+	return &objectURL
+}
+
+// Put sends the blob in one request. The payload is hashed for the signature, so it is read into
+// memory first; callers keep uploads small.
+func (s *S3Storage) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	payload, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key).String(), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	payloadHash := sha256.Sum256(payload)
+	resp, err := s.do(req, hex.EncodeToString(payloadHash[:]))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return s3Error(resp)
+	}
+	return nil
+}
+
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key).String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(req, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, domainstorage.ErrBlobNotFound
+	}
+	defer resp.Body.Close()
+	return nil, s3Error(resp)
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key).String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// S3 answers 204 whether or not the object existed; some compatible servers answer 404.
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	}
+	return s3Error(resp)
+}
+
+func (s *S3Storage) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	signV4(req, payloadHash, s3Credentials{
+		accessKeyID:     s.config.AccessKeyID,
+		secretAccessKey: s.config.SecretAccessKey,
+		region:          s.config.Region,
+		service:         "s3",
+	}, time.Now())
+	return s.client.Do(req)
+}
+
+// s3Error describes a failed request, including the start of the XML error S3 sends back.
+func s3Error(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("S3 %s %s answered %s: %s",
+		resp.Request.Method, resp.Request.URL.Path, resp.Status, strings.TrimSpace(string(body)))
+}
+
+// escapeS3Path URI-encodes every byte of path except unreserved characters and slashes, as
+// Signature Version 4 expects of S3 object paths.
+func escapeS3Path(path string) string {
+	var escaped strings.Builder
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		if c == '/' || isUnreserved(c) {
+			escaped.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&escaped, "%%%02X", c)
+	}
+	return escaped.String()
+}
+
+func isUnreserved(c byte) bool {
+	return 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
@@ -0,0 +1,188 @@
+package storageadapter
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	domainstorage "github.com/mohamedfawas/employee_management_system/internal/domain/storage"
+)
+
+// fakeS3 stands in for an S3 bucket served path style, keeping objects in memory. It checks that
+// every request is signed and that uploads carry the hash of their payload.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	types   map[string]string
+	fail    bool
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: map[string][]byte{}, types: map[string]string{}}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=test-key/") {
+		http.Error(w, "<Error><Code>AccessDenied</Code></Error>", http.StatusForbidden)
+		return
+	}
+	if f.fail {
+		http.Error(w, "<Error><Code>InternalError</Code></Error>", http.StatusInternalServerError)
+		return
+	}
+
+	key := r.URL.EscapedPath()
+	switch r.Method {
+	case http.MethodPut:
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		hash := sha256.Sum256(body)
+		if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(hash[:]) {
+			http.Error(w, "<Error><Code>XAmzContentSHA256Mismatch</Code></Error>", http.StatusBadRequest)
+			return
+		}
+		f.objects[key] = body
+		f.types[key] = r.Header.Get("Content-Type")
+	case http.MethodGet:
+		body, ok := f.objects[key]
+		if !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		w.Write(body)
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestS3Storage(t *testing.T, backend *fakeS3) domainstorage.BlobStorage {
+	t.Helper()
+	server := httptest.NewServer(backend)
+	t.Cleanup(server.Close)
+
+	storage, err := NewS3Storage(S3Config{
+		Endpoint:        server.URL,
+		Region:          "us-east-1",
+		Bucket:          "documents",
+		AccessKeyID:     "test-key",
+		SecretAccessKey: "test-secret",
+		UsePathStyle:    true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return storage
+}
+
+func TestS3StoragePutGetDelete(t *testing.T) {
+	backend := newFakeS3()
+	storage := newTestS3Storage(t, backend)
+	ctx := context.Background()
+	key := "employees/7/contract signed.pdf"
+	content := "%PDF-1.4 contract"
+
+	if err := storage.Put(ctx, key, strings.NewReader(content), int64(len(content)), "application/pdf"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	objectKey := "/documents/employees/7/contract%20signed.pdf"
+	if _, ok := backend.objects[objectKey]; !ok {
+		t.Fatalf("object not stored under %s, have %v", objectKey, backend.objects)
+	}
+	if got := backend.types[objectKey]; got != "application/pdf" {
+		t.Errorf("Content-Type = %q, want application/pdf", got)
+	}
+
+	reader, err := storage.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	body, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != content {
+		t.Errorf("Get = %q, want %q", body, content)
+	}
+
+	if err := storage.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := storage.Get(ctx, key); !errors.Is(err, domainstorage.ErrBlobNotFound) {
+		t.Errorf("Get after Delete = %v, want ErrBlobNotFound", err)
+	}
+}
+
+func TestS3StorageGetMissing(t *testing.T) {
+	storage := newTestS3Storage(t, newFakeS3())
+
+	_, err := storage.Get(context.Background(), "employees/7/missing.pdf")
+	if !errors.Is(err, domainstorage.ErrBlobNotFound) {
+		t.Errorf("Get = %v, want ErrBlobNotFound", err)
+	}
+}
+
+func TestS3StorageDeleteMissing(t *testing.T) {
+	storage := newTestS3Storage(t, newFakeS3())
+
+	if err := storage.Delete(context.Background(), "employees/7/missing.pdf"); err != nil {
+		t.Errorf("Delete = %v, want nil", err)
+	}
+}
+
+func TestS3StorageServerError(t *testing.T) {
+	backend := newFakeS3()
+	backend.fail = true
+	storage := newTestS3Storage(t, backend)
+	ctx := context.Background()
+
+	if err := storage.Put(ctx, "a.pdf", strings.NewReader("x"), 1, "application/pdf"); err == nil {
+		t.Error("Put succeeded, want an error")
+	}
+	if _, err := storage.Get(ctx, "a.pdf"); err == nil || errors.Is(err, domainstorage.ErrBlobNotFound) {
+		t.Errorf("Get = %v, want a server error", err)
+	}
+	if err := storage.Delete(ctx, "a.pdf"); err == nil {
+		t.Error("Delete succeeded, want an error")
+	}
+}
+
+func TestS3StorageObjectURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoint  string
+		pathStyle bool
+		want      string
+	}{
+		{"virtual hosted", "https://s3.eu-west-1.amazonaws.com", false, "https://documents.s3.eu-west-1.amazonaws.com/a/b%2Bc.pdf"},
+		{"path style", "http://localhost:9000", true, "http://localhost:9000/documents/a/b%2Bc.pdf"},
+		{"path style with prefix", "http://localhost:9000/s3/", true, "http://localhost:9000/s3/documents/a/b%2Bc.pdf"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage, err := NewS3Storage(S3Config{Endpoint: tt.endpoint, Region: "eu-west-1", Bucket: "documents", UsePathStyle: tt.pathStyle})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := storage.(*S3Storage).objectURL("a/b+c.pdf").String(); got != tt.want {
+				t.Errorf("objectURL = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -12,11 +12,16 @@ import (
 	_ "github.com/mohamedfawas/employee_management_system/docs"
 	cacheadapter "github.com/mohamedfawas/employee_management_system/internal/adapter/cache"
 	postgresAdapter "github.com/mohamedfawas/employee_management_system/internal/adapter/db"
+	notificationadapter "github.com/mohamedfawas/employee_management_system/internal/adapter/notification"
+	storageadapter "github.com/mohamedfawas/employee_management_system/internal/adapter/storage"
 	"github.com/mohamedfawas/employee_management_system/internal/config"
 	httpRouter "github.com/mohamedfawas/employee_management_system/internal/delivery/http"
 	customMiddleware "github.com/mohamedfawas/employee_management_system/internal/delivery/http/middleware"
 	v1 "github.com/mohamedfawas/employee_management_system/internal/delivery/http/v1"
-	employeeUsecase "github.com/mohamedfawas/employee_management_system/internal/usecase"
+	"github.com/mohamedfawas/employee_management_system/internal/delivery/job"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/storage"
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
 	redisClient "github.com/mohamedfawas/employee_management_system/pkg/cache"
 	"github.com/mohamedfawas/employee_management_system/pkg/constants"
 	postgresClient "github.com/mohamedfawas/employee_management_system/pkg/database/postgres"
@@ -27,6 +32,8 @@ type Server struct {
 	config     *config.Config
 	httpServer *http.Server
 
+	scheduler *job.Scheduler
+
 	postgresClient *postgresClient.Client
 	redisClient    *redisClient.Client
 }
@@ -36,6 +43,50 @@ func NewServer(ctx context.Context, cfg *config.Config) (*Server, error) {
 		config: cfg,
 	}
 
+	if !entity.IsValidCurrency(cfg.Reporting.Currency) {
+		return nil, fmt.Errorf("invalid reporting currency %q", cfg.Reporting.Currency)
+	}
+	salaryBandPolicy := entity.SalaryBandPolicy(cfg.Compensation.BandPolicy)
+	if !salaryBandPolicy.IsValid() {
+		return nil, fmt.Errorf("invalid salary band policy %q", cfg.Compensation.BandPolicy)
+	}
+	overtimeRules := entity.OvertimeRules{
+		DailyLimit:  time.Duration(cfg.Attendance.DailyOvertimeHours * float64(time.Hour)),
+		WeeklyLimit: time.Duration(cfg.Attendance.WeeklyOvertimeHours * float64(time.Hour)),
+		RestDays:    cfg.Attendance.RestDayOvertime,
+	}
+	if !overtimeRules.IsValid() {
+		return nil, fmt.Errorf("invalid overtime thresholds %v/%v hours",
+			cfg.Attendance.DailyOvertimeHours, cfg.Attendance.WeeklyOvertimeHours)
+	}
+	if cfg.Attendance.MaxShiftHours <= 0 {
+		return nil, fmt.Errorf("invalid max shift length %d hours", cfg.Attendance.MaxShiftHours)
+	}
+	if cfg.Scheduling.MinRestHours < 0 {
+		return nil, fmt.Errorf("invalid minimum rest period %v hours", cfg.Scheduling.MinRestHours)
+	}
+	if cfg.Scheduling.FeedDays <= 0 {
+		return nil, fmt.Errorf("invalid shift feed length %d days", cfg.Scheduling.FeedDays)
+	}
+
+	prorationBasis := entity.ProrationBasis(cfg.Payroll.ProrationBasis)
+	if !prorationBasis.IsValid() {
+		return nil, fmt.Errorf("invalid proration basis %q", cfg.Payroll.ProrationBasis)
+	}
+
+	if cfg.Certifications.ExpiryWindowDays < 1 || cfg.Certifications.ExpiryWindowDays > 365 {
+		return nil, fmt.Errorf("invalid certification expiry window %d days", cfg.Certifications.ExpiryWindowDays)
+	}
+
+	if cfg.Documents.MaxSizeMB <= 0 {
+		return nil, fmt.Errorf("invalid maximum document size %d MB", cfg.Documents.MaxSizeMB)
+	}
+	maxDocumentSize := int64(cfg.Documents.MaxSizeMB) << 20
+	blobStorage, err := newBlobStorage(cfg.Storage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize document storage: %w", err)
+	}
+
 	if err := server.initClients(ctx); err != nil {
 		return nil, fmt.Errorf("failed to initialize clients: %w", err)
 	}
@@ -53,10 +104,121 @@ func NewServer(ctx context.Context, cfg *config.Config) (*Server, error) {
 	e.GET("/swagger/*", echoSwagger.WrapHandler)
 
 	employeeRepo := postgresAdapter.NewEmployeeRepository(server.postgresClient.Pool)
+	emergencyContactRepo := postgresAdapter.NewEmergencyContactRepository(server.postgresClient.Pool)
+	compensationRepo := postgresAdapter.NewCompensationRepository(server.postgresClient.Pool)
+	exchangeRateRepo := postgresAdapter.NewExchangeRateRepository(server.postgresClient.Pool)
+	positionRepo := postgresAdapter.NewPositionRepository(server.postgresClient.Pool)
+	leaveRepo := postgresAdapter.NewLeaveRepository(server.postgresClient.Pool)
+	holidayCalendarRepo := postgresAdapter.NewHolidayCalendarRepository(server.postgresClient.Pool)
+	locationRepo := postgresAdapter.NewLocationRepository(server.postgresClient.Pool)
+	attendanceRepo := postgresAdapter.NewAttendanceRepository(server.postgresClient.Pool)
+	shiftRepo := postgresAdapter.NewShiftRepository(server.postgresClient.Pool)
+	payrollRepo := postgresAdapter.NewPayrollRepository(server.postgresClient.Pool)
+	departmentRepo := postgresAdapter.NewDepartmentRepository(server.postgresClient.Pool)
+	reviewRepo := postgresAdapter.NewReviewRepository(server.postgresClient.Pool)
+	goalRepo := postgresAdapter.NewGoalRepository(server.postgresClient.Pool)
+	skillRepo := postgresAdapter.NewSkillRepository(server.postgresClient.Pool)
+	trainingRepo := postgresAdapter.NewTrainingRepository(server.postgresClient.Pool)
+	certificationRepo := postgresAdapter.NewCertificationRepository(server.postgresClient.Pool)
+	documentRepo := postgresAdapter.NewDocumentRepository(server.postgresClient.Pool)
+	checklistRepo := postgresAdapter.NewChecklistRepository(server.postgresClient.Pool)
+	approvalRepo := postgresAdapter.NewApprovalRepository(server.postgresClient.Pool)
+	recruitingRepo := postgresAdapter.NewRecruitingRepository(server.postgresClient.Pool)
+	assetRepo := postgresAdapter.NewAssetRepository(server.postgresClient.Pool)
+	expenseRepo := postgresAdapter.NewExpenseRepository(server.postgresClient.Pool)
+	benefitRepo := postgresAdapter.NewBenefitRepository(server.postgresClient.Pool)
+	customFieldRepo := postgresAdapter.NewCustomFieldRepository(server.postgresClient.Pool)
+	segmentRepo := postgresAdapter.NewSegmentRepository(server.postgresClient.Pool)
 	redisAdapter := cacheadapter.NewRedisAdapter(server.redisClient)
-	employeeUsecase := employeeUsecase.NewEmployeeUsecase(employeeRepo, redisAdapter)
-	employeeHandler := v1.NewEmployeeHandler(employeeUsecase)
-	httpRouter.RegisterRoutes(e, employeeHandler)
+	notifier := notificationadapter.NewLogNotifier()
+	if cfg.Notifications.WebhookURL != "" {
+		notifier = notificationadapter.NewWebhookNotifier(cfg.Notifications.WebhookURL,
+			time.Duration(cfg.Notifications.WebhookTimeout)*time.Second)
+	}
+
+	exchangeRateUsecase := usecase.NewExchangeRateUsecase(exchangeRateRepo, employeeRepo, cfg.Reporting.Currency)
+	checklistUsecase := usecase.NewChecklistUsecase(checklistRepo, employeeRepo)
+	approvalUsecase := usecase.NewApprovalUsecase(approvalRepo, employeeRepo, positionRepo, notifier)
+	employeeUsecase := usecase.NewEmployeeUsecase(employeeRepo, positionRepo, exchangeRateUsecase, salaryBandPolicy, redisAdapter,
+		checklistUsecase, approvalUsecase, customFieldRepo, assetRepo, locationRepo)
+	emergencyContactUsecase := usecase.NewEmergencyContactUsecase(emergencyContactRepo, employeeRepo)
+	compensationUsecase := usecase.NewCompensationUsecase(compensationRepo, employeeRepo, locationRepo, positionRepo,
+		exchangeRateUsecase, salaryBandPolicy, redisAdapter, approvalUsecase)
+	approvalUsecase.RegisterHandler(entity.ApprovalTypeTermination, employeeUsecase)
+	approvalUsecase.RegisterHandler(entity.ApprovalTypeSalaryChange, compensationUsecase)
+	positionUsecase := usecase.NewPositionUsecase(positionRepo, employeeRepo, exchangeRateUsecase)
+	holidayCalendarUsecase := usecase.NewHolidayCalendarUsecase(holidayCalendarRepo)
+	locationUsecase := usecase.NewLocationUsecase(locationRepo, employeeRepo, redisAdapter)
+	workingDayCalculator := usecase.NewWorkingDayCalculator(employeeRepo, locationRepo, holidayCalendarRepo)
+	leaveUsecase := usecase.NewLeaveUsecase(leaveRepo, employeeRepo, employeeUsecase, workingDayCalculator, approvalUsecase)
+	approvalUsecase.RegisterHandler(entity.ApprovalTypeLeave, leaveUsecase)
+	attendanceUsecase := usecase.NewAttendanceUsecase(attendanceRepo, employeeRepo, leaveRepo, locationRepo, workingDayCalculator,
+		overtimeRules, time.Duration(cfg.Attendance.MaxShiftHours)*time.Hour)
+	shiftUsecase := usecase.NewShiftUsecase(shiftRepo, employeeRepo, leaveRepo, locationRepo,
+		time.Duration(cfg.Scheduling.MinRestHours*float64(time.Hour)), time.Duration(cfg.Scheduling.FeedDays)*24*time.Hour)
+	compensationCalculator := usecase.NewCompensationCalculator(compensationRepo, employeeRepo, workingDayCalculator,
+		exchangeRateUsecase, prorationBasis)
+	payrollUsecase := usecase.NewPayrollUsecase(payrollRepo, employeeRepo, benefitRepo, expenseRepo,
+		exchangeRateUsecase, compensationCalculator)
+	departmentUsecase := usecase.NewDepartmentUsecase(departmentRepo)
+	reviewUsecase := usecase.NewReviewUsecase(reviewRepo, employeeRepo)
+	goalUsecase := usecase.NewGoalUsecase(goalRepo, employeeRepo)
+	skillUsecase := usecase.NewSkillUsecase(skillRepo, employeeRepo)
+	trainingUsecase := usecase.NewTrainingUsecase(trainingRepo, employeeRepo)
+	certificationUsecase := usecase.NewCertificationUsecase(certificationRepo, employeeRepo, notifier,
+		cfg.Certifications.ExpiryWindowDays)
+	documentUsecase := usecase.NewDocumentUsecase(documentRepo, employeeRepo, blobStorage, maxDocumentSize)
+	recruitingUsecase := usecase.NewRecruitingUsecase(recruitingRepo, employeeRepo, locationRepo, employeeUsecase)
+	assetUsecase := usecase.NewAssetUsecase(assetRepo, employeeRepo, locationRepo)
+	expenseUsecase := usecase.NewExpenseUsecase(expenseRepo, employeeRepo, payrollRepo, exchangeRateUsecase,
+		approvalUsecase, blobStorage, maxDocumentSize, cfg.Reporting.Currency)
+	approvalUsecase.RegisterHandler(entity.ApprovalTypeExpense, expenseUsecase)
+	benefitUsecase := usecase.NewBenefitUsecase(benefitRepo, employeeRepo, locationRepo)
+	customFieldUsecase := usecase.NewCustomFieldUsecase(customFieldRepo, redisAdapter)
+	segmentUsecase := usecase.NewSegmentUsecase(segmentRepo, employeeRepo, customFieldRepo, notifier, redisAdapter)
+
+	httpRouter.RegisterRoutes(e, httpRouter.Handlers{
+		Employee:         v1.NewEmployeeHandler(employeeUsecase),
+		EmergencyContact: v1.NewEmergencyContactHandler(emergencyContactUsecase),
+		Compensation:     v1.NewCompensationHandler(compensationUsecase, compensationCalculator),
+		ExchangeRate:     v1.NewExchangeRateHandler(exchangeRateUsecase),
+		Position:         v1.NewPositionHandler(positionUsecase),
+		Leave:            v1.NewLeaveHandler(leaveUsecase),
+		HolidayCalendar:  v1.NewHolidayCalendarHandler(holidayCalendarUsecase, workingDayCalculator),
+		Location:         v1.NewLocationHandler(locationUsecase),
+		Attendance:       v1.NewAttendanceHandler(attendanceUsecase),
+		Shift:            v1.NewShiftHandler(shiftUsecase),
+		Payroll:          v1.NewPayrollHandler(payrollUsecase),
+		Department:       v1.NewDepartmentHandler(departmentUsecase),
+		Review:           v1.NewReviewHandler(reviewUsecase),
+		Goal:             v1.NewGoalHandler(goalUsecase),
+		Skill:            v1.NewSkillHandler(skillUsecase),
+		Training:         v1.NewTrainingHandler(trainingUsecase),
+		Certification:    v1.NewCertificationHandler(certificationUsecase),
+		Document:         v1.NewDocumentHandler(documentUsecase),
+		Checklist:        v1.NewChecklistHandler(checklistUsecase),
+		Approval:         v1.NewApprovalHandler(approvalUsecase),
+		Recruiting:       v1.NewRecruitingHandler(recruitingUsecase),
+		Asset:            v1.NewAssetHandler(assetUsecase),
+		Expense:          v1.NewExpenseHandler(expenseUsecase),
+		Benefit:          v1.NewBenefitHandler(benefitUsecase),
+		CustomField:      v1.NewCustomFieldHandler(customFieldUsecase),
+		Segment:          v1.NewSegmentHandler(segmentUsecase),
+	}, httpRouter.UploadLimits{Document: maxDocumentSize, Evidence: usecase.MaxEvidenceSize})
+
+	server.scheduler = job.NewScheduler()
+	server.scheduler.Register(job.NewApplyCompensationChangesJob(compensationUsecase,
+		time.Duration(cfg.Jobs.CompensationInterval)*time.Minute))
+	server.scheduler.Register(job.NewLeaveStatusJob(leaveUsecase,
+		time.Duration(cfg.Jobs.LeaveStatusInterval)*time.Minute))
+	server.scheduler.Register(job.NewCertificationExpiryJob(certificationUsecase,
+		time.Duration(cfg.Jobs.CertificationExpiryInterval)*time.Minute))
+	server.scheduler.Register(job.NewApprovalEscalationJob(approvalUsecase,
+		time.Duration(cfg.Jobs.ApprovalEscalationInterval)*time.Minute))
+	server.scheduler.Register(job.NewApplyLocationAssignmentsJob(locationUsecase,
+		time.Duration(cfg.Jobs.LocationAssignmentInterval)*time.Minute))
+	server.scheduler.Register(job.NewActivateStartingHiresJob(recruitingUsecase,
+		time.Duration(cfg.Jobs.HireActivationInterval)*time.Minute))
 
 	server.httpServer = &http.Server{
 		Addr:         fmt.Sprintf(":%s", cfg.HTTP.Port),
@@ -101,6 +263,25 @@ func (s *Server) initClients(ctx context.Context) error {
 	return nil
 }
 
+// newBlobStorage returns the storage backend selected by the configuration.
+func newBlobStorage(cfg config.StorageConfig) (storage.BlobStorage, error) {
+	switch cfg.Backend {
+	case "local":
+		return storageadapter.NewLocalStorage(cfg.LocalPath)
+	case "s3":
+		return storageadapter.NewS3Storage(storageadapter.S3Config{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			UsePathStyle:    cfg.S3UsePathStyle,
+			Timeout:         time.Duration(cfg.S3Timeout) * time.Second,
+		})
+	}
+	return nil, fmt.Errorf("unknown storage backend %q", cfg.Backend)
+}
+
 func setupMiddleware(e *echo.Echo) {
 	e.Use(customMiddleware.RequestIDMiddleware())
 	e.Use(middleware.Recover())
@@ -109,10 +290,13 @@ func setupMiddleware(e *echo.Echo) {
 }
 
 func (s *Server) Start() error {
+	s.scheduler.Start()
 	return s.httpServer.ListenAndServe()
 }
 
 func (s *Server) Stop(ctx context.Context) error {
+	s.scheduler.Stop()
+
 	if s.postgresClient != nil {
 		s.postgresClient.Close()
 	}
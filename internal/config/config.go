@@ -7,10 +7,20 @@ import (
 )
 
 type Config struct {
-	Environment string         `mapstructure:"environment"`
-	HTTP        HTTPConfig     `mapstructure:"http"`
-	Postgres    PostgresConfig `mapstructure:"postgres"`
-	Redis       RedisConfig    `mapstructure:"redis"`
+	Environment    string               `mapstructure:"environment"`
+	HTTP           HTTPConfig           `mapstructure:"http"`
+	Postgres       PostgresConfig       `mapstructure:"postgres"`
+	Redis          RedisConfig          `mapstructure:"redis"`
+	Jobs           JobsConfig           `mapstructure:"jobs"`
+	Reporting      ReportingConfig      `mapstructure:"reporting"`
+	Compensation   CompensationConfig   `mapstructure:"compensation"`
+	Attendance     AttendanceConfig     `mapstructure:"attendance"`
+	Scheduling     SchedulingConfig     `mapstructure:"scheduling"`
+	Payroll        PayrollConfig        `mapstructure:"payroll"`
+	Certifications CertificationsConfig `mapstructure:"certifications"`
+	Notifications  NotificationsConfig  `mapstructure:"notifications"`
+	Storage        StorageConfig        `mapstructure:"storage"`
+	Documents      DocumentsConfig      `mapstructure:"documents"`
 }
 
 type HTTPConfig struct {
@@ -37,6 +47,64 @@ type RedisConfig struct {
 	DB       int    `mapstructure:"db"`
 }
 
+type JobsConfig struct {
+	CompensationInterval        int `mapstructure:"compensation_interval"`         // in minutes, 0 disables the job
+	LeaveStatusInterval         int `mapstructure:"leave_status_interval"`         // in minutes, 0 disables the job
+	CertificationExpiryInterval int `mapstructure:"certification_expiry_interval"` // in minutes, 0 disables the job
+	ApprovalEscalationInterval  int `mapstructure:"approval_escalation_interval"`  // in minutes, 0 disables the job
+	LocationAssignmentInterval  int `mapstructure:"location_assignment_interval"`  // in minutes, 0 disables the job
+	HireActivationInterval      int `mapstructure:"hire_activation_interval"`      // in minutes, 0 disables the job
+}
+
+type ReportingConfig struct {
+	Currency string `mapstructure:"currency"` // ISO 4217 code salaries are converted to for reports
+}
+
+type CompensationConfig struct {
+	BandPolicy string `mapstructure:"band_policy"` // reject or flag salaries outside the position's band
+}
+
+type AttendanceConfig struct {
+	DailyOvertimeHours  float64 `mapstructure:"daily_overtime_hours"`  // hours per day before overtime, 0 disables
+	WeeklyOvertimeHours float64 `mapstructure:"weekly_overtime_hours"` // regular hours per week before overtime, 0 disables
+	RestDayOvertime     bool    `mapstructure:"rest_day_overtime"`     // time on weekends and public holidays is overtime
+	MaxShiftHours       int     `mapstructure:"max_shift_hours"`       // open shifts older than this are missing a clock-out
+}
+
+type SchedulingConfig struct {
+	MinRestHours float64 `mapstructure:"min_rest_hours"` // minimum time off between two shifts of an employee
+	FeedDays     int     `mapstructure:"feed_days"`      // how many days ahead the shift calendar feed covers
+}
+
+type PayrollConfig struct {
+	ProrationBasis string `mapstructure:"proration_basis"` // calendar_days or working_days
+}
+
+type CertificationsConfig struct {
+	ExpiryWindowDays int `mapstructure:"expiry_window_days"` // how many days before expiry holders are notified
+}
+
+type NotificationsConfig struct {
+	WebhookURL     string `mapstructure:"webhook_url"`     // notices are posted here as JSON, empty logs them instead
+	WebhookTimeout int    `mapstructure:"webhook_timeout"` // in seconds
+}
+
+type StorageConfig struct {
+	Backend           string `mapstructure:"backend"`    // local or s3
+	LocalPath         string `mapstructure:"local_path"` // directory files are kept in by the local backend
+	S3Endpoint        string `mapstructure:"s3_endpoint"`
+	S3Region          string `mapstructure:"s3_region"`
+	S3Bucket          string `mapstructure:"s3_bucket"`
+	S3AccessKeyID     string `mapstructure:"s3_access_key_id"`
+	S3SecretAccessKey string `mapstructure:"s3_secret_access_key"`
+	S3UsePathStyle    bool   `mapstructure:"s3_use_path_style"` // needed by most S3-compatible servers, e.g. MinIO
+	S3Timeout         int    `mapstructure:"s3_timeout"`        // in seconds
+}
+
+type DocumentsConfig struct {
+	MaxSizeMB int `mapstructure:"max_size_mb"` // largest employee document accepted
+}
+
 func Load(configPath string) (*Config, error) {
 	v := viper.New()
 	v.SetEnvPrefix("APP") // Prefix for env vars (e.g., APP_ENVIRONMENT, APP_HTTP_PORT)
@@ -83,6 +151,54 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("redis.port", 6379)
 	v.SetDefault("redis.password", "")
 	v.SetDefault("redis.db", 0)
+
+	// Background job defaults
+	v.SetDefault("jobs.compensation_interval", 60)
+	v.SetDefault("jobs.leave_status_interval", 60)
+	v.SetDefault("jobs.certification_expiry_interval", 24*60)
+	v.SetDefault("jobs.approval_escalation_interval", 15)
+	v.SetDefault("jobs.location_assignment_interval", 60)
+	v.SetDefault("jobs.hire_activation_interval", 60)
+
+	// Reporting defaults
+	v.SetDefault("reporting.currency", "USD")
+
+	// Compensation defaults
+	v.SetDefault("compensation.band_policy", "reject")
+
+	// Attendance defaults
+	v.SetDefault("attendance.daily_overtime_hours", 8)
+	v.SetDefault("attendance.weekly_overtime_hours", 40)
+	v.SetDefault("attendance.rest_day_overtime", true)
+	v.SetDefault("attendance.max_shift_hours", 16)
+
+	// Scheduling defaults
+	v.SetDefault("scheduling.min_rest_hours", 11)
+	v.SetDefault("scheduling.feed_days", 90)
+
+	// Payroll defaults
+	v.SetDefault("payroll.proration_basis", "calendar_days")
+
+	// Certification defaults
+	v.SetDefault("certifications.expiry_window_days", 30)
+
+	// Notification defaults
+	v.SetDefault("notifications.webhook_url", "")
+	v.SetDefault("notifications.webhook_timeout", 10)
+
+	// Storage defaults
+	v.SetDefault("storage.backend", "local")
+	v.SetDefault("storage.local_path", "./data/documents")
+	v.SetDefault("storage.s3_endpoint", "")
+	v.SetDefault("storage.s3_region", "us-east-1")
+	v.SetDefault("storage.s3_bucket", "")
+	v.SetDefault("storage.s3_access_key_id", "")
+	v.SetDefault("storage.s3_secret_access_key", "")
+	v.SetDefault("storage.s3_use_path_style", false)
+	v.SetDefault("storage.s3_timeout", 60)
+
+	// Document defaults
+	v.SetDefault("documents.max_size_mb", 10)
 }
 
 // bindEnvVars binds environment variables for all config fields.
@@ -104,6 +220,34 @@ func bindEnvVars(v *viper.Viper) {
 		"redis.port",
 		"redis.password",
 		"redis.db",
+		"jobs.compensation_interval",
+		"jobs.leave_status_interval",
+		"jobs.certification_expiry_interval",
+		"jobs.approval_escalation_interval",
+		"jobs.location_assignment_interval",
+		"jobs.hire_activation_interval",
+		"reporting.currency",
+		"compensation.band_policy",
+		"attendance.daily_overtime_hours",
+		"attendance.weekly_overtime_hours",
+		"attendance.rest_day_overtime",
+		"attendance.max_shift_hours",
+		"scheduling.min_rest_hours",
+		"scheduling.feed_days",
+		"payroll.proration_basis",
+		"certifications.expiry_window_days",
+		"notifications.webhook_url",
+		"notifications.webhook_timeout",
+		"storage.backend",
+		"storage.local_path",
+		"storage.s3_endpoint",
+		"storage.s3_region",
+		"storage.s3_bucket",
+		"storage.s3_access_key_id",
+		"storage.s3_secret_access_key",
+		"storage.s3_use_path_style",
+		"storage.s3_timeout",
+		"documents.max_size_mb",
 	}
 	for _, key := range keys {
 		_ = v.BindEnv(key)
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// multipartOverhead is the room left on top of the file for the multipart framing and the other
+// form fields of an upload.
+const multipartOverhead = 1 << 20
+
+// BodyLimitMiddleware turns away uploads of files larger than maxFileSize before they are read.
+// Requests announcing a larger body are rejected up front, and the body of the others is cut off
+// once it goes over the limit, which the handlers see as a failed read.
+func BodyLimitMiddleware(maxFileSize int64) echo.MiddlewareFunc {
+	limit := maxFileSize + multipartOverhead
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			request := c.Request()
+			if request.ContentLength > limit {
+				return apiresponse.Error(c, appError.ErrRequestTooLarge, nil)
+			}
+			request.Body = http.MaxBytesReader(c.Response(), request.Body, limit)
+			return next(c)
+		}
+	}
+}
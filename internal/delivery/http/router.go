@@ -2,16 +2,353 @@ package http
 
 import (
 	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/delivery/http/middleware"
 	v1 "github.com/mohamedfawas/employee_management_system/internal/delivery/http/v1"
 )
 
-func RegisterRoutes(e *echo.Echo, h *v1.EmployeeHandler) {
+// Handlers groups the v1 handlers registered on the router.
+type Handlers struct {
+	Employee         *v1.EmployeeHandler
+	EmergencyContact *v1.EmergencyContactHandler
+	Compensation     *v1.CompensationHandler
+	ExchangeRate     *v1.ExchangeRateHandler
+	Position         *v1.PositionHandler
+	Leave            *v1.LeaveHandler
+	HolidayCalendar  *v1.HolidayCalendarHandler
+	Location         *v1.LocationHandler
+	Attendance       *v1.AttendanceHandler
+	Shift            *v1.ShiftHandler
+	Payroll          *v1.PayrollHandler
+	Department       *v1.DepartmentHandler
+	Review           *v1.ReviewHandler
+	Goal             *v1.GoalHandler
+	Skill            *v1.SkillHandler
+	Training         *v1.TrainingHandler
+	Certification    *v1.CertificationHandler
+	Document         *v1.DocumentHandler
+	Checklist        *v1.ChecklistHandler
+	Approval         *v1.ApprovalHandler
+	Recruiting       *v1.RecruitingHandler
+	Asset            *v1.AssetHandler
+	Expense          *v1.ExpenseHandler
+	Benefit          *v1.BenefitHandler
+	CustomField      *v1.CustomFieldHandler
+	Segment          *v1.SegmentHandler
+}
+
+// UploadLimits caps the files accepted by the upload routes, so that larger requests are turned
+// away before their body is read.
+type UploadLimits struct {
+	Document int64 // employee documents and expense receipts
+	Evidence int64 // certification evidence
+}
+
+func RegisterRoutes(e *echo.Echo, h Handlers, limits UploadLimits) {
+	documentLimit := middleware.BodyLimitMiddleware(limits.Document)
+	evidenceLimit := middleware.BodyLimitMiddleware(limits.Evidence)
+
 	v1 := e.Group("/api/v1")
 	{
-		v1.POST("/employees", h.CreateEmployee)
-		v1.GET("/employees/:id", h.GetEmployeeById)
-		v1.GET("/employees", h.GetAllEmployees)
-		v1.PUT("/employees/:id", h.UpdateEmployee)
-		v1.DELETE("/employees/:id", h.DeleteEmployee)
+		v1.POST("/employees", h.Employee.CreateEmployee)
+		v1.GET("/employees/:id", h.Employee.GetEmployeeById)
+		v1.GET("/employees", h.Employee.GetAllEmployees)
+		v1.PUT("/employees/:id", h.Employee.UpdateEmployee)
+		v1.DELETE("/employees/:id", h.Employee.DeleteEmployee)
+		v1.PUT("/employees/:id/status", h.Employee.ChangeEmploymentStatus)
+		v1.POST("/employees/:id/terminate", h.Employee.TerminateEmployee)
+		v1.POST("/employees/:id/rehire", h.Employee.RehireEmployee)
+		v1.GET("/employees/:id/status-history", h.Employee.GetEmploymentStatusHistory)
+
+		v1.POST("/employees/:id/emergency-contacts", h.EmergencyContact.CreateEmergencyContact)
+		v1.GET("/employees/:id/emergency-contacts", h.EmergencyContact.GetEmergencyContacts)
+		v1.GET("/employees/:id/emergency-contacts/:contactId", h.EmergencyContact.GetEmergencyContactById)
+		v1.PUT("/employees/:id/emergency-contacts/:contactId", h.EmergencyContact.UpdateEmergencyContact)
+		v1.DELETE("/employees/:id/emergency-contacts/:contactId", h.EmergencyContact.DeleteEmergencyContact)
+
+		v1.POST("/employees/:id/compensation", h.Compensation.ScheduleCompensationChange)
+		v1.GET("/employees/:id/compensation", h.Compensation.GetCompensationHistory)
+		v1.DELETE("/employees/:id/compensation/:changeId", h.Compensation.CancelCompensationChange)
+		v1.GET("/employees/:id/pay-preview", h.Compensation.GetPayPreview)
+		v1.GET("/employees/:id/salary", h.ExchangeRate.ConvertEmployeeSalary)
+
+		v1.POST("/exchange-rates", h.ExchangeRate.CreateExchangeRate)
+		v1.GET("/exchange-rates", h.ExchangeRate.GetExchangeRates)
+
+		v1.POST("/positions", h.Position.CreatePosition)
+		v1.GET("/positions", h.Position.GetAllPositions)
+		v1.GET("/positions/:id", h.Position.GetPositionById)
+		v1.PUT("/positions/:id", h.Position.UpdatePosition)
+		v1.DELETE("/positions/:id", h.Position.DeletePosition)
+		v1.GET("/reports/out-of-band-salaries", h.Position.GetOutOfBandEmployees)
+
+		v1.POST("/leave-types", h.Leave.CreateLeaveType)
+		v1.GET("/leave-types", h.Leave.GetAllLeaveTypes)
+		v1.GET("/leave-types/:id", h.Leave.GetLeaveTypeById)
+		v1.PUT("/leave-types/:id", h.Leave.UpdateLeaveType)
+		v1.DELETE("/leave-types/:id", h.Leave.DeleteLeaveType)
+		v1.GET("/employees/:id/leave-balances", h.Leave.GetLeaveBalances)
+		v1.POST("/employees/:id/leave-requests", h.Leave.CreateLeaveRequest)
+		v1.GET("/employees/:id/leave-requests", h.Leave.GetLeaveRequests)
+		v1.GET("/employees/:id/leave-requests/:requestId", h.Leave.GetLeaveRequestById)
+		v1.POST("/employees/:id/leave-requests/:requestId/approve", h.Leave.ApproveLeaveRequest)
+		v1.POST("/employees/:id/leave-requests/:requestId/reject", h.Leave.RejectLeaveRequest)
+		v1.POST("/employees/:id/leave-requests/:requestId/cancel", h.Leave.CancelLeaveRequest)
+
+		v1.POST("/holiday-calendars", h.HolidayCalendar.CreateHolidayCalendar)
+		v1.GET("/holiday-calendars", h.HolidayCalendar.GetAllHolidayCalendars)
+		v1.GET("/holiday-calendars/:id", h.HolidayCalendar.GetHolidayCalendarById)
+		v1.PUT("/holiday-calendars/:id", h.HolidayCalendar.UpdateHolidayCalendar)
+		v1.DELETE("/holiday-calendars/:id", h.HolidayCalendar.DeleteHolidayCalendar)
+		v1.POST("/holiday-calendars/:id/holidays", h.HolidayCalendar.CreateHoliday)
+		v1.GET("/holiday-calendars/:id/holidays", h.HolidayCalendar.GetHolidays)
+		v1.DELETE("/holiday-calendars/:id/holidays/:holidayId", h.HolidayCalendar.DeleteHoliday)
+		v1.POST("/holiday-calendars/:id/import", h.HolidayCalendar.ImportHolidays)
+		v1.GET("/employees/:id/working-days", h.HolidayCalendar.GetWorkingDays)
+
+		v1.POST("/locations", h.Location.CreateLocation)
+		v1.GET("/locations", h.Location.GetAllLocations)
+		v1.GET("/locations/:id", h.Location.GetLocationById)
+		v1.PUT("/locations/:id", h.Location.UpdateLocation)
+		v1.DELETE("/locations/:id", h.Location.DeleteLocation)
+		v1.POST("/employees/:id/locations", h.Location.AssignEmployeeLocation)
+		v1.GET("/employees/:id/locations", h.Location.GetLocationHistory)
+		v1.DELETE("/employees/:id/locations/:assignmentId", h.Location.CancelLocationAssignment)
+
+		v1.POST("/employees/:id/attendance/clock-in", h.Attendance.ClockIn)
+		v1.POST("/employees/:id/attendance/clock-out", h.Attendance.ClockOut)
+		v1.GET("/employees/:id/attendance", h.Attendance.GetAttendanceEntries)
+		v1.PUT("/employees/:id/attendance/:entryId", h.Attendance.UpdateAttendanceEntry)
+		v1.GET("/employees/:id/timesheets", h.Attendance.GetTimesheets)
+		v1.GET("/employees/:id/timesheets/:week", h.Attendance.GetTimesheet)
+		v1.POST("/employees/:id/timesheets/:week/submit", h.Attendance.SubmitTimesheet)
+		v1.POST("/employees/:id/timesheets/:week/approve", h.Attendance.ApproveTimesheet)
+		v1.POST("/employees/:id/timesheets/:week/reject", h.Attendance.RejectTimesheet)
+		v1.GET("/reports/missing-clock-outs", h.Attendance.GetMissingClockOuts)
+
+		v1.POST("/shift-templates", h.Shift.CreateShiftTemplate)
+		v1.GET("/shift-templates", h.Shift.GetAllShiftTemplates)
+		v1.GET("/shift-templates/:id", h.Shift.GetShiftTemplateById)
+		v1.PUT("/shift-templates/:id", h.Shift.UpdateShiftTemplate)
+		v1.DELETE("/shift-templates/:id", h.Shift.DeleteShiftTemplate)
+		v1.POST("/rosters", h.Shift.CreateRoster)
+		v1.GET("/employees/:id/shifts", h.Shift.GetShifts)
+		v1.GET("/employees/:id/shifts.ics", h.Shift.GetShiftFeed)
+		v1.DELETE("/employees/:id/shifts/:shiftId", h.Shift.DeleteShift)
+
+		v1.POST("/payroll-rules", h.Payroll.CreatePayrollRule)
+		v1.GET("/payroll-rules", h.Payroll.GetAllPayrollRules)
+		v1.GET("/payroll-rules/:id", h.Payroll.GetPayrollRuleById)
+		v1.PUT("/payroll-rules/:id", h.Payroll.UpdatePayrollRule)
+		v1.DELETE("/payroll-rules/:id", h.Payroll.DeletePayrollRule)
+		v1.POST("/payroll-periods", h.Payroll.CreatePayrollPeriod)
+		v1.GET("/payroll-periods", h.Payroll.GetAllPayrollPeriods)
+		v1.GET("/payroll-periods/:id", h.Payroll.GetPayrollPeriodById)
+		v1.POST("/payroll-runs", h.Payroll.CreatePayrollRun)
+		v1.GET("/payroll-runs", h.Payroll.GetAllPayrollRuns)
+		v1.GET("/payroll-runs/:id", h.Payroll.GetPayrollRunById)
+		v1.DELETE("/payroll-runs/:id", h.Payroll.DeletePayrollRun)
+		v1.POST("/payroll-runs/:id/recalculate", h.Payroll.RecalculatePayrollRun)
+		v1.POST("/payroll-runs/:id/finalize", h.Payroll.FinalizePayrollRun)
+		v1.POST("/payroll-runs/:id/reopen", h.Payroll.ReopenPayrollRun)
+		v1.POST("/payroll-runs/:id/lock", h.Payroll.LockPayrollRun)
+		v1.GET("/payroll-runs/:id/payslips", h.Payroll.GetPayslips)
+		v1.GET("/payroll-runs/:id/payslips/:payslipId", h.Payroll.GetPayslipById)
+		v1.GET("/payroll-runs/:id/payslips/:payslipId/pdf", h.Payroll.GetPayslipPDF)
+		v1.GET("/employees/:id/payslips", h.Payroll.GetEmployeePayslips)
+
+		v1.POST("/departments", h.Department.CreateDepartment)
+		v1.GET("/departments", h.Department.GetAllDepartments)
+		v1.GET("/departments/:id", h.Department.GetDepartmentById)
+		v1.PUT("/departments/:id", h.Department.UpdateDepartment)
+		v1.DELETE("/departments/:id", h.Department.DeleteDepartment)
+
+		v1.POST("/review-cycles", h.Review.CreateReviewCycle)
+		v1.GET("/review-cycles", h.Review.GetAllReviewCycles)
+		v1.GET("/review-cycles/:id", h.Review.GetReviewCycleById)
+		v1.PUT("/review-cycles/:id", h.Review.UpdateReviewCycle)
+		v1.DELETE("/review-cycles/:id", h.Review.DeleteReviewCycle)
+		v1.POST("/review-cycles/:id/launch", h.Review.LaunchReviewCycle)
+		v1.POST("/review-cycles/:id/close", h.Review.CloseReviewCycle)
+		v1.GET("/review-cycles/:id/reviews", h.Review.GetReviews)
+		v1.GET("/review-cycles/:id/calibration", h.Review.GetReviewCalibration)
+		v1.GET("/reviews/:id", h.Review.GetReviewById)
+		v1.POST("/reviews/:id/peers", h.Review.AddPeerReviewers)
+		v1.POST("/reviews/:id/submissions", h.Review.SubmitReview)
+		v1.POST("/reviews/:id/lock", h.Review.LockReview)
+		v1.GET("/employees/:id/reviews", h.Review.GetEmployeeReviews)
+
+		v1.POST("/goals", h.Goal.CreateGoal)
+		v1.GET("/goals", h.Goal.GetCompanyGoals)
+		v1.GET("/goals/:id", h.Goal.GetGoalById)
+		v1.PUT("/goals/:id", h.Goal.UpdateGoal)
+		v1.DELETE("/goals/:id", h.Goal.DeleteGoal)
+		v1.GET("/goals/:id/aligned", h.Goal.GetAlignedGoals)
+		v1.POST("/goals/:id/key-results", h.Goal.AddKeyResult)
+		v1.POST("/goals/:id/check-ins", h.Goal.CreateGoalCheckIn)
+		v1.GET("/goals/:id/check-ins", h.Goal.GetGoalCheckIns)
+		v1.GET("/employees/:id/goals", h.Goal.GetEmployeeGoals)
+		v1.GET("/employees/:id/goal-rollup", h.Goal.GetGoalRollup)
+
+		v1.POST("/skill-categories", h.Skill.CreateSkillCategory)
+		v1.GET("/skill-categories", h.Skill.GetAllSkillCategories)
+		v1.PUT("/skill-categories/:id", h.Skill.UpdateSkillCategory)
+		v1.DELETE("/skill-categories/:id", h.Skill.DeleteSkillCategory)
+		v1.POST("/skills", h.Skill.CreateSkill)
+		v1.GET("/skills", h.Skill.GetAllSkills)
+		v1.POST("/skills/search", h.Skill.SearchEmployeesBySkills)
+		v1.GET("/skills/:id", h.Skill.GetSkillById)
+		v1.PUT("/skills/:id", h.Skill.UpdateSkill)
+		v1.DELETE("/skills/:id", h.Skill.DeleteSkill)
+		v1.GET("/employees/:id/skills", h.Skill.GetEmployeeSkills)
+		v1.PUT("/employees/:id/skills/:skillId", h.Skill.SetEmployeeSkill)
+		v1.DELETE("/employees/:id/skills/:skillId", h.Skill.DeleteEmployeeSkill)
+
+		v1.POST("/training-courses", h.Training.CreateCourse)
+		v1.GET("/training-courses", h.Training.GetAllCourses)
+		v1.GET("/training-courses/:id", h.Training.GetCourseById)
+		v1.PUT("/training-courses/:id", h.Training.UpdateCourse)
+		v1.DELETE("/training-courses/:id", h.Training.DeleteCourse)
+		v1.POST("/training-courses/:id/enrollments", h.Training.EnrollEmployee)
+		v1.GET("/training-courses/:id/enrollments", h.Training.GetCourseEnrollments)
+		v1.POST("/enrollments/:id/complete", h.Training.CompleteEnrollment)
+		v1.POST("/enrollments/:id/cancel", h.Training.CancelEnrollment)
+		v1.GET("/employees/:id/enrollments", h.Training.GetEmployeeEnrollments)
+
+		v1.POST("/employees/:id/certifications", h.Certification.CreateCertification)
+		v1.GET("/employees/:id/certifications", h.Certification.GetEmployeeCertifications)
+		v1.GET("/certifications/expiring", h.Certification.GetExpiringCertifications)
+		v1.GET("/certifications/:id", h.Certification.GetCertificationById)
+		v1.PUT("/certifications/:id", h.Certification.UpdateCertification)
+		v1.DELETE("/certifications/:id", h.Certification.DeleteCertification)
+		v1.POST("/certifications/:id/evidence", h.Certification.AddEvidence, evidenceLimit)
+		v1.GET("/certifications/:id/evidence", h.Certification.GetEvidence)
+		v1.GET("/certifications/:id/evidence/:evidenceId", h.Certification.DownloadEvidence)
+		v1.DELETE("/certifications/:id/evidence/:evidenceId", h.Certification.DeleteEvidence)
+
+		v1.POST("/employees/:id/documents", h.Document.UploadDocument, documentLimit)
+		v1.GET("/employees/:id/documents", h.Document.GetEmployeeDocuments)
+		v1.GET("/employees/:id/documents/:documentId", h.Document.DownloadDocument)
+		v1.DELETE("/employees/:id/documents/:documentId", h.Document.DeleteDocument)
+
+		v1.POST("/checklist-templates", h.Checklist.CreateTemplate)
+		v1.GET("/checklist-templates", h.Checklist.GetTemplates)
+		v1.GET("/checklist-templates/:id", h.Checklist.GetTemplateById)
+		v1.PUT("/checklist-templates/:id", h.Checklist.UpdateTemplate)
+		v1.DELETE("/checklist-templates/:id", h.Checklist.DeleteTemplate)
+		v1.POST("/employees/:id/checklists", h.Checklist.StartChecklist)
+		v1.GET("/employees/:id/checklists", h.Checklist.GetEmployeeChecklists)
+		v1.GET("/employees/:id/checklist-tasks", h.Checklist.GetOwnerTasks)
+		v1.GET("/checklists", h.Checklist.GetChecklists)
+		v1.GET("/checklists/:id", h.Checklist.GetChecklistById)
+		v1.PUT("/checklist-tasks/:id", h.Checklist.AssignTask)
+		v1.POST("/checklist-tasks/:id/complete", h.Checklist.CompleteTask)
+		v1.POST("/checklist-tasks/:id/reopen", h.Checklist.ReopenTask)
+
+		v1.POST("/approval-workflows", h.Approval.CreateWorkflow)
+		v1.GET("/approval-workflows", h.Approval.GetWorkflows)
+		v1.GET("/approval-workflows/:id", h.Approval.GetWorkflowById)
+		v1.PUT("/approval-workflows/:id", h.Approval.UpdateWorkflow)
+		v1.DELETE("/approval-workflows/:id", h.Approval.DeleteWorkflow)
+		v1.GET("/approvals", h.Approval.GetInbox)
+		v1.GET("/approvals/requests", h.Approval.GetRequests)
+		v1.GET("/approvals/requests/:id", h.Approval.GetRequestById)
+		v1.POST("/approvals/requests/:id/cancel", h.Approval.CancelRequest)
+		v1.POST("/approvals/tasks/:id/approve", h.Approval.ApproveTask)
+		v1.POST("/approvals/tasks/:id/reject", h.Approval.RejectTask)
+		v1.POST("/employees/:id/delegations", h.Approval.CreateDelegation)
+		v1.GET("/employees/:id/delegations", h.Approval.GetDelegations)
+		v1.DELETE("/employees/:id/delegations/:delegationId", h.Approval.DeleteDelegation)
+
+		v1.POST("/requisitions", h.Recruiting.CreateRequisition)
+		v1.GET("/requisitions", h.Recruiting.GetRequisitions)
+		v1.GET("/requisitions/:id", h.Recruiting.GetRequisitionById)
+		v1.PUT("/requisitions/:id", h.Recruiting.UpdateRequisition)
+		v1.DELETE("/requisitions/:id", h.Recruiting.DeleteRequisition)
+		v1.POST("/requisitions/:id/candidates", h.Recruiting.AddCandidate)
+		v1.GET("/requisitions/:id/candidates", h.Recruiting.GetCandidates)
+		v1.GET("/candidates/:id", h.Recruiting.GetCandidateById)
+		v1.PUT("/candidates/:id", h.Recruiting.UpdateCandidate)
+		v1.DELETE("/candidates/:id", h.Recruiting.DeleteCandidate)
+		v1.POST("/candidates/:id/stage", h.Recruiting.MoveCandidate)
+		v1.POST("/candidates/:id/offer", h.Recruiting.MakeOffer)
+		v1.POST("/candidates/:id/accept-offer", h.Recruiting.AcceptOffer)
+		v1.POST("/candidates/:id/interviews", h.Recruiting.ScheduleInterview)
+		v1.GET("/candidates/:id/interviews", h.Recruiting.GetCandidateInterviews)
+		v1.PUT("/interviews/:id", h.Recruiting.RescheduleInterview)
+		v1.POST("/interviews/:id/feedback", h.Recruiting.SubmitInterviewFeedback)
+		v1.POST("/interviews/:id/cancel", h.Recruiting.CancelInterview)
+		v1.GET("/employees/:id/interviews", h.Recruiting.GetInterviewerSchedule)
+
+		v1.POST("/assets", h.Asset.CreateAsset)
+		v1.GET("/assets", h.Asset.GetAssets)
+		v1.GET("/assets/:id", h.Asset.GetAssetById)
+		v1.PUT("/assets/:id", h.Asset.UpdateAsset)
+		v1.DELETE("/assets/:id", h.Asset.DeleteAsset)
+		v1.POST("/assets/:id/assign", h.Asset.AssignAsset)
+		v1.POST("/assets/:id/return", h.Asset.ReturnAsset)
+		v1.GET("/assets/:id/assignments", h.Asset.GetAssetAssignments)
+		v1.GET("/employees/:id/assets", h.Asset.GetEmployeeAssets)
+
+		v1.POST("/expense-categories", h.Expense.CreateExpenseCategory)
+		v1.GET("/expense-categories", h.Expense.GetAllExpenseCategories)
+		v1.GET("/expense-categories/:id", h.Expense.GetExpenseCategoryById)
+		v1.PUT("/expense-categories/:id", h.Expense.UpdateExpenseCategory)
+		v1.DELETE("/expense-categories/:id", h.Expense.DeleteExpenseCategory)
+		v1.POST("/employees/:id/expenses", h.Expense.CreateExpenseClaim)
+		v1.GET("/employees/:id/expenses", h.Expense.GetEmployeeExpenseClaims)
+		v1.GET("/employees/:id/expenses/:expenseId", h.Expense.GetExpenseClaimById)
+		v1.PUT("/employees/:id/expenses/:expenseId", h.Expense.UpdateExpenseClaim)
+		v1.DELETE("/employees/:id/expenses/:expenseId", h.Expense.DeleteExpenseClaim)
+		v1.POST("/employees/:id/expenses/:expenseId/submit", h.Expense.SubmitExpenseClaim)
+		v1.POST("/employees/:id/expenses/:expenseId/approve", h.Expense.ApproveExpenseClaim)
+		v1.POST("/employees/:id/expenses/:expenseId/reject", h.Expense.RejectExpenseClaim)
+		v1.POST("/employees/:id/expenses/:expenseId/reimburse", h.Expense.ReimburseExpenseClaim)
+		v1.POST("/employees/:id/expenses/:expenseId/receipts", h.Expense.UploadExpenseReceipt, documentLimit)
+		v1.GET("/employees/:id/expenses/:expenseId/receipts", h.Expense.GetExpenseReceipts)
+		v1.GET("/employees/:id/expenses/:expenseId/receipts/:receiptId", h.Expense.DownloadExpenseReceipt)
+		v1.DELETE("/employees/:id/expenses/:expenseId/receipts/:receiptId", h.Expense.DeleteExpenseReceipt)
+		v1.GET("/expenses", h.Expense.GetExpenseClaims)
+		v1.GET("/expenses/report", h.Expense.GetExpenseReport)
+
+		v1.POST("/benefit-plans", h.Benefit.CreateBenefitPlan)
+		v1.GET("/benefit-plans", h.Benefit.GetAllBenefitPlans)
+		v1.GET("/benefit-plans/:id", h.Benefit.GetBenefitPlanById)
+		v1.PUT("/benefit-plans/:id", h.Benefit.UpdateBenefitPlan)
+		v1.DELETE("/benefit-plans/:id", h.Benefit.DeleteBenefitPlan)
+		v1.POST("/benefit-enrollment-windows", h.Benefit.CreateEnrollmentWindow)
+		v1.GET("/benefit-enrollment-windows", h.Benefit.GetEnrollmentWindows)
+		v1.DELETE("/benefit-enrollment-windows/:id", h.Benefit.DeleteEnrollmentWindow)
+		v1.POST("/employees/:id/dependents", h.Benefit.CreateDependent)
+		v1.GET("/employees/:id/dependents", h.Benefit.GetDependents)
+		v1.PUT("/employees/:id/dependents/:dependentId", h.Benefit.UpdateDependent)
+		v1.DELETE("/employees/:id/dependents/:dependentId", h.Benefit.DeleteDependent)
+		v1.GET("/employees/:id/benefit-plans", h.Benefit.GetEligibleBenefitPlans)
+		v1.POST("/employees/:id/benefits", h.Benefit.EnrollInBenefit)
+		v1.GET("/employees/:id/benefits", h.Benefit.GetBenefitEnrollments)
+		v1.GET("/employees/:id/benefits/:enrollmentId", h.Benefit.GetBenefitEnrollmentById)
+		v1.DELETE("/employees/:id/benefits/:enrollmentId", h.Benefit.WithdrawBenefitEnrollment)
+		v1.POST("/employees/:id/benefits/:enrollmentId/end", h.Benefit.EndBenefitEnrollment)
+		v1.GET("/benefits/deductions", h.Benefit.GetBenefitDeductions)
+
+		v1.POST("/custom-fields", h.CustomField.CreateCustomField)
+		v1.GET("/custom-fields", h.CustomField.GetAllCustomFields)
+		v1.GET("/custom-fields/:id", h.CustomField.GetCustomFieldById)
+		v1.PUT("/custom-fields/:id", h.CustomField.UpdateCustomField)
+		v1.DELETE("/custom-fields/:id", h.CustomField.DeleteCustomField)
+
+		v1.GET("/employees/:id/tags", h.Segment.GetEmployeeTags)
+		v1.POST("/employees/:id/tags", h.Segment.AddEmployeeTags)
+		v1.DELETE("/employees/:id/tags/:tag", h.Segment.RemoveEmployeeTag)
+		v1.GET("/tags", h.Segment.GetAllTags)
+		v1.DELETE("/tags/:tag", h.Segment.DeleteTag)
+		v1.POST("/segments", h.Segment.CreateSegment)
+		v1.GET("/segments", h.Segment.GetAllSegments)
+		v1.GET("/segments/:id", h.Segment.GetSegmentById)
+		v1.PUT("/segments/:id", h.Segment.UpdateSegment)
+		v1.DELETE("/segments/:id", h.Segment.DeleteSegment)
+		v1.GET("/segments/:id/members", h.Segment.GetSegmentMembers)
+		v1.GET("/segments/:id/members.csv", h.Segment.ExportSegmentMembers)
+		v1.POST("/segments/:id/announcements", h.Segment.AnnounceToSegment)
+		v1.POST("/segments/:id/tags", h.Segment.TagSegmentMembers)
 	}
 }
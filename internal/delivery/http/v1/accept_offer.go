@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// AcceptOffer godoc
+// @Summary Accept a candidate's offer
+// @Description Hires a candidate on their offer. The employee is created with the candidate's name and contact details, the requisition's position, department, location and hiring manager, and the offered salary and start date; employees starting later are created as candidates and become active on their start date.
+// @Tags Recruiting
+// @Accept json
+// @Produce json
+// @Param id path int true "Candidate ID"
+// @Param payload body OfferAcceptanceRequest true "Offer acceptance payload"
+// @Success 200 {object} GetEmployeeByIdResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /candidates/{id}/accept-offer [post]
+func (h *RecruitingHandler) AcceptOffer(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCandidateId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req OfferAcceptanceRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"work_email": "Work email must be a string",
+			})
+	}
+
+	acceptance := entity.OfferAcceptance{
+		CandidateID:  id,
+		WorkEmail:    req.WorkEmail,
+		ManagerID:    req.ManagerID,
+		CustomFields: req.CustomFields,
+	}
+
+	employee, err := h.recruitingUsecase.AcceptOffer(c.Request().Context(), acceptance)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error accepting offer: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Offer accepted successfully", toGetEmployeeByIdResponse(employee))
+}
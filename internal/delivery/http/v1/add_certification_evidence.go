@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"io"
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// AddEvidence godoc
+// @Summary Attach evidence to a certification
+// @Description Uploads a PDF, PNG or JPEG file of up to 5 MB, such as a scan of the certificate, as the "file" field of a multipart form. The type is detected from the contents.
+// @Tags Certifications
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Certification ID"
+// @Param file formData file true "Evidence file"
+// @Success 200 {object} EvidenceResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 413 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /certifications/{id}/evidence [post]
+func (h *CertificationHandler) AddEvidence(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCertificationId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		if isBodyTooLarge(err) {
+			return apiresponse.Error(c, appError.ErrEvidenceTooLarge, nil)
+		}
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"file": "An evidence file is required",
+			})
+	}
+	if fileHeader.Size > usecase.MaxEvidenceSize {
+		return apiresponse.Error(c, appError.ErrEvidenceTooLarge, nil)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		log.Printf("Error opening uploaded evidence: %v", err)
+		return apiresponse.Error(c, err, nil)
+	}
+	defer file.Close()
+
+	// One byte over the limit is enough for the usecase to reject the file.
+	content, err := io.ReadAll(io.LimitReader(file, usecase.MaxEvidenceSize+1))
+	if err != nil {
+		log.Printf("Error reading uploaded evidence: %v", err)
+		return apiresponse.Error(c, err, nil)
+	}
+
+	evidence, err := h.certificationUsecase.AddEvidence(c.Request().Context(), id, fileHeader.Filename, content)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error adding certification evidence: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Evidence uploaded successfully", toEvidenceResponse(evidence))
+}
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// AddEmployeeTags godoc
+// @Summary Tag an employee
+// @Description Adds free-form tags to an employee and returns all their tags. Tags are trimmed and lower-cased, up to 50 characters long and without commas; tags the employee already has are skipped
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param payload body TagsRequest true "Tags payload"
+// @Success 200 {object} TagListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/tags [post]
+func (h *SegmentHandler) AddEmployeeTags(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req TagsRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"tags": "Tags must be a list of strings",
+			})
+	}
+
+	tags, err := h.segmentUsecase.AddEmployeeTags(c.Request().Context(), employeeID, req.Tags)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error adding employee tags: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Tags added successfully", tags)
+}
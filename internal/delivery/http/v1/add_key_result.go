@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// AddKeyResult godoc
+// @Summary Add a key result
+// @Description Adds a key result to an active goal, starting at its start value
+// @Tags Goals
+// @Accept json
+// @Produce json
+// @Param id path int true "Goal ID"
+// @Param payload body KeyResultRequest true "Key result payload"
+// @Success 200 {object} GoalResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /goals/{id}/key-results [post]
+func (h *GoalHandler) AddKeyResult(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidGoalId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req KeyResultRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"title":        "Title is required and must be at least 3 characters long",
+				"target_value": "Target value is required and must differ from the start value",
+			})
+	}
+
+	goal, err := h.goalUsecase.AddKeyResult(c.Request().Context(), toKeyResultEntity(id, req))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error adding key result: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Key result added successfully", toGoalResponse(goal))
+}
@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// AddPeerReviewers godoc
+// @Summary Ask for peer reviews
+// @Description Asks other employees for a peer review of an in-progress review. Employees already asked are skipped.
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Param id path int true "Review ID"
+// @Param payload body PeerReviewersRequest true "Peer reviewers payload"
+// @Success 200 {object} ReviewResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /reviews/{id}/peers [post]
+func (h *ReviewHandler) AddPeerReviewers(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidReviewId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req PeerReviewersRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"reviewer_ids": "Reviewer IDs are required",
+			})
+	}
+
+	review, err := h.reviewUsecase.AddPeerReviewers(c.Request().Context(), id, req.ReviewerIDs)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error adding peer reviewers: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Peer reviewers added successfully", toReviewResponse(review))
+}
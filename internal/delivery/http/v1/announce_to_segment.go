@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// AnnounceToSegment godoc
+// @Summary Send an announcement to a segment
+// @Description Sends an announcement to the work email of every employee currently in a segment and returns how many employees it went to. Members without a work email are skipped.
+// @Tags Segments
+// @Accept json
+// @Produce json
+// @Param id path int true "Segment ID"
+// @Param payload body AnnouncementRequest true "Announcement payload"
+// @Success 200 {object} AnnouncementSentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /segments/{id}/announcements [post]
+func (h *SegmentHandler) AnnounceToSegment(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidSegmentId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req AnnouncementRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidAnnouncement,
+			map[string]string{
+				"body": "Invalid request body",
+			})
+	}
+
+	recipients, err := h.segmentUsecase.AnnounceToSegment(c.Request().Context(), id, req.Subject, req.Body)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error sending segment announcement: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Announcement sent successfully", AnnouncementSentResponse{Recipients: recipients})
+}
@@ -0,0 +1,467 @@
+package v1
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// ApprovalStepRequest is a step of an approval workflow.
+// swagger:model ApprovalStepRequest
+type ApprovalStepRequest struct {
+	// Steps run stage by stage from the lowest; steps sharing a stage run in parallel
+	// example: 1
+	Stage int `json:"stage"`
+
+	// One of manager (of the employee the request is about), position (any holder of the
+	// position) or employee
+	// example: position
+	ApproverType string `json:"approver_type"`
+
+	// Position of position steps or employee of employee steps, empty for manager steps
+	// example: 4
+	ApproverID *int `json:"approver_id"`
+
+	// Hours the step may stay undecided before it is escalated, 0 to 720; 0 never escalates
+	// example: 48
+	TimeoutHours int `json:"timeout_hours"`
+
+	// Receives the step on timeout; without it the step goes to the manager of its assignee
+	// example: 3
+	EscalateToID *int `json:"escalate_to_id"`
+}
+
+// ApprovalWorkflowRequest is the payload for creating or updating an approval workflow.
+// swagger:model ApprovalWorkflowRequest
+type ApprovalWorkflowRequest struct {
+	// example: Salary change approval
+	Name string `json:"name"`
+
+	// One of salary_change, termination, expense or leave
+	// example: salary_change
+	RequestType string `json:"request_type"`
+
+	// 1 to 20 steps
+	Steps []ApprovalStepRequest `json:"steps"`
+}
+
+// ApprovalDecisionRequest is the payload for approving or rejecting an approval task.
+// swagger:model ApprovalDecisionRequest
+type ApprovalDecisionRequest struct {
+	// Employee deciding, who must have the task in their inbox
+	// example: 7
+	ApproverID int `json:"approver_id"`
+
+	// example: Within budget
+	Comment string `json:"comment"`
+}
+
+// ApprovalDelegationRequest is the payload for delegating approvals.
+// swagger:model ApprovalDelegationRequest
+type ApprovalDelegationRequest struct {
+	// Employee deciding in the delegator's place
+	// example: 9
+	DelegateID int `json:"delegate_id"`
+
+	// example: 2025-08-01
+	StartDate string `json:"start_date"`
+
+	// Last day of the delegation
+	// example: 2025-08-15
+	EndDate string `json:"end_date"`
+}
+
+// ApprovalStepResponse represents a step of an approval workflow.
+// swagger:model ApprovalStepResponse
+type ApprovalStepResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	Stage int `json:"stage"`
+
+	// example: position
+	ApproverType string `json:"approver_type"`
+
+	// example: 4
+	ApproverID *int `json:"approver_id,omitempty"`
+
+	// example: 48
+	TimeoutHours int `json:"timeout_hours"`
+
+	// example: 3
+	EscalateToID *int `json:"escalate_to_id,omitempty"`
+}
+
+// ApprovalWorkflowResponse represents an approval workflow with its steps.
+// swagger:model ApprovalWorkflowResponse
+type ApprovalWorkflowResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: Salary change approval
+	Name string `json:"name"`
+
+	// example: salary_change
+	RequestType string `json:"request_type"`
+
+	Steps []ApprovalStepResponse `json:"steps"`
+
+	// example: 2025-01-10 09:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-01-10 09:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ApprovalTaskResponse represents a decision an approval request waits for, or got.
+// swagger:model ApprovalTaskResponse
+type ApprovalTaskResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	RequestID int `json:"request_id"`
+
+	// example: salary_change
+	RequestType string `json:"request_type"`
+
+	// Employee the request is about
+	// example: 12
+	EmployeeID int `json:"employee_id"`
+
+	// example: Jane Doe
+	EmployeeName string `json:"employee_name"`
+
+	// example: Change the salary of Jane Doe from 5000.00 USD to 5500.00 USD effective 2025-04-01: Annual raise
+	Summary string `json:"summary"`
+
+	// example: 1
+	Stage int `json:"stage"`
+
+	// example: position
+	ApproverType string `json:"approver_type"`
+
+	// Empty for position tasks until they are escalated
+	// example: 7
+	AssigneeID *int `json:"assignee_id,omitempty"`
+
+	// example: John Smith
+	AssigneeName string `json:"assignee_name,omitempty"`
+
+	// Position whose holders may decide the task
+	// example: 4
+	PositionID *int `json:"position_id,omitempty"`
+
+	// example: HR Director
+	Position string `json:"position,omitempty"`
+
+	// One of waiting, pending, approved, rejected, skipped or cancelled
+	// example: pending
+	Status string `json:"status"`
+
+	// example: 2025-03-12 09:00:00
+	DueAt string `json:"due_at,omitempty"`
+
+	// Still undecided past its timeout
+	// example: false
+	Overdue bool `json:"overdue"`
+
+	// example: 2025-03-12 09:15:00
+	EscalatedAt string `json:"escalated_at,omitempty"`
+
+	// Approver or their delegate
+	// example: 7
+	DecidedBy *int `json:"decided_by,omitempty"`
+
+	// example: Within budget
+	Comment string `json:"comment,omitempty"`
+
+	// example: 2025-03-11 14:00:00
+	DecidedAt string `json:"decided_at,omitempty"`
+}
+
+// ApprovalRequestResponse represents a change waiting for, or decided by, approval.
+// swagger:model ApprovalRequestResponse
+type ApprovalRequestResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: salary_change
+	Type string `json:"type"`
+
+	// example: employee:12
+	Reference string `json:"reference"`
+
+	// example: 12
+	EmployeeID int `json:"employee_id"`
+
+	// example: Jane Doe
+	EmployeeName string `json:"employee_name"`
+
+	// example: 7
+	RequestedBy *int `json:"requested_by,omitempty"`
+
+	// example: Change the salary of Jane Doe from 5000.00 USD to 5500.00 USD effective 2025-04-01: Annual raise
+	Summary string `json:"summary"`
+
+	// The change, made once the request is approved
+	Payload json.RawMessage `json:"payload" swaggertype:"object"`
+
+	// One of pending, approved, rejected, cancelled or failed (approved, but the change could not be made)
+	// example: pending
+	Status string `json:"status"`
+
+	// Stage waiting for decisions, 0 once decided
+	// example: 1
+	CurrentStage int `json:"current_stage"`
+
+	// example: Termination date must not be before the hire date
+	FailureReason string `json:"failure_reason,omitempty"`
+
+	Tasks []ApprovalTaskResponse `json:"tasks"`
+
+	// example: 2025-03-10 09:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-03-11 14:00:00
+	DecidedAt string `json:"decided_at,omitempty"`
+}
+
+// ApprovalDelegationResponse represents an approval delegation.
+// swagger:model ApprovalDelegationResponse
+type ApprovalDelegationResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 7
+	DelegatorID int `json:"delegator_id"`
+
+	// example: John Smith
+	DelegatorName string `json:"delegator_name"`
+
+	// example: 9
+	DelegateID int `json:"delegate_id"`
+
+	// example: Mary Major
+	DelegateName string `json:"delegate_name"`
+
+	// example: 2025-08-01
+	StartDate string `json:"start_date"`
+
+	// example: 2025-08-15
+	EndDate string `json:"end_date"`
+
+	// In effect today
+	// example: true
+	Active bool `json:"active"`
+
+	// example: 2025-07-28 10:00:00
+	CreatedAt string `json:"created_at"`
+}
+
+// ApprovalWorkflowResponseWrapper wraps StandardResponse with ApprovalWorkflowResponse as data.
+// swagger:model ApprovalWorkflowResponseWrapper
+type ApprovalWorkflowResponseWrapper struct {
+	Success   bool                     `json:"success"`
+	Message   string                   `json:"message"`
+	Data      ApprovalWorkflowResponse `json:"data"`
+	Timestamp string                   `json:"timestamp"`
+	RequestID string                   `json:"request_id"`
+}
+
+// ApprovalWorkflowListResponseWrapper wraps StandardResponse with a list of approval workflows.
+// swagger:model ApprovalWorkflowListResponseWrapper
+type ApprovalWorkflowListResponseWrapper struct {
+	Success   bool                       `json:"success"`
+	Message   string                     `json:"message"`
+	Data      []ApprovalWorkflowResponse `json:"data"`
+	Timestamp string                     `json:"timestamp"`
+	RequestID string                     `json:"request_id"`
+}
+
+// ApprovalRequestResponseWrapper wraps StandardResponse with ApprovalRequestResponse as data.
+// swagger:model ApprovalRequestResponseWrapper
+type ApprovalRequestResponseWrapper struct {
+	Success   bool                    `json:"success"`
+	Message   string                  `json:"message"`
+	Data      ApprovalRequestResponse `json:"data"`
+	Timestamp string                  `json:"timestamp"`
+	RequestID string                  `json:"request_id"`
+}
+
+// ApprovalRequestListResponseWrapper wraps StandardResponse with a list of approval requests.
+// swagger:model ApprovalRequestListResponseWrapper
+type ApprovalRequestListResponseWrapper struct {
+	Success   bool                      `json:"success"`
+	Message   string                    `json:"message"`
+	Data      []ApprovalRequestResponse `json:"data"`
+	Timestamp string                    `json:"timestamp"`
+	RequestID string                    `json:"request_id"`
+}
+
+// ApprovalTaskListResponseWrapper wraps StandardResponse with a list of approval tasks.
+// swagger:model ApprovalTaskListResponseWrapper
+type ApprovalTaskListResponseWrapper struct {
+	Success   bool                   `json:"success"`
+	Message   string                 `json:"message"`
+	Data      []ApprovalTaskResponse `json:"data"`
+	Timestamp string                 `json:"timestamp"`
+	RequestID string                 `json:"request_id"`
+}
+
+// ApprovalDelegationResponseWrapper wraps StandardResponse with ApprovalDelegationResponse as data.
+// swagger:model ApprovalDelegationResponseWrapper
+type ApprovalDelegationResponseWrapper struct {
+	Success   bool                       `json:"success"`
+	Message   string                     `json:"message"`
+	Data      ApprovalDelegationResponse `json:"data"`
+	Timestamp string                     `json:"timestamp"`
+	RequestID string                     `json:"request_id"`
+}
+
+// ApprovalDelegationListResponseWrapper wraps StandardResponse with a list of approval delegations.
+// swagger:model ApprovalDelegationListResponseWrapper
+type ApprovalDelegationListResponseWrapper struct {
+	Success   bool                         `json:"success"`
+	Message   string                       `json:"message"`
+	Data      []ApprovalDelegationResponse `json:"data"`
+	Timestamp string                       `json:"timestamp"`
+	RequestID string                       `json:"request_id"`
+}
+
+func toApprovalWorkflowEntity(req ApprovalWorkflowRequest) *entity.ApprovalWorkflow {
+	workflow := &entity.ApprovalWorkflow{
+		Name:        req.Name,
+		RequestType: entity.ApprovalType(req.RequestType),
+		Steps:       []entity.ApprovalStep{},
+	}
+	for _, step := range req.Steps {
+		workflow.Steps = append(workflow.Steps, entity.ApprovalStep{
+			Stage:        step.Stage,
+			ApproverType: entity.ApproverType(step.ApproverType),
+			ApproverID:   step.ApproverID,
+			TimeoutHours: step.TimeoutHours,
+			EscalateToID: step.EscalateToID,
+		})
+	}
+	return workflow
+}
+
+// toApprovalDelegationEntity maps the request, returning the dates that could not be parsed.
+func toApprovalDelegationEntity(delegatorID int, req ApprovalDelegationRequest) (*entity.ApprovalDelegation, map[string]string, error) {
+	startDate, err := time.Parse(constants.DateFormat, req.StartDate)
+	if err != nil {
+		return nil, map[string]string{
+			"start_date": "Date format is invalid , expected format: YYYY-MM-DD",
+		}, appError.ErrInvalidApprovalDelegation
+	}
+	endDate, err := time.Parse(constants.DateFormat, req.EndDate)
+	if err != nil {
+		return nil, map[string]string{
+			"end_date": "Date format is invalid , expected format: YYYY-MM-DD",
+		}, appError.ErrInvalidApprovalDelegation
+	}
+	return &entity.ApprovalDelegation{
+		DelegatorID: delegatorID,
+		DelegateID:  req.DelegateID,
+		StartDate:   startDate,
+		EndDate:     endDate,
+	}, nil, nil
+}
+
+func toApprovalWorkflowResponse(workflow *entity.ApprovalWorkflow) ApprovalWorkflowResponse {
+	response := ApprovalWorkflowResponse{
+		ID:          workflow.ID,
+		Name:        workflow.Name,
+		RequestType: string(workflow.RequestType),
+		Steps:       []ApprovalStepResponse{},
+		CreatedAt:   workflow.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:   workflow.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+	for _, step := range workflow.Steps {
+		response.Steps = append(response.Steps, ApprovalStepResponse{
+			ID:           step.ID,
+			Stage:        step.Stage,
+			ApproverType: string(step.ApproverType),
+			ApproverID:   step.ApproverID,
+			TimeoutHours: step.TimeoutHours,
+			EscalateToID: step.EscalateToID,
+		})
+	}
+	return response
+}
+
+func toApprovalTaskResponse(task *entity.ApprovalTask, asOf time.Time) ApprovalTaskResponse {
+	response := ApprovalTaskResponse{
+		ID:           task.ID,
+		RequestID:    task.RequestID,
+		RequestType:  string(task.RequestType),
+		EmployeeID:   task.EmployeeID,
+		EmployeeName: task.EmployeeName,
+		Summary:      task.Summary,
+		Stage:        task.Stage,
+		ApproverType: string(task.ApproverType),
+		AssigneeID:   task.AssigneeID,
+		AssigneeName: task.AssigneeName,
+		PositionID:   task.PositionID,
+		Position:     task.Position,
+		Status:       string(task.Status),
+		Overdue:      task.IsOverdue(asOf),
+		DecidedBy:    task.DecidedBy,
+		Comment:      task.Comment,
+	}
+	if task.DueAt != nil {
+		response.DueAt = task.DueAt.Format(constants.DateTimeFormat)
+	}
+	if task.EscalatedAt != nil {
+		response.EscalatedAt = task.EscalatedAt.Format(constants.DateTimeFormat)
+	}
+	if task.DecidedAt != nil {
+		response.DecidedAt = task.DecidedAt.Format(constants.DateTimeFormat)
+	}
+	return response
+}
+
+func toApprovalRequestResponse(request *entity.ApprovalRequest) ApprovalRequestResponse {
+	now := time.Now()
+	response := ApprovalRequestResponse{
+		ID:            request.ID,
+		Type:          string(request.Type),
+		Reference:     request.Reference,
+		EmployeeID:    request.EmployeeID,
+		EmployeeName:  request.EmployeeName,
+		RequestedBy:   request.RequestedBy,
+		Summary:       request.Summary,
+		Payload:       request.Payload,
+		Status:        string(request.Status),
+		CurrentStage:  request.CurrentStage,
+		FailureReason: request.FailureReason,
+		Tasks:         []ApprovalTaskResponse{},
+		CreatedAt:     request.CreatedAt.Format(constants.DateTimeFormat),
+	}
+	for _, task := range request.Tasks {
+		response.Tasks = append(response.Tasks, toApprovalTaskResponse(&task, now))
+	}
+	if request.DecidedAt != nil {
+		response.DecidedAt = request.DecidedAt.Format(constants.DateTimeFormat)
+	}
+	return response
+}
+
+func toApprovalDelegationResponse(delegation *entity.ApprovalDelegation) ApprovalDelegationResponse {
+	return ApprovalDelegationResponse{
+		ID:            delegation.ID,
+		DelegatorID:   delegation.DelegatorID,
+		DelegatorName: delegation.DelegatorName,
+		DelegateID:    delegation.DelegateID,
+		DelegateName:  delegation.DelegateName,
+		StartDate:     delegation.StartDate.Format(constants.DateFormat),
+		EndDate:       delegation.EndDate.Format(constants.DateFormat),
+		Active:        delegation.IsActive(time.Now().UTC()),
+		CreatedAt:     delegation.CreatedAt.Format(constants.DateTimeFormat),
+	}
+}
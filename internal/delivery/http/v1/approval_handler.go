@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type ApprovalHandler struct {
+	approvalUsecase usecase.ApprovalUsecase
+}
+
+func NewApprovalHandler(approvalUsecase usecase.ApprovalUsecase) *ApprovalHandler {
+	return &ApprovalHandler{approvalUsecase: approvalUsecase}
+}
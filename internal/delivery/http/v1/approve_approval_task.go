@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// ApproveTask godoc
+// @Summary Approve an approval task
+// @Description Approves a task in the approver's inbox. The last approval of a stage starts the next one; the last approval of the request makes the change, and a change that cannot be made leaves the request failed.
+// @Tags Approvals
+// @Accept json
+// @Produce json
+// @Param id path int true "Approval task ID"
+// @Param payload body ApprovalDecisionRequest true "Decision payload"
+// @Success 200 {object} ApprovalRequestResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 403 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /approvals/tasks/{id}/approve [post]
+func (h *ApprovalHandler) ApproveTask(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidApprovalTaskId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req ApprovalDecisionRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"approver_id": "Approver ID is required",
+			})
+	}
+
+	request, err := h.approvalUsecase.DecideTask(c.Request().Context(), entity.ApprovalDecision{
+		TaskID:     id,
+		ApproverID: req.ApproverID,
+		Approve:    true,
+		Comment:    req.Comment,
+	})
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error approving approval task: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Approval task approved successfully", toApprovalRequestResponse(request))
+}
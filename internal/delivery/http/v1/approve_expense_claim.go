@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// ApproveExpenseClaim godoc
+// @Summary Approve an expense claim
+// @Description Approves a submitted claim, which payroll can then reimburse. Only the employee's manager may decide when one is set, and claims with an approval request are decided through it
+// @Tags Expenses
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param expenseId path int true "Expense claim ID"
+// @Param payload body ExpenseDecisionRequest true "Decision payload"
+// @Success 200 {object} ExpenseClaimResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 403 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/expenses/{expenseId}/approve [post]
+func (h *ExpenseHandler) ApproveExpenseClaim(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	claimID, err := parseIDParam(c, "expenseId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidExpenseClaimId,
+			map[string]string{
+				"expenseId": "Expense claim ID must be a valid number",
+			})
+	}
+
+	var req ExpenseDecisionRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"approver_id": "Approver ID is required",
+			})
+	}
+
+	claim, err := h.expenseUsecase.ApproveClaim(c.Request().Context(), employeeID, claimID, req.ApproverID, req.Comment)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error approving expense claim: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Expense claim approved successfully", toExpenseClaimResponse(claim))
+}
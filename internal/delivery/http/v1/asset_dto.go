@@ -0,0 +1,236 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// AssetRequest is the payload for creating or updating an asset.
+// swagger:model AssetRequest
+type AssetRequest struct {
+	// example: MacBook Pro 14
+	Name string `json:"name"`
+
+	// One of laptop, desktop, monitor, phone, tablet, badge, key, other
+	// example: laptop
+	Type string `json:"type"`
+
+	// example: C02XK1JHJG5J
+	SerialNumber string `json:"serial_number"`
+
+	// example: 2024-11-15
+	PurchaseDate string `json:"purchase_date"`
+
+	// One of available, in_repair, lost, retired; defaults to available for new assets and is
+	// left unchanged otherwise. Assets are assigned and returned through their own endpoints.
+	// example: available
+	Status string `json:"status"`
+
+	// example: 16 GB RAM, 512 GB SSD
+	Notes string `json:"notes"`
+}
+
+// AssetResponse represents an asset.
+// swagger:model AssetResponse
+type AssetResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: MacBook Pro 14
+	Name string `json:"name"`
+
+	// example: laptop
+	Type string `json:"type"`
+
+	// example: C02XK1JHJG5J
+	SerialNumber string `json:"serial_number"`
+
+	// example: 2024-11-15
+	PurchaseDate string `json:"purchase_date,omitempty"`
+
+	// One of available, assigned, in_repair, lost, retired
+	// example: assigned
+	Status string `json:"status"`
+
+	// example: 16 GB RAM, 512 GB SSD
+	Notes string `json:"notes,omitempty"`
+
+	// Employee holding the asset
+	// example: 1
+	HolderID *int `json:"holder_id,omitempty"`
+
+	// example: John Doe
+	HolderName string `json:"holder_name,omitempty"`
+
+	// example: 2024-11-20 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2024-11-20 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// AssetAssignmentRequest is the payload for handing an asset to an employee.
+// swagger:model AssetAssignmentRequest
+type AssetAssignmentRequest struct {
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// Defaults to today
+	// example: 2025-01-06
+	AssignedOn string `json:"assigned_on"`
+
+	// example: Handed over with charger
+	Notes string `json:"notes"`
+}
+
+// AssetReturnRequest is the payload for taking an asset back.
+// swagger:model AssetReturnRequest
+type AssetReturnRequest struct {
+	// Defaults to today
+	// example: 2025-06-30
+	ReturnedOn string `json:"returned_on"`
+
+	// Status the asset is left in: available (default), in_repair, lost or retired
+	// example: available
+	Status string `json:"status"`
+
+	// example: Screen scratched
+	Notes string `json:"notes"`
+}
+
+// AssetAssignmentResponse is an employee holding an asset.
+// swagger:model AssetAssignmentResponse
+type AssetAssignmentResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	AssetID int `json:"asset_id"`
+
+	// example: MacBook Pro 14
+	AssetName string `json:"asset_name"`
+
+	// example: laptop
+	AssetType string `json:"asset_type"`
+
+	// example: C02XK1JHJG5J
+	SerialNumber string `json:"serial_number"`
+
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: John Doe
+	EmployeeName string `json:"employee_name"`
+
+	// example: 2025-01-06
+	AssignedOn string `json:"assigned_on"`
+
+	// Empty while the employee still holds the asset
+	// example: 2025-06-30
+	ReturnedOn string `json:"returned_on,omitempty"`
+
+	// example: Handed over with charger
+	Notes string `json:"notes,omitempty"`
+
+	// example: Screen scratched
+	ReturnNotes string `json:"return_notes,omitempty"`
+
+	// example: 2025-01-06 09:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-06-30 17:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// AssetResponseWrapper wraps StandardResponse with AssetResponse as data.
+// swagger:model AssetResponseWrapper
+type AssetResponseWrapper struct {
+	Success   bool          `json:"success"`
+	Message   string        `json:"message"`
+	Data      AssetResponse `json:"data"`
+	Timestamp string        `json:"timestamp"`
+	RequestID string        `json:"request_id"`
+}
+
+// AssetListResponseWrapper wraps StandardResponse with a list of assets.
+// swagger:model AssetListResponseWrapper
+type AssetListResponseWrapper struct {
+	Success   bool            `json:"success"`
+	Message   string          `json:"message"`
+	Data      []AssetResponse `json:"data"`
+	Timestamp string          `json:"timestamp"`
+	RequestID string          `json:"request_id"`
+}
+
+// AssetAssignmentResponseWrapper wraps StandardResponse with AssetAssignmentResponse as data.
+// swagger:model AssetAssignmentResponseWrapper
+type AssetAssignmentResponseWrapper struct {
+	Success   bool                    `json:"success"`
+	Message   string                  `json:"message"`
+	Data      AssetAssignmentResponse `json:"data"`
+	Timestamp string                  `json:"timestamp"`
+	RequestID string                  `json:"request_id"`
+}
+
+// AssetAssignmentListResponseWrapper wraps StandardResponse with a list of asset assignments.
+// swagger:model AssetAssignmentListResponseWrapper
+type AssetAssignmentListResponseWrapper struct {
+	Success   bool                      `json:"success"`
+	Message   string                    `json:"message"`
+	Data      []AssetAssignmentResponse `json:"data"`
+	Timestamp string                    `json:"timestamp"`
+	RequestID string                    `json:"request_id"`
+}
+
+func toAssetEntity(req AssetRequest) (*entity.Asset, map[string]string, error) {
+	purchaseDate, err := parseOptionalDate(req.PurchaseDate)
+	if err != nil {
+		return nil, map[string]string{
+			"purchase_date": "Date format is invalid , expected format: YYYY-MM-DD",
+		}, appError.ErrInvalidAsset
+	}
+
+	return &entity.Asset{
+		Name:         req.Name,
+		Type:         entity.AssetType(req.Type),
+		SerialNumber: req.SerialNumber,
+		PurchaseDate: purchaseDate,
+		Status:       entity.AssetStatus(req.Status),
+		Notes:        req.Notes,
+	}, nil, nil
+}
+
+func toAssetResponse(asset *entity.Asset) AssetResponse {
+	return AssetResponse{
+		ID:           asset.ID,
+		Name:         asset.Name,
+		Type:         string(asset.Type),
+		SerialNumber: asset.SerialNumber,
+		PurchaseDate: formatOptionalDate(asset.PurchaseDate),
+		Status:       string(asset.Status),
+		Notes:        asset.Notes,
+		HolderID:     asset.HolderID,
+		HolderName:   asset.HolderName,
+		CreatedAt:    asset.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:    asset.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toAssetAssignmentResponse(assignment *entity.AssetAssignment) AssetAssignmentResponse {
+	return AssetAssignmentResponse{
+		ID:           assignment.ID,
+		AssetID:      assignment.AssetID,
+		AssetName:    assignment.AssetName,
+		AssetType:    string(assignment.AssetType),
+		SerialNumber: assignment.SerialNumber,
+		EmployeeID:   assignment.EmployeeID,
+		EmployeeName: assignment.EmployeeName,
+		AssignedOn:   assignment.AssignedOn.Format(constants.DateFormat),
+		ReturnedOn:   formatOptionalDate(assignment.ReturnedOn),
+		Notes:        assignment.Notes,
+		ReturnNotes:  assignment.ReturnNotes,
+		CreatedAt:    assignment.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:    assignment.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
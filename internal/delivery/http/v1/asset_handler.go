@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type AssetHandler struct {
+	assetUsecase usecase.AssetUsecase
+}
+
+func NewAssetHandler(assetUsecase usecase.AssetUsecase) *AssetHandler {
+	return &AssetHandler{assetUsecase: assetUsecase}
+}
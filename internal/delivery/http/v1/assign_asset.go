@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// AssignAsset godoc
+// @Summary Assign an asset to an employee
+// @Description Hands an available asset to an employee, today unless a date is given. An asset is held by one employee at a time.
+// @Tags Assets
+// @Accept json
+// @Produce json
+// @Param id path int true "Asset ID"
+// @Param payload body AssetAssignmentRequest true "Assignment payload"
+// @Success 200 {object} AssetAssignmentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /assets/{id}/assign [post]
+func (h *AssetHandler) AssignAsset(c echo.Context) error {
+	assetID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidAssetId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req AssetAssignmentRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"employee_id": "Employee ID is required",
+			})
+	}
+	assignedOn, err := parseOptionalDate(req.AssignedOn)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidAssetAssignment,
+			map[string]string{
+				"assigned_on": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	assignment := &entity.AssetAssignment{
+		AssetID:    assetID,
+		EmployeeID: req.EmployeeID,
+		Notes:      req.Notes,
+	}
+	if assignedOn != nil {
+		assignment.AssignedOn = *assignedOn
+	}
+
+	createdAssignment, err := h.assetUsecase.AssignAsset(c.Request().Context(), assignment)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error assigning asset: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Asset assigned successfully", toAssetAssignmentResponse(createdAssignment))
+}
@@ -0,0 +1,58 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// AssignTask godoc
+// @Summary Assign a checklist task
+// @Description Changes the owner and due date of an open task. Leaving the owner empty unassigns it.
+// @Tags Checklists
+// @Accept json
+// @Produce json
+// @Param id path int true "Checklist task ID"
+// @Param payload body AssignChecklistTaskRequest true "Owner and due date"
+// @Success 200 {object} ChecklistTaskResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /checklist-tasks/{id} [put]
+func (h *ChecklistHandler) AssignTask(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidChecklistTaskId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req AssignChecklistTaskRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"due_on": "Due date is required, expected format: YYYY-MM-DD",
+			})
+	}
+
+	task, details, err := toChecklistTaskEntity(id, req)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+
+	updatedTask, err := h.checklistUsecase.AssignTask(c.Request().Context(), task)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error assigning checklist task: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Checklist task assigned successfully", toChecklistTaskResponse(updatedTask, time.Now()))
+}
@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// AssignEmployeeLocation godoc
+// @Summary Move an employee to another location
+// @Description Changes an employee's primary location. Assignments effective today or earlier at the new location are applied immediately; future-dated ones are applied by a background job on the effective date.
+// @Tags Locations
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param payload body LocationAssignmentRequest true "Location assignment payload"
+// @Success 200 {object} LocationAssignmentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/locations [post]
+func (h *LocationHandler) AssignEmployeeLocation(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req LocationAssignmentRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"location_id":    "Location ID is required",
+				"effective_date": "Effective date is required and must be a valid date",
+			})
+	}
+
+	effectiveDate, err := time.Parse(constants.DateFormat, req.EffectiveDate)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEffectiveDate,
+			map[string]string{
+				"effective_date": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	assignment, err := h.locationUsecase.AssignEmployeeLocation(c.Request().Context(), &entity.LocationAssignment{
+		EmployeeID:    employeeID,
+		LocationID:    req.LocationID,
+		EffectiveDate: effectiveDate,
+	})
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error assigning employee location: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	message := "Location assignment applied successfully"
+	if assignment.Status == entity.LocationAssignmentScheduled {
+		message = "Location assignment scheduled successfully"
+	}
+	return apiresponse.Success(c, message, toLocationAssignmentResponse(assignment))
+}
@@ -0,0 +1,293 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// PunchRequest is the payload for clocking in or out. Both fields are optional.
+// swagger:model PunchRequest
+type PunchRequest struct {
+	// Punch time in UTC, defaults to now
+	// example: 2025-03-03 09:00:00
+	Time string `json:"time"`
+
+	// Only used when clocking in
+	// example: On site at client
+	Note string `json:"note"`
+}
+
+// AttendanceEntryRequest is the payload for correcting a shift.
+// swagger:model AttendanceEntryRequest
+type AttendanceEntryRequest struct {
+	// example: 2025-03-03 09:00:00
+	ClockIn string `json:"clock_in"`
+
+	// Omit to leave the shift open
+	// example: 2025-03-03 17:30:00
+	ClockOut string `json:"clock_out"`
+
+	// example: Forgot to clock out
+	Note string `json:"note"`
+}
+
+// AttendanceEntryResponse represents a shift.
+// swagger:model AttendanceEntryResponse
+type AttendanceEntryResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: 2025-03-03 09:00:00
+	ClockIn string `json:"clock_in"`
+
+	// example: 2025-03-03 17:30:00
+	ClockOut string `json:"clock_out,omitempty"`
+
+	// example: 510
+	WorkedMinutes int `json:"worked_minutes"`
+
+	// example: On site at client
+	Note string `json:"note,omitempty"`
+
+	// example: 2025-03-03 09:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-03-03 17:30:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// TimesheetDayResponse is one day of a weekly timesheet.
+// swagger:model TimesheetDayResponse
+type TimesheetDayResponse struct {
+	// example: 2025-03-03
+	Date string `json:"date"`
+
+	// Weekend or public holiday
+	// example: false
+	RestDay bool `json:"rest_day"`
+
+	// example: false
+	OnLeave bool `json:"on_leave"`
+
+	// example: 510
+	WorkedMinutes int `json:"worked_minutes"`
+
+	// example: 480
+	RegularMinutes int `json:"regular_minutes"`
+
+	// example: 30
+	OvertimeMinutes int `json:"overtime_minutes"`
+}
+
+// TimesheetIssueResponse is a missing punch found in a timesheet.
+// swagger:model TimesheetIssueResponse
+type TimesheetIssueResponse struct {
+	// example: 2025-03-04
+	Date string `json:"date"`
+
+	// Either missing_clock_out or no_punches
+	// example: no_punches
+	Kind string `json:"kind"`
+
+	// The open shift, for missing clock-outs
+	// example: 12
+	EntryID int `json:"entry_id,omitempty"`
+}
+
+// TimesheetResponse represents a submitted timesheet.
+// swagger:model TimesheetResponse
+type TimesheetResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: 2025-03-03
+	WeekStart string `json:"week_start"`
+
+	// One of submitted, approved or rejected
+	// example: submitted
+	Status string `json:"status"`
+
+	// example: 2430
+	TotalMinutes int `json:"total_minutes"`
+
+	// example: 2400
+	RegularMinutes int `json:"regular_minutes"`
+
+	// example: 30
+	OvertimeMinutes int `json:"overtime_minutes"`
+
+	// example: 2025-03-08 18:00:00
+	SubmittedAt string `json:"submitted_at"`
+
+	// example: 7
+	DecidedBy *int `json:"decided_by,omitempty"`
+
+	// example: 2025-03-10 09:00:00
+	DecidedAt string `json:"decided_at,omitempty"`
+
+	// example: Looks good
+	DecisionComment string `json:"decision_comment,omitempty"`
+}
+
+// WeeklyTimesheetResponse is a week of attendance computed from the punches.
+// swagger:model WeeklyTimesheetResponse
+type WeeklyTimesheetResponse struct {
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: 2025-03-03
+	WeekStart string `json:"week_start"`
+
+	Days []TimesheetDayResponse `json:"days"`
+
+	// example: 2430
+	TotalMinutes int `json:"total_minutes"`
+
+	// example: 2400
+	RegularMinutes int `json:"regular_minutes"`
+
+	// example: 30
+	OvertimeMinutes int `json:"overtime_minutes"`
+
+	Issues []TimesheetIssueResponse `json:"issues"`
+
+	// Present once the week has been submitted
+	Submission *TimesheetResponse `json:"submission,omitempty"`
+}
+
+// TimesheetDecisionRequest is the payload for approving or rejecting a timesheet.
+// swagger:model TimesheetDecisionRequest
+type TimesheetDecisionRequest struct {
+	// ID of the deciding employee; must be the employee's manager when one is set
+	// example: 7
+	ApproverID int `json:"approver_id"`
+
+	// example: Please add Tuesday's hours
+	Comment string `json:"comment"`
+}
+
+// AttendanceEntryResponseWrapper wraps StandardResponse with AttendanceEntryResponse as data.
+// swagger:model AttendanceEntryResponseWrapper
+type AttendanceEntryResponseWrapper struct {
+	Success   bool                    `json:"success"`
+	Message   string                  `json:"message"`
+	Data      AttendanceEntryResponse `json:"data"`
+	Timestamp string                  `json:"timestamp"`
+	RequestID string                  `json:"request_id"`
+}
+
+// AttendanceEntryListResponseWrapper wraps StandardResponse with a list of shifts.
+// swagger:model AttendanceEntryListResponseWrapper
+type AttendanceEntryListResponseWrapper struct {
+	Success   bool                      `json:"success"`
+	Message   string                    `json:"message"`
+	Data      []AttendanceEntryResponse `json:"data"`
+	Timestamp string                    `json:"timestamp"`
+	RequestID string                    `json:"request_id"`
+}
+
+// TimesheetResponseWrapper wraps StandardResponse with TimesheetResponse as data.
+// swagger:model TimesheetResponseWrapper
+type TimesheetResponseWrapper struct {
+	Success   bool              `json:"success"`
+	Message   string            `json:"message"`
+	Data      TimesheetResponse `json:"data"`
+	Timestamp string            `json:"timestamp"`
+	RequestID string            `json:"request_id"`
+}
+
+// TimesheetListResponseWrapper wraps StandardResponse with a list of submitted timesheets.
+// swagger:model TimesheetListResponseWrapper
+type TimesheetListResponseWrapper struct {
+	Success   bool                `json:"success"`
+	Message   string              `json:"message"`
+	Data      []TimesheetResponse `json:"data"`
+	Timestamp string              `json:"timestamp"`
+	RequestID string              `json:"request_id"`
+}
+
+// WeeklyTimesheetResponseWrapper wraps StandardResponse with WeeklyTimesheetResponse as data.
+// swagger:model WeeklyTimesheetResponseWrapper
+type WeeklyTimesheetResponseWrapper struct {
+	Success   bool                    `json:"success"`
+	Message   string                  `json:"message"`
+	Data      WeeklyTimesheetResponse `json:"data"`
+	Timestamp string                  `json:"timestamp"`
+	RequestID string                  `json:"request_id"`
+}
+
+func toAttendanceEntryResponse(entry *entity.AttendanceEntry) AttendanceEntryResponse {
+	response := AttendanceEntryResponse{
+		ID:            entry.ID,
+		EmployeeID:    entry.EmployeeID,
+		ClockIn:       entry.ClockIn.Format(constants.DateTimeFormat),
+		WorkedMinutes: entry.WorkedMinutes(),
+		Note:          entry.Note,
+		CreatedAt:     entry.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:     entry.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+	if entry.ClockOut != nil {
+		response.ClockOut = entry.ClockOut.Format(constants.DateTimeFormat)
+	}
+	return response
+}
+
+func toTimesheetResponse(timesheet *entity.Timesheet) TimesheetResponse {
+	response := TimesheetResponse{
+		ID:              timesheet.ID,
+		EmployeeID:      timesheet.EmployeeID,
+		WeekStart:       timesheet.WeekStart.Format(constants.DateFormat),
+		Status:          string(timesheet.Status),
+		TotalMinutes:    timesheet.TotalMinutes,
+		RegularMinutes:  timesheet.RegularMinutes,
+		OvertimeMinutes: timesheet.OvertimeMinutes,
+		SubmittedAt:     timesheet.SubmittedAt.Format(constants.DateTimeFormat),
+		DecidedBy:       timesheet.DecidedBy,
+		DecisionComment: timesheet.DecisionComment,
+	}
+	if timesheet.DecidedAt != nil {
+		response.DecidedAt = timesheet.DecidedAt.Format(constants.DateTimeFormat)
+	}
+	return response
+}
+
+func toWeeklyTimesheetResponse(timesheet *usecase.WeeklyTimesheet) WeeklyTimesheetResponse {
+	response := WeeklyTimesheetResponse{
+		EmployeeID:      timesheet.EmployeeID,
+		WeekStart:       timesheet.WeekStart.Format(constants.DateFormat),
+		Days:            []TimesheetDayResponse{},
+		TotalMinutes:    timesheet.TotalMinutes,
+		RegularMinutes:  timesheet.RegularMinutes,
+		OvertimeMinutes: timesheet.OvertimeMinutes,
+		Issues:          []TimesheetIssueResponse{},
+	}
+	for _, day := range timesheet.Days {
+		response.Days = append(response.Days, TimesheetDayResponse{
+			Date:            day.Date.Format(constants.DateFormat),
+			RestDay:         day.RestDay,
+			OnLeave:         day.OnLeave,
+			WorkedMinutes:   day.WorkedMinutes,
+			RegularMinutes:  day.RegularMinutes,
+			OvertimeMinutes: day.OvertimeMinutes,
+		})
+	}
+	for _, issue := range timesheet.Issues {
+		response.Issues = append(response.Issues, TimesheetIssueResponse{
+			Date:    issue.Date.Format(constants.DateFormat),
+			Kind:    string(issue.Kind),
+			EntryID: issue.EntryID,
+		})
+	}
+	if timesheet.Submission != nil {
+		submission := toTimesheetResponse(timesheet.Submission)
+		response.Submission = &submission
+	}
+	return response
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type AttendanceHandler struct {
+	attendanceUsecase usecase.AttendanceUsecase
+}
+
+func NewAttendanceHandler(attendanceUsecase usecase.AttendanceUsecase) *AttendanceHandler {
+	return &AttendanceHandler{attendanceUsecase: attendanceUsecase}
+}
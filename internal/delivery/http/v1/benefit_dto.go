@@ -0,0 +1,480 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// BenefitPlanRequest is the payload for creating or updating a benefit plan. Contributions are
+// monthly amounts in minor units of the plan's currency.
+// swagger:model BenefitPlanRequest
+type BenefitPlanRequest struct {
+	// example: Family health insurance
+	Name string `json:"name"`
+
+	// One of health, dental, vision, life, disability, pension, other
+	// example: health
+	Type string `json:"type"`
+
+	// example: Covers hospital stays and outpatient care
+	Description string `json:"description"`
+
+	// example: USD
+	Currency string `json:"currency"`
+
+	// Deducted from the employee's pay every month
+	// example: 12000
+	EmployeeContribution int64 `json:"employee_contribution"`
+
+	// Paid by the company every month
+	// example: 36000
+	EmployerContribution int64 `json:"employer_contribution"`
+
+	// example: true
+	CoversDependents bool `json:"covers_dependents"`
+
+	// Deducted every month for each covered dependent
+	// example: 5000
+	DependentContribution int64 `json:"dependent_contribution"`
+
+	// Employment statuses that may enroll; defaults to active
+	// example: ["active","on_leave"]
+	EligibleStatuses []string `json:"eligible_statuses"`
+
+	// Months employees must have been employed for when coverage starts
+	// example: 3
+	MinTenureMonths int `json:"min_tenure_months"`
+
+	// Locations the plan is limited to; empty for every location
+	// example: [1,2]
+	LocationIDs []int `json:"location_ids"`
+}
+
+// BenefitPlanResponse represents a benefit plan.
+// swagger:model BenefitPlanResponse
+type BenefitPlanResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: Family health insurance
+	Name string `json:"name"`
+
+	// example: health
+	Type string `json:"type"`
+
+	// example: Covers hospital stays and outpatient care
+	Description string `json:"description,omitempty"`
+
+	EmployeeContribution MoneyDTO `json:"employee_contribution"`
+
+	EmployerContribution MoneyDTO `json:"employer_contribution"`
+
+	// example: true
+	CoversDependents bool `json:"covers_dependents"`
+
+	DependentContribution *MoneyDTO `json:"dependent_contribution,omitempty"`
+
+	// example: ["active","on_leave"]
+	EligibleStatuses []string `json:"eligible_statuses"`
+
+	// example: 3
+	MinTenureMonths int `json:"min_tenure_months"`
+
+	// example: [1,2]
+	LocationIDs []int `json:"location_ids,omitempty"`
+
+	// example: 2025-01-06 09:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-01-06 09:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// EnrollmentWindowRequest is the payload for opening an enrollment window.
+// swagger:model EnrollmentWindowRequest
+type EnrollmentWindowRequest struct {
+	// example: Open enrollment 2026
+	Name string `json:"name"`
+
+	// example: 2025-11-01
+	StartsOn string `json:"starts_on"`
+
+	// example: 2025-11-30
+	EndsOn string `json:"ends_on"`
+
+	// example: 2026-01-01
+	CoverageStartsOn string `json:"coverage_starts_on"`
+}
+
+// EnrollmentWindowResponse represents an enrollment window.
+// swagger:model EnrollmentWindowResponse
+type EnrollmentWindowResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: Open enrollment 2026
+	Name string `json:"name"`
+
+	// example: 2025-11-01
+	StartsOn string `json:"starts_on"`
+
+	// example: 2025-11-30
+	EndsOn string `json:"ends_on"`
+
+	// example: 2026-01-01
+	CoverageStartsOn string `json:"coverage_starts_on"`
+
+	// example: 2025-10-01 09:00:00
+	CreatedAt string `json:"created_at"`
+}
+
+// DependentRequest is the payload for adding or updating a dependent.
+// swagger:model DependentRequest
+type DependentRequest struct {
+	// example: Jane Doe
+	Name string `json:"name"`
+
+	// One of spouse, partner, child, other
+	// example: spouse
+	Relationship string `json:"relationship"`
+
+	// example: 1990-04-12
+	DateOfBirth string `json:"date_of_birth"`
+}
+
+// DependentResponse represents a dependent of an employee.
+// swagger:model DependentResponse
+type DependentResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: Jane Doe
+	Name string `json:"name"`
+
+	// example: spouse
+	Relationship string `json:"relationship"`
+
+	// example: 1990-04-12
+	DateOfBirth string `json:"date_of_birth,omitempty"`
+
+	// example: 2025-01-06 09:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-01-06 09:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// BenefitEnrollmentRequest is the payload for enrolling an employee in a benefit plan.
+// swagger:model BenefitEnrollmentRequest
+type BenefitEnrollmentRequest struct {
+	// example: 1
+	PlanID int `json:"plan_id"`
+
+	// Dependents of the employee to cover, for plans covering dependents
+	// example: [1,2]
+	DependentIDs []int `json:"dependent_ids"`
+}
+
+// BenefitEnrollmentResponse is an employee's coverage by a benefit plan.
+// swagger:model BenefitEnrollmentResponse
+type BenefitEnrollmentResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: John Doe
+	EmployeeName string `json:"employee_name"`
+
+	Plan BenefitPlanResponse `json:"plan"`
+
+	// Enrollment window the employee enrolled in; empty for new hires
+	// example: 1
+	WindowID *int `json:"window_id,omitempty"`
+
+	// example: 2026-01-01
+	CoverageStartsOn string `json:"coverage_starts_on"`
+
+	// Last day of coverage; empty while the enrollment is open
+	// example: 2026-06-30
+	CoverageEndsOn string `json:"coverage_ends_on,omitempty"`
+
+	// example: [1,2]
+	DependentIDs []int `json:"dependent_ids"`
+
+	// example: 2025-11-12 09:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-11-12 09:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// BenefitDeductionResponse is what payroll deducts from an employee's pay in a month for one
+// enrollment.
+// swagger:model BenefitDeductionResponse
+type BenefitDeductionResponse struct {
+	// example: 1
+	EnrollmentID int `json:"enrollment_id"`
+
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: John Doe
+	EmployeeName string `json:"employee_name"`
+
+	// example: 1
+	PlanID int `json:"plan_id"`
+
+	// example: Family health insurance
+	PlanName string `json:"plan_name"`
+
+	// example: health
+	PlanType string `json:"plan_type"`
+
+	// example: 2
+	DependentCount int `json:"dependent_count"`
+
+	// Deducted from the employee's pay, dependents included
+	EmployeeContribution MoneyDTO `json:"employee_contribution"`
+
+	EmployerContribution MoneyDTO `json:"employer_contribution"`
+}
+
+// BenefitPlanResponseWrapper wraps StandardResponse with BenefitPlanResponse as data.
+// swagger:model BenefitPlanResponseWrapper
+type BenefitPlanResponseWrapper struct {
+	Success   bool                `json:"success"`
+	Message   string              `json:"message"`
+	Data      BenefitPlanResponse `json:"data"`
+	Timestamp string              `json:"timestamp"`
+	RequestID string              `json:"request_id"`
+}
+
+// BenefitPlanListResponseWrapper wraps StandardResponse with a list of benefit plans.
+// swagger:model BenefitPlanListResponseWrapper
+type BenefitPlanListResponseWrapper struct {
+	Success   bool                  `json:"success"`
+	Message   string                `json:"message"`
+	Data      []BenefitPlanResponse `json:"data"`
+	Timestamp string                `json:"timestamp"`
+	RequestID string                `json:"request_id"`
+}
+
+// EnrollmentWindowResponseWrapper wraps StandardResponse with EnrollmentWindowResponse as data.
+// swagger:model EnrollmentWindowResponseWrapper
+type EnrollmentWindowResponseWrapper struct {
+	Success   bool                     `json:"success"`
+	Message   string                   `json:"message"`
+	Data      EnrollmentWindowResponse `json:"data"`
+	Timestamp string                   `json:"timestamp"`
+	RequestID string                   `json:"request_id"`
+}
+
+// EnrollmentWindowListResponseWrapper wraps StandardResponse with a list of enrollment windows.
+// swagger:model EnrollmentWindowListResponseWrapper
+type EnrollmentWindowListResponseWrapper struct {
+	Success   bool                       `json:"success"`
+	Message   string                     `json:"message"`
+	Data      []EnrollmentWindowResponse `json:"data"`
+	Timestamp string                     `json:"timestamp"`
+	RequestID string                     `json:"request_id"`
+}
+
+// DependentResponseWrapper wraps StandardResponse with DependentResponse as data.
+// swagger:model DependentResponseWrapper
+type DependentResponseWrapper struct {
+	Success   bool              `json:"success"`
+	Message   string            `json:"message"`
+	Data      DependentResponse `json:"data"`
+	Timestamp string            `json:"timestamp"`
+	RequestID string            `json:"request_id"`
+}
+
+// DependentListResponseWrapper wraps StandardResponse with a list of dependents.
+// swagger:model DependentListResponseWrapper
+type DependentListResponseWrapper struct {
+	Success   bool                `json:"success"`
+	Message   string              `json:"message"`
+	Data      []DependentResponse `json:"data"`
+	Timestamp string              `json:"timestamp"`
+	RequestID string              `json:"request_id"`
+}
+
+// BenefitEnrollmentResponseWrapper wraps StandardResponse with BenefitEnrollmentResponse as data.
+// swagger:model BenefitEnrollmentResponseWrapper
+type BenefitEnrollmentResponseWrapper struct {
+	Success   bool                      `json:"success"`
+	Message   string                    `json:"message"`
+	Data      BenefitEnrollmentResponse `json:"data"`
+	Timestamp string                    `json:"timestamp"`
+	RequestID string                    `json:"request_id"`
+}
+
+// BenefitEnrollmentListResponseWrapper wraps StandardResponse with a list of benefit enrollments.
+// swagger:model BenefitEnrollmentListResponseWrapper
+type BenefitEnrollmentListResponseWrapper struct {
+	Success   bool                        `json:"success"`
+	Message   string                      `json:"message"`
+	Data      []BenefitEnrollmentResponse `json:"data"`
+	Timestamp string                      `json:"timestamp"`
+	RequestID string                      `json:"request_id"`
+}
+
+// BenefitDeductionListResponseWrapper wraps StandardResponse with a list of benefit deductions.
+// swagger:model BenefitDeductionListResponseWrapper
+type BenefitDeductionListResponseWrapper struct {
+	Success   bool                       `json:"success"`
+	Message   string                     `json:"message"`
+	Data      []BenefitDeductionResponse `json:"data"`
+	Timestamp string                     `json:"timestamp"`
+	RequestID string                     `json:"request_id"`
+}
+
+func toBenefitPlanEntity(req BenefitPlanRequest) *entity.BenefitPlan {
+	statuses := []entity.EmploymentStatus{}
+	for _, status := range req.EligibleStatuses {
+		statuses = append(statuses, entity.EmploymentStatus(status))
+	}
+	return &entity.BenefitPlan{
+		Name:                  req.Name,
+		Type:                  entity.BenefitPlanType(req.Type),
+		Description:           req.Description,
+		Currency:              req.Currency,
+		EmployeeContribution:  req.EmployeeContribution,
+		EmployerContribution:  req.EmployerContribution,
+		CoversDependents:      req.CoversDependents,
+		DependentContribution: req.DependentContribution,
+		Eligibility: entity.BenefitEligibility{
+			Statuses:        statuses,
+			MinTenureMonths: req.MinTenureMonths,
+			LocationIDs:     req.LocationIDs,
+		},
+	}
+}
+
+func toBenefitPlanResponse(plan *entity.BenefitPlan) BenefitPlanResponse {
+	statuses := []string{}
+	for _, status := range plan.Eligibility.Statuses {
+		statuses = append(statuses, string(status))
+	}
+	response := BenefitPlanResponse{
+		ID:                   plan.ID,
+		Name:                 plan.Name,
+		Type:                 string(plan.Type),
+		Description:          plan.Description,
+		EmployeeContribution: toMoneyDTO(entity.Money{Amount: plan.EmployeeContribution, Currency: plan.Currency}),
+		EmployerContribution: toMoneyDTO(entity.Money{Amount: plan.EmployerContribution, Currency: plan.Currency}),
+		CoversDependents:     plan.CoversDependents,
+		EligibleStatuses:     statuses,
+		MinTenureMonths:      plan.Eligibility.MinTenureMonths,
+		LocationIDs:          plan.Eligibility.LocationIDs,
+		CreatedAt:            plan.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:            plan.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+	if plan.CoversDependents {
+		contribution := toMoneyDTO(entity.Money{Amount: plan.DependentContribution, Currency: plan.Currency})
+		response.DependentContribution = &contribution
+	}
+	return response
+}
+
+func toEnrollmentWindowEntity(req EnrollmentWindowRequest) (*entity.BenefitEnrollmentWindow, map[string]string, error) {
+	window := &entity.BenefitEnrollmentWindow{Name: req.Name}
+	dates := []struct {
+		field  string
+		value  string
+		target *time.Time
+	}{
+		{"starts_on", req.StartsOn, &window.StartsOn},
+		{"ends_on", req.EndsOn, &window.EndsOn},
+		{"coverage_starts_on", req.CoverageStartsOn, &window.CoverageStartsOn},
+	}
+	for _, date := range dates {
+		parsed, err := time.Parse(constants.DateFormat, date.value)
+		if err != nil {
+			return nil, map[string]string{
+				date.field: "Date format is invalid , expected format: YYYY-MM-DD",
+			}, appError.ErrInvalidEnrollmentWindow
+		}
+		*date.target = parsed
+	}
+	return window, nil, nil
+}
+
+func toEnrollmentWindowResponse(window *entity.BenefitEnrollmentWindow) EnrollmentWindowResponse {
+	return EnrollmentWindowResponse{
+		ID:               window.ID,
+		Name:             window.Name,
+		StartsOn:         window.StartsOn.Format(constants.DateFormat),
+		EndsOn:           window.EndsOn.Format(constants.DateFormat),
+		CoverageStartsOn: window.CoverageStartsOn.Format(constants.DateFormat),
+		CreatedAt:        window.CreatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toDependentEntity(req DependentRequest) (*entity.Dependent, map[string]string, error) {
+	dateOfBirth, err := parseOptionalDate(req.DateOfBirth)
+	if err != nil {
+		return nil, map[string]string{
+			"date_of_birth": "Date format is invalid , expected format: YYYY-MM-DD",
+		}, appError.ErrInvalidDependent
+	}
+
+	return &entity.Dependent{
+		Name:         req.Name,
+		Relationship: entity.DependentRelationship(req.Relationship),
+		DateOfBirth:  dateOfBirth,
+	}, nil, nil
+}
+
+func toDependentResponse(dependent *entity.Dependent) DependentResponse {
+	return DependentResponse{
+		ID:           dependent.ID,
+		EmployeeID:   dependent.EmployeeID,
+		Name:         dependent.Name,
+		Relationship: string(dependent.Relationship),
+		DateOfBirth:  formatOptionalDate(dependent.DateOfBirth),
+		CreatedAt:    dependent.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:    dependent.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toBenefitEnrollmentResponse(enrollment *entity.BenefitEnrollment) BenefitEnrollmentResponse {
+	dependentIDs := enrollment.DependentIDs
+	if dependentIDs == nil {
+		dependentIDs = []int{}
+	}
+	return BenefitEnrollmentResponse{
+		ID:               enrollment.ID,
+		EmployeeID:       enrollment.EmployeeID,
+		EmployeeName:     enrollment.EmployeeName,
+		Plan:             toBenefitPlanResponse(enrollment.Plan),
+		WindowID:         enrollment.WindowID,
+		CoverageStartsOn: enrollment.CoverageStartsOn.Format(constants.DateFormat),
+		CoverageEndsOn:   formatOptionalDate(enrollment.CoverageEndsOn),
+		DependentIDs:     dependentIDs,
+		CreatedAt:        enrollment.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:        enrollment.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toBenefitDeductionResponse(deduction *entity.BenefitDeduction) BenefitDeductionResponse {
+	return BenefitDeductionResponse{
+		EnrollmentID:         deduction.EnrollmentID,
+		EmployeeID:           deduction.EmployeeID,
+		EmployeeName:         deduction.EmployeeName,
+		PlanID:               deduction.PlanID,
+		PlanName:             deduction.PlanName,
+		PlanType:             string(deduction.PlanType),
+		DependentCount:       deduction.DependentCount,
+		EmployeeContribution: toMoneyDTO(deduction.EmployeeContribution),
+		EmployerContribution: toMoneyDTO(deduction.EmployerContribution),
+	}
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type BenefitHandler struct {
+	benefitUsecase usecase.BenefitUsecase
+}
+
+func NewBenefitHandler(benefitUsecase usecase.BenefitUsecase) *BenefitHandler {
+	return &BenefitHandler{benefitUsecase: benefitUsecase}
+}
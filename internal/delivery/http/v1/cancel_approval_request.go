@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CancelRequest godoc
+// @Summary Cancel an approval request
+// @Description Withdraws a pending approval request; the change is not made
+// @Tags Approvals
+// @Produce json
+// @Param id path int true "Approval request ID"
+// @Success 200 {object} ApprovalRequestResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /approvals/requests/{id}/cancel [post]
+func (h *ApprovalHandler) CancelRequest(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidApprovalRequestId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	request, err := h.approvalUsecase.CancelRequest(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error cancelling approval request: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Approval request cancelled successfully", toApprovalRequestResponse(request))
+}
@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CancelCompensationChange godoc
+// @Summary Cancel a scheduled salary change
+// @Description Cancels a future-dated salary change that has not been applied yet
+// @Tags Compensation
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param changeId path int true "Compensation change ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/compensation/{changeId} [delete]
+func (h *CompensationHandler) CancelCompensationChange(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	changeID, err := parseIDParam(c, "changeId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCompensationChangeId,
+			map[string]string{
+				"changeId": "Change ID must be a valid number",
+			})
+	}
+
+	err = h.compensationUsecase.CancelCompensationChange(c.Request().Context(), employeeID, changeID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error cancelling compensation change: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Compensation change cancelled successfully")
+}
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CancelEnrollment godoc
+// @Summary Cancel an enrollment
+// @Description Withdraws the employee from a course they have not completed
+// @Tags Training
+// @Produce json
+// @Param id path int true "Enrollment ID"
+// @Success 200 {object} EnrollmentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /enrollments/{id}/cancel [post]
+func (h *TrainingHandler) CancelEnrollment(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEnrollmentId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	enrollment, err := h.trainingUsecase.CancelEnrollment(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error cancelling enrollment: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Enrollment cancelled successfully", toEnrollmentResponse(enrollment))
+}
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CancelInterview godoc
+// @Summary Cancel an interview
+// @Description Cancels a scheduled interview
+// @Tags Recruiting
+// @Produce json
+// @Param id path int true "Interview ID"
+// @Success 200 {object} InterviewResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /interviews/{id}/cancel [post]
+func (h *RecruitingHandler) CancelInterview(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidInterviewId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	interview, err := h.recruitingUsecase.CancelInterview(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error cancelling interview: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Interview cancelled successfully", toInterviewResponse(interview))
+}
@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CancelLeaveRequest godoc
+// @Summary Cancel a leave request
+// @Description Withdraws a pending or approved request. Approved days go back to the balance.
+// @Tags Leave
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param requestId path int true "Leave request ID"
+// @Success 200 {object} LeaveRequestResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/leave-requests/{requestId}/cancel [post]
+func (h *LeaveHandler) CancelLeaveRequest(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	requestID, err := parseIDParam(c, "requestId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidLeaveRequestId,
+			map[string]string{
+				"requestId": "Leave request ID must be a valid number",
+			})
+	}
+
+	request, err := h.leaveUsecase.CancelLeaveRequest(c.Request().Context(), employeeID, requestID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error cancelling leave request: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Leave request cancelled successfully", toLeaveRequestResponse(request))
+}
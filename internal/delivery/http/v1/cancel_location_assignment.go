@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CancelLocationAssignment godoc
+// @Summary Cancel a scheduled location assignment
+// @Description Cancels a future-dated location assignment that has not been applied yet
+// @Tags Locations
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param assignmentId path int true "Location assignment ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/locations/{assignmentId} [delete]
+func (h *LocationHandler) CancelLocationAssignment(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	assignmentID, err := parseIDParam(c, "assignmentId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidLocationAssignmentId,
+			map[string]string{
+				"assignmentId": "Assignment ID must be a valid number",
+			})
+	}
+
+	err = h.locationUsecase.CancelLocationAssignment(c.Request().Context(), employeeID, assignmentID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error cancelling location assignment: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Location assignment cancelled successfully")
+}
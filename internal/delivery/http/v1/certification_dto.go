@@ -0,0 +1,194 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// CertificationRequest is the payload for recording or updating a certification.
+// swagger:model CertificationRequest
+type CertificationRequest struct {
+	// example: Certified Forklift Operator
+	Name string `json:"name"`
+
+	// example: National Safety Council
+	Issuer string `json:"issuer"`
+
+	// example: NSC-48213
+	CredentialID string `json:"credential_id"`
+
+	// example: 2024-05-10
+	IssuedOn string `json:"issued_on"`
+
+	// Leave empty for a certification that never expires
+	// example: 2027-05-10
+	ExpiresOn string `json:"expires_on"`
+}
+
+// CertificationResponse represents an employee's certification.
+// swagger:model CertificationResponse
+type CertificationResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: John Doe
+	EmployeeName string `json:"employee_name"`
+
+	// example: Certified Forklift Operator
+	Name string `json:"name"`
+
+	// example: National Safety Council
+	Issuer string `json:"issuer,omitempty"`
+
+	// example: NSC-48213
+	CredentialID string `json:"credential_id,omitempty"`
+
+	// example: 2024-05-10
+	IssuedOn string `json:"issued_on"`
+
+	// example: 2027-05-10
+	ExpiresOn string `json:"expires_on,omitempty"`
+
+	// example: false
+	Expired bool `json:"expired"`
+
+	// Course enrollment that issued the certification
+	// example: 1
+	EnrollmentID *int `json:"enrollment_id,omitempty"`
+
+	// When the holder was notified of the upcoming expiry
+	// example: 2027-04-10
+	ExpiryNotifiedOn string `json:"expiry_notified_on,omitempty"`
+
+	// example: 1
+	EvidenceCount int `json:"evidence_count"`
+
+	// example: 2025-03-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-03-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// EvidenceResponse describes a file attached to a certification.
+// swagger:model EvidenceResponse
+type EvidenceResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	CertificationID int `json:"certification_id"`
+
+	// example: forklift-certificate.pdf
+	FileName string `json:"file_name"`
+
+	// One of application/pdf, image/png or image/jpeg
+	// example: application/pdf
+	ContentType string `json:"content_type"`
+
+	// In bytes
+	// example: 184320
+	Size int64 `json:"size"`
+
+	// example: 2025-03-01 08:00:00
+	UploadedAt string `json:"uploaded_at"`
+}
+
+// CertificationResponseWrapper wraps StandardResponse with CertificationResponse as data.
+// swagger:model CertificationResponseWrapper
+type CertificationResponseWrapper struct {
+	Success   bool                  `json:"success"`
+	Message   string                `json:"message"`
+	Data      CertificationResponse `json:"data"`
+	Timestamp string                `json:"timestamp"`
+	RequestID string                `json:"request_id"`
+}
+
+// CertificationListResponseWrapper wraps StandardResponse with a list of certifications.
+// swagger:model CertificationListResponseWrapper
+type CertificationListResponseWrapper struct {
+	Success   bool                    `json:"success"`
+	Message   string                  `json:"message"`
+	Data      []CertificationResponse `json:"data"`
+	Timestamp string                  `json:"timestamp"`
+	RequestID string                  `json:"request_id"`
+}
+
+// EvidenceResponseWrapper wraps StandardResponse with EvidenceResponse as data.
+// swagger:model EvidenceResponseWrapper
+type EvidenceResponseWrapper struct {
+	Success   bool             `json:"success"`
+	Message   string           `json:"message"`
+	Data      EvidenceResponse `json:"data"`
+	Timestamp string           `json:"timestamp"`
+	RequestID string           `json:"request_id"`
+}
+
+// EvidenceListResponseWrapper wraps StandardResponse with a list of evidence files.
+// swagger:model EvidenceListResponseWrapper
+type EvidenceListResponseWrapper struct {
+	Success   bool               `json:"success"`
+	Message   string             `json:"message"`
+	Data      []EvidenceResponse `json:"data"`
+	Timestamp string             `json:"timestamp"`
+	RequestID string             `json:"request_id"`
+}
+
+func toCertificationEntity(req CertificationRequest) (*entity.Certification, map[string]string, error) {
+	issuedOn, err := time.Parse(constants.DateFormat, req.IssuedOn)
+	if err != nil {
+		return nil, map[string]string{
+			"issued_on": "Date format is invalid , expected format: YYYY-MM-DD",
+		}, appError.ErrInvalidCertification
+	}
+	expiresOn, err := parseOptionalDate(req.ExpiresOn)
+	if err != nil {
+		return nil, map[string]string{
+			"expires_on": "Date format is invalid , expected format: YYYY-MM-DD",
+		}, appError.ErrInvalidCertification
+	}
+
+	return &entity.Certification{
+		Name:         req.Name,
+		Issuer:       req.Issuer,
+		CredentialID: req.CredentialID,
+		IssuedOn:     issuedOn,
+		ExpiresOn:    expiresOn,
+	}, nil, nil
+}
+
+func toCertificationResponse(certification *entity.Certification) CertificationResponse {
+	return CertificationResponse{
+		ID:               certification.ID,
+		EmployeeID:       certification.EmployeeID,
+		EmployeeName:     certification.EmployeeName,
+		Name:             certification.Name,
+		Issuer:           certification.Issuer,
+		CredentialID:     certification.CredentialID,
+		IssuedOn:         certification.IssuedOn.Format(constants.DateFormat),
+		ExpiresOn:        formatOptionalDate(certification.ExpiresOn),
+		Expired:          certification.IsExpired(time.Now()),
+		EnrollmentID:     certification.EnrollmentID,
+		ExpiryNotifiedOn: formatOptionalDate(certification.ExpiryNotifiedOn),
+		EvidenceCount:    certification.EvidenceCount,
+		CreatedAt:        certification.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:        certification.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toEvidenceResponse(evidence *entity.CertificationEvidence) EvidenceResponse {
+	return EvidenceResponse{
+		ID:              evidence.ID,
+		CertificationID: evidence.CertificationID,
+		FileName:        evidence.FileName,
+		ContentType:     evidence.ContentType,
+		Size:            evidence.Size,
+		UploadedAt:      evidence.UploadedAt.Format(constants.DateTimeFormat),
+	}
+}
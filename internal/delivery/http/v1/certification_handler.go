@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type CertificationHandler struct {
+	certificationUsecase usecase.CertificationUsecase
+}
+
+func NewCertificationHandler(certificationUsecase usecase.CertificationUsecase) *CertificationHandler {
+	return &CertificationHandler{certificationUsecase: certificationUsecase}
+}
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// ChangeEmploymentStatus godoc
+// @Summary Change employment status
+// @Description Moves an employee between candidate, active, on_leave and suspended. Use the terminate and rehire endpoints for terminations.
+// @Tags Employees
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param payload body ChangeEmploymentStatusRequest true "Status change payload"
+// @Success 200 {object} GetEmployeeByIdResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/status [put]
+func (h *EmployeeHandler) ChangeEmploymentStatus(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req ChangeEmploymentStatusRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"status":         "Status is required",
+				"effective_date": "Effective date is required and must be a valid date",
+			})
+	}
+
+	effectiveDate, err := time.Parse(constants.DateFormat, req.EffectiveDate)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEffectiveDate,
+			map[string]string{
+				"effective_date": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	employee, err := h.employeeUsecase.ChangeEmploymentStatus(c.Request().Context(), &entity.EmploymentStatusChange{
+		EmployeeID:    id,
+		ToStatus:      entity.EmploymentStatus(req.Status),
+		EffectiveDate: effectiveDate,
+		Reason:        req.Reason,
+	})
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error changing employment status: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Employment status updated successfully", toGetEmployeeByIdResponse(employee))
+}
@@ -0,0 +1,386 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// ChecklistTemplateTaskRequest is a task of a checklist template.
+// swagger:model ChecklistTemplateTaskRequest
+type ChecklistTemplateTaskRequest struct {
+	// example: Set up laptop and accounts
+	Title string `json:"title"`
+
+	// example: Order the laptop and create email, chat and VPN accounts
+	Description string `json:"description"`
+
+	// One of employee (the person joining or leaving), manager (their manager) or designated
+	// example: designated
+	OwnerRole string `json:"owner_role"`
+
+	// Owner of designated tasks, empty otherwise
+	// example: 7
+	OwnerID *int `json:"owner_id"`
+
+	// Days from the hire or termination date the task is due, -365 to 365
+	// example: -3
+	DueDays int `json:"due_days"`
+}
+
+// ChecklistTemplateRequest is the payload for creating or updating a checklist template.
+// swagger:model ChecklistTemplateRequest
+type ChecklistTemplateRequest struct {
+	// example: Engineering onboarding
+	Name string `json:"name"`
+
+	// One of onboarding or offboarding
+	// example: onboarding
+	Kind string `json:"kind"`
+
+	// Limits the template to employees in the position
+	// example: 2
+	PositionID *int `json:"position_id"`
+
+	// Limits the template to employees in the department
+	// example: 1
+	DepartmentID *int `json:"department_id"`
+
+	// 1 to 50 tasks, in the order they are listed on checklists
+	Tasks []ChecklistTemplateTaskRequest `json:"tasks"`
+}
+
+// StartChecklistRequest is the payload for starting a checklist for an employee.
+// swagger:model StartChecklistRequest
+type StartChecklistRequest struct {
+	// One of onboarding or offboarding
+	// example: onboarding
+	Kind string `json:"kind"`
+}
+
+// AssignChecklistTaskRequest is the payload for assigning a checklist task.
+// swagger:model AssignChecklistTaskRequest
+type AssignChecklistTaskRequest struct {
+	// Empty to leave the task unassigned
+	// example: 7
+	OwnerID *int `json:"owner_id"`
+
+	// example: 2025-03-03
+	DueOn string `json:"due_on"`
+}
+
+// ChecklistTemplateTaskResponse represents a task of a checklist template.
+// swagger:model ChecklistTemplateTaskResponse
+type ChecklistTemplateTaskResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: Set up laptop and accounts
+	Title string `json:"title"`
+
+	// example: Order the laptop and create email, chat and VPN accounts
+	Description string `json:"description,omitempty"`
+
+	// example: designated
+	OwnerRole string `json:"owner_role"`
+
+	// example: 7
+	OwnerID *int `json:"owner_id,omitempty"`
+
+	// example: -3
+	DueDays int `json:"due_days"`
+}
+
+// ChecklistTemplateResponse represents a checklist template with its tasks.
+// swagger:model ChecklistTemplateResponse
+type ChecklistTemplateResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: Engineering onboarding
+	Name string `json:"name"`
+
+	// example: onboarding
+	Kind string `json:"kind"`
+
+	// example: 2
+	PositionID *int `json:"position_id,omitempty"`
+
+	// example: 1
+	DepartmentID *int `json:"department_id,omitempty"`
+
+	Tasks []ChecklistTemplateTaskResponse `json:"tasks"`
+
+	// example: 2025-01-10 09:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-01-10 09:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ChecklistTaskResponse represents a task of an employee's checklist.
+// swagger:model ChecklistTaskResponse
+type ChecklistTaskResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	ChecklistID int `json:"checklist_id"`
+
+	// example: onboarding
+	Kind string `json:"kind"`
+
+	// Employee joining or leaving
+	// example: 12
+	EmployeeID int `json:"employee_id"`
+
+	// example: Jane Doe
+	EmployeeName string `json:"employee_name"`
+
+	// example: Set up laptop and accounts
+	Title string `json:"title"`
+
+	// example: Order the laptop and create email, chat and VPN accounts
+	Description string `json:"description,omitempty"`
+
+	// Empty while unassigned
+	// example: 7
+	OwnerID *int `json:"owner_id,omitempty"`
+
+	// example: John Smith
+	OwnerName string `json:"owner_name,omitempty"`
+
+	// example: 2025-02-26
+	DueOn string `json:"due_on"`
+
+	// Still open after the due date
+	// example: false
+	Overdue bool `json:"overdue"`
+
+	// example: 2025-02-25 16:30:00
+	CompletedAt string `json:"completed_at,omitempty"`
+}
+
+// ChecklistProgressResponse summarizes how far a checklist has come.
+// swagger:model ChecklistProgressResponse
+type ChecklistProgressResponse struct {
+	// example: 8
+	TotalTasks int `json:"total_tasks"`
+
+	// example: 6
+	CompletedTasks int `json:"completed_tasks"`
+
+	// example: 1
+	OverdueTasks int `json:"overdue_tasks"`
+
+	// example: 75
+	PercentComplete float64 `json:"percent_complete"`
+}
+
+// ChecklistResponse represents an employee's onboarding or offboarding checklist.
+// swagger:model ChecklistResponse
+type ChecklistResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 12
+	EmployeeID int `json:"employee_id"`
+
+	// example: Jane Doe
+	EmployeeName string `json:"employee_name"`
+
+	// Empty once the template is deleted
+	// example: 1
+	TemplateID *int `json:"template_id,omitempty"`
+
+	// example: onboarding
+	Kind string `json:"kind"`
+
+	// example: Engineering onboarding
+	Name string `json:"name"`
+
+	// Hire or termination date the due dates count from
+	// example: 2025-03-01
+	StartDate string `json:"start_date"`
+
+	Progress ChecklistProgressResponse `json:"progress"`
+
+	Tasks []ChecklistTaskResponse `json:"tasks"`
+
+	// example: 2025-02-20 10:00:00
+	CreatedAt string `json:"created_at"`
+
+	// Set once every task is done
+	// example: 2025-03-14 17:00:00
+	CompletedAt string `json:"completed_at,omitempty"`
+}
+
+// ChecklistTemplateResponseWrapper wraps StandardResponse with ChecklistTemplateResponse as data.
+// swagger:model ChecklistTemplateResponseWrapper
+type ChecklistTemplateResponseWrapper struct {
+	Success   bool                      `json:"success"`
+	Message   string                    `json:"message"`
+	Data      ChecklistTemplateResponse `json:"data"`
+	Timestamp string                    `json:"timestamp"`
+	RequestID string                    `json:"request_id"`
+}
+
+// ChecklistTemplateListResponseWrapper wraps StandardResponse with a list of checklist templates.
+// swagger:model ChecklistTemplateListResponseWrapper
+type ChecklistTemplateListResponseWrapper struct {
+	Success   bool                        `json:"success"`
+	Message   string                      `json:"message"`
+	Data      []ChecklistTemplateResponse `json:"data"`
+	Timestamp string                      `json:"timestamp"`
+	RequestID string                      `json:"request_id"`
+}
+
+// ChecklistResponseWrapper wraps StandardResponse with ChecklistResponse as data.
+// swagger:model ChecklistResponseWrapper
+type ChecklistResponseWrapper struct {
+	Success   bool              `json:"success"`
+	Message   string            `json:"message"`
+	Data      ChecklistResponse `json:"data"`
+	Timestamp string            `json:"timestamp"`
+	RequestID string            `json:"request_id"`
+}
+
+// ChecklistListResponseWrapper wraps StandardResponse with a list of checklists.
+// swagger:model ChecklistListResponseWrapper
+type ChecklistListResponseWrapper struct {
+	Success   bool                `json:"success"`
+	Message   string              `json:"message"`
+	Data      []ChecklistResponse `json:"data"`
+	Timestamp string              `json:"timestamp"`
+	RequestID string              `json:"request_id"`
+}
+
+// ChecklistTaskResponseWrapper wraps StandardResponse with ChecklistTaskResponse as data.
+// swagger:model ChecklistTaskResponseWrapper
+type ChecklistTaskResponseWrapper struct {
+	Success   bool                  `json:"success"`
+	Message   string                `json:"message"`
+	Data      ChecklistTaskResponse `json:"data"`
+	Timestamp string                `json:"timestamp"`
+	RequestID string                `json:"request_id"`
+}
+
+// ChecklistTaskListResponseWrapper wraps StandardResponse with a list of checklist tasks.
+// swagger:model ChecklistTaskListResponseWrapper
+type ChecklistTaskListResponseWrapper struct {
+	Success   bool                    `json:"success"`
+	Message   string                  `json:"message"`
+	Data      []ChecklistTaskResponse `json:"data"`
+	Timestamp string                  `json:"timestamp"`
+	RequestID string                  `json:"request_id"`
+}
+
+func toChecklistTemplateEntity(req ChecklistTemplateRequest) *entity.ChecklistTemplate {
+	template := &entity.ChecklistTemplate{
+		Name:         req.Name,
+		Kind:         entity.ChecklistKind(req.Kind),
+		PositionID:   req.PositionID,
+		DepartmentID: req.DepartmentID,
+		Tasks:        []entity.ChecklistTemplateTask{},
+	}
+	for _, task := range req.Tasks {
+		template.Tasks = append(template.Tasks, entity.ChecklistTemplateTask{
+			Title:       task.Title,
+			Description: task.Description,
+			OwnerRole:   entity.ChecklistOwnerRole(task.OwnerRole),
+			OwnerID:     task.OwnerID,
+			DueDays:     task.DueDays,
+		})
+	}
+	return template
+}
+
+// toChecklistTaskEntity maps the request, returning the date that could not be parsed.
+func toChecklistTaskEntity(id int, req AssignChecklistTaskRequest) (*entity.ChecklistTask, map[string]string, error) {
+	dueOn, err := time.Parse(constants.DateFormat, req.DueOn)
+	if err != nil {
+		return nil, map[string]string{
+			"due_on": "Date format is invalid , expected format: YYYY-MM-DD",
+		}, appError.ErrInvalidChecklistTask
+	}
+	return &entity.ChecklistTask{
+		ID:      id,
+		OwnerID: req.OwnerID,
+		DueOn:   dueOn,
+	}, nil, nil
+}
+
+func toChecklistTemplateResponse(template *entity.ChecklistTemplate) ChecklistTemplateResponse {
+	response := ChecklistTemplateResponse{
+		ID:           template.ID,
+		Name:         template.Name,
+		Kind:         string(template.Kind),
+		PositionID:   template.PositionID,
+		DepartmentID: template.DepartmentID,
+		Tasks:        []ChecklistTemplateTaskResponse{},
+		CreatedAt:    template.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:    template.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+	for _, task := range template.Tasks {
+		response.Tasks = append(response.Tasks, ChecklistTemplateTaskResponse{
+			ID:          task.ID,
+			Title:       task.Title,
+			Description: task.Description,
+			OwnerRole:   string(task.OwnerRole),
+			OwnerID:     task.OwnerID,
+			DueDays:     task.DueDays,
+		})
+	}
+	return response
+}
+
+func toChecklistTaskResponse(task *entity.ChecklistTask, asOf time.Time) ChecklistTaskResponse {
+	response := ChecklistTaskResponse{
+		ID:           task.ID,
+		ChecklistID:  task.ChecklistID,
+		Kind:         string(task.ChecklistKind),
+		EmployeeID:   task.EmployeeID,
+		EmployeeName: task.EmployeeName,
+		Title:        task.Title,
+		Description:  task.Description,
+		OwnerID:      task.OwnerID,
+		OwnerName:    task.OwnerName,
+		DueOn:        task.DueOn.Format(constants.DateFormat),
+		Overdue:      task.IsOverdue(asOf),
+	}
+	if task.CompletedAt != nil {
+		response.CompletedAt = task.CompletedAt.Format(constants.DateTimeFormat)
+	}
+	return response
+}
+
+func toChecklistResponse(checklist *entity.Checklist) ChecklistResponse {
+	now := time.Now()
+	progress := checklist.Progress(now)
+	response := ChecklistResponse{
+		ID:           checklist.ID,
+		EmployeeID:   checklist.EmployeeID,
+		EmployeeName: checklist.EmployeeName,
+		TemplateID:   checklist.TemplateID,
+		Kind:         string(checklist.Kind),
+		Name:         checklist.Name,
+		StartDate:    checklist.StartDate.Format(constants.DateFormat),
+		Progress: ChecklistProgressResponse{
+			TotalTasks:      progress.TotalTasks,
+			CompletedTasks:  progress.CompletedTasks,
+			OverdueTasks:    progress.OverdueTasks,
+			PercentComplete: progress.Percent,
+		},
+		Tasks:     []ChecklistTaskResponse{},
+		CreatedAt: checklist.CreatedAt.Format(constants.DateTimeFormat),
+	}
+	for _, task := range checklist.Tasks {
+		response.Tasks = append(response.Tasks, toChecklistTaskResponse(&task, now))
+	}
+	if checklist.CompletedAt != nil {
+		response.CompletedAt = checklist.CompletedAt.Format(constants.DateTimeFormat)
+	}
+	return response
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type ChecklistHandler struct {
+	checklistUsecase usecase.ChecklistUsecase
+}
+
+func NewChecklistHandler(checklistUsecase usecase.ChecklistUsecase) *ChecklistHandler {
+	return &ChecklistHandler{checklistUsecase: checklistUsecase}
+}
@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// ClockOut godoc
+// @Summary Clock out
+// @Description Closes the employee's open shift, now or at the given time
+// @Tags Attendance
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param payload body PunchRequest false "Punch payload"
+// @Success 200 {object} AttendanceEntryResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/attendance/clock-out [post]
+func (h *AttendanceHandler) ClockOut(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req PunchRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidPunchTime,
+			map[string]string{
+				"time": "Time must be a string",
+			})
+	}
+
+	var at time.Time
+	if req.Time != "" {
+		at, err = time.Parse(constants.DateTimeFormat, req.Time)
+		if err != nil {
+			return apiresponse.Error(c,
+				appError.ErrInvalidPunchTime,
+				map[string]string{
+					"time": "Time format is invalid , expected format: YYYY-MM-DD HH:MM:SS",
+				})
+		}
+	}
+
+	entry, err := h.attendanceUsecase.ClockOut(c.Request().Context(), employeeID, at)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error clocking out: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Clocked out successfully", toAttendanceEntryResponse(entry))
+}
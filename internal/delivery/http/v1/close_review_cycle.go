@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CloseReviewCycle godoc
+// @Summary Close a review cycle
+// @Description Closes an active cycle and locks its completed reviews; reviews still in progress stay unfinished
+// @Tags Reviews
+// @Produce json
+// @Param id path int true "Review cycle ID"
+// @Success 200 {object} ReviewCycleResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /review-cycles/{id}/close [post]
+func (h *ReviewHandler) CloseReviewCycle(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidReviewCycleId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	cycle, err := h.reviewUsecase.CloseReviewCycle(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error closing review cycle: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Review cycle closed successfully", toReviewCycleResponse(cycle))
+}
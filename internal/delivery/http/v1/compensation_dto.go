@@ -0,0 +1,191 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// ScheduleCompensationChangeRequest is the payload for a salary change.
+// swagger:model ScheduleCompensationChangeRequest
+type ScheduleCompensationChangeRequest struct {
+	// New salary per pay period, in minor currency units
+	NewSalary MoneyDTO `json:"new_salary"`
+
+	// Date the new salary applies from (YYYY-MM-DD); future dates are applied by a background job
+	// example: 2025-04-01
+	EffectiveDate string `json:"effective_date"`
+
+	// example: Annual raise
+	Reason string `json:"reason"`
+
+	// Required unless salary changes go through an approval workflow, which records the approvers
+	// instead
+	// example: Jane Smith
+	ApprovedBy string `json:"approved_by"`
+
+	// Employee asking for the change, recorded on the approval request
+	// example: 7
+	RequestedBy *int `json:"requested_by"`
+}
+
+// CompensationChangeResponse is one entry of the compensation timeline.
+// swagger:model CompensationChangeResponse
+type CompensationChangeResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: 2025-04-01
+	EffectiveDate string `json:"effective_date"`
+
+	// Empty for the initial salary
+	OldSalary *MoneyDTO `json:"old_salary,omitempty"`
+
+	NewSalary MoneyDTO `json:"new_salary"`
+
+	// example: Annual raise
+	Reason string `json:"reason"`
+
+	// example: Jane Smith
+	ApprovedBy string `json:"approved_by,omitempty"`
+
+	// One of scheduled, applied, cancelled
+	// example: scheduled
+	Status string `json:"status"`
+
+	// example: 2025-04-01 00:00:05
+	AppliedAt string `json:"applied_at,omitempty"`
+
+	// example: 2025-03-10 09:00:00
+	CreatedAt string `json:"created_at"`
+}
+
+// CompensationChangeResponseWrapper wraps StandardResponse with CompensationChangeResponse as data.
+// swagger:model CompensationChangeResponseWrapper
+type CompensationChangeResponseWrapper struct {
+	Success   bool                       `json:"success"`
+	Message   string                     `json:"message"`
+	Data      CompensationChangeResponse `json:"data"`
+	Timestamp string                     `json:"timestamp"`
+	RequestID string                     `json:"request_id"`
+}
+
+// CompensationHistoryResponseWrapper wraps StandardResponse with the compensation timeline.
+// swagger:model CompensationHistoryResponseWrapper
+type CompensationHistoryResponseWrapper struct {
+	Success   bool                         `json:"success"`
+	Message   string                       `json:"message"`
+	Data      []CompensationChangeResponse `json:"data"`
+	Timestamp string                       `json:"timestamp"`
+	RequestID string                       `json:"request_id"`
+}
+
+// PaySegmentResponse is a stretch of the month paid at one salary.
+// swagger:model PaySegmentResponse
+type PaySegmentResponse struct {
+	// example: 2025-03-10
+	StartDate string `json:"start_date"`
+
+	// example: 2025-03-31
+	EndDate string `json:"end_date"`
+
+	// Salary per pay period in effect during the segment
+	Salary MoneyDTO `json:"salary"`
+
+	// Calendar or working days covered, depending on the basis
+	// example: 16
+	Days int `json:"days"`
+
+	Amount MoneyDTO `json:"amount"`
+}
+
+// PayPreviewResponse is an employee's base pay for a month, prorated over the days they were employed.
+// swagger:model PayPreviewResponse
+type PayPreviewResponse struct {
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: 2025-03
+	Period string `json:"period"`
+
+	// One of calendar_days or working_days
+	// example: working_days
+	Basis string `json:"basis"`
+
+	// example: monthly
+	PayPeriod string `json:"pay_period"`
+
+	// Days of the month on the basis
+	// example: 21
+	MonthDays int `json:"month_days"`
+
+	// Days of the month the employee was employed
+	// example: 16
+	PaidDays int `json:"paid_days"`
+
+	// example: true
+	Prorated bool `json:"prorated"`
+
+	Segments []PaySegmentResponse `json:"segments"`
+
+	// Total base pay in the currency of the current salary
+	Amount MoneyDTO `json:"amount"`
+}
+
+// PayPreviewResponseWrapper wraps StandardResponse with PayPreviewResponse as data.
+// swagger:model PayPreviewResponseWrapper
+type PayPreviewResponseWrapper struct {
+	Success   bool               `json:"success"`
+	Message   string             `json:"message"`
+	Data      PayPreviewResponse `json:"data"`
+	Timestamp string             `json:"timestamp"`
+	RequestID string             `json:"request_id"`
+}
+
+func toCompensationChangeResponse(change *entity.CompensationChange) CompensationChangeResponse {
+	response := CompensationChangeResponse{
+		ID:            change.ID,
+		EmployeeID:    change.EmployeeID,
+		EffectiveDate: change.EffectiveDate.Format(constants.DateFormat),
+		NewSalary:     toMoneyDTO(change.NewSalary),
+		Reason:        change.Reason,
+		ApprovedBy:    change.ApprovedBy,
+		Status:        string(change.Status),
+		CreatedAt:     change.CreatedAt.Format(constants.DateTimeFormat),
+	}
+	if !change.OldSalary.IsZero() {
+		oldSalary := toMoneyDTO(change.OldSalary)
+		response.OldSalary = &oldSalary
+	}
+	if change.AppliedAt != nil {
+		response.AppliedAt = change.AppliedAt.Format(constants.DateTimeFormat)
+	}
+	return response
+}
+
+func toPayPreviewResponse(pay *usecase.ProratedPay) PayPreviewResponse {
+	response := PayPreviewResponse{
+		EmployeeID: pay.EmployeeID,
+		Period:     pay.Start.Format(constants.MonthFormat),
+		Basis:      string(pay.Basis),
+		PayPeriod:  string(pay.PayPeriod),
+		MonthDays:  pay.MonthDays,
+		PaidDays:   pay.PaidDays,
+		Prorated:   pay.IsProrated(),
+		Segments:   []PaySegmentResponse{},
+		Amount:     toMoneyDTO(pay.Amount),
+	}
+	for _, segment := range pay.Segments {
+		response.Segments = append(response.Segments, PaySegmentResponse{
+			StartDate: segment.Start.Format(constants.DateFormat),
+			EndDate:   segment.End.Format(constants.DateFormat),
+			Salary:    toMoneyDTO(segment.Salary),
+			Days:      segment.Days,
+			Amount:    toMoneyDTO(segment.Amount),
+		})
+	}
+	return response
+}
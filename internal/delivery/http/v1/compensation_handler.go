@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type CompensationHandler struct {
+	compensationUsecase    usecase.CompensationUsecase
+	compensationCalculator usecase.CompensationCalculator
+}
+
+func NewCompensationHandler(compensationUsecase usecase.CompensationUsecase,
+	compensationCalculator usecase.CompensationCalculator) *CompensationHandler {
+	return &CompensationHandler{
+		compensationUsecase:    compensationUsecase,
+		compensationCalculator: compensationCalculator,
+	}
+}
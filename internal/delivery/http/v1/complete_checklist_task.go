@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CompleteTask godoc
+// @Summary Complete a checklist task
+// @Description Marks a task done. The checklist completes with its last task; an offboarding cannot complete while the employee still holds company assets.
+// @Tags Checklists
+// @Produce json
+// @Param id path int true "Checklist task ID"
+// @Success 200 {object} ChecklistResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /checklist-tasks/{id}/complete [post]
+func (h *ChecklistHandler) CompleteTask(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidChecklistTaskId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	checklist, err := h.checklistUsecase.CompleteTask(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error completing checklist task: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Checklist task completed successfully", toChecklistResponse(checklist))
+}
@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CompleteEnrollment godoc
+// @Summary Complete an enrollment
+// @Description Records that the employee completed the course, today unless a date is given. Courses with a certification validity issue a certification dated on completion.
+// @Tags Training
+// @Accept json
+// @Produce json
+// @Param id path int true "Enrollment ID"
+// @Param payload body EnrollmentCompletionRequest false "Completion payload"
+// @Success 200 {object} EnrollmentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /enrollments/{id}/complete [post]
+func (h *TrainingHandler) CompleteEnrollment(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEnrollmentId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req EnrollmentCompletionRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"completed_on": "Completion date must be a date",
+			})
+	}
+	completionDate, err := parseOptionalDate(req.CompletedOn)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEnrollment,
+			map[string]string{
+				"completed_on": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	var completedOn time.Time
+	if completionDate != nil {
+		completedOn = *completionDate
+	}
+
+	enrollment, err := h.trainingUsecase.CompleteEnrollment(c.Request().Context(), id, completedOn)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error completing enrollment: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Enrollment completed successfully", toEnrollmentResponse(enrollment))
+}
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// ConvertEmployeeSalary godoc
+// @Summary Get salary in another currency
+// @Description Converts an employee's salary with the latest stored exchange rates. Defaults to the reporting currency.
+// @Tags ExchangeRates
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param currency query string false "Target ISO 4217 currency, defaults to the reporting currency"
+// @Success 200 {object} SalaryConversionResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/salary [get]
+func (h *ExchangeRateHandler) ConvertEmployeeSalary(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	conversion, err := h.exchangeRateUsecase.ConvertEmployeeSalary(c.Request().Context(), employeeID, c.QueryParam("currency"))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error converting employee salary: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Salary converted successfully", toSalaryConversionResponse(conversion))
+}
@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateDelegation godoc
+// @Summary Delegate approvals
+// @Description Lets another employee decide everything the employee may approve between two dates, e.g. while they are on leave
+// @Tags Approvals
+// @Accept json
+// @Produce json
+// @Param id path int true "Delegator's employee ID"
+// @Param payload body ApprovalDelegationRequest true "Delegation payload"
+// @Success 200 {object} ApprovalDelegationResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/delegations [post]
+func (h *ApprovalHandler) CreateDelegation(c echo.Context) error {
+	delegatorID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req ApprovalDelegationRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"delegate_id": "Delegate ID is required",
+				"start_date":  "Start date is required and must be a valid date",
+				"end_date":    "End date is required and must be a valid date",
+			})
+	}
+
+	delegation, details, err := toApprovalDelegationEntity(delegatorID, req)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+
+	createdDelegation, err := h.approvalUsecase.CreateDelegation(c.Request().Context(), delegation)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating approval delegation: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Approval delegation created successfully", toApprovalDelegationResponse(createdDelegation))
+}
@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateWorkflow godoc
+// @Summary Create an approval workflow
+// @Description Creates the approval chain of a request type. Once it exists, changes of that type are submitted for approval instead of being made directly. Steps run stage by stage; steps sharing a stage run in parallel.
+// @Tags Approvals
+// @Accept json
+// @Produce json
+// @Param payload body ApprovalWorkflowRequest true "Approval workflow payload"
+// @Success 200 {object} ApprovalWorkflowResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /approval-workflows [post]
+func (h *ApprovalHandler) CreateWorkflow(c echo.Context) error {
+	var req ApprovalWorkflowRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":         "Name is required and must be at least 3 characters long",
+				"request_type": "Request type is required, salary_change, termination, expense or leave",
+				"steps":        "At least one step is required",
+			})
+	}
+
+	workflow, err := h.approvalUsecase.CreateWorkflow(c.Request().Context(), toApprovalWorkflowEntity(req))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating approval workflow: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Approval workflow created successfully", toApprovalWorkflowResponse(workflow))
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateAsset godoc
+// @Summary Add an asset
+// @Description Adds a piece of company equipment to the inventory, available unless another status is given
+// @Tags Assets
+// @Accept json
+// @Produce json
+// @Param payload body AssetRequest true "Asset payload"
+// @Success 200 {object} AssetResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /assets [post]
+func (h *AssetHandler) CreateAsset(c echo.Context) error {
+	var req AssetRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":          "Name is required and must be at least 2 characters long",
+				"type":          "Type is required",
+				"serial_number": "Serial number is required",
+			})
+	}
+
+	asset, details, err := toAssetEntity(req)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+
+	createdAsset, err := h.assetUsecase.CreateAsset(c.Request().Context(), asset)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating asset: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Asset created successfully", toAssetResponse(createdAsset))
+}
@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateBenefitPlan godoc
+// @Summary Create a benefit plan
+// @Description Creates a benefit plan with monthly contributions and eligibility rules. Employees are eligible when their employment status is listed, they have been employed for min_tenure_months when coverage starts and they work at one of the plan's locations, if it lists any
+// @Tags Benefits
+// @Accept json
+// @Produce json
+// @Param payload body BenefitPlanRequest true "Benefit plan payload"
+// @Success 200 {object} BenefitPlanResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /benefit-plans [post]
+func (h *BenefitHandler) CreateBenefitPlan(c echo.Context) error {
+	var req BenefitPlanRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":     "Name is required and must be at least 2 characters long",
+				"type":     "Type is required",
+				"currency": "Currency is required",
+			})
+	}
+
+	createdPlan, err := h.benefitUsecase.CreatePlan(c.Request().Context(), toBenefitPlanEntity(req))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating benefit plan: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Benefit plan created successfully", toBenefitPlanResponse(createdPlan))
+}
@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateCertification godoc
+// @Summary Record a certification
+// @Description Records a certification the employee holds, with its issue and, if it has one, expiry date
+// @Tags Certifications
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param payload body CertificationRequest true "Certification payload"
+// @Success 200 {object} CertificationResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/certifications [post]
+func (h *CertificationHandler) CreateCertification(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req CertificationRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":      "Name is required",
+				"issued_on": "Issue date is required, expected format: YYYY-MM-DD",
+			})
+	}
+
+	certification, details, err := toCertificationEntity(req)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+	certification.EmployeeID = employeeID
+
+	createdCertification, err := h.certificationUsecase.CreateCertification(c.Request().Context(), certification)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating certification: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Certification created successfully", toCertificationResponse(createdCertification))
+}
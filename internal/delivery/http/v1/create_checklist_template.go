@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateTemplate godoc
+// @Summary Create a checklist template
+// @Description Creates an onboarding or offboarding template, optionally limited to a position and/or department. New hires and terminations get a checklist from the most specific matching template, position before department.
+// @Tags Checklists
+// @Accept json
+// @Produce json
+// @Param payload body ChecklistTemplateRequest true "Checklist template payload"
+// @Success 200 {object} ChecklistTemplateResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /checklist-templates [post]
+func (h *ChecklistHandler) CreateTemplate(c echo.Context) error {
+	var req ChecklistTemplateRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":  "Name is required and must be at least 3 characters long",
+				"kind":  "Kind is required, onboarding or offboarding",
+				"tasks": "At least one task is required",
+			})
+	}
+
+	template, err := h.checklistUsecase.CreateTemplate(c.Request().Context(), toChecklistTemplateEntity(req))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating checklist template: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Checklist template created successfully", toChecklistTemplateResponse(template))
+}
@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateCustomField godoc
+// @Summary Define a custom employee field
+// @Description Defines a field employees can hold a value of in custom_fields. Values are checked against the field's type, options and validation whenever an employee is created or updated, and required fields must have one
+// @Tags CustomFields
+// @Accept json
+// @Produce json
+// @Param payload body CustomFieldRequest true "Custom field payload"
+// @Success 200 {object} CustomFieldResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /custom-fields [post]
+func (h *CustomFieldHandler) CreateCustomField(c echo.Context) error {
+	var req CustomFieldRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"key":   "Key is required",
+				"label": "Label is required",
+				"type":  "Type is required",
+			})
+	}
+
+	createdDefinition, err := h.customFieldUsecase.CreateDefinition(c.Request().Context(), toCustomFieldEntity(req))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating custom field: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Custom field created successfully", toCustomFieldResponse(createdDefinition))
+}
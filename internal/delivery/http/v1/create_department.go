@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateDepartment godoc
+// @Summary Create a department
+// @Description Adds a department employees can be assigned to
+// @Tags Departments
+// @Accept json
+// @Produce json
+// @Param payload body DepartmentRequest true "Department payload"
+// @Success 200 {object} DepartmentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /departments [post]
+func (h *DepartmentHandler) CreateDepartment(c echo.Context) error {
+	var req DepartmentRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name": "Name is required and must be at least 2 characters long",
+			})
+	}
+
+	department, err := h.departmentUsecase.CreateDepartment(c.Request().Context(), toDepartmentEntity(req))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating department: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Department created successfully", toDepartmentResponse(department))
+}
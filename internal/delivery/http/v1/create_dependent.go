@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateDependent godoc
+// @Summary Add a dependent
+// @Description Adds a dependent benefit plans covering dependents may cover
+// @Tags Benefits
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param payload body DependentRequest true "Dependent payload"
+// @Success 200 {object} DependentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/dependents [post]
+func (h *BenefitHandler) CreateDependent(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req DependentRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":         "Name is required",
+				"relationship": "Relationship is required",
+			})
+	}
+
+	dependent, details, err := toDependentEntity(req)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+	dependent.EmployeeID = employeeID
+
+	createdDependent, err := h.benefitUsecase.CreateDependent(c.Request().Context(), dependent)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating dependent: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Dependent created successfully", toDependentResponse(createdDependent))
+}
@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateEmergencyContact godoc
+// @Summary Add an emergency contact
+// @Description Adds an emergency contact to an employee. Marking it primary unsets the previous primary contact.
+// @Tags EmergencyContacts
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param payload body EmergencyContactRequest true "Emergency contact payload"
+// @Success 200 {object} EmergencyContactResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/emergency-contacts [post]
+func (h *EmergencyContactHandler) CreateEmergencyContact(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req EmergencyContactRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":         "Name is required and must be at least 2 characters long",
+				"relationship": "Relationship is required",
+				"phone":        "Phone is required in E.164 format",
+			})
+	}
+
+	contact := &entity.EmergencyContact{
+		EmployeeID:   employeeID,
+		Name:         req.Name,
+		Relationship: req.Relationship,
+		Phone:        req.Phone,
+		Email:        req.Email,
+		IsPrimary:    req.IsPrimary,
+	}
+
+	createdContact, err := h.emergencyContactUsecase.CreateEmergencyContact(c.Request().Context(), contact)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating emergency contact: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Emergency contact created successfully", toEmergencyContactResponse(createdContact))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
 	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
 	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
 )
 
 // CreateEmployee godoc
@@ -27,14 +28,14 @@ func (h *EmployeeHandler) CreateEmployee(c echo.Context) error {
 		return apiresponse.Error(c,
 			appError.ErrMissingRequiredFields,
 			map[string]string{
-				"name":       "Name is required and must be at least 3 characters long",
-				"position":   "Position is required and must be at least 3 characters long",
-				"salary":     "Salary is required and must be a valid number",
-				"hired_date": "Hired date is required and must be a valid date",
+				"name":        "Name is required and must be at least 3 characters long",
+				"position_id": "Position ID is required and must reference an existing position",
+				"salary":      "Salary is required with an amount in minor units and a currency",
+				"hired_date":  "Hired date is required and must be a valid date",
 			})
 	}
 
-	hiredDate, err := time.Parse("2006-01-02", req.HiredDate)
+	hiredDate, err := time.Parse(constants.DateFormat, req.HiredDate)
 	if err != nil {
 		return apiresponse.Error(c,
 			appError.ErrInvalidHiredDate,
@@ -44,11 +45,32 @@ func (h *EmployeeHandler) CreateEmployee(c echo.Context) error {
 		)
 	}
 
+	dateOfBirth, err := parseOptionalDate(req.DateOfBirth)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidDateOfBirth,
+			map[string]string{
+				"date_of_birth": "Date format is invalid , expected format: YYYY-MM-DD",
+			},
+		)
+	}
+
 	employee := &entity.Employee{
-		Name:      req.Name,
-		Position:  req.Position,
-		Salary:    req.Salary,
-		HiredDate: hiredDate,
+		Name:          req.Name,
+		PositionID:    req.PositionID,
+		ManagerID:     req.ManagerID,
+		LocationID:    req.LocationID,
+		DepartmentID:  req.DepartmentID,
+		Salary:        toMoneyEntity(req.Salary),
+		PayPeriod:     entity.PayPeriod(req.PayPeriod),
+		HiredDate:     hiredDate,
+		WorkEmail:     req.WorkEmail,
+		PersonalEmail: req.PersonalEmail,
+		Phone:         req.Phone,
+		Address:       toAddressEntity(req.Address),
+		DateOfBirth:   dateOfBirth,
+		CustomFields:  req.CustomFields,
+		Status:        entity.EmploymentStatus(req.Status),
 	}
 
 	createdEmployee, err := h.employeeUsecase.CreateEmployee(c.Request().Context(), employee)
@@ -60,12 +82,28 @@ func (h *EmployeeHandler) CreateEmployee(c echo.Context) error {
 	}
 
 	createdEmployeeResponse := CreateEmployeeResponse{
-		ID:        createdEmployee.ID,
-		Name:      createdEmployee.Name,
-		Position:  createdEmployee.Position,
-		Salary:    createdEmployee.Salary,
-		HiredDate: createdEmployee.HiredDate.Format("2006-01-02"),
-		CreatedAt: createdEmployee.CreatedAt.Format("2006-01-02 15:04:05"),
+		ID:                createdEmployee.ID,
+		Name:              createdEmployee.Name,
+		PositionID:        createdEmployee.PositionID,
+		Position:          createdEmployee.Position,
+		Salary:            toMoneyDTO(createdEmployee.Salary),
+		PayPeriod:         string(createdEmployee.PayPeriod),
+		SalaryOutOfBand:   createdEmployee.SalaryOutOfBand,
+		ManagerID:         createdEmployee.ManagerID,
+		LocationID:        createdEmployee.LocationID,
+		DepartmentID:      createdEmployee.DepartmentID,
+		HiredDate:         createdEmployee.HiredDate.Format(constants.DateFormat),
+		WorkEmail:         createdEmployee.WorkEmail,
+		PersonalEmail:     createdEmployee.PersonalEmail,
+		Phone:             createdEmployee.Phone,
+		Address:           toAddressDTO(createdEmployee.Address),
+		DateOfBirth:       formatOptionalDate(createdEmployee.DateOfBirth),
+		Status:            string(createdEmployee.Status),
+		TerminationDate:   formatOptionalDate(createdEmployee.TerminationDate),
+		TerminationReason: createdEmployee.TerminationReason,
+		CustomFields:      createdEmployee.CustomFields,
+		Tags:              createdEmployee.Tags,
+		CreatedAt:         createdEmployee.CreatedAt.Format(constants.DateTimeFormat),
 	}
 
 	return apiresponse.Success(c, "Employee created successfully", createdEmployeeResponse)
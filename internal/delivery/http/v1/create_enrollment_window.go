@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateEnrollmentWindow godoc
+// @Summary Open an enrollment window
+// @Description Creates a period employees may enroll in benefit plans in, for coverage starting on coverage_starts_on. Windows may not overlap
+// @Tags Benefits
+// @Accept json
+// @Produce json
+// @Param payload body EnrollmentWindowRequest true "Enrollment window payload"
+// @Success 200 {object} EnrollmentWindowResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /benefit-enrollment-windows [post]
+func (h *BenefitHandler) CreateEnrollmentWindow(c echo.Context) error {
+	var req EnrollmentWindowRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":               "Name is required",
+				"starts_on":          "Start date is required",
+				"ends_on":            "End date is required",
+				"coverage_starts_on": "Coverage start date is required",
+			})
+	}
+
+	window, details, err := toEnrollmentWindowEntity(req)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+
+	createdWindow, err := h.benefitUsecase.CreateWindow(c.Request().Context(), window)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating enrollment window: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Enrollment window created successfully", toEnrollmentWindowResponse(createdWindow))
+}
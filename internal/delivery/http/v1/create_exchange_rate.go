@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// CreateExchangeRate godoc
+// @Summary Add an exchange rate
+// @Description Stores the rate between two currencies from an effective date onwards
+// @Tags ExchangeRates
+// @Accept json
+// @Produce json
+// @Param payload body CreateExchangeRateRequest true "Exchange rate payload"
+// @Success 200 {object} ExchangeRateResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /exchange-rates [post]
+func (h *ExchangeRateHandler) CreateExchangeRate(c echo.Context) error {
+	var req CreateExchangeRateRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"base_currency":  "Base currency is required",
+				"quote_currency": "Quote currency is required",
+				"rate":           "Rate is required and must be a positive number",
+				"effective_date": "Effective date is required and must be a valid date",
+			})
+	}
+
+	effectiveDate, err := time.Parse(constants.DateFormat, req.EffectiveDate)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEffectiveDate,
+			map[string]string{
+				"effective_date": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	rate, err := h.exchangeRateUsecase.CreateExchangeRate(c.Request().Context(), &entity.ExchangeRate{
+		BaseCurrency:  req.BaseCurrency,
+		QuoteCurrency: req.QuoteCurrency,
+		Rate:          req.Rate,
+		EffectiveDate: effectiveDate,
+	})
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating exchange rate: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Exchange rate created successfully", toExchangeRateResponse(rate))
+}
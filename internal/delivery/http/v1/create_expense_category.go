@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateExpenseCategory godoc
+// @Summary Create an expense category
+// @Description Creates a category employees claim expenses in, optionally with a per-claim limit and requiring receipts unless receipt_required is false
+// @Tags Expenses
+// @Accept json
+// @Produce json
+// @Param payload body ExpenseCategoryRequest true "Expense category payload"
+// @Success 200 {object} ExpenseCategoryResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /expense-categories [post]
+func (h *ExpenseHandler) CreateExpenseCategory(c echo.Context) error {
+	var req ExpenseCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name": "Name is required and must be at least 2 characters long",
+			})
+	}
+
+	createdCategory, err := h.expenseUsecase.CreateCategory(c.Request().Context(), toExpenseCategoryEntity(req))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating expense category: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Expense category created successfully", toExpenseCategoryResponse(createdCategory))
+}
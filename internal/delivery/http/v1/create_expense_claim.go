@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateExpenseClaim godoc
+// @Summary Create an expense claim
+// @Description Drafts an expense claim with its items, all in the claim's currency. The items of a category may not exceed its limit
+// @Tags Expenses
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param payload body ExpenseClaimRequest true "Expense claim payload"
+// @Success 200 {object} ExpenseClaimResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/expenses [post]
+func (h *ExpenseHandler) CreateExpenseClaim(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req ExpenseClaimRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"title":    "Title is required",
+				"currency": "Currency is required",
+				"items":    "At least one item is required",
+			})
+	}
+
+	claim, details, err := toExpenseClaimEntity(req)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+	claim.EmployeeID = employeeID
+
+	createdClaim, err := h.expenseUsecase.CreateClaim(c.Request().Context(), claim)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating expense claim: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Expense claim created successfully", toExpenseClaimResponse(createdClaim))
+}
@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateGoal godoc
+// @Summary Create a goal
+// @Description Adds a goal with 1 to 10 key results, owned by an employee or, without an employee, by the company. It can be aligned to a company goal or a goal of the owner's manager.
+// @Tags Goals
+// @Accept json
+// @Produce json
+// @Param payload body GoalRequest true "Goal payload"
+// @Success 200 {object} GoalResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /goals [post]
+func (h *GoalHandler) CreateGoal(c echo.Context) error {
+	var req GoalRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"title":       "Title is required and must be at least 3 characters long",
+				"start_date":  "Start date is required, expected format: YYYY-MM-DD",
+				"due_date":    "Due date is required, expected format: YYYY-MM-DD",
+				"key_results": "Between 1 and 10 key results are required",
+			})
+	}
+
+	goal, details, err := toGoalEntity(req)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+
+	createdGoal, err := h.goalUsecase.CreateGoal(c.Request().Context(), goal)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating goal: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Goal created successfully", toGoalResponse(createdGoal))
+}
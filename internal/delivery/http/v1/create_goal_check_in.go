@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateGoalCheckIn godoc
+// @Summary Check in on a goal
+// @Description Records the current value of one of an active goal's key results
+// @Tags Goals
+// @Accept json
+// @Produce json
+// @Param id path int true "Goal ID"
+// @Param payload body GoalCheckInRequest true "Check-in payload"
+// @Success 200 {object} GoalCheckInResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /goals/{id}/check-ins [post]
+func (h *GoalHandler) CreateGoalCheckIn(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidGoalId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req GoalCheckInRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"key_result_id": "Key result ID is required",
+				"value":         "Value is required",
+			})
+	}
+
+	checkIn, err := h.goalUsecase.CreateCheckIn(c.Request().Context(), &entity.GoalCheckIn{
+		GoalID:      id,
+		KeyResultID: req.KeyResultID,
+		Value:       req.Value,
+		Comment:     req.Comment,
+	})
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating goal check-in: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Check-in recorded successfully", toGoalCheckInResponse(checkIn))
+}
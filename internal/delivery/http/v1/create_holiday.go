@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// CreateHoliday godoc
+// @Summary Add a holiday
+// @Description Adds a single holiday to a calendar
+// @Tags Holidays
+// @Accept json
+// @Produce json
+// @Param id path int true "Holiday calendar ID"
+// @Param payload body HolidayRequest true "Holiday payload"
+// @Success 200 {object} HolidayResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /holiday-calendars/{id}/holidays [post]
+func (h *HolidayCalendarHandler) CreateHoliday(c echo.Context) error {
+	calendarID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidHolidayCalendarId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req HolidayRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"date": "Date is required",
+				"name": "Name is required",
+			})
+	}
+
+	date, err := time.Parse(constants.DateFormat, req.Date)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidHoliday,
+			map[string]string{
+				"date": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	holiday, err := h.holidayCalendarUsecase.CreateHoliday(c.Request().Context(), &entity.Holiday{
+		CalendarID: calendarID,
+		Date:       date,
+		Name:       req.Name,
+	})
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating holiday: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Holiday created successfully", toHolidayResponse(holiday))
+}
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateHolidayCalendar godoc
+// @Summary Create a holiday calendar
+// @Description Adds an empty holiday calendar; add holidays one by one or import an iCalendar file
+// @Tags Holidays
+// @Accept json
+// @Produce json
+// @Param payload body HolidayCalendarRequest true "Holiday calendar payload"
+// @Success 200 {object} HolidayCalendarResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /holiday-calendars [post]
+func (h *HolidayCalendarHandler) CreateHolidayCalendar(c echo.Context) error {
+	var req HolidayCalendarRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name": "Name is required and must be at least 3 characters long",
+			})
+	}
+
+	calendar, err := h.holidayCalendarUsecase.CreateHolidayCalendar(c.Request().Context(), toHolidayCalendarEntity(req))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating holiday calendar: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Holiday calendar created successfully", toHolidayCalendarResponse(calendar))
+}
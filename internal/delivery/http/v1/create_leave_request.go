@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// CreateLeaveRequest godoc
+// @Summary Request leave
+// @Description Files a pending leave request. Dates are inclusive and may not overlap another pending or approved request. The request goes to the leave approval workflow when there is one, otherwise the manager decides it.
+// @Tags Leave
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param payload body CreateLeaveRequestRequest true "Leave request payload"
+// @Success 200 {object} LeaveRequestResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/leave-requests [post]
+func (h *LeaveHandler) CreateLeaveRequest(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req CreateLeaveRequestRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"leave_type_id": "Leave type ID is required",
+				"start_date":    "Start date is required and must be a valid date",
+				"end_date":      "End date is required and must be a valid date",
+			})
+	}
+
+	startDate, err := time.Parse(constants.DateFormat, req.StartDate)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidLeaveDates,
+			map[string]string{
+				"start_date": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+	endDate, err := time.Parse(constants.DateFormat, req.EndDate)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidLeaveDates,
+			map[string]string{
+				"end_date": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	request, err := h.leaveUsecase.CreateLeaveRequest(c.Request().Context(), &entity.LeaveRequest{
+		EmployeeID:  employeeID,
+		LeaveTypeID: req.LeaveTypeID,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating leave request: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Leave request created successfully", toLeaveRequestResponse(request))
+}
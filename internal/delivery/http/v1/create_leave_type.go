@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateLeaveType godoc
+// @Summary Create a leave type
+// @Description Adds a leave type with its accrual policy
+// @Tags Leave
+// @Accept json
+// @Produce json
+// @Param payload body LeaveTypeRequest true "Leave type payload"
+// @Success 200 {object} LeaveTypeResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /leave-types [post]
+func (h *LeaveHandler) CreateLeaveType(c echo.Context) error {
+	var req LeaveTypeRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":           "Name is required",
+				"accrual_policy": "Accrual policy must be one of none, annual, monthly",
+			})
+	}
+
+	leaveType, err := h.leaveUsecase.CreateLeaveType(c.Request().Context(), toLeaveTypeEntity(req))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating leave type: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Leave type created successfully", toLeaveTypeResponse(leaveType))
+}
@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateLocation godoc
+// @Summary Create a location
+// @Description Adds an office location, optionally observing a holiday calendar
+// @Tags Locations
+// @Accept json
+// @Produce json
+// @Param payload body LocationRequest true "Location payload"
+// @Success 200 {object} LocationResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /locations [post]
+func (h *LocationHandler) CreateLocation(c echo.Context) error {
+	var req LocationRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name": "Name is required and must be at least 3 characters long",
+			})
+	}
+
+	location, err := h.locationUsecase.CreateLocation(c.Request().Context(), toLocationEntity(req))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating location: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Location created successfully", toLocationResponse(location))
+}
@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// CreatePayrollPeriod godoc
+// @Summary Open a payroll period
+// @Description Adds a monthly payroll period with its pay date
+// @Tags Payroll
+// @Accept json
+// @Produce json
+// @Param payload body PayrollPeriodRequest true "Payroll period payload"
+// @Success 200 {object} PayrollPeriodResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /payroll-periods [post]
+func (h *PayrollHandler) CreatePayrollPeriod(c echo.Context) error {
+	var req PayrollPeriodRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"period":   "Period is required, expected format: YYYY-MM",
+				"pay_date": "Pay date is required, expected format: YYYY-MM-DD",
+			})
+	}
+
+	month, err := time.Parse(constants.MonthFormat, req.Period)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidPayrollPeriod,
+			map[string]string{
+				"period": "Period format is invalid , expected format: YYYY-MM",
+			})
+	}
+	payDate, err := time.Parse(constants.DateFormat, req.PayDate)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidPayrollPeriod,
+			map[string]string{
+				"pay_date": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	period, err := h.payrollUsecase.CreatePayrollPeriod(c.Request().Context(), &entity.PayrollPeriod{
+		Year:    month.Year(),
+		Month:   month.Month(),
+		PayDate: payDate,
+	})
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating payroll period: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Payroll period created successfully", toPayrollPeriodResponse(period))
+}
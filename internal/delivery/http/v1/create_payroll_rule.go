@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreatePayrollRule godoc
+// @Summary Create a payroll rule
+// @Description Adds an earning, deduction or tax applied to every payslip, either as a fixed amount or a percentage
+// @Tags Payroll
+// @Accept json
+// @Produce json
+// @Param payload body PayrollRuleRequest true "Payroll rule payload"
+// @Success 200 {object} PayrollRuleResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /payroll-rules [post]
+func (h *PayrollHandler) CreatePayrollRule(c echo.Context) error {
+	var req PayrollRuleRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":   "Name is required and must be at least 3 characters long",
+				"kind":   "Kind is required, one of earning, deduction or tax",
+				"method": "Method is required, one of fixed or percentage",
+			})
+	}
+
+	rule, err := h.payrollUsecase.CreatePayrollRule(c.Request().Context(), toPayrollRuleEntity(req))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating payroll rule: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Payroll rule created successfully", toPayrollRuleResponse(rule))
+}
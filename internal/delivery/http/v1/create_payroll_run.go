@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreatePayrollRun godoc
+// @Summary Start a payroll run
+// @Description Calculates a draft run of the period with a payslip for every employee employed during it, using current salaries and active payroll rules. Pay is prorated for employees who joined, left or had a salary change during the period. Benefit contributions are deducted, and expense claims approved by the end of the period are paid back unless another run already holds them.
+// @Tags Payroll
+// @Accept json
+// @Produce json
+// @Param payload body PayrollRunRequest true "Payroll run payload"
+// @Success 200 {object} PayrollRunResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /payroll-runs [post]
+func (h *PayrollHandler) CreatePayrollRun(c echo.Context) error {
+	var req PayrollRunRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"payroll_period_id": "Payroll period ID is required and must be a valid number",
+			})
+	}
+
+	run, err := h.payrollUsecase.CreatePayrollRun(c.Request().Context(), req.PayrollPeriodID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating payroll run: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Payroll run created successfully", toPayrollRunResponse(run))
+}
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreatePosition godoc
+// @Summary Create a position
+// @Description Adds a position to the catalog, optionally with a salary band
+// @Tags Positions
+// @Accept json
+// @Produce json
+// @Param payload body PositionRequest true "Position payload"
+// @Success 200 {object} PositionResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /positions [post]
+func (h *PositionHandler) CreatePosition(c echo.Context) error {
+	var req PositionRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"title": "Title is required and must be at least 3 characters long",
+			})
+	}
+
+	position, err := h.positionUsecase.CreatePosition(c.Request().Context(), toPositionEntity(req))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating position: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Position created successfully", toPositionResponse(position))
+}
@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateRequisition godoc
+// @Summary Create a job requisition
+// @Description Opens a requisition to hire for a position. Employees hired through it get its position, department, location and hiring manager.
+// @Tags Recruiting
+// @Accept json
+// @Produce json
+// @Param payload body RequisitionRequest true "Job requisition payload"
+// @Success 200 {object} RequisitionResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /requisitions [post]
+func (h *RecruitingHandler) CreateRequisition(c echo.Context) error {
+	var req RequisitionRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"title":       "Title is required and must be at least 3 characters long",
+				"position_id": "Position ID is required",
+			})
+	}
+
+	requisition, err := h.recruitingUsecase.CreateRequisition(c.Request().Context(), toRequisitionEntity(req))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating job requisition: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Job requisition created successfully", toRequisitionResponse(requisition))
+}
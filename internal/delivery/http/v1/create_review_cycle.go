@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateReviewCycle godoc
+// @Summary Create a review cycle
+// @Description Adds a draft review cycle with its questions, rating scale and the deadlines of the self, peer and manager review stages
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Param payload body ReviewCycleRequest true "Review cycle payload"
+// @Success 200 {object} ReviewCycleResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /review-cycles [post]
+func (h *ReviewHandler) CreateReviewCycle(c echo.Context) error {
+	var req ReviewCycleRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":          "Name is required and must be at least 3 characters long",
+				"period_start":  "Period start is required, expected format: YYYY-MM-DD",
+				"period_end":    "Period end is required, expected format: YYYY-MM-DD",
+				"rating_labels": "Between 2 and 10 rating labels are required",
+				"questions":     "At least one question is required",
+			})
+	}
+
+	cycle, details, err := toReviewCycleEntity(req)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+
+	createdCycle, err := h.reviewUsecase.CreateReviewCycle(c.Request().Context(), cycle)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating review cycle: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Review cycle created successfully", toReviewCycleResponse(createdCycle))
+}
@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// CreateRoster godoc
+// @Summary Roster employees on a shift
+// @Description Assigns employees to a shift template on the selected weekdays of a date range. Shifts may not fall on approved leave, overlap other shifts or leave less than the minimum rest between shifts; when any does, nothing is rostered and the conflicts are listed in the error details. A dry run returns the conflicts without rostering.
+// @Tags Shifts
+// @Accept json
+// @Produce json
+// @Param payload body RosterRequest true "Roster payload"
+// @Success 200 {object} RosterResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /rosters [post]
+func (h *ShiftHandler) CreateRoster(c echo.Context) error {
+	var req RosterRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"shift_template_id": "Shift template ID is required",
+				"employee_ids":      "At least one employee ID is required",
+				"from":              "From date is required and must be a valid date",
+				"to":                "To date is required and must be a valid date",
+			})
+	}
+
+	from, err := time.Parse(constants.DateFormat, req.From)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidDateRange,
+			map[string]string{
+				"from": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+	to, err := time.Parse(constants.DateFormat, req.To)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidDateRange,
+			map[string]string{
+				"to": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	weekdays := []time.Weekday{}
+	for _, weekday := range req.Weekdays {
+		if weekday < 1 || weekday > 7 {
+			return apiresponse.Error(c,
+				appError.ErrInvalidRoster,
+				map[string]string{
+					"weekdays": "Weekdays must be between 1 (Monday) and 7 (Sunday)",
+				})
+		}
+		weekdays = append(weekdays, time.Weekday(weekday%7))
+	}
+
+	result, err := h.shiftUsecase.CreateRoster(c.Request().Context(), &usecase.Roster{
+		ShiftTemplateID: req.ShiftTemplateID,
+		EmployeeIDs:     req.EmployeeIDs,
+		From:            from,
+		To:              to,
+		Weekdays:        weekdays,
+		DryRun:          req.DryRun,
+	})
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating roster: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	if req.DryRun {
+		if len(result.Conflicts) > 0 {
+			return apiresponse.Success(c, "Roster has conflicts", toRosterResponse(result))
+		}
+		return apiresponse.Success(c, "Roster has no conflicts", toRosterResponse(result))
+	}
+	if len(result.Conflicts) > 0 {
+		details := map[string]string{}
+		for _, conflict := range result.Conflicts {
+			key := fmt.Sprintf("employee %d on %s", conflict.EmployeeID, conflict.Date.Format(constants.DateFormat))
+			details[key] = string(conflict.Reason)
+		}
+		return apiresponse.Error(c, appError.ErrRosterConflicts, details)
+	}
+
+	return apiresponse.Success(c, "Roster created successfully", toRosterResponse(result))
+}
@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateSegment godoc
+// @Summary Save an employee segment
+// @Description Saves a named employee list filter anyone can use by its ID. Members are not stored: they are whoever matches the filter when the segment is used
+// @Tags Segments
+// @Accept json
+// @Produce json
+// @Param payload body SegmentRequest true "Segment payload"
+// @Success 200 {object} SegmentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /segments [post]
+func (h *SegmentHandler) CreateSegment(c echo.Context) error {
+	var req SegmentRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":   "Name is required",
+				"filter": "Filter must be an object",
+			})
+	}
+
+	createdSegment, err := h.segmentUsecase.CreateSegment(c.Request().Context(), toSegmentEntity(req))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating segment: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Segment created successfully", toSegmentResponse(createdSegment))
+}
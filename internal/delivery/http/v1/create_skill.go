@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateSkill godoc
+// @Summary Create a skill
+// @Description Adds a skill to a category of the skills taxonomy
+// @Tags Skills
+// @Accept json
+// @Produce json
+// @Param payload body SkillRequest true "Skill payload"
+// @Success 200 {object} SkillResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /skills [post]
+func (h *SkillHandler) CreateSkill(c echo.Context) error {
+	var req SkillRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"category_id": "Category ID is required",
+				"name":        "Name is required",
+			})
+	}
+
+	skill, err := h.skillUsecase.CreateSkill(c.Request().Context(), toSkillEntity(req))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating skill: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Skill created successfully", toSkillResponse(skill))
+}
@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateSkillCategory godoc
+// @Summary Create a skill category
+// @Description Adds a category to the skills taxonomy
+// @Tags Skills
+// @Accept json
+// @Produce json
+// @Param payload body SkillCategoryRequest true "Skill category payload"
+// @Success 200 {object} SkillCategoryResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /skill-categories [post]
+func (h *SkillHandler) CreateSkillCategory(c echo.Context) error {
+	var req SkillCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name": "Name is required and must be at least 2 characters long",
+			})
+	}
+
+	category, err := h.skillUsecase.CreateSkillCategory(c.Request().Context(), &entity.SkillCategory{Name: req.Name})
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating skill category: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Skill category created successfully", toSkillCategoryResponse(category))
+}
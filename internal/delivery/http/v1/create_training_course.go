@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CreateCourse godoc
+// @Summary Create a training course
+// @Description Adds a course employees can be enrolled in. Courses with a certification validity issue a certification on completion.
+// @Tags Training
+// @Accept json
+// @Produce json
+// @Param payload body TrainingCourseRequest true "Training course payload"
+// @Success 200 {object} TrainingCourseResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /training-courses [post]
+func (h *TrainingHandler) CreateCourse(c echo.Context) error {
+	var req TrainingCourseRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name": "Name is required and must be at least 2 characters long",
+			})
+	}
+
+	course, err := h.trainingUsecase.CreateCourse(c.Request().Context(), toTrainingCourseEntity(req))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error creating training course: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Training course created successfully", toTrainingCourseResponse(course))
+}
@@ -0,0 +1,148 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// CustomFieldRequest is the payload for defining a custom employee field. The key and the type of
+// a field cannot change once it is created.
+// swagger:model CustomFieldRequest
+type CustomFieldRequest struct {
+	// Key employees' values are saved under, in snake_case
+	// example: t_shirt_size
+	Key string `json:"key"`
+
+	// example: T-shirt size
+	Label string `json:"label"`
+
+	// One of text, number, boolean, date, select, multi_select
+	// example: select
+	Type string `json:"type"`
+
+	// example: false
+	Required bool `json:"required"`
+
+	// Values a select or multi_select field may take
+	// example: ["S","M","L","XL"]
+	Options []string `json:"options"`
+
+	Validation *CustomFieldValidationDTO `json:"validation"`
+}
+
+// CustomFieldValidationDTO limits the values of a custom field beyond its type.
+// swagger:model CustomFieldValidationDTO
+type CustomFieldValidationDTO struct {
+	// Shortest text allowed
+	// example: 2
+	MinLength *int `json:"min_length,omitempty"`
+
+	// Longest text allowed
+	// example: 40
+	MaxLength *int `json:"max_length,omitempty"`
+
+	// Regular expression the whole text must match
+	// example: ^[A-Z]{2}[0-9]{4}$
+	Pattern string `json:"pattern,omitempty"`
+
+	// Smallest number allowed
+	// example: 0
+	Min *float64 `json:"min,omitempty"`
+
+	// Largest number allowed
+	// example: 100
+	Max *float64 `json:"max,omitempty"`
+}
+
+// CustomFieldResponse represents a custom employee field definition.
+// swagger:model CustomFieldResponse
+type CustomFieldResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: t_shirt_size
+	Key string `json:"key"`
+
+	// example: T-shirt size
+	Label string `json:"label"`
+
+	// example: select
+	Type string `json:"type"`
+
+	// example: false
+	Required bool `json:"required"`
+
+	// example: ["S","M","L","XL"]
+	Options []string `json:"options,omitempty"`
+
+	Validation *CustomFieldValidationDTO `json:"validation,omitempty"`
+
+	// example: 2025-01-06 09:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-01-06 09:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// CustomFieldResponseWrapper wraps StandardResponse with CustomFieldResponse as data.
+// swagger:model CustomFieldResponseWrapper
+type CustomFieldResponseWrapper struct {
+	Success   bool                `json:"success"`
+	Message   string              `json:"message"`
+	Data      CustomFieldResponse `json:"data"`
+	Timestamp string              `json:"timestamp"`
+	RequestID string              `json:"request_id"`
+}
+
+// CustomFieldListResponseWrapper wraps StandardResponse with a list of custom fields.
+// swagger:model CustomFieldListResponseWrapper
+type CustomFieldListResponseWrapper struct {
+	Success   bool                  `json:"success"`
+	Message   string                `json:"message"`
+	Data      []CustomFieldResponse `json:"data"`
+	Timestamp string                `json:"timestamp"`
+	RequestID string                `json:"request_id"`
+}
+
+func toCustomFieldEntity(req CustomFieldRequest) *entity.CustomFieldDefinition {
+	definition := &entity.CustomFieldDefinition{
+		Key:      req.Key,
+		Label:    req.Label,
+		Type:     entity.CustomFieldType(req.Type),
+		Required: req.Required,
+		Options:  req.Options,
+	}
+	if req.Validation != nil {
+		definition.Validation = entity.CustomFieldValidation{
+			MinLength: req.Validation.MinLength,
+			MaxLength: req.Validation.MaxLength,
+			Pattern:   req.Validation.Pattern,
+			Min:       req.Validation.Min,
+			Max:       req.Validation.Max,
+		}
+	}
+	return definition
+}
+
+func toCustomFieldResponse(definition *entity.CustomFieldDefinition) CustomFieldResponse {
+	response := CustomFieldResponse{
+		ID:        definition.ID,
+		Key:       definition.Key,
+		Label:     definition.Label,
+		Type:      string(definition.Type),
+		Required:  definition.Required,
+		Options:   definition.Options,
+		CreatedAt: definition.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt: definition.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+	if rules := definition.Validation; rules != (entity.CustomFieldValidation{}) {
+		response.Validation = &CustomFieldValidationDTO{
+			MinLength: rules.MinLength,
+			MaxLength: rules.MaxLength,
+			Pattern:   rules.Pattern,
+			Min:       rules.Min,
+			Max:       rules.Max,
+		}
+	}
+	return response
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type CustomFieldHandler struct {
+	customFieldUsecase usecase.CustomFieldUsecase
+}
+
+func NewCustomFieldHandler(customFieldUsecase usecase.CustomFieldUsecase) *CustomFieldHandler {
+	return &CustomFieldHandler{customFieldUsecase: customFieldUsecase}
+}
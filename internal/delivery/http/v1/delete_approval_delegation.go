@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteDelegation godoc
+// @Summary Delete an approval delegation
+// @Description Ends a delegation; tasks the delegate has not decided go back to the delegator alone
+// @Tags Approvals
+// @Produce json
+// @Param id path int true "Delegator's employee ID"
+// @Param delegationId path int true "Approval delegation ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/delegations/{delegationId} [delete]
+func (h *ApprovalHandler) DeleteDelegation(c echo.Context) error {
+	delegatorID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	id, err := parseIDParam(c, "delegationId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidApprovalDelegationId,
+			map[string]string{
+				"delegationId": "Approval delegation ID must be a valid number",
+			})
+	}
+
+	if err := h.approvalUsecase.DeleteDelegation(c.Request().Context(), delegatorID, id); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting approval delegation: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Approval delegation deleted successfully")
+}
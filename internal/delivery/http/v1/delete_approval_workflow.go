@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteWorkflow godoc
+// @Summary Delete an approval workflow
+// @Description Removes an approval workflow, so changes of its type no longer need approval. Requests already submitted still go through their tasks.
+// @Tags Approvals
+// @Produce json
+// @Param id path int true "Approval workflow ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /approval-workflows/{id} [delete]
+func (h *ApprovalHandler) DeleteWorkflow(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidApprovalWorkflowId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	if err := h.approvalUsecase.DeleteWorkflow(c.Request().Context(), id); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting approval workflow: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Approval workflow deleted successfully")
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteAsset godoc
+// @Summary Delete an asset
+// @Description Deletes an asset with its assignment history. Assigned assets have to be returned first.
+// @Tags Assets
+// @Produce json
+// @Param id path int true "Asset ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /assets/{id} [delete]
+func (h *AssetHandler) DeleteAsset(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidAssetId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	if err := h.assetUsecase.DeleteAsset(c.Request().Context(), id); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting asset: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Asset deleted successfully")
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteBenefitPlan godoc
+// @Summary Delete a benefit plan
+// @Description Deletes a benefit plan nobody has enrolled in
+// @Tags Benefits
+// @Produce json
+// @Param id path int true "Benefit plan ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /benefit-plans/{id} [delete]
+func (h *BenefitHandler) DeleteBenefitPlan(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidBenefitPlanId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	if err := h.benefitUsecase.DeletePlan(c.Request().Context(), id); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting benefit plan: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Benefit plan deleted successfully")
+}
@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteCandidate godoc
+// @Summary Delete a candidate
+// @Description Deletes a candidate and their interviews
+// @Tags Recruiting
+// @Produce json
+// @Param id path int true "Candidate ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /candidates/{id} [delete]
+func (h *RecruitingHandler) DeleteCandidate(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCandidateId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	if err := h.recruitingUsecase.DeleteCandidate(c.Request().Context(), id); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting candidate: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Candidate deleted successfully")
+}
@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteCertification godoc
+// @Summary Delete a certification
+// @Description Remove a certification with its evidence
+// @Tags Certifications
+// @Produce json
+// @Param id path int true "Certification ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /certifications/{id} [delete]
+func (h *CertificationHandler) DeleteCertification(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCertificationId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	if err := h.certificationUsecase.DeleteCertification(c.Request().Context(), id); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting certification: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Certification deleted successfully")
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteEvidence godoc
+// @Summary Delete certification evidence
+// @Description Removes a file attached to a certification
+// @Tags Certifications
+// @Produce json
+// @Param id path int true "Certification ID"
+// @Param evidenceId path int true "Evidence ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /certifications/{id}/evidence/{evidenceId} [delete]
+func (h *CertificationHandler) DeleteEvidence(c echo.Context) error {
+	certificationID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCertificationId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	id, err := parseIDParam(c, "evidenceId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEvidenceId,
+			map[string]string{
+				"evidenceId": "Evidence ID must be a valid number",
+			})
+	}
+
+	if err := h.certificationUsecase.DeleteEvidence(c.Request().Context(), certificationID, id); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting certification evidence: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Evidence deleted successfully")
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteDepartment godoc
+// @Summary Delete a department
+// @Description Remove a department that no employee is assigned to
+// @Tags Departments
+// @Produce json
+// @Param id path int true "Department ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /departments/{id} [delete]
+func (h *DepartmentHandler) DeleteDepartment(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidDepartment,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	if err := h.departmentUsecase.DeleteDepartment(c.Request().Context(), id); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting department: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Department deleted successfully")
+}
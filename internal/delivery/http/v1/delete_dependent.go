@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteDependent godoc
+// @Summary Delete a dependent
+// @Description Deletes a dependent, who is no longer covered by the employee's enrollments
+// @Tags Benefits
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param dependentId path int true "Dependent ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/dependents/{dependentId} [delete]
+func (h *BenefitHandler) DeleteDependent(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	dependentID, err := parseIDParam(c, "dependentId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidDependentId,
+			map[string]string{
+				"dependentId": "Dependent ID must be a valid number",
+			})
+	}
+
+	if err := h.benefitUsecase.DeleteDependent(c.Request().Context(), employeeID, dependentID); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting dependent: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Dependent deleted successfully")
+}
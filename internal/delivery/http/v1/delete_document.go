@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteDocument godoc
+// @Summary Delete an employee document
+// @Description Removes a document and its stored file
+// @Tags Documents
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param documentId path int true "Document ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/documents/{documentId} [delete]
+func (h *DocumentHandler) DeleteDocument(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	id, err := parseIDParam(c, "documentId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidDocumentId,
+			map[string]string{
+				"documentId": "Document ID must be a valid number",
+			})
+	}
+
+	if err := h.documentUsecase.DeleteDocument(c.Request().Context(), employeeID, id); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting document: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Document deleted successfully")
+}
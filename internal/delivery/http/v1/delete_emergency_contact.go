@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteEmergencyContact godoc
+// @Summary Delete an emergency contact
+// @Description Remove an emergency contact from an employee
+// @Tags EmergencyContacts
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param contactId path int true "Emergency contact ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/emergency-contacts/{contactId} [delete]
+func (h *EmergencyContactHandler) DeleteEmergencyContact(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	contactID, err := parseIDParam(c, "contactId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmergencyContactId,
+			map[string]string{
+				"contactId": "Contact ID must be a valid number",
+			})
+	}
+
+	err = h.emergencyContactUsecase.DeleteEmergencyContact(c.Request().Context(), employeeID, contactID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting emergency contact: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Emergency contact deleted successfully")
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteEmployeeSkill godoc
+// @Summary Remove an employee's skill
+// @Description Removes a skill from an employee's profile
+// @Tags Skills
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param skillId path int true "Skill ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/skills/{skillId} [delete]
+func (h *SkillHandler) DeleteEmployeeSkill(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	skillID, err := parseIDParam(c, "skillId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidSkillId,
+			map[string]string{
+				"skillId": "Skill ID must be a valid number",
+			})
+	}
+
+	if err := h.skillUsecase.DeleteEmployeeSkill(c.Request().Context(), employeeID, skillID); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting employee skill: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Employee skill deleted successfully")
+}
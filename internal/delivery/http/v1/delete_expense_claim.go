@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteExpenseClaim godoc
+// @Summary Delete an expense claim
+// @Description Deletes a draft or rejected claim with its items and receipts
+// @Tags Expenses
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param expenseId path int true "Expense claim ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/expenses/{expenseId} [delete]
+func (h *ExpenseHandler) DeleteExpenseClaim(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	claimID, err := parseIDParam(c, "expenseId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidExpenseClaimId,
+			map[string]string{
+				"expenseId": "Expense claim ID must be a valid number",
+			})
+	}
+
+	if err := h.expenseUsecase.DeleteClaim(c.Request().Context(), employeeID, claimID); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting expense claim: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Expense claim deleted successfully")
+}
@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteExpenseReceipt godoc
+// @Summary Delete a receipt
+// @Description Removes a receipt from a draft or rejected claim
+// @Tags Expenses
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param expenseId path int true "Expense claim ID"
+// @Param receiptId path int true "Receipt ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/expenses/{expenseId}/receipts/{receiptId} [delete]
+func (h *ExpenseHandler) DeleteExpenseReceipt(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	claimID, err := parseIDParam(c, "expenseId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidExpenseClaimId,
+			map[string]string{
+				"expenseId": "Expense claim ID must be a valid number",
+			})
+	}
+	receiptID, err := parseIDParam(c, "receiptId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidExpenseReceiptId,
+			map[string]string{
+				"receiptId": "Receipt ID must be a valid number",
+			})
+	}
+
+	if err := h.expenseUsecase.DeleteReceipt(c.Request().Context(), employeeID, claimID, receiptID); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting receipt: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Receipt deleted successfully")
+}
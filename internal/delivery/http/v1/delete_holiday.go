@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteHoliday godoc
+// @Summary Delete a holiday
+// @Description Remove a holiday from a calendar
+// @Tags Holidays
+// @Produce json
+// @Param id path int true "Holiday calendar ID"
+// @Param holidayId path int true "Holiday ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /holiday-calendars/{id}/holidays/{holidayId} [delete]
+func (h *HolidayCalendarHandler) DeleteHoliday(c echo.Context) error {
+	calendarID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidHolidayCalendarId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	holidayID, err := parseIDParam(c, "holidayId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidHolidayId,
+			map[string]string{
+				"holidayId": "Holiday ID must be a valid number",
+			})
+	}
+
+	if err := h.holidayCalendarUsecase.DeleteHoliday(c.Request().Context(), calendarID, holidayID); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting holiday: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Holiday deleted successfully")
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteHolidayCalendar godoc
+// @Summary Delete a holiday calendar
+// @Description Remove a holiday calendar and its holidays. Calendars still assigned to a location cannot be deleted.
+// @Tags Holidays
+// @Produce json
+// @Param id path int true "Holiday calendar ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /holiday-calendars/{id} [delete]
+func (h *HolidayCalendarHandler) DeleteHolidayCalendar(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidHolidayCalendarId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	if err := h.holidayCalendarUsecase.DeleteHolidayCalendar(c.Request().Context(), id); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting holiday calendar: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Holiday calendar deleted successfully")
+}
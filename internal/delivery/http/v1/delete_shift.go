@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteShift godoc
+// @Summary Remove a shift
+// @Description Takes the employee off a rostered shift
+// @Tags Shifts
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param shiftId path int true "Shift ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/shifts/{shiftId} [delete]
+func (h *ShiftHandler) DeleteShift(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	shiftID, err := parseIDParam(c, "shiftId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidShiftId,
+			map[string]string{
+				"shiftId": "Shift ID must be a valid number",
+			})
+	}
+
+	if err := h.shiftUsecase.DeleteShift(c.Request().Context(), employeeID, shiftID); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting shift: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Shift deleted successfully")
+}
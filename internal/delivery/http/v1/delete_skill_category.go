@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteSkillCategory godoc
+// @Summary Delete a skill category
+// @Description Remove a skill category that has no skills
+// @Tags Skills
+// @Produce json
+// @Param id path int true "Skill category ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /skill-categories/{id} [delete]
+func (h *SkillHandler) DeleteSkillCategory(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidSkillCategory,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	if err := h.skillUsecase.DeleteSkillCategory(c.Request().Context(), id); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting skill category: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Skill category deleted successfully")
+}
@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteTag godoc
+// @Summary Delete a tag
+// @Description Removes a tag from every employee having it. Segments filtering on the tag are left as they are
+// @Tags Tags
+// @Produce json
+// @Param tag path string true "Tag"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /tags/{tag} [delete]
+func (h *SegmentHandler) DeleteTag(c echo.Context) error {
+	if err := h.segmentUsecase.DeleteTag(c.Request().Context(), c.Param("tag")); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error deleting tag: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Tag deleted successfully")
+}
@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// DepartmentRequest is the payload for creating or updating a department.
+// swagger:model DepartmentRequest
+type DepartmentRequest struct {
+	// example: Engineering
+	Name string `json:"name"`
+}
+
+// DepartmentResponse represents a department.
+// swagger:model DepartmentResponse
+type DepartmentResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: Engineering
+	Name string `json:"name"`
+
+	// example: 2025-01-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-01-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// DepartmentResponseWrapper wraps StandardResponse with DepartmentResponse as data.
+// swagger:model DepartmentResponseWrapper
+type DepartmentResponseWrapper struct {
+	Success   bool               `json:"success"`
+	Message   string             `json:"message"`
+	Data      DepartmentResponse `json:"data"`
+	Timestamp string             `json:"timestamp"`
+	RequestID string             `json:"request_id"`
+}
+
+// DepartmentListResponseWrapper wraps StandardResponse with a list of departments.
+// swagger:model DepartmentListResponseWrapper
+type DepartmentListResponseWrapper struct {
+	Success   bool                 `json:"success"`
+	Message   string               `json:"message"`
+	Data      []DepartmentResponse `json:"data"`
+	Timestamp string               `json:"timestamp"`
+	RequestID string               `json:"request_id"`
+}
+
+func toDepartmentEntity(req DepartmentRequest) *entity.Department {
+	return &entity.Department{
+		Name: req.Name,
+	}
+}
+
+func toDepartmentResponse(department *entity.Department) DepartmentResponse {
+	return DepartmentResponse{
+		ID:        department.ID,
+		Name:      department.Name,
+		CreatedAt: department.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt: department.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
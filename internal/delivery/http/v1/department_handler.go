@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type DepartmentHandler struct {
+	departmentUsecase usecase.DepartmentUsecase
+}
+
+func NewDepartmentHandler(departmentUsecase usecase.DepartmentUsecase) *DepartmentHandler {
+	return &DepartmentHandler{departmentUsecase: departmentUsecase}
+}
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// DocumentResponse describes a document kept with an employee.
+// swagger:model DocumentResponse
+type DocumentResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// One of contract, identity, offer_letter or other
+	// example: contract
+	Category string `json:"category"`
+
+	// example: employment-contract.pdf
+	FileName string `json:"file_name"`
+
+	// Detected from the contents
+	// example: application/pdf
+	ContentType string `json:"content_type"`
+
+	// In bytes
+	// example: 245760
+	Size int64 `json:"size"`
+
+	// Hex SHA-256 of the contents
+	// example: 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08
+	Checksum string `json:"checksum_sha256"`
+
+	// example: 2025-03-01 08:00:00
+	UploadedAt string `json:"uploaded_at"`
+}
+
+// DocumentResponseWrapper wraps StandardResponse with DocumentResponse as data.
+// swagger:model DocumentResponseWrapper
+type DocumentResponseWrapper struct {
+	Success   bool             `json:"success"`
+	Message   string           `json:"message"`
+	Data      DocumentResponse `json:"data"`
+	Timestamp string           `json:"timestamp"`
+	RequestID string           `json:"request_id"`
+}
+
+// DocumentListResponseWrapper wraps StandardResponse with a list of documents.
+// swagger:model DocumentListResponseWrapper
+type DocumentListResponseWrapper struct {
+	Success   bool               `json:"success"`
+	Message   string             `json:"message"`
+	Data      []DocumentResponse `json:"data"`
+	Timestamp string             `json:"timestamp"`
+	RequestID string             `json:"request_id"`
+}
+
+func toDocumentResponse(document *entity.EmployeeDocument) DocumentResponse {
+	return DocumentResponse{
+		ID:          document.ID,
+		EmployeeID:  document.EmployeeID,
+		Category:    string(document.Category),
+		FileName:    document.FileName,
+		ContentType: document.ContentType,
+		Size:        document.Size,
+		Checksum:    document.Checksum,
+		UploadedAt:  document.UploadedAt.Format(constants.DateTimeFormat),
+	}
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type DocumentHandler struct {
+	documentUsecase usecase.DocumentUsecase
+}
+
+func NewDocumentHandler(documentUsecase usecase.DocumentUsecase) *DocumentHandler {
+	return &DocumentHandler{documentUsecase: documentUsecase}
+}
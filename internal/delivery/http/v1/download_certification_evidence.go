@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DownloadEvidence godoc
+// @Summary Download certification evidence
+// @Description Returns an evidence file as it was uploaded
+// @Tags Certifications
+// @Produce application/pdf
+// @Produce image/png
+// @Produce image/jpeg
+// @Param id path int true "Certification ID"
+// @Param evidenceId path int true "Evidence ID"
+// @Success 200 {file} file
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /certifications/{id}/evidence/{evidenceId} [get]
+func (h *CertificationHandler) DownloadEvidence(c echo.Context) error {
+	certificationID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCertificationId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	id, err := parseIDParam(c, "evidenceId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEvidenceId,
+			map[string]string{
+				"evidenceId": "Evidence ID must be a valid number",
+			})
+	}
+
+	evidence, err := h.certificationUsecase.GetEvidenceById(c.Request().Context(), certificationID, id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting certification evidence: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename=%q`, evidence.FileName))
+	return c.Blob(http.StatusOK, evidence.ContentType, evidence.Content)
+}
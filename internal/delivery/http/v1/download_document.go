@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DownloadDocument godoc
+// @Summary Download an employee document
+// @Description Streams a document as it was uploaded
+// @Tags Documents
+// @Produce application/octet-stream
+// @Param id path int true "Employee ID"
+// @Param documentId path int true "Document ID"
+// @Success 200 {file} file
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/documents/{documentId} [get]
+func (h *DocumentHandler) DownloadDocument(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	id, err := parseIDParam(c, "documentId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidDocumentId,
+			map[string]string{
+				"documentId": "Document ID must be a valid number",
+			})
+	}
+
+	document, content, err := h.documentUsecase.OpenDocument(c.Request().Context(), employeeID, id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error opening document: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	defer content.Close()
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename=%q`, document.FileName))
+	c.Response().Header().Set(echo.HeaderContentLength, strconv.FormatInt(document.Size, 10))
+	return c.Stream(http.StatusOK, document.ContentType, content)
+}
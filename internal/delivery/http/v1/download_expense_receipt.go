@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DownloadExpenseReceipt godoc
+// @Summary Download a receipt
+// @Description Streams a receipt as it was uploaded
+// @Tags Expenses
+// @Produce application/octet-stream
+// @Param id path int true "Employee ID"
+// @Param expenseId path int true "Expense claim ID"
+// @Param receiptId path int true "Receipt ID"
+// @Success 200 {file} file
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/expenses/{expenseId}/receipts/{receiptId} [get]
+func (h *ExpenseHandler) DownloadExpenseReceipt(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	claimID, err := parseIDParam(c, "expenseId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidExpenseClaimId,
+			map[string]string{
+				"expenseId": "Expense claim ID must be a valid number",
+			})
+	}
+	receiptID, err := parseIDParam(c, "receiptId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidExpenseReceiptId,
+			map[string]string{
+				"receiptId": "Receipt ID must be a valid number",
+			})
+	}
+
+	receipt, content, err := h.expenseUsecase.OpenReceipt(c.Request().Context(), employeeID, claimID, receiptID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error opening receipt: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	defer content.Close()
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename=%q`, receipt.FileName))
+	c.Response().Header().Set(echo.HeaderContentLength, strconv.FormatInt(receipt.Size, 10))
+	return c.Stream(http.StatusOK, receipt.ContentType, content)
+}
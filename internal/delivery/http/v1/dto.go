@@ -7,17 +7,58 @@ type CreateEmployeeRequest struct {
 	// example: John Doe
 	Name string `json:"name"`
 
-	// Job position assigned to the employee
-	// example: Software Engineer
-	Position string `json:"position"`
+	// ID of the position in the positions catalog
+	// example: 1
+	PositionID int `json:"position_id"`
+
+	// ID of the employee this employee reports to
+	// example: 7
+	ManagerID *int `json:"manager_id"`
+
+	// ID of the office location whose holiday calendar applies to the employee
+	// example: 2
+	LocationID *int `json:"location_id"`
+
+	// example: 3
+	DepartmentID *int `json:"department_id"`
+
+	// Salary of the employee per pay period, in minor currency units
+	Salary MoneyDTO `json:"salary"`
 
-	// Monthly salary of the employee
-	// example: 60000
-	Salary int `json:"salary"`
+	// One of monthly, annual, hourly (default monthly)
+	// example: monthly
+	PayPeriod string `json:"pay_period"`
 
 	// Date when the employee was hired (YYYY-MM-DD)
 	// example: 2024-01-15
 	HiredDate string `json:"hired_date"`
+
+	// Work email, unique across employees
+	// example: john.doe@company.com
+	WorkEmail string `json:"work_email"`
+
+	// Personal email, unique across employees
+	// example: john.doe@gmail.com
+	PersonalEmail string `json:"personal_email"`
+
+	// Phone number in E.164 format
+	// example: +14155552671
+	Phone string `json:"phone"`
+
+	// Postal address
+	Address *AddressDTO `json:"address"`
+
+	// Date of birth (YYYY-MM-DD)
+	// example: 1990-05-20
+	DateOfBirth string `json:"date_of_birth"`
+
+	// Values of custom fields by key, required ones included. Dates are YYYY-MM-DD, multi_select
+	// values are arrays of options.
+	CustomFields map[string]any `json:"custom_fields"`
+
+	// Initial employment status: candidate or active (default active)
+	// example: active
+	Status string `json:"status"`
 }
 
 // CreateEmployeeResponse represents the response returned after creating an employee.
@@ -30,15 +71,62 @@ type CreateEmployeeResponse struct {
 	// example: John Doe
 	Name string `json:"name"`
 
+	// example: 1
+	PositionID int `json:"position_id"`
+
 	// example: Software Engineer
 	Position string `json:"position"`
 
-	// example: 60000
-	Salary int `json:"salary"`
+	Salary MoneyDTO `json:"salary"`
+
+	// example: monthly
+	PayPeriod string `json:"pay_period"`
+
+	// True when the salary was saved outside the position's band
+	// example: false
+	SalaryOutOfBand bool `json:"salary_out_of_band"`
+
+	// example: 7
+	ManagerID *int `json:"manager_id,omitempty"`
+
+	// example: 2
+	LocationID *int `json:"location_id,omitempty"`
+
+	// example: 3
+	DepartmentID *int `json:"department_id,omitempty"`
 
 	// example: 2024-01-15
 	HiredDate string `json:"hired_date"`
 
+	// example: john.doe@company.com
+	WorkEmail string `json:"work_email,omitempty"`
+
+	// example: john.doe@gmail.com
+	PersonalEmail string `json:"personal_email,omitempty"`
+
+	// example: +14155552671
+	Phone string `json:"phone,omitempty"`
+
+	Address *AddressDTO `json:"address,omitempty"`
+
+	// example: 1990-05-20
+	DateOfBirth string `json:"date_of_birth,omitempty"`
+
+	// example: active
+	Status string `json:"status"`
+
+	// example: 2025-06-30
+	TerminationDate string `json:"termination_date,omitempty"`
+
+	// example: Resigned
+	TerminationReason string `json:"termination_reason,omitempty"`
+
+	// Values of custom fields by key
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+
+	// example: ["new hire","remote"]
+	Tags []string `json:"tags,omitempty"`
+
 	// example: 2024-01-15T10:30:00Z
 	CreatedAt string `json:"created_at"`
 }
@@ -60,15 +148,66 @@ type GetEmployeeByIdResponse struct {
 	// example: John Doe
 	Name string `json:"name"`
 
+	// example: 1
+	PositionID int `json:"position_id"`
+
 	// example: Software Engineer
 	Position string `json:"position"`
 
-	// example: 60000
-	Salary int `json:"salary"`
+	Salary MoneyDTO `json:"salary"`
+
+	// example: monthly
+	PayPeriod string `json:"pay_period"`
+
+	// True when the salary was saved outside the position's band
+	// example: false
+	SalaryOutOfBand bool `json:"salary_out_of_band"`
+
+	// example: 7
+	ManagerID *int `json:"manager_id,omitempty"`
+
+	// example: 2
+	LocationID *int `json:"location_id,omitempty"`
+
+	// example: 3
+	DepartmentID *int `json:"department_id,omitempty"`
 
 	// example: 2024-01-15
 	HiredDate string `json:"hired_date"`
 
+	// example: john.doe@company.com
+	WorkEmail string `json:"work_email,omitempty"`
+
+	// example: john.doe@gmail.com
+	PersonalEmail string `json:"personal_email,omitempty"`
+
+	// example: +14155552671
+	Phone string `json:"phone,omitempty"`
+
+	Address *AddressDTO `json:"address,omitempty"`
+
+	// example: 1990-05-20
+	DateOfBirth string `json:"date_of_birth,omitempty"`
+
+	// example: active
+	Status string `json:"status"`
+
+	// example: 2025-06-30
+	TerminationDate string `json:"termination_date,omitempty"`
+
+	// example: Resigned
+	TerminationReason string `json:"termination_reason,omitempty"`
+
+	// Values of custom fields by key
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+
+	// example: ["new hire","remote"]
+	Tags []string `json:"tags,omitempty"`
+
+	// Company assets the employee still holds, only returned on termination
+	// example: 2
+	UnreturnedAssets int `json:"unreturned_assets,omitempty"`
+
 	// example: 2024-01-15T10:30:00Z
 	CreatedAt string `json:"created_at"`
 }
@@ -82,15 +221,62 @@ type GetAllEmployeesResponse struct {
 	// example: John Doe
 	Name string `json:"name"`
 
+	// example: 1
+	PositionID int `json:"position_id"`
+
 	// example: Software Engineer
 	Position string `json:"position"`
 
-	// example: 60000
-	Salary int `json:"salary"`
+	Salary MoneyDTO `json:"salary"`
+
+	// example: monthly
+	PayPeriod string `json:"pay_period"`
+
+	// True when the salary was saved outside the position's band
+	// example: false
+	SalaryOutOfBand bool `json:"salary_out_of_band"`
+
+	// example: 7
+	ManagerID *int `json:"manager_id,omitempty"`
+
+	// example: 2
+	LocationID *int `json:"location_id,omitempty"`
+
+	// example: 3
+	DepartmentID *int `json:"department_id,omitempty"`
 
 	// example: 2024-01-15
 	HiredDate string `json:"hired_date"`
 
+	// example: john.doe@company.com
+	WorkEmail string `json:"work_email,omitempty"`
+
+	// example: john.doe@gmail.com
+	PersonalEmail string `json:"personal_email,omitempty"`
+
+	// example: +14155552671
+	Phone string `json:"phone,omitempty"`
+
+	Address *AddressDTO `json:"address,omitempty"`
+
+	// example: 1990-05-20
+	DateOfBirth string `json:"date_of_birth,omitempty"`
+
+	// example: active
+	Status string `json:"status"`
+
+	// example: 2025-06-30
+	TerminationDate string `json:"termination_date,omitempty"`
+
+	// example: Resigned
+	TerminationReason string `json:"termination_reason,omitempty"`
+
+	// Values of custom fields by key
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+
+	// example: ["new hire","remote"]
+	Tags []string `json:"tags,omitempty"`
+
 	// example: 2024-01-15T10:30:00Z
 	CreatedAt string `json:"created_at"`
 }
@@ -101,14 +287,53 @@ type UpdateEmployeeRequest struct {
 	// example: John Doe Updated
 	Name string `json:"name"`
 
-	// example: Senior Software Engineer
-	Position string `json:"position"`
+	// example: 2
+	PositionID int `json:"position_id"`
+
+	// ID of the employee this employee reports to, omit for none
+	// example: 7
+	ManagerID *int `json:"manager_id"`
+
+	// ID of the employee's office location, omit for none
+	// example: 2
+	LocationID *int `json:"location_id"`
+
+	// ID of the employee's department, omit for none
+	// example: 3
+	DepartmentID *int `json:"department_id"`
+
+	// Current salary of the employee, changes go through the compensation endpoint
+	Salary MoneyDTO `json:"salary"`
 
-	// example: 75000
-	Salary int `json:"salary"`
+	// Current pay period of the employee, empty keeps it
+	// example: monthly
+	PayPeriod string `json:"pay_period"`
 
 	// example: 2024-01-15
 	HiredDate string `json:"hired_date"`
+
+	// Work email, unique across employees
+	// example: john.doe@company.com
+	WorkEmail string `json:"work_email"`
+
+	// Personal email, unique across employees
+	// example: john.doe@gmail.com
+	PersonalEmail string `json:"personal_email"`
+
+	// Phone number in E.164 format
+	// example: +14155552671
+	Phone string `json:"phone"`
+
+	// Postal address
+	Address *AddressDTO `json:"address"`
+
+	// Date of birth (YYYY-MM-DD)
+	// example: 1990-05-20
+	DateOfBirth string `json:"date_of_birth"`
+
+	// Values of custom fields by key, required ones included. Dates are YYYY-MM-DD, multi_select
+	// values are arrays of options.
+	CustomFields map[string]any `json:"custom_fields"`
 }
 
 // UpdateEmployeeResponse returned after updating an employee.
@@ -120,15 +345,95 @@ type UpdateEmployeeResponse struct {
 	// example: John Doe Updated
 	Name string `json:"name"`
 
+	// example: 2
+	PositionID int `json:"position_id"`
+
 	// example: Senior Software Engineer
 	Position string `json:"position"`
 
-	// example: 75000
-	Salary int `json:"salary"`
+	Salary MoneyDTO `json:"salary"`
+
+	// example: monthly
+	PayPeriod string `json:"pay_period"`
+
+	// True when the salary was saved outside the position's band
+	// example: false
+	SalaryOutOfBand bool `json:"salary_out_of_band"`
+
+	// example: 7
+	ManagerID *int `json:"manager_id,omitempty"`
+
+	// example: 2
+	LocationID *int `json:"location_id,omitempty"`
+
+	// example: 3
+	DepartmentID *int `json:"department_id,omitempty"`
 
 	// example: 2024-01-15
 	HiredDate string `json:"hired_date"`
 
+	// example: john.doe@company.com
+	WorkEmail string `json:"work_email,omitempty"`
+
+	// example: john.doe@gmail.com
+	PersonalEmail string `json:"personal_email,omitempty"`
+
+	// example: +14155552671
+	Phone string `json:"phone,omitempty"`
+
+	Address *AddressDTO `json:"address,omitempty"`
+
+	// example: 1990-05-20
+	DateOfBirth string `json:"date_of_birth,omitempty"`
+
+	// example: active
+	Status string `json:"status"`
+
+	// example: 2025-06-30
+	TerminationDate string `json:"termination_date,omitempty"`
+
+	// example: Resigned
+	TerminationReason string `json:"termination_reason,omitempty"`
+
+	// Values of custom fields by key
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+
+	// example: ["new hire","remote"]
+	Tags []string `json:"tags,omitempty"`
+
 	// example: 2024-02-01T12:00:00Z
 	UpdatedAt string `json:"updated_at"`
 }
+
+// AddressDTO is a structured postal address.
+// swagger:model AddressDTO
+type AddressDTO struct {
+	// example: 221B Baker Street
+	Line1 string `json:"line1"`
+
+	// example: Flat 2
+	Line2 string `json:"line2,omitempty"`
+
+	// example: London
+	City string `json:"city"`
+
+	// example: Greater London
+	State string `json:"state,omitempty"`
+
+	// example: NW1 6XE
+	PostalCode string `json:"postal_code"`
+
+	// ISO 3166-1 alpha-2 country code
+	// example: GB
+	Country string `json:"country"`
+}
+
+// MoneyDTO is an amount in the minor units of an ISO 4217 currency, e.g. 6000000 USD = 60,000.00 USD.
+// swagger:model MoneyDTO
+type MoneyDTO struct {
+	// example: 6000000
+	Amount int64 `json:"amount"`
+
+	// example: USD
+	Currency string `json:"currency"`
+}
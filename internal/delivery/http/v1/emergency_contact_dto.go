@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// EmergencyContactRequest is the payload for creating or updating an emergency contact.
+// swagger:model EmergencyContactRequest
+type EmergencyContactRequest struct {
+	// example: Jane Doe
+	Name string `json:"name"`
+
+	// example: Spouse
+	Relationship string `json:"relationship"`
+
+	// Phone number in E.164 format
+	// example: +14155552672
+	Phone string `json:"phone"`
+
+	// example: jane.doe@gmail.com
+	Email string `json:"email"`
+
+	// Marks this contact as the primary one; other contacts lose the flag
+	// example: true
+	IsPrimary bool `json:"is_primary"`
+}
+
+// EmergencyContactResponse represents an emergency contact of an employee.
+// swagger:model EmergencyContactResponse
+type EmergencyContactResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: Jane Doe
+	Name string `json:"name"`
+
+	// example: Spouse
+	Relationship string `json:"relationship"`
+
+	// example: +14155552672
+	Phone string `json:"phone"`
+
+	// example: jane.doe@gmail.com
+	Email string `json:"email,omitempty"`
+
+	// example: true
+	IsPrimary bool `json:"is_primary"`
+
+	// example: 2024-01-15 10:30:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2024-01-15 10:30:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// EmergencyContactResponseWrapper wraps StandardResponse with EmergencyContactResponse as data.
+// swagger:model EmergencyContactResponseWrapper
+type EmergencyContactResponseWrapper struct {
+	Success   bool                     `json:"success"`
+	Message   string                   `json:"message"`
+	Data      EmergencyContactResponse `json:"data"`
+	Timestamp string                   `json:"timestamp"`
+	RequestID string                   `json:"request_id"`
+}
+
+// EmergencyContactListResponseWrapper wraps StandardResponse with a list of emergency contacts.
+// swagger:model EmergencyContactListResponseWrapper
+type EmergencyContactListResponseWrapper struct {
+	Success   bool                       `json:"success"`
+	Message   string                     `json:"message"`
+	Data      []EmergencyContactResponse `json:"data"`
+	Timestamp string                     `json:"timestamp"`
+	RequestID string                     `json:"request_id"`
+}
+
+func toEmergencyContactResponse(contact *entity.EmergencyContact) EmergencyContactResponse {
+	return EmergencyContactResponse{
+		ID:           contact.ID,
+		EmployeeID:   contact.EmployeeID,
+		Name:         contact.Name,
+		Relationship: contact.Relationship,
+		Phone:        contact.Phone,
+		Email:        contact.Email,
+		IsPrimary:    contact.IsPrimary,
+		CreatedAt:    contact.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:    contact.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
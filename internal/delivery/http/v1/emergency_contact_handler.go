@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type EmergencyContactHandler struct {
+	emergencyContactUsecase usecase.EmergencyContactUsecase
+}
+
+func NewEmergencyContactHandler(emergencyContactUsecase usecase.EmergencyContactUsecase) *EmergencyContactHandler {
+	return &EmergencyContactHandler{emergencyContactUsecase: emergencyContactUsecase}
+}
@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// ChangeEmploymentStatusRequest moves an employee to another non-terminal status.
+// swagger:model ChangeEmploymentStatusRequest
+type ChangeEmploymentStatusRequest struct {
+	// One of candidate, active, on_leave, suspended
+	// example: suspended
+	Status string `json:"status"`
+
+	// Date the new status takes effect (YYYY-MM-DD)
+	// example: 2024-03-01
+	EffectiveDate string `json:"effective_date"`
+
+	// example: Pending investigation
+	Reason string `json:"reason"`
+}
+
+// TerminateEmployeeRequest is the payload for terminating an employee.
+// swagger:model TerminateEmployeeRequest
+type TerminateEmployeeRequest struct {
+	// Last day of employment (YYYY-MM-DD)
+	// example: 2024-06-30
+	TerminationDate string `json:"termination_date"`
+
+	// example: Resigned
+	Reason string `json:"reason"`
+
+	// Employee asking for the termination, recorded on the approval request when terminations
+	// need approval
+	// example: 7
+	RequestedBy *int `json:"requested_by"`
+}
+
+// RehireEmployeeRequest is the payload for rehiring a terminated employee.
+// swagger:model RehireEmployeeRequest
+type RehireEmployeeRequest struct {
+	// First day of the new employment (YYYY-MM-DD)
+	// example: 2025-01-06
+	RehireDate string `json:"rehire_date"`
+
+	// example: Returning after sabbatical
+	Reason string `json:"reason"`
+}
+
+// EmploymentStatusChangeResponse is one entry of an employee's status history.
+// swagger:model EmploymentStatusChangeResponse
+type EmploymentStatusChangeResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// Empty for the first entry
+	// example: active
+	FromStatus string `json:"from_status,omitempty"`
+
+	// example: terminated
+	ToStatus string `json:"to_status"`
+
+	// example: 2024-06-30
+	EffectiveDate string `json:"effective_date"`
+
+	// example: Resigned
+	Reason string `json:"reason,omitempty"`
+
+	// example: 2024-06-01 10:30:00
+	CreatedAt string `json:"created_at"`
+}
+
+// EmploymentStatusHistoryResponseWrapper wraps StandardResponse with the status history.
+// swagger:model EmploymentStatusHistoryResponseWrapper
+type EmploymentStatusHistoryResponseWrapper struct {
+	Success   bool                             `json:"success"`
+	Message   string                           `json:"message"`
+	Data      []EmploymentStatusChangeResponse `json:"data"`
+	Timestamp string                           `json:"timestamp"`
+	RequestID string                           `json:"request_id"`
+}
+
+func toEmploymentStatusChangeResponse(change *entity.EmploymentStatusChange) EmploymentStatusChangeResponse {
+	return EmploymentStatusChangeResponse{
+		ID:            change.ID,
+		FromStatus:    string(change.FromStatus),
+		ToStatus:      string(change.ToStatus),
+		EffectiveDate: change.EffectiveDate.Format(constants.DateFormat),
+		Reason:        change.Reason,
+		CreatedAt:     change.CreatedAt.Format(constants.DateTimeFormat),
+	}
+}
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// EndBenefitEnrollment godoc
+// @Summary End a benefit enrollment
+// @Description Ends coverage that has started at the end of the current month, the last month its contributions are deducted for
+// @Tags Benefits
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param enrollmentId path int true "Enrollment ID"
+// @Success 200 {object} BenefitEnrollmentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/benefits/{enrollmentId}/end [post]
+func (h *BenefitHandler) EndBenefitEnrollment(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	enrollmentID, err := parseIDParam(c, "enrollmentId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidBenefitEnrollmentId,
+			map[string]string{
+				"enrollmentId": "Enrollment ID must be a valid number",
+			})
+	}
+
+	enrollment, err := h.benefitUsecase.EndEnrollment(c.Request().Context(), employeeID, enrollmentID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error ending benefit enrollment: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Benefit enrollment ended successfully", toBenefitEnrollmentResponse(enrollment))
+}
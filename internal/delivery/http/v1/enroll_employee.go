@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// EnrollEmployee godoc
+// @Summary Enroll an employee in a course
+// @Description Enrolls an employee in a training course, optionally with a due date. An employee can only have one open enrollment per course.
+// @Tags Training
+// @Accept json
+// @Produce json
+// @Param id path int true "Training course ID"
+// @Param payload body EnrollmentRequest true "Enrollment payload"
+// @Success 200 {object} EnrollmentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /training-courses/{id}/enrollments [post]
+func (h *TrainingHandler) EnrollEmployee(c echo.Context) error {
+	courseID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidTrainingCourseId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req EnrollmentRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"employee_id": "Employee ID is required",
+			})
+	}
+
+	enrolledOn, err := parseOptionalDate(req.EnrolledOn)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEnrollment,
+			map[string]string{
+				"enrolled_on": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+	dueOn, err := parseOptionalDate(req.DueOn)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEnrollment,
+			map[string]string{
+				"due_on": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	enrollment := &entity.TrainingEnrollment{
+		CourseID:   courseID,
+		EmployeeID: req.EmployeeID,
+		DueOn:      dueOn,
+	}
+	if enrolledOn != nil {
+		enrollment.EnrolledOn = *enrolledOn
+	}
+
+	createdEnrollment, err := h.trainingUsecase.EnrollEmployee(c.Request().Context(), enrollment)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error enrolling employee: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Employee enrolled successfully", toEnrollmentResponse(createdEnrollment))
+}
@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// EnrollInBenefit godoc
+// @Summary Enroll an employee in a benefit plan
+// @Description Enrolls an employee in a benefit plan they are eligible for, with the dependents to cover. Coverage starts with the open enrollment window's coverage, or today for employees hired in the last 30 days. Employees hold one plan of each type other than other
+// @Tags Benefits
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param payload body BenefitEnrollmentRequest true "Enrollment payload"
+// @Success 200 {object} BenefitEnrollmentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/benefits [post]
+func (h *BenefitHandler) EnrollInBenefit(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req BenefitEnrollmentRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"plan_id": "Plan ID is required",
+			})
+	}
+
+	enrollment := &entity.BenefitEnrollment{
+		EmployeeID:   employeeID,
+		PlanID:       req.PlanID,
+		DependentIDs: req.DependentIDs,
+	}
+
+	createdEnrollment, err := h.benefitUsecase.Enroll(c.Request().Context(), enrollment)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error enrolling in benefit: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Enrolled in benefit plan successfully", toBenefitEnrollmentResponse(createdEnrollment))
+}
@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// CreateExchangeRateRequest is the payload for storing an exchange rate.
+// swagger:model CreateExchangeRateRequest
+type CreateExchangeRateRequest struct {
+	// example: USD
+	BaseCurrency string `json:"base_currency"`
+
+	// example: EUR
+	QuoteCurrency string `json:"quote_currency"`
+
+	// Units of the quote currency worth one unit of the base currency
+	// example: 0.92
+	Rate float64 `json:"rate"`
+
+	// example: 2025-01-01
+	EffectiveDate string `json:"effective_date"`
+}
+
+// ExchangeRateResponse represents a stored exchange rate.
+// swagger:model ExchangeRateResponse
+type ExchangeRateResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: USD
+	BaseCurrency string `json:"base_currency"`
+
+	// example: EUR
+	QuoteCurrency string `json:"quote_currency"`
+
+	// example: 0.92
+	Rate float64 `json:"rate"`
+
+	// example: 2025-01-01
+	EffectiveDate string `json:"effective_date"`
+
+	// example: 2025-01-01 08:00:00
+	CreatedAt string `json:"created_at"`
+}
+
+// SalaryConversionResponse is an employee's salary converted into another currency.
+// swagger:model SalaryConversionResponse
+type SalaryConversionResponse struct {
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	Salary MoneyDTO `json:"salary"`
+
+	// example: monthly
+	PayPeriod string `json:"pay_period"`
+
+	Converted MoneyDTO `json:"converted"`
+
+	// example: 0.92
+	Rate float64 `json:"rate"`
+
+	// Effective date of the newest rate used
+	// example: 2025-01-01
+	RateDate string `json:"rate_date"`
+}
+
+// ExchangeRateResponseWrapper wraps StandardResponse with ExchangeRateResponse as data.
+// swagger:model ExchangeRateResponseWrapper
+type ExchangeRateResponseWrapper struct {
+	Success   bool                 `json:"success"`
+	Message   string               `json:"message"`
+	Data      ExchangeRateResponse `json:"data"`
+	Timestamp string               `json:"timestamp"`
+	RequestID string               `json:"request_id"`
+}
+
+// ExchangeRateListResponseWrapper wraps StandardResponse with a list of exchange rates.
+// swagger:model ExchangeRateListResponseWrapper
+type ExchangeRateListResponseWrapper struct {
+	Success   bool                   `json:"success"`
+	Message   string                 `json:"message"`
+	Data      []ExchangeRateResponse `json:"data"`
+	Timestamp string                 `json:"timestamp"`
+	RequestID string                 `json:"request_id"`
+}
+
+// SalaryConversionResponseWrapper wraps StandardResponse with SalaryConversionResponse as data.
+// swagger:model SalaryConversionResponseWrapper
+type SalaryConversionResponseWrapper struct {
+	Success   bool                     `json:"success"`
+	Message   string                   `json:"message"`
+	Data      SalaryConversionResponse `json:"data"`
+	Timestamp string                   `json:"timestamp"`
+	RequestID string                   `json:"request_id"`
+}
+
+func toExchangeRateResponse(rate *entity.ExchangeRate) ExchangeRateResponse {
+	return ExchangeRateResponse{
+		ID:            rate.ID,
+		BaseCurrency:  rate.BaseCurrency,
+		QuoteCurrency: rate.QuoteCurrency,
+		Rate:          rate.Rate,
+		EffectiveDate: rate.EffectiveDate.Format(constants.DateFormat),
+		CreatedAt:     rate.CreatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toSalaryConversionResponse(conversion *usecase.SalaryConversion) SalaryConversionResponse {
+	return SalaryConversionResponse{
+		EmployeeID: conversion.EmployeeID,
+		Salary:     toMoneyDTO(conversion.Salary),
+		PayPeriod:  string(conversion.PayPeriod),
+		Converted:  toMoneyDTO(conversion.Converted),
+		Rate:       conversion.Rate,
+		RateDate:   conversion.RateDate.Format(constants.DateFormat),
+	}
+}
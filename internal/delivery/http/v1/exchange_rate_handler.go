@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type ExchangeRateHandler struct {
+	exchangeRateUsecase usecase.ExchangeRateUsecase
+}
+
+func NewExchangeRateHandler(exchangeRateUsecase usecase.ExchangeRateUsecase) *ExchangeRateHandler {
+	return &ExchangeRateHandler{exchangeRateUsecase: exchangeRateUsecase}
+}
@@ -0,0 +1,415 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// ExpenseCategoryRequest is the payload for creating or updating an expense category.
+// swagger:model ExpenseCategoryRequest
+type ExpenseCategoryRequest struct {
+	// example: Travel
+	Name string `json:"name"`
+
+	// example: Flights, trains and taxis
+	Description string `json:"description"`
+
+	// Most a single claim may hold of the category; omit for no limit
+	Limit *MoneyDTO `json:"limit"`
+
+	// Whether claims with expenses of the category need a receipt; defaults to true
+	// example: true
+	ReceiptRequired *bool `json:"receipt_required"`
+}
+
+// ExpenseCategoryResponse represents an expense category.
+// swagger:model ExpenseCategoryResponse
+type ExpenseCategoryResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: Travel
+	Name string `json:"name"`
+
+	// example: Flights, trains and taxis
+	Description string `json:"description,omitempty"`
+
+	Limit *MoneyDTO `json:"limit,omitempty"`
+
+	// example: true
+	ReceiptRequired bool `json:"receipt_required"`
+
+	// example: 2025-01-06 09:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-01-06 09:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ExpenseItemRequest is one expense of a claim.
+// swagger:model ExpenseItemRequest
+type ExpenseItemRequest struct {
+	// example: 1
+	CategoryID int `json:"category_id"`
+
+	// example: 2025-03-12
+	ExpenseDate string `json:"expense_date"`
+
+	// example: Taxi to the airport
+	Description string `json:"description"`
+
+	// Amount in minor units of the claim's currency
+	// example: 4550
+	Amount int64 `json:"amount"`
+}
+
+// ExpenseClaimRequest is the payload for creating or updating an expense claim.
+// swagger:model ExpenseClaimRequest
+type ExpenseClaimRequest struct {
+	// example: Customer visit in Berlin
+	Title string `json:"title"`
+
+	// Currency of all items
+	// example: EUR
+	Currency string `json:"currency"`
+
+	Items []ExpenseItemRequest `json:"items"`
+}
+
+// ExpenseDecisionRequest is the payload for approving or rejecting an expense claim.
+// swagger:model ExpenseDecisionRequest
+type ExpenseDecisionRequest struct {
+	// ID of the deciding employee; must be the claimant's manager when one is set
+	// example: 7
+	ApproverID int `json:"approver_id"`
+
+	// example: Fine, thanks
+	Comment string `json:"comment"`
+}
+
+// ExpenseReimbursementRequest is the payload for marking an expense claim reimbursed.
+// swagger:model ExpenseReimbursementRequest
+type ExpenseReimbursementRequest struct {
+	// Defaults to today
+	// example: 2025-03-31
+	ReimbursedOn string `json:"reimbursed_on"`
+
+	// Payroll run paying the claim back, if any
+	// example: 3
+	PayrollRunID *int `json:"payroll_run_id"`
+
+	// example: TRX-2025-0331-17
+	Reference string `json:"reference"`
+}
+
+// ExpenseItemResponse is one expense of a claim.
+// swagger:model ExpenseItemResponse
+type ExpenseItemResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	CategoryID int `json:"category_id"`
+
+	// example: Travel
+	CategoryName string `json:"category_name"`
+
+	// example: 2025-03-12
+	ExpenseDate string `json:"expense_date"`
+
+	// example: Taxi to the airport
+	Description string `json:"description"`
+
+	Amount MoneyDTO `json:"amount"`
+}
+
+// ExpenseClaimResponse represents an expense claim. Items are only included for single claims.
+// swagger:model ExpenseClaimResponse
+type ExpenseClaimResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: John Doe
+	EmployeeName string `json:"employee_name"`
+
+	// example: Customer visit in Berlin
+	Title string `json:"title"`
+
+	// One of draft, submitted, approved, rejected, reimbursed
+	// example: approved
+	Status string `json:"status"`
+
+	Total MoneyDTO `json:"total"`
+
+	Items []ExpenseItemResponse `json:"items,omitempty"`
+
+	// example: 2
+	ReceiptCount int `json:"receipt_count"`
+
+	// example: 2025-03-14 10:00:00
+	SubmittedAt string `json:"submitted_at,omitempty"`
+
+	// Approval request deciding the claim, when an approval workflow decides it
+	// example: 12
+	ApprovalRequestID *int `json:"approval_request_id,omitempty"`
+
+	// example: 7
+	DecidedBy *int `json:"decided_by,omitempty"`
+
+	// example: 2025-03-15 09:00:00
+	DecidedAt string `json:"decided_at,omitempty"`
+
+	// example: Fine, thanks
+	DecisionComment string `json:"decision_comment,omitempty"`
+
+	// example: 2025-03-31
+	ReimbursedOn string `json:"reimbursed_on,omitempty"`
+
+	// example: 3
+	PayrollRunID *int `json:"payroll_run_id,omitempty"`
+
+	// example: TRX-2025-0331-17
+	ReimbursementReference string `json:"reimbursement_reference,omitempty"`
+
+	// example: 2025-03-13 18:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-03-15 09:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ExpenseReceiptResponse is the metadata of a receipt; the file is downloaded separately.
+// swagger:model ExpenseReceiptResponse
+type ExpenseReceiptResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	ClaimID int `json:"claim_id"`
+
+	// example: taxi.pdf
+	FileName string `json:"file_name"`
+
+	// example: application/pdf
+	ContentType string `json:"content_type"`
+
+	// example: 48213
+	Size int64 `json:"size"`
+
+	// Hex SHA-256 of the contents
+	// example: 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08
+	Checksum string `json:"checksum"`
+
+	// example: 2025-03-13 18:05:00
+	UploadedAt string `json:"uploaded_at"`
+}
+
+// ExpenseReportRowResponse totals a department's approved and reimbursed expenses in a month.
+// swagger:model ExpenseReportRowResponse
+type ExpenseReportRowResponse struct {
+	// Empty for employees without a department
+	// example: 2
+	DepartmentID *int `json:"department_id,omitempty"`
+
+	// example: Sales
+	DepartmentName string `json:"department_name,omitempty"`
+
+	// Month, as its first day
+	// example: 2025-03-01
+	Period string `json:"period"`
+
+	// example: 4
+	ClaimCount int `json:"claim_count"`
+
+	// Total in the reporting currency
+	Total MoneyDTO `json:"total"`
+}
+
+// ExpenseCategoryResponseWrapper wraps StandardResponse with ExpenseCategoryResponse as data.
+// swagger:model ExpenseCategoryResponseWrapper
+type ExpenseCategoryResponseWrapper struct {
+	Success   bool                    `json:"success"`
+	Message   string                  `json:"message"`
+	Data      ExpenseCategoryResponse `json:"data"`
+	Timestamp string                  `json:"timestamp"`
+	RequestID string                  `json:"request_id"`
+}
+
+// ExpenseCategoryListResponseWrapper wraps StandardResponse with a list of expense categories.
+// swagger:model ExpenseCategoryListResponseWrapper
+type ExpenseCategoryListResponseWrapper struct {
+	Success   bool                      `json:"success"`
+	Message   string                    `json:"message"`
+	Data      []ExpenseCategoryResponse `json:"data"`
+	Timestamp string                    `json:"timestamp"`
+	RequestID string                    `json:"request_id"`
+}
+
+// ExpenseClaimResponseWrapper wraps StandardResponse with ExpenseClaimResponse as data.
+// swagger:model ExpenseClaimResponseWrapper
+type ExpenseClaimResponseWrapper struct {
+	Success   bool                 `json:"success"`
+	Message   string               `json:"message"`
+	Data      ExpenseClaimResponse `json:"data"`
+	Timestamp string               `json:"timestamp"`
+	RequestID string               `json:"request_id"`
+}
+
+// ExpenseClaimListResponseWrapper wraps StandardResponse with a list of expense claims.
+// swagger:model ExpenseClaimListResponseWrapper
+type ExpenseClaimListResponseWrapper struct {
+	Success   bool                   `json:"success"`
+	Message   string                 `json:"message"`
+	Data      []ExpenseClaimResponse `json:"data"`
+	Timestamp string                 `json:"timestamp"`
+	RequestID string                 `json:"request_id"`
+}
+
+// ExpenseReceiptResponseWrapper wraps StandardResponse with ExpenseReceiptResponse as data.
+// swagger:model ExpenseReceiptResponseWrapper
+type ExpenseReceiptResponseWrapper struct {
+	Success   bool                   `json:"success"`
+	Message   string                 `json:"message"`
+	Data      ExpenseReceiptResponse `json:"data"`
+	Timestamp string                 `json:"timestamp"`
+	RequestID string                 `json:"request_id"`
+}
+
+// ExpenseReceiptListResponseWrapper wraps StandardResponse with a list of expense receipts.
+// swagger:model ExpenseReceiptListResponseWrapper
+type ExpenseReceiptListResponseWrapper struct {
+	Success   bool                     `json:"success"`
+	Message   string                   `json:"message"`
+	Data      []ExpenseReceiptResponse `json:"data"`
+	Timestamp string                   `json:"timestamp"`
+	RequestID string                   `json:"request_id"`
+}
+
+// ExpenseReportResponseWrapper wraps StandardResponse with the rows of an expense report.
+// swagger:model ExpenseReportResponseWrapper
+type ExpenseReportResponseWrapper struct {
+	Success   bool                       `json:"success"`
+	Message   string                     `json:"message"`
+	Data      []ExpenseReportRowResponse `json:"data"`
+	Timestamp string                     `json:"timestamp"`
+	RequestID string                     `json:"request_id"`
+}
+
+func toExpenseCategoryEntity(req ExpenseCategoryRequest) *entity.ExpenseCategory {
+	category := &entity.ExpenseCategory{
+		Name:            req.Name,
+		Description:     req.Description,
+		ReceiptRequired: true,
+	}
+	if req.Limit != nil {
+		category.Limit = toMoneyEntity(*req.Limit)
+	}
+	if req.ReceiptRequired != nil {
+		category.ReceiptRequired = *req.ReceiptRequired
+	}
+	return category
+}
+
+func toExpenseCategoryResponse(category *entity.ExpenseCategory) ExpenseCategoryResponse {
+	response := ExpenseCategoryResponse{
+		ID:              category.ID,
+		Name:            category.Name,
+		Description:     category.Description,
+		ReceiptRequired: category.ReceiptRequired,
+		CreatedAt:       category.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:       category.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+	if category.HasLimit() {
+		limit := toMoneyDTO(category.Limit)
+		response.Limit = &limit
+	}
+	return response
+}
+
+func toExpenseClaimEntity(req ExpenseClaimRequest) (*entity.ExpenseClaim, map[string]string, error) {
+	claim := &entity.ExpenseClaim{
+		Title:    req.Title,
+		Currency: req.Currency,
+		Items:    []entity.ExpenseItem{},
+	}
+	for _, item := range req.Items {
+		expenseDate, err := time.Parse(constants.DateFormat, item.ExpenseDate)
+		if err != nil {
+			return nil, map[string]string{
+				"expense_date": "Date format is invalid , expected format: YYYY-MM-DD",
+			}, appError.ErrInvalidExpenseClaim
+		}
+		claim.Items = append(claim.Items, entity.ExpenseItem{
+			CategoryID:  item.CategoryID,
+			ExpenseDate: expenseDate,
+			Description: item.Description,
+			Amount:      entity.Money{Amount: item.Amount, Currency: req.Currency},
+		})
+	}
+	return claim, nil, nil
+}
+
+func toExpenseClaimResponse(claim *entity.ExpenseClaim) ExpenseClaimResponse {
+	response := ExpenseClaimResponse{
+		ID:                     claim.ID,
+		EmployeeID:             claim.EmployeeID,
+		EmployeeName:           claim.EmployeeName,
+		Title:                  claim.Title,
+		Status:                 string(claim.Status),
+		Total:                  toMoneyDTO(claim.Total),
+		ReceiptCount:           claim.ReceiptCount,
+		ApprovalRequestID:      claim.ApprovalRequestID,
+		DecidedBy:              claim.DecidedBy,
+		DecisionComment:        claim.DecisionComment,
+		ReimbursedOn:           formatOptionalDate(claim.ReimbursedOn),
+		PayrollRunID:           claim.PayrollRunID,
+		ReimbursementReference: claim.ReimbursementReference,
+		CreatedAt:              claim.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:              claim.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+	if claim.SubmittedAt != nil {
+		response.SubmittedAt = claim.SubmittedAt.Format(constants.DateTimeFormat)
+	}
+	if claim.DecidedAt != nil {
+		response.DecidedAt = claim.DecidedAt.Format(constants.DateTimeFormat)
+	}
+	for _, item := range claim.Items {
+		response.Items = append(response.Items, ExpenseItemResponse{
+			ID:           item.ID,
+			CategoryID:   item.CategoryID,
+			CategoryName: item.CategoryName,
+			ExpenseDate:  item.ExpenseDate.Format(constants.DateFormat),
+			Description:  item.Description,
+			Amount:       toMoneyDTO(item.Amount),
+		})
+	}
+	return response
+}
+
+func toExpenseReceiptResponse(receipt *entity.ExpenseReceipt) ExpenseReceiptResponse {
+	return ExpenseReceiptResponse{
+		ID:          receipt.ID,
+		ClaimID:     receipt.ClaimID,
+		FileName:    receipt.FileName,
+		ContentType: receipt.ContentType,
+		Size:        receipt.Size,
+		Checksum:    receipt.Checksum,
+		UploadedAt:  receipt.UploadedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toExpenseReportRowResponse(row *entity.ExpenseReportRow) ExpenseReportRowResponse {
+	return ExpenseReportRowResponse{
+		DepartmentID:   row.DepartmentID,
+		DepartmentName: row.DepartmentName,
+		Period:         row.Period.Format(constants.DateFormat),
+		ClaimCount:     row.ClaimCount,
+		Total:          toMoneyDTO(row.Total),
+	}
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type ExpenseHandler struct {
+	expenseUsecase usecase.ExpenseUsecase
+}
+
+func NewExpenseHandler(expenseUsecase usecase.ExpenseUsecase) *ExpenseHandler {
+	return &ExpenseHandler{expenseUsecase: expenseUsecase}
+}
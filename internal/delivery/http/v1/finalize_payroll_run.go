@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// FinalizePayrollRun godoc
+// @Summary Finalize a payroll run
+// @Description Finalizes a draft run; its payslips become visible to employees and can no longer be recalculated
+// @Tags Payroll
+// @Produce json
+// @Param id path int true "Payroll run ID"
+// @Success 200 {object} PayrollRunResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /payroll-runs/{id}/finalize [post]
+func (h *PayrollHandler) FinalizePayrollRun(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidPayrollRunId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	run, err := h.payrollUsecase.FinalizePayrollRun(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error finalizing payroll run: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Payroll run finalized successfully", toPayrollRunResponse(run))
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllBenefitPlans godoc
+// @Summary List benefit plans
+// @Description Lists the benefit plans ordered by name
+// @Tags Benefits
+// @Produce json
+// @Success 200 {object} BenefitPlanListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /benefit-plans [get]
+func (h *BenefitHandler) GetAllBenefitPlans(c echo.Context) error {
+	plans, err := h.benefitUsecase.GetAllPlans(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting benefit plans: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(plans) == 0 {
+		return apiresponse.Success(c, "No benefit plans found", nil)
+	}
+
+	plansResponse := []BenefitPlanResponse{}
+	for _, plan := range plans {
+		plansResponse = append(plansResponse, toBenefitPlanResponse(plan))
+	}
+
+	return apiresponse.Success(c, "Benefit plans retrieved successfully", plansResponse)
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllCustomFields godoc
+// @Summary List custom employee fields
+// @Description Lists the custom employee fields ordered by key
+// @Tags CustomFields
+// @Produce json
+// @Success 200 {object} CustomFieldListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /custom-fields [get]
+func (h *CustomFieldHandler) GetAllCustomFields(c echo.Context) error {
+	definitions, err := h.customFieldUsecase.GetAllDefinitions(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting custom fields: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(definitions) == 0 {
+		return apiresponse.Success(c, "No custom fields found", nil)
+	}
+
+	definitionsResponse := []CustomFieldResponse{}
+	for _, definition := range definitions {
+		definitionsResponse = append(definitionsResponse, toCustomFieldResponse(definition))
+	}
+
+	return apiresponse.Success(c, "Custom fields retrieved successfully", definitionsResponse)
+}
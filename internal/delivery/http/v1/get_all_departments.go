@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllDepartments godoc
+// @Summary List departments
+// @Description Lists the departments ordered by name
+// @Tags Departments
+// @Produce json
+// @Success 200 {object} DepartmentListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /departments [get]
+func (h *DepartmentHandler) GetAllDepartments(c echo.Context) error {
+	departments, err := h.departmentUsecase.GetAllDepartments(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting departments: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(departments) == 0 {
+		return apiresponse.Success(c, "No departments found", nil)
+	}
+
+	departmentsResponse := []DepartmentResponse{}
+	for _, department := range departments {
+		departmentsResponse = append(departmentsResponse, toDepartmentResponse(department))
+	}
+
+	return apiresponse.Success(c, "Departments retrieved successfully", departmentsResponse)
+}
@@ -2,22 +2,35 @@ package v1
 
 import (
 	"log"
+	"strconv"
+	"strings"
 
 	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
 	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
 	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
 )
 
 // GetAllEmployees retrieves all employees
 // @Summary Get all employees
-// @Description Retrieve a list of all employees in the system
+// @Description Retrieve a list of all employees in the system. Custom fields filter the list with custom.<key>=<value>, e.g. custom.t_shirt_size=M; multi_select fields take comma separated options an employee must all have selected
 // @Tags employees
 // @Produce json
+// @Param status query string false "Comma separated employment statuses, e.g. active,on_leave"
+// @Param manager_id query int false "Only direct reports of this manager"
+// @Param location_id query int false "Only employees whose primary location this is"
+// @Param tag query string false "Comma separated tags employees must all have, e.g. remote,new hire"
 // @Success 200 {object} GetAllEmployeesResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
 // @Failure 500 {object} apiresponse.StandardResponse
 // @Router /employees [get]
 func (h *EmployeeHandler) GetAllEmployees(c echo.Context) error {
-	employees, err := h.employeeUsecase.GetAllEmployees(c.Request().Context())
+	filter, details, err := parseEmployeeFilter(c)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+
+	employees, err := h.employeeUsecase.GetAllEmployees(c.Request().Context(), filter)
 	if err != nil {
 		if appError.ShouldLogError(err) {
 			log.Printf("Error getting all employees: %v", err)
@@ -29,15 +42,65 @@ func (h *EmployeeHandler) GetAllEmployees(c echo.Context) error {
 	}
 	employeesResponse := []GetAllEmployeesResponse{}
 	for _, employee := range employees {
-		employeesResponse = append(employeesResponse, GetAllEmployeesResponse{
-			ID:        employee.ID,
-			Name:      employee.Name,
-			Position:  employee.Position,
-			Salary:    employee.Salary,
-			HiredDate: employee.HiredDate.Format("2006-01-02"),
-			CreatedAt: employee.CreatedAt.Format("2006-01-02 15:04:05"),
-		})
+		employeesResponse = append(employeesResponse, toGetAllEmployeesResponse(employee))
 	}
 
 	return apiresponse.Success(c, "Employees retrieved successfully", employeesResponse)
 }
+
+// customFieldFilterPrefix marks the query parameters filtering the employee list on custom fields.
+const customFieldFilterPrefix = "custom."
+
+// parseEmployeeFilter reads the list filters from the query string.
+func parseEmployeeFilter(c echo.Context) (entity.EmployeeFilter, map[string]string, error) {
+	var filter entity.EmployeeFilter
+
+	if statusParam := c.QueryParam("status"); statusParam != "" {
+		for _, value := range strings.Split(statusParam, ",") {
+			status := entity.EmploymentStatus(strings.TrimSpace(value))
+			if !status.IsValid() {
+				return filter, map[string]string{
+					"status": "Unknown employment status: " + string(status),
+				}, appError.ErrInvalidEmploymentStatus
+			}
+			filter.Statuses = append(filter.Statuses, status)
+		}
+	}
+
+	if managerParam := c.QueryParam("manager_id"); managerParam != "" {
+		managerID, err := strconv.Atoi(managerParam)
+		if err != nil || managerID <= 0 {
+			return filter, map[string]string{
+				"manager_id": "Manager ID must be a valid number",
+			}, appError.ErrInvalidManager
+		}
+		filter.ManagerID = managerID
+	}
+
+	if locationParam := c.QueryParam("location_id"); locationParam != "" {
+		locationID, err := strconv.Atoi(locationParam)
+		if err != nil || locationID <= 0 {
+			return filter, map[string]string{
+				"location_id": "Location ID must be a valid number",
+			}, appError.ErrInvalidLocation
+		}
+		filter.LocationID = locationID
+	}
+
+	if tagParam := c.QueryParam("tag"); tagParam != "" {
+		filter.Tags = strings.Split(tagParam, ",")
+	}
+
+	for param, values := range c.QueryParams() {
+		key, ok := strings.CutPrefix(param, customFieldFilterPrefix)
+		if !ok {
+			continue
+		}
+		if filter.CustomFields == nil {
+			filter.CustomFields = map[string]any{}
+		}
+		filter.CustomFields[key] = values[0]
+	}
+
+	return filter, nil, nil
+}
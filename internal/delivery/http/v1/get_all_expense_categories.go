@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllExpenseCategories godoc
+// @Summary List expense categories
+// @Description Lists the expense categories ordered by name
+// @Tags Expenses
+// @Produce json
+// @Success 200 {object} ExpenseCategoryListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /expense-categories [get]
+func (h *ExpenseHandler) GetAllExpenseCategories(c echo.Context) error {
+	categories, err := h.expenseUsecase.GetAllCategories(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting expense categories: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(categories) == 0 {
+		return apiresponse.Success(c, "No expense categories found", nil)
+	}
+
+	categoriesResponse := []ExpenseCategoryResponse{}
+	for _, category := range categories {
+		categoriesResponse = append(categoriesResponse, toExpenseCategoryResponse(category))
+	}
+
+	return apiresponse.Success(c, "Expense categories retrieved successfully", categoriesResponse)
+}
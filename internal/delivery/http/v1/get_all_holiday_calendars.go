@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllHolidayCalendars godoc
+// @Summary List holiday calendars
+// @Description Lists the holiday calendars ordered by name
+// @Tags Holidays
+// @Produce json
+// @Success 200 {object} HolidayCalendarListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /holiday-calendars [get]
+func (h *HolidayCalendarHandler) GetAllHolidayCalendars(c echo.Context) error {
+	calendars, err := h.holidayCalendarUsecase.GetAllHolidayCalendars(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting holiday calendars: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(calendars) == 0 {
+		return apiresponse.Success(c, "No holiday calendars found", nil)
+	}
+
+	calendarsResponse := []HolidayCalendarResponse{}
+	for _, calendar := range calendars {
+		calendarsResponse = append(calendarsResponse, toHolidayCalendarResponse(calendar))
+	}
+
+	return apiresponse.Success(c, "Holiday calendars retrieved successfully", calendarsResponse)
+}
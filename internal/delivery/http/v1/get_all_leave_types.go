@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllLeaveTypes godoc
+// @Summary List leave types
+// @Description Lists all leave types ordered by name
+// @Tags Leave
+// @Produce json
+// @Success 200 {object} LeaveTypeListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /leave-types [get]
+func (h *LeaveHandler) GetAllLeaveTypes(c echo.Context) error {
+	leaveTypes, err := h.leaveUsecase.GetAllLeaveTypes(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting leave types: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(leaveTypes) == 0 {
+		return apiresponse.Success(c, "No leave types found", nil)
+	}
+
+	leaveTypesResponse := []LeaveTypeResponse{}
+	for _, leaveType := range leaveTypes {
+		leaveTypesResponse = append(leaveTypesResponse, toLeaveTypeResponse(leaveType))
+	}
+
+	return apiresponse.Success(c, "Leave types retrieved successfully", leaveTypesResponse)
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllLocations godoc
+// @Summary List locations
+// @Description Lists the locations ordered by name
+// @Tags Locations
+// @Produce json
+// @Success 200 {object} LocationListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /locations [get]
+func (h *LocationHandler) GetAllLocations(c echo.Context) error {
+	locations, err := h.locationUsecase.GetAllLocations(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting locations: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(locations) == 0 {
+		return apiresponse.Success(c, "No locations found", nil)
+	}
+
+	locationsResponse := []LocationResponse{}
+	for _, location := range locations {
+		locationsResponse = append(locationsResponse, toLocationResponse(location))
+	}
+
+	return apiresponse.Success(c, "Locations retrieved successfully", locationsResponse)
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllPayrollPeriods godoc
+// @Summary List payroll periods
+// @Description Lists payroll periods, latest first
+// @Tags Payroll
+// @Produce json
+// @Success 200 {object} PayrollPeriodListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /payroll-periods [get]
+func (h *PayrollHandler) GetAllPayrollPeriods(c echo.Context) error {
+	periods, err := h.payrollUsecase.GetAllPayrollPeriods(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting payroll periods: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(periods) == 0 {
+		return apiresponse.Success(c, "No payroll periods found", nil)
+	}
+
+	periodsResponse := []PayrollPeriodResponse{}
+	for _, period := range periods {
+		periodsResponse = append(periodsResponse, toPayrollPeriodResponse(period))
+	}
+
+	return apiresponse.Success(c, "Payroll periods retrieved successfully", periodsResponse)
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllPayrollRules godoc
+// @Summary List payroll rules
+// @Description Lists payroll rules, including inactive ones, in the order they are applied
+// @Tags Payroll
+// @Produce json
+// @Success 200 {object} PayrollRuleListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /payroll-rules [get]
+func (h *PayrollHandler) GetAllPayrollRules(c echo.Context) error {
+	rules, err := h.payrollUsecase.GetAllPayrollRules(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting payroll rules: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(rules) == 0 {
+		return apiresponse.Success(c, "No payroll rules found", nil)
+	}
+
+	rulesResponse := []PayrollRuleResponse{}
+	for _, rule := range rules {
+		rulesResponse = append(rulesResponse, toPayrollRuleResponse(rule))
+	}
+
+	return apiresponse.Success(c, "Payroll rules retrieved successfully", rulesResponse)
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllPayrollRuns godoc
+// @Summary List payroll runs
+// @Description Lists payroll runs, latest period first
+// @Tags Payroll
+// @Produce json
+// @Success 200 {object} PayrollRunListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /payroll-runs [get]
+func (h *PayrollHandler) GetAllPayrollRuns(c echo.Context) error {
+	runs, err := h.payrollUsecase.GetAllPayrollRuns(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting payroll runs: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(runs) == 0 {
+		return apiresponse.Success(c, "No payroll runs found", nil)
+	}
+
+	runsResponse := []PayrollRunResponse{}
+	for _, run := range runs {
+		runsResponse = append(runsResponse, toPayrollRunResponse(run))
+	}
+
+	return apiresponse.Success(c, "Payroll runs retrieved successfully", runsResponse)
+}
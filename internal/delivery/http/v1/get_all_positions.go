@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllPositions godoc
+// @Summary List positions
+// @Description Lists the positions catalog ordered by job level
+// @Tags Positions
+// @Produce json
+// @Success 200 {object} PositionListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /positions [get]
+func (h *PositionHandler) GetAllPositions(c echo.Context) error {
+	positions, err := h.positionUsecase.GetAllPositions(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting positions: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(positions) == 0 {
+		return apiresponse.Success(c, "No positions found", nil)
+	}
+
+	positionsResponse := []PositionResponse{}
+	for _, position := range positions {
+		positionsResponse = append(positionsResponse, toPositionResponse(position))
+	}
+
+	return apiresponse.Success(c, "Positions retrieved successfully", positionsResponse)
+}
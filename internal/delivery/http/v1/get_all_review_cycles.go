@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllReviewCycles godoc
+// @Summary List review cycles
+// @Description Lists review cycles without their questions, latest period first
+// @Tags Reviews
+// @Produce json
+// @Success 200 {object} ReviewCycleListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /review-cycles [get]
+func (h *ReviewHandler) GetAllReviewCycles(c echo.Context) error {
+	cycles, err := h.reviewUsecase.GetAllReviewCycles(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting review cycles: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(cycles) == 0 {
+		return apiresponse.Success(c, "No review cycles found", nil)
+	}
+
+	cyclesResponse := []ReviewCycleResponse{}
+	for _, cycle := range cycles {
+		cyclesResponse = append(cyclesResponse, toReviewCycleResponse(cycle))
+	}
+
+	return apiresponse.Success(c, "Review cycles retrieved successfully", cyclesResponse)
+}
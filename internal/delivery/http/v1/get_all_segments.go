@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllSegments godoc
+// @Summary List employee segments
+// @Description Lists the saved employee segments ordered by name
+// @Tags Segments
+// @Produce json
+// @Success 200 {object} SegmentListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /segments [get]
+func (h *SegmentHandler) GetAllSegments(c echo.Context) error {
+	segments, err := h.segmentUsecase.GetAllSegments(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting segments: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(segments) == 0 {
+		return apiresponse.Success(c, "No segments found", nil)
+	}
+
+	segmentsResponse := []SegmentResponse{}
+	for _, segment := range segments {
+		segmentsResponse = append(segmentsResponse, toSegmentResponse(segment))
+	}
+
+	return apiresponse.Success(c, "Segments retrieved successfully", segmentsResponse)
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllShiftTemplates godoc
+// @Summary List shift templates
+// @Description Lists shift templates by start time
+// @Tags Shifts
+// @Produce json
+// @Success 200 {object} ShiftTemplateListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /shift-templates [get]
+func (h *ShiftHandler) GetAllShiftTemplates(c echo.Context) error {
+	templates, err := h.shiftUsecase.GetAllShiftTemplates(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting shift templates: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(templates) == 0 {
+		return apiresponse.Success(c, "No shift templates found", nil)
+	}
+
+	templatesResponse := []ShiftTemplateResponse{}
+	for _, template := range templates {
+		templatesResponse = append(templatesResponse, toShiftTemplateResponse(template))
+	}
+
+	return apiresponse.Success(c, "Shift templates retrieved successfully", templatesResponse)
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllSkillCategories godoc
+// @Summary List skill categories
+// @Description Lists the categories of the skills taxonomy ordered by name
+// @Tags Skills
+// @Produce json
+// @Success 200 {object} SkillCategoryListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /skill-categories [get]
+func (h *SkillHandler) GetAllSkillCategories(c echo.Context) error {
+	categories, err := h.skillUsecase.GetAllSkillCategories(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting skill categories: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(categories) == 0 {
+		return apiresponse.Success(c, "No skill categories found", nil)
+	}
+
+	categoriesResponse := []SkillCategoryResponse{}
+	for _, category := range categories {
+		categoriesResponse = append(categoriesResponse, toSkillCategoryResponse(category))
+	}
+
+	return apiresponse.Success(c, "Skill categories retrieved successfully", categoriesResponse)
+}
@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllSkills godoc
+// @Summary List skills
+// @Description Lists the skills ordered by category and name
+// @Tags Skills
+// @Produce json
+// @Param category_id query int false "Only skills of this category"
+// @Success 200 {object} SkillListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /skills [get]
+func (h *SkillHandler) GetAllSkills(c echo.Context) error {
+	categoryID := 0
+	if categoryParam := c.QueryParam("category_id"); categoryParam != "" {
+		id, err := strconv.Atoi(categoryParam)
+		if err != nil || id <= 0 {
+			return apiresponse.Error(c,
+				appError.ErrInvalidSkillCategory,
+				map[string]string{
+					"category_id": "Category ID must be a valid number",
+				})
+		}
+		categoryID = id
+	}
+
+	skills, err := h.skillUsecase.GetAllSkills(c.Request().Context(), categoryID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting skills: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(skills) == 0 {
+		return apiresponse.Success(c, "No skills found", nil)
+	}
+
+	skillsResponse := []SkillResponse{}
+	for _, skill := range skills {
+		skillsResponse = append(skillsResponse, toSkillResponse(skill))
+	}
+
+	return apiresponse.Success(c, "Skills retrieved successfully", skillsResponse)
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllTags godoc
+// @Summary List tags
+// @Description Lists the tags in use with how many employees have each
+// @Tags Tags
+// @Produce json
+// @Success 200 {object} TagCountListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /tags [get]
+func (h *SegmentHandler) GetAllTags(c echo.Context) error {
+	tags, err := h.segmentUsecase.GetAllTags(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting tags: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(tags) == 0 {
+		return apiresponse.Success(c, "No tags found", nil)
+	}
+
+	tagsResponse := []TagCountResponse{}
+	for _, tag := range tags {
+		tagsResponse = append(tagsResponse, TagCountResponse{Tag: tag.Tag, Employees: tag.Employees})
+	}
+
+	return apiresponse.Success(c, "Tags retrieved successfully", tagsResponse)
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAllCourses godoc
+// @Summary List training courses
+// @Description Lists the training courses ordered by name
+// @Tags Training
+// @Produce json
+// @Success 200 {object} TrainingCourseListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /training-courses [get]
+func (h *TrainingHandler) GetAllCourses(c echo.Context) error {
+	courses, err := h.trainingUsecase.GetAllCourses(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting training courses: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(courses) == 0 {
+		return apiresponse.Success(c, "No training courses found", nil)
+	}
+
+	coursesResponse := []TrainingCourseResponse{}
+	for _, course := range courses {
+		coursesResponse = append(coursesResponse, toTrainingCourseResponse(course))
+	}
+
+	return apiresponse.Success(c, "Training courses retrieved successfully", coursesResponse)
+}
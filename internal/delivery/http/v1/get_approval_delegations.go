@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetDelegations godoc
+// @Summary List an employee's approval delegations
+// @Description Lists the delegations an employee made, latest first
+// @Tags Approvals
+// @Produce json
+// @Param id path int true "Delegator's employee ID"
+// @Success 200 {object} ApprovalDelegationListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/delegations [get]
+func (h *ApprovalHandler) GetDelegations(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	delegations, err := h.approvalUsecase.GetDelegations(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting approval delegations: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(delegations) == 0 {
+		return apiresponse.Success(c, "No approval delegations found", nil)
+	}
+
+	delegationsResponse := []ApprovalDelegationResponse{}
+	for _, delegation := range delegations {
+		delegationsResponse = append(delegationsResponse, toApprovalDelegationResponse(delegation))
+	}
+
+	return apiresponse.Success(c, "Approval delegations retrieved successfully", delegationsResponse)
+}
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetInbox godoc
+// @Summary Get an approver's inbox
+// @Description Lists the approval tasks an employee may decide now, oldest request first: tasks assigned to them, position tasks of the position they hold and the same for everyone who delegated to them today
+// @Tags Approvals
+// @Produce json
+// @Param approver_id query int true "Approver's employee ID"
+// @Success 200 {object} ApprovalTaskListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /approvals [get]
+func (h *ApprovalHandler) GetInbox(c echo.Context) error {
+	approverID, err := strconv.Atoi(c.QueryParam("approver_id"))
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"approver_id": "Approver ID is required and must be a valid number",
+			})
+	}
+
+	tasks, err := h.approvalUsecase.GetInbox(c.Request().Context(), approverID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting approval inbox: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(tasks) == 0 {
+		return apiresponse.Success(c, "No approval tasks found", nil)
+	}
+
+	now := time.Now()
+	tasksResponse := []ApprovalTaskResponse{}
+	for _, task := range tasks {
+		tasksResponse = append(tasksResponse, toApprovalTaskResponse(task, now))
+	}
+
+	return apiresponse.Success(c, "Approval tasks retrieved successfully", tasksResponse)
+}
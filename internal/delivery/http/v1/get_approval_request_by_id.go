@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetRequestById godoc
+// @Summary Get an approval request
+// @Description Returns an approval request with its tasks
+// @Tags Approvals
+// @Produce json
+// @Param id path int true "Approval request ID"
+// @Success 200 {object} ApprovalRequestResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /approvals/requests/{id} [get]
+func (h *ApprovalHandler) GetRequestById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidApprovalRequestId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	request, err := h.approvalUsecase.GetRequestById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting approval request: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Approval request retrieved successfully", toApprovalRequestResponse(request))
+}
@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetRequests godoc
+// @Summary List approval requests
+// @Description Lists approval requests with their tasks, latest first
+// @Tags Approvals
+// @Produce json
+// @Param type query string false "salary_change, termination, expense or leave"
+// @Param status query string false "pending, approved, rejected, cancelled or failed"
+// @Param employee_id query int false "Employee the requests are about"
+// @Success 200 {object} ApprovalRequestListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /approvals/requests [get]
+func (h *ApprovalHandler) GetRequests(c echo.Context) error {
+	filter := entity.ApprovalRequestFilter{
+		Type:   entity.ApprovalType(c.QueryParam("type")),
+		Status: entity.ApprovalRequestStatus(c.QueryParam("status")),
+	}
+	if employeeParam := c.QueryParam("employee_id"); employeeParam != "" {
+		employeeID, err := strconv.Atoi(employeeParam)
+		if err != nil || employeeID <= 0 {
+			return apiresponse.Error(c,
+				appError.ErrInvalidApprovalFilter,
+				map[string]string{
+					"employee_id": "Employee ID must be a valid number",
+				})
+		}
+		filter.EmployeeID = employeeID
+	}
+
+	requests, err := h.approvalUsecase.GetRequests(c.Request().Context(), filter)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting approval requests: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(requests) == 0 {
+		return apiresponse.Success(c, "No approval requests found", nil)
+	}
+
+	requestsResponse := []ApprovalRequestResponse{}
+	for _, request := range requests {
+		requestsResponse = append(requestsResponse, toApprovalRequestResponse(request))
+	}
+
+	return apiresponse.Success(c, "Approval requests retrieved successfully", requestsResponse)
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetWorkflowById godoc
+// @Summary Get an approval workflow
+// @Description Returns an approval workflow with its steps
+// @Tags Approvals
+// @Produce json
+// @Param id path int true "Approval workflow ID"
+// @Success 200 {object} ApprovalWorkflowResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /approval-workflows/{id} [get]
+func (h *ApprovalHandler) GetWorkflowById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidApprovalWorkflowId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	workflow, err := h.approvalUsecase.GetWorkflowById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting approval workflow: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Approval workflow retrieved successfully", toApprovalWorkflowResponse(workflow))
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetWorkflows godoc
+// @Summary List approval workflows
+// @Description Lists the approval workflows with their steps, by request type
+// @Tags Approvals
+// @Produce json
+// @Success 200 {object} ApprovalWorkflowListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /approval-workflows [get]
+func (h *ApprovalHandler) GetWorkflows(c echo.Context) error {
+	workflows, err := h.approvalUsecase.GetWorkflows(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting approval workflows: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(workflows) == 0 {
+		return apiresponse.Success(c, "No approval workflows found", nil)
+	}
+
+	workflowsResponse := []ApprovalWorkflowResponse{}
+	for _, workflow := range workflows {
+		workflowsResponse = append(workflowsResponse, toApprovalWorkflowResponse(workflow))
+	}
+
+	return apiresponse.Success(c, "Approval workflows retrieved successfully", workflowsResponse)
+}
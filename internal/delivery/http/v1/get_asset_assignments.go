@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAssetAssignments godoc
+// @Summary Get an asset's assignment history
+// @Description Lists who held the asset and when, latest first
+// @Tags Assets
+// @Produce json
+// @Param id path int true "Asset ID"
+// @Success 200 {object} AssetAssignmentListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /assets/{id}/assignments [get]
+func (h *AssetHandler) GetAssetAssignments(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidAssetId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	assignments, err := h.assetUsecase.GetAssetAssignments(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting asset assignments: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(assignments) == 0 {
+		return apiresponse.Success(c, "No assignments found", nil)
+	}
+
+	assignmentsResponse := []AssetAssignmentResponse{}
+	for _, assignment := range assignments {
+		assignmentsResponse = append(assignmentsResponse, toAssetAssignmentResponse(assignment))
+	}
+
+	return apiresponse.Success(c, "Asset assignments retrieved successfully", assignmentsResponse)
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAssetById godoc
+// @Summary Get an asset
+// @Description Returns an asset with the employee holding it, if any
+// @Tags Assets
+// @Produce json
+// @Param id path int true "Asset ID"
+// @Success 200 {object} AssetResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /assets/{id} [get]
+func (h *AssetHandler) GetAssetById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidAssetId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	asset, err := h.assetUsecase.GetAssetById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting asset: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Asset retrieved successfully", toAssetResponse(asset))
+}
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetAssets godoc
+// @Summary List assets
+// @Description Lists the asset inventory ordered by name, with the employee holding each asset
+// @Tags Assets
+// @Produce json
+// @Param type query string false "laptop, desktop, monitor, phone, tablet, badge, key or other"
+// @Param status query string false "available, assigned, in_repair, lost or retired"
+// @Success 200 {object} AssetListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /assets [get]
+func (h *AssetHandler) GetAssets(c echo.Context) error {
+	filter := entity.AssetFilter{
+		Type:   entity.AssetType(c.QueryParam("type")),
+		Status: entity.AssetStatus(c.QueryParam("status")),
+	}
+
+	assets, err := h.assetUsecase.GetAssets(c.Request().Context(), filter)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting assets: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(assets) == 0 {
+		return apiresponse.Success(c, "No assets found", nil)
+	}
+
+	assetsResponse := []AssetResponse{}
+	for _, asset := range assets {
+		assetsResponse = append(assetsResponse, toAssetResponse(asset))
+	}
+
+	return apiresponse.Success(c, "Assets retrieved successfully", assetsResponse)
+}
@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetBenefitDeductions godoc
+// @Summary List benefit deductions for payroll
+// @Description Lists what payroll deducts in a month for every enrollment covering some day of it, at the plans' current contributions. Deductions are not prorated
+// @Tags Benefits
+// @Produce json
+// @Param period query string true "Payroll month (YYYY-MM)"
+// @Param employee_id query int false "Only this employee"
+// @Success 200 {object} BenefitDeductionListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /benefits/deductions [get]
+func (h *BenefitHandler) GetBenefitDeductions(c echo.Context) error {
+	period, err := time.Parse("2006-01", c.QueryParam("period"))
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidDeductionPeriod,
+			map[string]string{
+				"period": "Period format is invalid , expected format: YYYY-MM",
+			})
+	}
+
+	employeeID := 0
+	if employeeParam := c.QueryParam("employee_id"); employeeParam != "" {
+		employeeID, err = strconv.Atoi(employeeParam)
+		if err != nil || employeeID <= 0 {
+			return apiresponse.Error(c,
+				appError.ErrInvalidDeductionPeriod,
+				map[string]string{
+					"employee_id": "Employee ID must be a valid number",
+				})
+		}
+	}
+
+	deductions, err := h.benefitUsecase.GetDeductions(c.Request().Context(), period.Year(), period.Month(), employeeID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting benefit deductions: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(deductions) == 0 {
+		return apiresponse.Success(c, "No benefit deductions found", nil)
+	}
+
+	deductionsResponse := []BenefitDeductionResponse{}
+	for _, deduction := range deductions {
+		deductionsResponse = append(deductionsResponse, toBenefitDeductionResponse(deduction))
+	}
+
+	return apiresponse.Success(c, "Benefit deductions retrieved successfully", deductionsResponse)
+}
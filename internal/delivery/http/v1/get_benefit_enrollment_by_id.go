@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetBenefitEnrollmentById godoc
+// @Summary Get a benefit enrollment
+// @Description Returns one of an employee's benefit enrollments
+// @Tags Benefits
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param enrollmentId path int true "Enrollment ID"
+// @Success 200 {object} BenefitEnrollmentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/benefits/{enrollmentId} [get]
+func (h *BenefitHandler) GetBenefitEnrollmentById(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	enrollmentID, err := parseIDParam(c, "enrollmentId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidBenefitEnrollmentId,
+			map[string]string{
+				"enrollmentId": "Enrollment ID must be a valid number",
+			})
+	}
+
+	enrollment, err := h.benefitUsecase.GetEnrollmentById(c.Request().Context(), employeeID, enrollmentID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting benefit enrollment: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Benefit enrollment retrieved successfully", toBenefitEnrollmentResponse(enrollment))
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetBenefitEnrollments godoc
+// @Summary List an employee's benefit enrollments
+// @Description Lists an employee's benefit enrollments, current and past, latest coverage first
+// @Tags Benefits
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} BenefitEnrollmentListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/benefits [get]
+func (h *BenefitHandler) GetBenefitEnrollments(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	enrollments, err := h.benefitUsecase.GetEnrollments(c.Request().Context(), employeeID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting benefit enrollments: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(enrollments) == 0 {
+		return apiresponse.Success(c, "No benefit enrollments found", nil)
+	}
+
+	enrollmentsResponse := []BenefitEnrollmentResponse{}
+	for _, enrollment := range enrollments {
+		enrollmentsResponse = append(enrollmentsResponse, toBenefitEnrollmentResponse(enrollment))
+	}
+
+	return apiresponse.Success(c, "Benefit enrollments retrieved successfully", enrollmentsResponse)
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetBenefitPlanById godoc
+// @Summary Get a benefit plan
+// @Description Returns a benefit plan by ID
+// @Tags Benefits
+// @Produce json
+// @Param id path int true "Benefit plan ID"
+// @Success 200 {object} BenefitPlanResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /benefit-plans/{id} [get]
+func (h *BenefitHandler) GetBenefitPlanById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidBenefitPlanId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	plan, err := h.benefitUsecase.GetPlanById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting benefit plan: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Benefit plan retrieved successfully", toBenefitPlanResponse(plan))
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetCandidateById godoc
+// @Summary Get a candidate
+// @Description Returns a candidate with their stage and offer
+// @Tags Recruiting
+// @Produce json
+// @Param id path int true "Candidate ID"
+// @Success 200 {object} CandidateResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /candidates/{id} [get]
+func (h *RecruitingHandler) GetCandidateById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCandidateId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	candidate, err := h.recruitingUsecase.GetCandidateById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting candidate: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Candidate retrieved successfully", toCandidateResponse(candidate))
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetCandidateInterviews godoc
+// @Summary List the interviews of a candidate
+// @Description Lists the interviews of a candidate by time
+// @Tags Recruiting
+// @Produce json
+// @Param id path int true "Candidate ID"
+// @Success 200 {object} InterviewListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /candidates/{id}/interviews [get]
+func (h *RecruitingHandler) GetCandidateInterviews(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCandidateId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	interviews, err := h.recruitingUsecase.GetCandidateInterviews(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting candidate interviews: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(interviews) == 0 {
+		return apiresponse.Success(c, "No interviews found", nil)
+	}
+
+	interviewsResponse := []InterviewResponse{}
+	for _, interview := range interviews {
+		interviewsResponse = append(interviewsResponse, toInterviewResponse(interview))
+	}
+
+	return apiresponse.Success(c, "Interviews retrieved successfully", interviewsResponse)
+}
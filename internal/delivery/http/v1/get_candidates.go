@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetCandidates godoc
+// @Summary List the candidates of a job requisition
+// @Description Lists the candidates of a job requisition, latest applicant first
+// @Tags Recruiting
+// @Produce json
+// @Param id path int true "Job requisition ID"
+// @Param stage query string false "applied, screening, interview, offer, hired, rejected or withdrawn"
+// @Success 200 {object} CandidateListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /requisitions/{id}/candidates [get]
+func (h *RecruitingHandler) GetCandidates(c echo.Context) error {
+	requisitionID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidRequisitionId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	filter := entity.CandidateFilter{
+		RequisitionID: requisitionID,
+		Stage:         entity.CandidateStage(c.QueryParam("stage")),
+	}
+
+	candidates, err := h.recruitingUsecase.GetCandidates(c.Request().Context(), filter)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting candidates: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(candidates) == 0 {
+		return apiresponse.Success(c, "No candidates found", nil)
+	}
+
+	candidatesResponse := []CandidateResponse{}
+	for _, candidate := range candidates {
+		candidatesResponse = append(candidatesResponse, toCandidateResponse(candidate))
+	}
+
+	return apiresponse.Success(c, "Candidates retrieved successfully", candidatesResponse)
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetCertificationById godoc
+// @Summary Get a certification
+// @Description Retrieve a certification
+// @Tags Certifications
+// @Produce json
+// @Param id path int true "Certification ID"
+// @Success 200 {object} CertificationResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /certifications/{id} [get]
+func (h *CertificationHandler) GetCertificationById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCertificationId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	certification, err := h.certificationUsecase.GetCertificationById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting certification: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Certification retrieved successfully", toCertificationResponse(certification))
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetEvidence godoc
+// @Summary List a certification's evidence
+// @Description Lists the files attached to a certification, oldest first
+// @Tags Certifications
+// @Produce json
+// @Param id path int true "Certification ID"
+// @Success 200 {object} EvidenceListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /certifications/{id}/evidence [get]
+func (h *CertificationHandler) GetEvidence(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCertificationId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	evidence, err := h.certificationUsecase.GetEvidence(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting certification evidence: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(evidence) == 0 {
+		return apiresponse.Success(c, "No evidence found", nil)
+	}
+
+	evidenceResponse := []EvidenceResponse{}
+	for _, file := range evidence {
+		evidenceResponse = append(evidenceResponse, toEvidenceResponse(file))
+	}
+
+	return apiresponse.Success(c, "Evidence retrieved successfully", evidenceResponse)
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetChecklistById godoc
+// @Summary Get a checklist
+// @Description Returns an onboarding or offboarding checklist with its tasks and progress
+// @Tags Checklists
+// @Produce json
+// @Param id path int true "Checklist ID"
+// @Success 200 {object} ChecklistResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /checklists/{id} [get]
+func (h *ChecklistHandler) GetChecklistById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidChecklistId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	checklist, err := h.checklistUsecase.GetChecklistById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting checklist: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Checklist retrieved successfully", toChecklistResponse(checklist))
+}
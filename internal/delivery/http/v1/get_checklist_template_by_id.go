@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetTemplateById godoc
+// @Summary Get a checklist template
+// @Description Returns a checklist template with its tasks
+// @Tags Checklists
+// @Produce json
+// @Param id path int true "Checklist template ID"
+// @Success 200 {object} ChecklistTemplateResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /checklist-templates/{id} [get]
+func (h *ChecklistHandler) GetTemplateById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidChecklistTemplateId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	template, err := h.checklistUsecase.GetTemplateById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting checklist template: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Checklist template retrieved successfully", toChecklistTemplateResponse(template))
+}
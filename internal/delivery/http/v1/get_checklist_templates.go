@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetTemplates godoc
+// @Summary List checklist templates
+// @Description Lists the checklist templates with their tasks, by kind and name
+// @Tags Checklists
+// @Produce json
+// @Param kind query string false "onboarding or offboarding"
+// @Success 200 {object} ChecklistTemplateListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /checklist-templates [get]
+func (h *ChecklistHandler) GetTemplates(c echo.Context) error {
+	templates, err := h.checklistUsecase.GetTemplates(c.Request().Context(), entity.ChecklistKind(c.QueryParam("kind")))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting checklist templates: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(templates) == 0 {
+		return apiresponse.Success(c, "No checklist templates found", nil)
+	}
+
+	templatesResponse := []ChecklistTemplateResponse{}
+	for _, template := range templates {
+		templatesResponse = append(templatesResponse, toChecklistTemplateResponse(template))
+	}
+
+	return apiresponse.Success(c, "Checklist templates retrieved successfully", templatesResponse)
+}
@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetChecklists godoc
+// @Summary Track checklist progress
+// @Description Lists onboarding and offboarding checklists with their progress, latest first
+// @Tags Checklists
+// @Produce json
+// @Param kind query string false "onboarding or offboarding"
+// @Param open query bool false "Only checklists with open tasks"
+// @Success 200 {object} ChecklistListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /checklists [get]
+func (h *ChecklistHandler) GetChecklists(c echo.Context) error {
+	filter := entity.ChecklistFilter{Kind: entity.ChecklistKind(c.QueryParam("kind"))}
+	if openParam := c.QueryParam("open"); openParam != "" {
+		open, err := strconv.ParseBool(openParam)
+		if err != nil {
+			return apiresponse.Error(c,
+				appError.ErrInvalidChecklistFilter,
+				map[string]string{
+					"open": "Open must be true or false",
+				})
+		}
+		filter.OpenOnly = open
+	}
+
+	checklists, err := h.checklistUsecase.GetChecklists(c.Request().Context(), filter)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting checklists: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(checklists) == 0 {
+		return apiresponse.Success(c, "No checklists found", nil)
+	}
+
+	checklistsResponse := []ChecklistResponse{}
+	for _, checklist := range checklists {
+		checklistsResponse = append(checklistsResponse, toChecklistResponse(checklist))
+	}
+
+	return apiresponse.Success(c, "Checklists retrieved successfully", checklistsResponse)
+}
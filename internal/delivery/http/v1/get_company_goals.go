@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetCompanyGoals godoc
+// @Summary List company goals
+// @Description Lists the goals owned by the company, by due date
+// @Tags Goals
+// @Produce json
+// @Success 200 {object} GoalListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /goals [get]
+func (h *GoalHandler) GetCompanyGoals(c echo.Context) error {
+	goals, err := h.goalUsecase.GetCompanyGoals(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting company goals: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(goals) == 0 {
+		return apiresponse.Success(c, "No goals found", nil)
+	}
+
+	goalsResponse := []GoalResponse{}
+	for _, goal := range goals {
+		goalsResponse = append(goalsResponse, toGoalResponse(goal))
+	}
+
+	return apiresponse.Success(c, "Goals retrieved successfully", goalsResponse)
+}
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetCompensationHistory godoc
+// @Summary Get compensation timeline
+// @Description Lists every applied, scheduled and cancelled salary change of an employee, ordered by effective date
+// @Tags Compensation
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} CompensationHistoryResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/compensation [get]
+func (h *CompensationHandler) GetCompensationHistory(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	changes, err := h.compensationUsecase.GetCompensationHistory(c.Request().Context(), employeeID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting compensation history: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	historyResponse := []CompensationChangeResponse{}
+	for _, change := range changes {
+		historyResponse = append(historyResponse, toCompensationChangeResponse(change))
+	}
+
+	return apiresponse.Success(c, "Compensation history retrieved successfully", historyResponse)
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetCourseEnrollments godoc
+// @Summary List a course's enrollments
+// @Description Lists the enrollments in a training course, latest first
+// @Tags Training
+// @Produce json
+// @Param id path int true "Training course ID"
+// @Success 200 {object} EnrollmentListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /training-courses/{id}/enrollments [get]
+func (h *TrainingHandler) GetCourseEnrollments(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidTrainingCourseId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	enrollments, err := h.trainingUsecase.GetCourseEnrollments(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting course enrollments: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(enrollments) == 0 {
+		return apiresponse.Success(c, "No enrollments found", nil)
+	}
+
+	enrollmentsResponse := []EnrollmentResponse{}
+	for _, enrollment := range enrollments {
+		enrollmentsResponse = append(enrollmentsResponse, toEnrollmentResponse(enrollment))
+	}
+
+	return apiresponse.Success(c, "Enrollments retrieved successfully", enrollmentsResponse)
+}
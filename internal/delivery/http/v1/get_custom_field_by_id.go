@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetCustomFieldById godoc
+// @Summary Get a custom employee field
+// @Description Returns a custom employee field by ID
+// @Tags CustomFields
+// @Produce json
+// @Param id path int true "Custom field ID"
+// @Success 200 {object} CustomFieldResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /custom-fields/{id} [get]
+func (h *CustomFieldHandler) GetCustomFieldById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCustomFieldDefinitionId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	definition, err := h.customFieldUsecase.GetDefinitionById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting custom field: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Custom field retrieved successfully", toCustomFieldResponse(definition))
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetDepartmentById godoc
+// @Summary Get a department
+// @Description Fetch a single department
+// @Tags Departments
+// @Produce json
+// @Param id path int true "Department ID"
+// @Success 200 {object} DepartmentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /departments/{id} [get]
+func (h *DepartmentHandler) GetDepartmentById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidDepartment,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	department, err := h.departmentUsecase.GetDepartmentById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting department by id: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Department retrieved successfully", toDepartmentResponse(department))
+}
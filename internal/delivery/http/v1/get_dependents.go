@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetDependents godoc
+// @Summary List an employee's dependents
+// @Description Lists the dependents of an employee ordered by name
+// @Tags Benefits
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} DependentListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/dependents [get]
+func (h *BenefitHandler) GetDependents(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	dependents, err := h.benefitUsecase.GetDependents(c.Request().Context(), employeeID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting dependents: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(dependents) == 0 {
+		return apiresponse.Success(c, "No dependents found", nil)
+	}
+
+	dependentsResponse := []DependentResponse{}
+	for _, dependent := range dependents {
+		dependentsResponse = append(dependentsResponse, toDependentResponse(dependent))
+	}
+
+	return apiresponse.Success(c, "Dependents retrieved successfully", dependentsResponse)
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetEligibleBenefitPlans godoc
+// @Summary List the benefit plans an employee is eligible for
+// @Description Lists the benefit plans whose eligibility rules the employee meets today
+// @Tags Benefits
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} BenefitPlanListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/benefit-plans [get]
+func (h *BenefitHandler) GetEligibleBenefitPlans(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	plans, err := h.benefitUsecase.GetEligiblePlans(c.Request().Context(), employeeID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting eligible benefit plans: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(plans) == 0 {
+		return apiresponse.Success(c, "No eligible benefit plans found", nil)
+	}
+
+	plansResponse := []BenefitPlanResponse{}
+	for _, plan := range plans {
+		plansResponse = append(plansResponse, toBenefitPlanResponse(plan))
+	}
+
+	return apiresponse.Success(c, "Eligible benefit plans retrieved successfully", plansResponse)
+}
@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetEmergencyContactById godoc
+// @Summary Get an emergency contact
+// @Description Fetch a single emergency contact of an employee
+// @Tags EmergencyContacts
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param contactId path int true "Emergency contact ID"
+// @Success 200 {object} EmergencyContactResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/emergency-contacts/{contactId} [get]
+func (h *EmergencyContactHandler) GetEmergencyContactById(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	contactID, err := parseIDParam(c, "contactId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmergencyContactId,
+			map[string]string{
+				"contactId": "Contact ID must be a valid number",
+			})
+	}
+
+	contact, err := h.emergencyContactUsecase.GetEmergencyContactById(c.Request().Context(), employeeID, contactID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting emergency contact by id: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Emergency contact retrieved successfully", toEmergencyContactResponse(contact))
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetEmergencyContacts godoc
+// @Summary List emergency contacts
+// @Description Lists the emergency contacts of an employee, primary contact first
+// @Tags EmergencyContacts
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} EmergencyContactListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/emergency-contacts [get]
+func (h *EmergencyContactHandler) GetEmergencyContacts(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	contacts, err := h.emergencyContactUsecase.GetEmergencyContactsByEmployeeId(c.Request().Context(), employeeID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting emergency contacts: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(contacts) == 0 {
+		return apiresponse.Success(c, "No emergency contacts found", nil)
+	}
+
+	contactsResponse := []EmergencyContactResponse{}
+	for _, contact := range contacts {
+		contactsResponse = append(contactsResponse, toEmergencyContactResponse(contact))
+	}
+
+	return apiresponse.Success(c, "Emergency contacts retrieved successfully", contactsResponse)
+}
@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetEmployeeAssets godoc
+// @Summary List an employee's assets
+// @Description Lists the assets the employee holds and held before, latest first
+// @Tags Assets
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param held query bool false "Only assets the employee still holds"
+// @Success 200 {object} AssetAssignmentListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/assets [get]
+func (h *AssetHandler) GetEmployeeAssets(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	heldOnly := false
+	if heldParam := c.QueryParam("held"); heldParam != "" {
+		heldOnly, err = strconv.ParseBool(heldParam)
+		if err != nil {
+			return apiresponse.Error(c,
+				appError.ErrInvalidAssetAssignment,
+				map[string]string{
+					"held": "Held must be true or false",
+				})
+		}
+	}
+
+	assignments, err := h.assetUsecase.GetEmployeeAssets(c.Request().Context(), id, heldOnly)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting employee assets: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(assignments) == 0 {
+		return apiresponse.Success(c, "No assets found", nil)
+	}
+
+	assignmentsResponse := []AssetAssignmentResponse{}
+	for _, assignment := range assignments {
+		assignmentsResponse = append(assignmentsResponse, toAssetAssignmentResponse(assignment))
+	}
+
+	return apiresponse.Success(c, "Employee assets retrieved successfully", assignmentsResponse)
+}
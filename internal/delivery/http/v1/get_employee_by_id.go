@@ -47,14 +47,7 @@ func (h *EmployeeHandler) GetEmployeeById(c echo.Context) error {
 		return apiresponse.Error(c, err, nil)
 	}
 
-	employeeResponse := GetEmployeeByIdResponse{
-		ID:        employee.ID,
-		Name:      employee.Name,
-		Position:  employee.Position,
-		Salary:    employee.Salary,
-		HiredDate: employee.HiredDate.Format("2006-01-02"),
-		CreatedAt: employee.CreatedAt.Format("2006-01-02 15:04:05"),
-	}
+	employeeResponse := toGetEmployeeByIdResponse(employee)
 
 	return apiresponse.Success(c, "Employee retrieved successfully", employeeResponse)
 }
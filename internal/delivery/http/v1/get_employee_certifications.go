@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetEmployeeCertifications godoc
+// @Summary List an employee's certifications
+// @Description Lists the employee's certifications, soonest to expire first
+// @Tags Certifications
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} CertificationListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/certifications [get]
+func (h *CertificationHandler) GetEmployeeCertifications(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	certifications, err := h.certificationUsecase.GetEmployeeCertifications(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting employee certifications: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(certifications) == 0 {
+		return apiresponse.Success(c, "No certifications found", nil)
+	}
+
+	certificationsResponse := []CertificationResponse{}
+	for _, certification := range certifications {
+		certificationsResponse = append(certificationsResponse, toCertificationResponse(certification))
+	}
+
+	return apiresponse.Success(c, "Certifications retrieved successfully", certificationsResponse)
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetEmployeeChecklists godoc
+// @Summary List an employee's checklists
+// @Description Lists an employee's onboarding and offboarding checklists with their progress, latest first
+// @Tags Checklists
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} ChecklistListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/checklists [get]
+func (h *ChecklistHandler) GetEmployeeChecklists(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	checklists, err := h.checklistUsecase.GetEmployeeChecklists(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting employee checklists: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(checklists) == 0 {
+		return apiresponse.Success(c, "No checklists found", nil)
+	}
+
+	checklistsResponse := []ChecklistResponse{}
+	for _, checklist := range checklists {
+		checklistsResponse = append(checklistsResponse, toChecklistResponse(checklist))
+	}
+
+	return apiresponse.Success(c, "Checklists retrieved successfully", checklistsResponse)
+}
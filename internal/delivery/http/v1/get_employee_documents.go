@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetEmployeeDocuments godoc
+// @Summary List an employee's documents
+// @Description Lists the documents kept with an employee, latest first
+// @Tags Documents
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} DocumentListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/documents [get]
+func (h *DocumentHandler) GetEmployeeDocuments(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	documents, err := h.documentUsecase.GetEmployeeDocuments(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting employee documents: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(documents) == 0 {
+		return apiresponse.Success(c, "No documents found", nil)
+	}
+
+	documentResponses := []DocumentResponse{}
+	for _, document := range documents {
+		documentResponses = append(documentResponses, toDocumentResponse(document))
+	}
+
+	return apiresponse.Success(c, "Documents retrieved successfully", documentResponses)
+}
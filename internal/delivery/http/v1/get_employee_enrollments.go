@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetEmployeeEnrollments godoc
+// @Summary List an employee's enrollments
+// @Description Lists the training courses the employee is or was enrolled in, latest first
+// @Tags Training
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} EnrollmentListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/enrollments [get]
+func (h *TrainingHandler) GetEmployeeEnrollments(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	enrollments, err := h.trainingUsecase.GetEmployeeEnrollments(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting employee enrollments: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(enrollments) == 0 {
+		return apiresponse.Success(c, "No enrollments found", nil)
+	}
+
+	enrollmentsResponse := []EnrollmentResponse{}
+	for _, enrollment := range enrollments {
+		enrollmentsResponse = append(enrollmentsResponse, toEnrollmentResponse(enrollment))
+	}
+
+	return apiresponse.Success(c, "Enrollments retrieved successfully", enrollmentsResponse)
+}
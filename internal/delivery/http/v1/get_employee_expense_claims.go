@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetEmployeeExpenseClaims godoc
+// @Summary List an employee's expense claims
+// @Description Lists an employee's expense claims without their items, most recent first
+// @Tags Expenses
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param status query string false "draft, submitted, approved, rejected or reimbursed"
+// @Success 200 {object} ExpenseClaimListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/expenses [get]
+func (h *ExpenseHandler) GetEmployeeExpenseClaims(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	status := entity.ExpenseClaimStatus(c.QueryParam("status"))
+	claims, err := h.expenseUsecase.GetEmployeeClaims(c.Request().Context(), employeeID, status)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting expense claims: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(claims) == 0 {
+		return apiresponse.Success(c, "No expense claims found", nil)
+	}
+
+	claimsResponse := []ExpenseClaimResponse{}
+	for _, claim := range claims {
+		claimsResponse = append(claimsResponse, toExpenseClaimResponse(claim))
+	}
+
+	return apiresponse.Success(c, "Expense claims retrieved successfully", claimsResponse)
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetEmployeeGoals godoc
+// @Summary List an employee's goals
+// @Description Lists the goals owned by the employee with their key results and progress, by due date
+// @Tags Goals
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} GoalListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/goals [get]
+func (h *GoalHandler) GetEmployeeGoals(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	goals, err := h.goalUsecase.GetEmployeeGoals(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting employee goals: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(goals) == 0 {
+		return apiresponse.Success(c, "No goals found", nil)
+	}
+
+	goalsResponse := []GoalResponse{}
+	for _, goal := range goals {
+		goalsResponse = append(goalsResponse, toGoalResponse(goal))
+	}
+
+	return apiresponse.Success(c, "Goals retrieved successfully", goalsResponse)
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetEmployeePayslips godoc
+// @Summary List an employee's payslips
+// @Description Lists the employee's payslips of finalized and locked payroll runs, latest period first
+// @Tags Payroll
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} PayslipListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/payslips [get]
+func (h *PayrollHandler) GetEmployeePayslips(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	payslips, err := h.payrollUsecase.GetEmployeePayslips(c.Request().Context(), employeeID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting employee payslips: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(payslips) == 0 {
+		return apiresponse.Success(c, "No payslips found", nil)
+	}
+
+	payslipsResponse := []PayslipResponse{}
+	for _, payslip := range payslips {
+		payslipsResponse = append(payslipsResponse, toPayslipResponse(payslip))
+	}
+
+	return apiresponse.Success(c, "Payslips retrieved successfully", payslipsResponse)
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetEmployeeReviews godoc
+// @Summary List an employee's reviews
+// @Description Lists the employee's reviews across cycles, latest cycle first
+// @Tags Reviews
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} ReviewListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/reviews [get]
+func (h *ReviewHandler) GetEmployeeReviews(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	reviews, err := h.reviewUsecase.GetEmployeeReviews(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting employee reviews: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(reviews) == 0 {
+		return apiresponse.Success(c, "No reviews found", nil)
+	}
+
+	reviewsResponse := []ReviewResponse{}
+	for _, review := range reviews {
+		reviewsResponse = append(reviewsResponse, toReviewResponse(review))
+	}
+
+	return apiresponse.Success(c, "Reviews retrieved successfully", reviewsResponse)
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetEmployeeSkills godoc
+// @Summary Get an employee's skills
+// @Description Lists the skills an employee holds with their proficiency levels
+// @Tags Skills
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} EmployeeSkillListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/skills [get]
+func (h *SkillHandler) GetEmployeeSkills(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	employeeSkills, err := h.skillUsecase.GetEmployeeSkills(c.Request().Context(), employeeID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting employee skills: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(employeeSkills) == 0 {
+		return apiresponse.Success(c, "No skills found", nil)
+	}
+
+	employeeSkillsResponse := []EmployeeSkillResponse{}
+	for _, employeeSkill := range employeeSkills {
+		employeeSkillsResponse = append(employeeSkillsResponse, toEmployeeSkillResponse(employeeSkill))
+	}
+
+	return apiresponse.Success(c, "Employee skills retrieved successfully", employeeSkillsResponse)
+}
@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetEmployeeTags godoc
+// @Summary List an employee's tags
+// @Description Lists the tags of an employee in alphabetical order
+// @Tags Tags
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} TagListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/tags [get]
+func (h *SegmentHandler) GetEmployeeTags(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	tags, err := h.segmentUsecase.GetEmployeeTags(c.Request().Context(), employeeID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting employee tags: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(tags) == 0 {
+		return apiresponse.Success(c, "No tags found", nil)
+	}
+
+	return apiresponse.Success(c, "Tags retrieved successfully", tags)
+}
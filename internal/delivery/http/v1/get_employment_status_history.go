@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetEmploymentStatusHistory godoc
+// @Summary Get employment status history
+// @Description Lists every status change of an employee, oldest first
+// @Tags Employees
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} EmploymentStatusHistoryResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/status-history [get]
+func (h *EmployeeHandler) GetEmploymentStatusHistory(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	changes, err := h.employeeUsecase.GetEmploymentStatusHistory(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting employment status history: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	historyResponse := []EmploymentStatusChangeResponse{}
+	for _, change := range changes {
+		historyResponse = append(historyResponse, toEmploymentStatusChangeResponse(change))
+	}
+
+	return apiresponse.Success(c, "Employment status history retrieved successfully", historyResponse)
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetEnrollmentWindows godoc
+// @Summary List enrollment windows
+// @Description Lists the enrollment windows, latest first
+// @Tags Benefits
+// @Produce json
+// @Success 200 {object} EnrollmentWindowListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /benefit-enrollment-windows [get]
+func (h *BenefitHandler) GetEnrollmentWindows(c echo.Context) error {
+	windows, err := h.benefitUsecase.GetWindows(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting enrollment windows: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(windows) == 0 {
+		return apiresponse.Success(c, "No enrollment windows found", nil)
+	}
+
+	windowsResponse := []EnrollmentWindowResponse{}
+	for _, window := range windows {
+		windowsResponse = append(windowsResponse, toEnrollmentWindowResponse(window))
+	}
+
+	return apiresponse.Success(c, "Enrollment windows retrieved successfully", windowsResponse)
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetExchangeRates godoc
+// @Summary List exchange rates
+// @Description Lists stored exchange rates grouped by currency pair, newest first
+// @Tags ExchangeRates
+// @Produce json
+// @Success 200 {object} ExchangeRateListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /exchange-rates [get]
+func (h *ExchangeRateHandler) GetExchangeRates(c echo.Context) error {
+	rates, err := h.exchangeRateUsecase.GetExchangeRates(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting exchange rates: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(rates) == 0 {
+		return apiresponse.Success(c, "No exchange rates found", nil)
+	}
+
+	ratesResponse := []ExchangeRateResponse{}
+	for _, rate := range rates {
+		ratesResponse = append(ratesResponse, toExchangeRateResponse(rate))
+	}
+
+	return apiresponse.Success(c, "Exchange rates retrieved successfully", ratesResponse)
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetExpenseCategoryById godoc
+// @Summary Get an expense category
+// @Description Returns an expense category by ID
+// @Tags Expenses
+// @Produce json
+// @Param id path int true "Expense category ID"
+// @Success 200 {object} ExpenseCategoryResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /expense-categories/{id} [get]
+func (h *ExpenseHandler) GetExpenseCategoryById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidExpenseCategoryId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	category, err := h.expenseUsecase.GetCategoryById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting expense category: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Expense category retrieved successfully", toExpenseCategoryResponse(category))
+}
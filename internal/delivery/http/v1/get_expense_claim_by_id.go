@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetExpenseClaimById godoc
+// @Summary Get an expense claim
+// @Description Returns an expense claim with its items
+// @Tags Expenses
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param expenseId path int true "Expense claim ID"
+// @Success 200 {object} ExpenseClaimResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/expenses/{expenseId} [get]
+func (h *ExpenseHandler) GetExpenseClaimById(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	claimID, err := parseIDParam(c, "expenseId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidExpenseClaimId,
+			map[string]string{
+				"expenseId": "Expense claim ID must be a valid number",
+			})
+	}
+
+	claim, err := h.expenseUsecase.GetClaimById(c.Request().Context(), employeeID, claimID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting expense claim: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Expense claim retrieved successfully", toExpenseClaimResponse(claim))
+}
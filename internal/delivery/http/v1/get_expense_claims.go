@@ -0,0 +1,76 @@
+package v1
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetExpenseClaims godoc
+// @Summary List expense claims
+// @Description Lists the expense claims of all employees without their items, most recent first. Payroll picks the claims to reimburse up with status=approved.
+// @Tags Expenses
+// @Produce json
+// @Param status query string false "draft, submitted, approved, rejected or reimbursed"
+// @Param employee_id query int false "Only claims of this employee"
+// @Param department_id query int false "Only claims of employees in this department"
+// @Success 200 {object} ExpenseClaimListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /expenses [get]
+func (h *ExpenseHandler) GetExpenseClaims(c echo.Context) error {
+	filter, details, err := parseExpenseClaimFilter(c)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+
+	claims, err := h.expenseUsecase.GetClaims(c.Request().Context(), filter)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting expense claims: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(claims) == 0 {
+		return apiresponse.Success(c, "No expense claims found", nil)
+	}
+
+	claimsResponse := []ExpenseClaimResponse{}
+	for _, claim := range claims {
+		claimsResponse = append(claimsResponse, toExpenseClaimResponse(claim))
+	}
+
+	return apiresponse.Success(c, "Expense claims retrieved successfully", claimsResponse)
+}
+
+func parseExpenseClaimFilter(c echo.Context) (entity.ExpenseClaimFilter, map[string]string, error) {
+	filter := entity.ExpenseClaimFilter{
+		Status: entity.ExpenseClaimStatus(c.QueryParam("status")),
+	}
+
+	if employeeParam := c.QueryParam("employee_id"); employeeParam != "" {
+		employeeID, err := strconv.Atoi(employeeParam)
+		if err != nil || employeeID <= 0 {
+			return filter, map[string]string{
+				"employee_id": "Employee ID must be a valid number",
+			}, appError.ErrInvalidExpenseClaimFilter
+		}
+		filter.EmployeeID = employeeID
+	}
+
+	if departmentParam := c.QueryParam("department_id"); departmentParam != "" {
+		departmentID, err := strconv.Atoi(departmentParam)
+		if err != nil || departmentID <= 0 {
+			return filter, map[string]string{
+				"department_id": "Department ID must be a valid number",
+			}, appError.ErrInvalidExpenseClaimFilter
+		}
+		filter.DepartmentID = departmentID
+	}
+
+	return filter, nil, nil
+}
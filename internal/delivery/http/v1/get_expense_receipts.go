@@ -0,0 +1,58 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetExpenseReceipts godoc
+// @Summary List receipts
+// @Description Lists the receipts attached to an expense claim, oldest first
+// @Tags Expenses
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param expenseId path int true "Expense claim ID"
+// @Success 200 {object} ExpenseReceiptListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/expenses/{expenseId}/receipts [get]
+func (h *ExpenseHandler) GetExpenseReceipts(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	claimID, err := parseIDParam(c, "expenseId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidExpenseClaimId,
+			map[string]string{
+				"expenseId": "Expense claim ID must be a valid number",
+			})
+	}
+
+	receipts, err := h.expenseUsecase.GetReceipts(c.Request().Context(), employeeID, claimID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting receipts: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(receipts) == 0 {
+		return apiresponse.Success(c, "No receipts found", nil)
+	}
+
+	receiptsResponse := []ExpenseReceiptResponse{}
+	for _, receipt := range receipts {
+		receiptsResponse = append(receiptsResponse, toExpenseReceiptResponse(receipt))
+	}
+
+	return apiresponse.Success(c, "Receipts retrieved successfully", receiptsResponse)
+}
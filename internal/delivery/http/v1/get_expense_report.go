@@ -0,0 +1,76 @@
+package v1
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// GetExpenseReport godoc
+// @Summary Report expenses by department and month
+// @Description Totals approved and reimbursed expenses dated from one date to another inclusive by department and month, in the reporting currency at the rates of the end of each month
+// @Tags Expenses
+// @Produce json
+// @Param from query string true "First expense date (YYYY-MM-DD)"
+// @Param to query string true "Last expense date (YYYY-MM-DD)"
+// @Param department_id query int false "Only this department"
+// @Success 200 {object} ExpenseReportResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /expenses/report [get]
+func (h *ExpenseHandler) GetExpenseReport(c echo.Context) error {
+	from, err := time.Parse(constants.DateFormat, c.QueryParam("from"))
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidExpenseReport,
+			map[string]string{
+				"from": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+	to, err := time.Parse(constants.DateFormat, c.QueryParam("to"))
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidExpenseReport,
+			map[string]string{
+				"to": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+	filter := entity.ExpenseReportFilter{From: from, To: to}
+
+	if departmentParam := c.QueryParam("department_id"); departmentParam != "" {
+		departmentID, err := strconv.Atoi(departmentParam)
+		if err != nil || departmentID <= 0 {
+			return apiresponse.Error(c,
+				appError.ErrInvalidExpenseReport,
+				map[string]string{
+					"department_id": "Department ID must be a valid number",
+				})
+		}
+		filter.DepartmentID = departmentID
+	}
+
+	rows, err := h.expenseUsecase.GetReport(c.Request().Context(), filter)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting expense report: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(rows) == 0 {
+		return apiresponse.Success(c, "No expenses found", nil)
+	}
+
+	rowsResponse := []ExpenseReportRowResponse{}
+	for _, row := range rows {
+		rowsResponse = append(rowsResponse, toExpenseReportRowResponse(row))
+	}
+
+	return apiresponse.Success(c, "Expense report retrieved successfully", rowsResponse)
+}
@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetExpiringCertifications godoc
+// @Summary Report expiring certifications
+// @Description Lists the certifications of current employees expiring between today and the end of the window, soonest first
+// @Tags Certifications
+// @Produce json
+// @Param within_days query int false "Window in days, 1 to 365; defaults to the configured notice window"
+// @Success 200 {object} CertificationListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /certifications/expiring [get]
+func (h *CertificationHandler) GetExpiringCertifications(c echo.Context) error {
+	withinDays := 0
+	if withinParam := c.QueryParam("within_days"); withinParam != "" {
+		days, err := strconv.Atoi(withinParam)
+		if err != nil || days <= 0 {
+			return apiresponse.Error(c,
+				appError.ErrInvalidExpiryWindow,
+				map[string]string{
+					"within_days": "Days must be a positive number",
+				})
+		}
+		withinDays = days
+	}
+
+	certifications, err := h.certificationUsecase.GetExpiringCertifications(c.Request().Context(), time.Now(), withinDays)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting expiring certifications: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(certifications) == 0 {
+		return apiresponse.Success(c, "No expiring certifications found", nil)
+	}
+
+	certificationsResponse := []CertificationResponse{}
+	for _, certification := range certifications {
+		certificationsResponse = append(certificationsResponse, toCertificationResponse(certification))
+	}
+
+	return apiresponse.Success(c, "Expiring certifications retrieved successfully", certificationsResponse)
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetGoalById godoc
+// @Summary Get a goal
+// @Description Retrieve a goal with its key results and their progress
+// @Tags Goals
+// @Produce json
+// @Param id path int true "Goal ID"
+// @Success 200 {object} GoalResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /goals/{id} [get]
+func (h *GoalHandler) GetGoalById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidGoalId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	goal, err := h.goalUsecase.GetGoalById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting goal: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Goal retrieved successfully", toGoalResponse(goal))
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetGoalCheckIns godoc
+// @Summary List a goal's check-ins
+// @Description Lists the check-ins on the goal's key results, latest first
+// @Tags Goals
+// @Produce json
+// @Param id path int true "Goal ID"
+// @Success 200 {object} GoalCheckInListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /goals/{id}/check-ins [get]
+func (h *GoalHandler) GetGoalCheckIns(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidGoalId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	checkIns, err := h.goalUsecase.GetCheckIns(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting goal check-ins: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(checkIns) == 0 {
+		return apiresponse.Success(c, "No check-ins found", nil)
+	}
+
+	checkInsResponse := []GoalCheckInResponse{}
+	for _, checkIn := range checkIns {
+		checkInsResponse = append(checkInsResponse, toGoalCheckInResponse(checkIn))
+	}
+
+	return apiresponse.Success(c, "Check-ins retrieved successfully", checkInsResponse)
+}
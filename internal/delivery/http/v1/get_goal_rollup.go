@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetGoalRollup godoc
+// @Summary Roll up goal progress
+// @Description Returns the goal progress of the employee and, nested, of everyone reporting to them directly or indirectly, with team averages rolled up through the management hierarchy. Cancelled goals are left out.
+// @Tags Goals
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} GoalRollupResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/goal-rollup [get]
+func (h *GoalHandler) GetGoalRollup(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	rollup, err := h.goalUsecase.GetGoalRollup(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting goal rollup: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Goal rollup retrieved successfully", toGoalRollupResponse(rollup))
+}
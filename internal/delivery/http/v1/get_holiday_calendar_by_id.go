@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetHolidayCalendarById godoc
+// @Summary Get a holiday calendar
+// @Description Fetch a single holiday calendar
+// @Tags Holidays
+// @Produce json
+// @Param id path int true "Holiday calendar ID"
+// @Success 200 {object} HolidayCalendarResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /holiday-calendars/{id} [get]
+func (h *HolidayCalendarHandler) GetHolidayCalendarById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidHolidayCalendarId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	calendar, err := h.holidayCalendarUsecase.GetHolidayCalendarById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting holiday calendar by id: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Holiday calendar retrieved successfully", toHolidayCalendarResponse(calendar))
+}
@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetHolidays godoc
+// @Summary List holidays
+// @Description Lists the holidays of a calendar for one year, by date
+// @Tags Holidays
+// @Produce json
+// @Param id path int true "Holiday calendar ID"
+// @Param year query int false "Calendar year, defaults to the current year"
+// @Success 200 {object} HolidayListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /holiday-calendars/{id}/holidays [get]
+func (h *HolidayCalendarHandler) GetHolidays(c echo.Context) error {
+	calendarID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidHolidayCalendarId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	year := time.Now().UTC().Year()
+	if yearParam := c.QueryParam("year"); yearParam != "" {
+		year, err = strconv.Atoi(yearParam)
+		if err != nil {
+			return apiresponse.Error(c,
+				appError.ErrInvalidYear,
+				map[string]string{
+					"year": "Year must be a valid number",
+				})
+		}
+	}
+
+	holidays, err := h.holidayCalendarUsecase.GetHolidays(c.Request().Context(), calendarID, year)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting holidays: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(holidays) == 0 {
+		return apiresponse.Success(c, "No holidays found", nil)
+	}
+
+	holidaysResponse := []HolidayResponse{}
+	for _, holiday := range holidays {
+		holidaysResponse = append(holidaysResponse, toHolidayResponse(holiday))
+	}
+
+	return apiresponse.Success(c, "Holidays retrieved successfully", holidaysResponse)
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetInterviewerSchedule godoc
+// @Summary List the upcoming interviews of an employee
+// @Description Lists the scheduled interviews an employee conducts that have not ended yet, by time
+// @Tags Recruiting
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} InterviewListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/interviews [get]
+func (h *RecruitingHandler) GetInterviewerSchedule(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	interviews, err := h.recruitingUsecase.GetInterviewerSchedule(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting interviewer schedule: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(interviews) == 0 {
+		return apiresponse.Success(c, "No upcoming interviews found", nil)
+	}
+
+	interviewsResponse := []InterviewResponse{}
+	for _, interview := range interviews {
+		interviewsResponse = append(interviewsResponse, toInterviewResponse(interview))
+	}
+
+	return apiresponse.Success(c, "Interviews retrieved successfully", interviewsResponse)
+}
@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetLeaveBalances godoc
+// @Summary Get leave balances
+// @Description Returns the employee's balance for every leave type that tracks one, accrued up to today
+// @Tags Leave
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param year query int false "Calendar year, defaults to the current year"
+// @Success 200 {object} LeaveBalanceListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/leave-balances [get]
+func (h *LeaveHandler) GetLeaveBalances(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	year := time.Now().UTC().Year()
+	if yearParam := c.QueryParam("year"); yearParam != "" {
+		year, err = strconv.Atoi(yearParam)
+		if err != nil {
+			return apiresponse.Error(c,
+				appError.ErrInvalidYear,
+				map[string]string{
+					"year": "Year must be a valid number",
+				})
+		}
+	}
+
+	balances, err := h.leaveUsecase.GetLeaveBalances(c.Request().Context(), employeeID, year)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting leave balances: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(balances) == 0 {
+		return apiresponse.Success(c, "No leave balances found", nil)
+	}
+
+	balancesResponse := []LeaveBalanceResponse{}
+	for _, balance := range balances {
+		balancesResponse = append(balancesResponse, toLeaveBalanceResponse(balance))
+	}
+
+	return apiresponse.Success(c, "Leave balances retrieved successfully", balancesResponse)
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetLeaveRequests godoc
+// @Summary List leave requests
+// @Description Lists an employee's leave requests, most recent first
+// @Tags Leave
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} LeaveRequestListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/leave-requests [get]
+func (h *LeaveHandler) GetLeaveRequests(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	requests, err := h.leaveUsecase.GetLeaveRequests(c.Request().Context(), employeeID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting leave requests: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(requests) == 0 {
+		return apiresponse.Success(c, "No leave requests found", nil)
+	}
+
+	requestsResponse := []LeaveRequestResponse{}
+	for _, request := range requests {
+		requestsResponse = append(requestsResponse, toLeaveRequestResponse(request))
+	}
+
+	return apiresponse.Success(c, "Leave requests retrieved successfully", requestsResponse)
+}
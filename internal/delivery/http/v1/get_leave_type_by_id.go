@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetLeaveTypeById godoc
+// @Summary Get a leave type
+// @Description Fetch a single leave type
+// @Tags Leave
+// @Produce json
+// @Param id path int true "Leave type ID"
+// @Success 200 {object} LeaveTypeResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /leave-types/{id} [get]
+func (h *LeaveHandler) GetLeaveTypeById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidLeaveTypeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	leaveType, err := h.leaveUsecase.GetLeaveTypeById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting leave type by id: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Leave type retrieved successfully", toLeaveTypeResponse(leaveType))
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetLocationById godoc
+// @Summary Get a location
+// @Description Fetch a single location
+// @Tags Locations
+// @Produce json
+// @Param id path int true "Location ID"
+// @Success 200 {object} LocationResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /locations/{id} [get]
+func (h *LocationHandler) GetLocationById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidLocation,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	location, err := h.locationUsecase.GetLocationById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting location by id: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Location retrieved successfully", toLocationResponse(location))
+}
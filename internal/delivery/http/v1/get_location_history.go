@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetLocationHistory godoc
+// @Summary Get location timeline
+// @Description Lists every applied, scheduled and cancelled primary location assignment of an employee, ordered by effective date
+// @Tags Locations
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} LocationHistoryResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/locations [get]
+func (h *LocationHandler) GetLocationHistory(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	assignments, err := h.locationUsecase.GetLocationHistory(c.Request().Context(), employeeID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting location history: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	historyResponse := []LocationAssignmentResponse{}
+	for _, assignment := range assignments {
+		historyResponse = append(historyResponse, toLocationAssignmentResponse(assignment))
+	}
+
+	return apiresponse.Success(c, "Location history retrieved successfully", historyResponse)
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetMissingClockOuts godoc
+// @Summary List missing clock-outs
+// @Description Lists shifts that are still open after the maximum shift length, oldest first
+// @Tags Attendance
+// @Produce json
+// @Success 200 {object} AttendanceEntryListResponseWrapper
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /reports/missing-clock-outs [get]
+func (h *AttendanceHandler) GetMissingClockOuts(c echo.Context) error {
+	entries, err := h.attendanceUsecase.GetMissingClockOuts(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting missing clock-outs: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(entries) == 0 {
+		return apiresponse.Success(c, "No missing clock-outs found", nil)
+	}
+
+	entriesResponse := []AttendanceEntryResponse{}
+	for _, entry := range entries {
+		entriesResponse = append(entriesResponse, toAttendanceEntryResponse(entry))
+	}
+
+	return apiresponse.Success(c, "Missing clock-outs retrieved successfully", entriesResponse)
+}
@@ -0,0 +1,38 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetOutOfBandEmployees godoc
+// @Summary Out-of-band salaries report
+// @Description Lists current employees whose salary today lies outside the band of their position
+// @Tags Positions
+// @Produce json
+// @Success 200 {object} OutOfBandEmployeeListResponseWrapper
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /reports/out-of-band-salaries [get]
+func (h *PositionHandler) GetOutOfBandEmployees(c echo.Context) error {
+	employees, err := h.positionUsecase.GetOutOfBandEmployees(c.Request().Context())
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting out of band employees: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(employees) == 0 {
+		return apiresponse.Success(c, "No employees out of band", nil)
+	}
+
+	employeesResponse := []OutOfBandEmployeeResponse{}
+	for _, employee := range employees {
+		employeesResponse = append(employeesResponse, toOutOfBandEmployeeResponse(employee))
+	}
+
+	return apiresponse.Success(c, "Out of band employees retrieved successfully", employeesResponse)
+}
@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetOwnerTasks godoc
+// @Summary List an employee's checklist tasks
+// @Description Lists the open onboarding and offboarding tasks assigned to an employee, by due date
+// @Tags Checklists
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} ChecklistTaskListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/checklist-tasks [get]
+func (h *ChecklistHandler) GetOwnerTasks(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	tasks, err := h.checklistUsecase.GetOwnerTasks(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting checklist tasks: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(tasks) == 0 {
+		return apiresponse.Success(c, "No checklist tasks found", nil)
+	}
+
+	now := time.Now()
+	tasksResponse := []ChecklistTaskResponse{}
+	for _, task := range tasks {
+		tasksResponse = append(tasksResponse, toChecklistTaskResponse(task, now))
+	}
+
+	return apiresponse.Success(c, "Checklist tasks retrieved successfully", tasksResponse)
+}
@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// GetPayPreview godoc
+// @Summary Preview an employee's pay for a month
+// @Description Prorates the employee's monthly pay over the days they were employed, split where their salary changed. Scheduled salary changes are included.
+// @Tags Compensation
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param period query string false "Month (YYYY-MM), defaults to the current month"
+// @Param basis query string false "calendar_days or working_days, defaults to the configured basis"
+// @Success 200 {object} PayPreviewResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/pay-preview [get]
+func (h *CompensationHandler) GetPayPreview(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	period := time.Now().UTC()
+	if periodParam := c.QueryParam("period"); periodParam != "" {
+		period, err = time.Parse(constants.MonthFormat, periodParam)
+		if err != nil {
+			return apiresponse.Error(c,
+				appError.ErrInvalidPayPreviewPeriod,
+				map[string]string{
+					"period": "Period format is invalid , expected format: YYYY-MM",
+				})
+		}
+	}
+
+	pay, err := h.compensationCalculator.GetPayPreview(c.Request().Context(), employeeID, period.Year(), period.Month(),
+		entity.ProrationBasis(c.QueryParam("basis")))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error previewing pay: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Pay preview retrieved successfully", toPayPreviewResponse(pay))
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetPayrollPeriodById godoc
+// @Summary Get a payroll period
+// @Description Retrieve a payroll period by its ID
+// @Tags Payroll
+// @Produce json
+// @Param id path int true "Payroll period ID"
+// @Success 200 {object} PayrollPeriodResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /payroll-periods/{id} [get]
+func (h *PayrollHandler) GetPayrollPeriodById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidPayrollPeriodId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	period, err := h.payrollUsecase.GetPayrollPeriodById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting payroll period: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Payroll period retrieved successfully", toPayrollPeriodResponse(period))
+}
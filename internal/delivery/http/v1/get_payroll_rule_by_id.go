@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetPayrollRuleById godoc
+// @Summary Get a payroll rule
+// @Description Retrieve a payroll rule by its ID
+// @Tags Payroll
+// @Produce json
+// @Param id path int true "Payroll rule ID"
+// @Success 200 {object} PayrollRuleResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /payroll-rules/{id} [get]
+func (h *PayrollHandler) GetPayrollRuleById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidPayrollRuleId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	rule, err := h.payrollUsecase.GetPayrollRuleById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting payroll rule: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Payroll rule retrieved successfully", toPayrollRuleResponse(rule))
+}
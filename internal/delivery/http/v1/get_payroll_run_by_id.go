@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetPayrollRunById godoc
+// @Summary Get a payroll run
+// @Description Retrieve a payroll run by its ID
+// @Tags Payroll
+// @Produce json
+// @Param id path int true "Payroll run ID"
+// @Success 200 {object} PayrollRunResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /payroll-runs/{id} [get]
+func (h *PayrollHandler) GetPayrollRunById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidPayrollRunId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	run, err := h.payrollUsecase.GetPayrollRunById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting payroll run: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Payroll run retrieved successfully", toPayrollRunResponse(run))
+}
@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetPayslipById godoc
+// @Summary Get a payslip
+// @Description Retrieve a payslip of a payroll run with its lines
+// @Tags Payroll
+// @Produce json
+// @Param id path int true "Payroll run ID"
+// @Param payslipId path int true "Payslip ID"
+// @Success 200 {object} PayslipResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /payroll-runs/{id}/payslips/{payslipId} [get]
+func (h *PayrollHandler) GetPayslipById(c echo.Context) error {
+	runID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidPayrollRunId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	id, err := parseIDParam(c, "payslipId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidPayslipId,
+			map[string]string{
+				"payslipId": "ID must be a valid number",
+			})
+	}
+
+	payslip, err := h.payrollUsecase.GetPayslipById(c.Request().Context(), runID, id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting payslip: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Payslip retrieved successfully", toPayslipResponse(payslip))
+}
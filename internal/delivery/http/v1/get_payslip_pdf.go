@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetPayslipPDF godoc
+// @Summary Download a payslip as PDF
+// @Description Renders a payslip of a payroll run as a one-page PDF document
+// @Tags Payroll
+// @Produce application/pdf
+// @Param id path int true "Payroll run ID"
+// @Param payslipId path int true "Payslip ID"
+// @Success 200 {file} file
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /payroll-runs/{id}/payslips/{payslipId}/pdf [get]
+func (h *PayrollHandler) GetPayslipPDF(c echo.Context) error {
+	runID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidPayrollRunId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	id, err := parseIDParam(c, "payslipId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidPayslipId,
+			map[string]string{
+				"payslipId": "ID must be a valid number",
+			})
+	}
+
+	payslip, err := h.payrollUsecase.GetPayslipById(c.Request().Context(), runID, id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting payslip: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	var document bytes.Buffer
+	if _, err := renderPayslipPDF(payslip).WriteTo(&document); err != nil {
+		log.Printf("Error rendering payslip: %v", err)
+		return apiresponse.Error(c, err, nil)
+	}
+
+	filename := fmt.Sprintf("payslip-%d-%s.pdf", payslip.EmployeeID, payslip.Period.Name())
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, filename))
+	return c.Blob(http.StatusOK, "application/pdf", document.Bytes())
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetPayslips godoc
+// @Summary List the payslips of a payroll run
+// @Description Lists the run's payslips by employee name, without their lines
+// @Tags Payroll
+// @Produce json
+// @Param id path int true "Payroll run ID"
+// @Success 200 {object} PayslipListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /payroll-runs/{id}/payslips [get]
+func (h *PayrollHandler) GetPayslips(c echo.Context) error {
+	runID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidPayrollRunId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	payslips, err := h.payrollUsecase.GetPayslips(c.Request().Context(), runID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting payslips: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(payslips) == 0 {
+		return apiresponse.Success(c, "No payslips found", nil)
+	}
+
+	payslipsResponse := []PayslipResponse{}
+	for _, payslip := range payslips {
+		payslipsResponse = append(payslipsResponse, toPayslipResponse(payslip))
+	}
+
+	return apiresponse.Success(c, "Payslips retrieved successfully", payslipsResponse)
+}
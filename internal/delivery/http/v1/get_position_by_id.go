@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetPositionById godoc
+// @Summary Get a position
+// @Description Fetch a single position with its salary band
+// @Tags Positions
+// @Produce json
+// @Param id path int true "Position ID"
+// @Success 200 {object} PositionResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /positions/{id} [get]
+func (h *PositionHandler) GetPositionById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidPosition,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	position, err := h.positionUsecase.GetPositionById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting position by id: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Position retrieved successfully", toPositionResponse(position))
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetRequisitionById godoc
+// @Summary Get a job requisition
+// @Description Returns a job requisition with the number of candidates hired through it
+// @Tags Recruiting
+// @Produce json
+// @Param id path int true "Job requisition ID"
+// @Success 200 {object} RequisitionResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /requisitions/{id} [get]
+func (h *RecruitingHandler) GetRequisitionById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidRequisitionId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	requisition, err := h.recruitingUsecase.GetRequisitionById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting job requisition: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Job requisition retrieved successfully", toRequisitionResponse(requisition))
+}
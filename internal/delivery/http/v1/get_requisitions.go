@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetRequisitions godoc
+// @Summary List job requisitions
+// @Description Lists job requisitions, latest first
+// @Tags Recruiting
+// @Produce json
+// @Param status query string false "open, on_hold, filled or closed"
+// @Success 200 {object} RequisitionListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /requisitions [get]
+func (h *RecruitingHandler) GetRequisitions(c echo.Context) error {
+	status := entity.RequisitionStatus(c.QueryParam("status"))
+
+	requisitions, err := h.recruitingUsecase.GetRequisitions(c.Request().Context(), status)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting job requisitions: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(requisitions) == 0 {
+		return apiresponse.Success(c, "No job requisitions found", nil)
+	}
+
+	requisitionsResponse := []RequisitionResponse{}
+	for _, requisition := range requisitions {
+		requisitionsResponse = append(requisitionsResponse, toRequisitionResponse(requisition))
+	}
+
+	return apiresponse.Success(c, "Job requisitions retrieved successfully", requisitionsResponse)
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetReviewById godoc
+// @Summary Get a review
+// @Description Retrieve a review with its self, peer and manager submissions and their answers
+// @Tags Reviews
+// @Produce json
+// @Param id path int true "Review ID"
+// @Success 200 {object} ReviewResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /reviews/{id} [get]
+func (h *ReviewHandler) GetReviewById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidReviewId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	review, err := h.reviewUsecase.GetReviewById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting review: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Review retrieved successfully", toReviewResponse(review))
+}
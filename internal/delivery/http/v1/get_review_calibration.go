@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetReviewCalibration godoc
+// @Summary Get a review cycle's calibration
+// @Description Aggregates the cycle's self, peer and manager ratings by department, with the distribution of manager ratings over completed reviews
+// @Tags Reviews
+// @Produce json
+// @Param id path int true "Review cycle ID"
+// @Success 200 {object} ReviewCalibrationResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /review-cycles/{id}/calibration [get]
+func (h *ReviewHandler) GetReviewCalibration(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidReviewCycleId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	calibration, err := h.reviewUsecase.GetReviewCalibration(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting review calibration: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Review calibration retrieved successfully", toReviewCalibrationResponse(calibration))
+}
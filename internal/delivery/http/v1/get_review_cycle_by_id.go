@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetReviewCycleById godoc
+// @Summary Get a review cycle
+// @Description Retrieve a review cycle with its questions
+// @Tags Reviews
+// @Produce json
+// @Param id path int true "Review cycle ID"
+// @Success 200 {object} ReviewCycleResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /review-cycles/{id} [get]
+func (h *ReviewHandler) GetReviewCycleById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidReviewCycleId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	cycle, err := h.reviewUsecase.GetReviewCycleById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting review cycle: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Review cycle retrieved successfully", toReviewCycleResponse(cycle))
+}
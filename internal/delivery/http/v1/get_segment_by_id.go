@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetSegmentById godoc
+// @Summary Get an employee segment
+// @Description Returns a saved employee segment by ID
+// @Tags Segments
+// @Produce json
+// @Param id path int true "Segment ID"
+// @Success 200 {object} SegmentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /segments/{id} [get]
+func (h *SegmentHandler) GetSegmentById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidSegmentId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	segment, err := h.segmentUsecase.GetSegmentById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting segment: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Segment retrieved successfully", toSegmentResponse(segment))
+}
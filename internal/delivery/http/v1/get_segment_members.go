@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetSegmentMembers godoc
+// @Summary List a segment's members
+// @Description Evaluates a segment, listing the employees matching its filter now
+// @Tags Segments
+// @Produce json
+// @Param id path int true "Segment ID"
+// @Success 200 {object} GetAllEmployeesResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /segments/{id}/members [get]
+func (h *SegmentHandler) GetSegmentMembers(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidSegmentId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	employees, err := h.segmentUsecase.GetSegmentMembers(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting segment members: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(employees) == 0 {
+		return apiresponse.Success(c, "No employees found", nil)
+	}
+
+	employeesResponse := []GetAllEmployeesResponse{}
+	for _, employee := range employees {
+		employeesResponse = append(employeesResponse, toGetAllEmployeesResponse(employee))
+	}
+
+	return apiresponse.Success(c, "Segment members retrieved successfully", employeesResponse)
+}
@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/ical"
+)
+
+// GetShiftFeed godoc
+// @Summary Shift calendar feed
+// @Description iCalendar feed of the employee's upcoming shifts, for subscribing from a calendar app. Calendar apps are told to show the shifts in the time zone of the employee's location.
+// @Tags Shifts
+// @Produce text/calendar
+// @Param id path int true "Employee ID"
+// @Success 200 {string} string "iCalendar file"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/shifts.ics [get]
+func (h *ShiftHandler) GetShiftFeed(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	feed, err := h.shiftUsecase.GetUpcomingShifts(c.Request().Context(), employeeID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting upcoming shifts: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	events := []ical.TimedEvent{}
+	for _, shift := range feed.Shifts {
+		event := ical.TimedEvent{
+			UID:     fmt.Sprintf("shift-%d@employee-management-system", shift.ID),
+			Summary: shift.ShiftTemplateName,
+			Start:   shift.StartsAt,
+			End:     shift.EndsAt,
+		}
+		if shift.BreakMinutes > 0 {
+			event.Description = fmt.Sprintf("Includes a %d minute break", shift.BreakMinutes)
+		}
+		events = append(events, event)
+	}
+
+	var calendar bytes.Buffer
+	if err := ical.Write(&calendar, "Shifts", feed.TimeZone.String(), events); err != nil {
+		log.Printf("Error writing shift feed: %v", err)
+		return apiresponse.Error(c, err, nil)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`inline; filename="shifts-%d.ics"`, employeeID))
+	return c.Blob(http.StatusOK, "text/calendar; charset=utf-8", calendar.Bytes())
+}
@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetShiftTemplateById godoc
+// @Summary Get a shift template
+// @Tags Shifts
+// @Produce json
+// @Param id path int true "Shift template ID"
+// @Success 200 {object} ShiftTemplateResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /shift-templates/{id} [get]
+func (h *ShiftHandler) GetShiftTemplateById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidShiftTemplateId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	template, err := h.shiftUsecase.GetShiftTemplateById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting shift template: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Shift template retrieved successfully", toShiftTemplateResponse(template))
+}
@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// GetShifts godoc
+// @Summary List rostered shifts
+// @Description Lists the employee's shifts starting from one date to another inclusive
+// @Tags Shifts
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param from query string true "First date (YYYY-MM-DD)"
+// @Param to query string true "Last date (YYYY-MM-DD)"
+// @Success 200 {object} ShiftListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/shifts [get]
+func (h *ShiftHandler) GetShifts(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	from, err := time.Parse(constants.DateFormat, c.QueryParam("from"))
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidDateRange,
+			map[string]string{
+				"from": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+	to, err := time.Parse(constants.DateFormat, c.QueryParam("to"))
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidDateRange,
+			map[string]string{
+				"to": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	shifts, err := h.shiftUsecase.GetShifts(c.Request().Context(), employeeID, from, to)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting shifts: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(shifts) == 0 {
+		return apiresponse.Success(c, "No shifts found", nil)
+	}
+
+	shiftsResponse := []ShiftResponse{}
+	for _, shift := range shifts {
+		shiftsResponse = append(shiftsResponse, toShiftResponse(shift))
+	}
+
+	return apiresponse.Success(c, "Shifts retrieved successfully", shiftsResponse)
+}
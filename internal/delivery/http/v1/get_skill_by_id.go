@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetSkillById godoc
+// @Summary Get a skill
+// @Description Retrieve a skill with its category
+// @Tags Skills
+// @Produce json
+// @Param id path int true "Skill ID"
+// @Success 200 {object} SkillResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /skills/{id} [get]
+func (h *SkillHandler) GetSkillById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidSkillId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	skill, err := h.skillUsecase.GetSkillById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting skill: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Skill retrieved successfully", toSkillResponse(skill))
+}
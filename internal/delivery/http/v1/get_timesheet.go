@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// GetTimesheet godoc
+// @Summary Get a weekly timesheet
+// @Description Aggregates the employee's shifts for the week (Monday to Sunday) containing the given date, with overtime and missing punches
+// @Tags Attendance
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param week path string true "Any date in the week (YYYY-MM-DD)"
+// @Success 200 {object} WeeklyTimesheetResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/timesheets/{week} [get]
+func (h *AttendanceHandler) GetTimesheet(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	week, err := time.Parse(constants.DateFormat, c.Param("week"))
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidTimesheetWeek,
+			map[string]string{
+				"week": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	timesheet, err := h.attendanceUsecase.GetTimesheet(c.Request().Context(), employeeID, week)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting timesheet: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Timesheet retrieved successfully", toWeeklyTimesheetResponse(timesheet))
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetTimesheets godoc
+// @Summary List submitted timesheets
+// @Description Lists the employee's submitted timesheets, latest week first
+// @Tags Attendance
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Success 200 {object} TimesheetListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/timesheets [get]
+func (h *AttendanceHandler) GetTimesheets(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	timesheets, err := h.attendanceUsecase.GetTimesheets(c.Request().Context(), employeeID)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting timesheets: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(timesheets) == 0 {
+		return apiresponse.Success(c, "No timesheets found", nil)
+	}
+
+	timesheetsResponse := []TimesheetResponse{}
+	for _, timesheet := range timesheets {
+		timesheetsResponse = append(timesheetsResponse, toTimesheetResponse(timesheet))
+	}
+
+	return apiresponse.Success(c, "Timesheets retrieved successfully", timesheetsResponse)
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetCourseById godoc
+// @Summary Get a training course
+// @Description Retrieve a training course
+// @Tags Training
+// @Produce json
+// @Param id path int true "Training course ID"
+// @Success 200 {object} TrainingCourseResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /training-courses/{id} [get]
+func (h *TrainingHandler) GetCourseById(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidTrainingCourseId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	course, err := h.trainingUsecase.GetCourseById(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error getting training course: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Training course retrieved successfully", toTrainingCourseResponse(course))
+}
@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// GetWorkingDays godoc
+// @Summary Count an employee's working days
+// @Description Counts the weekdays from one date to another inclusive, minus the public holidays of the employee's location
+// @Tags Holidays
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param from query string true "First date (YYYY-MM-DD)"
+// @Param to query string true "Last date (YYYY-MM-DD)"
+// @Success 200 {object} WorkingDaysResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/working-days [get]
+func (h *HolidayCalendarHandler) GetWorkingDays(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	from, err := time.Parse(constants.DateFormat, c.QueryParam("from"))
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidDateRange,
+			map[string]string{
+				"from": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+	to, err := time.Parse(constants.DateFormat, c.QueryParam("to"))
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidDateRange,
+			map[string]string{
+				"to": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	workingDays, err := h.workingDays.GetWorkingDays(c.Request().Context(), employeeID, from, to)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error counting working days: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Working days retrieved successfully", toWorkingDaysResponse(workingDays))
+}
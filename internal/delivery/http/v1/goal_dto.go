@@ -0,0 +1,344 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// KeyResultRequest is the payload for adding a key result to a goal.
+// swagger:model KeyResultRequest
+type KeyResultRequest struct {
+	// example: Close enterprise deals
+	Title string `json:"title"`
+
+	// example: deals
+	Unit string `json:"unit"`
+
+	// example: 0
+	StartValue float64 `json:"start_value"`
+
+	// May be below the start value, e.g. for reducing costs
+	// example: 12
+	TargetValue float64 `json:"target_value"`
+}
+
+// GoalRequest is the payload for creating or updating a goal.
+// swagger:model GoalRequest
+type GoalRequest struct {
+	// Owner of the goal; empty for a company goal. Ignored on update.
+	// example: 1
+	EmployeeID *int `json:"employee_id"`
+
+	// Company goal, or goal of the owner's manager, this goal contributes to
+	// example: 3
+	ParentID *int `json:"parent_id"`
+
+	// example: Grow enterprise revenue
+	Title string `json:"title"`
+
+	// example: Expand into the enterprise segment this half
+	Description string `json:"description"`
+
+	// example: 2025-01-01
+	StartDate string `json:"start_date"`
+
+	// example: 2025-06-30
+	DueDate string `json:"due_date"`
+
+	// One of active, completed or cancelled; defaults to active
+	// example: active
+	Status string `json:"status"`
+
+	// 1 to 10 key results; required on create, ignored on update
+	KeyResults []KeyResultRequest `json:"key_results"`
+}
+
+// KeyResultResponse represents a key result with its progress.
+// swagger:model KeyResultResponse
+type KeyResultResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: Close enterprise deals
+	Title string `json:"title"`
+
+	// example: deals
+	Unit string `json:"unit,omitempty"`
+
+	// example: 0
+	StartValue float64 `json:"start_value"`
+
+	// example: 12
+	TargetValue float64 `json:"target_value"`
+
+	// example: 9
+	CurrentValue float64 `json:"current_value"`
+
+	// Percentage from start to target value
+	// example: 75
+	Progress float64 `json:"progress"`
+}
+
+// GoalResponse represents a goal with its key results.
+// swagger:model GoalResponse
+type GoalResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// Empty for a company goal
+	// example: 1
+	EmployeeID *int `json:"employee_id,omitempty"`
+
+	// example: John Doe
+	EmployeeName string `json:"employee_name,omitempty"`
+
+	// example: 3
+	ParentID *int `json:"parent_id,omitempty"`
+
+	// example: Grow enterprise revenue
+	Title string `json:"title"`
+
+	// example: Expand into the enterprise segment this half
+	Description string `json:"description,omitempty"`
+
+	// example: 2025-01-01
+	StartDate string `json:"start_date"`
+
+	// example: 2025-06-30
+	DueDate string `json:"due_date"`
+
+	// example: active
+	Status string `json:"status"`
+
+	// 100 once completed, otherwise the average progress of the key results
+	// example: 75
+	Progress float64 `json:"progress"`
+
+	KeyResults []KeyResultResponse `json:"key_results"`
+
+	// example: 2025-01-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-03-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// GoalCheckInRequest is the payload for checking in on a key result.
+// swagger:model GoalCheckInRequest
+type GoalCheckInRequest struct {
+	// example: 1
+	KeyResultID int `json:"key_result_id"`
+
+	// New current value of the key result
+	// example: 9
+	Value float64 `json:"value"`
+
+	// example: Two deals signed this week
+	Comment string `json:"comment"`
+}
+
+// GoalCheckInResponse represents a check-in on a key result.
+// swagger:model GoalCheckInResponse
+type GoalCheckInResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	GoalID int `json:"goal_id"`
+
+	// example: 1
+	KeyResultID int `json:"key_result_id"`
+
+	// example: Close enterprise deals
+	KeyResultTitle string `json:"key_result_title"`
+
+	// example: 9
+	Value float64 `json:"value"`
+
+	// example: Two deals signed this week
+	Comment string `json:"comment,omitempty"`
+
+	// example: 2025-03-01 08:00:00
+	CreatedAt string `json:"created_at"`
+}
+
+// GoalRollupResponse is the goal progress of an employee and the team reporting to them.
+// swagger:model GoalRollupResponse
+type GoalRollupResponse struct {
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: Jane Smith
+	EmployeeName string `json:"employee_name"`
+
+	// The employee's own goals, excluding cancelled ones
+	// example: 2
+	Goals int `json:"goals"`
+
+	// Average progress of the employee's own goals
+	// example: 62.5
+	Progress *float64 `json:"progress,omitempty"`
+
+	// Goals of the employee and everyone reporting to them
+	// example: 9
+	TeamGoals int `json:"team_goals"`
+
+	// Average progress of the team's goals
+	// example: 48.33
+	TeamProgress *float64 `json:"team_progress,omitempty"`
+
+	Reports []GoalRollupResponse `json:"reports"`
+}
+
+// GoalResponseWrapper wraps StandardResponse with GoalResponse as data.
+// swagger:model GoalResponseWrapper
+type GoalResponseWrapper struct {
+	Success   bool         `json:"success"`
+	Message   string       `json:"message"`
+	Data      GoalResponse `json:"data"`
+	Timestamp string       `json:"timestamp"`
+	RequestID string       `json:"request_id"`
+}
+
+// GoalListResponseWrapper wraps StandardResponse with a list of goals.
+// swagger:model GoalListResponseWrapper
+type GoalListResponseWrapper struct {
+	Success   bool           `json:"success"`
+	Message   string         `json:"message"`
+	Data      []GoalResponse `json:"data"`
+	Timestamp string         `json:"timestamp"`
+	RequestID string         `json:"request_id"`
+}
+
+// GoalCheckInResponseWrapper wraps StandardResponse with GoalCheckInResponse as data.
+// swagger:model GoalCheckInResponseWrapper
+type GoalCheckInResponseWrapper struct {
+	Success   bool                `json:"success"`
+	Message   string              `json:"message"`
+	Data      GoalCheckInResponse `json:"data"`
+	Timestamp string              `json:"timestamp"`
+	RequestID string              `json:"request_id"`
+}
+
+// GoalCheckInListResponseWrapper wraps StandardResponse with a list of check-ins.
+// swagger:model GoalCheckInListResponseWrapper
+type GoalCheckInListResponseWrapper struct {
+	Success   bool                  `json:"success"`
+	Message   string                `json:"message"`
+	Data      []GoalCheckInResponse `json:"data"`
+	Timestamp string                `json:"timestamp"`
+	RequestID string                `json:"request_id"`
+}
+
+// GoalRollupResponseWrapper wraps StandardResponse with GoalRollupResponse as data.
+// swagger:model GoalRollupResponseWrapper
+type GoalRollupResponseWrapper struct {
+	Success   bool               `json:"success"`
+	Message   string             `json:"message"`
+	Data      GoalRollupResponse `json:"data"`
+	Timestamp string             `json:"timestamp"`
+	RequestID string             `json:"request_id"`
+}
+
+// toGoalEntity maps the request, returning the date that could not be parsed.
+func toGoalEntity(req GoalRequest) (*entity.Goal, map[string]string, error) {
+	startDate, err := time.Parse(constants.DateFormat, req.StartDate)
+	if err != nil {
+		return nil, map[string]string{
+			"start_date": "Date format is invalid , expected format: YYYY-MM-DD",
+		}, appError.ErrInvalidGoal
+	}
+	dueDate, err := time.Parse(constants.DateFormat, req.DueDate)
+	if err != nil {
+		return nil, map[string]string{
+			"due_date": "Date format is invalid , expected format: YYYY-MM-DD",
+		}, appError.ErrInvalidGoal
+	}
+
+	goal := &entity.Goal{
+		EmployeeID:  req.EmployeeID,
+		ParentID:    req.ParentID,
+		Title:       req.Title,
+		Description: req.Description,
+		StartDate:   startDate,
+		DueDate:     dueDate,
+		Status:      entity.GoalStatus(req.Status),
+		KeyResults:  []entity.KeyResult{},
+	}
+	for _, keyResult := range req.KeyResults {
+		goal.KeyResults = append(goal.KeyResults, *toKeyResultEntity(0, keyResult))
+	}
+	return goal, nil, nil
+}
+
+func toKeyResultEntity(goalID int, req KeyResultRequest) *entity.KeyResult {
+	return &entity.KeyResult{
+		GoalID:      goalID,
+		Title:       req.Title,
+		Unit:        req.Unit,
+		StartValue:  req.StartValue,
+		TargetValue: req.TargetValue,
+	}
+}
+
+func toGoalResponse(goal *entity.Goal) GoalResponse {
+	response := GoalResponse{
+		ID:           goal.ID,
+		EmployeeID:   goal.EmployeeID,
+		EmployeeName: goal.EmployeeName,
+		ParentID:     goal.ParentID,
+		Title:        goal.Title,
+		Description:  goal.Description,
+		StartDate:    goal.StartDate.Format(constants.DateFormat),
+		DueDate:      goal.DueDate.Format(constants.DateFormat),
+		Status:       string(goal.Status),
+		Progress:     goal.Progress(),
+		KeyResults:   []KeyResultResponse{},
+		CreatedAt:    goal.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:    goal.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+	for _, keyResult := range goal.KeyResults {
+		response.KeyResults = append(response.KeyResults, KeyResultResponse{
+			ID:           keyResult.ID,
+			Title:        keyResult.Title,
+			Unit:         keyResult.Unit,
+			StartValue:   keyResult.StartValue,
+			TargetValue:  keyResult.TargetValue,
+			CurrentValue: keyResult.CurrentValue,
+			Progress:     keyResult.Progress(),
+		})
+	}
+	return response
+}
+
+func toGoalCheckInResponse(checkIn *entity.GoalCheckIn) GoalCheckInResponse {
+	return GoalCheckInResponse{
+		ID:             checkIn.ID,
+		GoalID:         checkIn.GoalID,
+		KeyResultID:    checkIn.KeyResultID,
+		KeyResultTitle: checkIn.KeyResultTitle,
+		Value:          checkIn.Value,
+		Comment:        checkIn.Comment,
+		CreatedAt:      checkIn.CreatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toGoalRollupResponse(rollup *usecase.GoalRollup) GoalRollupResponse {
+	response := GoalRollupResponse{
+		EmployeeID:   rollup.EmployeeID,
+		EmployeeName: rollup.EmployeeName,
+		Goals:        rollup.Goals,
+		Progress:     rollup.Progress,
+		TeamGoals:    rollup.TeamGoals,
+		TeamProgress: rollup.TeamProgress,
+		Reports:      []GoalRollupResponse{},
+	}
+	for _, report := range rollup.Reports {
+		response.Reports = append(response.Reports, toGoalRollupResponse(report))
+	}
+	return response
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type GoalHandler struct {
+	goalUsecase usecase.GoalUsecase
+}
+
+func NewGoalHandler(goalUsecase usecase.GoalUsecase) *GoalHandler {
+	return &GoalHandler{goalUsecase: goalUsecase}
+}
@@ -0,0 +1,198 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// HolidayCalendarRequest is the payload for creating or updating a holiday calendar.
+// swagger:model HolidayCalendarRequest
+type HolidayCalendarRequest struct {
+	// example: India public holidays
+	Name string `json:"name"`
+
+	// ISO 3166-1 alpha-2 country code, optional
+	// example: IN
+	Country string `json:"country"`
+}
+
+// HolidayCalendarResponse represents a holiday calendar.
+// swagger:model HolidayCalendarResponse
+type HolidayCalendarResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: India public holidays
+	Name string `json:"name"`
+
+	// example: IN
+	Country string `json:"country,omitempty"`
+
+	// example: 2025-01-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-01-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// HolidayRequest is the payload for adding a holiday to a calendar.
+// swagger:model HolidayRequest
+type HolidayRequest struct {
+	// example: 2025-08-15
+	Date string `json:"date"`
+
+	// example: Independence Day
+	Name string `json:"name"`
+}
+
+// HolidayResponse represents a holiday.
+// swagger:model HolidayResponse
+type HolidayResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	CalendarID int `json:"calendar_id"`
+
+	// example: 2025-08-15
+	Date string `json:"date"`
+
+	// example: Independence Day
+	Name string `json:"name"`
+}
+
+// HolidayImportResponse reports the outcome of an iCalendar import.
+// swagger:model HolidayImportResponse
+type HolidayImportResponse struct {
+	// Number of holidays added or renamed
+	// example: 14
+	Imported int `json:"imported"`
+}
+
+// WorkingDaysResponse breaks down the dates of a range for an employee.
+// swagger:model WorkingDaysResponse
+type WorkingDaysResponse struct {
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: 2025-08-01
+	From string `json:"from"`
+
+	// example: 2025-08-31
+	To string `json:"to"`
+
+	// example: 31
+	CalendarDays int `json:"calendar_days"`
+
+	// example: 10
+	WeekendDays int `json:"weekend_days"`
+
+	// Holidays falling on weekdays
+	Holidays []HolidayResponse `json:"holidays"`
+
+	// example: 20
+	WorkingDays int `json:"working_days"`
+}
+
+// HolidayCalendarResponseWrapper wraps StandardResponse with HolidayCalendarResponse as data.
+// swagger:model HolidayCalendarResponseWrapper
+type HolidayCalendarResponseWrapper struct {
+	Success   bool                    `json:"success"`
+	Message   string                  `json:"message"`
+	Data      HolidayCalendarResponse `json:"data"`
+	Timestamp string                  `json:"timestamp"`
+	RequestID string                  `json:"request_id"`
+}
+
+// HolidayCalendarListResponseWrapper wraps StandardResponse with a list of holiday calendars.
+// swagger:model HolidayCalendarListResponseWrapper
+type HolidayCalendarListResponseWrapper struct {
+	Success   bool                      `json:"success"`
+	Message   string                    `json:"message"`
+	Data      []HolidayCalendarResponse `json:"data"`
+	Timestamp string                    `json:"timestamp"`
+	RequestID string                    `json:"request_id"`
+}
+
+// HolidayResponseWrapper wraps StandardResponse with HolidayResponse as data.
+// swagger:model HolidayResponseWrapper
+type HolidayResponseWrapper struct {
+	Success   bool            `json:"success"`
+	Message   string          `json:"message"`
+	Data      HolidayResponse `json:"data"`
+	Timestamp string          `json:"timestamp"`
+	RequestID string          `json:"request_id"`
+}
+
+// HolidayListResponseWrapper wraps StandardResponse with a list of holidays.
+// swagger:model HolidayListResponseWrapper
+type HolidayListResponseWrapper struct {
+	Success   bool              `json:"success"`
+	Message   string            `json:"message"`
+	Data      []HolidayResponse `json:"data"`
+	Timestamp string            `json:"timestamp"`
+	RequestID string            `json:"request_id"`
+}
+
+// HolidayImportResponseWrapper wraps StandardResponse with HolidayImportResponse as data.
+// swagger:model HolidayImportResponseWrapper
+type HolidayImportResponseWrapper struct {
+	Success   bool                  `json:"success"`
+	Message   string                `json:"message"`
+	Data      HolidayImportResponse `json:"data"`
+	Timestamp string                `json:"timestamp"`
+	RequestID string                `json:"request_id"`
+}
+
+// WorkingDaysResponseWrapper wraps StandardResponse with WorkingDaysResponse as data.
+// swagger:model WorkingDaysResponseWrapper
+type WorkingDaysResponseWrapper struct {
+	Success   bool                `json:"success"`
+	Message   string              `json:"message"`
+	Data      WorkingDaysResponse `json:"data"`
+	Timestamp string              `json:"timestamp"`
+	RequestID string              `json:"request_id"`
+}
+
+func toHolidayCalendarEntity(req HolidayCalendarRequest) *entity.HolidayCalendar {
+	return &entity.HolidayCalendar{
+		Name:    req.Name,
+		Country: req.Country,
+	}
+}
+
+func toHolidayCalendarResponse(calendar *entity.HolidayCalendar) HolidayCalendarResponse {
+	return HolidayCalendarResponse{
+		ID:        calendar.ID,
+		Name:      calendar.Name,
+		Country:   calendar.Country,
+		CreatedAt: calendar.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt: calendar.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toHolidayResponse(holiday *entity.Holiday) HolidayResponse {
+	return HolidayResponse{
+		ID:         holiday.ID,
+		CalendarID: holiday.CalendarID,
+		Date:       holiday.Date.Format(constants.DateFormat),
+		Name:       holiday.Name,
+	}
+}
+
+func toWorkingDaysResponse(workingDays *usecase.WorkingDays) WorkingDaysResponse {
+	holidays := []HolidayResponse{}
+	for _, holiday := range workingDays.Holidays {
+		holidays = append(holidays, toHolidayResponse(holiday))
+	}
+	return WorkingDaysResponse{
+		EmployeeID:   workingDays.EmployeeID,
+		From:         workingDays.Start.Format(constants.DateFormat),
+		To:           workingDays.End.Format(constants.DateFormat),
+		CalendarDays: workingDays.CalendarDays,
+		WeekendDays:  workingDays.WeekendDays,
+		Holidays:     holidays,
+		WorkingDays:  workingDays.WorkingDays,
+	}
+}
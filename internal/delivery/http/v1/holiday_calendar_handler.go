@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type HolidayCalendarHandler struct {
+	holidayCalendarUsecase usecase.HolidayCalendarUsecase
+	workingDays            usecase.WorkingDayCalculator
+}
+
+func NewHolidayCalendarHandler(holidayCalendarUsecase usecase.HolidayCalendarUsecase,
+	workingDays usecase.WorkingDayCalculator) *HolidayCalendarHandler {
+	return &HolidayCalendarHandler{
+		holidayCalendarUsecase: holidayCalendarUsecase,
+		workingDays:            workingDays,
+	}
+}
@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"io"
+	"log"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// maxICalendarSize caps uploaded calendars; a few years of public holidays take a few kilobytes.
+const maxICalendarSize = 1 << 20
+
+// ImportHolidays godoc
+// @Summary Import holidays from an iCalendar file
+// @Description Adds every day covered by the events of an .ics file to the calendar. Send the file as the request body (text/calendar) or as the "file" field of a multipart form. Yearly recurring events are expanded up to the end of next year; dates already in the calendar are renamed.
+// @Tags Holidays
+// @Accept text/calendar
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Holiday calendar ID"
+// @Param file formData file false "iCalendar file"
+// @Success 200 {object} HolidayImportResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /holiday-calendars/{id}/import [post]
+func (h *HolidayCalendarHandler) ImportHolidays(c echo.Context) error {
+	calendarID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidHolidayCalendarId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var ics io.Reader = c.Request().Body
+	if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEMultipartForm) {
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			return apiresponse.Error(c,
+				appError.ErrMissingRequiredFields,
+				map[string]string{
+					"file": "An iCalendar file is required",
+				})
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			log.Printf("Error opening uploaded calendar: %v", err)
+			return apiresponse.Error(c, err, nil)
+		}
+		defer file.Close()
+		ics = file
+	}
+
+	imported, err := h.holidayCalendarUsecase.ImportHolidays(c.Request().Context(), calendarID,
+		io.LimitReader(ics, maxICalendarSize))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error importing holidays: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Holidays imported successfully", HolidayImportResponse{Imported: imported})
+}
@@ -0,0 +1,267 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// LeaveTypeRequest is the payload for creating or updating a leave type.
+// swagger:model LeaveTypeRequest
+type LeaveTypeRequest struct {
+	// example: Annual leave
+	Name string `json:"name"`
+
+	// One of none (no balance kept), annual or monthly
+	// example: monthly
+	AccrualPolicy string `json:"accrual_policy"`
+
+	// Days granted over a full year of service
+	// example: 24
+	DaysPerYear float64 `json:"days_per_year"`
+
+	// Unused days that move to the next year
+	// example: 5
+	MaxCarryOverDays float64 `json:"max_carry_over_days"`
+
+	// Defaults to true
+	// example: true
+	IsPaid *bool `json:"is_paid"`
+}
+
+// LeaveTypeResponse represents a leave type.
+// swagger:model LeaveTypeResponse
+type LeaveTypeResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: Annual leave
+	Name string `json:"name"`
+
+	// example: monthly
+	AccrualPolicy string `json:"accrual_policy"`
+
+	// example: 24
+	DaysPerYear float64 `json:"days_per_year"`
+
+	// example: 5
+	MaxCarryOverDays float64 `json:"max_carry_over_days"`
+
+	// example: true
+	IsPaid bool `json:"is_paid"`
+
+	// example: 2025-01-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-01-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// LeaveBalanceResponse is an employee's allowance of one leave type for a year.
+// swagger:model LeaveBalanceResponse
+type LeaveBalanceResponse struct {
+	// example: 1
+	LeaveTypeID int `json:"leave_type_id"`
+
+	// example: 2025
+	Year int `json:"year"`
+
+	// example: 12
+	AccruedDays float64 `json:"accrued_days"`
+
+	// example: 3
+	CarriedOverDays float64 `json:"carried_over_days"`
+
+	// example: 5
+	UsedDays float64 `json:"used_days"`
+
+	// example: 10
+	RemainingDays float64 `json:"remaining_days"`
+
+	// example: 2025-06-30 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// CreateLeaveRequestRequest is the payload for requesting leave.
+// swagger:model CreateLeaveRequestRequest
+type CreateLeaveRequestRequest struct {
+	// example: 1
+	LeaveTypeID int `json:"leave_type_id"`
+
+	// First day of leave (YYYY-MM-DD)
+	// example: 2025-08-04
+	StartDate string `json:"start_date"`
+
+	// Last day of leave (YYYY-MM-DD), inclusive
+	// example: 2025-08-08
+	EndDate string `json:"end_date"`
+
+	// example: Family holiday
+	Reason string `json:"reason"`
+}
+
+// LeaveDecisionRequest is the payload for approving or rejecting a leave request.
+// swagger:model LeaveDecisionRequest
+type LeaveDecisionRequest struct {
+	// ID of the deciding employee; must be the requester's manager when one is set
+	// example: 7
+	ApproverID int `json:"approver_id"`
+
+	// example: Enjoy your time off
+	Comment string `json:"comment"`
+}
+
+// LeaveRequestResponse represents a leave request.
+// swagger:model LeaveRequestResponse
+type LeaveRequestResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: 1
+	LeaveTypeID int `json:"leave_type_id"`
+
+	// example: 2025-08-04
+	StartDate string `json:"start_date"`
+
+	// example: 2025-08-08
+	EndDate string `json:"end_date"`
+
+	// Working days deducted from the balance
+	// example: 5
+	Days float64 `json:"days"`
+
+	// example: Family holiday
+	Reason string `json:"reason,omitempty"`
+
+	// One of pending, approved, rejected, cancelled
+	// example: approved
+	Status string `json:"status"`
+
+	// Approval request deciding the leave, when an approval workflow decides it
+	// example: 12
+	ApprovalRequestID *int `json:"approval_request_id,omitempty"`
+
+	// example: 7
+	DecidedBy *int `json:"decided_by,omitempty"`
+
+	// example: 2025-07-01 09:00:00
+	DecidedAt string `json:"decided_at,omitempty"`
+
+	// example: Enjoy your time off
+	DecisionComment string `json:"decision_comment,omitempty"`
+
+	// example: 2025-06-30 08:00:00
+	CreatedAt string `json:"created_at"`
+}
+
+// LeaveTypeResponseWrapper wraps StandardResponse with LeaveTypeResponse as data.
+// swagger:model LeaveTypeResponseWrapper
+type LeaveTypeResponseWrapper struct {
+	Success   bool              `json:"success"`
+	Message   string            `json:"message"`
+	Data      LeaveTypeResponse `json:"data"`
+	Timestamp string            `json:"timestamp"`
+	RequestID string            `json:"request_id"`
+}
+
+// LeaveTypeListResponseWrapper wraps StandardResponse with a list of leave types.
+// swagger:model LeaveTypeListResponseWrapper
+type LeaveTypeListResponseWrapper struct {
+	Success   bool                `json:"success"`
+	Message   string              `json:"message"`
+	Data      []LeaveTypeResponse `json:"data"`
+	Timestamp string              `json:"timestamp"`
+	RequestID string              `json:"request_id"`
+}
+
+// LeaveBalanceListResponseWrapper wraps StandardResponse with a list of leave balances.
+// swagger:model LeaveBalanceListResponseWrapper
+type LeaveBalanceListResponseWrapper struct {
+	Success   bool                   `json:"success"`
+	Message   string                 `json:"message"`
+	Data      []LeaveBalanceResponse `json:"data"`
+	Timestamp string                 `json:"timestamp"`
+	RequestID string                 `json:"request_id"`
+}
+
+// LeaveRequestResponseWrapper wraps StandardResponse with LeaveRequestResponse as data.
+// swagger:model LeaveRequestResponseWrapper
+type LeaveRequestResponseWrapper struct {
+	Success   bool                 `json:"success"`
+	Message   string               `json:"message"`
+	Data      LeaveRequestResponse `json:"data"`
+	Timestamp string               `json:"timestamp"`
+	RequestID string               `json:"request_id"`
+}
+
+// LeaveRequestListResponseWrapper wraps StandardResponse with a list of leave requests.
+// swagger:model LeaveRequestListResponseWrapper
+type LeaveRequestListResponseWrapper struct {
+	Success   bool                   `json:"success"`
+	Message   string                 `json:"message"`
+	Data      []LeaveRequestResponse `json:"data"`
+	Timestamp string                 `json:"timestamp"`
+	RequestID string                 `json:"request_id"`
+}
+
+func toLeaveTypeEntity(req LeaveTypeRequest) *entity.LeaveType {
+	isPaid := true
+	if req.IsPaid != nil {
+		isPaid = *req.IsPaid
+	}
+	return &entity.LeaveType{
+		Name:             req.Name,
+		AccrualPolicy:    entity.LeaveAccrualPolicy(req.AccrualPolicy),
+		DaysPerYear:      req.DaysPerYear,
+		MaxCarryOverDays: req.MaxCarryOverDays,
+		IsPaid:           isPaid,
+	}
+}
+
+func toLeaveTypeResponse(leaveType *entity.LeaveType) LeaveTypeResponse {
+	return LeaveTypeResponse{
+		ID:               leaveType.ID,
+		Name:             leaveType.Name,
+		AccrualPolicy:    string(leaveType.AccrualPolicy),
+		DaysPerYear:      leaveType.DaysPerYear,
+		MaxCarryOverDays: leaveType.MaxCarryOverDays,
+		IsPaid:           leaveType.IsPaid,
+		CreatedAt:        leaveType.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:        leaveType.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toLeaveBalanceResponse(balance *entity.LeaveBalance) LeaveBalanceResponse {
+	return LeaveBalanceResponse{
+		LeaveTypeID:     balance.LeaveTypeID,
+		Year:            balance.Year,
+		AccruedDays:     balance.AccruedDays,
+		CarriedOverDays: balance.CarriedOverDays,
+		UsedDays:        balance.UsedDays,
+		RemainingDays:   balance.RemainingDays(),
+		UpdatedAt:       balance.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toLeaveRequestResponse(request *entity.LeaveRequest) LeaveRequestResponse {
+	response := LeaveRequestResponse{
+		ID:                request.ID,
+		EmployeeID:        request.EmployeeID,
+		LeaveTypeID:       request.LeaveTypeID,
+		StartDate:         request.StartDate.Format(constants.DateFormat),
+		EndDate:           request.EndDate.Format(constants.DateFormat),
+		Days:              request.Days,
+		Reason:            request.Reason,
+		Status:            string(request.Status),
+		ApprovalRequestID: request.ApprovalRequestID,
+		DecidedBy:         request.DecidedBy,
+		DecisionComment:   request.DecisionComment,
+		CreatedAt:         request.CreatedAt.Format(constants.DateTimeFormat),
+	}
+	if request.DecidedAt != nil {
+		response.DecidedAt = request.DecidedAt.Format(constants.DateTimeFormat)
+	}
+	return response
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type LeaveHandler struct {
+	leaveUsecase usecase.LeaveUsecase
+}
+
+func NewLeaveHandler(leaveUsecase usecase.LeaveUsecase) *LeaveHandler {
+	return &LeaveHandler{leaveUsecase: leaveUsecase}
+}
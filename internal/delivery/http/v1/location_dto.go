@@ -0,0 +1,175 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// LocationRequest is the payload for creating or updating an office location.
+// swagger:model LocationRequest
+type LocationRequest struct {
+	// example: Bengaluru office
+	Name string `json:"name"`
+
+	// Office address, omit for remote locations
+	Address *AddressDTO `json:"address"`
+
+	// IANA time zone used for dates at the location, defaults to UTC
+	// example: Asia/Kolkata
+	TimeZone string `json:"time_zone"`
+
+	// Marks a location grouping employees working remotely
+	// example: false
+	Remote bool `json:"remote"`
+
+	// Holiday calendar observed at the location, omit for weekends only
+	// example: 1
+	HolidayCalendarID *int `json:"holiday_calendar_id"`
+}
+
+// LocationResponse represents an office location.
+// swagger:model LocationResponse
+type LocationResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: Bengaluru office
+	Name string `json:"name"`
+
+	Address *AddressDTO `json:"address,omitempty"`
+
+	// example: Asia/Kolkata
+	TimeZone string `json:"time_zone"`
+
+	// example: false
+	Remote bool `json:"remote"`
+
+	// example: 1
+	HolidayCalendarID *int `json:"holiday_calendar_id,omitempty"`
+
+	// example: 2025-01-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-01-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// LocationResponseWrapper wraps StandardResponse with LocationResponse as data.
+// swagger:model LocationResponseWrapper
+type LocationResponseWrapper struct {
+	Success   bool             `json:"success"`
+	Message   string           `json:"message"`
+	Data      LocationResponse `json:"data"`
+	Timestamp string           `json:"timestamp"`
+	RequestID string           `json:"request_id"`
+}
+
+// LocationListResponseWrapper wraps StandardResponse with a list of locations.
+// swagger:model LocationListResponseWrapper
+type LocationListResponseWrapper struct {
+	Success   bool               `json:"success"`
+	Message   string             `json:"message"`
+	Data      []LocationResponse `json:"data"`
+	Timestamp string             `json:"timestamp"`
+	RequestID string             `json:"request_id"`
+}
+
+// LocationAssignmentRequest is the payload for moving an employee to another primary location.
+// swagger:model LocationAssignmentRequest
+type LocationAssignmentRequest struct {
+	// example: 2
+	LocationID int `json:"location_id"`
+
+	// Date the employee moves (YYYY-MM-DD) at the new location; future dates are applied by a
+	// background job
+	// example: 2025-04-01
+	EffectiveDate string `json:"effective_date"`
+}
+
+// LocationAssignmentResponse is one entry of an employee's location timeline.
+// swagger:model LocationAssignmentResponse
+type LocationAssignmentResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: 2
+	LocationID int `json:"location_id"`
+
+	// example: Bengaluru office
+	LocationName string `json:"location_name"`
+
+	// example: 2025-04-01
+	EffectiveDate string `json:"effective_date"`
+
+	// One of scheduled, applied, cancelled
+	// example: scheduled
+	Status string `json:"status"`
+
+	// example: 2025-04-01 00:00:05
+	AppliedAt string `json:"applied_at,omitempty"`
+
+	// example: 2025-03-10 09:00:00
+	CreatedAt string `json:"created_at"`
+}
+
+// LocationAssignmentResponseWrapper wraps StandardResponse with LocationAssignmentResponse as data.
+// swagger:model LocationAssignmentResponseWrapper
+type LocationAssignmentResponseWrapper struct {
+	Success   bool                       `json:"success"`
+	Message   string                     `json:"message"`
+	Data      LocationAssignmentResponse `json:"data"`
+	Timestamp string                     `json:"timestamp"`
+	RequestID string                     `json:"request_id"`
+}
+
+// LocationHistoryResponseWrapper wraps StandardResponse with the location timeline.
+// swagger:model LocationHistoryResponseWrapper
+type LocationHistoryResponseWrapper struct {
+	Success   bool                         `json:"success"`
+	Message   string                       `json:"message"`
+	Data      []LocationAssignmentResponse `json:"data"`
+	Timestamp string                       `json:"timestamp"`
+	RequestID string                       `json:"request_id"`
+}
+
+func toLocationEntity(req LocationRequest) *entity.Location {
+	return &entity.Location{
+		Name:              req.Name,
+		Address:           toAddressEntity(req.Address),
+		TimeZone:          req.TimeZone,
+		Remote:            req.Remote,
+		HolidayCalendarID: req.HolidayCalendarID,
+	}
+}
+
+func toLocationResponse(location *entity.Location) LocationResponse {
+	return LocationResponse{
+		ID:                location.ID,
+		Name:              location.Name,
+		Address:           toAddressDTO(location.Address),
+		TimeZone:          location.TimeZone,
+		Remote:            location.Remote,
+		HolidayCalendarID: location.HolidayCalendarID,
+		CreatedAt:         location.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:         location.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toLocationAssignmentResponse(assignment *entity.LocationAssignment) LocationAssignmentResponse {
+	response := LocationAssignmentResponse{
+		ID:            assignment.ID,
+		EmployeeID:    assignment.EmployeeID,
+		LocationID:    assignment.LocationID,
+		LocationName:  assignment.LocationName,
+		EffectiveDate: assignment.EffectiveDate.Format(constants.DateFormat),
+		Status:        string(assignment.Status),
+		CreatedAt:     assignment.CreatedAt.Format(constants.DateTimeFormat),
+	}
+	if assignment.AppliedAt != nil {
+		response.AppliedAt = assignment.AppliedAt.Format(constants.DateTimeFormat)
+	}
+	return response
+}
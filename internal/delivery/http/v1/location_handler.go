@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type LocationHandler struct {
+	locationUsecase usecase.LocationUsecase
+}
+
+func NewLocationHandler(locationUsecase usecase.LocationUsecase) *LocationHandler {
+	return &LocationHandler{locationUsecase: locationUsecase}
+}
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// LockPayrollRun godoc
+// @Summary Lock a payroll run
+// @Description Closes a finalized run for good, e.g. once it has been paid. A locked run cannot be reopened or deleted. The expense claims the run pays back are marked reimbursed as of the pay date.
+// @Tags Payroll
+// @Produce json
+// @Param id path int true "Payroll run ID"
+// @Success 200 {object} PayrollRunResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /payroll-runs/{id}/lock [post]
+func (h *PayrollHandler) LockPayrollRun(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidPayrollRunId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	run, err := h.payrollUsecase.LockPayrollRun(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error locking payroll run: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Payroll run locked successfully", toPayrollRunResponse(run))
+}
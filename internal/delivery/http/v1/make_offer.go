@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// MakeOffer godoc
+// @Summary Make a candidate an offer
+// @Description Makes, or revises, the offer of a candidate and moves them to the offer stage
+// @Tags Recruiting
+// @Accept json
+// @Produce json
+// @Param id path int true "Candidate ID"
+// @Param payload body OfferRequest true "Offer payload"
+// @Success 200 {object} CandidateResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /candidates/{id}/offer [post]
+func (h *RecruitingHandler) MakeOffer(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCandidateId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req OfferRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"salary":     "Salary is required",
+				"start_date": "Start date is required",
+			})
+	}
+
+	offer, details, err := toJobOfferEntity(req)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+
+	candidate, err := h.recruitingUsecase.MakeOffer(c.Request().Context(), id, offer)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error making offer: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Offer made successfully", toCandidateResponse(candidate))
+}
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+func toMoneyEntity(dto MoneyDTO) entity.Money {
+	return entity.Money{Amount: dto.Amount, Currency: dto.Currency}
+}
+
+func toMoneyDTO(money entity.Money) MoneyDTO {
+	return MoneyDTO{Amount: money.Amount, Currency: money.Currency}
+}
+
+func toAddressEntity(dto *AddressDTO) entity.Address {
+	if dto == nil {
+		return entity.Address{}
+	}
+	return entity.Address{
+		Line1:      dto.Line1,
+		Line2:      dto.Line2,
+		City:       dto.City,
+		State:      dto.State,
+		PostalCode: dto.PostalCode,
+		Country:    dto.Country,
+	}
+}
+
+func toAddressDTO(address entity.Address) *AddressDTO {
+	if address.IsZero() {
+		return nil
+	}
+	return &AddressDTO{
+		Line1:      address.Line1,
+		Line2:      address.Line2,
+		City:       address.City,
+		State:      address.State,
+		PostalCode: address.PostalCode,
+		Country:    address.Country,
+	}
+}
+
+// parseOptionalDate parses a YYYY-MM-DD date, returning nil for an empty string.
+func parseOptionalDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse(constants.DateFormat, value)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
+
+// parseTimeOfDay parses an HH:MM wall clock time.
+func parseTimeOfDay(value string) (entity.TimeOfDay, error) {
+	t, err := time.Parse(constants.TimeOfDayFormat, value)
+	if err != nil {
+		return 0, err
+	}
+	return entity.TimeOfDay(t.Hour()*60 + t.Minute()), nil
+}
+
+func formatOptionalDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+	return date.Format(constants.DateFormat)
+}
+
+func toGetEmployeeByIdResponse(employee *entity.Employee) GetEmployeeByIdResponse {
+	return GetEmployeeByIdResponse{
+		ID:                employee.ID,
+		Name:              employee.Name,
+		PositionID:        employee.PositionID,
+		Position:          employee.Position,
+		Salary:            toMoneyDTO(employee.Salary),
+		PayPeriod:         string(employee.PayPeriod),
+		SalaryOutOfBand:   employee.SalaryOutOfBand,
+		ManagerID:         employee.ManagerID,
+		LocationID:        employee.LocationID,
+		DepartmentID:      employee.DepartmentID,
+		HiredDate:         employee.HiredDate.Format(constants.DateFormat),
+		WorkEmail:         employee.WorkEmail,
+		PersonalEmail:     employee.PersonalEmail,
+		Phone:             employee.Phone,
+		Address:           toAddressDTO(employee.Address),
+		DateOfBirth:       formatOptionalDate(employee.DateOfBirth),
+		Status:            string(employee.Status),
+		TerminationDate:   formatOptionalDate(employee.TerminationDate),
+		TerminationReason: employee.TerminationReason,
+		CustomFields:      employee.CustomFields,
+		Tags:              employee.Tags,
+		UnreturnedAssets:  employee.UnreturnedAssets,
+		CreatedAt:         employee.CreatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toGetAllEmployeesResponse(employee *entity.Employee) GetAllEmployeesResponse {
+	return GetAllEmployeesResponse{
+		ID:                employee.ID,
+		Name:              employee.Name,
+		PositionID:        employee.PositionID,
+		Position:          employee.Position,
+		Salary:            toMoneyDTO(employee.Salary),
+		PayPeriod:         string(employee.PayPeriod),
+		SalaryOutOfBand:   employee.SalaryOutOfBand,
+		ManagerID:         employee.ManagerID,
+		LocationID:        employee.LocationID,
+		DepartmentID:      employee.DepartmentID,
+		HiredDate:         employee.HiredDate.Format(constants.DateFormat),
+		WorkEmail:         employee.WorkEmail,
+		PersonalEmail:     employee.PersonalEmail,
+		Phone:             employee.Phone,
+		Address:           toAddressDTO(employee.Address),
+		DateOfBirth:       formatOptionalDate(employee.DateOfBirth),
+		Status:            string(employee.Status),
+		TerminationDate:   formatOptionalDate(employee.TerminationDate),
+		TerminationReason: employee.TerminationReason,
+		CustomFields:      employee.CustomFields,
+		Tags:              employee.Tags,
+		CreatedAt:         employee.CreatedAt.Format(constants.DateTimeFormat),
+	}
+}
@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// MoveCandidate godoc
+// @Summary Move a candidate along the pipeline
+// @Description Moves a candidate to screening, interview, rejected or withdrawn. Candidates reach the offer stage with an offer and the hired stage by accepting it.
+// @Tags Recruiting
+// @Accept json
+// @Produce json
+// @Param id path int true "Candidate ID"
+// @Param payload body CandidateStageRequest true "Stage payload"
+// @Success 200 {object} CandidateResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /candidates/{id}/stage [post]
+func (h *RecruitingHandler) MoveCandidate(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCandidateId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req CandidateStageRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"stage": "Stage is required",
+			})
+	}
+
+	candidate, err := h.recruitingUsecase.MoveCandidate(c.Request().Context(), id, entity.CandidateStage(req.Stage))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error moving candidate: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Candidate moved successfully", toCandidateResponse(candidate))
+}
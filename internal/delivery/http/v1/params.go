@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+var errMissingParam = errors.New("missing path parameter")
+
+// parseIDParam reads a positive integer id from the named path parameter.
+func parseIDParam(c echo.Context, name string) (int, error) {
+	value := c.Param(name)
+	if value == "" {
+		return 0, errMissingParam
+	}
+	return strconv.Atoi(value)
+}
+
+// isBodyTooLarge reports whether reading the request failed because the body went over the upload
+// limit.
+func isBodyTooLarge(err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	return errors.As(err, &maxBytesErr)
+}
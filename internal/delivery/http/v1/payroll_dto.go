@@ -0,0 +1,374 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// PayrollRuleRequest is the payload for creating or updating a payroll rule.
+// swagger:model PayrollRuleRequest
+type PayrollRuleRequest struct {
+	// example: Provident fund
+	Name string `json:"name"`
+
+	// One of earning, deduction or tax
+	// example: deduction
+	Kind string `json:"kind"`
+
+	// fixed (amount per period) or percentage (rate)
+	// example: percentage
+	Method string `json:"method"`
+
+	// Fixed rules only, in minor units; converted to the salary currency when it differs
+	Amount *MoneyDTO `json:"amount"`
+
+	// Percentage rules only; of the base pay for earnings, the gross pay for deductions and the
+	// gross pay after deductions for taxes
+	// example: 12
+	Rate float64 `json:"rate"`
+
+	// Defaults to true
+	// example: true
+	IsActive *bool `json:"is_active"`
+}
+
+// PayrollRuleResponse represents a payroll rule.
+// swagger:model PayrollRuleResponse
+type PayrollRuleResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: Provident fund
+	Name string `json:"name"`
+
+	// example: deduction
+	Kind string `json:"kind"`
+
+	// example: percentage
+	Method string `json:"method"`
+
+	Amount *MoneyDTO `json:"amount,omitempty"`
+
+	// example: 12
+	Rate float64 `json:"rate,omitempty"`
+
+	// example: true
+	IsActive bool `json:"is_active"`
+
+	// example: 2025-01-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-01-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// PayrollPeriodRequest is the payload for opening a payroll period.
+// swagger:model PayrollPeriodRequest
+type PayrollPeriodRequest struct {
+	// Month of the period
+	// example: 2025-03
+	Period string `json:"period"`
+
+	// example: 2025-03-31
+	PayDate string `json:"pay_date"`
+}
+
+// PayrollPeriodResponse represents a payroll period.
+// swagger:model PayrollPeriodResponse
+type PayrollPeriodResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 2025-03
+	Period string `json:"period"`
+
+	// example: 2025-03-01
+	StartDate string `json:"start_date"`
+
+	// example: 2025-03-31
+	EndDate string `json:"end_date"`
+
+	// example: 2025-03-31
+	PayDate string `json:"pay_date"`
+
+	// example: 2025-03-01 08:00:00
+	CreatedAt string `json:"created_at"`
+}
+
+// PayrollRunRequest is the payload for starting a payroll run.
+// swagger:model PayrollRunRequest
+type PayrollRunRequest struct {
+	// example: 1
+	PayrollPeriodID int `json:"payroll_period_id"`
+}
+
+// PayrollRunResponse represents a payroll run.
+// swagger:model PayrollRunResponse
+type PayrollRunResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	PayrollPeriodID int `json:"payroll_period_id"`
+
+	// One of draft, finalized or locked
+	// example: draft
+	Status string `json:"status"`
+
+	// example: 42
+	PayslipCount int `json:"payslip_count"`
+
+	// example: 2025-03-28 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-03-28 08:00:00
+	UpdatedAt string `json:"updated_at"`
+
+	// example: 2025-03-29 10:00:00
+	FinalizedAt string `json:"finalized_at,omitempty"`
+
+	// example: 2025-03-31 18:00:00
+	LockedAt string `json:"locked_at,omitempty"`
+}
+
+// PayslipLineResponse is one amount on a payslip.
+// swagger:model PayslipLineResponse
+type PayslipLineResponse struct {
+	// Empty for the base pay, benefit deductions, reimbursements and once the rule has been deleted
+	// example: 1
+	PayrollRuleID *int `json:"payroll_rule_id,omitempty"`
+
+	// The expense claim a reimbursement pays back
+	// example: 3
+	ExpenseClaimID *int `json:"expense_claim_id,omitempty"`
+
+	// One of base, earning, deduction, tax or reimbursement
+	// example: deduction
+	Kind string `json:"kind"`
+
+	// example: Provident fund
+	Name string `json:"name"`
+
+	Amount MoneyDTO `json:"amount"`
+}
+
+// PayslipResponse represents an employee's payslip. Lines are only included for a single payslip.
+// swagger:model PayslipResponse
+type PayslipResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	PayrollRunID int `json:"payroll_run_id"`
+
+	// example: 2025-03
+	Period string `json:"period"`
+
+	// example: 2025-03-31
+	PayDate string `json:"pay_date"`
+
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: John Doe
+	EmployeeName string `json:"employee_name"`
+
+	// example: Software Engineer
+	Position string `json:"position,omitempty"`
+
+	Salary MoneyDTO `json:"salary"`
+
+	// example: monthly
+	PayPeriod string `json:"pay_period"`
+
+	BasePay         MoneyDTO `json:"base_pay"`
+	GrossPay        MoneyDTO `json:"gross_pay"`
+	TotalDeductions MoneyDTO `json:"total_deductions"`
+	TotalTax        MoneyDTO `json:"total_tax"`
+
+	// Expense claims paid back on top of the pay, untaxed
+	TotalReimbursements MoneyDTO              `json:"total_reimbursements"`
+	NetPay              MoneyDTO              `json:"net_pay"`
+	Lines               []PayslipLineResponse `json:"lines,omitempty"`
+
+	// example: 2025-03-28 08:00:00
+	CreatedAt string `json:"created_at"`
+}
+
+// PayrollRuleResponseWrapper wraps StandardResponse with PayrollRuleResponse as data.
+// swagger:model PayrollRuleResponseWrapper
+type PayrollRuleResponseWrapper struct {
+	Success   bool                `json:"success"`
+	Message   string              `json:"message"`
+	Data      PayrollRuleResponse `json:"data"`
+	Timestamp string              `json:"timestamp"`
+	RequestID string              `json:"request_id"`
+}
+
+// PayrollRuleListResponseWrapper wraps StandardResponse with a list of payroll rules.
+// swagger:model PayrollRuleListResponseWrapper
+type PayrollRuleListResponseWrapper struct {
+	Success   bool                  `json:"success"`
+	Message   string                `json:"message"`
+	Data      []PayrollRuleResponse `json:"data"`
+	Timestamp string                `json:"timestamp"`
+	RequestID string                `json:"request_id"`
+}
+
+// PayrollPeriodResponseWrapper wraps StandardResponse with PayrollPeriodResponse as data.
+// swagger:model PayrollPeriodResponseWrapper
+type PayrollPeriodResponseWrapper struct {
+	Success   bool                  `json:"success"`
+	Message   string                `json:"message"`
+	Data      PayrollPeriodResponse `json:"data"`
+	Timestamp string                `json:"timestamp"`
+	RequestID string                `json:"request_id"`
+}
+
+// PayrollPeriodListResponseWrapper wraps StandardResponse with a list of payroll periods.
+// swagger:model PayrollPeriodListResponseWrapper
+type PayrollPeriodListResponseWrapper struct {
+	Success   bool                    `json:"success"`
+	Message   string                  `json:"message"`
+	Data      []PayrollPeriodResponse `json:"data"`
+	Timestamp string                  `json:"timestamp"`
+	RequestID string                  `json:"request_id"`
+}
+
+// PayrollRunResponseWrapper wraps StandardResponse with PayrollRunResponse as data.
+// swagger:model PayrollRunResponseWrapper
+type PayrollRunResponseWrapper struct {
+	Success   bool               `json:"success"`
+	Message   string             `json:"message"`
+	Data      PayrollRunResponse `json:"data"`
+	Timestamp string             `json:"timestamp"`
+	RequestID string             `json:"request_id"`
+}
+
+// PayrollRunListResponseWrapper wraps StandardResponse with a list of payroll runs.
+// swagger:model PayrollRunListResponseWrapper
+type PayrollRunListResponseWrapper struct {
+	Success   bool                 `json:"success"`
+	Message   string               `json:"message"`
+	Data      []PayrollRunResponse `json:"data"`
+	Timestamp string               `json:"timestamp"`
+	RequestID string               `json:"request_id"`
+}
+
+// PayslipResponseWrapper wraps StandardResponse with PayslipResponse as data.
+// swagger:model PayslipResponseWrapper
+type PayslipResponseWrapper struct {
+	Success   bool            `json:"success"`
+	Message   string          `json:"message"`
+	Data      PayslipResponse `json:"data"`
+	Timestamp string          `json:"timestamp"`
+	RequestID string          `json:"request_id"`
+}
+
+// PayslipListResponseWrapper wraps StandardResponse with a list of payslips.
+// swagger:model PayslipListResponseWrapper
+type PayslipListResponseWrapper struct {
+	Success   bool              `json:"success"`
+	Message   string            `json:"message"`
+	Data      []PayslipResponse `json:"data"`
+	Timestamp string            `json:"timestamp"`
+	RequestID string            `json:"request_id"`
+}
+
+func toPayrollRuleEntity(req PayrollRuleRequest) *entity.PayrollRule {
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+	rule := &entity.PayrollRule{
+		Name:     req.Name,
+		Kind:     entity.PayrollRuleKind(req.Kind),
+		Method:   entity.PayrollRuleMethod(req.Method),
+		Rate:     req.Rate,
+		IsActive: isActive,
+	}
+	if req.Amount != nil {
+		rule.Amount = toMoneyEntity(*req.Amount)
+	}
+	return rule
+}
+
+func toPayrollRuleResponse(rule *entity.PayrollRule) PayrollRuleResponse {
+	response := PayrollRuleResponse{
+		ID:        rule.ID,
+		Name:      rule.Name,
+		Kind:      string(rule.Kind),
+		Method:    string(rule.Method),
+		Rate:      rule.Rate,
+		IsActive:  rule.IsActive,
+		CreatedAt: rule.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt: rule.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+	if !rule.Amount.IsZero() {
+		amount := toMoneyDTO(rule.Amount)
+		response.Amount = &amount
+	}
+	return response
+}
+
+func toPayrollPeriodResponse(period *entity.PayrollPeriod) PayrollPeriodResponse {
+	return PayrollPeriodResponse{
+		ID:        period.ID,
+		Period:    period.Name(),
+		StartDate: period.StartDate().Format(constants.DateFormat),
+		EndDate:   period.EndDate().Format(constants.DateFormat),
+		PayDate:   period.PayDate.Format(constants.DateFormat),
+		CreatedAt: period.CreatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toPayrollRunResponse(run *entity.PayrollRun) PayrollRunResponse {
+	response := PayrollRunResponse{
+		ID:              run.ID,
+		PayrollPeriodID: run.PayrollPeriodID,
+		Status:          string(run.Status),
+		PayslipCount:    run.PayslipCount,
+		CreatedAt:       run.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:       run.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+	if run.FinalizedAt != nil {
+		response.FinalizedAt = run.FinalizedAt.Format(constants.DateTimeFormat)
+	}
+	if run.LockedAt != nil {
+		response.LockedAt = run.LockedAt.Format(constants.DateTimeFormat)
+	}
+	return response
+}
+
+func toPayslipResponse(payslip *entity.Payslip) PayslipResponse {
+	response := PayslipResponse{
+		ID:                  payslip.ID,
+		PayrollRunID:        payslip.PayrollRunID,
+		EmployeeID:          payslip.EmployeeID,
+		EmployeeName:        payslip.EmployeeName,
+		Position:            payslip.Position,
+		Salary:              toMoneyDTO(payslip.Salary),
+		PayPeriod:           string(payslip.PayPeriod),
+		BasePay:             toMoneyDTO(payslip.BasePay),
+		GrossPay:            toMoneyDTO(payslip.GrossPay),
+		TotalDeductions:     toMoneyDTO(payslip.TotalDeductions),
+		TotalTax:            toMoneyDTO(payslip.TotalTax),
+		TotalReimbursements: toMoneyDTO(payslip.TotalReimbursements),
+		NetPay:              toMoneyDTO(payslip.NetPay),
+		CreatedAt:           payslip.CreatedAt.Format(constants.DateTimeFormat),
+	}
+	if payslip.Period != nil {
+		response.Period = payslip.Period.Name()
+		response.PayDate = payslip.Period.PayDate.Format(constants.DateFormat)
+	}
+	for _, line := range payslip.Lines {
+		response.Lines = append(response.Lines, PayslipLineResponse{
+			PayrollRuleID:  line.PayrollRuleID,
+			ExpenseClaimID: line.ExpenseClaimID,
+			Kind:           string(line.Kind),
+			Name:           line.Name,
+			Amount:         toMoneyDTO(line.Amount),
+		})
+	}
+	return response
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type PayrollHandler struct {
+	payrollUsecase usecase.PayrollUsecase
+}
+
+func NewPayrollHandler(payrollUsecase usecase.PayrollUsecase) *PayrollHandler {
+	return &PayrollHandler{payrollUsecase: payrollUsecase}
+}
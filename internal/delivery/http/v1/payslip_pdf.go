@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+	"github.com/mohamedfawas/employee_management_system/pkg/pdf"
+)
+
+// Layout of the payslip PDF, in points.
+const (
+	payslipMarginLeft  = 56.0
+	payslipMarginRight = pdf.PageWidth - 56.0
+	payslipLineHeight  = 18.0
+)
+
+// renderPayslipPDF lays out a payslip on a single A4 page: a header with the employee and period,
+// one row per payslip line grouped by kind, and the totals.
+func renderPayslipPDF(payslip *entity.Payslip) *pdf.Document {
+	document := pdf.New()
+	y := pdf.PageHeight - 72
+
+	document.Text(payslipMarginLeft, y, pdf.FontBold, 18, "Payslip")
+	if payslip.Period != nil {
+		document.TextRight(payslipMarginRight, y, pdf.FontBold, 18, payslip.Period.Name())
+	}
+	y -= 2 * payslipLineHeight
+
+	details := [][2]string{
+		{"Employee", fmt.Sprintf("%s (#%d)", payslip.EmployeeName, payslip.EmployeeID)},
+		{"Position", payslip.Position},
+		{"Salary", fmt.Sprintf("%s %s", formatMoney(payslip.Salary), payslip.PayPeriod)},
+	}
+	if payslip.Period != nil {
+		details = append(details,
+			[2]string{"Period", payslip.Period.StartDate().Format(constants.DateFormat) + " to " +
+				payslip.Period.EndDate().Format(constants.DateFormat)},
+			[2]string{"Pay date", payslip.Period.PayDate.Format(constants.DateFormat)},
+		)
+	}
+	for _, detail := range details {
+		if detail[1] == "" {
+			continue
+		}
+		document.Text(payslipMarginLeft, y, pdf.FontBold, 10, detail[0])
+		document.Text(payslipMarginLeft+90, y, pdf.FontRegular, 10, detail[1])
+		y -= payslipLineHeight
+	}
+
+	type payslipSection struct {
+		title string
+		kinds []entity.PayslipLineKind
+		total entity.Money
+	}
+	sections := []payslipSection{
+		{"Earnings", []entity.PayslipLineKind{entity.PayslipLineKindBase, entity.PayslipLineKindEarning}, payslip.GrossPay},
+		{"Deductions", []entity.PayslipLineKind{entity.PayslipLineKindDeduction}, payslip.TotalDeductions},
+		{"Taxes", []entity.PayslipLineKind{entity.PayslipLineKindTax}, payslip.TotalTax},
+	}
+	if payslip.TotalReimbursements.Amount != 0 {
+		sections = append(sections, payslipSection{"Reimbursements",
+			[]entity.PayslipLineKind{entity.PayslipLineKindReimbursement}, payslip.TotalReimbursements})
+	}
+	for _, section := range sections {
+		y -= payslipLineHeight
+		document.Text(payslipMarginLeft, y, pdf.FontBold, 12, section.title)
+		y -= 6
+		document.Line(payslipMarginLeft, payslipMarginRight, y)
+		y -= payslipLineHeight
+
+		for _, line := range payslip.Lines {
+			if !containsLineKind(section.kinds, line.Kind) {
+				continue
+			}
+			document.Text(payslipMarginLeft, y, pdf.FontRegular, 10, line.Name)
+			document.TextRight(payslipMarginRight, y, pdf.FontRegular, 10, formatMoney(line.Amount))
+			y -= payslipLineHeight
+		}
+
+		document.Text(payslipMarginLeft, y, pdf.FontBold, 10, "Total "+strings.ToLower(section.title))
+		document.TextRight(payslipMarginRight, y, pdf.FontBold, 10, formatMoney(section.total))
+		y -= payslipLineHeight
+	}
+
+	y -= payslipLineHeight
+	document.Line(payslipMarginLeft, payslipMarginRight, y+payslipLineHeight/2)
+	document.Text(payslipMarginLeft, y-6, pdf.FontBold, 14, "Net pay")
+	document.TextRight(payslipMarginRight, y-6, pdf.FontBold, 14, formatMoney(payslip.NetPay))
+
+	return document
+}
+
+func containsLineKind(kinds []entity.PayslipLineKind, kind entity.PayslipLineKind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// formatMoney formats an amount in major units with thousands separators, e.g. 1,234.56 USD.
+func formatMoney(m entity.Money) string {
+	exponent, _ := entity.CurrencyMinorUnits(m.Currency)
+	amount := strconv.FormatFloat(m.Major(), 'f', exponent, 64)
+
+	sign := ""
+	if strings.HasPrefix(amount, "-") {
+		sign, amount = "-", amount[1:]
+	}
+	whole, fraction, _ := strings.Cut(amount, ".")
+	for i := len(whole) - 3; i > 0; i -= 3 {
+		whole = whole[:i] + "," + whole[i:]
+	}
+	if fraction != "" {
+		whole += "." + fraction
+	}
+	return sign + whole + " " + m.Currency
+}
@@ -0,0 +1,168 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// PositionRequest is the payload for creating or updating a position.
+// swagger:model PositionRequest
+type PositionRequest struct {
+	// example: Software Engineer
+	Title string `json:"title"`
+
+	// Seniority level, 1 being the most junior (default 1)
+	// example: 2
+	JobLevel int `json:"job_level"`
+
+	// Lower end of the salary band per pay period. Omit both ends for a position without a band.
+	MinSalary *MoneyDTO `json:"min_salary"`
+
+	// Upper end of the salary band per pay period, in the same currency as min_salary
+	MaxSalary *MoneyDTO `json:"max_salary"`
+
+	// Pay period the band is expressed in: monthly, annual or hourly (default monthly)
+	// example: monthly
+	PayPeriod string `json:"pay_period"`
+}
+
+// PositionResponse represents a position in the catalog.
+// swagger:model PositionResponse
+type PositionResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: Software Engineer
+	Title string `json:"title"`
+
+	// example: 2
+	JobLevel int `json:"job_level"`
+
+	MinSalary *MoneyDTO `json:"min_salary,omitempty"`
+
+	MaxSalary *MoneyDTO `json:"max_salary,omitempty"`
+
+	// example: monthly
+	PayPeriod string `json:"pay_period"`
+
+	// example: 2025-01-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-01-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// OutOfBandEmployeeResponse is an employee whose salary lies outside their position's band.
+// Annual amounts are expressed in the band's currency.
+// swagger:model OutOfBandEmployeeResponse
+type OutOfBandEmployeeResponse struct {
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: John Doe
+	Name string `json:"name"`
+
+	// example: 1
+	PositionID int `json:"position_id"`
+
+	// example: Software Engineer
+	Position string `json:"position"`
+
+	Salary MoneyDTO `json:"salary"`
+
+	// example: monthly
+	PayPeriod string `json:"pay_period"`
+
+	AnnualSalary MoneyDTO `json:"annual_salary"`
+
+	AnnualBandMin MoneyDTO `json:"annual_band_min"`
+
+	AnnualBandMax MoneyDTO `json:"annual_band_max"`
+
+	// Either below or above
+	// example: above
+	Deviation string `json:"deviation"`
+}
+
+// PositionResponseWrapper wraps StandardResponse with PositionResponse as data.
+// swagger:model PositionResponseWrapper
+type PositionResponseWrapper struct {
+	Success   bool             `json:"success"`
+	Message   string           `json:"message"`
+	Data      PositionResponse `json:"data"`
+	Timestamp string           `json:"timestamp"`
+	RequestID string           `json:"request_id"`
+}
+
+// PositionListResponseWrapper wraps StandardResponse with a list of positions.
+// swagger:model PositionListResponseWrapper
+type PositionListResponseWrapper struct {
+	Success   bool               `json:"success"`
+	Message   string             `json:"message"`
+	Data      []PositionResponse `json:"data"`
+	Timestamp string             `json:"timestamp"`
+	RequestID string             `json:"request_id"`
+}
+
+// OutOfBandEmployeeListResponseWrapper wraps StandardResponse with a list of out-of-band employees.
+// swagger:model OutOfBandEmployeeListResponseWrapper
+type OutOfBandEmployeeListResponseWrapper struct {
+	Success   bool                        `json:"success"`
+	Message   string                      `json:"message"`
+	Data      []OutOfBandEmployeeResponse `json:"data"`
+	Timestamp string                      `json:"timestamp"`
+	RequestID string                      `json:"request_id"`
+}
+
+func toPositionEntity(req PositionRequest) *entity.Position {
+	position := &entity.Position{
+		Title:     req.Title,
+		JobLevel:  req.JobLevel,
+		PayPeriod: entity.PayPeriod(req.PayPeriod),
+	}
+	if req.MinSalary != nil {
+		position.MinSalary = toMoneyEntity(*req.MinSalary)
+	}
+	if req.MaxSalary != nil {
+		position.MaxSalary = toMoneyEntity(*req.MaxSalary)
+	}
+	return position
+}
+
+func toPositionResponse(position *entity.Position) PositionResponse {
+	response := PositionResponse{
+		ID:        position.ID,
+		Title:     position.Title,
+		JobLevel:  position.JobLevel,
+		PayPeriod: string(position.PayPeriod),
+		CreatedAt: position.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt: position.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+	if position.HasBand() {
+		minSalary := toMoneyDTO(position.MinSalary)
+		maxSalary := toMoneyDTO(position.MaxSalary)
+		response.MinSalary = &minSalary
+		response.MaxSalary = &maxSalary
+	}
+	return response
+}
+
+func toOutOfBandEmployeeResponse(outOfBand *usecase.OutOfBandEmployee) OutOfBandEmployeeResponse {
+	deviation := "above"
+	if outOfBand.Check.IsBelow() {
+		deviation = "below"
+	}
+	return OutOfBandEmployeeResponse{
+		EmployeeID:    outOfBand.Employee.ID,
+		Name:          outOfBand.Employee.Name,
+		PositionID:    outOfBand.Position.ID,
+		Position:      outOfBand.Position.Title,
+		Salary:        toMoneyDTO(outOfBand.Employee.Salary),
+		PayPeriod:     string(outOfBand.Employee.PayPeriod),
+		AnnualSalary:  toMoneyDTO(outOfBand.Check.AnnualSalary),
+		AnnualBandMin: toMoneyDTO(outOfBand.Check.AnnualMin),
+		AnnualBandMax: toMoneyDTO(outOfBand.Check.AnnualMax),
+		Deviation:     deviation,
+	}
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type PositionHandler struct {
+	positionUsecase usecase.PositionUsecase
+}
+
+func NewPositionHandler(positionUsecase usecase.PositionUsecase) *PositionHandler {
+	return &PositionHandler{positionUsecase: positionUsecase}
+}
@@ -0,0 +1,463 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// RequisitionRequest is the payload for creating or updating a job requisition.
+// swagger:model RequisitionRequest
+type RequisitionRequest struct {
+	// example: Backend engineer
+	Title string `json:"title"`
+
+	// Position new hires get
+	// example: 2
+	PositionID int `json:"position_id"`
+
+	// example: 3
+	DepartmentID *int `json:"department_id"`
+
+	// example: 1
+	LocationID *int `json:"location_id"`
+
+	// Manager of the new hires
+	// example: 7
+	HiringManagerID *int `json:"hiring_manager_id"`
+
+	// example: 2
+	Openings int `json:"openings"`
+
+	// One of open, on_hold, filled or closed (default open)
+	// example: open
+	Status string `json:"status"`
+
+	// example: Builds and runs the payroll services
+	Description string `json:"description"`
+}
+
+// RequisitionResponse represents a job requisition.
+// swagger:model RequisitionResponse
+type RequisitionResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: Backend engineer
+	Title string `json:"title"`
+
+	// example: 2
+	PositionID int `json:"position_id"`
+
+	// example: Software Engineer
+	Position string `json:"position"`
+
+	// example: 3
+	DepartmentID *int `json:"department_id,omitempty"`
+
+	// example: 1
+	LocationID *int `json:"location_id,omitempty"`
+
+	// example: 7
+	HiringManagerID *int `json:"hiring_manager_id,omitempty"`
+
+	// example: Jane Smith
+	HiringManagerName string `json:"hiring_manager_name,omitempty"`
+
+	// example: 2
+	Openings int `json:"openings"`
+
+	// Candidates hired so far
+	// example: 1
+	Hired int `json:"hired"`
+
+	// example: open
+	Status string `json:"status"`
+
+	// example: Builds and runs the payroll services
+	Description string `json:"description,omitempty"`
+
+	// example: 2025-03-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-03-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// CandidateRequest is the payload for adding or updating a candidate.
+// swagger:model CandidateRequest
+type CandidateRequest struct {
+	// example: Alex Morgan
+	Name string `json:"name"`
+
+	// example: alex.morgan@example.com
+	Email string `json:"email"`
+
+	// E.164 format
+	// example: +14155552671
+	Phone string `json:"phone"`
+
+	// Where the candidate came from
+	// example: referral
+	Source string `json:"source"`
+
+	// example: Referred by the platform team
+	Notes string `json:"notes"`
+}
+
+// CandidateStageRequest is the payload for moving a candidate along the pipeline.
+// swagger:model CandidateStageRequest
+type CandidateStageRequest struct {
+	// One of screening, interview, rejected or withdrawn
+	// example: screening
+	Stage string `json:"stage"`
+}
+
+// OfferRequest is the payload for making a candidate an offer.
+// swagger:model OfferRequest
+type OfferRequest struct {
+	// Salary per pay period, in minor currency units
+	Salary MoneyDTO `json:"salary"`
+
+	// One of monthly, annual, hourly (default monthly)
+	// example: monthly
+	PayPeriod string `json:"pay_period"`
+
+	// example: 2025-05-01
+	StartDate string `json:"start_date"`
+}
+
+// OfferAcceptanceRequest is the payload for accepting a candidate's offer.
+// swagger:model OfferAcceptanceRequest
+type OfferAcceptanceRequest struct {
+	// example: alex.morgan@company.com
+	WorkEmail string `json:"work_email"`
+
+	// Defaults to the requisition's hiring manager
+	// example: 7
+	ManagerID *int `json:"manager_id"`
+
+	// Values of the new employee's custom fields by key, required ones included
+	CustomFields map[string]any `json:"custom_fields"`
+}
+
+// OfferResponse represents the offer made to a candidate.
+// swagger:model OfferResponse
+type OfferResponse struct {
+	Salary MoneyDTO `json:"salary"`
+
+	// example: monthly
+	PayPeriod string `json:"pay_period"`
+
+	// example: 2025-05-01
+	StartDate string `json:"start_date"`
+}
+
+// CandidateResponse represents a candidate.
+// swagger:model CandidateResponse
+type CandidateResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	RequisitionID int `json:"requisition_id"`
+
+	// example: Backend engineer
+	RequisitionTitle string `json:"requisition_title"`
+
+	// example: Alex Morgan
+	Name string `json:"name"`
+
+	// example: alex.morgan@example.com
+	Email string `json:"email"`
+
+	// example: +14155552671
+	Phone string `json:"phone,omitempty"`
+
+	// example: referral
+	Source string `json:"source,omitempty"`
+
+	// example: Referred by the platform team
+	Notes string `json:"notes,omitempty"`
+
+	// One of applied, screening, interview, offer, hired, rejected or withdrawn
+	// example: interview
+	Stage string `json:"stage"`
+
+	// example: 2025-03-04 10:00:00
+	StageChangedAt string `json:"stage_changed_at"`
+
+	Offer *OfferResponse `json:"offer,omitempty"`
+
+	// Employee created once the offer was accepted
+	// example: 12
+	EmployeeID *int `json:"employee_id,omitempty"`
+
+	// example: 2025-03-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-03-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// InterviewRequest is the payload for scheduling or rescheduling an interview.
+// swagger:model InterviewRequest
+type InterviewRequest struct {
+	// example: 7
+	InterviewerID int `json:"interviewer_id"`
+
+	// example: 2025-03-10 14:00:00
+	ScheduledAt string `json:"scheduled_at"`
+
+	// 15 to 480 minutes (default 60)
+	// example: 45
+	DurationMinutes int `json:"duration_minutes"`
+}
+
+// InterviewFeedbackRequest is the payload for the interviewer's feedback.
+// swagger:model InterviewFeedbackRequest
+type InterviewFeedbackRequest struct {
+	// 1 to 5
+	// example: 4
+	Rating *int `json:"rating"`
+
+	// One of strong_yes, yes, no or strong_no
+	// example: yes
+	Recommendation string `json:"recommendation"`
+
+	// example: Solid system design, light on testing
+	Feedback string `json:"feedback"`
+}
+
+// InterviewResponse represents an interview.
+// swagger:model InterviewResponse
+type InterviewResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	CandidateID int `json:"candidate_id"`
+
+	// example: Alex Morgan
+	CandidateName string `json:"candidate_name"`
+
+	// example: 1
+	RequisitionID int `json:"requisition_id"`
+
+	// example: 7
+	InterviewerID int `json:"interviewer_id"`
+
+	// example: Jane Smith
+	InterviewerName string `json:"interviewer_name"`
+
+	// example: 2025-03-10 14:00:00
+	ScheduledAt string `json:"scheduled_at"`
+
+	// example: 45
+	DurationMinutes int `json:"duration_minutes"`
+
+	// One of scheduled, completed or cancelled
+	// example: completed
+	Status string `json:"status"`
+
+	// example: 4
+	Rating *int `json:"rating,omitempty"`
+
+	// example: yes
+	Recommendation string `json:"recommendation,omitempty"`
+
+	// example: Solid system design, light on testing
+	Feedback string `json:"feedback,omitempty"`
+
+	// example: 2025-03-10 15:10:00
+	FeedbackAt string `json:"feedback_at,omitempty"`
+
+	// example: 2025-03-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-03-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// RequisitionResponseWrapper wraps StandardResponse with RequisitionResponse as data.
+// swagger:model RequisitionResponseWrapper
+type RequisitionResponseWrapper struct {
+	Success   bool                `json:"success"`
+	Message   string              `json:"message"`
+	Data      RequisitionResponse `json:"data"`
+	Timestamp string              `json:"timestamp"`
+	RequestID string              `json:"request_id"`
+}
+
+// RequisitionListResponseWrapper wraps StandardResponse with a list of requisitions.
+// swagger:model RequisitionListResponseWrapper
+type RequisitionListResponseWrapper struct {
+	Success   bool                  `json:"success"`
+	Message   string                `json:"message"`
+	Data      []RequisitionResponse `json:"data"`
+	Timestamp string                `json:"timestamp"`
+	RequestID string                `json:"request_id"`
+}
+
+// CandidateResponseWrapper wraps StandardResponse with CandidateResponse as data.
+// swagger:model CandidateResponseWrapper
+type CandidateResponseWrapper struct {
+	Success   bool              `json:"success"`
+	Message   string            `json:"message"`
+	Data      CandidateResponse `json:"data"`
+	Timestamp string            `json:"timestamp"`
+	RequestID string            `json:"request_id"`
+}
+
+// CandidateListResponseWrapper wraps StandardResponse with a list of candidates.
+// swagger:model CandidateListResponseWrapper
+type CandidateListResponseWrapper struct {
+	Success   bool                `json:"success"`
+	Message   string              `json:"message"`
+	Data      []CandidateResponse `json:"data"`
+	Timestamp string              `json:"timestamp"`
+	RequestID string              `json:"request_id"`
+}
+
+// InterviewResponseWrapper wraps StandardResponse with InterviewResponse as data.
+// swagger:model InterviewResponseWrapper
+type InterviewResponseWrapper struct {
+	Success   bool              `json:"success"`
+	Message   string            `json:"message"`
+	Data      InterviewResponse `json:"data"`
+	Timestamp string            `json:"timestamp"`
+	RequestID string            `json:"request_id"`
+}
+
+// InterviewListResponseWrapper wraps StandardResponse with a list of interviews.
+// swagger:model InterviewListResponseWrapper
+type InterviewListResponseWrapper struct {
+	Success   bool                `json:"success"`
+	Message   string              `json:"message"`
+	Data      []InterviewResponse `json:"data"`
+	Timestamp string              `json:"timestamp"`
+	RequestID string              `json:"request_id"`
+}
+
+func toRequisitionEntity(req RequisitionRequest) *entity.JobRequisition {
+	return &entity.JobRequisition{
+		Title:           req.Title,
+		PositionID:      req.PositionID,
+		DepartmentID:    req.DepartmentID,
+		LocationID:      req.LocationID,
+		HiringManagerID: req.HiringManagerID,
+		Openings:        req.Openings,
+		Status:          entity.RequisitionStatus(req.Status),
+		Description:     req.Description,
+	}
+}
+
+func toRequisitionResponse(requisition *entity.JobRequisition) RequisitionResponse {
+	return RequisitionResponse{
+		ID:                requisition.ID,
+		Title:             requisition.Title,
+		PositionID:        requisition.PositionID,
+		Position:          requisition.Position,
+		DepartmentID:      requisition.DepartmentID,
+		LocationID:        requisition.LocationID,
+		HiringManagerID:   requisition.HiringManagerID,
+		HiringManagerName: requisition.HiringManagerName,
+		Openings:          requisition.Openings,
+		Hired:             requisition.Hired,
+		Status:            string(requisition.Status),
+		Description:       requisition.Description,
+		CreatedAt:         requisition.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:         requisition.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toCandidateEntity(req CandidateRequest) *entity.Candidate {
+	return &entity.Candidate{
+		Name:   req.Name,
+		Email:  req.Email,
+		Phone:  req.Phone,
+		Source: req.Source,
+		Notes:  req.Notes,
+	}
+}
+
+func toCandidateResponse(candidate *entity.Candidate) CandidateResponse {
+	response := CandidateResponse{
+		ID:               candidate.ID,
+		RequisitionID:    candidate.RequisitionID,
+		RequisitionTitle: candidate.RequisitionTitle,
+		Name:             candidate.Name,
+		Email:            candidate.Email,
+		Phone:            candidate.Phone,
+		Source:           candidate.Source,
+		Notes:            candidate.Notes,
+		Stage:            string(candidate.Stage),
+		StageChangedAt:   candidate.StageChangedAt.Format(constants.DateTimeFormat),
+		EmployeeID:       candidate.EmployeeID,
+		CreatedAt:        candidate.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:        candidate.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+	if offer := candidate.Offer; offer != nil {
+		response.Offer = &OfferResponse{
+			Salary:    toMoneyDTO(offer.Salary),
+			PayPeriod: string(offer.PayPeriod),
+			StartDate: offer.StartDate.Format(constants.DateFormat),
+		}
+	}
+	return response
+}
+
+// toJobOfferEntity maps the request, returning the start date when it could not be parsed.
+func toJobOfferEntity(req OfferRequest) (*entity.JobOffer, map[string]string, error) {
+	startDate, err := time.Parse(constants.DateFormat, req.StartDate)
+	if err != nil {
+		return nil, map[string]string{
+			"start_date": "Date format is invalid , expected format: YYYY-MM-DD",
+		}, appError.ErrInvalidOffer
+	}
+	return &entity.JobOffer{
+		Salary:    toMoneyEntity(req.Salary),
+		PayPeriod: entity.PayPeriod(req.PayPeriod),
+		StartDate: startDate,
+	}, nil, nil
+}
+
+// toInterviewEntity maps the request, returning the time when it could not be parsed.
+func toInterviewEntity(req InterviewRequest) (*entity.Interview, map[string]string, error) {
+	scheduledAt, err := time.Parse(constants.DateTimeFormat, req.ScheduledAt)
+	if err != nil {
+		return nil, map[string]string{
+			"scheduled_at": "Time format is invalid , expected format: YYYY-MM-DD HH:MM:SS",
+		}, appError.ErrInvalidInterview
+	}
+	return &entity.Interview{
+		InterviewerID:   req.InterviewerID,
+		ScheduledAt:     scheduledAt,
+		DurationMinutes: req.DurationMinutes,
+	}, nil, nil
+}
+
+func toInterviewResponse(interview *entity.Interview) InterviewResponse {
+	response := InterviewResponse{
+		ID:              interview.ID,
+		CandidateID:     interview.CandidateID,
+		CandidateName:   interview.CandidateName,
+		RequisitionID:   interview.RequisitionID,
+		InterviewerID:   interview.InterviewerID,
+		InterviewerName: interview.InterviewerName,
+		ScheduledAt:     interview.ScheduledAt.UTC().Format(constants.DateTimeFormat),
+		DurationMinutes: interview.DurationMinutes,
+		Status:          string(interview.Status),
+		Rating:          interview.Rating,
+		Recommendation:  string(interview.Recommendation),
+		Feedback:        interview.Feedback,
+		CreatedAt:       interview.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:       interview.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+	if interview.FeedbackAt != nil {
+		response.FeedbackAt = interview.FeedbackAt.Format(constants.DateTimeFormat)
+	}
+	return response
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type RecruitingHandler struct {
+	recruitingUsecase usecase.RecruitingUsecase
+}
+
+func NewRecruitingHandler(recruitingUsecase usecase.RecruitingUsecase) *RecruitingHandler {
+	return &RecruitingHandler{recruitingUsecase: recruitingUsecase}
+}
@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// RehireEmployee godoc
+// @Summary Rehire an employee
+// @Description Brings a terminated employee back as active. The rehire date becomes the new hired date.
+// @Tags Employees
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param payload body RehireEmployeeRequest true "Rehire payload"
+// @Success 200 {object} GetEmployeeByIdResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/rehire [post]
+func (h *EmployeeHandler) RehireEmployee(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req RehireEmployeeRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"rehire_date": "Rehire date is required and must be a valid date",
+			})
+	}
+
+	rehireDate, err := time.Parse(constants.DateFormat, req.RehireDate)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidRehireDate,
+			map[string]string{
+				"rehire_date": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	employee, err := h.employeeUsecase.RehireEmployee(c.Request().Context(), id, rehireDate, req.Reason)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error rehiring employee: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Employee rehired successfully", toGetEmployeeByIdResponse(employee))
+}
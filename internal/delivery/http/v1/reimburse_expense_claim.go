@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// ReimburseExpenseClaim godoc
+// @Summary Reimburse an expense claim
+// @Description Marks an approved claim paid back, optionally by a payroll run. Claims held by a payroll run are reimbursed when the run is locked instead.
+// @Tags Expenses
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param expenseId path int true "Expense claim ID"
+// @Param payload body ExpenseReimbursementRequest true "Reimbursement payload"
+// @Success 200 {object} ExpenseClaimResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/expenses/{expenseId}/reimburse [post]
+func (h *ExpenseHandler) ReimburseExpenseClaim(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	claimID, err := parseIDParam(c, "expenseId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidExpenseClaimId,
+			map[string]string{
+				"expenseId": "Expense claim ID must be a valid number",
+			})
+	}
+
+	var req ExpenseReimbursementRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"reimbursed_on": "Reimbursement date must be a date",
+			})
+	}
+	reimbursedOn, err := parseOptionalDate(req.ReimbursedOn)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidExpenseReimbursement,
+			map[string]string{
+				"reimbursed_on": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	claim, err := h.expenseUsecase.ReimburseClaim(c.Request().Context(), employeeID, claimID, reimbursedOn,
+		req.PayrollRunID, req.Reference)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error reimbursing expense claim: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Expense claim reimbursed successfully", toExpenseClaimResponse(claim))
+}
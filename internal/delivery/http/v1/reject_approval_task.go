@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// RejectTask godoc
+// @Summary Reject an approval task
+// @Description Rejects a task in the approver's inbox, which rejects the whole request; the change is not made.
+// @Tags Approvals
+// @Accept json
+// @Produce json
+// @Param id path int true "Approval task ID"
+// @Param payload body ApprovalDecisionRequest true "Decision payload"
+// @Success 200 {object} ApprovalRequestResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 403 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /approvals/tasks/{id}/reject [post]
+func (h *ApprovalHandler) RejectTask(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidApprovalTaskId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req ApprovalDecisionRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"approver_id": "Approver ID is required",
+			})
+	}
+
+	request, err := h.approvalUsecase.DecideTask(c.Request().Context(), entity.ApprovalDecision{
+		TaskID:     id,
+		ApproverID: req.ApproverID,
+		Approve:    false,
+		Comment:    req.Comment,
+	})
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error rejecting approval task: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Approval task rejected successfully", toApprovalRequestResponse(request))
+}
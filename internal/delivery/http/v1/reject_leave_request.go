@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// RejectLeaveRequest godoc
+// @Summary Reject a leave request
+// @Description Rejects a pending request. Only the employee's manager may decide when one is set, and requests in an approval workflow are decided there.
+// @Tags Leave
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param requestId path int true "Leave request ID"
+// @Param payload body LeaveDecisionRequest true "Decision payload"
+// @Success 200 {object} LeaveRequestResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 403 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/leave-requests/{requestId}/reject [post]
+func (h *LeaveHandler) RejectLeaveRequest(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	requestID, err := parseIDParam(c, "requestId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidLeaveRequestId,
+			map[string]string{
+				"requestId": "Leave request ID must be a valid number",
+			})
+	}
+
+	var req LeaveDecisionRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"approver_id": "Approver ID is required",
+			})
+	}
+
+	request, err := h.leaveUsecase.RejectLeaveRequest(c.Request().Context(), employeeID, requestID, req.ApproverID, req.Comment)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error rejecting leave request: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Leave request rejected successfully", toLeaveRequestResponse(request))
+}
@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// RejectTimesheet godoc
+// @Summary Reject a weekly timesheet
+// @Description Sends a submitted timesheet back to the employee and unlocks its shifts. Only the employee's manager may decide when one is set.
+// @Tags Attendance
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param week path string true "Any date in the week (YYYY-MM-DD)"
+// @Param payload body TimesheetDecisionRequest true "Decision payload"
+// @Success 200 {object} TimesheetResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 403 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/timesheets/{week}/reject [post]
+func (h *AttendanceHandler) RejectTimesheet(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	week, err := time.Parse(constants.DateFormat, c.Param("week"))
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidTimesheetWeek,
+			map[string]string{
+				"week": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	var req TimesheetDecisionRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"approver_id": "Approver ID is required",
+			})
+	}
+
+	timesheet, err := h.attendanceUsecase.RejectTimesheet(c.Request().Context(), employeeID, week, req.ApproverID, req.Comment)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error rejecting timesheet: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Timesheet rejected successfully", toTimesheetResponse(timesheet))
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// RemoveEmployeeTag godoc
+// @Summary Remove a tag from an employee
+// @Description Removes one tag from an employee
+// @Tags Tags
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param tag path string true "Tag"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/tags/{tag} [delete]
+func (h *SegmentHandler) RemoveEmployeeTag(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	if err := h.segmentUsecase.RemoveEmployeeTag(c.Request().Context(), employeeID, c.Param("tag")); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error removing employee tag: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Tag removed successfully")
+}
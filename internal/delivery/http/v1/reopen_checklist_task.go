@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// ReopenTask godoc
+// @Summary Reopen a checklist task
+// @Description Marks a completed task open again, reopening its checklist if it was complete
+// @Tags Checklists
+// @Produce json
+// @Param id path int true "Checklist task ID"
+// @Success 200 {object} ChecklistResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /checklist-tasks/{id}/reopen [post]
+func (h *ChecklistHandler) ReopenTask(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidChecklistTaskId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	checklist, err := h.checklistUsecase.ReopenTask(c.Request().Context(), id)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error reopening checklist task: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Checklist task reopened successfully", toChecklistResponse(checklist))
+}
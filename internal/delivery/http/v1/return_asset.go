@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// ReturnAsset godoc
+// @Summary Return an asset
+// @Description Takes an assigned asset back, today unless a date is given. The asset becomes available again unless it is reported in repair, lost or retired.
+// @Tags Assets
+// @Accept json
+// @Produce json
+// @Param id path int true "Asset ID"
+// @Param payload body AssetReturnRequest false "Return payload"
+// @Success 200 {object} AssetAssignmentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /assets/{id}/return [post]
+func (h *AssetHandler) ReturnAsset(c echo.Context) error {
+	assetID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidAssetId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req AssetReturnRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"returned_on": "Return date must be a date",
+			})
+	}
+	returnedOn, err := parseOptionalDate(req.ReturnedOn)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidAssetAssignment,
+			map[string]string{
+				"returned_on": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	assignment, err := h.assetUsecase.ReturnAsset(c.Request().Context(), &entity.AssetAssignment{
+		AssetID:     assetID,
+		ReturnedOn:  returnedOn,
+		ReturnNotes: req.Notes,
+	}, entity.AssetStatus(req.Status))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error returning asset: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Asset returned successfully", toAssetAssignmentResponse(assignment))
+}
@@ -0,0 +1,457 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// ReviewQuestionDTO is a question of a review cycle.
+// swagger:model ReviewQuestionDTO
+type ReviewQuestionDTO struct {
+	// Ignored in requests
+	// example: 1
+	ID int `json:"id"`
+
+	// example: How well did the employee meet their goals?
+	Text string `json:"text"`
+
+	// rating (answered on the cycle's scale) or text
+	// example: rating
+	Kind string `json:"kind"`
+}
+
+// ReviewCycleRequest is the payload for creating or updating a review cycle.
+// swagger:model ReviewCycleRequest
+type ReviewCycleRequest struct {
+	// example: 2025 H1
+	Name string `json:"name"`
+
+	// example: 2025-01-01
+	PeriodStart string `json:"period_start"`
+
+	// example: 2025-06-30
+	PeriodEnd string `json:"period_end"`
+
+	// example: 2025-07-10
+	SelfReviewDeadline string `json:"self_review_deadline"`
+
+	// example: 2025-07-20
+	PeerReviewDeadline string `json:"peer_review_deadline"`
+
+	// example: 2025-07-31
+	ManagerReviewDeadline string `json:"manager_review_deadline"`
+
+	// Rating scale from lowest to highest; rating 1 is the first label
+	// example: ["Needs improvement","Meets expectations","Exceeds expectations"]
+	RatingLabels []string `json:"rating_labels"`
+
+	Questions []ReviewQuestionDTO `json:"questions"`
+}
+
+// ReviewCycleResponse represents a review cycle. Questions are only included for a single cycle.
+// swagger:model ReviewCycleResponse
+type ReviewCycleResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 2025 H1
+	Name string `json:"name"`
+
+	// example: 2025-01-01
+	PeriodStart string `json:"period_start"`
+
+	// example: 2025-06-30
+	PeriodEnd string `json:"period_end"`
+
+	// example: 2025-07-10
+	SelfReviewDeadline string `json:"self_review_deadline"`
+
+	// example: 2025-07-20
+	PeerReviewDeadline string `json:"peer_review_deadline"`
+
+	// example: 2025-07-31
+	ManagerReviewDeadline string `json:"manager_review_deadline"`
+
+	// example: ["Needs improvement","Meets expectations","Exceeds expectations"]
+	RatingLabels []string `json:"rating_labels"`
+
+	Questions []ReviewQuestionDTO `json:"questions,omitempty"`
+
+	// One of draft, active or closed
+	// example: active
+	Status string `json:"status"`
+
+	// example: 2025-06-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-06-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+
+	// example: 2025-07-01 08:00:00
+	LaunchedAt string `json:"launched_at,omitempty"`
+
+	// example: 2025-08-15 08:00:00
+	ClosedAt string `json:"closed_at,omitempty"`
+}
+
+// ReviewAnswerDTO is the answer to one question of a review cycle.
+// swagger:model ReviewAnswerDTO
+type ReviewAnswerDTO struct {
+	// example: 1
+	QuestionID int `json:"question_id"`
+
+	// Rating questions only, from 1 to the number of rating labels
+	// example: 2
+	Rating *int `json:"rating,omitempty"`
+
+	// Required for text questions
+	// example: Delivered the billing migration ahead of schedule
+	Comment string `json:"comment,omitempty"`
+}
+
+// ReviewSubmissionRequest is the payload for submitting a review.
+// swagger:model ReviewSubmissionRequest
+type ReviewSubmissionRequest struct {
+	// example: 2
+	ReviewerID int `json:"reviewer_id"`
+
+	// One of self, peer or manager
+	// example: manager
+	Role string `json:"role"`
+
+	// One answer per question of the cycle
+	Answers []ReviewAnswerDTO `json:"answers"`
+}
+
+// PeerReviewersRequest is the payload for asking employees for a peer review.
+// swagger:model PeerReviewersRequest
+type PeerReviewersRequest struct {
+	// example: [3,4]
+	ReviewerIDs []int `json:"reviewer_ids"`
+}
+
+// ReviewSubmissionResponse represents one reviewer's part of a review.
+// swagger:model ReviewSubmissionResponse
+type ReviewSubmissionResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 2
+	ReviewerID int `json:"reviewer_id"`
+
+	// example: Jane Smith
+	ReviewerName string `json:"reviewer_name"`
+
+	// example: manager
+	Role string `json:"role"`
+
+	// Empty while the submission is pending
+	// example: 2025-07-25 14:00:00
+	SubmittedAt string `json:"submitted_at,omitempty"`
+
+	Answers []ReviewAnswerDTO `json:"answers,omitempty"`
+}
+
+// ReviewResponse represents an employee's review. Submissions are only included for a single review.
+// swagger:model ReviewResponse
+type ReviewResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	ReviewCycleID int `json:"review_cycle_id"`
+
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: John Doe
+	EmployeeName string `json:"employee_name"`
+
+	// example: 1
+	DepartmentID *int `json:"department_id,omitempty"`
+
+	// example: Engineering
+	DepartmentName string `json:"department_name,omitempty"`
+
+	// Manager when the cycle was launched
+	// example: 2
+	ManagerID *int `json:"manager_id,omitempty"`
+
+	// One of in_progress, completed or locked
+	// example: completed
+	Status string `json:"status"`
+
+	// example: 2.5
+	SelfRating *float64 `json:"self_rating,omitempty"`
+
+	// example: 2.25
+	PeerRating *float64 `json:"peer_rating,omitempty"`
+
+	// example: 2
+	ManagerRating *float64 `json:"manager_rating,omitempty"`
+
+	Submissions []ReviewSubmissionResponse `json:"submissions,omitempty"`
+
+	// example: 2025-07-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-07-25 14:00:00
+	UpdatedAt string `json:"updated_at"`
+
+	// example: 2025-07-25 14:00:00
+	CompletedAt string `json:"completed_at,omitempty"`
+
+	// example: 2025-08-15 08:00:00
+	LockedAt string `json:"locked_at,omitempty"`
+}
+
+// DepartmentCalibrationResponse summarises the ratings of one department's reviews.
+// swagger:model DepartmentCalibrationResponse
+type DepartmentCalibrationResponse struct {
+	// Empty for employees without a department
+	// example: 1
+	DepartmentID *int `json:"department_id,omitempty"`
+
+	// example: Engineering
+	DepartmentName string `json:"department_name,omitempty"`
+
+	// example: 12
+	Reviews int `json:"reviews"`
+
+	// Completed or locked reviews
+	// example: 10
+	Completed int `json:"completed"`
+
+	// example: 2.4
+	AverageSelfRating *float64 `json:"average_self_rating,omitempty"`
+
+	// example: 2.2
+	AveragePeerRating *float64 `json:"average_peer_rating,omitempty"`
+
+	// example: 2.1
+	AverageManagerRating *float64 `json:"average_manager_rating,omitempty"`
+
+	// Completed reviews per manager rating, from rating 1 up
+	// example: [1,7,2]
+	Distribution []int `json:"distribution"`
+}
+
+// ReviewCalibrationResponse represents a cycle's ratings aggregated by department.
+// swagger:model ReviewCalibrationResponse
+type ReviewCalibrationResponse struct {
+	// example: 1
+	ReviewCycleID int `json:"review_cycle_id"`
+
+	// example: ["Needs improvement","Meets expectations","Exceeds expectations"]
+	RatingLabels []string `json:"rating_labels"`
+
+	Departments []DepartmentCalibrationResponse `json:"departments"`
+	Total       DepartmentCalibrationResponse   `json:"total"`
+}
+
+// ReviewCycleResponseWrapper wraps StandardResponse with ReviewCycleResponse as data.
+// swagger:model ReviewCycleResponseWrapper
+type ReviewCycleResponseWrapper struct {
+	Success   bool                `json:"success"`
+	Message   string              `json:"message"`
+	Data      ReviewCycleResponse `json:"data"`
+	Timestamp string              `json:"timestamp"`
+	RequestID string              `json:"request_id"`
+}
+
+// ReviewCycleListResponseWrapper wraps StandardResponse with a list of review cycles.
+// swagger:model ReviewCycleListResponseWrapper
+type ReviewCycleListResponseWrapper struct {
+	Success   bool                  `json:"success"`
+	Message   string                `json:"message"`
+	Data      []ReviewCycleResponse `json:"data"`
+	Timestamp string                `json:"timestamp"`
+	RequestID string                `json:"request_id"`
+}
+
+// ReviewResponseWrapper wraps StandardResponse with ReviewResponse as data.
+// swagger:model ReviewResponseWrapper
+type ReviewResponseWrapper struct {
+	Success   bool           `json:"success"`
+	Message   string         `json:"message"`
+	Data      ReviewResponse `json:"data"`
+	Timestamp string         `json:"timestamp"`
+	RequestID string         `json:"request_id"`
+}
+
+// ReviewListResponseWrapper wraps StandardResponse with a list of reviews.
+// swagger:model ReviewListResponseWrapper
+type ReviewListResponseWrapper struct {
+	Success   bool             `json:"success"`
+	Message   string           `json:"message"`
+	Data      []ReviewResponse `json:"data"`
+	Timestamp string           `json:"timestamp"`
+	RequestID string           `json:"request_id"`
+}
+
+// ReviewCalibrationResponseWrapper wraps StandardResponse with ReviewCalibrationResponse as data.
+// swagger:model ReviewCalibrationResponseWrapper
+type ReviewCalibrationResponseWrapper struct {
+	Success   bool                      `json:"success"`
+	Message   string                    `json:"message"`
+	Data      ReviewCalibrationResponse `json:"data"`
+	Timestamp string                    `json:"timestamp"`
+	RequestID string                    `json:"request_id"`
+}
+
+// toReviewCycleEntity maps the request, returning the first date that could not be parsed.
+func toReviewCycleEntity(req ReviewCycleRequest) (*entity.ReviewCycle, map[string]string, error) {
+	cycle := &entity.ReviewCycle{
+		Name:         req.Name,
+		RatingLabels: req.RatingLabels,
+		Questions:    []entity.ReviewQuestion{},
+	}
+	dates := []struct {
+		field string
+		value string
+		date  *time.Time
+	}{
+		{"period_start", req.PeriodStart, &cycle.PeriodStart},
+		{"period_end", req.PeriodEnd, &cycle.PeriodEnd},
+		{"self_review_deadline", req.SelfReviewDeadline, &cycle.SelfReviewDeadline},
+		{"peer_review_deadline", req.PeerReviewDeadline, &cycle.PeerReviewDeadline},
+		{"manager_review_deadline", req.ManagerReviewDeadline, &cycle.ManagerReviewDeadline},
+	}
+	for _, d := range dates {
+		date, err := time.Parse(constants.DateFormat, d.value)
+		if err != nil {
+			return nil, map[string]string{
+				d.field: "Date format is invalid , expected format: YYYY-MM-DD",
+			}, appError.ErrInvalidReviewCycle
+		}
+		*d.date = date
+	}
+	for _, question := range req.Questions {
+		cycle.Questions = append(cycle.Questions, entity.ReviewQuestion{
+			Text: question.Text,
+			Kind: entity.ReviewQuestionKind(question.Kind),
+		})
+	}
+	return cycle, nil, nil
+}
+
+func toReviewSubmissionEntity(reviewID int, req ReviewSubmissionRequest) *entity.ReviewSubmission {
+	submission := &entity.ReviewSubmission{
+		ReviewID:   reviewID,
+		ReviewerID: req.ReviewerID,
+		Role:       entity.ReviewerRole(req.Role),
+		Answers:    []entity.ReviewAnswer{},
+	}
+	for _, answer := range req.Answers {
+		submission.Answers = append(submission.Answers, entity.ReviewAnswer{
+			QuestionID: answer.QuestionID,
+			Rating:     answer.Rating,
+			Comment:    answer.Comment,
+		})
+	}
+	return submission
+}
+
+func toReviewCycleResponse(cycle *entity.ReviewCycle) ReviewCycleResponse {
+	response := ReviewCycleResponse{
+		ID:                    cycle.ID,
+		Name:                  cycle.Name,
+		PeriodStart:           cycle.PeriodStart.Format(constants.DateFormat),
+		PeriodEnd:             cycle.PeriodEnd.Format(constants.DateFormat),
+		SelfReviewDeadline:    cycle.SelfReviewDeadline.Format(constants.DateFormat),
+		PeerReviewDeadline:    cycle.PeerReviewDeadline.Format(constants.DateFormat),
+		ManagerReviewDeadline: cycle.ManagerReviewDeadline.Format(constants.DateFormat),
+		RatingLabels:          cycle.RatingLabels,
+		Status:                string(cycle.Status),
+		CreatedAt:             cycle.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:             cycle.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+	for _, question := range cycle.Questions {
+		response.Questions = append(response.Questions, ReviewQuestionDTO{
+			ID:   question.ID,
+			Text: question.Text,
+			Kind: string(question.Kind),
+		})
+	}
+	if cycle.LaunchedAt != nil {
+		response.LaunchedAt = cycle.LaunchedAt.Format(constants.DateTimeFormat)
+	}
+	if cycle.ClosedAt != nil {
+		response.ClosedAt = cycle.ClosedAt.Format(constants.DateTimeFormat)
+	}
+	return response
+}
+
+func toReviewResponse(review *entity.Review) ReviewResponse {
+	response := ReviewResponse{
+		ID:             review.ID,
+		ReviewCycleID:  review.ReviewCycleID,
+		EmployeeID:     review.EmployeeID,
+		EmployeeName:   review.EmployeeName,
+		DepartmentID:   review.DepartmentID,
+		DepartmentName: review.DepartmentName,
+		ManagerID:      review.ManagerID,
+		Status:         string(review.Status),
+		SelfRating:     review.SelfRating,
+		PeerRating:     review.PeerRating,
+		ManagerRating:  review.ManagerRating,
+		CreatedAt:      review.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:      review.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+	for _, submission := range review.Submissions {
+		submissionResponse := ReviewSubmissionResponse{
+			ID:           submission.ID,
+			ReviewerID:   submission.ReviewerID,
+			ReviewerName: submission.ReviewerName,
+			Role:         string(submission.Role),
+		}
+		if submission.SubmittedAt != nil {
+			submissionResponse.SubmittedAt = submission.SubmittedAt.Format(constants.DateTimeFormat)
+		}
+		for _, answer := range submission.Answers {
+			submissionResponse.Answers = append(submissionResponse.Answers, ReviewAnswerDTO{
+				QuestionID: answer.QuestionID,
+				Rating:     answer.Rating,
+				Comment:    answer.Comment,
+			})
+		}
+		response.Submissions = append(response.Submissions, submissionResponse)
+	}
+	if review.CompletedAt != nil {
+		response.CompletedAt = review.CompletedAt.Format(constants.DateTimeFormat)
+	}
+	if review.LockedAt != nil {
+		response.LockedAt = review.LockedAt.Format(constants.DateTimeFormat)
+	}
+	return response
+}
+
+func toReviewCalibrationResponse(calibration *usecase.ReviewCalibration) ReviewCalibrationResponse {
+	response := ReviewCalibrationResponse{
+		ReviewCycleID: calibration.ReviewCycleID,
+		RatingLabels:  calibration.RatingLabels,
+		Departments:   []DepartmentCalibrationResponse{},
+		Total:         toDepartmentCalibrationResponse(calibration.Total),
+	}
+	for _, department := range calibration.Departments {
+		response.Departments = append(response.Departments, toDepartmentCalibrationResponse(department))
+	}
+	return response
+}
+
+func toDepartmentCalibrationResponse(department *usecase.DepartmentCalibration) DepartmentCalibrationResponse {
+	return DepartmentCalibrationResponse{
+		DepartmentID:         department.DepartmentID,
+		DepartmentName:       department.DepartmentName,
+		Reviews:              department.Reviews,
+		Completed:            department.Completed,
+		AverageSelfRating:    department.AverageSelfRating,
+		AveragePeerRating:    department.AveragePeerRating,
+		AverageManagerRating: department.AverageManagerRating,
+		Distribution:         department.Distribution,
+	}
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type ReviewHandler struct {
+	reviewUsecase usecase.ReviewUsecase
+}
+
+func NewReviewHandler(reviewUsecase usecase.ReviewUsecase) *ReviewHandler {
+	return &ReviewHandler{reviewUsecase: reviewUsecase}
+}
@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// ScheduleCompensationChange godoc
+// @Summary Change an employee's salary
+// @Description Records a salary change. Changes effective today or earlier are applied immediately; future-dated ones are applied by a background job on the effective date. The new salary is checked against the band of the position and rejected or flagged depending on the band policy.
+// @Tags Compensation
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param payload body ScheduleCompensationChangeRequest true "Compensation change payload"
+// @Success 200 {object} CompensationChangeResponseWrapper
+// @Success 202 {object} ApprovalRequestResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/compensation [post]
+func (h *CompensationHandler) ScheduleCompensationChange(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req ScheduleCompensationChangeRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"new_salary":     "New salary is required with an amount in minor units and a currency",
+				"effective_date": "Effective date is required and must be a valid date",
+				"reason":         "Reason is required",
+				"approved_by":    "Approver is required unless salary changes need approval",
+			})
+	}
+
+	effectiveDate, err := time.Parse(constants.DateFormat, req.EffectiveDate)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEffectiveDate,
+			map[string]string{
+				"effective_date": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	change, approval, err := h.compensationUsecase.RequestCompensationChange(c.Request().Context(), &entity.CompensationChange{
+		EmployeeID:    employeeID,
+		EffectiveDate: effectiveDate,
+		NewSalary:     toMoneyEntity(req.NewSalary),
+		Reason:        req.Reason,
+		ApprovedBy:    req.ApprovedBy,
+	}, req.RequestedBy)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error scheduling compensation change: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if approval != nil {
+		return apiresponse.Accepted(c, "Compensation change submitted for approval", toApprovalRequestResponse(approval))
+	}
+
+	message := "Compensation change applied successfully"
+	if change.Status == entity.CompensationChangeScheduled {
+		message = "Compensation change scheduled successfully"
+	}
+	return apiresponse.Success(c, message, toCompensationChangeResponse(change))
+}
@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// ScheduleInterview godoc
+// @Summary Schedule an interview
+// @Description Schedules an interview with a candidate still in the pipeline, moving applied or screened candidates to the interview stage. Times are in UTC.
+// @Tags Recruiting
+// @Accept json
+// @Produce json
+// @Param id path int true "Candidate ID"
+// @Param payload body InterviewRequest true "Interview payload"
+// @Success 200 {object} InterviewResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /candidates/{id}/interviews [post]
+func (h *RecruitingHandler) ScheduleInterview(c echo.Context) error {
+	candidateID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCandidateId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req InterviewRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"interviewer_id": "Interviewer ID is required",
+				"scheduled_at":   "Scheduled time is required",
+			})
+	}
+
+	interview, details, err := toInterviewEntity(req)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+	interview.CandidateID = candidateID
+
+	createdInterview, err := h.recruitingUsecase.ScheduleInterview(c.Request().Context(), interview)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error scheduling interview: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Interview scheduled successfully", toInterviewResponse(createdInterview))
+}
@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// SearchEmployeesBySkills godoc
+// @Summary Search employees by skills
+// @Description Finds employees holding the required skills at or above the minimum levels.
+// @Description Employees meeting more requirements rank first, then those exceeding the levels by more.
+// @Tags Skills
+// @Accept json
+// @Produce json
+// @Param payload body SkillSearchRequest true "Skill search payload"
+// @Success 200 {object} SkillMatchListResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /skills/search [post]
+func (h *SkillHandler) SearchEmployeesBySkills(c echo.Context) error {
+	var req SkillSearchRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"requirements": "At least one skill requirement is required",
+			})
+	}
+
+	verifiedSince, err := parseOptionalDate(req.VerifiedSince)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidSkillSearch,
+			map[string]string{
+				"verified_since": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	search := usecase.SkillSearch{
+		VerifiedSince: verifiedSince,
+		FullMatchOnly: req.FullMatchOnly,
+		Limit:         req.Limit,
+	}
+	for _, requirement := range req.Requirements {
+		search.Requirements = append(search.Requirements, entity.SkillRequirement{
+			SkillID:  requirement.SkillID,
+			MinLevel: entity.ProficiencyLevel(requirement.MinLevel),
+		})
+	}
+
+	matches, err := h.skillUsecase.SearchEmployeesBySkills(c.Request().Context(), search)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error searching employees by skills: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if len(matches) == 0 {
+		return apiresponse.Success(c, "No matching employees found", nil)
+	}
+
+	matchesResponse := []SkillMatchResponse{}
+	for _, match := range matches {
+		matchesResponse = append(matchesResponse, toSkillMatchResponse(match))
+	}
+
+	return apiresponse.Success(c, "Matching employees retrieved successfully", matchesResponse)
+}
@@ -0,0 +1,224 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// TagsRequest is the payload for tagging employees.
+// swagger:model TagsRequest
+type TagsRequest struct {
+	// Tags are trimmed and lower-cased
+	// example: ["new hire","remote"]
+	Tags []string `json:"tags"`
+}
+
+// TagCountResponse is a tag in use and how many employees have it.
+// swagger:model TagCountResponse
+type TagCountResponse struct {
+	// example: remote
+	Tag string `json:"tag"`
+
+	// example: 12
+	Employees int `json:"employees"`
+}
+
+// SegmentMembersTaggedResponse reports a bulk tagging of a segment's members.
+// swagger:model SegmentMembersTaggedResponse
+type SegmentMembersTaggedResponse struct {
+	// Tags added, not counting those members already had
+	// example: 8
+	TagsAdded int `json:"tags_added"`
+}
+
+// AnnouncementRequest is the payload for sending an announcement to a segment.
+// swagger:model AnnouncementRequest
+type AnnouncementRequest struct {
+	// example: Office closed on Friday
+	Subject string `json:"subject"`
+
+	// example: The office is closed this Friday for maintenance, please work from home.
+	Body string `json:"body"`
+}
+
+// AnnouncementSentResponse reports an announcement sent to a segment.
+// swagger:model AnnouncementSentResponse
+type AnnouncementSentResponse struct {
+	// Members the announcement went to, not counting those without a work email
+	// example: 12
+	Recipients int `json:"recipients"`
+}
+
+// SegmentFilterDTO is the employee list filter a segment saves.
+// swagger:model SegmentFilterDTO
+type SegmentFilterDTO struct {
+	// example: ["active","on_leave"]
+	Statuses []string `json:"statuses,omitempty"`
+
+	// Only direct reports of this manager
+	// example: 7
+	ManagerID int `json:"manager_id,omitempty"`
+
+	// Only employees whose primary location this is
+	// example: 2
+	LocationID int `json:"location_id,omitempty"`
+
+	// Only employees with every one of these tags
+	// example: ["remote"]
+	Tags []string `json:"tags,omitempty"`
+
+	// Custom field values by key, as taken by the custom.<key> filters of the employee list
+	// example: {"t_shirt_size":"M"}
+	CustomFields map[string]string `json:"custom_fields,omitempty"`
+}
+
+// SegmentRequest is the payload for creating or updating a segment.
+// swagger:model SegmentRequest
+type SegmentRequest struct {
+	// example: Remote engineers
+	Name string `json:"name"`
+
+	// example: Engineers working from home, for remote work announcements
+	Description string `json:"description"`
+
+	Filter SegmentFilterDTO `json:"filter"`
+
+	// Employee saving the segment, ignored on update
+	// example: 7
+	CreatedBy *int `json:"created_by"`
+}
+
+// SegmentResponse represents a saved employee segment.
+// swagger:model SegmentResponse
+type SegmentResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: Remote engineers
+	Name string `json:"name"`
+
+	// example: Engineers working from home, for remote work announcements
+	Description string `json:"description,omitempty"`
+
+	Filter SegmentFilterDTO `json:"filter"`
+
+	// example: 7
+	CreatedBy *int `json:"created_by,omitempty"`
+
+	// example: 2025-01-06 09:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-01-06 09:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// TagListResponseWrapper wraps StandardResponse with an employee's tags.
+// swagger:model TagListResponseWrapper
+type TagListResponseWrapper struct {
+	Success   bool     `json:"success"`
+	Message   string   `json:"message"`
+	Data      []string `json:"data"`
+	Timestamp string   `json:"timestamp"`
+	RequestID string   `json:"request_id"`
+}
+
+// TagCountListResponseWrapper wraps StandardResponse with the tags in use.
+// swagger:model TagCountListResponseWrapper
+type TagCountListResponseWrapper struct {
+	Success   bool               `json:"success"`
+	Message   string             `json:"message"`
+	Data      []TagCountResponse `json:"data"`
+	Timestamp string             `json:"timestamp"`
+	RequestID string             `json:"request_id"`
+}
+
+// SegmentResponseWrapper wraps StandardResponse with SegmentResponse as data.
+// swagger:model SegmentResponseWrapper
+type SegmentResponseWrapper struct {
+	Success   bool            `json:"success"`
+	Message   string          `json:"message"`
+	Data      SegmentResponse `json:"data"`
+	Timestamp string          `json:"timestamp"`
+	RequestID string          `json:"request_id"`
+}
+
+// SegmentListResponseWrapper wraps StandardResponse with a list of segments.
+// swagger:model SegmentListResponseWrapper
+type SegmentListResponseWrapper struct {
+	Success   bool              `json:"success"`
+	Message   string            `json:"message"`
+	Data      []SegmentResponse `json:"data"`
+	Timestamp string            `json:"timestamp"`
+	RequestID string            `json:"request_id"`
+}
+
+// SegmentMembersTaggedResponseWrapper wraps StandardResponse with SegmentMembersTaggedResponse as data.
+// swagger:model SegmentMembersTaggedResponseWrapper
+type SegmentMembersTaggedResponseWrapper struct {
+	Success   bool                         `json:"success"`
+	Message   string                       `json:"message"`
+	Data      SegmentMembersTaggedResponse `json:"data"`
+	Timestamp string                       `json:"timestamp"`
+	RequestID string                       `json:"request_id"`
+}
+
+// AnnouncementSentResponseWrapper wraps StandardResponse with AnnouncementSentResponse as data.
+// swagger:model AnnouncementSentResponseWrapper
+type AnnouncementSentResponseWrapper struct {
+	Success   bool                     `json:"success"`
+	Message   string                   `json:"message"`
+	Data      AnnouncementSentResponse `json:"data"`
+	Timestamp string                   `json:"timestamp"`
+	RequestID string                   `json:"request_id"`
+}
+
+func toSegmentEntity(req SegmentRequest) *entity.EmployeeSegment {
+	filter := entity.EmployeeFilter{
+		ManagerID:  req.Filter.ManagerID,
+		LocationID: req.Filter.LocationID,
+		Tags:       req.Filter.Tags,
+	}
+	for _, status := range req.Filter.Statuses {
+		filter.Statuses = append(filter.Statuses, entity.EmploymentStatus(status))
+	}
+	if len(req.Filter.CustomFields) > 0 {
+		filter.CustomFields = map[string]any{}
+		for key, value := range req.Filter.CustomFields {
+			filter.CustomFields[key] = value
+		}
+	}
+	return &entity.EmployeeSegment{
+		Name:        req.Name,
+		Description: req.Description,
+		Filter:      filter,
+		CreatedBy:   req.CreatedBy,
+	}
+}
+
+func toSegmentResponse(segment *entity.EmployeeSegment) SegmentResponse {
+	filter := SegmentFilterDTO{
+		ManagerID:  segment.Filter.ManagerID,
+		LocationID: segment.Filter.LocationID,
+		Tags:       segment.Filter.Tags,
+	}
+	for _, status := range segment.Filter.Statuses {
+		filter.Statuses = append(filter.Statuses, string(status))
+	}
+	if len(segment.Filter.CustomFields) > 0 {
+		filter.CustomFields = map[string]string{}
+		for key, value := range segment.Filter.CustomFields {
+			if text, ok := value.(string); ok {
+				filter.CustomFields[key] = text
+			}
+		}
+	}
+	return SegmentResponse{
+		ID:          segment.ID,
+		Name:        segment.Name,
+		Description: segment.Description,
+		Filter:      filter,
+		CreatedBy:   segment.CreatedBy,
+		CreatedAt:   segment.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:   segment.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type SegmentHandler struct {
+	segmentUsecase usecase.SegmentUsecase
+}
+
+func NewSegmentHandler(segmentUsecase usecase.SegmentUsecase) *SegmentHandler {
+	return &SegmentHandler{segmentUsecase: segmentUsecase}
+}
@@ -0,0 +1,76 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// SetEmployeeSkill godoc
+// @Summary Set an employee's skill level
+// @Description Records the employee's proficiency in a skill, replacing any earlier level
+// @Tags Skills
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param skillId path int true "Skill ID"
+// @Param payload body EmployeeSkillRequest true "Employee skill payload"
+// @Success 200 {object} EmployeeSkillResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/skills/{skillId} [put]
+func (h *SkillHandler) SetEmployeeSkill(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	skillID, err := parseIDParam(c, "skillId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidSkillId,
+			map[string]string{
+				"skillId": "Skill ID must be a valid number",
+			})
+	}
+
+	var req EmployeeSkillRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"level": "Level is required and must be between 1 and 5",
+			})
+	}
+
+	lastVerifiedOn, err := parseOptionalDate(req.LastVerifiedOn)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidProficiencyLevel,
+			map[string]string{
+				"last_verified_on": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	employeeSkill, err := h.skillUsecase.SetEmployeeSkill(c.Request().Context(), &entity.EmployeeSkill{
+		EmployeeID:     employeeID,
+		SkillID:        skillID,
+		Level:          entity.ProficiencyLevel(req.Level),
+		LastVerifiedOn: lastVerifiedOn,
+	})
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error setting employee skill: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Employee skill saved successfully", toEmployeeSkillResponse(employeeSkill))
+}
@@ -0,0 +1,208 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// ShiftTemplateRequest is the payload for creating or updating a shift template.
+// swagger:model ShiftTemplateRequest
+type ShiftTemplateRequest struct {
+	// example: Early support
+	Name string `json:"name"`
+
+	// UTC wall clock time
+	// example: 06:00
+	StartTime string `json:"start_time"`
+
+	// UTC wall clock time, at or before start_time for an overnight shift
+	// example: 14:30
+	EndTime string `json:"end_time"`
+
+	// example: 30
+	BreakMinutes int `json:"break_minutes"`
+}
+
+// ShiftTemplateResponse represents a shift template.
+// swagger:model ShiftTemplateResponse
+type ShiftTemplateResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: Early support
+	Name string `json:"name"`
+
+	// example: 06:00
+	StartTime string `json:"start_time"`
+
+	// example: 14:30
+	EndTime string `json:"end_time"`
+
+	// example: 30
+	BreakMinutes int `json:"break_minutes"`
+
+	// Shift length including the break
+	// example: 510
+	DurationMinutes int `json:"duration_minutes"`
+
+	// example: 2025-01-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-01-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// RosterRequest is the payload for assigning employees to a shift template over a date range.
+// swagger:model RosterRequest
+type RosterRequest struct {
+	// example: 1
+	ShiftTemplateID int `json:"shift_template_id"`
+
+	// example: [1,2,3]
+	EmployeeIDs []int `json:"employee_ids"`
+
+	// example: 2025-03-03
+	From string `json:"from"`
+
+	// example: 2025-03-30
+	To string `json:"to"`
+
+	// ISO weekdays to roster, 1 (Monday) to 7 (Sunday); omit for every day
+	// example: [1,2,3,4,5]
+	Weekdays []int `json:"weekdays"`
+
+	// Only check the roster for conflicts
+	// example: false
+	DryRun bool `json:"dry_run"`
+}
+
+// ShiftResponse represents a rostered shift.
+// swagger:model ShiftResponse
+type ShiftResponse struct {
+	// example: 1
+	ID int `json:"id,omitempty"`
+
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: 1
+	ShiftTemplateID int `json:"shift_template_id"`
+
+	// example: Early support
+	ShiftTemplateName string `json:"shift_template_name"`
+
+	// example: 2025-03-03
+	Date string `json:"date"`
+
+	// example: 2025-03-03 06:00:00
+	StartsAt string `json:"starts_at"`
+
+	// example: 2025-03-03 14:30:00
+	EndsAt string `json:"ends_at"`
+
+	// example: 30
+	BreakMinutes int `json:"break_minutes"`
+}
+
+// ShiftConflictResponse is a planned shift that could not be rostered.
+// swagger:model ShiftConflictResponse
+type ShiftConflictResponse struct {
+	// example: 2
+	EmployeeID int `json:"employee_id"`
+
+	// example: 2025-03-04
+	Date string `json:"date"`
+
+	// One of not_employed, on_leave, overlapping_shift, insufficient_rest
+	// example: on_leave
+	Reason string `json:"reason"`
+}
+
+// RosterResponse lists the shifts of a roster, or for a dry run the conflicts preventing it.
+// swagger:model RosterResponse
+type RosterResponse struct {
+	Shifts    []ShiftResponse         `json:"shifts"`
+	Conflicts []ShiftConflictResponse `json:"conflicts"`
+}
+
+// ShiftTemplateResponseWrapper wraps StandardResponse with ShiftTemplateResponse as data.
+// swagger:model ShiftTemplateResponseWrapper
+type ShiftTemplateResponseWrapper struct {
+	Success   bool                  `json:"success"`
+	Message   string                `json:"message"`
+	Data      ShiftTemplateResponse `json:"data"`
+	Timestamp string                `json:"timestamp"`
+	RequestID string                `json:"request_id"`
+}
+
+// ShiftTemplateListResponseWrapper wraps StandardResponse with a list of shift templates.
+// swagger:model ShiftTemplateListResponseWrapper
+type ShiftTemplateListResponseWrapper struct {
+	Success   bool                    `json:"success"`
+	Message   string                  `json:"message"`
+	Data      []ShiftTemplateResponse `json:"data"`
+	Timestamp string                  `json:"timestamp"`
+	RequestID string                  `json:"request_id"`
+}
+
+// ShiftListResponseWrapper wraps StandardResponse with a list of shifts.
+// swagger:model ShiftListResponseWrapper
+type ShiftListResponseWrapper struct {
+	Success   bool            `json:"success"`
+	Message   string          `json:"message"`
+	Data      []ShiftResponse `json:"data"`
+	Timestamp string          `json:"timestamp"`
+	RequestID string          `json:"request_id"`
+}
+
+// RosterResponseWrapper wraps StandardResponse with RosterResponse as data.
+// swagger:model RosterResponseWrapper
+type RosterResponseWrapper struct {
+	Success   bool           `json:"success"`
+	Message   string         `json:"message"`
+	Data      RosterResponse `json:"data"`
+	Timestamp string         `json:"timestamp"`
+	RequestID string         `json:"request_id"`
+}
+
+func toShiftTemplateResponse(template *entity.ShiftTemplate) ShiftTemplateResponse {
+	return ShiftTemplateResponse{
+		ID:              template.ID,
+		Name:            template.Name,
+		StartTime:       template.StartTime.String(),
+		EndTime:         template.EndTime.String(),
+		BreakMinutes:    template.BreakMinutes,
+		DurationMinutes: int(template.Duration().Minutes()),
+		CreatedAt:       template.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:       template.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toShiftResponse(shift *entity.Shift) ShiftResponse {
+	return ShiftResponse{
+		ID:                shift.ID,
+		EmployeeID:        shift.EmployeeID,
+		ShiftTemplateID:   shift.ShiftTemplateID,
+		ShiftTemplateName: shift.ShiftTemplateName,
+		Date:              shift.Date.Format(constants.DateFormat),
+		StartsAt:          shift.StartsAt.Format(constants.DateTimeFormat),
+		EndsAt:            shift.EndsAt.Format(constants.DateTimeFormat),
+		BreakMinutes:      shift.BreakMinutes,
+	}
+}
+
+func toRosterResponse(result *usecase.RosterResult) RosterResponse {
+	response := RosterResponse{Shifts: []ShiftResponse{}, Conflicts: []ShiftConflictResponse{}}
+	for _, shift := range result.Shifts {
+		response.Shifts = append(response.Shifts, toShiftResponse(shift))
+	}
+	for _, conflict := range result.Conflicts {
+		response.Conflicts = append(response.Conflicts, ShiftConflictResponse{
+			EmployeeID: conflict.EmployeeID,
+			Date:       conflict.Date.Format(constants.DateFormat),
+			Reason:     string(conflict.Reason),
+		})
+	}
+	return response
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type ShiftHandler struct {
+	shiftUsecase usecase.ShiftUsecase
+}
+
+func NewShiftHandler(shiftUsecase usecase.ShiftUsecase) *ShiftHandler {
+	return &ShiftHandler{shiftUsecase: shiftUsecase}
+}
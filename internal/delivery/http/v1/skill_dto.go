@@ -0,0 +1,323 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// SkillCategoryRequest is the payload for creating or updating a skill category.
+// swagger:model SkillCategoryRequest
+type SkillCategoryRequest struct {
+	// example: Programming languages
+	Name string `json:"name"`
+}
+
+// SkillCategoryResponse represents a skill category.
+// swagger:model SkillCategoryResponse
+type SkillCategoryResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: Programming languages
+	Name string `json:"name"`
+
+	// example: 2025-01-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-01-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// SkillRequest is the payload for creating or updating a skill.
+// swagger:model SkillRequest
+type SkillRequest struct {
+	// example: 1
+	CategoryID int `json:"category_id"`
+
+	// example: Go
+	Name string `json:"name"`
+
+	// example: Building backend services in Go
+	Description string `json:"description"`
+}
+
+// SkillResponse represents a skill.
+// swagger:model SkillResponse
+type SkillResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	CategoryID int `json:"category_id"`
+
+	// example: Programming languages
+	CategoryName string `json:"category_name"`
+
+	// example: Go
+	Name string `json:"name"`
+
+	// example: Building backend services in Go
+	Description string `json:"description,omitempty"`
+
+	// example: 2025-01-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-01-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// EmployeeSkillRequest is the payload for setting an employee's level in a skill.
+// swagger:model EmployeeSkillRequest
+type EmployeeSkillRequest struct {
+	// From 1 (novice) to 5 (expert)
+	// example: 4
+	Level int `json:"level"`
+
+	// When the level was last confirmed; empty when it never was
+	// example: 2025-03-01
+	LastVerifiedOn string `json:"last_verified_on"`
+}
+
+// EmployeeSkillResponse represents an employee's level in a skill.
+// swagger:model EmployeeSkillResponse
+type EmployeeSkillResponse struct {
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: 1
+	SkillID int `json:"skill_id"`
+
+	// example: Go
+	SkillName string `json:"skill_name"`
+
+	// example: Programming languages
+	CategoryName string `json:"category_name"`
+
+	// example: 4
+	Level int `json:"level"`
+
+	// One of novice, beginner, intermediate, advanced or expert
+	// example: advanced
+	LevelName string `json:"level_name"`
+
+	// example: 2025-03-01
+	LastVerifiedOn string `json:"last_verified_on,omitempty"`
+
+	// example: 2025-03-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// SkillRequirementDTO asks for a skill at a minimum level.
+// swagger:model SkillRequirementDTO
+type SkillRequirementDTO struct {
+	// example: 1
+	SkillID int `json:"skill_id"`
+
+	// From 1 (novice) to 5 (expert)
+	// example: 3
+	MinLevel int `json:"min_level"`
+}
+
+// SkillSearchRequest is the payload for searching employees by skills.
+// swagger:model SkillSearchRequest
+type SkillSearchRequest struct {
+	// 1 to 20 distinct skills
+	Requirements []SkillRequirementDTO `json:"requirements"`
+
+	// Only count levels verified on or after this date
+	// example: 2024-01-01
+	VerifiedSince string `json:"verified_since"`
+
+	// Leave out employees missing any requirement
+	// example: false
+	FullMatchOnly bool `json:"full_match_only"`
+
+	// Defaults to 20, at most 100
+	// example: 20
+	Limit int `json:"limit"`
+}
+
+// SkillMatchDetailResponse is how an employee meets one requirement.
+// swagger:model SkillMatchDetailResponse
+type SkillMatchDetailResponse struct {
+	// example: 1
+	SkillID int `json:"skill_id"`
+
+	// example: Go
+	SkillName string `json:"skill_name"`
+
+	// example: 3
+	MinLevel int `json:"min_level"`
+
+	// Empty when the employee lacks the skill
+	// example: 4
+	Level int `json:"level,omitempty"`
+
+	// example: 2025-03-01
+	LastVerifiedOn string `json:"last_verified_on,omitempty"`
+
+	// example: true
+	Met bool `json:"met"`
+}
+
+// SkillMatchResponse is an employee found by a skill search.
+// swagger:model SkillMatchResponse
+type SkillMatchResponse struct {
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: John Doe
+	EmployeeName string `json:"employee_name"`
+
+	// example: Software Engineer
+	Position string `json:"position,omitempty"`
+
+	// Requirements met at or above their minimum level
+	// example: 2
+	Matched int `json:"matched"`
+
+	// example: 3
+	Required int `json:"required"`
+
+	// Average of how far each requirement is met, as a percentage
+	// example: 88.89
+	Score float64 `json:"score"`
+
+	Skills []SkillMatchDetailResponse `json:"skills"`
+}
+
+// SkillCategoryResponseWrapper wraps StandardResponse with SkillCategoryResponse as data.
+// swagger:model SkillCategoryResponseWrapper
+type SkillCategoryResponseWrapper struct {
+	Success   bool                  `json:"success"`
+	Message   string                `json:"message"`
+	Data      SkillCategoryResponse `json:"data"`
+	Timestamp string                `json:"timestamp"`
+	RequestID string                `json:"request_id"`
+}
+
+// SkillCategoryListResponseWrapper wraps StandardResponse with a list of skill categories.
+// swagger:model SkillCategoryListResponseWrapper
+type SkillCategoryListResponseWrapper struct {
+	Success   bool                    `json:"success"`
+	Message   string                  `json:"message"`
+	Data      []SkillCategoryResponse `json:"data"`
+	Timestamp string                  `json:"timestamp"`
+	RequestID string                  `json:"request_id"`
+}
+
+// SkillResponseWrapper wraps StandardResponse with SkillResponse as data.
+// swagger:model SkillResponseWrapper
+type SkillResponseWrapper struct {
+	Success   bool          `json:"success"`
+	Message   string        `json:"message"`
+	Data      SkillResponse `json:"data"`
+	Timestamp string        `json:"timestamp"`
+	RequestID string        `json:"request_id"`
+}
+
+// SkillListResponseWrapper wraps StandardResponse with a list of skills.
+// swagger:model SkillListResponseWrapper
+type SkillListResponseWrapper struct {
+	Success   bool            `json:"success"`
+	Message   string          `json:"message"`
+	Data      []SkillResponse `json:"data"`
+	Timestamp string          `json:"timestamp"`
+	RequestID string          `json:"request_id"`
+}
+
+// EmployeeSkillResponseWrapper wraps StandardResponse with EmployeeSkillResponse as data.
+// swagger:model EmployeeSkillResponseWrapper
+type EmployeeSkillResponseWrapper struct {
+	Success   bool                  `json:"success"`
+	Message   string                `json:"message"`
+	Data      EmployeeSkillResponse `json:"data"`
+	Timestamp string                `json:"timestamp"`
+	RequestID string                `json:"request_id"`
+}
+
+// EmployeeSkillListResponseWrapper wraps StandardResponse with a list of employee skills.
+// swagger:model EmployeeSkillListResponseWrapper
+type EmployeeSkillListResponseWrapper struct {
+	Success   bool                    `json:"success"`
+	Message   string                  `json:"message"`
+	Data      []EmployeeSkillResponse `json:"data"`
+	Timestamp string                  `json:"timestamp"`
+	RequestID string                  `json:"request_id"`
+}
+
+// SkillMatchListResponseWrapper wraps StandardResponse with a list of skill matches.
+// swagger:model SkillMatchListResponseWrapper
+type SkillMatchListResponseWrapper struct {
+	Success   bool                 `json:"success"`
+	Message   string               `json:"message"`
+	Data      []SkillMatchResponse `json:"data"`
+	Timestamp string               `json:"timestamp"`
+	RequestID string               `json:"request_id"`
+}
+
+func toSkillCategoryResponse(category *entity.SkillCategory) SkillCategoryResponse {
+	return SkillCategoryResponse{
+		ID:        category.ID,
+		Name:      category.Name,
+		CreatedAt: category.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt: category.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toSkillEntity(req SkillRequest) *entity.Skill {
+	return &entity.Skill{
+		CategoryID:  req.CategoryID,
+		Name:        req.Name,
+		Description: req.Description,
+	}
+}
+
+func toSkillResponse(skill *entity.Skill) SkillResponse {
+	return SkillResponse{
+		ID:           skill.ID,
+		CategoryID:   skill.CategoryID,
+		CategoryName: skill.CategoryName,
+		Name:         skill.Name,
+		Description:  skill.Description,
+		CreatedAt:    skill.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:    skill.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toEmployeeSkillResponse(employeeSkill *entity.EmployeeSkill) EmployeeSkillResponse {
+	return EmployeeSkillResponse{
+		EmployeeID:     employeeSkill.EmployeeID,
+		SkillID:        employeeSkill.SkillID,
+		SkillName:      employeeSkill.SkillName,
+		CategoryName:   employeeSkill.CategoryName,
+		Level:          int(employeeSkill.Level),
+		LevelName:      employeeSkill.Level.String(),
+		LastVerifiedOn: formatOptionalDate(employeeSkill.LastVerifiedOn),
+		UpdatedAt:      employeeSkill.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toSkillMatchResponse(match *usecase.SkillMatch) SkillMatchResponse {
+	response := SkillMatchResponse{
+		EmployeeID:   match.EmployeeID,
+		EmployeeName: match.EmployeeName,
+		Position:     match.Position,
+		Matched:      match.Matched,
+		Required:     match.Required,
+		Score:        match.Score,
+		Skills:       []SkillMatchDetailResponse{},
+	}
+	for _, detail := range match.Skills {
+		response.Skills = append(response.Skills, SkillMatchDetailResponse{
+			SkillID:        detail.SkillID,
+			SkillName:      detail.SkillName,
+			MinLevel:       int(detail.MinLevel),
+			Level:          int(detail.Level),
+			LastVerifiedOn: formatOptionalDate(detail.LastVerifiedOn),
+			Met:            detail.Met,
+		})
+	}
+	return response
+}
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type SkillHandler struct {
+	skillUsecase usecase.SkillUsecase
+}
+
+func NewSkillHandler(skillUsecase usecase.SkillUsecase) *SkillHandler {
+	return &SkillHandler{skillUsecase: skillUsecase}
+}
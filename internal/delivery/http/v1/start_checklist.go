@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// StartChecklist godoc
+// @Summary Start a checklist for an employee
+// @Description Starts an onboarding checklist for a current employee or an offboarding checklist for a terminated one, from the most specific matching template. Checklists start by themselves on hiring and termination; this is for templates added later or checklists restarted after completion.
+// @Tags Checklists
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param payload body StartChecklistRequest true "Checklist kind"
+// @Success 200 {object} ChecklistResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/checklists [post]
+func (h *ChecklistHandler) StartChecklist(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req StartChecklistRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"kind": "Kind is required, onboarding or offboarding",
+			})
+	}
+
+	checklist, err := h.checklistUsecase.StartChecklist(c.Request().Context(), id, entity.ChecklistKind(req.Kind))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error starting checklist: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Checklist started successfully", toChecklistResponse(checklist))
+}
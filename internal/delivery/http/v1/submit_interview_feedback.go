@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// SubmitInterviewFeedback godoc
+// @Summary Submit interview feedback
+// @Description Records the interviewer's rating, recommendation and feedback and completes the interview
+// @Tags Recruiting
+// @Accept json
+// @Produce json
+// @Param id path int true "Interview ID"
+// @Param payload body InterviewFeedbackRequest true "Feedback payload"
+// @Success 200 {object} InterviewResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /interviews/{id}/feedback [post]
+func (h *RecruitingHandler) SubmitInterviewFeedback(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidInterviewId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req InterviewFeedbackRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"rating":         "Rating is required",
+				"recommendation": "Recommendation is required",
+				"feedback":       "Feedback is required",
+			})
+	}
+
+	feedback := &entity.Interview{
+		ID:             id,
+		Rating:         req.Rating,
+		Recommendation: entity.InterviewRecommendation(req.Recommendation),
+		Feedback:       req.Feedback,
+	}
+
+	interview, err := h.recruitingUsecase.SubmitInterviewFeedback(c.Request().Context(), feedback)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error submitting interview feedback: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Interview feedback submitted successfully", toInterviewResponse(interview))
+}
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// SubmitReview godoc
+// @Summary Submit a review
+// @Description Records a self, peer or manager review before the deadline of its stage. Every question of the cycle must be answered once. The manager review completes the review.
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Param id path int true "Review ID"
+// @Param payload body ReviewSubmissionRequest true "Review submission payload"
+// @Success 200 {object} ReviewResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 403 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /reviews/{id}/submissions [post]
+func (h *ReviewHandler) SubmitReview(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidReviewId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req ReviewSubmissionRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"reviewer_id": "Reviewer ID is required",
+				"role":        "Role is required, one of self, peer or manager",
+				"answers":     "An answer to every question is required",
+			})
+	}
+
+	review, err := h.reviewUsecase.SubmitReview(c.Request().Context(), toReviewSubmissionEntity(id, req))
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error submitting review: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Review submitted successfully", toReviewResponse(review))
+}
@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// SubmitTimesheet godoc
+// @Summary Submit a weekly timesheet
+// @Description Submits the week containing the given date for approval and locks its shifts. Shifts without a clock-out must be corrected first; a rejected week can be resubmitted.
+// @Tags Attendance
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param week path string true "Any date in the week (YYYY-MM-DD)"
+// @Success 200 {object} WeeklyTimesheetResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/timesheets/{week}/submit [post]
+func (h *AttendanceHandler) SubmitTimesheet(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	week, err := time.Parse(constants.DateFormat, c.Param("week"))
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidTimesheetWeek,
+			map[string]string{
+				"week": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	timesheet, err := h.attendanceUsecase.SubmitTimesheet(c.Request().Context(), employeeID, week)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error submitting timesheet: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Timesheet submitted successfully", toWeeklyTimesheetResponse(timesheet))
+}
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// TagSegmentMembers godoc
+// @Summary Tag a segment's members
+// @Description Adds tags to every employee currently in a segment, skipping tags members already have, and returns how many tags were added
+// @Tags Segments
+// @Accept json
+// @Produce json
+// @Param id path int true "Segment ID"
+// @Param payload body TagsRequest true "Tags payload"
+// @Success 200 {object} SegmentMembersTaggedResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /segments/{id}/tags [post]
+func (h *SegmentHandler) TagSegmentMembers(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidSegmentId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req TagsRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"tags": "Tags must be a list of strings",
+			})
+	}
+
+	added, err := h.segmentUsecase.TagSegmentMembers(c.Request().Context(), id, req.Tags)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error tagging segment members: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Segment members tagged successfully", SegmentMembersTaggedResponse{TagsAdded: added})
+}
@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// TerminateEmployee godoc
+// @Summary Terminate an employee
+// @Description Ends the employment of an employee. The employee record is kept and stays queryable. When terminations go through an approval workflow, the termination is submitted for approval instead and the pending approval request is returned with status 202. Company assets the employee still holds are counted in unreturned_assets, and in the summary of the approval request.
+// @Tags Employees
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param payload body TerminateEmployeeRequest true "Termination payload"
+// @Success 200 {object} GetEmployeeByIdResponseWrapper
+// @Success 202 {object} ApprovalRequestResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/terminate [post]
+func (h *EmployeeHandler) TerminateEmployee(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req TerminateEmployeeRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"termination_date": "Termination date is required and must be a valid date",
+				"reason":           "Reason is required",
+			})
+	}
+
+	terminationDate, err := time.Parse(constants.DateFormat, req.TerminationDate)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidTerminationDate,
+			map[string]string{
+				"termination_date": "Date format is invalid , expected format: YYYY-MM-DD",
+			})
+	}
+
+	employee, approval, err := h.employeeUsecase.RequestTermination(c.Request().Context(), id, terminationDate,
+		req.Reason, req.RequestedBy)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error terminating employee: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+	if approval != nil {
+		return apiresponse.Accepted(c, "Termination submitted for approval", toApprovalRequestResponse(approval))
+	}
+
+	if employee.UnreturnedAssets > 0 {
+		return apiresponse.Success(c, "Employee terminated, company assets are still to be returned",
+			toGetEmployeeByIdResponse(employee))
+	}
+	return apiresponse.Success(c, "Employee terminated successfully", toGetEmployeeByIdResponse(employee))
+}
@@ -0,0 +1,206 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// TrainingCourseRequest is the payload for creating or updating a training course.
+// swagger:model TrainingCourseRequest
+type TrainingCourseRequest struct {
+	// example: Forklift safety
+	Name string `json:"name"`
+
+	// example: Safe operation of counterbalance forklifts
+	Description string `json:"description"`
+
+	// example: National Safety Council
+	Provider string `json:"provider"`
+
+	// example: 16
+	DurationHours float64 `json:"duration_hours"`
+
+	// Completing the course issues a certification valid for this many months, 0 for one that
+	// never expires; leave empty for courses that issue none
+	// example: 36
+	CertificationValidMonths *int `json:"certification_valid_months"`
+}
+
+// TrainingCourseResponse represents a training course.
+// swagger:model TrainingCourseResponse
+type TrainingCourseResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: Forklift safety
+	Name string `json:"name"`
+
+	// example: Safe operation of counterbalance forklifts
+	Description string `json:"description,omitempty"`
+
+	// example: National Safety Council
+	Provider string `json:"provider,omitempty"`
+
+	// example: 16
+	DurationHours float64 `json:"duration_hours"`
+
+	// example: 36
+	CertificationValidMonths *int `json:"certification_valid_months,omitempty"`
+
+	// example: 2025-03-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-03-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// EnrollmentRequest is the payload for enrolling an employee in a course.
+// swagger:model EnrollmentRequest
+type EnrollmentRequest struct {
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// Defaults to today
+	// example: 2025-03-01
+	EnrolledOn string `json:"enrolled_on"`
+
+	// example: 2025-04-30
+	DueOn string `json:"due_on"`
+}
+
+// EnrollmentCompletionRequest is the payload for completing an enrollment.
+// swagger:model EnrollmentCompletionRequest
+type EnrollmentCompletionRequest struct {
+	// Defaults to today
+	// example: 2025-04-15
+	CompletedOn string `json:"completed_on"`
+}
+
+// EnrollmentResponse represents an employee's enrollment in a course.
+// swagger:model EnrollmentResponse
+type EnrollmentResponse struct {
+	// example: 1
+	ID int `json:"id"`
+
+	// example: 1
+	CourseID int `json:"course_id"`
+
+	// example: Forklift safety
+	CourseName string `json:"course_name"`
+
+	// example: 1
+	EmployeeID int `json:"employee_id"`
+
+	// example: John Doe
+	EmployeeName string `json:"employee_name"`
+
+	// One of enrolled, completed or cancelled
+	// example: enrolled
+	Status string `json:"status"`
+
+	// example: 2025-03-01
+	EnrolledOn string `json:"enrolled_on"`
+
+	// example: 2025-04-30
+	DueOn string `json:"due_on,omitempty"`
+
+	// Still open after the due date
+	// example: false
+	Overdue bool `json:"overdue"`
+
+	// example: 2025-04-15
+	CompletedOn string `json:"completed_on,omitempty"`
+
+	// Certification issued on completion
+	// example: 1
+	CertificationID *int `json:"certification_id,omitempty"`
+
+	// example: 2025-03-01 08:00:00
+	CreatedAt string `json:"created_at"`
+
+	// example: 2025-03-01 08:00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// TrainingCourseResponseWrapper wraps StandardResponse with TrainingCourseResponse as data.
+// swagger:model TrainingCourseResponseWrapper
+type TrainingCourseResponseWrapper struct {
+	Success   bool                   `json:"success"`
+	Message   string                 `json:"message"`
+	Data      TrainingCourseResponse `json:"data"`
+	Timestamp string                 `json:"timestamp"`
+	RequestID string                 `json:"request_id"`
+}
+
+// TrainingCourseListResponseWrapper wraps StandardResponse with a list of training courses.
+// swagger:model TrainingCourseListResponseWrapper
+type TrainingCourseListResponseWrapper struct {
+	Success   bool                     `json:"success"`
+	Message   string                   `json:"message"`
+	Data      []TrainingCourseResponse `json:"data"`
+	Timestamp string                   `json:"timestamp"`
+	RequestID string                   `json:"request_id"`
+}
+
+// EnrollmentResponseWrapper wraps StandardResponse with EnrollmentResponse as data.
+// swagger:model EnrollmentResponseWrapper
+type EnrollmentResponseWrapper struct {
+	Success   bool               `json:"success"`
+	Message   string             `json:"message"`
+	Data      EnrollmentResponse `json:"data"`
+	Timestamp string             `json:"timestamp"`
+	RequestID string             `json:"request_id"`
+}
+
+// EnrollmentListResponseWrapper wraps StandardResponse with a list of enrollments.
+// swagger:model EnrollmentListResponseWrapper
+type EnrollmentListResponseWrapper struct {
+	Success   bool                 `json:"success"`
+	Message   string               `json:"message"`
+	Data      []EnrollmentResponse `json:"data"`
+	Timestamp string               `json:"timestamp"`
+	RequestID string               `json:"request_id"`
+}
+
+func toTrainingCourseEntity(req TrainingCourseRequest) *entity.TrainingCourse {
+	return &entity.TrainingCourse{
+		Name:                     req.Name,
+		Description:              req.Description,
+		Provider:                 req.Provider,
+		DurationHours:            req.DurationHours,
+		CertificationValidMonths: req.CertificationValidMonths,
+	}
+}
+
+func toTrainingCourseResponse(course *entity.TrainingCourse) TrainingCourseResponse {
+	return TrainingCourseResponse{
+		ID:                       course.ID,
+		Name:                     course.Name,
+		Description:              course.Description,
+		Provider:                 course.Provider,
+		DurationHours:            course.DurationHours,
+		CertificationValidMonths: course.CertificationValidMonths,
+		CreatedAt:                course.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:                course.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
+
+func toEnrollmentResponse(enrollment *entity.TrainingEnrollment) EnrollmentResponse {
+	return EnrollmentResponse{
+		ID:              enrollment.ID,
+		CourseID:        enrollment.CourseID,
+		CourseName:      enrollment.CourseName,
+		EmployeeID:      enrollment.EmployeeID,
+		EmployeeName:    enrollment.EmployeeName,
+		Status:          string(enrollment.Status),
+		EnrolledOn:      enrollment.EnrolledOn.Format(constants.DateFormat),
+		DueOn:           formatOptionalDate(enrollment.DueOn),
+		Overdue:         enrollment.IsOverdue(time.Now()),
+		CompletedOn:     formatOptionalDate(enrollment.CompletedOn),
+		CertificationID: enrollment.CertificationID,
+		CreatedAt:       enrollment.CreatedAt.Format(constants.DateTimeFormat),
+		UpdatedAt:       enrollment.UpdatedAt.Format(constants.DateTimeFormat),
+	}
+}
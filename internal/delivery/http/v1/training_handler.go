@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+type TrainingHandler struct {
+	trainingUsecase usecase.TrainingUsecase
+}
+
+func NewTrainingHandler(trainingUsecase usecase.TrainingUsecase) *TrainingHandler {
+	return &TrainingHandler{trainingUsecase: trainingUsecase}
+}
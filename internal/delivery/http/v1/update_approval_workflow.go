@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateWorkflow godoc
+// @Summary Update an approval workflow
+// @Description Replaces a workflow's details and steps. Requests already submitted keep their tasks.
+// @Tags Approvals
+// @Accept json
+// @Produce json
+// @Param id path int true "Approval workflow ID"
+// @Param payload body ApprovalWorkflowRequest true "Approval workflow payload"
+// @Success 200 {object} ApprovalWorkflowResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /approval-workflows/{id} [put]
+func (h *ApprovalHandler) UpdateWorkflow(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidApprovalWorkflowId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req ApprovalWorkflowRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":         "Name is required and must be at least 3 characters long",
+				"request_type": "Request type is required, salary_change, termination, expense or leave",
+				"steps":        "At least one step is required",
+			})
+	}
+
+	workflow := toApprovalWorkflowEntity(req)
+	workflow.ID = id
+	updatedWorkflow, err := h.approvalUsecase.UpdateWorkflow(c.Request().Context(), workflow)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating approval workflow: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Approval workflow updated successfully", toApprovalWorkflowResponse(updatedWorkflow))
+}
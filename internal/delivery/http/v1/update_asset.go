@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateAsset godoc
+// @Summary Update an asset
+// @Description Changes an asset's details. The status of an assigned asset only changes by returning it.
+// @Tags Assets
+// @Accept json
+// @Produce json
+// @Param id path int true "Asset ID"
+// @Param payload body AssetRequest true "Asset payload"
+// @Success 200 {object} AssetResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /assets/{id} [put]
+func (h *AssetHandler) UpdateAsset(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidAssetId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req AssetRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":          "Name is required and must be at least 2 characters long",
+				"type":          "Type is required",
+				"serial_number": "Serial number is required",
+			})
+	}
+
+	asset, details, err := toAssetEntity(req)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+	asset.ID = id
+
+	updatedAsset, err := h.assetUsecase.UpdateAsset(c.Request().Context(), asset)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating asset: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Asset updated successfully", toAssetResponse(updatedAsset))
+}
@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// UpdateAttendanceEntry godoc
+// @Summary Correct a shift
+// @Description Replaces the clock-in, clock-out and note of a shift, e.g. to fill in a missing clock-out. Weeks that are submitted or approved cannot be changed.
+// @Tags Attendance
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param entryId path int true "Attendance entry ID"
+// @Param payload body AttendanceEntryRequest true "Shift payload"
+// @Success 200 {object} AttendanceEntryResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/attendance/{entryId} [put]
+func (h *AttendanceHandler) UpdateAttendanceEntry(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	entryID, err := parseIDParam(c, "entryId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidAttendanceEntryId,
+			map[string]string{
+				"entryId": "Attendance entry ID must be a valid number",
+			})
+	}
+
+	var req AttendanceEntryRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"clock_in": "Clock-in time is required",
+			})
+	}
+
+	clockIn, err := time.Parse(constants.DateTimeFormat, req.ClockIn)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidPunchTime,
+			map[string]string{
+				"clock_in": "Time format is invalid , expected format: YYYY-MM-DD HH:MM:SS",
+			})
+	}
+	entry := &entity.AttendanceEntry{
+		ID:         entryID,
+		EmployeeID: employeeID,
+		ClockIn:    clockIn,
+		Note:       req.Note,
+	}
+	if req.ClockOut != "" {
+		clockOut, err := time.Parse(constants.DateTimeFormat, req.ClockOut)
+		if err != nil {
+			return apiresponse.Error(c,
+				appError.ErrInvalidPunchTime,
+				map[string]string{
+					"clock_out": "Time format is invalid , expected format: YYYY-MM-DD HH:MM:SS",
+				})
+		}
+		entry.ClockOut = &clockOut
+	}
+
+	updatedEntry, err := h.attendanceUsecase.UpdateAttendanceEntry(c.Request().Context(), entry)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating attendance entry: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Attendance entry updated successfully", toAttendanceEntryResponse(updatedEntry))
+}
@@ -0,0 +1,58 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateBenefitPlan godoc
+// @Summary Update a benefit plan
+// @Description Replaces a benefit plan's details. New contributions apply to existing enrollments from the next deductions on, new eligibility rules only to new enrollments
+// @Tags Benefits
+// @Accept json
+// @Produce json
+// @Param id path int true "Benefit plan ID"
+// @Param payload body BenefitPlanRequest true "Benefit plan payload"
+// @Success 200 {object} BenefitPlanResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /benefit-plans/{id} [put]
+func (h *BenefitHandler) UpdateBenefitPlan(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidBenefitPlanId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req BenefitPlanRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":     "Name is required and must be at least 2 characters long",
+				"type":     "Type is required",
+				"currency": "Currency is required",
+			})
+	}
+
+	plan := toBenefitPlanEntity(req)
+	plan.ID = id
+
+	updatedPlan, err := h.benefitUsecase.UpdatePlan(c.Request().Context(), plan)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating benefit plan: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Benefit plan updated successfully", toBenefitPlanResponse(updatedPlan))
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateCandidate godoc
+// @Summary Update a candidate
+// @Description Changes the contact details and notes of a candidate
+// @Tags Recruiting
+// @Accept json
+// @Produce json
+// @Param id path int true "Candidate ID"
+// @Param payload body CandidateRequest true "Candidate payload"
+// @Success 200 {object} CandidateResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /candidates/{id} [put]
+func (h *RecruitingHandler) UpdateCandidate(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCandidateId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req CandidateRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":  "Name is required and must be at least 3 characters long",
+				"email": "Email is required",
+			})
+	}
+
+	candidate := toCandidateEntity(req)
+	candidate.ID = id
+
+	updatedCandidate, err := h.recruitingUsecase.UpdateCandidate(c.Request().Context(), candidate)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating candidate: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Candidate updated successfully", toCandidateResponse(updatedCandidate))
+}
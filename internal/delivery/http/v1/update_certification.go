@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateCertification godoc
+// @Summary Update a certification
+// @Description Changes a certification, e.g. after renewal. A new expiry date makes the holder due for another expiry notice.
+// @Tags Certifications
+// @Accept json
+// @Produce json
+// @Param id path int true "Certification ID"
+// @Param payload body CertificationRequest true "Certification payload"
+// @Success 200 {object} CertificationResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /certifications/{id} [put]
+func (h *CertificationHandler) UpdateCertification(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCertificationId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req CertificationRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":      "Name is required",
+				"issued_on": "Issue date is required, expected format: YYYY-MM-DD",
+			})
+	}
+
+	certification, details, err := toCertificationEntity(req)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+	certification.ID = id
+
+	updatedCertification, err := h.certificationUsecase.UpdateCertification(c.Request().Context(), certification)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating certification: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Certification updated successfully", toCertificationResponse(updatedCertification))
+}
@@ -0,0 +1,58 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateTemplate godoc
+// @Summary Update a checklist template
+// @Description Replaces a template's details and tasks. Checklists already started from it keep their tasks.
+// @Tags Checklists
+// @Accept json
+// @Produce json
+// @Param id path int true "Checklist template ID"
+// @Param payload body ChecklistTemplateRequest true "Checklist template payload"
+// @Success 200 {object} ChecklistTemplateResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /checklist-templates/{id} [put]
+func (h *ChecklistHandler) UpdateTemplate(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidChecklistTemplateId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req ChecklistTemplateRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":  "Name is required and must be at least 3 characters long",
+				"kind":  "Kind is required, onboarding or offboarding",
+				"tasks": "At least one task is required",
+			})
+	}
+
+	template := toChecklistTemplateEntity(req)
+	template.ID = id
+
+	updatedTemplate, err := h.checklistUsecase.UpdateTemplate(c.Request().Context(), template)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating checklist template: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Checklist template updated successfully", toChecklistTemplateResponse(updatedTemplate))
+}
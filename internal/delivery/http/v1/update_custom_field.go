@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateCustomField godoc
+// @Summary Update a custom employee field
+// @Description Replaces a custom field's label, required flag, options and validation; its key and type stay the same. Values saved before are checked against the new rules when the employee is next updated
+// @Tags CustomFields
+// @Accept json
+// @Produce json
+// @Param id path int true "Custom field ID"
+// @Param payload body CustomFieldRequest true "Custom field payload"
+// @Success 200 {object} CustomFieldResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /custom-fields/{id} [put]
+func (h *CustomFieldHandler) UpdateCustomField(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidCustomFieldDefinitionId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req CustomFieldRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"key":   "Key is required",
+				"label": "Label is required",
+				"type":  "Type is required",
+			})
+	}
+
+	definition := toCustomFieldEntity(req)
+	definition.ID = id
+
+	updatedDefinition, err := h.customFieldUsecase.UpdateDefinition(c.Request().Context(), definition)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating custom field: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Custom field updated successfully", toCustomFieldResponse(updatedDefinition))
+}
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateDepartment godoc
+// @Summary Update a department
+// @Description Rename a department
+// @Tags Departments
+// @Accept json
+// @Produce json
+// @Param id path int true "Department ID"
+// @Param payload body DepartmentRequest true "Department payload"
+// @Success 200 {object} DepartmentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /departments/{id} [put]
+func (h *DepartmentHandler) UpdateDepartment(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidDepartment,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req DepartmentRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name": "Name is required and must be at least 2 characters long",
+			})
+	}
+
+	department := toDepartmentEntity(req)
+	department.ID = id
+
+	updatedDepartment, err := h.departmentUsecase.UpdateDepartment(c.Request().Context(), department)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating department: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Department updated successfully", toDepartmentResponse(updatedDepartment))
+}
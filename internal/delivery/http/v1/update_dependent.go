@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateDependent godoc
+// @Summary Update a dependent
+// @Description Replaces a dependent's details
+// @Tags Benefits
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param dependentId path int true "Dependent ID"
+// @Param payload body DependentRequest true "Dependent payload"
+// @Success 200 {object} DependentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/dependents/{dependentId} [put]
+func (h *BenefitHandler) UpdateDependent(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	dependentID, err := parseIDParam(c, "dependentId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidDependentId,
+			map[string]string{
+				"dependentId": "Dependent ID must be a valid number",
+			})
+	}
+
+	var req DependentRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":         "Name is required",
+				"relationship": "Relationship is required",
+			})
+	}
+
+	dependent, details, err := toDependentEntity(req)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+	dependent.ID = dependentID
+	dependent.EmployeeID = employeeID
+
+	updatedDependent, err := h.benefitUsecase.UpdateDependent(c.Request().Context(), dependent)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating dependent: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Dependent updated successfully", toDependentResponse(updatedDependent))
+}
@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateEmergencyContact godoc
+// @Summary Update an emergency contact
+// @Description Replace the details of an emergency contact
+// @Tags EmergencyContacts
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param contactId path int true "Emergency contact ID"
+// @Param payload body EmergencyContactRequest true "Emergency contact payload"
+// @Success 200 {object} EmergencyContactResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/emergency-contacts/{contactId} [put]
+func (h *EmergencyContactHandler) UpdateEmergencyContact(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	contactID, err := parseIDParam(c, "contactId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmergencyContactId,
+			map[string]string{
+				"contactId": "Contact ID must be a valid number",
+			})
+	}
+
+	var req EmergencyContactRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":         "Name is required and must be at least 2 characters long",
+				"relationship": "Relationship is required",
+				"phone":        "Phone is required in E.164 format",
+			})
+	}
+
+	contact := &entity.EmergencyContact{
+		ID:           contactID,
+		EmployeeID:   employeeID,
+		Name:         req.Name,
+		Relationship: req.Relationship,
+		Phone:        req.Phone,
+		Email:        req.Email,
+		IsPrimary:    req.IsPrimary,
+	}
+
+	updatedContact, err := h.emergencyContactUsecase.UpdateEmergencyContact(c.Request().Context(), contact)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating emergency contact: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Emergency contact updated successfully", toEmergencyContactResponse(updatedContact))
+}
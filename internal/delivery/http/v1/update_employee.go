@@ -9,12 +9,13 @@ import (
 	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
 	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
 	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
 )
 
 // UpdateEmployee updates an existing employee.
 //
 // @Summary Update an employee
-// @Description Update employee details by ID
+// @Description Update employee details by ID. The salary and pay period must match the current ones, salary changes are requested through POST /employees/{id}/compensation. An empty pay period keeps the current one.
 // @Tags Employees
 // @Accept json
 // @Produce json
@@ -49,14 +50,14 @@ func (h *EmployeeHandler) UpdateEmployee(c echo.Context) error {
 		return apiresponse.Error(c,
 			appError.ErrMissingRequiredFields,
 			map[string]string{
-				"name":       "Name is required and must be at least 3 characters long",
-				"position":   "Position is required and must be at least 3 characters long",
-				"salary":     "Salary is required and must be a valid number",
-				"hired_date": "Hired date is required and must be a valid date",
+				"name":        "Name is required and must be at least 3 characters long",
+				"position_id": "Position ID is required and must reference an existing position",
+				"salary":      "Salary is required with an amount in minor units and a currency",
+				"hired_date":  "Hired date is required and must be a valid date",
 			})
 	}
 
-	hiredDate, err := time.Parse("2006-01-02", req.HiredDate)
+	hiredDate, err := time.Parse(constants.DateFormat, req.HiredDate)
 	if err != nil {
 		return apiresponse.Error(c,
 			appError.ErrInvalidHiredDate,
@@ -66,12 +67,32 @@ func (h *EmployeeHandler) UpdateEmployee(c echo.Context) error {
 		)
 	}
 
+	dateOfBirth, err := parseOptionalDate(req.DateOfBirth)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidDateOfBirth,
+			map[string]string{
+				"date_of_birth": "Date format is invalid , expected format: YYYY-MM-DD",
+			},
+		)
+	}
+
 	employee := &entity.Employee{
-		ID:        id,
-		Name:      req.Name,
-		Position:  req.Position,
-		Salary:    req.Salary,
-		HiredDate: hiredDate,
+		ID:            id,
+		Name:          req.Name,
+		PositionID:    req.PositionID,
+		ManagerID:     req.ManagerID,
+		LocationID:    req.LocationID,
+		DepartmentID:  req.DepartmentID,
+		Salary:        toMoneyEntity(req.Salary),
+		PayPeriod:     entity.PayPeriod(req.PayPeriod),
+		HiredDate:     hiredDate,
+		WorkEmail:     req.WorkEmail,
+		PersonalEmail: req.PersonalEmail,
+		Phone:         req.Phone,
+		Address:       toAddressEntity(req.Address),
+		DateOfBirth:   dateOfBirth,
+		CustomFields:  req.CustomFields,
 	}
 
 	updatedEmployee, err := h.employeeUsecase.UpdateEmployee(c.Request().Context(), employee)
@@ -83,12 +104,28 @@ func (h *EmployeeHandler) UpdateEmployee(c echo.Context) error {
 	}
 
 	updatedEmployeeResponse := UpdateEmployeeResponse{
-		ID:        updatedEmployee.ID,
-		Name:      updatedEmployee.Name,
-		Position:  updatedEmployee.Position,
-		Salary:    updatedEmployee.Salary,
-		HiredDate: updatedEmployee.HiredDate.Format("2006-01-02"),
-		UpdatedAt: updatedEmployee.UpdatedAt.Format("2006-01-02 15:04:05"),
+		ID:                updatedEmployee.ID,
+		Name:              updatedEmployee.Name,
+		PositionID:        updatedEmployee.PositionID,
+		Position:          updatedEmployee.Position,
+		Salary:            toMoneyDTO(updatedEmployee.Salary),
+		PayPeriod:         string(updatedEmployee.PayPeriod),
+		SalaryOutOfBand:   updatedEmployee.SalaryOutOfBand,
+		ManagerID:         updatedEmployee.ManagerID,
+		LocationID:        updatedEmployee.LocationID,
+		DepartmentID:      updatedEmployee.DepartmentID,
+		HiredDate:         updatedEmployee.HiredDate.Format(constants.DateFormat),
+		WorkEmail:         updatedEmployee.WorkEmail,
+		PersonalEmail:     updatedEmployee.PersonalEmail,
+		Phone:             updatedEmployee.Phone,
+		Address:           toAddressDTO(updatedEmployee.Address),
+		DateOfBirth:       formatOptionalDate(updatedEmployee.DateOfBirth),
+		Status:            string(updatedEmployee.Status),
+		TerminationDate:   formatOptionalDate(updatedEmployee.TerminationDate),
+		TerminationReason: updatedEmployee.TerminationReason,
+		CustomFields:      updatedEmployee.CustomFields,
+		Tags:              updatedEmployee.Tags,
+		UpdatedAt:         updatedEmployee.UpdatedAt.Format(constants.DateTimeFormat),
 	}
 
 	return apiresponse.Success(c, "Employee updated successfully", updatedEmployeeResponse)
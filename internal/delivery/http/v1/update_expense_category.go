@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateExpenseCategory godoc
+// @Summary Update an expense category
+// @Description Replaces an expense category's details. Claims already submitted are not checked against a new limit
+// @Tags Expenses
+// @Accept json
+// @Produce json
+// @Param id path int true "Expense category ID"
+// @Param payload body ExpenseCategoryRequest true "Expense category payload"
+// @Success 200 {object} ExpenseCategoryResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /expense-categories/{id} [put]
+func (h *ExpenseHandler) UpdateExpenseCategory(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidExpenseCategoryId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req ExpenseCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name": "Name is required and must be at least 2 characters long",
+			})
+	}
+
+	category := toExpenseCategoryEntity(req)
+	category.ID = id
+
+	updatedCategory, err := h.expenseUsecase.UpdateCategory(c.Request().Context(), category)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating expense category: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Expense category updated successfully", toExpenseCategoryResponse(updatedCategory))
+}
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateExpenseClaim godoc
+// @Summary Update an expense claim
+// @Description Replaces the details and items of a draft or rejected claim, which becomes a draft again
+// @Tags Expenses
+// @Accept json
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param expenseId path int true "Expense claim ID"
+// @Param payload body ExpenseClaimRequest true "Expense claim payload"
+// @Success 200 {object} ExpenseClaimResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/expenses/{expenseId} [put]
+func (h *ExpenseHandler) UpdateExpenseClaim(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	claimID, err := parseIDParam(c, "expenseId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidExpenseClaimId,
+			map[string]string{
+				"expenseId": "Expense claim ID must be a valid number",
+			})
+	}
+
+	var req ExpenseClaimRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"title":    "Title is required",
+				"currency": "Currency is required",
+				"items":    "At least one item is required",
+			})
+	}
+
+	claim, details, err := toExpenseClaimEntity(req)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+	claim.EmployeeID = employeeID
+	claim.ID = claimID
+
+	updatedClaim, err := h.expenseUsecase.UpdateClaim(c.Request().Context(), claim)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating expense claim: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Expense claim updated successfully", toExpenseClaimResponse(updatedClaim))
+}
@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateGoal godoc
+// @Summary Update a goal
+// @Description Changes a goal's details, alignment and status. The owner and key results are left as they are.
+// @Tags Goals
+// @Accept json
+// @Produce json
+// @Param id path int true "Goal ID"
+// @Param payload body GoalRequest true "Goal payload"
+// @Success 200 {object} GoalResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /goals/{id} [put]
+func (h *GoalHandler) UpdateGoal(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidGoalId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req GoalRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"title":      "Title is required and must be at least 3 characters long",
+				"start_date": "Start date is required, expected format: YYYY-MM-DD",
+				"due_date":   "Due date is required, expected format: YYYY-MM-DD",
+			})
+	}
+
+	goal, details, err := toGoalEntity(req)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+	goal.ID = id
+
+	updatedGoal, err := h.goalUsecase.UpdateGoal(c.Request().Context(), goal)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating goal: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Goal updated successfully", toGoalResponse(updatedGoal))
+}
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateHolidayCalendar godoc
+// @Summary Update a holiday calendar
+// @Description Rename a holiday calendar or change its country
+// @Tags Holidays
+// @Accept json
+// @Produce json
+// @Param id path int true "Holiday calendar ID"
+// @Param payload body HolidayCalendarRequest true "Holiday calendar payload"
+// @Success 200 {object} HolidayCalendarResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /holiday-calendars/{id} [put]
+func (h *HolidayCalendarHandler) UpdateHolidayCalendar(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidHolidayCalendarId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req HolidayCalendarRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name": "Name is required and must be at least 3 characters long",
+			})
+	}
+
+	calendar := toHolidayCalendarEntity(req)
+	calendar.ID = id
+
+	updatedCalendar, err := h.holidayCalendarUsecase.UpdateHolidayCalendar(c.Request().Context(), calendar)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating holiday calendar: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Holiday calendar updated successfully", toHolidayCalendarResponse(updatedCalendar))
+}
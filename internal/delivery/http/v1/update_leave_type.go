@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateLeaveType godoc
+// @Summary Update a leave type
+// @Description Replace the name and accrual policy of a leave type. Balances are recomputed the next time they are read.
+// @Tags Leave
+// @Accept json
+// @Produce json
+// @Param id path int true "Leave type ID"
+// @Param payload body LeaveTypeRequest true "Leave type payload"
+// @Success 200 {object} LeaveTypeResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /leave-types/{id} [put]
+func (h *LeaveHandler) UpdateLeaveType(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidLeaveTypeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req LeaveTypeRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":           "Name is required",
+				"accrual_policy": "Accrual policy must be one of none, annual, monthly",
+			})
+	}
+
+	leaveType := toLeaveTypeEntity(req)
+	leaveType.ID = id
+
+	updatedLeaveType, err := h.leaveUsecase.UpdateLeaveType(c.Request().Context(), leaveType)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating leave type: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Leave type updated successfully", toLeaveTypeResponse(updatedLeaveType))
+}
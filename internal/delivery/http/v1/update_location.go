@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateLocation godoc
+// @Summary Update a location
+// @Description Rename a location or change the holiday calendar it observes
+// @Tags Locations
+// @Accept json
+// @Produce json
+// @Param id path int true "Location ID"
+// @Param payload body LocationRequest true "Location payload"
+// @Success 200 {object} LocationResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /locations/{id} [put]
+func (h *LocationHandler) UpdateLocation(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidLocation,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req LocationRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name": "Name is required and must be at least 3 characters long",
+			})
+	}
+
+	location := toLocationEntity(req)
+	location.ID = id
+
+	updatedLocation, err := h.locationUsecase.UpdateLocation(c.Request().Context(), location)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating location: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Location updated successfully", toLocationResponse(updatedLocation))
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdatePayrollRule godoc
+// @Summary Update a payroll rule
+// @Description Changes a payroll rule for future calculations. Existing payslips keep their amounts.
+// @Tags Payroll
+// @Accept json
+// @Produce json
+// @Param id path int true "Payroll rule ID"
+// @Param payload body PayrollRuleRequest true "Payroll rule payload"
+// @Success 200 {object} PayrollRuleResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /payroll-rules/{id} [put]
+func (h *PayrollHandler) UpdatePayrollRule(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidPayrollRuleId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req PayrollRuleRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":   "Name is required and must be at least 3 characters long",
+				"kind":   "Kind is required, one of earning, deduction or tax",
+				"method": "Method is required, one of fixed or percentage",
+			})
+	}
+
+	rule := toPayrollRuleEntity(req)
+	rule.ID = id
+	updatedRule, err := h.payrollUsecase.UpdatePayrollRule(c.Request().Context(), rule)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating payroll rule: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Payroll rule updated successfully", toPayrollRuleResponse(updatedRule))
+}
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdatePosition godoc
+// @Summary Update a position
+// @Description Replace the title, level and salary band of a position. Existing salaries are not re-validated; see the out-of-band report.
+// @Tags Positions
+// @Accept json
+// @Produce json
+// @Param id path int true "Position ID"
+// @Param payload body PositionRequest true "Position payload"
+// @Success 200 {object} PositionResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /positions/{id} [put]
+func (h *PositionHandler) UpdatePosition(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidPosition,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req PositionRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"title": "Title is required and must be at least 3 characters long",
+			})
+	}
+
+	position := toPositionEntity(req)
+	position.ID = id
+
+	updatedPosition, err := h.positionUsecase.UpdatePosition(c.Request().Context(), position)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating position: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Position updated successfully", toPositionResponse(updatedPosition))
+}
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateRequisition godoc
+// @Summary Update a job requisition
+// @Description Changes a job requisition for future hires. Openings cannot drop below the candidates already hired.
+// @Tags Recruiting
+// @Accept json
+// @Produce json
+// @Param id path int true "Job requisition ID"
+// @Param payload body RequisitionRequest true "Job requisition payload"
+// @Success 200 {object} RequisitionResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /requisitions/{id} [put]
+func (h *RecruitingHandler) UpdateRequisition(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidRequisitionId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req RequisitionRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"title":       "Title is required and must be at least 3 characters long",
+				"position_id": "Position ID is required",
+			})
+	}
+
+	requisition := toRequisitionEntity(req)
+	requisition.ID = id
+
+	updatedRequisition, err := h.recruitingUsecase.UpdateRequisition(c.Request().Context(), requisition)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating job requisition: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Job requisition updated successfully", toRequisitionResponse(updatedRequisition))
+}
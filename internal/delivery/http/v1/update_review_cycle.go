@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateReviewCycle godoc
+// @Summary Update a review cycle
+// @Description Replaces a draft review cycle, including its questions. Launched cycles can no longer be changed.
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Param id path int true "Review cycle ID"
+// @Param payload body ReviewCycleRequest true "Review cycle payload"
+// @Success 200 {object} ReviewCycleResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /review-cycles/{id} [put]
+func (h *ReviewHandler) UpdateReviewCycle(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidReviewCycleId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req ReviewCycleRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":          "Name is required and must be at least 3 characters long",
+				"period_start":  "Period start is required, expected format: YYYY-MM-DD",
+				"period_end":    "Period end is required, expected format: YYYY-MM-DD",
+				"rating_labels": "Between 2 and 10 rating labels are required",
+				"questions":     "At least one question is required",
+			})
+	}
+
+	cycle, details, err := toReviewCycleEntity(req)
+	if err != nil {
+		return apiresponse.Error(c, err, details)
+	}
+	cycle.ID = id
+
+	updatedCycle, err := h.reviewUsecase.UpdateReviewCycle(c.Request().Context(), cycle)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating review cycle: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Review cycle updated successfully", toReviewCycleResponse(updatedCycle))
+}
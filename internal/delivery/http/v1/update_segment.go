@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateSegment godoc
+// @Summary Update an employee segment
+// @Description Replaces a segment's name, description and filter
+// @Tags Segments
+// @Accept json
+// @Produce json
+// @Param id path int true "Segment ID"
+// @Param payload body SegmentRequest true "Segment payload"
+// @Success 200 {object} SegmentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /segments/{id} [put]
+func (h *SegmentHandler) UpdateSegment(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidSegmentId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req SegmentRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":   "Name is required",
+				"filter": "Filter must be an object",
+			})
+	}
+
+	segment := toSegmentEntity(req)
+	segment.ID = id
+
+	updatedSegment, err := h.segmentUsecase.UpdateSegment(c.Request().Context(), segment)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating segment: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Segment updated successfully", toSegmentResponse(updatedSegment))
+}
@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateShiftTemplate godoc
+// @Summary Update a shift template
+// @Description Changes a shift template. Shifts already rostered keep their times.
+// @Tags Shifts
+// @Accept json
+// @Produce json
+// @Param id path int true "Shift template ID"
+// @Param payload body ShiftTemplateRequest true "Shift template payload"
+// @Success 200 {object} ShiftTemplateResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /shift-templates/{id} [put]
+func (h *ShiftHandler) UpdateShiftTemplate(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidShiftTemplateId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req ShiftTemplateRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name":       "Name is required and must be at least 3 characters long",
+				"start_time": "Start time is required, expected format: HH:MM",
+				"end_time":   "End time is required, expected format: HH:MM",
+			})
+	}
+
+	startTime, err := parseTimeOfDay(req.StartTime)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidShiftTemplate,
+			map[string]string{
+				"start_time": "Time format is invalid , expected format: HH:MM",
+			})
+	}
+	endTime, err := parseTimeOfDay(req.EndTime)
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidShiftTemplate,
+			map[string]string{
+				"end_time": "Time format is invalid , expected format: HH:MM",
+			})
+	}
+
+	updatedTemplate, err := h.shiftUsecase.UpdateShiftTemplate(c.Request().Context(), &entity.ShiftTemplate{
+		ID:           id,
+		Name:         req.Name,
+		StartTime:    startTime,
+		EndTime:      endTime,
+		BreakMinutes: req.BreakMinutes,
+	})
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating shift template: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Shift template updated successfully", toShiftTemplateResponse(updatedTemplate))
+}
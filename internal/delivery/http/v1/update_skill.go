@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateSkill godoc
+// @Summary Update a skill
+// @Description Rename a skill, change its description or move it to another category
+// @Tags Skills
+// @Accept json
+// @Produce json
+// @Param id path int true "Skill ID"
+// @Param payload body SkillRequest true "Skill payload"
+// @Success 200 {object} SkillResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /skills/{id} [put]
+func (h *SkillHandler) UpdateSkill(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidSkillId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req SkillRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"category_id": "Category ID is required",
+				"name":        "Name is required",
+			})
+	}
+
+	skill := toSkillEntity(req)
+	skill.ID = id
+
+	updatedSkill, err := h.skillUsecase.UpdateSkill(c.Request().Context(), skill)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating skill: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Skill updated successfully", toSkillResponse(updatedSkill))
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateSkillCategory godoc
+// @Summary Update a skill category
+// @Description Rename a skill category
+// @Tags Skills
+// @Accept json
+// @Produce json
+// @Param id path int true "Skill category ID"
+// @Param payload body SkillCategoryRequest true "Skill category payload"
+// @Success 200 {object} SkillCategoryResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /skill-categories/{id} [put]
+func (h *SkillHandler) UpdateSkillCategory(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidSkillCategory,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req SkillCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name": "Name is required and must be at least 2 characters long",
+			})
+	}
+
+	category, err := h.skillUsecase.UpdateSkillCategory(c.Request().Context(), &entity.SkillCategory{
+		ID:   id,
+		Name: req.Name,
+	})
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating skill category: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Skill category updated successfully", toSkillCategoryResponse(category))
+}
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateCourse godoc
+// @Summary Update a training course
+// @Description Changes a training course. Certifications already issued keep their expiry date.
+// @Tags Training
+// @Accept json
+// @Produce json
+// @Param id path int true "Training course ID"
+// @Param payload body TrainingCourseRequest true "Training course payload"
+// @Success 200 {object} TrainingCourseResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /training-courses/{id} [put]
+func (h *TrainingHandler) UpdateCourse(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidTrainingCourseId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	var req TrainingCourseRequest
+	if err := c.Bind(&req); err != nil {
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"name": "Name is required and must be at least 2 characters long",
+			})
+	}
+
+	course := toTrainingCourseEntity(req)
+	course.ID = id
+
+	updatedCourse, err := h.trainingUsecase.UpdateCourse(c.Request().Context(), course)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error updating training course: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Training course updated successfully", toTrainingCourseResponse(updatedCourse))
+}
@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"log"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UploadDocument godoc
+// @Summary Upload an employee document
+// @Description Stores a PDF, image, plain text or Word file as the "file" field of a multipart form, with its category. The type is detected from the contents and the size is limited by configuration (10 MB by default).
+// @Tags Documents
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param file formData file true "Document file"
+// @Param category formData string true "contract, identity, offer_letter or other"
+// @Success 200 {object} DocumentResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 413 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/documents [post]
+func (h *DocumentHandler) UploadDocument(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		if isBodyTooLarge(err) {
+			return apiresponse.Error(c, appError.ErrDocumentTooLarge, nil)
+		}
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"file": "A document file is required",
+			})
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		log.Printf("Error opening uploaded document: %v", err)
+		return apiresponse.Error(c, err, nil)
+	}
+	defer file.Close()
+
+	document := &entity.EmployeeDocument{
+		EmployeeID: id,
+		Category:   entity.DocumentCategory(strings.TrimSpace(c.FormValue("category"))),
+		FileName:   fileHeader.Filename,
+	}
+	createdDocument, err := h.documentUsecase.UploadDocument(c.Request().Context(), document, file)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error uploading document: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Document uploaded successfully", toDocumentResponse(createdDocument))
+}
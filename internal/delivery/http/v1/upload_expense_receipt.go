@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UploadExpenseReceipt godoc
+// @Summary Upload a receipt
+// @Description Attaches a PDF or image as the "file" field of a multipart form to a draft or rejected claim. The type is detected from the contents and the size is limited by configuration (10 MB by default).
+// @Tags Expenses
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param expenseId path int true "Expense claim ID"
+// @Param file formData file true "Receipt file"
+// @Success 200 {object} ExpenseReceiptResponseWrapper
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 413 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/expenses/{expenseId}/receipts [post]
+func (h *ExpenseHandler) UploadExpenseReceipt(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+	claimID, err := parseIDParam(c, "expenseId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidExpenseClaimId,
+			map[string]string{
+				"expenseId": "Expense claim ID must be a valid number",
+			})
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		if isBodyTooLarge(err) {
+			return apiresponse.Error(c, appError.ErrExpenseReceiptTooLarge, nil)
+		}
+		return apiresponse.Error(c,
+			appError.ErrMissingRequiredFields,
+			map[string]string{
+				"file": "A receipt file is required",
+			})
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		log.Printf("Error opening uploaded receipt: %v", err)
+		return apiresponse.Error(c, err, nil)
+	}
+	defer file.Close()
+
+	receipt := &entity.ExpenseReceipt{
+		ClaimID:  claimID,
+		FileName: fileHeader.Filename,
+	}
+	createdReceipt, err := h.expenseUsecase.UploadReceipt(c.Request().Context(), employeeID, receipt, file)
+	if err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error uploading receipt: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.Success(c, "Receipt uploaded successfully", toExpenseReceiptResponse(createdReceipt))
+}
@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"log"
+
+	"github.com/labstack/echo/v4"
+	apiresponse "github.com/mohamedfawas/employee_management_system/pkg/apiresponse"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// WithdrawBenefitEnrollment godoc
+// @Summary Withdraw a benefit enrollment
+// @Description Removes an enrollment whose coverage has not started yet; started coverage is ended instead
+// @Tags Benefits
+// @Produce json
+// @Param id path int true "Employee ID"
+// @Param enrollmentId path int true "Enrollment ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apiresponse.StandardResponse
+// @Failure 404 {object} apiresponse.StandardResponse
+// @Failure 409 {object} apiresponse.StandardResponse
+// @Failure 500 {object} apiresponse.StandardResponse
+// @Router /employees/{id}/benefits/{enrollmentId} [delete]
+func (h *BenefitHandler) WithdrawBenefitEnrollment(c echo.Context) error {
+	employeeID, err := parseIDParam(c, "id")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidEmployeeId,
+			map[string]string{
+				"id": "ID must be a valid number",
+			})
+	}
+
+	enrollmentID, err := parseIDParam(c, "enrollmentId")
+	if err != nil {
+		return apiresponse.Error(c,
+			appError.ErrInvalidBenefitEnrollmentId,
+			map[string]string{
+				"enrollmentId": "Enrollment ID must be a valid number",
+			})
+	}
+
+	if err := h.benefitUsecase.WithdrawEnrollment(c.Request().Context(), employeeID, enrollmentID); err != nil {
+		if appError.ShouldLogError(err) {
+			log.Printf("Error withdrawing benefit enrollment: %v", err)
+		}
+		return apiresponse.Error(c, err, nil)
+	}
+
+	return apiresponse.DeletedResource(c, "Benefit enrollment withdrawn successfully")
+}
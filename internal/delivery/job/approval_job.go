@@ -0,0 +1,27 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+// NewApprovalEscalationJob hands approval tasks left undecided past their timeout on.
+func NewApprovalEscalationJob(approvalUsecase usecase.ApprovalUsecase, interval time.Duration) Job {
+	return Job{
+		Name:     "escalate-approval-tasks",
+		Interval: interval,
+		Run: func(ctx context.Context) error {
+			escalated, err := approvalUsecase.EscalateOverdueTasks(ctx, time.Now())
+			if err != nil {
+				return err
+			}
+			if escalated > 0 {
+				log.Printf("[JOB] escalated %d overdue approval task(s)", escalated)
+			}
+			return nil
+		},
+	}
+}
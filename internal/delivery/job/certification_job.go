@@ -0,0 +1,35 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+// NewCertificationExpiryJob reports certifications about to expire and notifies their holders.
+func NewCertificationExpiryJob(certificationUsecase usecase.CertificationUsecase, interval time.Duration) Job {
+	return Job{
+		Name:     "notify-expiring-certifications",
+		Interval: interval,
+		Run: func(ctx context.Context) error {
+			expiring, err := certificationUsecase.GetExpiringCertifications(ctx, time.Now(), 0)
+			if err != nil {
+				return err
+			}
+			if len(expiring) > 0 {
+				log.Printf("[JOB] %d certification(s) expire within the notice window", len(expiring))
+			}
+
+			notified, err := certificationUsecase.NotifyExpiringCertifications(ctx, time.Now())
+			if err != nil {
+				return err
+			}
+			if notified > 0 {
+				log.Printf("[JOB] sent %d certification expiry notice(s)", notified)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,27 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+// NewApplyCompensationChangesJob applies scheduled raises once their effective date is reached.
+func NewApplyCompensationChangesJob(compensationUsecase usecase.CompensationUsecase, interval time.Duration) Job {
+	return Job{
+		Name:     "apply-compensation-changes",
+		Interval: interval,
+		Run: func(ctx context.Context) error {
+			applied, err := compensationUsecase.ApplyDueCompensationChanges(ctx, time.Now())
+			if err != nil {
+				return err
+			}
+			if applied > 0 {
+				log.Printf("[JOB] applied %d scheduled compensation change(s)", applied)
+			}
+			return nil
+		},
+	}
+}
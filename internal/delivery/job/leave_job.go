@@ -0,0 +1,27 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+// NewLeaveStatusJob puts employees on leave when approved leave starts and back to active when it ends.
+func NewLeaveStatusJob(leaveUsecase usecase.LeaveUsecase, interval time.Duration) Job {
+	return Job{
+		Name:     "apply-leave-status-changes",
+		Interval: interval,
+		Run: func(ctx context.Context) error {
+			changed, err := leaveUsecase.ApplyLeaveStatusChanges(ctx, time.Now())
+			if err != nil {
+				return err
+			}
+			if changed > 0 {
+				log.Printf("[JOB] applied %d leave status change(s)", changed)
+			}
+			return nil
+		},
+	}
+}
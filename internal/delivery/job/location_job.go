@@ -0,0 +1,28 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+// NewApplyLocationAssignmentsJob moves employees to their new primary location once the assignment
+// is effective at that location.
+func NewApplyLocationAssignmentsJob(locationUsecase usecase.LocationUsecase, interval time.Duration) Job {
+	return Job{
+		Name:     "apply-location-assignments",
+		Interval: interval,
+		Run: func(ctx context.Context) error {
+			applied, err := locationUsecase.ApplyDueLocationAssignments(ctx, time.Now())
+			if err != nil {
+				return err
+			}
+			if applied > 0 {
+				log.Printf("[JOB] applied %d scheduled location assignment(s)", applied)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/usecase"
+)
+
+// NewActivateStartingHiresJob makes hires active once their start date is reached at their
+// location.
+func NewActivateStartingHiresJob(recruitingUsecase usecase.RecruitingUsecase, interval time.Duration) Job {
+	return Job{
+		Name:     "activate-starting-hires",
+		Interval: interval,
+		Run: func(ctx context.Context) error {
+			activated, err := recruitingUsecase.ActivateStartingHires(ctx, time.Now())
+			if err != nil {
+				return err
+			}
+			if activated > 0 {
+				log.Printf("[JOB] activated %d new hire(s)", activated)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package job
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// Job is a unit of background work run on a fixed interval.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler runs registered jobs in their own goroutines until it is stopped.
+type Scheduler struct {
+	jobs   []Job
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+func (s *Scheduler) Register(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Start launches every registered job. Each job runs once immediately and then on its interval.
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	for _, job := range s.jobs {
+		if job.Interval <= 0 {
+			log.Printf("[JOB] %s disabled (interval %s)", job.Name, job.Interval)
+			continue
+		}
+
+		s.wg.Add(1)
+		go func(job Job) {
+			defer s.wg.Done()
+			s.loop(ctx, job)
+		}(job)
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := job.Run(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("[JOB] %s failed: %v", job.Name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop cancels running jobs and waits for them to return.
+func (s *Scheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+}
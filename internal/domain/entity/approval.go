@@ -0,0 +1,205 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ApprovalType names the kind of change an approval request is about. Each type has at most one
+// workflow, and the usecase owning the change applies it once the request is approved.
+type ApprovalType string
+
+const (
+	ApprovalTypeSalaryChange ApprovalType = "salary_change"
+	ApprovalTypeTermination  ApprovalType = "termination"
+	ApprovalTypeExpense      ApprovalType = "expense"
+	ApprovalTypeLeave        ApprovalType = "leave"
+)
+
+func (t ApprovalType) IsValid() bool {
+	switch t {
+	case ApprovalTypeSalaryChange, ApprovalTypeTermination, ApprovalTypeExpense, ApprovalTypeLeave:
+		return true
+	}
+	return false
+}
+
+// ApproverType decides who decides a workflow step.
+type ApproverType string
+
+const (
+	// ApproverManager steps go to the manager of the employee the request is about, and are
+	// skipped for employees without one.
+	ApproverManager ApproverType = "manager"
+	// ApproverPosition steps may be decided by any current holder of the step's position, e.g.
+	// the HR director.
+	ApproverPosition ApproverType = "position"
+	// ApproverEmployee steps go to the step's employee.
+	ApproverEmployee ApproverType = "employee"
+)
+
+func (t ApproverType) IsValid() bool {
+	switch t {
+	case ApproverManager, ApproverPosition, ApproverEmployee:
+		return true
+	}
+	return false
+}
+
+type ApprovalRequestStatus string
+
+const (
+	ApprovalPending   ApprovalRequestStatus = "pending"
+	ApprovalApproved  ApprovalRequestStatus = "approved"
+	ApprovalRejected  ApprovalRequestStatus = "rejected"
+	ApprovalCancelled ApprovalRequestStatus = "cancelled"
+	// ApprovalFailed requests were approved, but the change could not be made afterwards.
+	ApprovalFailed ApprovalRequestStatus = "failed"
+)
+
+func (s ApprovalRequestStatus) IsValid() bool {
+	switch s {
+	case ApprovalPending, ApprovalApproved, ApprovalRejected, ApprovalCancelled, ApprovalFailed:
+		return true
+	}
+	return false
+}
+
+type ApprovalTaskStatus string
+
+const (
+	// ApprovalTaskWaiting tasks belong to a stage that has not started yet.
+	ApprovalTaskWaiting  ApprovalTaskStatus = "waiting"
+	ApprovalTaskPending  ApprovalTaskStatus = "pending"
+	ApprovalTaskApproved ApprovalTaskStatus = "approved"
+	ApprovalTaskRejected ApprovalTaskStatus = "rejected"
+	// ApprovalTaskSkipped tasks had no one to go to, e.g. a manager step for an employee without
+	// a manager.
+	ApprovalTaskSkipped ApprovalTaskStatus = "skipped"
+	// ApprovalTaskCancelled tasks were left open when their request was rejected or cancelled.
+	ApprovalTaskCancelled ApprovalTaskStatus = "cancelled"
+)
+
+// ApprovalWorkflow is the approval chain of a request type. Steps run stage by stage, lowest
+// first: a stage is done once each of its steps is approved, so steps sharing a stage run in
+// parallel and steps of different stages in sequence. One rejection rejects the request.
+type ApprovalWorkflow struct {
+	ID          int
+	Name        string
+	RequestType ApprovalType
+	Steps       []ApprovalStep
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type ApprovalStep struct {
+	ID           int
+	WorkflowID   int
+	Stage        int
+	ApproverType ApproverType
+	ApproverID   *int // the position or employee, nil for ApproverManager
+	// TimeoutHours is how long the step may stay undecided before it is escalated, 0 for never.
+	TimeoutHours int
+	// EscalateToID receives the step on timeout; without it the step goes to the manager of whoever
+	// it was assigned to.
+	EscalateToID *int
+}
+
+// ApprovalRequest asks for a change to be approved. Payload holds the change as the owning
+// usecase encoded it, so it can be made unchanged once the request is approved.
+type ApprovalRequest struct {
+	ID            int
+	Type          ApprovalType
+	Reference     string // what the change is to, e.g. employee:12; one pending request per reference
+	EmployeeID    int    // the employee the change is about, who may not approve it
+	EmployeeName  string // read only
+	RequestedBy   *int
+	Summary       string
+	Payload       json.RawMessage
+	Status        ApprovalRequestStatus
+	CurrentStage  int // the stage waiting for decisions, 0 once the request is decided
+	FailureReason string
+	Tasks         []ApprovalTask
+	CreatedAt     time.Time
+	DecidedAt     *time.Time
+}
+
+// LastDecidedBy returns who made the latest decision on the request, or nil when no task has
+// been decided by anyone yet.
+func (r *ApprovalRequest) LastDecidedBy() *int {
+	var last *ApprovalTask
+	for i := range r.Tasks {
+		task := &r.Tasks[i]
+		if task.DecidedBy == nil || task.DecidedAt == nil {
+			continue
+		}
+		if last == nil || !task.DecidedAt.Before(*last.DecidedAt) {
+			last = task
+		}
+	}
+	if last == nil {
+		return nil
+	}
+	return last.DecidedBy
+}
+
+// ApprovalTask is a workflow step copied onto a request.
+type ApprovalTask struct {
+	ID           int
+	RequestID    int
+	RequestType  ApprovalType // read only
+	EmployeeID   int          // read only, whom the request is about
+	EmployeeName string       // read only
+	Summary      string       // read only, the request's summary
+	Stage        int
+	ApproverType ApproverType
+	AssigneeID   *int   // nil for position tasks until they are escalated
+	AssigneeName string // read only
+	PositionID   *int   // the position whose holders may decide a position task
+	Position     string // read only, title of the position
+	Status       ApprovalTaskStatus
+	TimeoutHours int
+	EscalateToID *int
+	DueAt        *time.Time // set while pending with a timeout
+	EscalatedAt  *time.Time
+	DecidedBy    *int // the approver, or their delegate
+	Comment      string
+	DecidedAt    *time.Time
+}
+
+// IsOverdue reports whether the task is still undecided at asOf past its timeout.
+func (t *ApprovalTask) IsOverdue(asOf time.Time) bool {
+	return t.Status == ApprovalTaskPending && t.DueAt != nil && !asOf.Before(*t.DueAt)
+}
+
+// ApprovalDelegation lets DelegateID decide everything DelegatorID may decide from StartDate up to
+// and including EndDate, e.g. while the delegator is on leave.
+type ApprovalDelegation struct {
+	ID            int
+	DelegatorID   int
+	DelegatorName string // read only
+	DelegateID    int
+	DelegateName  string // read only
+	StartDate     time.Time
+	EndDate       time.Time
+	CreatedAt     time.Time
+}
+
+// IsActive reports whether the delegation is in effect on the day of asOf.
+func (d *ApprovalDelegation) IsActive(asOf time.Time) bool {
+	return !asOf.Before(d.StartDate) && asOf.Before(d.EndDate.AddDate(0, 0, 1))
+}
+
+type ApprovalRequestFilter struct {
+	EmployeeID int
+	Type       ApprovalType
+	Status     ApprovalRequestStatus
+}
+
+// ApprovalDecision is an approver's answer to a task.
+type ApprovalDecision struct {
+	TaskID     int
+	ApproverID int
+	Approve    bool
+	Comment    string
+}
@@ -0,0 +1,93 @@
+package entity
+
+import "time"
+
+type AssetType string
+
+const (
+	AssetTypeLaptop  AssetType = "laptop"
+	AssetTypeDesktop AssetType = "desktop"
+	AssetTypeMonitor AssetType = "monitor"
+	AssetTypePhone   AssetType = "phone"
+	AssetTypeTablet  AssetType = "tablet"
+	AssetTypeBadge   AssetType = "badge"
+	AssetTypeKey     AssetType = "key"
+	AssetTypeOther   AssetType = "other"
+)
+
+func (t AssetType) IsValid() bool {
+	switch t {
+	case AssetTypeLaptop, AssetTypeDesktop, AssetTypeMonitor, AssetTypePhone, AssetTypeTablet,
+		AssetTypeBadge, AssetTypeKey, AssetTypeOther:
+		return true
+	}
+	return false
+}
+
+type AssetStatus string
+
+const (
+	AssetStatusAvailable AssetStatus = "available"
+	// AssetStatusAssigned is never stored; an asset is assigned while it has an open assignment.
+	AssetStatusAssigned AssetStatus = "assigned"
+	AssetStatusInRepair AssetStatus = "in_repair"
+	AssetStatusLost     AssetStatus = "lost"
+	AssetStatusRetired  AssetStatus = "retired"
+)
+
+func (s AssetStatus) IsValid() bool {
+	switch s {
+	case AssetStatusAvailable, AssetStatusAssigned, AssetStatusInRepair, AssetStatusLost, AssetStatusRetired:
+		return true
+	}
+	return false
+}
+
+// Asset is a piece of company equipment, such as a laptop or an access badge.
+type Asset struct {
+	ID           int
+	Name         string
+	Type         AssetType
+	SerialNumber string
+	PurchaseDate *time.Time
+	Status       AssetStatus
+	Notes        string
+	HolderID     *int   // read only, the employee holding the asset
+	HolderName   string // read only
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// AssetAssignment is an employee holding an asset, from AssignedOn until it is returned.
+type AssetAssignment struct {
+	ID           int
+	AssetID      int
+	AssetName    string    // read only
+	AssetType    AssetType // read only
+	SerialNumber string    // read only
+	EmployeeID   int
+	EmployeeName string // read only
+	AssignedOn   time.Time
+	ReturnedOn   *time.Time
+	Notes        string
+	ReturnNotes  string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (a *AssetAssignment) IsOpen() bool {
+	return a.ReturnedOn == nil
+}
+
+// AssetFilter narrows asset lists; zero fields match everything.
+type AssetFilter struct {
+	Type   AssetType
+	Status AssetStatus
+}
+
+// AssetAssignmentFilter narrows assignment lists; zero fields match everything.
+type AssetAssignmentFilter struct {
+	AssetID    int
+	EmployeeID int
+	OpenOnly   bool
+}
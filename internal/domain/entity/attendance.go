@@ -0,0 +1,68 @@
+package entity
+
+import "time"
+
+// AttendanceEntry is one shift between a clock-in and a clock-out. ClockOut is nil while the
+// employee is still clocked in.
+type AttendanceEntry struct {
+	ID         int
+	EmployeeID int
+	ClockIn    time.Time
+	ClockOut   *time.Time
+	Note       string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (e AttendanceEntry) IsOpen() bool {
+	return e.ClockOut == nil
+}
+
+// WorkedMinutes is the length of a closed shift in whole minutes, 0 while it is open.
+func (e AttendanceEntry) WorkedMinutes() int {
+	if e.ClockOut == nil {
+		return 0
+	}
+	return int(e.ClockOut.Sub(e.ClockIn) / time.Minute)
+}
+
+type TimesheetStatus string
+
+const (
+	TimesheetStatusSubmitted TimesheetStatus = "submitted"
+	TimesheetStatusApproved  TimesheetStatus = "approved"
+	TimesheetStatusRejected  TimesheetStatus = "rejected"
+)
+
+// Timesheet is a week of attendance submitted for approval. WeekStart is a Monday.
+type Timesheet struct {
+	ID              int
+	EmployeeID      int
+	WeekStart       time.Time
+	Status          TimesheetStatus
+	TotalMinutes    int
+	RegularMinutes  int
+	OvertimeMinutes int
+	SubmittedAt     time.Time
+	DecidedBy       *int
+	DecidedAt       *time.Time
+	DecisionComment string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// IsLocked reports whether the week's punches can no longer change.
+func (t Timesheet) IsLocked() bool {
+	return t.Status == TimesheetStatusSubmitted || t.Status == TimesheetStatusApproved
+}
+
+// OvertimeRules decide which worked minutes count as overtime. A zero limit disables that rule.
+type OvertimeRules struct {
+	DailyLimit  time.Duration // worked per day beyond this is overtime
+	WeeklyLimit time.Duration // regular time per week beyond this is overtime
+	RestDays    bool          // all time worked on weekends and public holidays is overtime
+}
+
+func (r OvertimeRules) IsValid() bool {
+	return r.DailyLimit >= 0 && r.WeeklyLimit >= 0
+}
@@ -0,0 +1,157 @@
+package entity
+
+import "time"
+
+type BenefitPlanType string
+
+const (
+	BenefitPlanHealth     BenefitPlanType = "health"
+	BenefitPlanDental     BenefitPlanType = "dental"
+	BenefitPlanVision     BenefitPlanType = "vision"
+	BenefitPlanLife       BenefitPlanType = "life"
+	BenefitPlanDisability BenefitPlanType = "disability"
+	BenefitPlanPension    BenefitPlanType = "pension"
+	BenefitPlanOther      BenefitPlanType = "other"
+)
+
+func (t BenefitPlanType) IsValid() bool {
+	switch t {
+	case BenefitPlanHealth, BenefitPlanDental, BenefitPlanVision, BenefitPlanLife, BenefitPlanDisability,
+		BenefitPlanPension, BenefitPlanOther:
+		return true
+	}
+	return false
+}
+
+// BenefitPlan is a benefit employees can enroll in, such as health insurance or a pension. All
+// contributions are monthly amounts in the plan's currency.
+type BenefitPlan struct {
+	ID          int
+	Name        string
+	Type        BenefitPlanType
+	Description string
+	Currency    string
+	// EmployeeContribution is deducted from the employee's pay, plus DependentContribution for every
+	// covered dependent. EmployerContribution is paid by the company on top.
+	EmployeeContribution  int64
+	EmployerContribution  int64
+	CoversDependents      bool
+	DependentContribution int64
+	Eligibility           BenefitEligibility
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+// BenefitEligibility decides which employees may enroll in a plan.
+type BenefitEligibility struct {
+	Statuses        []EmploymentStatus
+	MinTenureMonths int
+	LocationIDs     []int // empty for every location
+}
+
+// IsEligible reports whether employee may be covered by the plan from date on.
+func (e BenefitEligibility) IsEligible(employee *Employee, date time.Time) bool {
+	statusEligible := false
+	for _, status := range e.Statuses {
+		if status == employee.Status {
+			statusEligible = true
+			break
+		}
+	}
+	if !statusEligible {
+		return false
+	}
+	if employee.HiredDate.AddDate(0, e.MinTenureMonths, 0).After(date) {
+		return false
+	}
+	if len(e.LocationIDs) == 0 {
+		return true
+	}
+	if employee.LocationID == nil {
+		return false
+	}
+	for _, locationID := range e.LocationIDs {
+		if locationID == *employee.LocationID {
+			return true
+		}
+	}
+	return false
+}
+
+// BenefitEnrollmentWindow is an open enrollment period. Coverage chosen during it starts on
+// CoverageStartsOn.
+type BenefitEnrollmentWindow struct {
+	ID               int
+	Name             string
+	StartsOn         time.Time
+	EndsOn           time.Time
+	CoverageStartsOn time.Time
+	CreatedAt        time.Time
+}
+
+// IsOpen reports whether employees may enroll on date.
+func (w *BenefitEnrollmentWindow) IsOpen(date time.Time) bool {
+	return !date.Before(w.StartsOn) && !date.After(w.EndsOn)
+}
+
+type DependentRelationship string
+
+const (
+	DependentSpouse  DependentRelationship = "spouse"
+	DependentPartner DependentRelationship = "partner"
+	DependentChild   DependentRelationship = "child"
+	DependentOther   DependentRelationship = "other"
+)
+
+func (r DependentRelationship) IsValid() bool {
+	switch r {
+	case DependentSpouse, DependentPartner, DependentChild, DependentOther:
+		return true
+	}
+	return false
+}
+
+// Dependent is a family member an employee can cover through their benefits.
+type Dependent struct {
+	ID           int
+	EmployeeID   int
+	Name         string
+	Relationship DependentRelationship
+	DateOfBirth  *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// BenefitEnrollment is an employee's coverage by a plan. Coverage runs until the end of
+// CoverageEndsOn's month, or for as long as the employee is employed while it is nil.
+type BenefitEnrollment struct {
+	ID               int
+	EmployeeID       int
+	EmployeeName     string // read only
+	PlanID           int
+	Plan             *BenefitPlan // read only
+	WindowID         *int         // nil for new hires enrolling outside a window
+	CoverageStartsOn time.Time
+	CoverageEndsOn   *time.Time
+	DependentIDs     []int
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// IsActive reports whether the enrollment covers the employee on date or will later on.
+func (e *BenefitEnrollment) IsActive(date time.Time) bool {
+	return e.CoverageEndsOn == nil || !e.CoverageEndsOn.Before(date)
+}
+
+// BenefitDeduction is what payroll deducts from an employee's pay in a month for one enrollment.
+type BenefitDeduction struct {
+	EnrollmentID         int
+	EmployeeID           int
+	EmployeeName         string
+	PlanID               int
+	PlanName             string
+	PlanType             BenefitPlanType
+	DependentCount       int
+	EmployeeContribution Money // deducted from the employee's pay
+	EmployerContribution Money
+}
@@ -0,0 +1,49 @@
+package entity
+
+import "time"
+
+// Certification is a credential an employee holds, issued by an outside body or by completing a
+// training course.
+type Certification struct {
+	ID               int
+	EmployeeID       int
+	EmployeeName     string // read only
+	Name             string
+	Issuer           string
+	CredentialID     string
+	IssuedOn         time.Time
+	ExpiresOn        *time.Time // nil when the certification never expires
+	EnrollmentID     *int       // the course enrollment that issued it, if any
+	ExpiryNotifiedOn *time.Time // read only, when the expiry notice went out
+	EvidenceCount    int        // read only
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// IsExpired reports whether the certification is no longer valid on asOf.
+func (c *Certification) IsExpired(asOf time.Time) bool {
+	return c.ExpiresOn != nil && !c.ExpiresOn.After(asOf)
+}
+
+// CertificationFilter narrows certification lists; zero fields match everything.
+type CertificationFilter struct {
+	EmployeeID int
+	// ExpiresFrom and ExpiresTo keep certifications expiring between the two dates, inclusive.
+	// Either one leaves out certifications that never expire.
+	ExpiresFrom *time.Time
+	ExpiresTo   *time.Time
+	// NotNotified leaves out certifications whose expiry notice already went out.
+	NotNotified bool
+	Statuses    []EmploymentStatus // of the holder
+}
+
+// CertificationEvidence is a file proving a certification, such as a scan of the certificate.
+type CertificationEvidence struct {
+	ID              int
+	CertificationID int
+	FileName        string
+	ContentType     string
+	Size            int64
+	Content         []byte // only filled when the file itself is requested
+	UploadedAt      time.Time
+}
@@ -0,0 +1,136 @@
+package entity
+
+import (
+	"math"
+	"time"
+)
+
+type ChecklistKind string
+
+const (
+	ChecklistKindOnboarding  ChecklistKind = "onboarding"
+	ChecklistKindOffboarding ChecklistKind = "offboarding"
+)
+
+func (k ChecklistKind) IsValid() bool {
+	switch k {
+	case ChecklistKindOnboarding, ChecklistKindOffboarding:
+		return true
+	}
+	return false
+}
+
+// ChecklistOwnerRole decides who a template task is assigned to when a checklist is started.
+type ChecklistOwnerRole string
+
+const (
+	// ChecklistOwnerEmployee tasks go to the employee joining or leaving.
+	ChecklistOwnerEmployee ChecklistOwnerRole = "employee"
+	// ChecklistOwnerManager tasks go to the employee's manager, and stay unassigned without one.
+	ChecklistOwnerManager ChecklistOwnerRole = "manager"
+	// ChecklistOwnerDesignated tasks go to the template task's OwnerID, e.g. someone in IT.
+	ChecklistOwnerDesignated ChecklistOwnerRole = "designated"
+)
+
+func (r ChecklistOwnerRole) IsValid() bool {
+	switch r {
+	case ChecklistOwnerEmployee, ChecklistOwnerManager, ChecklistOwnerDesignated:
+		return true
+	}
+	return false
+}
+
+// ChecklistTemplate lists the tasks of an onboarding or offboarding. Templates without a position
+// or department apply to everyone; otherwise the most specific match is used, position before
+// department.
+type ChecklistTemplate struct {
+	ID           int
+	Name         string
+	Kind         ChecklistKind
+	PositionID   *int
+	DepartmentID *int
+	Tasks        []ChecklistTemplateTask
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type ChecklistTemplateTask struct {
+	ID          int
+	TemplateID  int
+	Title       string
+	Description string
+	OwnerRole   ChecklistOwnerRole
+	OwnerID     *int // only for ChecklistOwnerDesignated
+	// DueDays counts from the hire date of onboardings and the termination date of offboardings;
+	// negative values fall before it.
+	DueDays int
+}
+
+// Checklist is a template's tasks copied for one employee's onboarding or offboarding.
+type Checklist struct {
+	ID           int
+	EmployeeID   int
+	EmployeeName string // read only
+	TemplateID   *int   // nil once the template is deleted
+	Kind         ChecklistKind
+	Name         string
+	StartDate    time.Time // the hire or termination date the due dates count from
+	Tasks        []ChecklistTask
+	CreatedAt    time.Time
+	CompletedAt  *time.Time // set once every task is done
+}
+
+// ChecklistProgress summarizes how far a checklist has come.
+type ChecklistProgress struct {
+	TotalTasks     int
+	CompletedTasks int
+	OverdueTasks   int
+	Percent        float64
+}
+
+// Progress counts the checklist's completed and overdue tasks as of asOf.
+func (c *Checklist) Progress(asOf time.Time) ChecklistProgress {
+	progress := ChecklistProgress{TotalTasks: len(c.Tasks)}
+	for _, task := range c.Tasks {
+		if task.IsCompleted() {
+			progress.CompletedTasks++
+		} else if task.IsOverdue(asOf) {
+			progress.OverdueTasks++
+		}
+	}
+	if progress.TotalTasks > 0 {
+		progress.Percent = math.Round(float64(progress.CompletedTasks)/float64(progress.TotalTasks)*10000) / 100
+	}
+	return progress
+}
+
+type ChecklistTask struct {
+	ID            int
+	ChecklistID   int
+	ChecklistKind ChecklistKind // read only
+	EmployeeID    int           // read only, whose checklist the task is on
+	EmployeeName  string        // read only
+	Title         string
+	Description   string
+	OwnerID       *int   // nil while unassigned
+	OwnerName     string // read only
+	DueOn         time.Time
+	CompletedAt   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (t *ChecklistTask) IsCompleted() bool {
+	return t.CompletedAt != nil
+}
+
+// IsOverdue reports whether the task is still open on a day after its due date.
+func (t *ChecklistTask) IsOverdue(asOf time.Time) bool {
+	return !t.IsCompleted() && !asOf.Before(t.DueOn.AddDate(0, 0, 1))
+}
+
+type ChecklistFilter struct {
+	EmployeeID int
+	Kind       ChecklistKind
+	OpenOnly   bool
+}
@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+type CompensationChangeStatus string
+
+const (
+	CompensationChangeScheduled CompensationChangeStatus = "scheduled"
+	CompensationChangeApplied   CompensationChangeStatus = "applied"
+	CompensationChangeCancelled CompensationChangeStatus = "cancelled"
+)
+
+// CompensationChange is one entry of an employee's salary timeline. Future-dated
+// changes stay scheduled until the effective date, when they are applied to the employee.
+type CompensationChange struct {
+	ID            int
+	EmployeeID    int
+	EffectiveDate time.Time
+	OldSalary     Money // zero for the initial salary
+	NewSalary     Money
+	Reason        string
+	ApprovedBy    string
+	Status        CompensationChangeStatus
+	AppliedAt     *time.Time
+	CreatedAt     time.Time
+}
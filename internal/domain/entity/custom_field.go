@@ -0,0 +1,47 @@
+package entity
+
+import "time"
+
+type CustomFieldType string
+
+const (
+	CustomFieldText        CustomFieldType = "text"
+	CustomFieldNumber      CustomFieldType = "number"
+	CustomFieldBoolean     CustomFieldType = "boolean"
+	CustomFieldDate        CustomFieldType = "date" // YYYY-MM-DD
+	CustomFieldSelect      CustomFieldType = "select"
+	CustomFieldMultiSelect CustomFieldType = "multi_select"
+)
+
+func (t CustomFieldType) IsValid() bool {
+	switch t {
+	case CustomFieldText, CustomFieldNumber, CustomFieldBoolean, CustomFieldDate,
+		CustomFieldSelect, CustomFieldMultiSelect:
+		return true
+	}
+	return false
+}
+
+// CustomFieldDefinition is an employee attribute defined at runtime. Employees keep their value
+// of it in Employee.CustomFields under Key.
+type CustomFieldDefinition struct {
+	ID         int
+	Key        string // snake_case, e.g. t_shirt_size
+	Label      string
+	Type       CustomFieldType
+	Required   bool
+	Options    []string // values a select or multi_select field may take
+	Validation CustomFieldValidation
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// CustomFieldValidation limits the values of a field further than its type. Length limits and the
+// pattern apply to text fields, Min and Max to number fields; nil limits are not checked.
+type CustomFieldValidation struct {
+	MinLength *int
+	MaxLength *int
+	Pattern   string // regular expression the whole value must match
+	Min       *float64
+	Max       *float64
+}
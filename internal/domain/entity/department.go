@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// Department is a team employees belong to, used to group them in reports such as review
+// calibration.
+type Department struct {
+	ID        int
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,34 @@
+package entity
+
+import "time"
+
+type DocumentCategory string
+
+const (
+	DocumentCategoryContract    DocumentCategory = "contract"
+	DocumentCategoryIdentity    DocumentCategory = "identity"
+	DocumentCategoryOfferLetter DocumentCategory = "offer_letter"
+	DocumentCategoryOther       DocumentCategory = "other"
+)
+
+func (c DocumentCategory) IsValid() bool {
+	switch c {
+	case DocumentCategoryContract, DocumentCategoryIdentity, DocumentCategoryOfferLetter, DocumentCategoryOther:
+		return true
+	}
+	return false
+}
+
+// EmployeeDocument describes a file kept with an employee. The file itself is in blob storage
+// under StorageKey.
+type EmployeeDocument struct {
+	ID          int
+	EmployeeID  int
+	Category    DocumentCategory
+	FileName    string
+	ContentType string // sniffed from the contents, not taken from the client
+	Size        int64
+	Checksum    string // hex SHA-256 of the contents
+	StorageKey  string
+	UploadedAt  time.Time
+}
@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+type EmergencyContact struct {
+	ID           int
+	EmployeeID   int
+	Name         string
+	Relationship string
+	Phone        string // E.164
+	Email        string
+	IsPrimary    bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
@@ -3,11 +3,42 @@ package entity
 import "time"
 
 type Employee struct {
-	ID        int
-	Name      string
-	Position  string
-	Salary    int
-	HiredDate time.Time
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID                int
+	Name              string
+	PositionID        int
+	Position          string // title of the position, read only
+	Salary            Money
+	PayPeriod         PayPeriod
+	SalaryOutOfBand   bool // saved outside the position's band under the flag policy
+	ManagerID         *int
+	LocationID        *int // office location, decides the holiday calendar
+	DepartmentID      *int
+	HiredDate         time.Time
+	WorkEmail         string
+	PersonalEmail     string
+	Phone             string // E.164, e.g. +14155552671
+	Address           Address
+	DateOfBirth       *time.Time
+	Status            EmploymentStatus
+	TerminationDate   *time.Time
+	TerminationReason string
+	CustomFields      map[string]any // values of custom fields by key, see CustomFieldDefinition
+	Tags              []string       // read only, managed on their own
+	UnreturnedAssets  int            // assets still held, only set when the employee is terminated
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// Address is a structured postal address.
+type Address struct {
+	Line1      string
+	Line2      string
+	City       string
+	State      string
+	PostalCode string
+	Country    string // ISO 3166-1 alpha-2, e.g. IN
+}
+
+func (a Address) IsZero() bool {
+	return a == Address{}
 }
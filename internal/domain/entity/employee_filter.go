@@ -0,0 +1,17 @@
+package entity
+
+// EmployeeFilter narrows the employee list. Zero values mean "no filter".
+type EmployeeFilter struct {
+	Statuses   []EmploymentStatus
+	ManagerID  int      // direct reports of this manager
+	LocationID int      // employees whose primary location this is
+	Tags       []string // employees with every one of these tags
+	// CustomFields keeps employees whose custom fields hold these values. Multi-select values match
+	// employees who selected each of them.
+	CustomFields map[string]any
+}
+
+func (f EmployeeFilter) IsEmpty() bool {
+	return len(f.Statuses) == 0 && f.ManagerID == 0 && f.LocationID == 0 && len(f.Tags) == 0 &&
+		len(f.CustomFields) == 0
+}
@@ -0,0 +1,48 @@
+package entity
+
+import "time"
+
+type EmploymentStatus string
+
+const (
+	EmploymentStatusCandidate  EmploymentStatus = "candidate"
+	EmploymentStatusActive     EmploymentStatus = "active"
+	EmploymentStatusOnLeave    EmploymentStatus = "on_leave"
+	EmploymentStatusSuspended  EmploymentStatus = "suspended"
+	EmploymentStatusTerminated EmploymentStatus = "terminated"
+)
+
+// employmentStatusTransitions lists, for every status, the statuses an employee may move to.
+// Terminated employees can only come back through a rehire, which makes them active again.
+var employmentStatusTransitions = map[EmploymentStatus][]EmploymentStatus{
+	EmploymentStatusCandidate:  {EmploymentStatusActive, EmploymentStatusTerminated},
+	EmploymentStatusActive:     {EmploymentStatusOnLeave, EmploymentStatusSuspended, EmploymentStatusTerminated},
+	EmploymentStatusOnLeave:    {EmploymentStatusActive, EmploymentStatusTerminated},
+	EmploymentStatusSuspended:  {EmploymentStatusActive, EmploymentStatusTerminated},
+	EmploymentStatusTerminated: {EmploymentStatusActive},
+}
+
+func (s EmploymentStatus) IsValid() bool {
+	_, ok := employmentStatusTransitions[s]
+	return ok
+}
+
+func (s EmploymentStatus) CanTransitionTo(next EmploymentStatus) bool {
+	for _, allowed := range employmentStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// EmploymentStatusChange is one entry of an employee's status history.
+type EmploymentStatusChange struct {
+	ID            int
+	EmployeeID    int
+	FromStatus    EmploymentStatus
+	ToStatus      EmploymentStatus
+	EffectiveDate time.Time
+	Reason        string
+	CreatedAt     time.Time
+}
@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// ExchangeRate states that one unit of BaseCurrency is worth Rate units of QuoteCurrency
+// from EffectiveDate onwards, until a newer rate for the same pair takes over.
+type ExchangeRate struct {
+	ID            int
+	BaseCurrency  string
+	QuoteCurrency string
+	Rate          float64
+	EffectiveDate time.Time
+	CreatedAt     time.Time
+}
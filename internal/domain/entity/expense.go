@@ -0,0 +1,118 @@
+package entity
+
+import "time"
+
+// ExpenseCategory is a kind of expense employees claim, such as travel or meals.
+type ExpenseCategory struct {
+	ID          int
+	Name        string
+	Description string
+	// Limit caps what a single claim may hold of the category; zero for no limit.
+	Limit           Money
+	ReceiptRequired bool
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// HasLimit reports whether claims are capped for the category.
+func (c *ExpenseCategory) HasLimit() bool {
+	return !c.Limit.IsZero()
+}
+
+type ExpenseClaimStatus string
+
+const (
+	ExpenseClaimDraft      ExpenseClaimStatus = "draft"
+	ExpenseClaimSubmitted  ExpenseClaimStatus = "submitted"
+	ExpenseClaimApproved   ExpenseClaimStatus = "approved"
+	ExpenseClaimRejected   ExpenseClaimStatus = "rejected"
+	ExpenseClaimReimbursed ExpenseClaimStatus = "reimbursed"
+)
+
+func (s ExpenseClaimStatus) IsValid() bool {
+	switch s {
+	case ExpenseClaimDraft, ExpenseClaimSubmitted, ExpenseClaimApproved, ExpenseClaimRejected, ExpenseClaimReimbursed:
+		return true
+	}
+	return false
+}
+
+// IsEditable reports whether the employee may still change the claim. Rejected claims can be
+// corrected and submitted again.
+func (s ExpenseClaimStatus) IsEditable() bool {
+	return s == ExpenseClaimDraft || s == ExpenseClaimRejected
+}
+
+// ExpenseClaim is an employee asking to be paid back for expenses. All items are in the claim's
+// currency. An approved claim may be held by the payroll run paying it back, PayrollRunID, and is
+// reimbursed when that run is locked.
+type ExpenseClaim struct {
+	ID           int
+	EmployeeID   int
+	EmployeeName string // read only
+	Title        string
+	Currency     string
+	Status       ExpenseClaimStatus
+	Items        []ExpenseItem // only loaded for single claims
+	Total        Money         // read only
+	ReceiptCount int           // read only
+	SubmittedAt  *time.Time
+	// ApprovalRequestID is set while an approval workflow decides the claim; otherwise the
+	// employee's manager decides it.
+	ApprovalRequestID      *int
+	DecidedBy              *int
+	DecidedAt              *time.Time
+	DecisionComment        string
+	ReimbursedOn           *time.Time
+	PayrollRunID           *int
+	ReimbursementReference string
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+}
+
+type ExpenseItem struct {
+	ID           int
+	ClaimID      int
+	CategoryID   int
+	CategoryName string // read only
+	ExpenseDate  time.Time
+	Description  string
+	Amount       Money
+}
+
+// ExpenseReceipt is a receipt kept with a claim. The file itself is in blob storage under
+// StorageKey.
+type ExpenseReceipt struct {
+	ID          int
+	ClaimID     int
+	FileName    string
+	ContentType string // sniffed from the contents, not taken from the client
+	Size        int64
+	Checksum    string // hex SHA-256 of the contents
+	StorageKey  string
+	UploadedAt  time.Time
+}
+
+// ExpenseClaimFilter narrows claim lists; zero fields match everything.
+type ExpenseClaimFilter struct {
+	EmployeeID   int
+	DepartmentID int
+	Status       ExpenseClaimStatus
+}
+
+// ExpenseReportFilter selects the expenses of a report by expense date, both ends included.
+type ExpenseReportFilter struct {
+	From         time.Time
+	To           time.Time
+	DepartmentID int
+}
+
+// ExpenseReportRow totals the approved and reimbursed expenses of a department in a month. Rows
+// for employees without a department have a nil DepartmentID.
+type ExpenseReportRow struct {
+	DepartmentID   *int
+	DepartmentName string
+	Period         time.Time // first day of the month
+	ClaimCount     int
+	Total          Money
+}
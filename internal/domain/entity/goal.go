@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"math"
+	"time"
+)
+
+type GoalStatus string
+
+const (
+	GoalStatusActive    GoalStatus = "active"
+	GoalStatusCompleted GoalStatus = "completed"
+	// GoalStatusCancelled goals are left out of progress roll-ups.
+	GoalStatusCancelled GoalStatus = "cancelled"
+)
+
+func (s GoalStatus) IsValid() bool {
+	switch s {
+	case GoalStatusActive, GoalStatusCompleted, GoalStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// Goal is an objective measured by its key results. Company goals have no EmployeeID; other goals
+// can be aligned to a company goal or a goal of the owner's manager through ParentID.
+type Goal struct {
+	ID           int
+	EmployeeID   *int
+	EmployeeName string // read only
+	ParentID     *int
+	Title        string
+	Description  string
+	StartDate    time.Time
+	DueDate      time.Time
+	Status       GoalStatus
+	KeyResults   []KeyResult
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Progress is the completion percentage of the goal: 100 once completed, otherwise the average
+// progress of its key results.
+func (g *Goal) Progress() float64 {
+	if g.Status == GoalStatusCompleted {
+		return 100
+	}
+	if len(g.KeyResults) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, keyResult := range g.KeyResults {
+		total += keyResult.Progress()
+	}
+	return math.Round(total/float64(len(g.KeyResults))*100) / 100
+}
+
+// KeyResult is a measurable outcome of a goal, moving from StartValue towards TargetValue.
+type KeyResult struct {
+	ID           int
+	GoalID       int
+	Title        string
+	Unit         string // e.g. %, customers or USD
+	StartValue   float64
+	TargetValue  float64
+	CurrentValue float64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Progress is how far CurrentValue has moved from StartValue to TargetValue, as a percentage
+// between 0 and 100. Targets below the start value count progress downwards.
+func (k *KeyResult) Progress() float64 {
+	if k.TargetValue == k.StartValue {
+		return 0
+	}
+	progress := (k.CurrentValue - k.StartValue) / (k.TargetValue - k.StartValue) * 100
+	return math.Round(math.Max(0, math.Min(progress, 100))*100) / 100
+}
+
+// GoalCheckIn records a key result's value at a point in time.
+type GoalCheckIn struct {
+	ID             int
+	GoalID         int // read only
+	KeyResultID    int
+	KeyResultTitle string // read only
+	Value          float64
+	Comment        string
+	CreatedAt      time.Time
+}
+
+// GoalFilter narrows the goal list. Zero values mean "no filter".
+type GoalFilter struct {
+	EmployeeIDs []int
+	CompanyOnly bool // goals without an owner
+	ParentID    int  // goals aligned to this goal
+}
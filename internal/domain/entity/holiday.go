@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// HolidayCalendar is a named set of public holidays, usually those of one country or region.
+type HolidayCalendar struct {
+	ID        int
+	Name      string
+	Country   string // ISO 3166-1 alpha-2, optional
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Holiday is a non-working date in a holiday calendar.
+type Holiday struct {
+	ID         int
+	CalendarID int
+	Date       time.Time
+	Name       string
+	CreatedAt  time.Time
+}
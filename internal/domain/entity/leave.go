@@ -0,0 +1,90 @@
+package entity
+
+import "time"
+
+// LeaveAccrualPolicy decides how a leave type builds up a balance over the year.
+type LeaveAccrualPolicy string
+
+const (
+	// LeaveAccrualNone does not track a balance, e.g. unpaid leave.
+	LeaveAccrualNone LeaveAccrualPolicy = "none"
+	// LeaveAccrualAnnual grants the full yearly allowance on January 1st (prorated in the hiring year).
+	LeaveAccrualAnnual LeaveAccrualPolicy = "annual"
+	// LeaveAccrualMonthly grants a twelfth of the yearly allowance for every completed month of service.
+	LeaveAccrualMonthly LeaveAccrualPolicy = "monthly"
+)
+
+func (p LeaveAccrualPolicy) IsValid() bool {
+	switch p {
+	case LeaveAccrualNone, LeaveAccrualAnnual, LeaveAccrualMonthly:
+		return true
+	}
+	return false
+}
+
+type LeaveType struct {
+	ID               int
+	Name             string
+	AccrualPolicy    LeaveAccrualPolicy
+	DaysPerYear      float64
+	MaxCarryOverDays float64 // unused days that move to the next year
+	IsPaid           bool
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func (t LeaveType) TracksBalance() bool {
+	return t.AccrualPolicy != LeaveAccrualNone
+}
+
+// LeaveBalance is an employee's allowance of one leave type for a calendar year.
+type LeaveBalance struct {
+	ID              int
+	EmployeeID      int
+	LeaveTypeID     int
+	Year            int
+	AccruedDays     float64
+	CarriedOverDays float64
+	UsedDays        float64
+	UpdatedAt       time.Time
+}
+
+func (b LeaveBalance) RemainingDays() float64 {
+	return b.AccruedDays + b.CarriedOverDays - b.UsedDays
+}
+
+type LeaveRequestStatus string
+
+const (
+	LeaveRequestStatusPending   LeaveRequestStatus = "pending"
+	LeaveRequestStatusApproved  LeaveRequestStatus = "approved"
+	LeaveRequestStatusRejected  LeaveRequestStatus = "rejected"
+	LeaveRequestStatusCancelled LeaveRequestStatus = "cancelled"
+)
+
+// LeaveRequest covers the dates from StartDate to EndDate inclusive. Days is the number of
+// working days in that range, which is what gets deducted from the balance.
+type LeaveRequest struct {
+	ID          int
+	EmployeeID  int
+	LeaveTypeID int
+	StartDate   time.Time
+	EndDate     time.Time
+	Days        float64
+	Reason      string
+	Status      LeaveRequestStatus
+	// ApprovalRequestID is set while an approval workflow decides the request; otherwise the
+	// employee's manager does.
+	ApprovalRequestID *int
+	DecidedBy         *int
+	DecidedAt         *time.Time
+	DecisionComment   string
+	StatusApplied     bool // the request put the employee on leave
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// Covers reports whether date falls within the requested range.
+func (r LeaveRequest) Covers(date time.Time) bool {
+	return !date.Before(r.StartDate) && !date.After(r.EndDate)
+}
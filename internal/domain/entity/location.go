@@ -0,0 +1,57 @@
+package entity
+
+import "time"
+
+// Location is an office employees can be assigned to. Its holiday calendar decides the
+// working days of the employees there; a location without one only observes weekends.
+// Remote locations group employees working from home and have no address.
+type Location struct {
+	ID                int
+	Name              string
+	Address           Address
+	TimeZone          string // IANA name, e.g. Asia/Kolkata
+	Remote            bool
+	HolidayCalendarID *int
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// Zone returns the location's time zone, UTC when it is unset or unknown.
+func (l *Location) Zone() *time.Location {
+	if l.TimeZone == "" {
+		return time.UTC
+	}
+	zone, err := time.LoadLocation(l.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return zone
+}
+
+// LocalDate returns the calendar date t falls on at the location. Like every date-only field it is
+// expressed as midnight UTC.
+func (l *Location) LocalDate(t time.Time) time.Time {
+	year, month, day := t.In(l.Zone()).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+type LocationAssignmentStatus string
+
+const (
+	LocationAssignmentScheduled LocationAssignmentStatus = "scheduled"
+	LocationAssignmentApplied   LocationAssignmentStatus = "applied"
+	LocationAssignmentCancelled LocationAssignmentStatus = "cancelled"
+)
+
+// LocationAssignment is one entry of an employee's primary location timeline. Future-dated
+// assignments stay scheduled until the effective date, when the employee moves to the location.
+type LocationAssignment struct {
+	ID            int
+	EmployeeID    int
+	LocationID    int
+	LocationName  string // read only
+	EffectiveDate time.Time
+	Status        LocationAssignmentStatus
+	AppliedAt     *time.Time
+	CreatedAt     time.Time
+}
@@ -0,0 +1,63 @@
+package entity
+
+import "math"
+
+// Money is an amount in the minor units of its currency (cents for USD, yen for JPY).
+type Money struct {
+	Amount   int64
+	Currency string // ISO 4217, e.g. USD
+}
+
+func (m Money) IsZero() bool {
+	return m == Money{}
+}
+
+// IsValid reports whether m is a positive amount in a known currency.
+func (m Money) IsValid() bool {
+	return m.Amount > 0 && IsValidCurrency(m.Currency)
+}
+
+// Major returns the amount in major units, e.g. 1234 USD cents -> 12.34.
+func (m Money) Major() float64 {
+	exponent, _ := CurrencyMinorUnits(m.Currency)
+	return float64(m.Amount) / math.Pow10(exponent)
+}
+
+// MoneyFromMajor builds Money from an amount in major units, rounding to the nearest minor unit.
+func MoneyFromMajor(amount float64, currency string) Money {
+	exponent, _ := CurrencyMinorUnits(currency)
+	return Money{
+		Amount:   int64(math.Round(amount * math.Pow10(exponent))),
+		Currency: currency,
+	}
+}
+
+type PayPeriod string
+
+const (
+	PayPeriodMonthly PayPeriod = "monthly"
+	PayPeriodAnnual  PayPeriod = "annual"
+	PayPeriodHourly  PayPeriod = "hourly"
+)
+
+// StandardHoursPerYear is used to compare hourly pay with monthly and annual salaries (40h x 52 weeks).
+const StandardHoursPerYear = 2080
+
+func (p PayPeriod) IsValid() bool {
+	switch p {
+	case PayPeriodMonthly, PayPeriodAnnual, PayPeriodHourly:
+		return true
+	}
+	return false
+}
+
+// PeriodsPerYear returns how many pay periods of this kind make up a year.
+func (p PayPeriod) PeriodsPerYear() int64 {
+	switch p {
+	case PayPeriodMonthly:
+		return 12
+	case PayPeriodHourly:
+		return StandardHoursPerYear
+	}
+	return 1
+}
@@ -0,0 +1,160 @@
+package entity
+
+import "time"
+
+type PayrollRuleKind string
+
+const (
+	PayrollRuleKindEarning   PayrollRuleKind = "earning"
+	PayrollRuleKindDeduction PayrollRuleKind = "deduction"
+	PayrollRuleKindTax       PayrollRuleKind = "tax"
+)
+
+func (k PayrollRuleKind) IsValid() bool {
+	switch k {
+	case PayrollRuleKindEarning, PayrollRuleKindDeduction, PayrollRuleKindTax:
+		return true
+	}
+	return false
+}
+
+type PayrollRuleMethod string
+
+const (
+	PayrollRuleMethodFixed      PayrollRuleMethod = "fixed"
+	PayrollRuleMethodPercentage PayrollRuleMethod = "percentage"
+)
+
+// PayrollRule is an earning, deduction or tax applied to every payslip. Fixed rules add Amount
+// each period; percentage rules take Rate percent of the base pay (earnings), the gross pay
+// (deductions) or the gross pay after deductions (taxes).
+type PayrollRule struct {
+	ID        int
+	Name      string
+	Kind      PayrollRuleKind
+	Method    PayrollRuleMethod
+	Amount    Money   // fixed rules only
+	Rate      float64 // percentage rules only, e.g. 12.5
+	IsActive  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// PayrollPeriod is a calendar month paid on PayDate.
+type PayrollPeriod struct {
+	ID        int
+	Year      int
+	Month     time.Month
+	PayDate   time.Time
+	CreatedAt time.Time
+}
+
+func (p PayrollPeriod) StartDate() time.Time {
+	return time.Date(p.Year, p.Month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (p PayrollPeriod) EndDate() time.Time {
+	return p.StartDate().AddDate(0, 1, -1)
+}
+
+// Name is the period in YYYY-MM form, e.g. 2025-03.
+func (p PayrollPeriod) Name() string {
+	return p.StartDate().Format("2006-01")
+}
+
+// ProrationBasis decides how pay is split over a period when an employee is only employed for
+// part of it or their salary changes during it.
+type ProrationBasis string
+
+const (
+	// ProrationBasisCalendarDays pays every day of the period alike, weekends included.
+	ProrationBasisCalendarDays ProrationBasis = "calendar_days"
+	// ProrationBasisWorkingDays only counts weekdays that are not public holidays.
+	ProrationBasisWorkingDays ProrationBasis = "working_days"
+)
+
+func (b ProrationBasis) IsValid() bool {
+	return b == ProrationBasisCalendarDays || b == ProrationBasisWorkingDays
+}
+
+type PayrollRunStatus string
+
+const (
+	PayrollRunStatusDraft     PayrollRunStatus = "draft"
+	PayrollRunStatusFinalized PayrollRunStatus = "finalized"
+	PayrollRunStatusLocked    PayrollRunStatus = "locked"
+)
+
+// payrollRunTransitions lists, for every status, the statuses a run may move to. Finalized runs
+// can be reopened as drafts; locked runs are final.
+var payrollRunTransitions = map[PayrollRunStatus][]PayrollRunStatus{
+	PayrollRunStatusDraft:     {PayrollRunStatusFinalized},
+	PayrollRunStatusFinalized: {PayrollRunStatusDraft, PayrollRunStatusLocked},
+	PayrollRunStatusLocked:    {},
+}
+
+func (s PayrollRunStatus) CanTransitionTo(next PayrollRunStatus) bool {
+	for _, allowed := range payrollRunTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// PayrollRun computes the payslips of a period.
+type PayrollRun struct {
+	ID              int
+	PayrollPeriodID int
+	Status          PayrollRunStatus
+	PayslipCount    int // read only
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	FinalizedAt     *time.Time
+	LockedAt        *time.Time
+}
+
+type PayslipLineKind string
+
+const (
+	PayslipLineKindBase      PayslipLineKind = "base"
+	PayslipLineKindEarning   PayslipLineKind = "earning"
+	PayslipLineKindDeduction PayslipLineKind = "deduction"
+	PayslipLineKindTax       PayslipLineKind = "tax"
+	// PayslipLineKindReimbursement pays back an approved expense claim. It is added to the net
+	// pay without being taxed.
+	PayslipLineKindReimbursement PayslipLineKind = "reimbursement"
+)
+
+// PayslipLine is one amount on a payslip. PayrollRuleID is nil for the base pay, benefit
+// deductions, reimbursements and once the rule has been deleted. ExpenseClaimID is the claim a
+// reimbursement pays back.
+type PayslipLine struct {
+	PayrollRuleID  *int
+	ExpenseClaimID *int
+	Kind           PayslipLineKind
+	Name           string
+	Amount         Money
+}
+
+// Payslip is an employee's pay for a run, with the employee and compensation as they were when
+// it was calculated. All amounts are in the salary currency. Reimbursements are paid on top of
+// the pay left after deductions and taxes.
+type Payslip struct {
+	ID                  int
+	PayrollRunID        int
+	Period              *PayrollPeriod // read only, the period of the run
+	EmployeeID          int
+	EmployeeName        string
+	Position            string
+	Salary              Money
+	PayPeriod           PayPeriod
+	BasePay             Money
+	GrossPay            Money
+	TotalDeductions     Money
+	TotalTax            Money
+	TotalReimbursements Money
+	NetPay              Money
+	Lines               []PayslipLine
+	CreatedAt           time.Time
+}
@@ -0,0 +1,34 @@
+package entity
+
+import "time"
+
+// Position is a catalog entry employees are assigned to. The salary band is expressed per
+// PayPeriod in a single currency; a position without a band accepts any salary.
+type Position struct {
+	ID        int
+	Title     string
+	JobLevel  int
+	MinSalary Money
+	MaxSalary Money
+	PayPeriod PayPeriod
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (p Position) HasBand() bool {
+	return !p.MinSalary.IsZero() || !p.MaxSalary.IsZero()
+}
+
+// SalaryBandPolicy decides what happens when an employee's salary falls outside their position's band.
+type SalaryBandPolicy string
+
+const (
+	// SalaryBandPolicyReject refuses to save the employee.
+	SalaryBandPolicyReject SalaryBandPolicy = "reject"
+	// SalaryBandPolicyFlag saves the employee and marks the salary as out of band.
+	SalaryBandPolicyFlag SalaryBandPolicy = "flag"
+)
+
+func (p SalaryBandPolicy) IsValid() bool {
+	return p == SalaryBandPolicyReject || p == SalaryBandPolicyFlag
+}
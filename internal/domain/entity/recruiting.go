@@ -0,0 +1,177 @@
+package entity
+
+import "time"
+
+type RequisitionStatus string
+
+const (
+	RequisitionOpen   RequisitionStatus = "open"
+	RequisitionOnHold RequisitionStatus = "on_hold"
+	// RequisitionFilled requisitions hired as many candidates as they have openings.
+	RequisitionFilled RequisitionStatus = "filled"
+	RequisitionClosed RequisitionStatus = "closed"
+)
+
+func (s RequisitionStatus) IsValid() bool {
+	switch s {
+	case RequisitionOpen, RequisitionOnHold, RequisitionFilled, RequisitionClosed:
+		return true
+	}
+	return false
+}
+
+// JobRequisition is an approved opening to hire for. Employees hired through it get its position,
+// department, location and hiring manager.
+type JobRequisition struct {
+	ID                int
+	Title             string
+	PositionID        int
+	Position          string // read only, title of the position
+	DepartmentID      *int
+	LocationID        *int
+	HiringManagerID   *int
+	HiringManagerName string // read only
+	Openings          int
+	Hired             int // read only, candidates hired so far
+	Status            RequisitionStatus
+	Description       string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+type CandidateStage string
+
+const (
+	CandidateApplied   CandidateStage = "applied"
+	CandidateScreening CandidateStage = "screening"
+	CandidateInterview CandidateStage = "interview"
+	CandidateOffer     CandidateStage = "offer"
+	// CandidateHired candidates accepted their offer and were created as employees.
+	CandidateHired     CandidateStage = "hired"
+	CandidateRejected  CandidateStage = "rejected"
+	CandidateWithdrawn CandidateStage = "withdrawn"
+)
+
+// candidateStageTransitions lists, for every stage, the stages a candidate may move to. Candidates
+// only reach the offer stage with an offer and the hired stage by accepting it, and hired, rejected
+// and withdrawn candidates leave the pipeline.
+var candidateStageTransitions = map[CandidateStage][]CandidateStage{
+	CandidateApplied:   {CandidateScreening, CandidateInterview, CandidateOffer, CandidateRejected, CandidateWithdrawn},
+	CandidateScreening: {CandidateInterview, CandidateOffer, CandidateRejected, CandidateWithdrawn},
+	CandidateInterview: {CandidateOffer, CandidateRejected, CandidateWithdrawn},
+	CandidateOffer:     {CandidateHired, CandidateRejected, CandidateWithdrawn},
+	CandidateHired:     {},
+	CandidateRejected:  {},
+	CandidateWithdrawn: {},
+}
+
+func (s CandidateStage) IsValid() bool {
+	_, ok := candidateStageTransitions[s]
+	return ok
+}
+
+func (s CandidateStage) CanTransitionTo(next CandidateStage) bool {
+	for _, allowed := range candidateStageTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsClosed reports whether candidates in the stage have left the pipeline.
+func (s CandidateStage) IsClosed() bool {
+	return len(candidateStageTransitions[s]) == 0
+}
+
+type Candidate struct {
+	ID               int
+	RequisitionID    int
+	RequisitionTitle string // read only
+	Name             string
+	Email            string
+	Phone            string // E.164, e.g. +14155552671
+	Source           string // where the candidate came from, e.g. referral
+	Notes            string
+	Stage            CandidateStage
+	StageChangedAt   time.Time
+	Offer            *JobOffer // set from the offer stage on
+	EmployeeID       *int      // the employee created once the offer was accepted
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// JobOffer is the salary and start date offered to a candidate.
+type JobOffer struct {
+	Salary    Money
+	PayPeriod PayPeriod
+	StartDate time.Time
+}
+
+type CandidateFilter struct {
+	RequisitionID int
+	Stage         CandidateStage
+}
+
+// OfferAcceptance holds what the new employee needs beyond the candidate's data.
+type OfferAcceptance struct {
+	CandidateID  int
+	WorkEmail    string
+	ManagerID    *int // defaults to the requisition's hiring manager
+	CustomFields map[string]any
+}
+
+type InterviewStatus string
+
+const (
+	InterviewScheduled InterviewStatus = "scheduled"
+	InterviewCompleted InterviewStatus = "completed"
+	InterviewCancelled InterviewStatus = "cancelled"
+)
+
+type InterviewRecommendation string
+
+const (
+	RecommendStrongYes InterviewRecommendation = "strong_yes"
+	RecommendYes       InterviewRecommendation = "yes"
+	RecommendNo        InterviewRecommendation = "no"
+	RecommendStrongNo  InterviewRecommendation = "strong_no"
+)
+
+func (r InterviewRecommendation) IsValid() bool {
+	switch r {
+	case RecommendStrongYes, RecommendYes, RecommendNo, RecommendStrongNo:
+		return true
+	}
+	return false
+}
+
+// Interview is one interviewer meeting a candidate. Completing it records the interviewer's
+// feedback.
+type Interview struct {
+	ID              int
+	CandidateID     int
+	CandidateName   string // read only
+	RequisitionID   int    // read only
+	InterviewerID   int
+	InterviewerName string // read only
+	ScheduledAt     time.Time
+	DurationMinutes int
+	Status          InterviewStatus
+	Rating          *int // 1 to 5
+	Recommendation  InterviewRecommendation
+	Feedback        string
+	FeedbackAt      *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// EndsAt returns when the interview is planned to end.
+func (i *Interview) EndsAt() time.Time {
+	return i.ScheduledAt.Add(time.Duration(i.DurationMinutes) * time.Minute)
+}
+
+// Overlaps reports whether the two interviews take up some of the same time.
+func (i *Interview) Overlaps(other *Interview) bool {
+	return i.ScheduledAt.Before(other.EndsAt()) && other.ScheduledAt.Before(i.EndsAt())
+}
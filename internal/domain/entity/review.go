@@ -0,0 +1,129 @@
+package entity
+
+import "time"
+
+type ReviewCycleStatus string
+
+const (
+	ReviewCycleStatusDraft  ReviewCycleStatus = "draft"
+	ReviewCycleStatusActive ReviewCycleStatus = "active"
+	ReviewCycleStatusClosed ReviewCycleStatus = "closed"
+)
+
+// ReviewCycle is a round of performance reviews covering PeriodStart to PeriodEnd. Its questions
+// and rating scale can only be changed while it is a draft.
+type ReviewCycle struct {
+	ID                    int
+	Name                  string
+	PeriodStart           time.Time
+	PeriodEnd             time.Time
+	SelfReviewDeadline    time.Time
+	PeerReviewDeadline    time.Time
+	ManagerReviewDeadline time.Time
+	RatingLabels          []string // rating 1 is the first label, e.g. Needs improvement
+	Questions             []ReviewQuestion
+	Status                ReviewCycleStatus
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	LaunchedAt            *time.Time
+	ClosedAt              *time.Time
+}
+
+// MaxRating is the highest rating on the cycle's scale.
+func (c *ReviewCycle) MaxRating() int {
+	return len(c.RatingLabels)
+}
+
+// Deadline returns the last day reviewers in role can submit.
+func (c *ReviewCycle) Deadline(role ReviewerRole) time.Time {
+	switch role {
+	case ReviewerRoleSelf:
+		return c.SelfReviewDeadline
+	case ReviewerRolePeer:
+		return c.PeerReviewDeadline
+	}
+	return c.ManagerReviewDeadline
+}
+
+type ReviewQuestionKind string
+
+const (
+	// ReviewQuestionKindRating is answered with a rating on the cycle's scale and an optional comment.
+	ReviewQuestionKindRating ReviewQuestionKind = "rating"
+	// ReviewQuestionKindText is answered with a comment only.
+	ReviewQuestionKindText ReviewQuestionKind = "text"
+)
+
+func (k ReviewQuestionKind) IsValid() bool {
+	return k == ReviewQuestionKindRating || k == ReviewQuestionKindText
+}
+
+type ReviewQuestion struct {
+	ID   int
+	Text string
+	Kind ReviewQuestionKind
+}
+
+type ReviewStatus string
+
+const (
+	ReviewStatusInProgress ReviewStatus = "in_progress"
+	// ReviewStatusCompleted is reached once the manager has submitted their review.
+	ReviewStatusCompleted ReviewStatus = "completed"
+	// ReviewStatusLocked reviews can no longer change.
+	ReviewStatusLocked ReviewStatus = "locked"
+)
+
+type ReviewerRole string
+
+const (
+	ReviewerRoleSelf    ReviewerRole = "self"
+	ReviewerRolePeer    ReviewerRole = "peer"
+	ReviewerRoleManager ReviewerRole = "manager"
+)
+
+func (r ReviewerRole) IsValid() bool {
+	switch r {
+	case ReviewerRoleSelf, ReviewerRolePeer, ReviewerRoleManager:
+		return true
+	}
+	return false
+}
+
+// Review is an employee's review form in a cycle.
+type Review struct {
+	ID             int
+	ReviewCycleID  int
+	EmployeeID     int
+	EmployeeName   string // read only
+	DepartmentID   *int   // the employee's current department, read only
+	DepartmentName string // read only
+	ManagerID      *int   // manager when the cycle was launched
+	Status         ReviewStatus
+	SelfRating     *float64 // average rating of the self review, read only
+	PeerRating     *float64 // average rating over submitted peer reviews, read only
+	ManagerRating  *float64 // average rating of the manager review, read only
+	Submissions    []ReviewSubmission
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	CompletedAt    *time.Time
+	LockedAt       *time.Time
+}
+
+// ReviewSubmission is one reviewer's part of a review. Answers are empty until it is submitted.
+type ReviewSubmission struct {
+	ID           int
+	ReviewID     int
+	ReviewerID   int
+	ReviewerName string // read only
+	Role         ReviewerRole
+	SubmittedAt  *time.Time
+	Answers      []ReviewAnswer
+	CreatedAt    time.Time
+}
+
+type ReviewAnswer struct {
+	QuestionID int
+	Rating     *int // rating questions only
+	Comment    string
+}
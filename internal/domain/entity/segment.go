@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// EmployeeSegment is a saved, named employee list filter. Its members are whoever matches the
+// filter when the segment is used, which makes segments targets for actions on groups of employees.
+type EmployeeSegment struct {
+	ID          int
+	Name        string
+	Description string
+	Filter      EmployeeFilter // custom field values are kept as given, not parsed
+	CreatedBy   *int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TagCount is a tag in use and how many employees have it.
+type TagCount struct {
+	Tag       string
+	Employees int
+}
@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeOfDay is a wall clock time in minutes after midnight.
+type TimeOfDay int
+
+func (t TimeOfDay) IsValid() bool {
+	return t >= 0 && t < 24*60
+}
+
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", t/60, t%60)
+}
+
+// ShiftTemplate is a reusable shift pattern. A template ending at or before its start time
+// runs overnight into the next day.
+type ShiftTemplate struct {
+	ID           int
+	Name         string
+	StartTime    TimeOfDay
+	EndTime      TimeOfDay
+	BreakMinutes int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Duration is the length of the shift, breaks included.
+func (t ShiftTemplate) Duration() time.Duration {
+	minutes := int(t.EndTime - t.StartTime)
+	if minutes <= 0 {
+		minutes += 24 * 60
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// ShiftOn plans the template on date for an employee, starting at its start time on the clocks of
+// zone, the time zone of the employee's location.
+func (t ShiftTemplate) ShiftOn(employeeID int, date time.Time, zone *time.Location) *Shift {
+	year, month, day := date.Date()
+	startsAt := time.Date(year, month, day, 0, int(t.StartTime), 0, 0, zone).UTC()
+	return &Shift{
+		EmployeeID:        employeeID,
+		ShiftTemplateID:   t.ID,
+		ShiftTemplateName: t.Name,
+		Date:              date,
+		StartsAt:          startsAt,
+		EndsAt:            startsAt.Add(t.Duration()),
+		BreakMinutes:      t.BreakMinutes,
+	}
+}
+
+// Shift is an employee rostered on a template for one day. Date is the day the shift starts;
+// an overnight shift ends the next day.
+type Shift struct {
+	ID                int
+	EmployeeID        int
+	ShiftTemplateID   int
+	ShiftTemplateName string // read only, from the template
+	Date              time.Time
+	StartsAt          time.Time
+	EndsAt            time.Time
+	BreakMinutes      int
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// Overlaps reports whether the shift and the span from start to end share any time.
+func (s Shift) Overlaps(start, end time.Time) bool {
+	return s.StartsAt.Before(end) && start.Before(s.EndsAt)
+}
@@ -0,0 +1,72 @@
+package entity
+
+import "time"
+
+// SkillCategory groups related skills in the skills taxonomy.
+type SkillCategory struct {
+	ID        int
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Skill struct {
+	ID           int
+	CategoryID   int
+	CategoryName string // read only
+	Name         string
+	Description  string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// ProficiencyLevel rates how well someone masters a skill.
+type ProficiencyLevel int
+
+const (
+	ProficiencyNovice       ProficiencyLevel = 1
+	ProficiencyBeginner     ProficiencyLevel = 2
+	ProficiencyIntermediate ProficiencyLevel = 3
+	ProficiencyAdvanced     ProficiencyLevel = 4
+	ProficiencyExpert       ProficiencyLevel = 5
+)
+
+func (l ProficiencyLevel) IsValid() bool {
+	return l >= ProficiencyNovice && l <= ProficiencyExpert
+}
+
+func (l ProficiencyLevel) String() string {
+	switch l {
+	case ProficiencyNovice:
+		return "novice"
+	case ProficiencyBeginner:
+		return "beginner"
+	case ProficiencyIntermediate:
+		return "intermediate"
+	case ProficiencyAdvanced:
+		return "advanced"
+	case ProficiencyExpert:
+		return "expert"
+	}
+	return "unknown"
+}
+
+// EmployeeSkill is an employee's proficiency in a skill.
+type EmployeeSkill struct {
+	EmployeeID     int
+	EmployeeName   string // read only
+	Position       string // title of the employee's position, read only
+	SkillID        int
+	SkillName      string // read only
+	CategoryName   string // read only
+	Level          ProficiencyLevel
+	LastVerifiedOn *time.Time // nil when the level was never verified
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// SkillRequirement asks for a skill at MinLevel or above.
+type SkillRequirement struct {
+	SkillID  int
+	MinLevel ProficiencyLevel
+}
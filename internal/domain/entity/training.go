@@ -0,0 +1,65 @@
+package entity
+
+import "time"
+
+type TrainingCourse struct {
+	ID            int
+	Name          string
+	Description   string
+	Provider      string
+	DurationHours float64
+	// CertificationValidMonths makes completing the course issue a certification valid for that
+	// many months, 0 for one that never expires. Courses without it issue none.
+	CertificationValidMonths *int
+	CreatedAt                time.Time
+	UpdatedAt                time.Time
+}
+
+// Certifies reports whether completing the course issues a certification.
+func (c *TrainingCourse) Certifies() bool {
+	return c.CertificationValidMonths != nil
+}
+
+type EnrollmentStatus string
+
+const (
+	EnrollmentStatusEnrolled  EnrollmentStatus = "enrolled"
+	EnrollmentStatusCompleted EnrollmentStatus = "completed"
+	EnrollmentStatusCancelled EnrollmentStatus = "cancelled"
+)
+
+func (s EnrollmentStatus) IsValid() bool {
+	switch s {
+	case EnrollmentStatusEnrolled, EnrollmentStatusCompleted, EnrollmentStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// TrainingEnrollment is an employee taking a course. An employee has at most one open enrollment
+// per course.
+type TrainingEnrollment struct {
+	ID              int
+	CourseID        int
+	CourseName      string // read only
+	EmployeeID      int
+	EmployeeName    string // read only
+	Status          EnrollmentStatus
+	EnrolledOn      time.Time
+	DueOn           *time.Time
+	CompletedOn     *time.Time
+	CertificationID *int // read only, the certification issued on completion
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// IsOverdue reports whether the enrollment is still open on a day after its due date.
+func (e *TrainingEnrollment) IsOverdue(asOf time.Time) bool {
+	return e.Status == EnrollmentStatusEnrolled && e.DueOn != nil && !asOf.Before(e.DueOn.AddDate(0, 0, 1))
+}
+
+// EnrollmentFilter narrows enrollment lists; zero fields match everything.
+type EnrollmentFilter struct {
+	CourseID   int
+	EmployeeID int
+}
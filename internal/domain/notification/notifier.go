@@ -0,0 +1,14 @@
+package notification
+
+import "context"
+
+// Message is a notice for people, addressed by email.
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, message Message) error
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type ApprovalRepository interface {
+	// CreateWorkflow inserts the workflow with its steps.
+	CreateWorkflow(ctx context.Context, workflow *entity.ApprovalWorkflow) (*entity.ApprovalWorkflow, error)
+	// GetWorkflowById returns the workflow with its steps.
+	GetWorkflowById(ctx context.Context, id int) (*entity.ApprovalWorkflow, error)
+	// GetWorkflowByType returns the workflow of requestType with its steps, or nil when there is
+	// none.
+	GetWorkflowByType(ctx context.Context, requestType entity.ApprovalType) (*entity.ApprovalWorkflow, error)
+	GetWorkflows(ctx context.Context) ([]*entity.ApprovalWorkflow, error)
+	// UpdateWorkflow stores the workflow and replaces its steps. Requests already submitted keep
+	// their tasks. It returns nil when the workflow does not exist.
+	UpdateWorkflow(ctx context.Context, workflow *entity.ApprovalWorkflow) (*entity.ApprovalWorkflow, error)
+	DeleteWorkflow(ctx context.Context, id int) error
+
+	// CreateRequest inserts the request with its tasks. Pending tasks with a timeout become due
+	// that many hours from now.
+	CreateRequest(ctx context.Context, request *entity.ApprovalRequest) (*entity.ApprovalRequest, error)
+	// GetRequestById returns the request with its tasks.
+	GetRequestById(ctx context.Context, id int) (*entity.ApprovalRequest, error)
+	// GetRequests returns the requests matching filter with their tasks, latest first.
+	GetRequests(ctx context.Context, filter entity.ApprovalRequestFilter) ([]*entity.ApprovalRequest, error)
+	// CancelRequest cancels the pending request and its open tasks and returns it.
+	CancelRequest(ctx context.Context, id int) (*entity.ApprovalRequest, error)
+	// SetRequestFailed records that the approved request's change could not be made.
+	SetRequestFailed(ctx context.Context, id int, reason string) error
+
+	GetTaskById(ctx context.Context, id int) (*entity.ApprovalTask, error)
+	// GetInbox returns the tasks approverID may decide on the day of asOf, oldest first: pending
+	// tasks of the current stage assigned to them or, for position tasks nobody was assigned to,
+	// to the position they hold, and the same for everyone who delegated to them on that day.
+	// Tasks of requests about approverID themselves are left out.
+	GetInbox(ctx context.Context, approverID int, asOf time.Time) ([]*entity.ApprovalTask, error)
+	// DecideTask records the decision on the pending task and moves its request along: a rejection
+	// rejects the request, and the last approval of a stage starts the next one or, after the last
+	// stage, approves the request. It returns the request.
+	DecideTask(ctx context.Context, decision entity.ApprovalDecision) (*entity.ApprovalRequest, error)
+	// GetOverdueTasks returns the pending tasks of the current stages that were due by asOf.
+	GetOverdueTasks(ctx context.Context, asOf time.Time) ([]*entity.ApprovalTask, error)
+	// EscalateTask hands the pending task to assigneeID and restarts its timeout. With a nil
+	// assigneeID the task keeps its assignee and stops timing out.
+	EscalateTask(ctx context.Context, id int, assigneeID *int) (*entity.ApprovalTask, error)
+
+	CreateDelegation(ctx context.Context, delegation *entity.ApprovalDelegation) (*entity.ApprovalDelegation, error)
+	GetDelegationById(ctx context.Context, id int) (*entity.ApprovalDelegation, error)
+	// GetDelegations returns the delegations made by delegatorID, latest first.
+	GetDelegations(ctx context.Context, delegatorID int) ([]*entity.ApprovalDelegation, error)
+	DeleteDelegation(ctx context.Context, id int) error
+}
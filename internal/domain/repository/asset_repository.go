@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type AssetRepository interface {
+	CreateAsset(ctx context.Context, asset *entity.Asset) (*entity.Asset, error)
+	GetAssetById(ctx context.Context, id int) (*entity.Asset, error)
+	// GetAssets returns the assets matching filter, ordered by name.
+	GetAssets(ctx context.Context, filter entity.AssetFilter) ([]*entity.Asset, error)
+	UpdateAsset(ctx context.Context, asset *entity.Asset) (*entity.Asset, error)
+	DeleteAsset(ctx context.Context, id int) error
+
+	// AssignAsset opens an assignment of the asset to the employee.
+	AssignAsset(ctx context.Context, assignment *entity.AssetAssignment) (*entity.AssetAssignment, error)
+	// ReturnAsset closes the asset's open assignment on assignment.ReturnedOn and sets the asset to
+	// status in the same transaction. It returns nil when the asset has no open assignment.
+	ReturnAsset(ctx context.Context, assignment *entity.AssetAssignment, status entity.AssetStatus) (*entity.AssetAssignment, error)
+	// GetAssignments returns the assignments matching filter, latest first.
+	GetAssignments(ctx context.Context, filter entity.AssetAssignmentFilter) ([]*entity.AssetAssignment, error)
+}
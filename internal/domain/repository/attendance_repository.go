@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type AttendanceRepository interface {
+	// CreateAttendanceEntry fails with ErrAlreadyClockedIn when an open entry is created while the
+	// employee already has one.
+	CreateAttendanceEntry(ctx context.Context, entry *entity.AttendanceEntry) (*entity.AttendanceEntry, error)
+	GetAttendanceEntryById(ctx context.Context, employeeID, id int) (*entity.AttendanceEntry, error)
+	// GetOpenAttendanceEntry returns nil when the employee is not clocked in.
+	GetOpenAttendanceEntry(ctx context.Context, employeeID int) (*entity.AttendanceEntry, error)
+	// GetAttendanceEntries returns the entries clocked in from start (inclusive) to end (exclusive), by clock-in.
+	GetAttendanceEntries(ctx context.Context, employeeID int, start, end time.Time) ([]*entity.AttendanceEntry, error)
+	// GetOverlappingAttendanceEntries returns the entries, other than excludeID, that overlap the
+	// span from start to end. A nil end or an open entry extends indefinitely.
+	GetOverlappingAttendanceEntries(ctx context.Context, employeeID, excludeID int, start time.Time,
+		end *time.Time) ([]*entity.AttendanceEntry, error)
+	// UpdateAttendanceEntry stores the times and note of an entry, returning nil when it does not exist.
+	UpdateAttendanceEntry(ctx context.Context, entry *entity.AttendanceEntry) (*entity.AttendanceEntry, error)
+	// GetOpenAttendanceEntriesBefore returns every open entry clocked in before cutoff.
+	GetOpenAttendanceEntriesBefore(ctx context.Context, cutoff time.Time) ([]*entity.AttendanceEntry, error)
+
+	// GetTimesheet returns nil when the week has not been submitted.
+	GetTimesheet(ctx context.Context, employeeID int, weekStart time.Time) (*entity.Timesheet, error)
+	GetTimesheets(ctx context.Context, employeeID int) ([]*entity.Timesheet, error)
+	// SubmitTimesheet stores a submitted timesheet, replacing a rejected one for the same week.
+	// It returns nil when the week is already submitted or approved.
+	SubmitTimesheet(ctx context.Context, timesheet *entity.Timesheet) (*entity.Timesheet, error)
+	// DecideTimesheet approves or rejects a submitted timesheet, returning nil when it is no longer submitted.
+	DecideTimesheet(ctx context.Context, timesheet *entity.Timesheet) (*entity.Timesheet, error)
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type BenefitRepository interface {
+	// CreatePlan stores a plan with the locations it is limited to.
+	CreatePlan(ctx context.Context, plan *entity.BenefitPlan) (*entity.BenefitPlan, error)
+	GetPlanById(ctx context.Context, id int) (*entity.BenefitPlan, error)
+	GetAllPlans(ctx context.Context) ([]*entity.BenefitPlan, error)
+	UpdatePlan(ctx context.Context, plan *entity.BenefitPlan) (*entity.BenefitPlan, error)
+	// DeletePlan fails with ErrBenefitPlanInUse when employees were ever enrolled in the plan.
+	DeletePlan(ctx context.Context, id int) error
+
+	CreateWindow(ctx context.Context, window *entity.BenefitEnrollmentWindow) (*entity.BenefitEnrollmentWindow, error)
+	GetWindowById(ctx context.Context, id int) (*entity.BenefitEnrollmentWindow, error)
+	// GetWindows returns the enrollment windows, latest first.
+	GetWindows(ctx context.Context) ([]*entity.BenefitEnrollmentWindow, error)
+	// GetOverlappingWindow returns a window sharing a day with from to to, or nil.
+	GetOverlappingWindow(ctx context.Context, from, to time.Time) (*entity.BenefitEnrollmentWindow, error)
+	DeleteWindow(ctx context.Context, id int) error
+
+	CreateDependent(ctx context.Context, dependent *entity.Dependent) (*entity.Dependent, error)
+	GetDependentById(ctx context.Context, employeeID, id int) (*entity.Dependent, error)
+	GetDependents(ctx context.Context, employeeID int) ([]*entity.Dependent, error)
+	UpdateDependent(ctx context.Context, dependent *entity.Dependent) (*entity.Dependent, error)
+	// DeleteDependent deletes a dependent, who is no longer covered by any enrollment.
+	DeleteDependent(ctx context.Context, employeeID, id int) error
+
+	// CreateEnrollment stores an enrollment with its dependents. It fails with
+	// ErrBenefitAlreadyEnrolled when the employee is already covered by the plan.
+	CreateEnrollment(ctx context.Context, enrollment *entity.BenefitEnrollment) (*entity.BenefitEnrollment, error)
+	GetEnrollmentById(ctx context.Context, employeeID, id int) (*entity.BenefitEnrollment, error)
+	// GetEnrollments returns the employee's enrollments with their plans, latest coverage first.
+	GetEnrollments(ctx context.Context, employeeID int) ([]*entity.BenefitEnrollment, error)
+	// EndEnrollment sets the last day of coverage of an open enrollment, returning nil when it has
+	// already ended.
+	EndEnrollment(ctx context.Context, employeeID, id int, coverageEndsOn time.Time) (*entity.BenefitEnrollment, error)
+	// DeleteEnrollment removes an enrollment whose coverage has not started yet.
+	DeleteEnrollment(ctx context.Context, employeeID, id int) error
+
+	// GetDeductions returns the deductions of every enrollment covering some day of from to to for an
+	// employee employed during it, by employee name. employeeID narrows them to one employee when
+	// positive.
+	GetDeductions(ctx context.Context, from, to time.Time, employeeID int) ([]*entity.BenefitDeduction, error)
+}
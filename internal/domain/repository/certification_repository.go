@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type CertificationRepository interface {
+	CreateCertification(ctx context.Context, certification *entity.Certification) (*entity.Certification, error)
+	GetCertificationById(ctx context.Context, id int) (*entity.Certification, error)
+	// GetCertifications returns the certifications matching filter, soonest to expire first and
+	// those that never expire last.
+	GetCertifications(ctx context.Context, filter entity.CertificationFilter) ([]*entity.Certification, error)
+	// UpdateCertification stores the certification's details, clearing the expiry notice when the
+	// expiry date changes. It returns nil when the certification does not exist.
+	UpdateCertification(ctx context.Context, certification *entity.Certification) (*entity.Certification, error)
+	DeleteCertification(ctx context.Context, id int) error
+	// MarkExpiryNotified records that the certification's expiry notice went out on notifiedOn.
+	MarkExpiryNotified(ctx context.Context, id int, notifiedOn time.Time) error
+
+	AddEvidence(ctx context.Context, evidence *entity.CertificationEvidence) (*entity.CertificationEvidence, error)
+	// GetEvidence lists a certification's evidence without the file contents, oldest first.
+	GetEvidence(ctx context.Context, certificationID int) ([]*entity.CertificationEvidence, error)
+	// GetEvidenceById returns the evidence with its file contents.
+	GetEvidenceById(ctx context.Context, certificationID, id int) (*entity.CertificationEvidence, error)
+	DeleteEvidence(ctx context.Context, certificationID, id int) error
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type ChecklistRepository interface {
+	// CreateTemplate inserts the template with its tasks.
+	CreateTemplate(ctx context.Context, template *entity.ChecklistTemplate) (*entity.ChecklistTemplate, error)
+	// GetTemplateById returns the template with its tasks.
+	GetTemplateById(ctx context.Context, id int) (*entity.ChecklistTemplate, error)
+	// GetTemplates returns the templates of kind, or of both kinds when kind is empty, with their
+	// tasks.
+	GetTemplates(ctx context.Context, kind entity.ChecklistKind) ([]*entity.ChecklistTemplate, error)
+	// UpdateTemplate stores the template and replaces its tasks. It returns nil when the template
+	// does not exist.
+	UpdateTemplate(ctx context.Context, template *entity.ChecklistTemplate) (*entity.ChecklistTemplate, error)
+	DeleteTemplate(ctx context.Context, id int) error
+	// FindTemplate returns the most specific template of kind for an employee of the position and
+	// department, or nil when none applies.
+	FindTemplate(ctx context.Context, kind entity.ChecklistKind, positionID int, departmentID *int) (*entity.ChecklistTemplate, error)
+
+	// CreateChecklist inserts the checklist with its tasks.
+	CreateChecklist(ctx context.Context, checklist *entity.Checklist) (*entity.Checklist, error)
+	// GetChecklistById returns the checklist with its tasks.
+	GetChecklistById(ctx context.Context, id int) (*entity.Checklist, error)
+	// GetChecklists returns the checklists matching filter with their tasks, latest first.
+	GetChecklists(ctx context.Context, filter entity.ChecklistFilter) ([]*entity.Checklist, error)
+
+	GetTaskById(ctx context.Context, id int) (*entity.ChecklistTask, error)
+	// GetOwnerTasks returns the open tasks assigned to ownerID, by due date.
+	GetOwnerTasks(ctx context.Context, ownerID int) ([]*entity.ChecklistTask, error)
+	// UpdateTask stores the task's owner and due date. It returns nil when the task does not exist.
+	UpdateTask(ctx context.Context, task *entity.ChecklistTask) (*entity.ChecklistTask, error)
+	// SetTaskCompletion marks the task completed at completedAt, or open again when it is nil, and
+	// completes or reopens its checklist to match. It returns the checklist, or
+	// ErrEmployeeHoldsAssets when completing the last task of an offboarding whose employee still
+	// holds assets.
+	SetTaskCompletion(ctx context.Context, id int, completedAt *time.Time) (*entity.Checklist, error)
+}
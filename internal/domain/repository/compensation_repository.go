@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type CompensationRepository interface {
+	// CreateScheduledChange stores a future-dated change without touching the employee.
+	CreateScheduledChange(ctx context.Context, change *entity.CompensationChange) (*entity.CompensationChange, error)
+
+	// ApplyChange records change as applied and updates the employee's salary, and whether it is
+	// out of band, in one transaction. It returns nil when the employee does not exist.
+	ApplyChange(ctx context.Context, change *entity.CompensationChange, salaryOutOfBand bool) (*entity.CompensationChange, error)
+
+	// ApplyScheduledChange applies a previously scheduled change like ApplyChange. It returns nil
+	// when the change is no longer scheduled.
+	ApplyScheduledChange(ctx context.Context, id int, salaryOutOfBand bool) (*entity.CompensationChange, error)
+
+	GetDueScheduledChanges(ctx context.Context, asOf time.Time) ([]*entity.CompensationChange, error)
+	GetChangesByEmployeeId(ctx context.Context, employeeID int) ([]*entity.CompensationChange, error)
+	CancelScheduledChange(ctx context.Context, employeeID, id int) error
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type CustomFieldRepository interface {
+	CreateDefinition(ctx context.Context, definition *entity.CustomFieldDefinition) (*entity.CustomFieldDefinition, error)
+	GetDefinitionById(ctx context.Context, id int) (*entity.CustomFieldDefinition, error)
+	GetAllDefinitions(ctx context.Context) ([]*entity.CustomFieldDefinition, error)
+	// UpdateDefinition changes everything but the key and the type of a definition.
+	UpdateDefinition(ctx context.Context, definition *entity.CustomFieldDefinition) (*entity.CustomFieldDefinition, error)
+	// DeleteDefinition removes a definition along with every employee's value of it.
+	DeleteDefinition(ctx context.Context, id int) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type DepartmentRepository interface {
+	CreateDepartment(ctx context.Context, department *entity.Department) (*entity.Department, error)
+	GetDepartmentById(ctx context.Context, id int) (*entity.Department, error)
+	GetAllDepartments(ctx context.Context) ([]*entity.Department, error)
+	UpdateDepartment(ctx context.Context, department *entity.Department) (*entity.Department, error)
+	DeleteDepartment(ctx context.Context, id int) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type DocumentRepository interface {
+	CreateDocument(ctx context.Context, document *entity.EmployeeDocument) (*entity.EmployeeDocument, error)
+	// GetDocuments returns the employee's documents, latest first.
+	GetDocuments(ctx context.Context, employeeID int) ([]*entity.EmployeeDocument, error)
+	GetDocumentById(ctx context.Context, employeeID, id int) (*entity.EmployeeDocument, error)
+	DeleteDocument(ctx context.Context, employeeID, id int) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type EmergencyContactRepository interface {
+	CreateEmergencyContact(ctx context.Context, contact *entity.EmergencyContact) (*entity.EmergencyContact, error)
+	GetEmergencyContactById(ctx context.Context, employeeID, id int) (*entity.EmergencyContact, error)
+	GetEmergencyContactsByEmployeeId(ctx context.Context, employeeID int) ([]*entity.EmergencyContact, error)
+	UpdateEmergencyContact(ctx context.Context, contact *entity.EmergencyContact) (*entity.EmergencyContact, error)
+	DeleteEmergencyContact(ctx context.Context, employeeID, id int) error
+}
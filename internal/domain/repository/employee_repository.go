@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
 )
@@ -9,7 +10,17 @@ import (
 type EmployeeRepository interface {
 	CreateEmployee(ctx context.Context, employee *entity.Employee) (*entity.Employee, error)
 	GetEmployeeById(ctx context.Context, id int) (*entity.Employee, error)
-	GetAllEmployees(ctx context.Context) ([]*entity.Employee, error)
-	UpdateEmployee(ctx context.Context, employee *entity.Employee) (*entity.Employee, error)
+	GetAllEmployees(ctx context.Context, filter entity.EmployeeFilter) ([]*entity.Employee, error)
+	// GetReports returns everyone reporting to managerID directly or through other managers.
+	GetReports(ctx context.Context, managerID int) ([]*entity.Employee, error)
+	// UpdateEmployee stores employee, except for its salary and pay period. A change of location
+	// is added to the location timeline as of locationDate.
+	UpdateEmployee(ctx context.Context, employee *entity.Employee, locationDate time.Time) (*entity.Employee, error)
 	DeleteEmployee(ctx context.Context, id int) error
+
+	// UpdateEmploymentStatus stores the status-related fields of employee and appends change to the
+	// status history, provided the employee is still in change.FromStatus. It returns nil when no
+	// employee matched.
+	UpdateEmploymentStatus(ctx context.Context, employee *entity.Employee, change *entity.EmploymentStatusChange) (*entity.Employee, error)
+	GetEmploymentStatusChanges(ctx context.Context, employeeID int) ([]*entity.EmploymentStatusChange, error)
 }
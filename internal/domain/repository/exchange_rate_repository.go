@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type ExchangeRateRepository interface {
+	CreateExchangeRate(ctx context.Context, rate *entity.ExchangeRate) (*entity.ExchangeRate, error)
+	GetExchangeRates(ctx context.Context) ([]*entity.ExchangeRate, error)
+
+	// GetLatestRate returns the newest rate for the pair effective on or before asOf, or nil when none exists.
+	GetLatestRate(ctx context.Context, baseCurrency, quoteCurrency string, asOf time.Time) (*entity.ExchangeRate, error)
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type ExpenseRepository interface {
+	CreateCategory(ctx context.Context, category *entity.ExpenseCategory) (*entity.ExpenseCategory, error)
+	GetCategoryById(ctx context.Context, id int) (*entity.ExpenseCategory, error)
+	GetAllCategories(ctx context.Context) ([]*entity.ExpenseCategory, error)
+	UpdateCategory(ctx context.Context, category *entity.ExpenseCategory) (*entity.ExpenseCategory, error)
+	DeleteCategory(ctx context.Context, id int) error
+
+	// CreateClaim stores a draft claim with its items.
+	CreateClaim(ctx context.Context, claim *entity.ExpenseClaim) (*entity.ExpenseClaim, error)
+	// GetClaimById returns a claim of the employee with its items.
+	GetClaimById(ctx context.Context, employeeID, id int) (*entity.ExpenseClaim, error)
+	// GetClaims returns the claims matching filter, without their items, latest first.
+	GetClaims(ctx context.Context, filter entity.ExpenseClaimFilter) ([]*entity.ExpenseClaim, error)
+	// UpdateClaim replaces the title, currency and items of an editable claim and makes it a draft
+	// again. It returns nil when the claim is no longer editable.
+	UpdateClaim(ctx context.Context, claim *entity.ExpenseClaim) (*entity.ExpenseClaim, error)
+	// UpdateClaimStatus writes the claim's status with its submission, decision and reimbursement
+	// details, provided the claim is still in from. It returns nil otherwise.
+	UpdateClaimStatus(ctx context.Context, claim *entity.ExpenseClaim, from entity.ExpenseClaimStatus) (*entity.ExpenseClaim, error)
+	// DeleteClaim deletes an editable claim with its items and receipts. It fails with
+	// ErrExpenseClaimNotEditable for other claims.
+	DeleteClaim(ctx context.Context, employeeID, id int) error
+
+	CreateReceipt(ctx context.Context, receipt *entity.ExpenseReceipt) (*entity.ExpenseReceipt, error)
+	// GetReceipts returns the receipts of a claim, oldest first.
+	GetReceipts(ctx context.Context, claimID int) ([]*entity.ExpenseReceipt, error)
+	GetReceiptById(ctx context.Context, claimID, id int) (*entity.ExpenseReceipt, error)
+	DeleteReceipt(ctx context.Context, claimID, id int) error
+
+	// GetReport totals the approved and reimbursed expenses matching filter by department, month
+	// and currency.
+	GetReport(ctx context.Context, filter entity.ExpenseReportFilter) ([]*entity.ExpenseReportRow, error)
+}
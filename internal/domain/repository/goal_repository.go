@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type GoalRepository interface {
+	// CreateGoal inserts the goal with its key results, starting each at its start value.
+	CreateGoal(ctx context.Context, goal *entity.Goal) (*entity.Goal, error)
+	// GetGoalById returns the goal with its key results.
+	GetGoalById(ctx context.Context, id int) (*entity.Goal, error)
+	// GetGoals returns the goals matching filter with their key results, ordered by due date.
+	GetGoals(ctx context.Context, filter entity.GoalFilter) ([]*entity.Goal, error)
+	// UpdateGoal stores the goal's details, leaving its owner and key results alone. It returns nil
+	// when the goal does not exist.
+	UpdateGoal(ctx context.Context, goal *entity.Goal) (*entity.Goal, error)
+	DeleteGoal(ctx context.Context, id int) error
+
+	AddKeyResult(ctx context.Context, keyResult *entity.KeyResult) (*entity.KeyResult, error)
+	// CreateCheckIn records the check-in and moves its key result to the checked-in value.
+	CreateCheckIn(ctx context.Context, checkIn *entity.GoalCheckIn) (*entity.GoalCheckIn, error)
+	// GetCheckIns returns the check-ins of a goal's key results, latest first.
+	GetCheckIns(ctx context.Context, goalID int) ([]*entity.GoalCheckIn, error)
+}
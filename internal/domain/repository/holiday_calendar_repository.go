@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type HolidayCalendarRepository interface {
+	CreateHolidayCalendar(ctx context.Context, calendar *entity.HolidayCalendar) (*entity.HolidayCalendar, error)
+	GetHolidayCalendarById(ctx context.Context, id int) (*entity.HolidayCalendar, error)
+	GetAllHolidayCalendars(ctx context.Context) ([]*entity.HolidayCalendar, error)
+	UpdateHolidayCalendar(ctx context.Context, calendar *entity.HolidayCalendar) (*entity.HolidayCalendar, error)
+	DeleteHolidayCalendar(ctx context.Context, id int) error
+
+	CreateHoliday(ctx context.Context, holiday *entity.Holiday) (*entity.Holiday, error)
+	// GetHolidays returns the holidays of a calendar dated from start to end inclusive, by date.
+	GetHolidays(ctx context.Context, calendarID int, start, end time.Time) ([]*entity.Holiday, error)
+	DeleteHoliday(ctx context.Context, calendarID, id int) error
+	// UpsertHolidays adds holidays to a calendar in one transaction, renaming those already on
+	// the same date. It returns the number of holidays written.
+	UpsertHolidays(ctx context.Context, calendarID int, holidays []*entity.Holiday) (int, error)
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type LeaveRepository interface {
+	CreateLeaveType(ctx context.Context, leaveType *entity.LeaveType) (*entity.LeaveType, error)
+	GetLeaveTypeById(ctx context.Context, id int) (*entity.LeaveType, error)
+	GetAllLeaveTypes(ctx context.Context) ([]*entity.LeaveType, error)
+	UpdateLeaveType(ctx context.Context, leaveType *entity.LeaveType) (*entity.LeaveType, error)
+	DeleteLeaveType(ctx context.Context, id int) error
+
+	// GetLeaveBalance returns nil when the employee has no balance for the type and year yet.
+	GetLeaveBalance(ctx context.Context, employeeID, leaveTypeID, year int) (*entity.LeaveBalance, error)
+	GetLeaveBalances(ctx context.Context, employeeID, year int) ([]*entity.LeaveBalance, error)
+	// UpsertLeaveBalance stores the accrued and carried over days of a balance, keeping its used days.
+	UpsertLeaveBalance(ctx context.Context, balance *entity.LeaveBalance) (*entity.LeaveBalance, error)
+
+	// CreateLeaveRequest fails with ErrLeaveRequestOverlaps when the employee already has a pending
+	// or approved request overlapping the same dates.
+	CreateLeaveRequest(ctx context.Context, request *entity.LeaveRequest) (*entity.LeaveRequest, error)
+	GetLeaveRequestById(ctx context.Context, employeeID, id int) (*entity.LeaveRequest, error)
+	GetLeaveRequestsByEmployeeId(ctx context.Context, employeeID int) ([]*entity.LeaveRequest, error)
+
+	// SetLeaveRequestApproval links a pending request to the approval request deciding it, or hands
+	// it back to the manager when approvalRequestID is nil. It returns nil when the request is no
+	// longer pending.
+	SetLeaveRequestApproval(ctx context.Context, id int, approvalRequestID *int) (*entity.LeaveRequest, error)
+	// ApproveLeaveRequest approves a pending request and, when deductBalance is set, deducts its
+	// days from the balance of the request's year. It fails with ErrInsufficientLeaveBalance when
+	// the balance does not cover the request and returns nil when the request is no longer pending.
+	ApproveLeaveRequest(ctx context.Context, request *entity.LeaveRequest, deductBalance bool) (*entity.LeaveRequest, error)
+	// RejectLeaveRequest rejects a pending request, returning nil when it is no longer pending.
+	RejectLeaveRequest(ctx context.Context, request *entity.LeaveRequest) (*entity.LeaveRequest, error)
+	// CancelLeaveRequest cancels a pending or approved request. Days deducted by an approval are
+	// given back when restoreBalance is set. It returns nil when the request was already closed.
+	CancelLeaveRequest(ctx context.Context, request *entity.LeaveRequest, restoreBalance bool) (*entity.LeaveRequest, error)
+
+	// GetLeaveRequestsToStart returns approved requests covering asOf that have not put the employee on leave yet.
+	GetLeaveRequestsToStart(ctx context.Context, asOf time.Time) ([]*entity.LeaveRequest, error)
+	// GetLeaveRequestsToEnd returns requests that put the employee on leave and have since ended or been cancelled.
+	GetLeaveRequestsToEnd(ctx context.Context, asOf time.Time) ([]*entity.LeaveRequest, error)
+	SetLeaveStatusApplied(ctx context.Context, id int, applied bool) error
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type LocationRepository interface {
+	CreateLocation(ctx context.Context, location *entity.Location) (*entity.Location, error)
+	GetLocationById(ctx context.Context, id int) (*entity.Location, error)
+	GetAllLocations(ctx context.Context) ([]*entity.Location, error)
+	UpdateLocation(ctx context.Context, location *entity.Location) (*entity.Location, error)
+	DeleteLocation(ctx context.Context, id int) error
+
+	// CreateScheduledAssignment stores a future-dated assignment without touching the employee.
+	CreateScheduledAssignment(ctx context.Context, assignment *entity.LocationAssignment) (*entity.LocationAssignment, error)
+	// ApplyAssignment records assignment as applied and moves the employee to its location in one
+	// transaction. It returns nil when the employee does not exist.
+	ApplyAssignment(ctx context.Context, assignment *entity.LocationAssignment) (*entity.LocationAssignment, error)
+	// ApplyScheduledAssignment applies a previously scheduled assignment. It returns nil when the
+	// assignment is no longer scheduled.
+	ApplyScheduledAssignment(ctx context.Context, id int) (*entity.LocationAssignment, error)
+	GetDueScheduledAssignments(ctx context.Context, asOf time.Time) ([]*entity.LocationAssignment, error)
+	GetAssignmentsByEmployeeId(ctx context.Context, employeeID int) ([]*entity.LocationAssignment, error)
+	CancelScheduledAssignment(ctx context.Context, employeeID, id int) error
+}
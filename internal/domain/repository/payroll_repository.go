@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type PayrollRepository interface {
+	CreatePayrollRule(ctx context.Context, rule *entity.PayrollRule) (*entity.PayrollRule, error)
+	GetPayrollRuleById(ctx context.Context, id int) (*entity.PayrollRule, error)
+	GetAllPayrollRules(ctx context.Context) ([]*entity.PayrollRule, error)
+	GetActivePayrollRules(ctx context.Context) ([]*entity.PayrollRule, error)
+	UpdatePayrollRule(ctx context.Context, rule *entity.PayrollRule) (*entity.PayrollRule, error)
+	DeletePayrollRule(ctx context.Context, id int) error
+
+	CreatePayrollPeriod(ctx context.Context, period *entity.PayrollPeriod) (*entity.PayrollPeriod, error)
+	GetPayrollPeriodById(ctx context.Context, id int) (*entity.PayrollPeriod, error)
+	GetAllPayrollPeriods(ctx context.Context) ([]*entity.PayrollPeriod, error)
+
+	// CreatePayrollRun stores a draft run of a period with its payslips, which hold the expense
+	// claims they pay back. It fails with ErrPayrollRunAlreadyExists when the period already has
+	// a run, and with ErrPayrollExpenseClaimTaken when a claim can no longer be held.
+	CreatePayrollRun(ctx context.Context, run *entity.PayrollRun, payslips []*entity.Payslip) (*entity.PayrollRun, error)
+	GetPayrollRunById(ctx context.Context, id int) (*entity.PayrollRun, error)
+	GetAllPayrollRuns(ctx context.Context) ([]*entity.PayrollRun, error)
+	// ReplacePayslips swaps the payslips of a draft run, and the expense claims it holds with them,
+	// returning nil when the run is not a draft. It fails like CreatePayrollRun.
+	ReplacePayslips(ctx context.Context, runID int, payslips []*entity.Payslip) (*entity.PayrollRun, error)
+	// UpdatePayrollRunStatus moves a run from one status to another, returning nil when the run
+	// is no longer in from. Locking a run reimburses the expense claims it holds as of its pay date.
+	UpdatePayrollRunStatus(ctx context.Context, id int, from, to entity.PayrollRunStatus) (*entity.PayrollRun, error)
+	// DeletePayrollRun deletes a draft run. It fails with ErrPayrollRunNotDraft for other runs.
+	DeletePayrollRun(ctx context.Context, id int) error
+
+	// GetPayslips returns the payslips of a run, without their lines, by employee name.
+	GetPayslips(ctx context.Context, runID int) ([]*entity.Payslip, error)
+	// GetPayslipById returns a payslip of a run with its lines.
+	GetPayslipById(ctx context.Context, runID, id int) (*entity.Payslip, error)
+	// GetEmployeePayslips returns the employee's payslips of finalized and locked runs, without
+	// their lines, latest period first.
+	GetEmployeePayslips(ctx context.Context, employeeID int) ([]*entity.Payslip, error)
+}
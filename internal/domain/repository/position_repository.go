@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type PositionRepository interface {
+	CreatePosition(ctx context.Context, position *entity.Position) (*entity.Position, error)
+	GetPositionById(ctx context.Context, id int) (*entity.Position, error)
+	GetAllPositions(ctx context.Context) ([]*entity.Position, error)
+	UpdatePosition(ctx context.Context, position *entity.Position) (*entity.Position, error)
+	DeletePosition(ctx context.Context, id int) error
+}
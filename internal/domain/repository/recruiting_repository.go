@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type RecruitingRepository interface {
+	CreateRequisition(ctx context.Context, requisition *entity.JobRequisition) (*entity.JobRequisition, error)
+	GetRequisitionById(ctx context.Context, id int) (*entity.JobRequisition, error)
+	// GetRequisitions returns the requisitions with status, or all of them when status is empty,
+	// latest first.
+	GetRequisitions(ctx context.Context, status entity.RequisitionStatus) ([]*entity.JobRequisition, error)
+	// UpdateRequisition returns nil when the requisition does not exist.
+	UpdateRequisition(ctx context.Context, requisition *entity.JobRequisition) (*entity.JobRequisition, error)
+	DeleteRequisition(ctx context.Context, id int) error
+
+	CreateCandidate(ctx context.Context, candidate *entity.Candidate) (*entity.Candidate, error)
+	GetCandidateById(ctx context.Context, id int) (*entity.Candidate, error)
+	// GetCandidates returns the candidates matching filter, latest applicant first.
+	GetCandidates(ctx context.Context, filter entity.CandidateFilter) ([]*entity.Candidate, error)
+	// UpdateCandidate stores the candidate's contact details and notes. It returns nil when the
+	// candidate does not exist.
+	UpdateCandidate(ctx context.Context, candidate *entity.Candidate) (*entity.Candidate, error)
+	// UpdateCandidateStage stores the candidate's stage, offer and employee, provided the candidate
+	// is still in the stage from. It returns nil when the candidate is not.
+	UpdateCandidateStage(ctx context.Context, candidate *entity.Candidate, from entity.CandidateStage) (*entity.Candidate, error)
+	DeleteCandidate(ctx context.Context, id int) error
+	// GetStartingHires returns the employees hired through an accepted offer who are still
+	// candidates and were hired on or before date.
+	GetStartingHires(ctx context.Context, date time.Time) ([]*entity.Employee, error)
+
+	CreateInterview(ctx context.Context, interview *entity.Interview) (*entity.Interview, error)
+	GetInterviewById(ctx context.Context, id int) (*entity.Interview, error)
+	// GetCandidateInterviews returns the candidate's interviews by time.
+	GetCandidateInterviews(ctx context.Context, candidateID int) ([]*entity.Interview, error)
+	// GetInterviewerSchedule returns the scheduled interviews of interviewerID ending after from,
+	// by time.
+	GetInterviewerSchedule(ctx context.Context, interviewerID int, from time.Time) ([]*entity.Interview, error)
+	// UpdateInterview stores the interview's schedule, status and feedback. It returns nil when the
+	// interview does not exist.
+	UpdateInterview(ctx context.Context, interview *entity.Interview) (*entity.Interview, error)
+}
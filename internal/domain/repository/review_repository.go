@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type ReviewRepository interface {
+	CreateReviewCycle(ctx context.Context, cycle *entity.ReviewCycle) (*entity.ReviewCycle, error)
+	// GetReviewCycleById returns the cycle with its questions.
+	GetReviewCycleById(ctx context.Context, id int) (*entity.ReviewCycle, error)
+	// GetAllReviewCycles returns the cycles without their questions.
+	GetAllReviewCycles(ctx context.Context) ([]*entity.ReviewCycle, error)
+	// UpdateReviewCycle replaces a draft cycle and its questions. It returns nil when the cycle is
+	// missing or no longer a draft.
+	UpdateReviewCycle(ctx context.Context, cycle *entity.ReviewCycle) (*entity.ReviewCycle, error)
+	// DeleteReviewCycle deletes a draft cycle.
+	DeleteReviewCycle(ctx context.Context, id int) error
+	// LaunchReviewCycle activates a draft cycle and creates its reviews with their pending
+	// submissions in one transaction. It returns nil when the cycle is no longer a draft.
+	LaunchReviewCycle(ctx context.Context, id int, reviews []*entity.Review) (*entity.ReviewCycle, error)
+	// CloseReviewCycle closes an active cycle and locks its completed reviews. It returns nil when
+	// the cycle is not active.
+	CloseReviewCycle(ctx context.Context, id int) (*entity.ReviewCycle, error)
+
+	// GetReviews returns the reviews of a cycle with their ratings, without submissions.
+	GetReviews(ctx context.Context, cycleID int) ([]*entity.Review, error)
+	GetEmployeeReviews(ctx context.Context, employeeID int) ([]*entity.Review, error)
+	// GetReviewById returns the review with its submissions and their answers.
+	GetReviewById(ctx context.Context, id int) (*entity.Review, error)
+	// AddPeerReviewers adds pending peer submissions, skipping reviewers already asked.
+	AddPeerReviewers(ctx context.Context, reviewID int, reviewerIDs []int) error
+	// SubmitReview records the answers of the reviewer in their role, creating the submission if
+	// it was not pending yet. A manager submission also completes the review. It returns false
+	// when the reviewer already submitted or the review is no longer in progress.
+	SubmitReview(ctx context.Context, submission *entity.ReviewSubmission) (bool, error)
+	// LockReview locks a completed review. It returns nil when the review is not completed.
+	LockReview(ctx context.Context, id int) (*entity.Review, error)
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type SegmentRepository interface {
+	GetEmployeeTags(ctx context.Context, employeeID int) ([]string, error)
+	// AddEmployeeTags tags every one of the employees, skipping tags they already have, and
+	// returns how many tags were added.
+	AddEmployeeTags(ctx context.Context, employeeIDs []int, tags []string) (int, error)
+	RemoveEmployeeTag(ctx context.Context, employeeID int, tag string) error
+	GetAllTags(ctx context.Context) ([]*entity.TagCount, error)
+	// DeleteTag removes a tag from every employee.
+	DeleteTag(ctx context.Context, tag string) error
+
+	CreateSegment(ctx context.Context, segment *entity.EmployeeSegment) (*entity.EmployeeSegment, error)
+	GetSegmentById(ctx context.Context, id int) (*entity.EmployeeSegment, error)
+	GetAllSegments(ctx context.Context) ([]*entity.EmployeeSegment, error)
+	UpdateSegment(ctx context.Context, segment *entity.EmployeeSegment) (*entity.EmployeeSegment, error)
+	DeleteSegment(ctx context.Context, id int) error
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type ShiftRepository interface {
+	CreateShiftTemplate(ctx context.Context, template *entity.ShiftTemplate) (*entity.ShiftTemplate, error)
+	GetShiftTemplateById(ctx context.Context, id int) (*entity.ShiftTemplate, error)
+	GetAllShiftTemplates(ctx context.Context) ([]*entity.ShiftTemplate, error)
+	UpdateShiftTemplate(ctx context.Context, template *entity.ShiftTemplate) (*entity.ShiftTemplate, error)
+	DeleteShiftTemplate(ctx context.Context, id int) error
+
+	// CreateShifts stores a roster in one transaction. It fails with ErrShiftOverlaps when any
+	// shift overlaps one the employee already has, creating none of them.
+	CreateShifts(ctx context.Context, shifts []*entity.Shift) ([]*entity.Shift, error)
+	// GetShifts returns the employee's shifts overlapping the span from start to end, by start time.
+	GetShifts(ctx context.Context, employeeID int, start, end time.Time) ([]*entity.Shift, error)
+	DeleteShift(ctx context.Context, employeeID, id int) error
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type SkillRepository interface {
+	CreateSkillCategory(ctx context.Context, category *entity.SkillCategory) (*entity.SkillCategory, error)
+	GetAllSkillCategories(ctx context.Context) ([]*entity.SkillCategory, error)
+	UpdateSkillCategory(ctx context.Context, category *entity.SkillCategory) (*entity.SkillCategory, error)
+	DeleteSkillCategory(ctx context.Context, id int) error
+
+	CreateSkill(ctx context.Context, skill *entity.Skill) (*entity.Skill, error)
+	GetSkillById(ctx context.Context, id int) (*entity.Skill, error)
+	// GetAllSkills returns the skills ordered by category and name, only those of categoryID when
+	// it is positive.
+	GetAllSkills(ctx context.Context, categoryID int) ([]*entity.Skill, error)
+	UpdateSkill(ctx context.Context, skill *entity.Skill) (*entity.Skill, error)
+	DeleteSkill(ctx context.Context, id int) error
+
+	// SetEmployeeSkill adds the skill to the employee or updates their level.
+	SetEmployeeSkill(ctx context.Context, employeeSkill *entity.EmployeeSkill) (*entity.EmployeeSkill, error)
+	GetEmployeeSkills(ctx context.Context, employeeID int) ([]*entity.EmployeeSkill, error)
+	DeleteEmployeeSkill(ctx context.Context, employeeID, skillID int) error
+	// GetSkillHolders returns the skills in skillIDs held by employees in one of statuses.
+	GetSkillHolders(ctx context.Context, skillIDs []int, statuses []entity.EmploymentStatus) ([]*entity.EmployeeSkill, error)
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type TrainingRepository interface {
+	CreateCourse(ctx context.Context, course *entity.TrainingCourse) (*entity.TrainingCourse, error)
+	GetCourseById(ctx context.Context, id int) (*entity.TrainingCourse, error)
+	GetAllCourses(ctx context.Context) ([]*entity.TrainingCourse, error)
+	UpdateCourse(ctx context.Context, course *entity.TrainingCourse) (*entity.TrainingCourse, error)
+	DeleteCourse(ctx context.Context, id int) error
+
+	CreateEnrollment(ctx context.Context, enrollment *entity.TrainingEnrollment) (*entity.TrainingEnrollment, error)
+	GetEnrollmentById(ctx context.Context, id int) (*entity.TrainingEnrollment, error)
+	// GetEnrollments returns the enrollments matching filter, latest first.
+	GetEnrollments(ctx context.Context, filter entity.EnrollmentFilter) ([]*entity.TrainingEnrollment, error)
+	// CompleteEnrollment marks the open enrollment completed on enrollment.CompletedOn and, when
+	// certification is not nil, issues it for the enrollment in the same transaction. It returns
+	// nil when the enrollment is no longer open.
+	CompleteEnrollment(ctx context.Context, enrollment *entity.TrainingEnrollment, certification *entity.Certification) (*entity.TrainingEnrollment, error)
+	// CancelEnrollment cancels the open enrollment. It returns nil when the enrollment is no
+	// longer open.
+	CancelEnrollment(ctx context.Context, id int) (*entity.TrainingEnrollment, error)
+}
@@ -0,0 +1,19 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrBlobNotFound is returned by Get when no blob is stored under the key.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStorage keeps files by key. Keys are slash separated relative paths, e.g.
+// employees/1/documents/<id>.
+type BlobStorage interface {
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the blob; deleting a missing blob is not an error.
+	Delete(ctx context.Context, key string) error
+}
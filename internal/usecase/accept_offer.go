@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *recruitingUsecaseImpl) AcceptOffer(ctx context.Context, acceptance entity.OfferAcceptance) (*entity.Employee, error) {
+	candidate, err := u.GetCandidateById(ctx, acceptance.CandidateID)
+	if err != nil {
+		return nil, err
+	}
+	if candidate.Stage != entity.CandidateOffer || candidate.Offer == nil {
+		return nil, appError.ErrCandidateStageTransition
+	}
+	requisition, err := u.GetRequisitionById(ctx, candidate.RequisitionID)
+	if err != nil {
+		return nil, err
+	}
+	if requisition.Status != entity.RequisitionOpen {
+		return nil, appError.ErrRequisitionNotOpen
+	}
+	today, err := localDate(ctx, u.locationRepository, requisition.LocationID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// Claim the candidate first, so that accepting the same offer twice cannot hire them twice.
+	hired := *candidate
+	hired.Stage = entity.CandidateHired
+	claimed, err := u.recruitingRepository.UpdateCandidateStage(ctx, &hired, entity.CandidateOffer)
+	if err != nil {
+		return nil, err
+	}
+	if claimed == nil {
+		return nil, appError.ErrCandidateStageTransition
+	}
+
+	employee, err := u.employees.CreateEmployee(ctx, offerEmployee(candidate, requisition, acceptance, today))
+	if err != nil {
+		if _, revertErr := u.recruitingRepository.UpdateCandidateStage(ctx, candidate, entity.CandidateHired); revertErr != nil {
+			log.Printf("Error returning candidate %d to the offer stage: %v", candidate.ID, revertErr)
+		}
+		return nil, err
+	}
+
+	// The employee is hired at this point, so failing to link them only gets logged.
+	claimed.EmployeeID = &employee.ID
+	if _, err := u.recruitingRepository.UpdateCandidateStage(ctx, claimed, entity.CandidateHired); err != nil {
+		log.Printf("Error linking candidate %d to employee %d: %v", candidate.ID, employee.ID, err)
+	}
+	u.fillRequisition(ctx, requisition.ID)
+	return employee, nil
+}
+
+// offerEmployee builds the employee a candidate becomes on accepting their offer. Employees starting
+// after today, the date at the requisition's location, are created as candidates, the employment
+// status that precedes active, until ActivateStartingHires activates them on their start date.
+func offerEmployee(candidate *entity.Candidate, requisition *entity.JobRequisition,
+	acceptance entity.OfferAcceptance, today time.Time) *entity.Employee {
+
+	employee := &entity.Employee{
+		Name:          candidate.Name,
+		PositionID:    requisition.PositionID,
+		Salary:        candidate.Offer.Salary,
+		PayPeriod:     candidate.Offer.PayPeriod,
+		ManagerID:     requisition.HiringManagerID,
+		LocationID:    requisition.LocationID,
+		DepartmentID:  requisition.DepartmentID,
+		HiredDate:     candidate.Offer.StartDate,
+		WorkEmail:     acceptance.WorkEmail,
+		PersonalEmail: candidate.Email,
+		Phone:         candidate.Phone,
+		Status:        entity.EmploymentStatusActive,
+		CustomFields:  acceptance.CustomFields,
+	}
+	if acceptance.ManagerID != nil {
+		employee.ManagerID = acceptance.ManagerID
+	}
+	if employee.HiredDate.After(today) {
+		employee.Status = entity.EmploymentStatusCandidate
+	}
+	return employee
+}
+
+// fillRequisition marks an open requisition filled once it hired as many candidates as it has
+// openings.
+func (u *recruitingUsecaseImpl) fillRequisition(ctx context.Context, requisitionID int) {
+	requisition, err := u.recruitingRepository.GetRequisitionById(ctx, requisitionID)
+	if err != nil {
+		log.Printf("Error loading requisition %d: %v", requisitionID, err)
+		return
+	}
+	if requisition == nil || requisition.Status != entity.RequisitionOpen || requisition.Hired < requisition.Openings {
+		return
+	}
+	requisition.Status = entity.RequisitionFilled
+	if _, err := u.recruitingRepository.UpdateRequisition(ctx, requisition); err != nil {
+		log.Printf("Error marking requisition %d filled: %v", requisitionID, err)
+	}
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *recruitingUsecaseImpl) ActivateStartingHires(ctx context.Context, asOf time.Time) (int, error) {
+	// Local dates run up to a day ahead of UTC, so look one day out and check every hire against
+	// the date at their own location.
+	hires, err := u.recruitingRepository.GetStartingHires(ctx, dateOnly(asOf).AddDate(0, 0, 1))
+	if err != nil {
+		return 0, err
+	}
+
+	activated := 0
+	for _, employee := range hires {
+		today, err := localDate(ctx, u.locationRepository, employee.LocationID, asOf)
+		if err != nil {
+			log.Printf("[RECRUITING] failed to get the local date of employee %d: %v", employee.ID, err)
+			continue
+		}
+		if employee.HiredDate.After(today) {
+			continue
+		}
+
+		_, err = u.employees.ChangeEmploymentStatus(ctx, &entity.EmploymentStatusChange{
+			EmployeeID:    employee.ID,
+			ToStatus:      entity.EmploymentStatusActive,
+			EffectiveDate: employee.HiredDate,
+			Reason:        "Start date reached",
+		})
+		if err != nil {
+			// Keep going: one broken hire must not hold back the others.
+			log.Printf("[RECRUITING] failed to activate employee %d: %v", employee.ID, err)
+			continue
+		}
+		activated++
+	}
+	return activated, nil
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *recruitingUsecaseImpl) AddCandidate(ctx context.Context, candidate *entity.Candidate) (*entity.Candidate, error) {
+	if err := validateCandidate(candidate); err != nil {
+		return nil, err
+	}
+	requisition, err := u.GetRequisitionById(ctx, candidate.RequisitionID)
+	if err != nil {
+		return nil, err
+	}
+	if requisition.Status != entity.RequisitionOpen {
+		return nil, appError.ErrRequisitionNotOpen
+	}
+
+	candidate.Stage = entity.CandidateApplied
+	return u.recruitingRepository.CreateCandidate(ctx, candidate)
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *certificationUsecaseImpl) AddEvidence(ctx context.Context, certificationID int, fileName string,
+	content []byte) (*entity.CertificationEvidence, error) {
+
+	if _, err := u.GetCertificationById(ctx, certificationID); err != nil {
+		return nil, err
+	}
+
+	evidence := &entity.CertificationEvidence{
+		CertificationID: certificationID,
+		FileName:        fileName,
+		Content:         content,
+	}
+	if err := validateEvidence(evidence); err != nil {
+		return nil, err
+	}
+	return u.certificationRepository.AddEvidence(ctx, evidence)
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *segmentUsecaseImpl) AddEmployeeTags(ctx context.Context, employeeID int, tags []string) ([]string, error) {
+	tags, err := normalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return nil, appError.ErrInvalidTag
+	}
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, employeeID); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.segmentRepository.AddEmployeeTags(ctx, []int{employeeID}, tags); err != nil {
+		return nil, err
+	}
+	invalidateEmployeesListCache(ctx, u.cache)
+	return u.segmentRepository.GetEmployeeTags(ctx, employeeID)
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *goalUsecaseImpl) AddKeyResult(ctx context.Context, keyResult *entity.KeyResult) (*entity.Goal, error) {
+	goal, err := u.GetGoalById(ctx, keyResult.GoalID)
+	if err != nil {
+		return nil, err
+	}
+	if goal.Status != entity.GoalStatusActive {
+		return nil, appError.ErrGoalNotActive
+	}
+	if len(goal.KeyResults) >= maxKeyResults {
+		return nil, appError.ErrInvalidGoal
+	}
+	if err := validateKeyResult(keyResult); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.goalRepository.AddKeyResult(ctx, keyResult); err != nil {
+		return nil, err
+	}
+	return u.GetGoalById(ctx, keyResult.GoalID)
+}
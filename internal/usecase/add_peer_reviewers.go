@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *reviewUsecaseImpl) AddPeerReviewers(ctx context.Context, reviewID int, reviewerIDs []int) (*entity.Review, error) {
+	review, err := u.GetReviewById(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.Status != entity.ReviewStatusInProgress {
+		return nil, appError.ErrReviewNotInProgress
+	}
+
+	if len(reviewerIDs) == 0 {
+		return nil, appError.ErrInvalidPeerReviewers
+	}
+	for _, reviewerID := range reviewerIDs {
+		// Employees cannot be their own peers, and their manager reviews them as manager.
+		if reviewerID <= 0 || reviewerID == review.EmployeeID ||
+			(review.ManagerID != nil && reviewerID == *review.ManagerID) {
+			return nil, appError.ErrInvalidPeerReviewers
+		}
+	}
+
+	if err := u.reviewRepository.AddPeerReviewers(ctx, reviewID, reviewerIDs); err != nil {
+		return nil, err
+	}
+	return u.GetReviewById(ctx, reviewID)
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/notification"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *segmentUsecaseImpl) AnnounceToSegment(ctx context.Context, id int, subject, body string) (int, error) {
+	subject = strings.TrimSpace(subject)
+	body = strings.TrimSpace(body)
+	if subject == "" || body == "" {
+		return 0, appError.ErrInvalidAnnouncement
+	}
+
+	members, err := u.GetSegmentMembers(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	recipients := []string{}
+	for _, member := range members {
+		if member.WorkEmail != "" {
+			recipients = append(recipients, member.WorkEmail)
+		}
+	}
+	if len(recipients) == 0 {
+		return 0, nil
+	}
+
+	if err := u.notifier.Notify(ctx, notification.Message{To: recipients, Subject: subject, Body: body}); err != nil {
+		return 0, err
+	}
+	return len(recipients), nil
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *compensationUsecaseImpl) ApplyDueCompensationChanges(ctx context.Context, asOf time.Time) (int, error) {
+	// Local dates run up to a day ahead of UTC, so look one day out and check every change against
+	// the date at the employee's location.
+	dueChanges, err := u.compensationRepository.GetDueScheduledChanges(ctx, dateOnly(asOf).AddDate(0, 0, 1))
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	for _, change := range dueChanges {
+		employee, err := u.employeeRepository.GetEmployeeById(ctx, change.EmployeeID)
+		if err != nil {
+			log.Printf("[COMPENSATION] failed to check change %d for employee %d: %v", change.ID, change.EmployeeID, err)
+			continue
+		}
+		if employee == nil {
+			continue
+		}
+		due, err := u.isChangeDue(ctx, employee, change, asOf)
+		if err != nil {
+			log.Printf("[COMPENSATION] failed to check change %d for employee %d: %v", change.ID, change.EmployeeID, err)
+			continue
+		}
+		if !due {
+			continue
+		}
+		// The band was enforced when the change was scheduled; bands and rates may have moved since,
+		// so the flag is worked out afresh.
+		outOfBand, err := u.isOutOfBand(ctx, employee, change.NewSalary)
+		if err != nil {
+			log.Printf("[COMPENSATION] failed to check the band of change %d for employee %d: %v", change.ID,
+				change.EmployeeID, err)
+			continue
+		}
+
+		appliedChange, err := u.compensationRepository.ApplyScheduledChange(ctx, change.ID, outOfBand)
+		if err != nil {
+			// Keep going: one broken change must not hold back the others.
+			log.Printf("[COMPENSATION] failed to apply change %d for employee %d: %v", change.ID, change.EmployeeID, err)
+			continue
+		}
+		if appliedChange != nil {
+			applied++
+		}
+	}
+
+	if applied > 0 {
+		invalidateEmployeesListCache(ctx, u.cache)
+	}
+	return applied, nil
+}
+
+// isChangeDue reports whether change is effective on the date asOf falls on at the employee's
+// location.
+func (u *compensationUsecaseImpl) isChangeDue(ctx context.Context, employee *entity.Employee,
+	change *entity.CompensationChange, asOf time.Time) (bool, error) {
+
+	today, err := localDate(ctx, u.locationRepository, employee.LocationID, asOf)
+	if err != nil {
+		return false, err
+	}
+	return !change.EffectiveDate.After(today), nil
+}
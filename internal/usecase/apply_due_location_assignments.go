@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *locationUsecaseImpl) ApplyDueLocationAssignments(ctx context.Context, asOf time.Time) (int, error) {
+	// Local dates run up to a day ahead of UTC, so look one day out and check every assignment
+	// against the date at its own location.
+	dueAssignments, err := u.locationRepository.GetDueScheduledAssignments(ctx, dateOnly(asOf).AddDate(0, 0, 1))
+	if err != nil {
+		return 0, err
+	}
+
+	locations := map[int]*entity.Location{}
+	applied := 0
+	for _, assignment := range dueAssignments {
+		location, ok := locations[assignment.LocationID]
+		if !ok {
+			location, err = u.locationRepository.GetLocationById(ctx, assignment.LocationID)
+			if err != nil {
+				log.Printf("[LOCATION] failed to load location %d: %v", assignment.LocationID, err)
+				continue
+			}
+			locations[assignment.LocationID] = location
+		}
+		if location != nil && assignment.EffectiveDate.After(location.LocalDate(asOf)) {
+			continue
+		}
+
+		appliedAssignment, err := u.locationRepository.ApplyScheduledAssignment(ctx, assignment.ID)
+		if err != nil {
+			// Keep going: one broken assignment must not hold back the others.
+			log.Printf("[LOCATION] failed to apply assignment %d for employee %d: %v", assignment.ID, assignment.EmployeeID, err)
+			continue
+		}
+		if appliedAssignment != nil {
+			applied++
+		}
+	}
+
+	if applied > 0 {
+		invalidateEmployeesListCache(ctx, u.cache)
+	}
+	return applied, nil
+}
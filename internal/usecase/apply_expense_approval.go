@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// ApplyApproval approves the claim of an approved expense request.
+func (u *expenseUsecaseImpl) ApplyApproval(ctx context.Context, request *entity.ApprovalRequest) error {
+	claim, err := u.getApprovalClaim(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	decidedAt := time.Now().UTC()
+	claim.Status = entity.ExpenseClaimApproved
+	claim.ApprovalRequestID = &request.ID
+	claim.DecidedAt = &decidedAt
+	claim.DecisionComment = fmt.Sprintf("Approval request #%d", request.ID)
+	approvedClaim, err := u.expenseRepository.UpdateClaimStatus(ctx, claim, entity.ExpenseClaimSubmitted)
+	if err != nil {
+		return err
+	}
+	if approvedClaim == nil {
+		return appError.ErrExpenseClaimNotSubmitted
+	}
+	return nil
+}
+
+// RejectApproval rejects the claim of a rejected expense request, and takes the claim of a
+// cancelled one back to draft.
+func (u *expenseUsecaseImpl) RejectApproval(ctx context.Context, request *entity.ApprovalRequest) error {
+	claim, err := u.getApprovalClaim(ctx, request)
+	if err != nil {
+		return err
+	}
+	if request.Status != entity.ApprovalRejected {
+		u.returnToDraft(ctx, claim)
+		return nil
+	}
+
+	decidedAt := time.Now().UTC()
+	claim.Status = entity.ExpenseClaimRejected
+	claim.ApprovalRequestID = &request.ID
+	claim.DecidedAt = &decidedAt
+	claim.DecisionComment = fmt.Sprintf("Approval request #%d", request.ID)
+	rejectedClaim, err := u.expenseRepository.UpdateClaimStatus(ctx, claim, entity.ExpenseClaimSubmitted)
+	if err != nil {
+		return err
+	}
+	if rejectedClaim == nil {
+		return appError.ErrExpenseClaimNotSubmitted
+	}
+	return nil
+}
+
+// getApprovalClaim returns the submitted claim an expense request is about.
+func (u *expenseUsecaseImpl) getApprovalClaim(ctx context.Context, request *entity.ApprovalRequest) (*entity.ExpenseClaim, error) {
+	var approval expenseApproval
+	if err := json.Unmarshal(request.Payload, &approval); err != nil {
+		return nil, err
+	}
+	claim, err := u.GetClaimById(ctx, request.EmployeeID, approval.ClaimID)
+	if err != nil {
+		return nil, err
+	}
+	if claim.Status != entity.ExpenseClaimSubmitted {
+		return nil, appError.ErrExpenseClaimNotSubmitted
+	}
+	return claim, nil
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// leaveApproval is the payload of leave approval requests.
+type leaveApproval struct {
+	LeaveRequestID int `json:"leave_request_id"`
+}
+
+// ApplyApproval approves the leave of an approved leave approval request. When the leave cannot
+// be approved, e.g. for lack of balance, it is handed back to the employee's manager to decide.
+func (u *leaveUsecaseImpl) ApplyApproval(ctx context.Context, request *entity.ApprovalRequest) error {
+	leaveRequest, err := u.getApprovalLeaveRequest(ctx, request)
+	if err != nil {
+		return err
+	}
+	if leaveRequest.Status != entity.LeaveRequestStatusPending {
+		return appError.ErrLeaveRequestClosed
+	}
+
+	leaveRequest.DecidedBy = request.LastDecidedBy()
+	leaveRequest.DecisionComment = fmt.Sprintf("Approval request #%d", request.ID)
+	employee, err := u.getEmployeeForLeave(ctx, leaveRequest.EmployeeID)
+	if err == nil {
+		_, err = u.approveLeave(ctx, employee, leaveRequest)
+	}
+	if err != nil && !errors.Is(err, appError.ErrLeaveRequestClosed) {
+		if _, releaseErr := u.leaveRepository.SetLeaveRequestApproval(ctx, leaveRequest.ID, nil); releaseErr != nil {
+			log.Printf("[LEAVE] failed to hand leave request %d back to the manager: %v", leaveRequest.ID, releaseErr)
+		}
+	}
+	return err
+}
+
+// RejectApproval rejects the leave of a rejected leave approval request, and cancels the leave of
+// a cancelled one.
+func (u *leaveUsecaseImpl) RejectApproval(ctx context.Context, request *entity.ApprovalRequest) error {
+	leaveRequest, err := u.getApprovalLeaveRequest(ctx, request)
+	if err != nil {
+		return err
+	}
+	if leaveRequest.Status != entity.LeaveRequestStatusPending {
+		// Cancelling the leave request cancels its approval request too.
+		return nil
+	}
+
+	if request.Status != entity.ApprovalRejected {
+		_, err := u.leaveRepository.CancelLeaveRequest(ctx, leaveRequest, false)
+		return err
+	}
+	leaveRequest.DecidedBy = request.LastDecidedBy()
+	leaveRequest.DecisionComment = fmt.Sprintf("Approval request #%d", request.ID)
+	rejectedRequest, err := u.leaveRepository.RejectLeaveRequest(ctx, leaveRequest)
+	if err != nil {
+		return err
+	}
+	if rejectedRequest == nil {
+		return appError.ErrLeaveRequestClosed
+	}
+	return nil
+}
+
+// getApprovalLeaveRequest returns the leave request a leave approval request is about.
+func (u *leaveUsecaseImpl) getApprovalLeaveRequest(ctx context.Context,
+	request *entity.ApprovalRequest) (*entity.LeaveRequest, error) {
+
+	var approval leaveApproval
+	if err := json.Unmarshal(request.Payload, &approval); err != nil {
+		return nil, err
+	}
+	return u.GetLeaveRequestById(ctx, request.EmployeeID, approval.LeaveRequestID)
+}
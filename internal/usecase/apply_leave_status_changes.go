@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *leaveUsecaseImpl) ApplyLeaveStatusChanges(ctx context.Context, asOf time.Time) (int, error) {
+	asOf = dateOnly(asOf)
+	changed := 0
+
+	// Ending first lets back-to-back leave start on the day the previous one ends.
+	toEnd, err := u.leaveRepository.GetLeaveRequestsToEnd(ctx, asOf)
+	if err != nil {
+		return 0, err
+	}
+	for _, request := range toEnd {
+		returnDate := request.EndDate.AddDate(0, 0, 1)
+		if request.Status != entity.LeaveRequestStatusApproved {
+			returnDate = asOf
+		}
+		if err := u.endLeave(ctx, request, returnDate); err != nil {
+			// Keep going: one broken request must not hold back the others.
+			log.Printf("[LEAVE] failed to end leave of employee %d for request %d: %v", request.EmployeeID, request.ID, err)
+			continue
+		}
+		changed++
+	}
+
+	toStart, err := u.leaveRepository.GetLeaveRequestsToStart(ctx, asOf)
+	if err != nil {
+		return changed, err
+	}
+	for _, request := range toStart {
+		started, err := u.startLeave(ctx, request)
+		if err != nil {
+			log.Printf("[LEAVE] failed to put employee %d on leave for request %d: %v", request.EmployeeID, request.ID, err)
+			continue
+		}
+		if started {
+			changed++
+		}
+	}
+	return changed, nil
+}
+
+// startLeave moves an active employee to on_leave for an approved request and remembers that the
+// request did so. Employees in any other status are left alone.
+func (u *leaveUsecaseImpl) startLeave(ctx context.Context, request *entity.LeaveRequest) (bool, error) {
+	employee, err := u.employeeRepository.GetEmployeeById(ctx, request.EmployeeID)
+	if err != nil {
+		return false, err
+	}
+	if employee == nil || employee.Status != entity.EmploymentStatusActive {
+		return false, nil
+	}
+
+	_, err = u.employeeUsecase.ChangeEmploymentStatus(ctx, &entity.EmploymentStatusChange{
+		EmployeeID:    employee.ID,
+		ToStatus:      entity.EmploymentStatusOnLeave,
+		EffectiveDate: request.StartDate,
+		Reason:        fmt.Sprintf("leave request %d", request.ID),
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, u.leaveRepository.SetLeaveStatusApplied(ctx, request.ID, true)
+}
+
+// endLeave makes an employee put on leave by request active again from returnDate.
+func (u *leaveUsecaseImpl) endLeave(ctx context.Context, request *entity.LeaveRequest, returnDate time.Time) error {
+	employee, err := u.employeeRepository.GetEmployeeById(ctx, request.EmployeeID)
+	if err != nil {
+		return err
+	}
+	if employee != nil && employee.Status == entity.EmploymentStatusOnLeave {
+		_, err = u.employeeUsecase.ChangeEmploymentStatus(ctx, &entity.EmploymentStatusChange{
+			EmployeeID:    employee.ID,
+			ToStatus:      entity.EmploymentStatusActive,
+			EffectiveDate: returnDate,
+			Reason:        fmt.Sprintf("returned from leave request %d", request.ID),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return u.leaveRepository.SetLeaveStatusApplied(ctx, request.ID, false)
+}
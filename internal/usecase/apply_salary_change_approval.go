@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// ApplyApproval makes the salary change of an approved request, scheduling it when it is effective
+// in the future.
+func (u *compensationUsecaseImpl) ApplyApproval(ctx context.Context, request *entity.ApprovalRequest) error {
+	var approval salaryChangeApproval
+	if err := json.Unmarshal(request.Payload, &approval); err != nil {
+		return err
+	}
+	effectiveDate, err := time.Parse(constants.DateFormat, approval.EffectiveDate)
+	if err != nil {
+		return err
+	}
+
+	_, err = u.ScheduleCompensationChange(ctx, &entity.CompensationChange{
+		EmployeeID:    request.EmployeeID,
+		EffectiveDate: effectiveDate,
+		NewSalary:     entity.Money{Amount: approval.Amount, Currency: approval.Currency},
+		Reason:        approval.Reason,
+		ApprovedBy:    fmt.Sprintf("Approval request #%d", request.ID),
+	})
+	return err
+}
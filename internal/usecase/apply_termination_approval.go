@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// ApplyApproval terminates the employee of an approved termination request.
+func (u *employeeUsecaseImpl) ApplyApproval(ctx context.Context, request *entity.ApprovalRequest) error {
+	var approval terminationApproval
+	if err := json.Unmarshal(request.Payload, &approval); err != nil {
+		return err
+	}
+	terminationDate, err := time.Parse(constants.DateFormat, approval.TerminationDate)
+	if err != nil {
+		return err
+	}
+
+	_, err = u.TerminateEmployee(ctx, request.EmployeeID, terminationDate, approval.Reason)
+	return err
+}
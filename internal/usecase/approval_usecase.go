@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/notification"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+// ApprovalHandler makes the change of an approved request. Usecases owning a kind of change submit
+// it for approval instead of making it, and register themselves as the handler of its type.
+type ApprovalHandler interface {
+	ApplyApproval(ctx context.Context, request *entity.ApprovalRequest) error
+}
+
+// ApprovalRejectionHandler is implemented by handlers that also need to hear when a request of
+// their type is rejected or cancelled, e.g. to close the record waiting for the decision.
+type ApprovalRejectionHandler interface {
+	RejectApproval(ctx context.Context, request *entity.ApprovalRequest) error
+}
+
+type ApprovalUsecase interface {
+	// RegisterHandler sets the handler making the changes of approved requests of requestType. It
+	// is meant to be called while wiring up the application, before requests are served.
+	RegisterHandler(requestType entity.ApprovalType, handler ApprovalHandler)
+
+	CreateWorkflow(ctx context.Context, workflow *entity.ApprovalWorkflow) (*entity.ApprovalWorkflow, error)
+	GetWorkflowById(ctx context.Context, id int) (*entity.ApprovalWorkflow, error)
+	GetWorkflows(ctx context.Context) ([]*entity.ApprovalWorkflow, error)
+	// UpdateWorkflow replaces the workflow's details and steps. Requests already submitted keep
+	// their tasks.
+	UpdateWorkflow(ctx context.Context, workflow *entity.ApprovalWorkflow) (*entity.ApprovalWorkflow, error)
+	DeleteWorkflow(ctx context.Context, id int) error
+
+	// Submit starts the workflow of the request's type for it. It returns nil when the change needs
+	// no approval, because there is no workflow for the type or none of its steps has anyone to go
+	// to, in which case the caller makes the change itself.
+	Submit(ctx context.Context, request *entity.ApprovalRequest) (*entity.ApprovalRequest, error)
+	GetRequestById(ctx context.Context, id int) (*entity.ApprovalRequest, error)
+	GetRequests(ctx context.Context, filter entity.ApprovalRequestFilter) ([]*entity.ApprovalRequest, error)
+	// CancelRequest withdraws a pending request.
+	CancelRequest(ctx context.Context, id int) (*entity.ApprovalRequest, error)
+
+	// GetInbox returns the tasks an approver may decide today, including those of employees who
+	// delegated to them.
+	GetInbox(ctx context.Context, approverID int) ([]*entity.ApprovalTask, error)
+	// DecideTask records an approver's decision and, once it approves the request, has the
+	// request's handler make the change. A change that fails leaves the request failed.
+	DecideTask(ctx context.Context, decision entity.ApprovalDecision) (*entity.ApprovalRequest, error)
+	// EscalateOverdueTasks hands every task undecided past its timeout on and returns how many were
+	// handed on. It is run periodically by a background job.
+	EscalateOverdueTasks(ctx context.Context, asOf time.Time) (int, error)
+
+	CreateDelegation(ctx context.Context, delegation *entity.ApprovalDelegation) (*entity.ApprovalDelegation, error)
+	GetDelegations(ctx context.Context, delegatorID int) ([]*entity.ApprovalDelegation, error)
+	DeleteDelegation(ctx context.Context, delegatorID, id int) error
+}
+
+type approvalUsecaseImpl struct {
+	approvalRepository repository.ApprovalRepository
+	employeeRepository repository.EmployeeRepository
+	positionRepository repository.PositionRepository
+	notifier           notification.Notifier
+	handlers           map[entity.ApprovalType]ApprovalHandler
+}
+
+func NewApprovalUsecase(approvalRepository repository.ApprovalRepository,
+	employeeRepository repository.EmployeeRepository, positionRepository repository.PositionRepository,
+	notifier notification.Notifier) ApprovalUsecase {
+	return &approvalUsecaseImpl{
+		approvalRepository: approvalRepository,
+		employeeRepository: employeeRepository,
+		positionRepository: positionRepository,
+		notifier:           notifier,
+		handlers:           map[entity.ApprovalType]ApprovalHandler{},
+	}
+}
+
+func (u *approvalUsecaseImpl) RegisterHandler(requestType entity.ApprovalType, handler ApprovalHandler) {
+	u.handlers[requestType] = handler
+}
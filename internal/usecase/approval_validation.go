@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const (
+	maxApprovalSteps        = 20
+	maxApprovalTimeoutHours = 720
+)
+
+func validateApprovalWorkflow(workflow *entity.ApprovalWorkflow) error {
+	workflow.Name = strings.TrimSpace(workflow.Name)
+	if len(workflow.Name) < 3 || !workflow.RequestType.IsValid() {
+		return appError.ErrInvalidApprovalWorkflow
+	}
+
+	if len(workflow.Steps) == 0 || len(workflow.Steps) > maxApprovalSteps {
+		return appError.ErrInvalidApprovalWorkflow
+	}
+	for _, step := range workflow.Steps {
+		if step.Stage < 1 || !step.ApproverType.IsValid() {
+			return appError.ErrInvalidApprovalWorkflow
+		}
+		if (step.ApproverType == entity.ApproverManager) != (step.ApproverID == nil) {
+			return appError.ErrInvalidApprovalWorkflow
+		}
+		if step.ApproverID != nil && *step.ApproverID <= 0 {
+			return appError.ErrInvalidApprovalWorkflow
+		}
+		if step.TimeoutHours < 0 || step.TimeoutHours > maxApprovalTimeoutHours {
+			return appError.ErrInvalidApprovalWorkflow
+		}
+		if step.EscalateToID != nil && *step.EscalateToID <= 0 {
+			return appError.ErrInvalidApprovalWorkflow
+		}
+	}
+	return nil
+}
+
+// validateApprovalStepApprovers checks that the positions and employees the workflow's steps refer
+// to exist.
+func (u *approvalUsecaseImpl) validateApprovalStepApprovers(ctx context.Context, workflow *entity.ApprovalWorkflow) error {
+	for _, step := range workflow.Steps {
+		switch step.ApproverType {
+		case entity.ApproverPosition:
+			position, err := u.positionRepository.GetPositionById(ctx, *step.ApproverID)
+			if err != nil {
+				return err
+			}
+			if position == nil {
+				return appError.ErrPositionNotFound
+			}
+		case entity.ApproverEmployee:
+			if err := ensureEmployeeExists(ctx, u.employeeRepository, *step.ApproverID); err != nil {
+				return err
+			}
+		}
+		if step.EscalateToID != nil {
+			if err := ensureEmployeeExists(ctx, u.employeeRepository, *step.EscalateToID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateApprovalRequestFilter(filter entity.ApprovalRequestFilter) error {
+	if filter.EmployeeID < 0 {
+		return appError.ErrInvalidApprovalFilter
+	}
+	if filter.Type != "" && !filter.Type.IsValid() {
+		return appError.ErrInvalidApprovalFilter
+	}
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return appError.ErrInvalidApprovalFilter
+	}
+	return nil
+}
+
+// approvalTasks copies the workflow's steps into tasks of a request about employee. The tasks of
+// the first stage with anyone to decide start pending and the later ones wait; it returns that
+// stage, or 0 when no step has anyone to go to.
+func approvalTasks(workflow *entity.ApprovalWorkflow, employee *entity.Employee) ([]entity.ApprovalTask, int) {
+	tasks := make([]entity.ApprovalTask, 0, len(workflow.Steps))
+	firstStage := 0
+	for _, step := range workflow.Steps {
+		task := entity.ApprovalTask{
+			Stage:        step.Stage,
+			ApproverType: step.ApproverType,
+			Status:       entity.ApprovalTaskWaiting,
+			TimeoutHours: step.TimeoutHours,
+			EscalateToID: step.EscalateToID,
+		}
+		switch step.ApproverType {
+		case entity.ApproverManager:
+			task.AssigneeID = employee.ManagerID
+		case entity.ApproverPosition:
+			task.PositionID = step.ApproverID
+		case entity.ApproverEmployee:
+			task.AssigneeID = step.ApproverID
+		}
+
+		// Nobody may approve a change about themselves, so such steps are skipped like manager
+		// steps of employees without a manager.
+		if task.PositionID == nil && (task.AssigneeID == nil || *task.AssigneeID == employee.ID) {
+			task.Status = entity.ApprovalTaskSkipped
+		} else if firstStage == 0 || step.Stage < firstStage {
+			firstStage = step.Stage
+		}
+		tasks = append(tasks, task)
+	}
+
+	for i := range tasks {
+		if tasks[i].Status == entity.ApprovalTaskWaiting && tasks[i].Stage == firstStage {
+			tasks[i].Status = entity.ApprovalTaskPending
+		}
+	}
+	return tasks, firstStage
+}
+
+func validateApprovalDelegation(delegation *entity.ApprovalDelegation) error {
+	if delegation.DelegatorID <= 0 {
+		return appError.ErrInvalidEmployeeId
+	}
+	if delegation.DelegateID <= 0 || delegation.DelegateID == delegation.DelegatorID {
+		return appError.ErrInvalidApprovalDelegation
+	}
+	if delegation.StartDate.IsZero() || delegation.EndDate.Before(delegation.StartDate) {
+		return appError.ErrInvalidApprovalDelegation
+	}
+	return nil
+}
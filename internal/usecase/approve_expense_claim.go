@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *expenseUsecaseImpl) ApproveClaim(ctx context.Context, employeeID, id, approverID int,
+	comment string) (*entity.ExpenseClaim, error) {
+	return u.decideClaim(ctx, employeeID, id, approverID, comment, entity.ExpenseClaimApproved)
+}
+
+func (u *expenseUsecaseImpl) RejectClaim(ctx context.Context, employeeID, id, approverID int,
+	comment string) (*entity.ExpenseClaim, error) {
+	return u.decideClaim(ctx, employeeID, id, approverID, comment, entity.ExpenseClaimRejected)
+}
+
+// decideClaim records the manager's decision on a submitted claim.
+func (u *expenseUsecaseImpl) decideClaim(ctx context.Context, employeeID, id, approverID int, comment string,
+	status entity.ExpenseClaimStatus) (*entity.ExpenseClaim, error) {
+
+	claim, err := u.GetClaimById(ctx, employeeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if claim.Status != entity.ExpenseClaimSubmitted {
+		return nil, appError.ErrExpenseClaimNotSubmitted
+	}
+	if claim.ApprovalRequestID != nil {
+		return nil, appError.ErrExpenseClaimInApproval
+	}
+
+	employee, err := u.employeeRepository.GetEmployeeById(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+	if err := validateApprover(ctx, u.employeeRepository, employee, approverID); err != nil {
+		return nil, err
+	}
+
+	decidedAt := time.Now().UTC()
+	claim.Status = status
+	claim.DecidedBy = &approverID
+	claim.DecidedAt = &decidedAt
+	claim.DecisionComment = strings.TrimSpace(comment)
+	decidedClaim, err := u.expenseRepository.UpdateClaimStatus(ctx, claim, entity.ExpenseClaimSubmitted)
+	if err != nil {
+		return nil, err
+	}
+	if decidedClaim == nil {
+		return nil, appError.ErrExpenseClaimNotSubmitted
+	}
+	return decidedClaim, nil
+}
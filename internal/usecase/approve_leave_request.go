@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// ApproveLeaveRequest approves a pending request and deducts its days from the balance. Leave
+// that has already started puts the employee on leave straight away; later leave is picked up
+// by ApplyLeaveStatusChanges.
+func (u *leaveUsecaseImpl) ApproveLeaveRequest(ctx context.Context, employeeID, id, approverID int,
+	comment string) (*entity.LeaveRequest, error) {
+
+	request, err := u.GetLeaveRequestById(ctx, employeeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status != entity.LeaveRequestStatusPending {
+		return nil, appError.ErrLeaveRequestClosed
+	}
+	if request.ApprovalRequestID != nil {
+		return nil, appError.ErrLeaveRequestInApproval
+	}
+
+	employee, err := u.getEmployeeForLeave(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateApprover(ctx, u.employeeRepository, employee, approverID); err != nil {
+		return nil, err
+	}
+
+	request.DecidedBy = &approverID
+	request.DecisionComment = strings.TrimSpace(comment)
+	return u.approveLeave(ctx, employee, request)
+}
+
+// approveLeave approves a pending request of employee, whether decided by the manager or by an
+// approval workflow.
+func (u *leaveUsecaseImpl) approveLeave(ctx context.Context, employee *entity.Employee,
+	request *entity.LeaveRequest) (*entity.LeaveRequest, error) {
+
+	leaveType, err := u.GetLeaveTypeById(ctx, request.LeaveTypeID)
+	if err != nil {
+		return nil, err
+	}
+	if leaveType.TracksBalance() {
+		if _, err := u.refreshLeaveBalance(ctx, employee, leaveType, request.StartDate.Year(), today()); err != nil {
+			return nil, err
+		}
+	}
+
+	approvedRequest, err := u.leaveRepository.ApproveLeaveRequest(ctx, request, leaveType.TracksBalance())
+	if err != nil {
+		return nil, err
+	}
+	if approvedRequest == nil {
+		return nil, appError.ErrLeaveRequestClosed
+	}
+
+	if approvedRequest.Covers(today()) {
+		started, err := u.startLeave(ctx, approvedRequest)
+		if err != nil {
+			// The approval stands; the background job retries the status change.
+			log.Printf("[LEAVE] failed to put employee %d on leave for request %d: %v", employee.ID, approvedRequest.ID, err)
+		}
+		approvedRequest.StatusApplied = started
+	}
+	return approvedRequest, nil
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *attendanceUsecaseImpl) ApproveTimesheet(ctx context.Context, employeeID int, week time.Time,
+	approverID int, comment string) (*entity.Timesheet, error) {
+
+	return u.decideTimesheet(ctx, employeeID, week, approverID, comment, entity.TimesheetStatusApproved)
+}
+
+// decideTimesheet records the approver's decision on a submitted timesheet.
+func (u *attendanceUsecaseImpl) decideTimesheet(ctx context.Context, employeeID int, week time.Time,
+	approverID int, comment string, status entity.TimesheetStatus) (*entity.Timesheet, error) {
+
+	employee, err := u.getEmployeeForAttendance(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	zone, err := u.employeeZone(ctx, employee)
+	if err != nil {
+		return nil, err
+	}
+	weekStart, err := parseTimesheetWeek(week, dateIn(time.Now(), zone))
+	if err != nil {
+		return nil, err
+	}
+
+	timesheet, err := u.attendanceRepository.GetTimesheet(ctx, employeeID, weekStart)
+	if err != nil {
+		return nil, err
+	}
+	if timesheet == nil {
+		return nil, appError.ErrTimesheetNotFound
+	}
+	if timesheet.Status != entity.TimesheetStatusSubmitted {
+		return nil, appError.ErrTimesheetClosed
+	}
+	if err := validateApprover(ctx, u.employeeRepository, employee, approverID); err != nil {
+		return nil, err
+	}
+
+	timesheet.Status = status
+	timesheet.DecidedBy = &approverID
+	timesheet.DecisionComment = strings.TrimSpace(comment)
+	decidedTimesheet, err := u.attendanceRepository.DecideTimesheet(ctx, timesheet)
+	if err != nil {
+		return nil, err
+	}
+	if decidedTimesheet == nil {
+		return nil, appError.ErrTimesheetClosed
+	}
+	return decidedTimesheet, nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type AssetUsecase interface {
+	CreateAsset(ctx context.Context, asset *entity.Asset) (*entity.Asset, error)
+	GetAssetById(ctx context.Context, id int) (*entity.Asset, error)
+	GetAssets(ctx context.Context, filter entity.AssetFilter) ([]*entity.Asset, error)
+	// UpdateAsset changes the asset's details. The status of an assigned asset only changes by
+	// returning it.
+	UpdateAsset(ctx context.Context, asset *entity.Asset) (*entity.Asset, error)
+	DeleteAsset(ctx context.Context, id int) error
+
+	// AssignAsset hands an available asset to an employee, today at the employee's location unless
+	// AssignedOn is set.
+	AssignAsset(ctx context.Context, assignment *entity.AssetAssignment) (*entity.AssetAssignment, error)
+	// ReturnAsset takes an assigned asset back, today unless ReturnedOn is set, leaving it in status:
+	// available unless it comes back broken or was lost.
+	ReturnAsset(ctx context.Context, assignment *entity.AssetAssignment, status entity.AssetStatus) (*entity.AssetAssignment, error)
+	GetAssetAssignments(ctx context.Context, assetID int) ([]*entity.AssetAssignment, error)
+	// GetEmployeeAssets returns the assets an employee holds, or held too unless heldOnly is set.
+	GetEmployeeAssets(ctx context.Context, employeeID int, heldOnly bool) ([]*entity.AssetAssignment, error)
+}
+
+type assetUsecaseImpl struct {
+	assetRepository    repository.AssetRepository
+	employeeRepository repository.EmployeeRepository
+	locationRepository repository.LocationRepository
+}
+
+func NewAssetUsecase(assetRepository repository.AssetRepository, employeeRepository repository.EmployeeRepository,
+	locationRepository repository.LocationRepository) AssetUsecase {
+	return &assetUsecaseImpl{
+		assetRepository:    assetRepository,
+		employeeRepository: employeeRepository,
+		locationRepository: locationRepository,
+	}
+}
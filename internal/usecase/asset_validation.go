@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func validateAsset(asset *entity.Asset) error {
+	asset.Name = strings.TrimSpace(asset.Name)
+	asset.SerialNumber = strings.TrimSpace(asset.SerialNumber)
+	asset.Notes = strings.TrimSpace(asset.Notes)
+	if len(asset.Name) < 2 || asset.SerialNumber == "" {
+		return appError.ErrInvalidAsset
+	}
+	if !asset.Type.IsValid() {
+		return appError.ErrInvalidAssetType
+	}
+	if asset.Status != "" && !asset.Status.IsValid() {
+		return appError.ErrInvalidAssetStatus
+	}
+	if asset.PurchaseDate != nil {
+		purchaseDate := dateOnly(*asset.PurchaseDate)
+		if purchaseDate.After(today()) {
+			return appError.ErrInvalidAsset
+		}
+		asset.PurchaseDate = &purchaseDate
+	}
+	return nil
+}
+
+// validateReturnStatus defaults the status a returned asset is left in to available. Returned
+// assets cannot stay assigned.
+func validateReturnStatus(status entity.AssetStatus) (entity.AssetStatus, error) {
+	if status == "" {
+		return entity.AssetStatusAvailable, nil
+	}
+	if !status.IsValid() || status == entity.AssetStatusAssigned {
+		return "", appError.ErrInvalidAssetStatus
+	}
+	return status, nil
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *assetUsecaseImpl) AssignAsset(ctx context.Context, assignment *entity.AssetAssignment) (*entity.AssetAssignment, error) {
+	asset, err := u.GetAssetById(ctx, assignment.AssetID)
+	if err != nil {
+		return nil, err
+	}
+	if asset.Status == entity.AssetStatusAssigned {
+		return nil, appError.ErrAssetAssigned
+	}
+	if asset.Status != entity.AssetStatusAvailable {
+		return nil, appError.ErrAssetNotAvailable
+	}
+
+	if assignment.EmployeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	employee, err := u.employeeRepository.GetEmployeeById(ctx, assignment.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+	if employee.Status == entity.EmploymentStatusTerminated {
+		return nil, appError.ErrEmployeeTerminated
+	}
+
+	localToday, err := localDate(ctx, u.locationRepository, employee.LocationID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if assignment.AssignedOn.IsZero() {
+		assignment.AssignedOn = localToday
+	}
+	assignment.AssignedOn = dateOnly(assignment.AssignedOn)
+	if assignment.AssignedOn.After(localToday) || assignment.AssignedOn.Before(dateOnly(employee.HiredDate)) {
+		return nil, appError.ErrInvalidAssetAssignment
+	}
+	// The history of an asset must not overlap, so it cannot be handed out again before its
+	// last return.
+	history, err := u.assetRepository.GetAssignments(ctx, entity.AssetAssignmentFilter{AssetID: asset.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(history) > 0 && history[0].ReturnedOn != nil && assignment.AssignedOn.Before(*history[0].ReturnedOn) {
+		return nil, appError.ErrInvalidAssetAssignment
+	}
+	assignment.Notes = strings.TrimSpace(assignment.Notes)
+
+	createdAssignment, err := u.assetRepository.AssignAsset(ctx, assignment)
+	if err != nil {
+		return nil, err
+	}
+	if createdAssignment == nil {
+		return nil, appError.ErrAssetNotAvailable
+	}
+	return createdAssignment, nil
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *checklistUsecaseImpl) AssignTask(ctx context.Context, task *entity.ChecklistTask) (*entity.ChecklistTask, error) {
+	existing, err := u.getChecklistTask(ctx, task.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.IsCompleted() || task.DueOn.IsZero() {
+		return nil, appError.ErrInvalidChecklistTask
+	}
+	if err := u.validateTaskOwner(ctx, task.OwnerID); err != nil {
+		return nil, err
+	}
+	task.DueOn = dateOnly(task.DueOn)
+
+	updatedTask, err := u.checklistRepository.UpdateTask(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	if updatedTask == nil {
+		return nil, appError.ErrChecklistTaskNotFound
+	}
+	return updatedTask, nil
+}
+
+func (u *checklistUsecaseImpl) getChecklistTask(ctx context.Context, id int) (*entity.ChecklistTask, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidChecklistTaskId
+	}
+	task, err := u.checklistRepository.GetTaskById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if task == nil {
+		return nil, appError.ErrChecklistTaskNotFound
+	}
+	return task, nil
+}
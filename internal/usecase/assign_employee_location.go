@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *locationUsecaseImpl) AssignEmployeeLocation(ctx context.Context,
+	assignment *entity.LocationAssignment) (*entity.LocationAssignment, error) {
+
+	if err := validateLocationAssignment(assignment); err != nil {
+		return nil, err
+	}
+	employee, err := u.employeeRepository.GetEmployeeById(ctx, assignment.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+	if employee.Status == entity.EmploymentStatusTerminated || assignment.EffectiveDate.Before(dateOnly(employee.HiredDate)) {
+		return nil, appError.ErrInvalidLocationAssignment
+	}
+	location, err := u.GetLocationById(ctx, assignment.LocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if assignment.EffectiveDate.After(location.LocalDate(time.Now())) {
+		return u.locationRepository.CreateScheduledAssignment(ctx, assignment)
+	}
+
+	appliedAssignment, err := u.locationRepository.ApplyAssignment(ctx, assignment)
+	if err != nil {
+		return nil, err
+	}
+	if appliedAssignment == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+
+	invalidateEmployeesListCache(ctx, u.cache)
+	return appliedAssignment, nil
+}
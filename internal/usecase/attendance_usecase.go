@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type AttendanceUsecase interface {
+	// ClockIn opens a shift at the given time, or now when at is zero.
+	ClockIn(ctx context.Context, employeeID int, at time.Time, note string) (*entity.AttendanceEntry, error)
+	// ClockOut closes the employee's open shift at the given time, or now when at is zero.
+	ClockOut(ctx context.Context, employeeID int, at time.Time) (*entity.AttendanceEntry, error)
+	// GetAttendanceEntries lists the shifts clocked in from one date to another inclusive.
+	GetAttendanceEntries(ctx context.Context, employeeID int, from, to time.Time) ([]*entity.AttendanceEntry, error)
+	// UpdateAttendanceEntry corrects the times of a shift, e.g. to fill in a missing clock-out.
+	UpdateAttendanceEntry(ctx context.Context, entry *entity.AttendanceEntry) (*entity.AttendanceEntry, error)
+
+	// GetTimesheet aggregates the week containing week with overtime and missing punches, along
+	// with its submission if there is one.
+	GetTimesheet(ctx context.Context, employeeID int, week time.Time) (*WeeklyTimesheet, error)
+	GetTimesheets(ctx context.Context, employeeID int) ([]*entity.Timesheet, error)
+	// SubmitTimesheet snapshots the week's totals for approval and locks its punches.
+	SubmitTimesheet(ctx context.Context, employeeID int, week time.Time) (*WeeklyTimesheet, error)
+	ApproveTimesheet(ctx context.Context, employeeID int, week time.Time, approverID int, comment string) (*entity.Timesheet, error)
+	// RejectTimesheet sends a submitted week back to the employee, unlocking its punches.
+	RejectTimesheet(ctx context.Context, employeeID int, week time.Time, approverID int, comment string) (*entity.Timesheet, error)
+
+	// GetMissingClockOuts lists the shifts still open after the maximum shift length.
+	GetMissingClockOuts(ctx context.Context) ([]*entity.AttendanceEntry, error)
+}
+
+type attendanceUsecaseImpl struct {
+	attendanceRepository repository.AttendanceRepository
+	employeeRepository   repository.EmployeeRepository
+	leaveRepository      repository.LeaveRepository
+	locationRepository   repository.LocationRepository
+	workingDays          WorkingDayCalculator
+	overtimeRules        entity.OvertimeRules
+	maxShift             time.Duration
+}
+
+func NewAttendanceUsecase(attendanceRepository repository.AttendanceRepository, employeeRepository repository.EmployeeRepository,
+	leaveRepository repository.LeaveRepository, locationRepository repository.LocationRepository,
+	workingDays WorkingDayCalculator, overtimeRules entity.OvertimeRules, maxShift time.Duration) AttendanceUsecase {
+	return &attendanceUsecaseImpl{
+		attendanceRepository: attendanceRepository,
+		employeeRepository:   employeeRepository,
+		leaveRepository:      leaveRepository,
+		locationRepository:   locationRepository,
+		workingDays:          workingDays,
+		overtimeRules:        overtimeRules,
+		maxShift:             maxShift,
+	}
+}
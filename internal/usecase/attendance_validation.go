@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *attendanceUsecaseImpl) getEmployeeForAttendance(ctx context.Context, employeeID int) (*entity.Employee, error) {
+	if employeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	employee, err := u.employeeRepository.GetEmployeeById(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+	return employee, nil
+}
+
+// punchTime defaults a punch to now and rejects punches in the future.
+func punchTime(at time.Time) (time.Time, error) {
+	now := time.Now().UTC().Truncate(time.Second)
+	if at.IsZero() {
+		return now, nil
+	}
+	at = at.UTC()
+	if at.After(now.Add(time.Minute)) {
+		return time.Time{}, appError.ErrInvalidPunchTime
+	}
+	return at, nil
+}
+
+// employeeZone returns the time zone of the employee's location, which decides the day and week
+// their punches count towards.
+func (u *attendanceUsecaseImpl) employeeZone(ctx context.Context, employee *entity.Employee) (*time.Location, error) {
+	return locationZone(ctx, u.locationRepository, employee.LocationID)
+}
+
+// ensureWeekUnlocked fails when the timesheet of the week containing at, in zone, has been
+// submitted or approved.
+func (u *attendanceUsecaseImpl) ensureWeekUnlocked(ctx context.Context, employeeID int, zone *time.Location,
+	at time.Time) error {
+
+	timesheet, err := u.attendanceRepository.GetTimesheet(ctx, employeeID, startOfWeek(at, zone))
+	if err != nil {
+		return err
+	}
+	if timesheet != nil && timesheet.IsLocked() {
+		return appError.ErrTimesheetLocked
+	}
+	return nil
+}
+
+// ensureNoOverlap fails when the shift overlaps another of the employee's shifts.
+func (u *attendanceUsecaseImpl) ensureNoOverlap(ctx context.Context, entry *entity.AttendanceEntry) error {
+	overlapping, err := u.attendanceRepository.GetOverlappingAttendanceEntries(ctx, entry.EmployeeID, entry.ID,
+		entry.ClockIn, entry.ClockOut)
+	if err != nil {
+		return err
+	}
+	if len(overlapping) > 0 {
+		return appError.ErrAttendanceOverlaps
+	}
+	return nil
+}
+
+// parseTimesheetWeek returns the Monday of the week containing the date week, which must not start
+// after today.
+func parseTimesheetWeek(week, today time.Time) (time.Time, error) {
+	if week.IsZero() {
+		return time.Time{}, appError.ErrInvalidTimesheetWeek
+	}
+	weekStart := startOfWeek(week, time.UTC)
+	if weekStart.After(today) {
+		return time.Time{}, appError.ErrInvalidTimesheetWeek
+	}
+	return weekStart, nil
+}
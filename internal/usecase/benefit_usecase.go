@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type BenefitUsecase interface {
+	CreatePlan(ctx context.Context, plan *entity.BenefitPlan) (*entity.BenefitPlan, error)
+	GetPlanById(ctx context.Context, id int) (*entity.BenefitPlan, error)
+	GetAllPlans(ctx context.Context) ([]*entity.BenefitPlan, error)
+	// UpdatePlan changes a plan. New contributions apply to existing enrollments from the next
+	// deductions on; new eligibility rules only to new enrollments.
+	UpdatePlan(ctx context.Context, plan *entity.BenefitPlan) (*entity.BenefitPlan, error)
+	DeletePlan(ctx context.Context, id int) error
+	// GetEligiblePlans returns the plans the employee may enroll in today.
+	GetEligiblePlans(ctx context.Context, employeeID int) ([]*entity.BenefitPlan, error)
+
+	// CreateWindow opens an enrollment period, which may not overlap another one.
+	CreateWindow(ctx context.Context, window *entity.BenefitEnrollmentWindow) (*entity.BenefitEnrollmentWindow, error)
+	GetWindows(ctx context.Context) ([]*entity.BenefitEnrollmentWindow, error)
+	DeleteWindow(ctx context.Context, id int) error
+
+	CreateDependent(ctx context.Context, dependent *entity.Dependent) (*entity.Dependent, error)
+	GetDependents(ctx context.Context, employeeID int) ([]*entity.Dependent, error)
+	UpdateDependent(ctx context.Context, dependent *entity.Dependent) (*entity.Dependent, error)
+	// DeleteDependent removes a dependent along with their coverage.
+	DeleteDependent(ctx context.Context, employeeID, id int) error
+
+	// Enroll covers an employee by an eligible plan, from the coverage start of the open
+	// enrollment window, or from today for employees hired in the last 30 days. Employees hold one
+	// plan of each type, other than other.
+	Enroll(ctx context.Context, enrollment *entity.BenefitEnrollment) (*entity.BenefitEnrollment, error)
+	GetEnrollments(ctx context.Context, employeeID int) ([]*entity.BenefitEnrollment, error)
+	GetEnrollmentById(ctx context.Context, employeeID, id int) (*entity.BenefitEnrollment, error)
+	// EndEnrollment ends started coverage at the end of the current month.
+	EndEnrollment(ctx context.Context, employeeID, id int) (*entity.BenefitEnrollment, error)
+	// WithdrawEnrollment removes an enrollment whose coverage has not started yet.
+	WithdrawEnrollment(ctx context.Context, employeeID, id int) error
+
+	// GetDeductions returns what payroll deducts in a month for every enrollment covering some day
+	// of it, at the plans' current contributions. Deductions are not prorated and stop after the
+	// month an employee is terminated in. employeeID narrows them to one employee when positive.
+	GetDeductions(ctx context.Context, year int, month time.Month, employeeID int) ([]*entity.BenefitDeduction, error)
+}
+
+type benefitUsecaseImpl struct {
+	benefitRepository  repository.BenefitRepository
+	employeeRepository repository.EmployeeRepository
+	locationRepository repository.LocationRepository
+}
+
+func NewBenefitUsecase(benefitRepository repository.BenefitRepository, employeeRepository repository.EmployeeRepository,
+	locationRepository repository.LocationRepository) BenefitUsecase {
+	return &benefitUsecaseImpl{
+		benefitRepository:  benefitRepository,
+		employeeRepository: employeeRepository,
+		locationRepository: locationRepository,
+	}
+}
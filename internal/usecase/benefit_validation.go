@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// newHireEnrollmentDays is how long after being hired employees may enroll outside an enrollment
+// window.
+const newHireEnrollmentDays = 30
+
+// defaultBenefitStatuses are the employment statuses eligible for plans that do not name any.
+var defaultBenefitStatuses = []entity.EmploymentStatus{entity.EmploymentStatusActive}
+
+func validateBenefitPlan(plan *entity.BenefitPlan) error {
+	plan.Name = strings.TrimSpace(plan.Name)
+	plan.Description = strings.TrimSpace(plan.Description)
+	plan.Currency = strings.ToUpper(strings.TrimSpace(plan.Currency))
+	if len(plan.Name) < 2 || !plan.Type.IsValid() || !entity.IsValidCurrency(plan.Currency) {
+		return appError.ErrInvalidBenefitPlan
+	}
+	if plan.EmployeeContribution < 0 || plan.EmployerContribution < 0 || plan.DependentContribution < 0 {
+		return appError.ErrInvalidBenefitPlan
+	}
+	if !plan.CoversDependents && plan.DependentContribution > 0 {
+		return appError.ErrInvalidBenefitPlan
+	}
+
+	eligibility := &plan.Eligibility
+	if eligibility.MinTenureMonths < 0 {
+		return appError.ErrInvalidBenefitPlan
+	}
+	if len(eligibility.Statuses) == 0 {
+		eligibility.Statuses = defaultBenefitStatuses
+	}
+	for _, status := range eligibility.Statuses {
+		if !status.IsValid() {
+			return appError.ErrInvalidBenefitPlan
+		}
+	}
+	for _, locationID := range eligibility.LocationIDs {
+		if locationID <= 0 {
+			return appError.ErrInvalidLocation
+		}
+	}
+	return nil
+}
+
+func validateEnrollmentWindow(window *entity.BenefitEnrollmentWindow) error {
+	window.Name = strings.TrimSpace(window.Name)
+	if window.Name == "" || window.StartsOn.IsZero() || window.EndsOn.IsZero() || window.CoverageStartsOn.IsZero() {
+		return appError.ErrInvalidEnrollmentWindow
+	}
+	window.StartsOn = dateOnly(window.StartsOn)
+	window.EndsOn = dateOnly(window.EndsOn)
+	window.CoverageStartsOn = dateOnly(window.CoverageStartsOn)
+	if window.EndsOn.Before(window.StartsOn) || window.CoverageStartsOn.Before(window.StartsOn) {
+		return appError.ErrInvalidEnrollmentWindow
+	}
+	return nil
+}
+
+func validateDependent(dependent *entity.Dependent) error {
+	dependent.Name = strings.TrimSpace(dependent.Name)
+	if dependent.Name == "" || !dependent.Relationship.IsValid() {
+		return appError.ErrInvalidDependent
+	}
+	if dependent.DateOfBirth != nil {
+		dateOfBirth := dateOnly(*dependent.DateOfBirth)
+		if dateOfBirth.After(today()) {
+			return appError.ErrInvalidDependent
+		}
+		dependent.DateOfBirth = &dateOfBirth
+	}
+	return nil
+}
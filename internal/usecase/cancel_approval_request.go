@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *approvalUsecaseImpl) CancelRequest(ctx context.Context, id int) (*entity.ApprovalRequest, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidApprovalRequestId
+	}
+	request, err := u.approvalRepository.CancelRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	u.rejectApproval(ctx, request)
+	return request, nil
+}
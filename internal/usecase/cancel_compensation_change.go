@@ -0,0 +1,18 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CancelCompensationChange withdraws a change that has not been applied yet.
+func (u *compensationUsecaseImpl) CancelCompensationChange(ctx context.Context, employeeID, id int) error {
+	if employeeID <= 0 {
+		return appError.ErrInvalidEmployeeId
+	}
+	if id <= 0 {
+		return appError.ErrInvalidCompensationChangeId
+	}
+	return u.compensationRepository.CancelScheduledChange(ctx, employeeID, id)
+}
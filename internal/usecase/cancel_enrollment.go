@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *trainingUsecaseImpl) CancelEnrollment(ctx context.Context, id int) (*entity.TrainingEnrollment, error) {
+	if _, err := u.getOpenEnrollment(ctx, id); err != nil {
+		return nil, err
+	}
+
+	cancelledEnrollment, err := u.trainingRepository.CancelEnrollment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if cancelledEnrollment == nil {
+		return nil, appError.ErrEnrollmentNotOpen
+	}
+	return cancelledEnrollment, nil
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *recruitingUsecaseImpl) CancelInterview(ctx context.Context, id int) (*entity.Interview, error) {
+	interview, err := u.getScheduledInterview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	interview.Status = entity.InterviewCancelled
+	cancelledInterview, err := u.recruitingRepository.UpdateInterview(ctx, interview)
+	if err != nil {
+		return nil, err
+	}
+	if cancelledInterview == nil {
+		return nil, appError.ErrInterviewNotFound
+	}
+	return cancelledInterview, nil
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"log"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CancelLeaveRequest withdraws a pending or approved request. Approved days go back to the
+// balance, and an employee on leave because of the request becomes active again. A pending
+// approval request for the leave is cancelled with it.
+func (u *leaveUsecaseImpl) CancelLeaveRequest(ctx context.Context, employeeID, id int) (*entity.LeaveRequest, error) {
+	request, err := u.GetLeaveRequestById(ctx, employeeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status != entity.LeaveRequestStatusPending && request.Status != entity.LeaveRequestStatusApproved {
+		return nil, appError.ErrLeaveRequestClosed
+	}
+
+	cancelledRequest, err := u.leaveRepository.CancelLeaveRequest(ctx, request, true)
+	if err != nil {
+		return nil, err
+	}
+	if cancelledRequest == nil {
+		return nil, appError.ErrLeaveRequestClosed
+	}
+
+	if request.Status == entity.LeaveRequestStatusPending && request.ApprovalRequestID != nil {
+		if _, err := u.approvals.CancelRequest(ctx, *request.ApprovalRequestID); err != nil {
+			log.Printf("[LEAVE] failed to cancel approval request %d of request %d: %v",
+				*request.ApprovalRequestID, cancelledRequest.ID, err)
+		}
+	}
+
+	if cancelledRequest.StatusApplied {
+		if err := u.endLeave(ctx, cancelledRequest, today()); err != nil {
+			log.Printf("[LEAVE] failed to end leave of employee %d for request %d: %v", employeeID, cancelledRequest.ID, err)
+		} else {
+			cancelledRequest.StatusApplied = false
+		}
+	}
+	return cancelledRequest, nil
+}
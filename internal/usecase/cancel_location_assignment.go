@@ -0,0 +1,18 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// CancelLocationAssignment cancels an assignment that has not been applied yet.
+func (u *locationUsecaseImpl) CancelLocationAssignment(ctx context.Context, employeeID, id int) error {
+	if employeeID <= 0 {
+		return appError.ErrInvalidEmployeeId
+	}
+	if id <= 0 {
+		return appError.ErrInvalidLocationAssignmentId
+	}
+	return u.locationRepository.CancelScheduledAssignment(ctx, employeeID, id)
+}
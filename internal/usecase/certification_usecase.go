@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/notification"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type CertificationUsecase interface {
+	CreateCertification(ctx context.Context, certification *entity.Certification) (*entity.Certification, error)
+	GetCertificationById(ctx context.Context, id int) (*entity.Certification, error)
+	GetEmployeeCertifications(ctx context.Context, employeeID int) ([]*entity.Certification, error)
+	UpdateCertification(ctx context.Context, certification *entity.Certification) (*entity.Certification, error)
+	DeleteCertification(ctx context.Context, id int) error
+
+	// GetExpiringCertifications returns the certifications of current employees expiring between
+	// asOf and withinDays later, using the configured window when withinDays is zero.
+	GetExpiringCertifications(ctx context.Context, asOf time.Time, withinDays int) ([]*entity.Certification, error)
+	// NotifyExpiringCertifications sends an expiry notice to the holder and their manager for each
+	// certification entering the configured window that has not been announced yet. It returns the
+	// number of notices sent.
+	NotifyExpiringCertifications(ctx context.Context, asOf time.Time) (int, error)
+
+	// AddEvidence attaches a PDF or image file to the certification.
+	AddEvidence(ctx context.Context, certificationID int, fileName string, content []byte) (*entity.CertificationEvidence, error)
+	GetEvidence(ctx context.Context, certificationID int) ([]*entity.CertificationEvidence, error)
+	GetEvidenceById(ctx context.Context, certificationID, id int) (*entity.CertificationEvidence, error)
+	DeleteEvidence(ctx context.Context, certificationID, id int) error
+}
+
+type certificationUsecaseImpl struct {
+	certificationRepository repository.CertificationRepository
+	employeeRepository      repository.EmployeeRepository
+	notifier                notification.Notifier
+	expiryWindowDays        int
+}
+
+func NewCertificationUsecase(certificationRepository repository.CertificationRepository,
+	employeeRepository repository.EmployeeRepository, notifier notification.Notifier, expiryWindowDays int) CertificationUsecase {
+	return &certificationUsecaseImpl{
+		certificationRepository: certificationRepository,
+		employeeRepository:      employeeRepository,
+		notifier:                notifier,
+		expiryWindowDays:        expiryWindowDays,
+	}
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// MaxEvidenceSize caps evidence files at 5 MB, plenty for a scanned certificate.
+const MaxEvidenceSize = 5 << 20
+
+// maxExpiryWindowDays is the furthest ahead expiring certifications can be looked up.
+const maxExpiryWindowDays = 365
+
+// evidenceContentTypes are the file types accepted as evidence, as sniffed from their contents.
+var evidenceContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/png":       true,
+	"image/jpeg":      true,
+}
+
+// certificationHolderStatuses are the employment statuses whose certifications still matter.
+var certificationHolderStatuses = []entity.EmploymentStatus{
+	entity.EmploymentStatusActive,
+	entity.EmploymentStatusOnLeave,
+	entity.EmploymentStatusSuspended,
+}
+
+func validateCertification(certification *entity.Certification) error {
+	certification.Name = strings.TrimSpace(certification.Name)
+	certification.Issuer = strings.TrimSpace(certification.Issuer)
+	certification.CredentialID = strings.TrimSpace(certification.CredentialID)
+	if certification.Name == "" || certification.IssuedOn.IsZero() {
+		return appError.ErrInvalidCertification
+	}
+
+	certification.IssuedOn = dateOnly(certification.IssuedOn)
+	if certification.IssuedOn.After(today()) {
+		return appError.ErrInvalidCertification
+	}
+	if certification.ExpiresOn != nil {
+		expiresOn := dateOnly(*certification.ExpiresOn)
+		if !expiresOn.After(certification.IssuedOn) {
+			return appError.ErrInvalidCertification
+		}
+		certification.ExpiresOn = &expiresOn
+	}
+	return nil
+}
+
+// validateEvidence checks the size and sniffed type of an evidence file and fills in its
+// metadata. The client's file name is kept without any directories.
+func validateEvidence(evidence *entity.CertificationEvidence) error {
+	if len(evidence.Content) > MaxEvidenceSize {
+		return appError.ErrEvidenceTooLarge
+	}
+	name, contentType, ok := validateUpload(evidence.FileName, "evidence", evidence.Content, evidenceContentTypes)
+	if !ok {
+		return appError.ErrInvalidEvidence
+	}
+	evidence.FileName = name
+	evidence.ContentType = contentType
+	evidence.Size = int64(len(evidence.Content))
+	return nil
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// ChangeEmploymentStatus moves an employee between the non-terminal statuses
+// (candidate, active, on_leave, suspended). Terminations and rehires have their
+// own usecases because they carry extra data.
+func (u *employeeUsecaseImpl) ChangeEmploymentStatus(ctx context.Context,
+	change *entity.EmploymentStatusChange) (*entity.Employee, error) {
+
+	if change.EmployeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	if !change.ToStatus.IsValid() {
+		return nil, appError.ErrInvalidEmploymentStatus
+	}
+	if change.EffectiveDate.IsZero() {
+		return nil, appError.ErrInvalidEffectiveDate
+	}
+
+	employee, err := u.GetEmployeeById(ctx, change.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	if change.ToStatus == entity.EmploymentStatusTerminated || employee.Status == entity.EmploymentStatusTerminated {
+		return nil, appError.ErrInvalidStatusTransition
+	}
+
+	change.Reason = strings.TrimSpace(change.Reason)
+	return u.transitionEmploymentStatus(ctx, employee, change)
+}
+
+// transitionEmploymentStatus moves employee, as loaded from the repository, to change.ToStatus.
+// Callers may adjust other status-related fields of employee (dates, reasons) beforehand.
+func (u *employeeUsecaseImpl) transitionEmploymentStatus(ctx context.Context,
+	employee *entity.Employee, change *entity.EmploymentStatusChange) (*entity.Employee, error) {
+
+	if !employee.Status.CanTransitionTo(change.ToStatus) {
+		return nil, appError.ErrInvalidStatusTransition
+	}
+	change.EmployeeID = employee.ID
+	change.FromStatus = employee.Status
+	employee.Status = change.ToStatus
+
+	updatedEmployee, err := u.employeeRepository.UpdateEmploymentStatus(ctx, employee, change)
+	if err != nil {
+		return nil, err
+	}
+	if updatedEmployee == nil {
+		return nil, appError.ErrEmploymentStatusChanged
+	}
+
+	invalidateEmployeesListCache(ctx, u.cache)
+	return updatedEmployee, nil
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type ChecklistUsecase interface {
+	CreateTemplate(ctx context.Context, template *entity.ChecklistTemplate) (*entity.ChecklistTemplate, error)
+	GetTemplateById(ctx context.Context, id int) (*entity.ChecklistTemplate, error)
+	GetTemplates(ctx context.Context, kind entity.ChecklistKind) ([]*entity.ChecklistTemplate, error)
+	// UpdateTemplate replaces the template's details and tasks. Checklists already started from it
+	// keep their tasks.
+	UpdateTemplate(ctx context.Context, template *entity.ChecklistTemplate) (*entity.ChecklistTemplate, error)
+	DeleteTemplate(ctx context.Context, id int) error
+
+	// StartChecklist copies the most specific template of kind for the employee into a checklist,
+	// assigning each task to its owner with a due date counted from the hire or termination date.
+	StartChecklist(ctx context.Context, employeeID int, kind entity.ChecklistKind) (*entity.Checklist, error)
+	GetChecklistById(ctx context.Context, id int) (*entity.Checklist, error)
+	GetChecklists(ctx context.Context, filter entity.ChecklistFilter) ([]*entity.Checklist, error)
+	GetEmployeeChecklists(ctx context.Context, employeeID int) ([]*entity.Checklist, error)
+
+	// GetOwnerTasks returns the open tasks assigned to an employee, by due date.
+	GetOwnerTasks(ctx context.Context, ownerID int) ([]*entity.ChecklistTask, error)
+	// AssignTask changes the owner and due date of an open task; a nil owner unassigns it.
+	AssignTask(ctx context.Context, task *entity.ChecklistTask) (*entity.ChecklistTask, error)
+	// CompleteTask and ReopenTask return the task's checklist, which completes with its last task.
+	// The last task of an offboarding cannot be completed while the employee still holds assets.
+	CompleteTask(ctx context.Context, id int) (*entity.Checklist, error)
+	ReopenTask(ctx context.Context, id int) (*entity.Checklist, error)
+}
+
+type checklistUsecaseImpl struct {
+	checklistRepository repository.ChecklistRepository
+	employeeRepository  repository.EmployeeRepository
+}
+
+func NewChecklistUsecase(checklistRepository repository.ChecklistRepository,
+	employeeRepository repository.EmployeeRepository) ChecklistUsecase {
+	return &checklistUsecaseImpl{
+		checklistRepository: checklistRepository,
+		employeeRepository:  employeeRepository,
+	}
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const (
+	maxChecklistTasks   = 50
+	maxChecklistDueDays = 365
+)
+
+func validateChecklistTemplate(template *entity.ChecklistTemplate) error {
+	template.Name = strings.TrimSpace(template.Name)
+	if len(template.Name) < 3 || !template.Kind.IsValid() {
+		return appError.ErrInvalidChecklistTemplate
+	}
+	if template.PositionID != nil && *template.PositionID <= 0 {
+		return appError.ErrInvalidChecklistTemplate
+	}
+	if template.DepartmentID != nil && *template.DepartmentID <= 0 {
+		return appError.ErrInvalidChecklistTemplate
+	}
+
+	if len(template.Tasks) == 0 || len(template.Tasks) > maxChecklistTasks {
+		return appError.ErrInvalidChecklistTemplate
+	}
+	for i := range template.Tasks {
+		task := &template.Tasks[i]
+		task.Title = strings.TrimSpace(task.Title)
+		task.Description = strings.TrimSpace(task.Description)
+		if len(task.Title) < 3 || !task.OwnerRole.IsValid() {
+			return appError.ErrInvalidChecklistTemplate
+		}
+		if (task.OwnerRole == entity.ChecklistOwnerDesignated) != (task.OwnerID != nil) {
+			return appError.ErrInvalidChecklistTemplate
+		}
+		if task.OwnerID != nil && *task.OwnerID <= 0 {
+			return appError.ErrInvalidChecklistTemplate
+		}
+		if task.DueDays < -maxChecklistDueDays || task.DueDays > maxChecklistDueDays {
+			return appError.ErrInvalidChecklistTemplate
+		}
+	}
+	return nil
+}
+
+func validateChecklistFilter(filter entity.ChecklistFilter) error {
+	if filter.Kind != "" && !filter.Kind.IsValid() {
+		return appError.ErrInvalidChecklistKind
+	}
+	return nil
+}
+
+// checklistStartDate returns the date the tasks of a checklist of kind for employee are due from:
+// the hire date of onboardings and the termination date of offboardings.
+func checklistStartDate(employee *entity.Employee, kind entity.ChecklistKind) (time.Time, error) {
+	switch kind {
+	case entity.ChecklistKindOnboarding:
+		if employee.Status == entity.EmploymentStatusTerminated {
+			return time.Time{}, appError.ErrChecklistNotApplicable
+		}
+		return dateOnly(employee.HiredDate), nil
+	case entity.ChecklistKindOffboarding:
+		if employee.Status != entity.EmploymentStatusTerminated || employee.TerminationDate == nil {
+			return time.Time{}, appError.ErrChecklistNotApplicable
+		}
+		return dateOnly(*employee.TerminationDate), nil
+	}
+	return time.Time{}, appError.ErrInvalidChecklistKind
+}
+
+// checklistTaskOwner returns who a template task is assigned to for employee, or nil when the task
+// belongs to a manager the employee does not have.
+func checklistTaskOwner(task entity.ChecklistTemplateTask, employee *entity.Employee) *int {
+	switch task.OwnerRole {
+	case entity.ChecklistOwnerEmployee:
+		return &employee.ID
+	case entity.ChecklistOwnerManager:
+		return employee.ManagerID
+	}
+	return task.OwnerID
+}
+
+// validateTaskOwner checks that ownerID, when set, is a current employee.
+func (u *checklistUsecaseImpl) validateTaskOwner(ctx context.Context, ownerID *int) error {
+	if ownerID == nil {
+		return nil
+	}
+	if *ownerID <= 0 {
+		return appError.ErrInvalidChecklistTask
+	}
+	owner, err := u.employeeRepository.GetEmployeeById(ctx, *ownerID)
+	if err != nil {
+		return err
+	}
+	if owner == nil || owner.Status == entity.EmploymentStatusTerminated {
+		return appError.ErrInvalidChecklistTask
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *attendanceUsecaseImpl) ClockIn(ctx context.Context, employeeID int, at time.Time,
+	note string) (*entity.AttendanceEntry, error) {
+
+	employee, err := u.getEmployeeForAttendance(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee.Status != entity.EmploymentStatusActive {
+		return nil, appError.ErrEmployeeNotActive
+	}
+
+	clockIn, err := punchTime(at)
+	if err != nil {
+		return nil, err
+	}
+	// The hire date is a date at the employee's location, so compare it with the local date.
+	zone, err := u.employeeZone(ctx, employee)
+	if err != nil {
+		return nil, err
+	}
+	if dateIn(clockIn, zone).Before(dateOnly(employee.HiredDate)) {
+		return nil, appError.ErrInvalidPunchTime
+	}
+	if err := u.ensureWeekUnlocked(ctx, employeeID, zone, clockIn); err != nil {
+		return nil, err
+	}
+
+	entry := &entity.AttendanceEntry{
+		EmployeeID: employeeID,
+		ClockIn:    clockIn,
+		Note:       strings.TrimSpace(note),
+	}
+	if err := u.ensureNoOverlap(ctx, entry); err != nil {
+		return nil, err
+	}
+	return u.attendanceRepository.CreateAttendanceEntry(ctx, entry)
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *attendanceUsecaseImpl) ClockOut(ctx context.Context, employeeID int, at time.Time) (*entity.AttendanceEntry, error) {
+	if _, err := u.getEmployeeForAttendance(ctx, employeeID); err != nil {
+		return nil, err
+	}
+
+	entry, err := u.attendanceRepository.GetOpenAttendanceEntry(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, appError.ErrNotClockedIn
+	}
+
+	clockOut, err := punchTime(at)
+	if err != nil {
+		return nil, err
+	}
+	if !clockOut.After(entry.ClockIn) {
+		return nil, appError.ErrInvalidPunchTime
+	}
+	entry.ClockOut = &clockOut
+
+	closedEntry, err := u.attendanceRepository.UpdateAttendanceEntry(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+	if closedEntry == nil {
+		return nil, appError.ErrNotClockedIn
+	}
+	return closedEntry, nil
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *reviewUsecaseImpl) CloseReviewCycle(ctx context.Context, id int) (*entity.ReviewCycle, error) {
+	cycle, err := u.GetReviewCycleById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if cycle.Status != entity.ReviewCycleStatusActive {
+		return nil, appError.ErrReviewCycleNotActive
+	}
+
+	closedCycle, err := u.reviewRepository.CloseReviewCycle(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if closedCycle == nil {
+		// The cycle was closed concurrently.
+		return nil, appError.ErrReviewCycleNotActive
+	}
+	return closedCycle, nil
+}
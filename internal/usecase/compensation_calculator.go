@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// PaySegment is a stretch of a month paid at one salary.
+type PaySegment struct {
+	Start  time.Time
+	End    time.Time
+	Salary entity.Money
+	Days   int          // calendar or working days, depending on the basis
+	Amount entity.Money // in the currency of the pay
+}
+
+// ProratedPay is an employee's base pay for a month: their monthly pay, cut down to the days they
+// were employed and split where their salary changed.
+type ProratedPay struct {
+	EmployeeID int
+	Start      time.Time
+	End        time.Time
+	Basis      entity.ProrationBasis
+	PayPeriod  entity.PayPeriod
+	MonthDays  int          // days of the month on the basis
+	PaidDays   int          // days of the month the employee was employed
+	Segments   []PaySegment // none when the employee was not employed during the month
+	Amount     entity.Money
+}
+
+// IsProrated reports whether the pay differs from a full month at a single salary.
+func (p *ProratedPay) IsProrated() bool {
+	return p.PaidDays != p.MonthDays || len(p.Segments) > 1
+}
+
+// CompensationCalculator works out what an employee earns in a month from their hire and
+// termination dates and their compensation timeline, including changes that are still scheduled.
+// An empty basis uses the configured default.
+type CompensationCalculator interface {
+	CalculateMonthlyPay(ctx context.Context, employee *entity.Employee, year int, month time.Month,
+		basis entity.ProrationBasis) (*ProratedPay, error)
+	GetPayPreview(ctx context.Context, employeeID int, year int, month time.Month,
+		basis entity.ProrationBasis) (*ProratedPay, error)
+}
+
+type compensationCalculatorImpl struct {
+	compensationRepository repository.CompensationRepository
+	employeeRepository     repository.EmployeeRepository
+	workingDays            WorkingDayCalculator
+	exchangeRates          ExchangeRateUsecase
+	defaultBasis           entity.ProrationBasis
+}
+
+func NewCompensationCalculator(compensationRepository repository.CompensationRepository,
+	employeeRepository repository.EmployeeRepository, workingDays WorkingDayCalculator,
+	exchangeRates ExchangeRateUsecase, defaultBasis entity.ProrationBasis) CompensationCalculator {
+	return &compensationCalculatorImpl{
+		compensationRepository: compensationRepository,
+		employeeRepository:     employeeRepository,
+		workingDays:            workingDays,
+		exchangeRates:          exchangeRates,
+		defaultBasis:           defaultBasis,
+	}
+}
+
+func (c *compensationCalculatorImpl) GetPayPreview(ctx context.Context, employeeID int, year int, month time.Month,
+	basis entity.ProrationBasis) (*ProratedPay, error) {
+
+	if employeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	employee, err := c.employeeRepository.GetEmployeeById(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+	return c.CalculateMonthlyPay(ctx, employee, year, month, basis)
+}
+
+func (c *compensationCalculatorImpl) CalculateMonthlyPay(ctx context.Context, employee *entity.Employee, year int,
+	month time.Month, basis entity.ProrationBasis) (*ProratedPay, error) {
+
+	if basis == "" {
+		basis = c.defaultBasis
+	}
+	if !basis.IsValid() {
+		return nil, appError.ErrInvalidProrationBasis
+	}
+	if year < 1 || month < time.January || month > time.December {
+		return nil, appError.ErrInvalidPayPreviewPeriod
+	}
+
+	start := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, -1)
+	currency := employee.Salary.Currency
+	pay := &ProratedPay{
+		EmployeeID: employee.ID,
+		Start:      start,
+		End:        end,
+		Basis:      basis,
+		PayPeriod:  employee.PayPeriod,
+		Segments:   []PaySegment{},
+		Amount:     entity.Money{Currency: currency},
+	}
+
+	monthDays, err := c.countDays(ctx, employee.ID, start, end, basis)
+	if err != nil {
+		return nil, err
+	}
+	pay.MonthDays = monthDays
+
+	// Only the days from hire to termination, both inclusive, are paid.
+	from, to := start, end
+	if hired := dateOnly(employee.HiredDate); hired.After(from) {
+		from = hired
+	}
+	if employee.TerminationDate != nil && dateOnly(*employee.TerminationDate).Before(to) {
+		to = dateOnly(*employee.TerminationDate)
+	}
+	if from.After(to) || monthDays == 0 {
+		return pay, nil
+	}
+
+	changes, err := c.compensationRepository.GetChangesByEmployeeId(ctx, employee.ID)
+	if err != nil {
+		return nil, err
+	}
+	fullMonth := float64(monthDays)
+	for _, segment := range salarySegments(employee.Salary, changes, from, to) {
+		days, err := c.countDays(ctx, employee.ID, segment.Start, segment.End, basis)
+		if err != nil {
+			return nil, err
+		}
+		amount := entity.Money{
+			Amount:   int64(math.Round(float64(monthlyPay(segment.Salary, employee.PayPeriod)*int64(days)) / fullMonth)),
+			Currency: segment.Salary.Currency,
+		}
+		if amount.Currency != currency {
+			amount, _, _, err = c.exchangeRates.ConvertMoney(ctx, amount, currency, end)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		segment.Days = days
+		segment.Amount = amount
+		pay.Segments = append(pay.Segments, segment)
+		pay.PaidDays += days
+		pay.Amount.Amount += amount.Amount
+	}
+	return pay, nil
+}
+
+func (c *compensationCalculatorImpl) countDays(ctx context.Context, employeeID int, start, end time.Time,
+	basis entity.ProrationBasis) (int, error) {
+
+	if basis == entity.ProrationBasisWorkingDays {
+		return c.workingDays.CountWorkingDays(ctx, employeeID, start, end)
+	}
+	return int(end.Sub(start).Hours()/24) + 1, nil
+}
+
+// salarySegments splits from..to wherever the salary changes. changes are ordered by effective
+// date; cancelled ones are ignored and scheduled ones count from their effective date. Without
+// any changes the current salary applies throughout.
+func salarySegments(current entity.Money, changes []*entity.CompensationChange, from, to time.Time) []PaySegment {
+	timeline := []*entity.CompensationChange{}
+	for _, change := range changes {
+		if change.Status != entity.CompensationChangeCancelled {
+			timeline = append(timeline, change)
+		}
+	}
+
+	salary := current
+	if len(timeline) > 0 {
+		// Before the first change the employee was on its old salary, if it recorded one.
+		salary = timeline[0].NewSalary
+		if !timeline[0].OldSalary.IsZero() {
+			salary = timeline[0].OldSalary
+		}
+	}
+	for _, change := range timeline {
+		if dateOnly(change.EffectiveDate).After(from) {
+			break
+		}
+		salary = change.NewSalary
+	}
+
+	segments := []PaySegment{{Start: from, End: to, Salary: salary}}
+	for _, change := range timeline {
+		effective := dateOnly(change.EffectiveDate)
+		if !effective.After(from) || effective.After(to) {
+			continue
+		}
+		last := &segments[len(segments)-1]
+		if change.NewSalary == last.Salary {
+			continue
+		}
+		if effective.Equal(last.Start) {
+			// Two changes on the same day: the later one wins.
+			last.Salary = change.NewSalary
+			continue
+		}
+		last.End = effective.AddDate(0, 0, -1)
+		segments = append(segments, PaySegment{Start: effective, End: to, Salary: change.NewSalary})
+	}
+	return segments
+}
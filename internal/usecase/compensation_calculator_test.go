@@ -0,0 +1,328 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func payMoney(amount int64) entity.Money {
+	return entity.Money{Amount: amount, Currency: "USD"}
+}
+
+func payDate(value string) time.Time {
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func payChange(effective string, oldSalary, newSalary int64, status entity.CompensationChangeStatus) *entity.CompensationChange {
+	return &entity.CompensationChange{
+		EffectiveDate: payDate(effective),
+		OldSalary:     payMoney(oldSalary),
+		NewSalary:     payMoney(newSalary),
+		Status:        status,
+	}
+}
+
+func TestSalarySegments(t *testing.T) {
+	applied, scheduled, cancelled := entity.CompensationChangeApplied, entity.CompensationChangeScheduled,
+		entity.CompensationChangeCancelled
+
+	tests := []struct {
+		name    string
+		current int64
+		changes []*entity.CompensationChange
+		from    string
+		to      string
+		want    []PaySegment
+	}{
+		{
+			name:    "no changes",
+			current: 300000,
+			from:    "2025-03-01", to: "2025-03-31",
+			want: []PaySegment{{Start: payDate("2025-03-01"), End: payDate("2025-03-31"), Salary: payMoney(300000)}},
+		},
+		{
+			name:    "initial salary only",
+			current: 300000,
+			changes: []*entity.CompensationChange{payChange("2024-06-01", 0, 300000, applied)},
+			from:    "2025-03-01", to: "2025-03-31",
+			want: []PaySegment{{Start: payDate("2025-03-01"), End: payDate("2025-03-31"), Salary: payMoney(300000)}},
+		},
+		{
+			name:    "change before the period",
+			current: 360000,
+			changes: []*entity.CompensationChange{payChange("2025-01-01", 300000, 360000, applied)},
+			from:    "2025-03-01", to: "2025-03-31",
+			want: []PaySegment{{Start: payDate("2025-03-01"), End: payDate("2025-03-31"), Salary: payMoney(360000)}},
+		},
+		{
+			name:    "change mid-month",
+			current: 360000,
+			changes: []*entity.CompensationChange{payChange("2025-03-16", 300000, 360000, applied)},
+			from:    "2025-03-01", to: "2025-03-31",
+			want: []PaySegment{
+				{Start: payDate("2025-03-01"), End: payDate("2025-03-15"), Salary: payMoney(300000)},
+				{Start: payDate("2025-03-16"), End: payDate("2025-03-31"), Salary: payMoney(360000)},
+			},
+		},
+		{
+			name:    "change on the first paid day",
+			current: 360000,
+			changes: []*entity.CompensationChange{payChange("2025-03-10", 300000, 360000, applied)},
+			from:    "2025-03-10", to: "2025-03-31",
+			want: []PaySegment{{Start: payDate("2025-03-10"), End: payDate("2025-03-31"), Salary: payMoney(360000)}},
+		},
+		{
+			name:    "change after the period uses the old salary",
+			current: 300000,
+			changes: []*entity.CompensationChange{payChange("2025-04-01", 300000, 360000, scheduled)},
+			from:    "2025-03-01", to: "2025-03-31",
+			want: []PaySegment{{Start: payDate("2025-03-01"), End: payDate("2025-03-31"), Salary: payMoney(300000)}},
+		},
+		{
+			name:    "scheduled change counts from its effective date",
+			current: 300000,
+			changes: []*entity.CompensationChange{payChange("2025-03-20", 300000, 330000, scheduled)},
+			from:    "2025-03-01", to: "2025-03-31",
+			want: []PaySegment{
+				{Start: payDate("2025-03-01"), End: payDate("2025-03-19"), Salary: payMoney(300000)},
+				{Start: payDate("2025-03-20"), End: payDate("2025-03-31"), Salary: payMoney(330000)},
+			},
+		},
+		{
+			name:    "cancelled change ignored",
+			current: 300000,
+			changes: []*entity.CompensationChange{
+				payChange("2024-06-01", 0, 300000, applied),
+				payChange("2025-03-16", 300000, 900000, cancelled),
+			},
+			from: "2025-03-01", to: "2025-03-31",
+			want: []PaySegment{{Start: payDate("2025-03-01"), End: payDate("2025-03-31"), Salary: payMoney(300000)}},
+		},
+		{
+			name:    "two changes on the same day, the later wins",
+			current: 380000,
+			changes: []*entity.CompensationChange{
+				payChange("2025-03-16", 300000, 360000, applied),
+				payChange("2025-03-16", 360000, 380000, applied),
+			},
+			from: "2025-03-01", to: "2025-03-31",
+			want: []PaySegment{
+				{Start: payDate("2025-03-01"), End: payDate("2025-03-15"), Salary: payMoney(300000)},
+				{Start: payDate("2025-03-16"), End: payDate("2025-03-31"), Salary: payMoney(380000)},
+			},
+		},
+		{
+			name:    "change to the same salary does not split",
+			current: 300000,
+			changes: []*entity.CompensationChange{payChange("2025-03-16", 300000, 300000, applied)},
+			from:    "2025-03-01", to: "2025-03-31",
+			want: []PaySegment{{Start: payDate("2025-03-01"), End: payDate("2025-03-31"), Salary: payMoney(300000)}},
+		},
+		{
+			name:    "partial month with a raise",
+			current: 360000,
+			changes: []*entity.CompensationChange{
+				payChange("2025-03-10", 0, 300000, applied),
+				payChange("2025-03-16", 300000, 360000, applied),
+			},
+			from: "2025-03-10", to: "2025-03-31",
+			want: []PaySegment{
+				{Start: payDate("2025-03-10"), End: payDate("2025-03-15"), Salary: payMoney(300000)},
+				{Start: payDate("2025-03-16"), End: payDate("2025-03-31"), Salary: payMoney(360000)},
+			},
+		},
+		{
+			name:    "raise and cut in one month",
+			current: 300000,
+			changes: []*entity.CompensationChange{
+				payChange("2025-03-10", 300000, 360000, applied),
+				payChange("2025-03-20", 360000, 300000, applied),
+			},
+			from: "2025-03-01", to: "2025-03-31",
+			want: []PaySegment{
+				{Start: payDate("2025-03-01"), End: payDate("2025-03-09"), Salary: payMoney(300000)},
+				{Start: payDate("2025-03-10"), End: payDate("2025-03-19"), Salary: payMoney(360000)},
+				{Start: payDate("2025-03-20"), End: payDate("2025-03-31"), Salary: payMoney(300000)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := salarySegments(payMoney(tt.current), tt.changes, payDate(tt.from), payDate(tt.to))
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d segments %v, want %d %v", len(got), got, len(tt.want), tt.want)
+			}
+			for i := range got {
+				if !got[i].Start.Equal(tt.want[i].Start) || !got[i].End.Equal(tt.want[i].End) ||
+					got[i].Salary != tt.want[i].Salary {
+					t.Errorf("segment %d = %s..%s at %v, want %s..%s at %v", i,
+						got[i].Start.Format(time.DateOnly), got[i].End.Format(time.DateOnly), got[i].Salary,
+						tt.want[i].Start.Format(time.DateOnly), tt.want[i].End.Format(time.DateOnly), tt.want[i].Salary)
+				}
+			}
+		})
+	}
+}
+
+func TestMonthlyPay(t *testing.T) {
+	tests := []struct {
+		name      string
+		salary    int64
+		payPeriod entity.PayPeriod
+		want      int64
+	}{
+		{"monthly", 500000, entity.PayPeriodMonthly, 500000},
+		{"annual", 6000000, entity.PayPeriodAnnual, 500000},
+		{"annual rounded", 1000001, entity.PayPeriodAnnual, 83333},
+		{"hourly over standard hours", 2500, entity.PayPeriodHourly, 433333},
+		{"hourly rounded", 1, entity.PayPeriodHourly, 173},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := monthlyPay(payMoney(tt.salary), tt.payPeriod); got != tt.want {
+				t.Errorf("monthlyPay(%d, %s) = %d, want %d", tt.salary, tt.payPeriod, got, tt.want)
+			}
+		})
+	}
+}
+
+// stubCompensationRepository serves a fixed compensation timeline.
+type stubCompensationRepository struct {
+	repository.CompensationRepository
+	changes []*entity.CompensationChange
+}
+
+func (r *stubCompensationRepository) GetChangesByEmployeeId(ctx context.Context,
+	employeeID int) ([]*entity.CompensationChange, error) {
+	return r.changes, nil
+}
+
+// weekdayCalculator counts Mondays to Fridays, without public holidays.
+type weekdayCalculator struct {
+	WorkingDayCalculator
+}
+
+func (weekdayCalculator) CountWorkingDays(ctx context.Context, employeeID int, start, end time.Time) (int, error) {
+	days := 0
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		if !isWeekend(day) {
+			days++
+		}
+	}
+	return days, nil
+}
+
+func TestCalculateMonthlyPay(t *testing.T) {
+	terminated := payDate("2025-03-10")
+
+	tests := []struct {
+		name         string
+		employee     entity.Employee
+		changes      []*entity.CompensationChange
+		basis        entity.ProrationBasis
+		wantAmount   int64
+		wantPaidDays int
+		wantSegments int
+		wantProrated bool
+	}{
+		{
+			name:       "full month",
+			employee:   entity.Employee{Salary: payMoney(310000), PayPeriod: entity.PayPeriodMonthly, HiredDate: payDate("2024-01-15")},
+			wantAmount: 310000, wantPaidDays: 31, wantSegments: 1,
+		},
+		{
+			name:       "annual salary",
+			employee:   entity.Employee{Salary: payMoney(3720000), PayPeriod: entity.PayPeriodAnnual, HiredDate: payDate("2024-01-15")},
+			wantAmount: 310000, wantPaidDays: 31, wantSegments: 1,
+		},
+		{
+			name:       "hired mid-month",
+			employee:   entity.Employee{Salary: payMoney(310000), PayPeriod: entity.PayPeriodMonthly, HiredDate: payDate("2025-03-11")},
+			wantAmount: 210000, wantPaidDays: 21, wantSegments: 1, wantProrated: true,
+		},
+		{
+			name: "terminated mid-month",
+			employee: entity.Employee{Salary: payMoney(310000), PayPeriod: entity.PayPeriodMonthly, HiredDate: payDate("2024-01-15"),
+				TerminationDate: &terminated},
+			wantAmount: 100000, wantPaidDays: 10, wantSegments: 1, wantProrated: true,
+		},
+		{
+			name:       "hourly, hired mid-month",
+			employee:   entity.Employee{Salary: payMoney(1500), PayPeriod: entity.PayPeriodHourly, HiredDate: payDate("2025-03-17")},
+			wantAmount: 125806, wantPaidDays: 15, wantSegments: 1, wantProrated: true,
+		},
+		{
+			name:         "raise mid-month",
+			employee:     entity.Employee{Salary: payMoney(620000), PayPeriod: entity.PayPeriodMonthly, HiredDate: payDate("2024-01-15")},
+			changes:      []*entity.CompensationChange{payChange("2025-03-16", 310000, 620000, entity.CompensationChangeApplied)},
+			wantAmount:   150000 + 320000,
+			wantPaidDays: 31, wantSegments: 2, wantProrated: true,
+		},
+		{
+			name:         "cancelled raise",
+			employee:     entity.Employee{Salary: payMoney(310000), PayPeriod: entity.PayPeriodMonthly, HiredDate: payDate("2024-01-15")},
+			changes:      []*entity.CompensationChange{payChange("2025-03-16", 310000, 620000, entity.CompensationChangeCancelled)},
+			wantAmount:   310000,
+			wantPaidDays: 31, wantSegments: 1,
+		},
+		{
+			name:       "working days, hired mid-month",
+			employee:   entity.Employee{Salary: payMoney(310000), PayPeriod: entity.PayPeriodMonthly, HiredDate: payDate("2025-03-17")},
+			basis:      entity.ProrationBasisWorkingDays,
+			wantAmount: 162381, wantPaidDays: 11, wantSegments: 1, wantProrated: true,
+		},
+		{
+			name:       "hired after the month",
+			employee:   entity.Employee{Salary: payMoney(310000), PayPeriod: entity.PayPeriodMonthly, HiredDate: payDate("2025-04-01")},
+			wantAmount: 0, wantPaidDays: 0, wantSegments: 0, wantProrated: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calculator := NewCompensationCalculator(&stubCompensationRepository{changes: tt.changes}, nil,
+				weekdayCalculator{}, nil, entity.ProrationBasisCalendarDays)
+
+			pay, err := calculator.CalculateMonthlyPay(context.Background(), &tt.employee, 2025, time.March, tt.basis)
+			if err != nil {
+				t.Fatalf("CalculateMonthlyPay: %v", err)
+			}
+			if pay.Amount != payMoney(tt.wantAmount) {
+				t.Errorf("amount = %v, want %v", pay.Amount, payMoney(tt.wantAmount))
+			}
+			if pay.PaidDays != tt.wantPaidDays {
+				t.Errorf("paid days = %d, want %d", pay.PaidDays, tt.wantPaidDays)
+			}
+			if len(pay.Segments) != tt.wantSegments {
+				t.Errorf("segments = %d, want %d", len(pay.Segments), tt.wantSegments)
+			}
+			if pay.IsProrated() != tt.wantProrated {
+				t.Errorf("prorated = %v, want %v", pay.IsProrated(), tt.wantProrated)
+			}
+		})
+	}
+}
+
+func TestCalculateMonthlyPayRejectsInvalidInput(t *testing.T) {
+	calculator := NewCompensationCalculator(&stubCompensationRepository{}, nil, weekdayCalculator{}, nil,
+		entity.ProrationBasisCalendarDays)
+	employee := &entity.Employee{Salary: payMoney(310000), PayPeriod: entity.PayPeriodMonthly, HiredDate: payDate("2024-01-15")}
+
+	_, err := calculator.CalculateMonthlyPay(context.Background(), employee, 2025, time.March, "fortnights")
+	if !errors.Is(err, appError.ErrInvalidProrationBasis) {
+		t.Errorf("unknown basis: err = %v, want %v", err, appError.ErrInvalidProrationBasis)
+	}
+	_, err = calculator.CalculateMonthlyPay(context.Background(), employee, 2025, 13, "")
+	if !errors.Is(err, appError.ErrInvalidPayPreviewPeriod) {
+		t.Errorf("month 13: err = %v, want %v", err, appError.ErrInvalidPayPreviewPeriod)
+	}
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	domaincache "github.com/mohamedfawas/employee_management_system/internal/domain/cache"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type CompensationUsecase interface {
+	// ScheduleCompensationChange applies the change right away when it is effective today or earlier,
+	// otherwise it is kept as scheduled until ApplyDueCompensationChanges picks it up. The new
+	// salary is checked against the band of the employee's position like salaries of new hires.
+	ScheduleCompensationChange(ctx context.Context, change *entity.CompensationChange) (*entity.CompensationChange, error)
+	// RequestCompensationChange submits the change for approval and returns the pending request. When
+	// salary changes need no approval it is scheduled right away, which requires ApprovedBy.
+	RequestCompensationChange(ctx context.Context, change *entity.CompensationChange,
+		requestedBy *int) (*entity.CompensationChange, *entity.ApprovalRequest, error)
+	// ApplyApproval makes the salary change of an approved request.
+	ApplyApproval(ctx context.Context, request *entity.ApprovalRequest) error
+	GetCompensationHistory(ctx context.Context, employeeID int) ([]*entity.CompensationChange, error)
+	CancelCompensationChange(ctx context.Context, employeeID, id int) error
+
+	// ApplyDueCompensationChanges applies every scheduled change effective on or before the date
+	// asOf falls on at the employee's location and returns how many were applied. It is run periodically by a background job.
+	ApplyDueCompensationChanges(ctx context.Context, asOf time.Time) (int, error)
+}
+
+type compensationUsecaseImpl struct {
+	compensationRepository repository.CompensationRepository
+	employeeRepository     repository.EmployeeRepository
+	locationRepository     repository.LocationRepository
+	positionRepository     repository.PositionRepository
+	salaryBands            salaryBandChecker
+	salaryBandPolicy       entity.SalaryBandPolicy
+	cache                  domaincache.Cache
+	approvals              ApprovalUsecase
+}
+
+func NewCompensationUsecase(compensationRepository repository.CompensationRepository,
+	employeeRepository repository.EmployeeRepository, locationRepository repository.LocationRepository,
+	positionRepository repository.PositionRepository, exchangeRateUsecase ExchangeRateUsecase,
+	salaryBandPolicy entity.SalaryBandPolicy, cache domaincache.Cache, approvals ApprovalUsecase) CompensationUsecase {
+	return &compensationUsecaseImpl{
+		compensationRepository: compensationRepository,
+		employeeRepository:     employeeRepository,
+		locationRepository:     locationRepository,
+		positionRepository:     positionRepository,
+		salaryBands:            salaryBandChecker{exchangeRateUsecase: exchangeRateUsecase},
+		salaryBandPolicy:       salaryBandPolicy,
+		cache:                  cache,
+		approvals:              approvals,
+	}
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// validateCompensationChange checks and normalizes the fields of a change, except who approved it.
+func validateCompensationChange(change *entity.CompensationChange) error {
+	if change.EmployeeID <= 0 {
+		return appError.ErrInvalidEmployeeId
+	}
+	change.NewSalary.Currency = strings.ToUpper(strings.TrimSpace(change.NewSalary.Currency))
+	if change.NewSalary.Amount <= 0 {
+		return appError.ErrInvalidSalary
+	}
+	if !entity.IsValidCurrency(change.NewSalary.Currency) {
+		return appError.ErrInvalidCurrency
+	}
+
+	change.Reason = strings.TrimSpace(change.Reason)
+	if change.Reason == "" {
+		return appError.ErrInvalidCompensationReason
+	}
+	return nil
+}
+
+// compensationChangeEmployee returns the employee of change, checking that the change may be made
+// to them.
+func (u *compensationUsecaseImpl) compensationChangeEmployee(ctx context.Context,
+	change *entity.CompensationChange) (*entity.Employee, error) {
+
+	employee, err := u.employeeRepository.GetEmployeeById(ctx, change.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+	if change.EffectiveDate.IsZero() || change.EffectiveDate.Before(employee.HiredDate) {
+		return nil, appError.ErrInvalidEffectiveDate
+	}
+	if employee.Status == entity.EmploymentStatusTerminated {
+		return nil, appError.ErrInvalidStatusTransition
+	}
+	return employee, nil
+}
+
+// checkSalaryBand reports whether salary lies outside the band of the employee's position, and
+// rejects it under the reject policy.
+func (u *compensationUsecaseImpl) checkSalaryBand(ctx context.Context, employee *entity.Employee,
+	salary entity.Money) (bool, error) {
+
+	outOfBand, err := u.isOutOfBand(ctx, employee, salary)
+	if err != nil {
+		return false, err
+	}
+	if outOfBand && u.salaryBandPolicy == entity.SalaryBandPolicyReject {
+		return false, appError.ErrSalaryOutOfBand
+	}
+	return outOfBand, nil
+}
+
+// isOutOfBand reports whether salary lies outside the band of the employee's position.
+func (u *compensationUsecaseImpl) isOutOfBand(ctx context.Context, employee *entity.Employee,
+	salary entity.Money) (bool, error) {
+
+	position, err := u.positionRepository.GetPositionById(ctx, employee.PositionID)
+	if err != nil || position == nil {
+		return false, err
+	}
+	check, err := u.salaryBands.check(ctx, salary, employee.PayPeriod, position, today())
+	if err != nil {
+		return false, err
+	}
+	return check != nil && !check.InBand(), nil
+}
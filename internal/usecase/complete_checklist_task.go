@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+// CompleteTask marks a task done. Completing a task again keeps the time it was first completed at.
+func (u *checklistUsecaseImpl) CompleteTask(ctx context.Context, id int) (*entity.Checklist, error) {
+	task, err := u.getChecklistTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if task.IsCompleted() {
+		return u.GetChecklistById(ctx, task.ChecklistID)
+	}
+	completedAt := time.Now()
+	return u.checklistRepository.SetTaskCompletion(ctx, id, &completedAt)
+}
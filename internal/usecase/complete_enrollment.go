@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *trainingUsecaseImpl) CompleteEnrollment(ctx context.Context, id int, completedOn time.Time) (*entity.TrainingEnrollment, error) {
+	enrollment, err := u.getOpenEnrollment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if completedOn.IsZero() {
+		completedOn = today()
+	}
+	completedOn = dateOnly(completedOn)
+	if completedOn.Before(enrollment.EnrolledOn) || completedOn.After(today()) {
+		return nil, appError.ErrInvalidEnrollment
+	}
+	enrollment.CompletedOn = &completedOn
+
+	course, err := u.GetCourseById(ctx, enrollment.CourseID)
+	if err != nil {
+		return nil, err
+	}
+	var certification *entity.Certification
+	if course.Certifies() {
+		certification = courseCertification(course, enrollment.EmployeeID, completedOn)
+	}
+
+	completedEnrollment, err := u.trainingRepository.CompleteEnrollment(ctx, enrollment, certification)
+	if err != nil {
+		return nil, err
+	}
+	if completedEnrollment == nil {
+		return nil, appError.ErrEnrollmentNotOpen
+	}
+	return completedEnrollment, nil
+}
+
+// getOpenEnrollment returns the enrollment, provided it is neither completed nor cancelled.
+func (u *trainingUsecaseImpl) getOpenEnrollment(ctx context.Context, id int) (*entity.TrainingEnrollment, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidEnrollmentId
+	}
+	enrollment, err := u.trainingRepository.GetEnrollmentById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if enrollment == nil {
+		return nil, appError.ErrEnrollmentNotFound
+	}
+	if enrollment.Status != entity.EnrollmentStatusEnrolled {
+		return nil, appError.ErrEnrollmentNotOpen
+	}
+	return enrollment, nil
+}
+
+// courseCertification is the certification completing course on completedOn earns, named after
+// the course and issued by its provider.
+func courseCertification(course *entity.TrainingCourse, employeeID int, completedOn time.Time) *entity.Certification {
+	certification := &entity.Certification{
+		EmployeeID: employeeID,
+		Name:       course.Name,
+		Issuer:     course.Provider,
+		IssuedOn:   completedOn,
+	}
+	if months := *course.CertificationValidMonths; months > 0 {
+		expiresOn := completedOn.AddDate(0, months, 0)
+		certification.ExpiresOn = &expiresOn
+	}
+	return certification
+}
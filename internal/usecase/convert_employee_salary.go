@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *exchangeRateUsecaseImpl) ConvertEmployeeSalary(ctx context.Context, employeeID int,
+	targetCurrency string) (*SalaryConversion, error) {
+
+	if employeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	if targetCurrency == "" {
+		targetCurrency = u.reportingCurrency
+	}
+
+	employee, err := u.employeeRepository.GetEmployeeById(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+
+	converted, rate, rateDate, err := u.ConvertMoney(ctx, employee.Salary, targetCurrency, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &SalaryConversion{
+		EmployeeID: employee.ID,
+		Salary:     employee.Salary,
+		PayPeriod:  employee.PayPeriod,
+		Converted:  converted,
+		Rate:       rate,
+		RateDate:   rateDate,
+	}, nil
+}
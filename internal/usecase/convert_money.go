@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *exchangeRateUsecaseImpl) ConvertMoney(ctx context.Context, amount entity.Money,
+	targetCurrency string, asOf time.Time) (entity.Money, float64, time.Time, error) {
+
+	targetCurrency = strings.ToUpper(strings.TrimSpace(targetCurrency))
+	if !entity.IsValidCurrency(amount.Currency) || !entity.IsValidCurrency(targetCurrency) {
+		return entity.Money{}, 0, time.Time{}, appError.ErrInvalidCurrency
+	}
+
+	rate, rateDate, err := u.findRate(ctx, amount.Currency, targetCurrency, dateOnly(asOf))
+	if err != nil {
+		return entity.Money{}, 0, time.Time{}, err
+	}
+
+	return entity.MoneyFromMajor(amount.Major()*rate, targetCurrency), rate, rateDate, nil
+}
+
+// findRate resolves the rate from one currency to another. It uses a direct rate when one is
+// stored, the inverse of the opposite pair otherwise, and finally crosses both currencies
+// through the reporting currency.
+func (u *exchangeRateUsecaseImpl) findRate(ctx context.Context, from, to string, asOf time.Time) (float64, time.Time, error) {
+	if from == to {
+		return 1, asOf, nil
+	}
+
+	rate, rateDate, found, err := u.findPairRate(ctx, from, to, asOf)
+	if err != nil || found {
+		return rate, rateDate, err
+	}
+
+	if from != u.reportingCurrency && to != u.reportingCurrency {
+		toReporting, firstDate, foundFirst, err := u.findPairRate(ctx, from, u.reportingCurrency, asOf)
+		if err != nil {
+			return 0, time.Time{}, err
+		}
+		fromReporting, secondDate, foundSecond, err := u.findPairRate(ctx, u.reportingCurrency, to, asOf)
+		if err != nil {
+			return 0, time.Time{}, err
+		}
+		if foundFirst && foundSecond {
+			rateDate := firstDate
+			if secondDate.After(rateDate) {
+				rateDate = secondDate
+			}
+			return toReporting * fromReporting, rateDate, nil
+		}
+	}
+
+	return 0, time.Time{}, appError.ErrExchangeRateNotFound
+}
+
+func (u *exchangeRateUsecaseImpl) findPairRate(ctx context.Context, from, to string,
+	asOf time.Time) (float64, time.Time, bool, error) {
+
+	direct, err := u.exchangeRateRepository.GetLatestRate(ctx, from, to, asOf)
+	if err != nil {
+		return 0, time.Time{}, false, err
+	}
+	if direct != nil {
+		return direct.Rate, direct.EffectiveDate, true, nil
+	}
+
+	inverse, err := u.exchangeRateRepository.GetLatestRate(ctx, to, from, asOf)
+	if err != nil {
+		return 0, time.Time{}, false, err
+	}
+	if inverse != nil {
+		return 1 / inverse.Rate, inverse.EffectiveDate, true, nil
+	}
+	return 0, time.Time{}, false, nil
+}
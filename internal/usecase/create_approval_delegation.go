@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *approvalUsecaseImpl) CreateDelegation(ctx context.Context,
+	delegation *entity.ApprovalDelegation) (*entity.ApprovalDelegation, error) {
+
+	if err := validateApprovalDelegation(delegation); err != nil {
+		return nil, err
+	}
+	delegation.StartDate = dateOnly(delegation.StartDate)
+	delegation.EndDate = dateOnly(delegation.EndDate)
+
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, delegation.DelegatorID); err != nil {
+		return nil, err
+	}
+	delegate, err := u.employeeRepository.GetEmployeeById(ctx, delegation.DelegateID)
+	if err != nil {
+		return nil, err
+	}
+	if delegate == nil || delegate.Status == entity.EmploymentStatusTerminated {
+		return nil, appError.ErrInvalidApprovalDelegation
+	}
+	return u.approvalRepository.CreateDelegation(ctx, delegation)
+}
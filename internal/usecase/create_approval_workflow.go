@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *approvalUsecaseImpl) CreateWorkflow(ctx context.Context, workflow *entity.ApprovalWorkflow) (*entity.ApprovalWorkflow, error) {
+	if err := validateApprovalWorkflow(workflow); err != nil {
+		return nil, err
+	}
+	if err := u.validateApprovalStepApprovers(ctx, workflow); err != nil {
+		return nil, err
+	}
+	return u.approvalRepository.CreateWorkflow(ctx, workflow)
+}
@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *assetUsecaseImpl) CreateAsset(ctx context.Context, asset *entity.Asset) (*entity.Asset, error) {
+	if err := validateAsset(asset); err != nil {
+		return nil, err
+	}
+	// New assets are handed out through AssignAsset, which records who holds them.
+	switch asset.Status {
+	case "":
+		asset.Status = entity.AssetStatusAvailable
+	case entity.AssetStatusAssigned:
+		return nil, appError.ErrInvalidAssetStatus
+	}
+	return u.assetRepository.CreateAsset(ctx, asset)
+}
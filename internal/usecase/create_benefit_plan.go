@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *benefitUsecaseImpl) CreatePlan(ctx context.Context, plan *entity.BenefitPlan) (*entity.BenefitPlan, error) {
+	if err := validateBenefitPlan(plan); err != nil {
+		return nil, err
+	}
+	return u.benefitRepository.CreatePlan(ctx, plan)
+}
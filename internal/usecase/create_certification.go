@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *certificationUsecaseImpl) CreateCertification(ctx context.Context, certification *entity.Certification) (*entity.Certification, error) {
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, certification.EmployeeID); err != nil {
+		return nil, err
+	}
+	if err := validateCertification(certification); err != nil {
+		return nil, err
+	}
+	// Only training completion links a certification to an enrollment.
+	certification.EnrollmentID = nil
+	return u.certificationRepository.CreateCertification(ctx, certification)
+}
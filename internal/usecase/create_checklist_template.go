@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *checklistUsecaseImpl) CreateTemplate(ctx context.Context, template *entity.ChecklistTemplate) (*entity.ChecklistTemplate, error) {
+	if err := validateChecklistTemplate(template); err != nil {
+		return nil, err
+	}
+	return u.checklistRepository.CreateTemplate(ctx, template)
+}
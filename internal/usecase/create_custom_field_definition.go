@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *customFieldUsecaseImpl) CreateDefinition(ctx context.Context,
+	definition *entity.CustomFieldDefinition) (*entity.CustomFieldDefinition, error) {
+
+	if err := validateCustomFieldDefinition(definition); err != nil {
+		return nil, err
+	}
+	return u.customFieldRepository.CreateDefinition(ctx, definition)
+}
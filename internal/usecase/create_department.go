@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *departmentUsecaseImpl) CreateDepartment(ctx context.Context, department *entity.Department) (*entity.Department, error) {
+	if err := validateDepartment(department); err != nil {
+		return nil, err
+	}
+	return u.departmentRepository.CreateDepartment(ctx, department)
+}
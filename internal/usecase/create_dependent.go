@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *benefitUsecaseImpl) CreateDependent(ctx context.Context, dependent *entity.Dependent) (*entity.Dependent, error) {
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, dependent.EmployeeID); err != nil {
+		return nil, err
+	}
+	if err := validateDependent(dependent); err != nil {
+		return nil, err
+	}
+	return u.benefitRepository.CreateDependent(ctx, dependent)
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *emergencyContactUsecaseImpl) CreateEmergencyContact(ctx context.Context,
+	contact *entity.EmergencyContact) (*entity.EmergencyContact, error) {
+
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, contact.EmployeeID); err != nil {
+		return nil, err
+	}
+	if err := validateEmergencyContact(contact); err != nil {
+		return nil, err
+	}
+
+	return u.emergencyContactRepository.CreateEmergencyContact(ctx, contact)
+}
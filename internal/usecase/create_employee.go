@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"log"
 
 	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
 	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
@@ -13,19 +15,61 @@ func (u *employeeUsecaseImpl) CreateEmployee(ctx context.Context,
 	if employee.Name == "" || len(employee.Name) < 3 {
 		return nil, appError.ErrInvalidName
 	}
-	if employee.Position == "" || len(employee.Position) < 3 {
-		return nil, appError.ErrInvalidPosition
-	}
 	if employee.HiredDate.IsZero() {
 		return nil, appError.ErrInvalidHiredDate
 	}
-	if employee.Salary <= 0 {
-		return nil, appError.ErrInvalidSalary
+	if err := validateSalary(employee); err != nil {
+		return nil, err
+	}
+
+	// New employees start either as a candidate or directly as active.
+	if employee.Status == "" {
+		employee.Status = entity.EmploymentStatusActive
+	}
+	if employee.Status != entity.EmploymentStatusCandidate && employee.Status != entity.EmploymentStatusActive {
+		return nil, appError.ErrInvalidEmploymentStatus
+	}
+
+	normalizeEmployeeProfile(employee)
+	if err := validateEmployeeProfile(employee); err != nil {
+		return nil, err
+	}
+	if err := u.validateManager(ctx, employee); err != nil {
+		return nil, err
+	}
+	if employee.LocationID != nil && *employee.LocationID <= 0 {
+		return nil, appError.ErrInvalidLocation
+	}
+	if employee.DepartmentID != nil && *employee.DepartmentID <= 0 {
+		return nil, appError.ErrInvalidDepartment
+	}
+	if err := u.assignPosition(ctx, employee); err != nil {
+		return nil, err
+	}
+	definitions, err := u.customFieldRepository.GetAllDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCustomFields(definitions, employee); err != nil {
+		return nil, err
 	}
 
 	createdEmployee, err := u.employeeRepository.CreateEmployee(ctx, employee)
 	if err != nil {
 		return nil, err
 	}
+
+	invalidateEmployeesListCache(ctx, u.cache)
+	u.startChecklist(ctx, createdEmployee.ID, entity.ChecklistKindOnboarding)
 	return createdEmployee, nil
 }
+
+// startChecklist starts the employee's onboarding or offboarding checklist once the change calling
+// for it is saved. Failing to start one does not undo the change, so errors are only logged; having
+// no template for the employee, or a checklist already open, is not an error at all.
+func (u *employeeUsecaseImpl) startChecklist(ctx context.Context, employeeID int, kind entity.ChecklistKind) {
+	_, err := u.checklists.StartChecklist(ctx, employeeID, kind)
+	if err != nil && !errors.Is(err, appError.ErrChecklistTemplateNotFound) && !errors.Is(err, appError.ErrChecklistAlreadyOpen) {
+		log.Printf("Error starting %s checklist of employee %d: %v", kind, employeeID, err)
+	}
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *benefitUsecaseImpl) CreateWindow(ctx context.Context, window *entity.BenefitEnrollmentWindow) (*entity.BenefitEnrollmentWindow, error) {
+	if err := validateEnrollmentWindow(window); err != nil {
+		return nil, err
+	}
+
+	// Windows do not overlap, so an employee's coverage start never depends on which one is picked.
+	overlapping, err := u.benefitRepository.GetOverlappingWindow(ctx, window.StartsOn, window.EndsOn)
+	if err != nil {
+		return nil, err
+	}
+	if overlapping != nil {
+		return nil, appError.ErrEnrollmentWindowOverlaps
+	}
+	return u.benefitRepository.CreateWindow(ctx, window)
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *exchangeRateUsecaseImpl) CreateExchangeRate(ctx context.Context,
+	rate *entity.ExchangeRate) (*entity.ExchangeRate, error) {
+
+	rate.BaseCurrency = strings.ToUpper(strings.TrimSpace(rate.BaseCurrency))
+	rate.QuoteCurrency = strings.ToUpper(strings.TrimSpace(rate.QuoteCurrency))
+
+	if !entity.IsValidCurrency(rate.BaseCurrency) || !entity.IsValidCurrency(rate.QuoteCurrency) {
+		return nil, appError.ErrInvalidCurrency
+	}
+	if rate.BaseCurrency == rate.QuoteCurrency || rate.Rate <= 0 {
+		return nil, appError.ErrInvalidExchangeRate
+	}
+	if rate.EffectiveDate.IsZero() {
+		return nil, appError.ErrInvalidEffectiveDate
+	}
+
+	return u.exchangeRateRepository.CreateExchangeRate(ctx, rate)
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *expenseUsecaseImpl) CreateCategory(ctx context.Context, category *entity.ExpenseCategory) (*entity.ExpenseCategory, error) {
+	if err := validateExpenseCategory(category); err != nil {
+		return nil, err
+	}
+	return u.expenseRepository.CreateCategory(ctx, category)
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *expenseUsecaseImpl) CreateClaim(ctx context.Context, claim *entity.ExpenseClaim) (*entity.ExpenseClaim, error) {
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, claim.EmployeeID); err != nil {
+		return nil, err
+	}
+	if err := validateExpenseClaim(claim); err != nil {
+		return nil, err
+	}
+	if err := u.checkExpenseCategories(ctx, claim, false); err != nil {
+		return nil, err
+	}
+	return u.expenseRepository.CreateClaim(ctx, claim)
+}
@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *goalUsecaseImpl) CreateGoal(ctx context.Context, goal *entity.Goal) (*entity.Goal, error) {
+	if err := validateGoal(goal, true); err != nil {
+		return nil, err
+	}
+	owner, err := u.getGoalOwner(ctx, goal)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.validateGoalParent(ctx, goal, owner); err != nil {
+		return nil, err
+	}
+	return u.goalRepository.CreateGoal(ctx, goal)
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *goalUsecaseImpl) CreateCheckIn(ctx context.Context, checkIn *entity.GoalCheckIn) (*entity.GoalCheckIn, error) {
+	goal, err := u.GetGoalById(ctx, checkIn.GoalID)
+	if err != nil {
+		return nil, err
+	}
+	if goal.Status != entity.GoalStatusActive {
+		return nil, appError.ErrGoalNotActive
+	}
+	if checkIn.KeyResultID <= 0 {
+		return nil, appError.ErrInvalidGoalCheckIn
+	}
+	found := false
+	for _, keyResult := range goal.KeyResults {
+		if keyResult.ID == checkIn.KeyResultID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, appError.ErrKeyResultNotFound
+	}
+	checkIn.Comment = strings.TrimSpace(checkIn.Comment)
+
+	return u.goalRepository.CreateCheckIn(ctx, checkIn)
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *holidayCalendarUsecaseImpl) CreateHoliday(ctx context.Context, holiday *entity.Holiday) (*entity.Holiday, error) {
+	if _, err := u.GetHolidayCalendarById(ctx, holiday.CalendarID); err != nil {
+		return nil, err
+	}
+	if err := validateHoliday(holiday); err != nil {
+		return nil, err
+	}
+	return u.holidayCalendarRepository.CreateHoliday(ctx, holiday)
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *holidayCalendarUsecaseImpl) CreateHolidayCalendar(ctx context.Context,
+	calendar *entity.HolidayCalendar) (*entity.HolidayCalendar, error) {
+
+	if err := validateHolidayCalendar(calendar); err != nil {
+		return nil, err
+	}
+	return u.holidayCalendarRepository.CreateHolidayCalendar(ctx, calendar)
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// CreateLeaveRequest files a pending request for the employee's working days in the range, so
+// weekends and public holidays are not charged. Balances only count the days accrued so far, so
+// the check here is repeated when the request is approved. The request goes to the leave approval
+// workflow when there is one, and to the employee's manager otherwise.
+func (u *leaveUsecaseImpl) CreateLeaveRequest(ctx context.Context, request *entity.LeaveRequest) (*entity.LeaveRequest, error) {
+	employee, err := u.getEmployeeForLeave(ctx, request.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee.Status == entity.EmploymentStatusTerminated {
+		return nil, appError.ErrEmployeeTerminated
+	}
+
+	leaveType, err := u.GetLeaveTypeById(ctx, request.LeaveTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.StartDate.IsZero() || request.EndDate.IsZero() {
+		return nil, appError.ErrInvalidLeaveDates
+	}
+	request.StartDate = dateOnly(request.StartDate)
+	request.EndDate = dateOnly(request.EndDate)
+	if request.EndDate.Before(request.StartDate) || request.EndDate.Year() != request.StartDate.Year() ||
+		request.StartDate.Before(dateOnly(employee.HiredDate)) {
+		return nil, appError.ErrInvalidLeaveDates
+	}
+
+	workingDays, err := u.workingDays.CountWorkingDays(ctx, employee.ID, request.StartDate, request.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	request.Days = float64(workingDays)
+	if request.Days == 0 {
+		return nil, appError.ErrInvalidLeaveDates
+	}
+	request.Reason = strings.TrimSpace(request.Reason)
+
+	if leaveType.TracksBalance() {
+		balance, err := u.refreshLeaveBalance(ctx, employee, leaveType, request.StartDate.Year(), today())
+		if err != nil {
+			return nil, err
+		}
+		if balance.RemainingDays() < request.Days {
+			return nil, appError.ErrInsufficientLeaveBalance
+		}
+	}
+
+	createdRequest, err := u.leaveRepository.CreateLeaveRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	approval, err := u.submitForApproval(ctx, employee, createdRequest)
+	if err != nil {
+		u.withdrawLeaveRequest(ctx, createdRequest)
+		return nil, err
+	}
+	if approval == nil {
+		return createdRequest, nil
+	}
+	approvalRequest, err := u.leaveRepository.SetLeaveRequestApproval(ctx, createdRequest.ID, &approval.ID)
+	if err != nil {
+		return nil, err
+	}
+	if approvalRequest == nil {
+		return u.GetLeaveRequestById(ctx, employee.ID, createdRequest.ID)
+	}
+	return approvalRequest, nil
+}
+
+// submitForApproval starts the leave approval workflow for a new request. It returns nil when there
+// is no workflow and the manager decides the request.
+func (u *leaveUsecaseImpl) submitForApproval(ctx context.Context, employee *entity.Employee,
+	request *entity.LeaveRequest) (*entity.ApprovalRequest, error) {
+
+	payload, err := json.Marshal(leaveApproval{LeaveRequestID: request.ID})
+	if err != nil {
+		return nil, err
+	}
+	return u.approvals.Submit(ctx, &entity.ApprovalRequest{
+		Type:        entity.ApprovalTypeLeave,
+		Reference:   fmt.Sprintf("leave:%d", request.ID),
+		EmployeeID:  employee.ID,
+		RequestedBy: &employee.ID,
+		Summary: fmt.Sprintf("Leave for %s from %s to %s, %g working days", employee.Name,
+			request.StartDate.Format(constants.DateFormat), request.EndDate.Format(constants.DateFormat), request.Days),
+		Payload: payload,
+	})
+}
+
+// withdrawLeaveRequest cancels a new request whose approval request could not be started.
+func (u *leaveUsecaseImpl) withdrawLeaveRequest(ctx context.Context, request *entity.LeaveRequest) {
+	if _, err := u.leaveRepository.CancelLeaveRequest(ctx, request, false); err != nil {
+		log.Printf("[LEAVE] failed to withdraw request %d: %v", request.ID, err)
+	}
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *leaveUsecaseImpl) CreateLeaveType(ctx context.Context, leaveType *entity.LeaveType) (*entity.LeaveType, error) {
+	if err := validateLeaveType(leaveType); err != nil {
+		return nil, err
+	}
+	return u.leaveRepository.CreateLeaveType(ctx, leaveType)
+}
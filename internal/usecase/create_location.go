@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *locationUsecaseImpl) CreateLocation(ctx context.Context, location *entity.Location) (*entity.Location, error) {
+	if err := validateLocation(location); err != nil {
+		return nil, err
+	}
+	return u.locationRepository.CreateLocation(ctx, location)
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *payrollUsecaseImpl) CreatePayrollPeriod(ctx context.Context, period *entity.PayrollPeriod) (*entity.PayrollPeriod, error) {
+	if err := validatePayrollPeriod(period); err != nil {
+		return nil, err
+	}
+	return u.payrollRepository.CreatePayrollPeriod(ctx, period)
+}
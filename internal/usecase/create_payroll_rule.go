@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *payrollUsecaseImpl) CreatePayrollRule(ctx context.Context, rule *entity.PayrollRule) (*entity.PayrollRule, error) {
+	if err := validatePayrollRule(rule); err != nil {
+		return nil, err
+	}
+	return u.payrollRepository.CreatePayrollRule(ctx, rule)
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *payrollUsecaseImpl) CreatePayrollRun(ctx context.Context, periodID int) (*entity.PayrollRun, error) {
+	period, err := u.GetPayrollPeriodById(ctx, periodID)
+	if err != nil {
+		return nil, err
+	}
+
+	payslips, err := u.calculatePayslips(ctx, 0, period)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &entity.PayrollRun{
+		PayrollPeriodID: period.ID,
+		Status:          entity.PayrollRunStatusDraft,
+	}
+	return u.payrollRepository.CreatePayrollRun(ctx, run, payslips)
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *positionUsecaseImpl) CreatePosition(ctx context.Context, position *entity.Position) (*entity.Position, error) {
+	if err := validatePosition(position); err != nil {
+		return nil, err
+	}
+	return u.positionRepository.CreatePosition(ctx, position)
+}
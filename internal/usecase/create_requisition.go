@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *recruitingUsecaseImpl) CreateRequisition(ctx context.Context,
+	requisition *entity.JobRequisition) (*entity.JobRequisition, error) {
+
+	if err := validateRequisition(requisition); err != nil {
+		return nil, err
+	}
+	return u.recruitingRepository.CreateRequisition(ctx, requisition)
+}
@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *reviewUsecaseImpl) CreateReviewCycle(ctx context.Context, cycle *entity.ReviewCycle) (*entity.ReviewCycle, error) {
+	if err := validateReviewCycle(cycle); err != nil {
+		return nil, err
+	}
+	cycle.Status = entity.ReviewCycleStatusDraft
+	return u.reviewRepository.CreateReviewCycle(ctx, cycle)
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *shiftUsecaseImpl) CreateRoster(ctx context.Context, roster *Roster) (*RosterResult, error) {
+	if err := validateRoster(roster); err != nil {
+		return nil, err
+	}
+	template, err := u.GetShiftTemplateById(ctx, roster.ShiftTemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RosterResult{Shifts: []*entity.Shift{}, Conflicts: []ShiftConflict{}}
+	for _, employeeID := range roster.EmployeeIDs {
+		employee, err := u.employeeRepository.GetEmployeeById(ctx, employeeID)
+		if err != nil {
+			return nil, err
+		}
+		if employee == nil {
+			return nil, appError.ErrEmployeeNotFound
+		}
+
+		// Shift times are wall clock times at the employee's location.
+		zone, err := locationZone(ctx, u.locationRepository, employee.LocationID)
+		if err != nil {
+			return nil, err
+		}
+		planned := []*entity.Shift{}
+		for date := roster.From; !date.After(roster.To); date = date.AddDate(0, 0, 1) {
+			if roster.includes(date) {
+				planned = append(planned, template.ShiftOn(employeeID, date, zone))
+			}
+		}
+		if len(planned) == 0 {
+			// None of the weekdays fall within the dates.
+			return nil, appError.ErrInvalidRoster
+		}
+
+		conflicts, err := u.findShiftConflicts(ctx, employee, zone, planned)
+		if err != nil {
+			return nil, err
+		}
+		result.Shifts = append(result.Shifts, planned...)
+		result.Conflicts = append(result.Conflicts, conflicts...)
+	}
+
+	if len(result.Conflicts) > 0 {
+		result.Shifts = []*entity.Shift{}
+		return result, nil
+	}
+	if roster.DryRun {
+		return result, nil
+	}
+
+	createdShifts, err := u.shiftRepository.CreateShifts(ctx, result.Shifts)
+	if err != nil {
+		return nil, err
+	}
+	result.Shifts = createdShifts
+	return result, nil
+}
+
+// findShiftConflicts checks the planned shifts of an employee, ordered by start, against their
+// employment, approved leave, their other shifts and each other. Each shift reports its first
+// conflict. Dates are days in zone, the time zone of the employee's location.
+func (u *shiftUsecaseImpl) findShiftConflicts(ctx context.Context, employee *entity.Employee, zone *time.Location,
+	planned []*entity.Shift) ([]ShiftConflict, error) {
+
+	existing, err := u.shiftRepository.GetShifts(ctx, employee.ID,
+		planned[0].StartsAt.Add(-u.minRest), planned[len(planned)-1].EndsAt.Add(u.minRest))
+	if err != nil {
+		return nil, err
+	}
+	leaveRequests, err := u.leaveRepository.GetLeaveRequestsByEmployeeId(ctx, employee.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts := []ShiftConflict{}
+	for i, shift := range planned {
+		neighbours := append([]*entity.Shift{}, existing...)
+		if i > 0 {
+			neighbours = append(neighbours, planned[i-1])
+		}
+		if i < len(planned)-1 {
+			neighbours = append(neighbours, planned[i+1])
+		}
+
+		if reason, ok := u.shiftConflict(employee, zone, shift, existing, neighbours, leaveRequests); ok {
+			conflicts = append(conflicts, ShiftConflict{EmployeeID: employee.ID, Date: shift.Date, Reason: reason})
+		}
+	}
+	return conflicts, nil
+}
+
+func (u *shiftUsecaseImpl) shiftConflict(employee *entity.Employee, zone *time.Location, shift *entity.Shift,
+	existing, neighbours []*entity.Shift, leaveRequests []*entity.LeaveRequest) (ShiftConflictReason, bool) {
+
+	lastDay := dateIn(shift.EndsAt.Add(-time.Minute), zone)
+	if shift.Date.Before(dateOnly(employee.HiredDate)) || employee.Status == entity.EmploymentStatusTerminated ||
+		(employee.TerminationDate != nil && lastDay.After(dateOnly(*employee.TerminationDate))) {
+		return ShiftConflictNotEmployed, true
+	}
+	for _, request := range leaveRequests {
+		if request.Status == entity.LeaveRequestStatusApproved && (request.Covers(shift.Date) || request.Covers(lastDay)) {
+			return ShiftConflictOnLeave, true
+		}
+	}
+	for _, other := range existing {
+		if other.Overlaps(shift.StartsAt, shift.EndsAt) {
+			return ShiftConflictOverlap, true
+		}
+	}
+	for _, other := range neighbours {
+		if other.Overlaps(shift.StartsAt.Add(-u.minRest), shift.EndsAt.Add(u.minRest)) {
+			return ShiftConflictRestPeriod, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *segmentUsecaseImpl) CreateSegment(ctx context.Context, segment *entity.EmployeeSegment) (*entity.EmployeeSegment, error) {
+	if err := u.validateSegment(ctx, segment); err != nil {
+		return nil, err
+	}
+	return u.segmentRepository.CreateSegment(ctx, segment)
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *shiftUsecaseImpl) CreateShiftTemplate(ctx context.Context, template *entity.ShiftTemplate) (*entity.ShiftTemplate, error) {
+	if err := validateShiftTemplate(template); err != nil {
+		return nil, err
+	}
+	return u.shiftRepository.CreateShiftTemplate(ctx, template)
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *skillUsecaseImpl) CreateSkill(ctx context.Context, skill *entity.Skill) (*entity.Skill, error) {
+	if err := validateSkill(skill); err != nil {
+		return nil, err
+	}
+	return u.skillRepository.CreateSkill(ctx, skill)
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *skillUsecaseImpl) CreateSkillCategory(ctx context.Context, category *entity.SkillCategory) (*entity.SkillCategory, error) {
+	if err := validateSkillCategory(category); err != nil {
+		return nil, err
+	}
+	return u.skillRepository.CreateSkillCategory(ctx, category)
+}
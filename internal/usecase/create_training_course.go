@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *trainingUsecaseImpl) CreateCourse(ctx context.Context, course *entity.TrainingCourse) (*entity.TrainingCourse, error) {
+	if err := validateTrainingCourse(course); err != nil {
+		return nil, err
+	}
+	return u.trainingRepository.CreateCourse(ctx, course)
+}
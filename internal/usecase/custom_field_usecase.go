@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	domaincache "github.com/mohamedfawas/employee_management_system/internal/domain/cache"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type CustomFieldUsecase interface {
+	CreateDefinition(ctx context.Context, definition *entity.CustomFieldDefinition) (*entity.CustomFieldDefinition, error)
+	GetDefinitionById(ctx context.Context, id int) (*entity.CustomFieldDefinition, error)
+	GetAllDefinitions(ctx context.Context) ([]*entity.CustomFieldDefinition, error)
+	// UpdateDefinition changes a definition but keeps its key and type. Values saved before are
+	// only checked against the new rules when the employee is next updated.
+	UpdateDefinition(ctx context.Context, definition *entity.CustomFieldDefinition) (*entity.CustomFieldDefinition, error)
+	// DeleteDefinition removes a definition and every employee's value of it.
+	DeleteDefinition(ctx context.Context, id int) error
+}
+
+type customFieldUsecaseImpl struct {
+	customFieldRepository repository.CustomFieldRepository
+	cache                 domaincache.Cache
+}
+
+func NewCustomFieldUsecase(customFieldRepository repository.CustomFieldRepository, cache domaincache.Cache) CustomFieldUsecase {
+	return &customFieldUsecaseImpl{
+		customFieldRepository: customFieldRepository,
+		cache:                 cache,
+	}
+}
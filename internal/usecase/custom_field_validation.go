@@ -0,0 +1,262 @@
+package usecase
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+var customFieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,62}$`)
+
+func validateCustomFieldDefinition(definition *entity.CustomFieldDefinition) error {
+	definition.Key = strings.TrimSpace(definition.Key)
+	definition.Label = strings.TrimSpace(definition.Label)
+	if !customFieldKeyPattern.MatchString(definition.Key) || definition.Label == "" || !definition.Type.IsValid() {
+		return appError.ErrInvalidCustomFieldDefinition
+	}
+
+	options := []string{}
+	for _, option := range definition.Options {
+		option = strings.TrimSpace(option)
+		if option == "" || slices.Contains(options, option) {
+			return appError.ErrInvalidCustomFieldDefinition
+		}
+		options = append(options, option)
+	}
+	definition.Options = options
+	hasOptions := definition.Type == entity.CustomFieldSelect || definition.Type == entity.CustomFieldMultiSelect
+	if hasOptions != (len(options) > 0) {
+		return appError.ErrInvalidCustomFieldDefinition
+	}
+
+	rules := &definition.Validation
+	rules.Pattern = strings.TrimSpace(rules.Pattern)
+	isText := definition.Type == entity.CustomFieldText
+	isNumber := definition.Type == entity.CustomFieldNumber
+	if !isText && (rules.MinLength != nil || rules.MaxLength != nil || rules.Pattern != "") {
+		return appError.ErrInvalidCustomFieldDefinition
+	}
+	if !isNumber && (rules.Min != nil || rules.Max != nil) {
+		return appError.ErrInvalidCustomFieldDefinition
+	}
+	if (rules.MinLength != nil && *rules.MinLength < 0) || (rules.MaxLength != nil && *rules.MaxLength < 0) {
+		return appError.ErrInvalidCustomFieldDefinition
+	}
+	if rules.MinLength != nil && rules.MaxLength != nil && *rules.MinLength > *rules.MaxLength {
+		return appError.ErrInvalidCustomFieldDefinition
+	}
+	if rules.Min != nil && rules.Max != nil && *rules.Min > *rules.Max {
+		return appError.ErrInvalidCustomFieldDefinition
+	}
+	if rules.Pattern != "" {
+		if _, err := regexp.Compile(rules.Pattern); err != nil {
+			return appError.ErrInvalidCustomFieldDefinition
+		}
+	}
+	return nil
+}
+
+// validateCustomFields checks the employee's custom field values against the definitions and
+// normalizes them: text is trimmed, dates are reformatted and empty values are dropped, so that a
+// required field cannot be satisfied by an empty string.
+func validateCustomFields(definitions []*entity.CustomFieldDefinition, employee *entity.Employee) error {
+	byKey := map[string]*entity.CustomFieldDefinition{}
+	for _, definition := range definitions {
+		byKey[definition.Key] = definition
+	}
+
+	values := map[string]any{}
+	for key, value := range employee.CustomFields {
+		definition, ok := byKey[key]
+		if !ok {
+			return appError.ErrUnknownCustomField
+		}
+		normalized, err := normalizeCustomFieldValue(definition, value)
+		if err != nil {
+			return err
+		}
+		if normalized != nil {
+			values[key] = normalized
+		}
+	}
+
+	for _, definition := range definitions {
+		if _, ok := values[definition.Key]; definition.Required && !ok {
+			return appError.ErrRequiredCustomFieldMissing
+		}
+	}
+	employee.CustomFields = values
+	return nil
+}
+
+// normalizeCustomFieldValue checks a value decoded from JSON against its definition. It returns
+// nil for null and empty values.
+func normalizeCustomFieldValue(definition *entity.CustomFieldDefinition, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	rules := definition.Validation
+
+	switch definition.Type {
+	case entity.CustomFieldText:
+		text, ok := value.(string)
+		if !ok {
+			return nil, appError.ErrInvalidCustomFieldValue
+		}
+		text = strings.TrimSpace(text)
+		if text == "" {
+			return nil, nil
+		}
+		length := utf8.RuneCountInString(text)
+		if (rules.MinLength != nil && length < *rules.MinLength) || (rules.MaxLength != nil && length > *rules.MaxLength) {
+			return nil, appError.ErrInvalidCustomFieldValue
+		}
+		if rules.Pattern != "" {
+			matched, err := regexp.MatchString(`^(?:`+rules.Pattern+`)$`, text)
+			if err != nil || !matched {
+				return nil, appError.ErrInvalidCustomFieldValue
+			}
+		}
+		return text, nil
+
+	case entity.CustomFieldNumber:
+		var number float64
+		switch v := value.(type) {
+		case float64:
+			number = v
+		case int:
+			number = float64(v)
+		default:
+			return nil, appError.ErrInvalidCustomFieldValue
+		}
+		if (rules.Min != nil && number < *rules.Min) || (rules.Max != nil && number > *rules.Max) {
+			return nil, appError.ErrInvalidCustomFieldValue
+		}
+		return number, nil
+
+	case entity.CustomFieldBoolean:
+		if _, ok := value.(bool); !ok {
+			return nil, appError.ErrInvalidCustomFieldValue
+		}
+		return value, nil
+
+	case entity.CustomFieldDate:
+		text, ok := value.(string)
+		if !ok {
+			return nil, appError.ErrInvalidCustomFieldValue
+		}
+		if strings.TrimSpace(text) == "" {
+			return nil, nil
+		}
+		date, err := time.Parse(constants.DateFormat, strings.TrimSpace(text))
+		if err != nil {
+			return nil, appError.ErrInvalidCustomFieldValue
+		}
+		return date.Format(constants.DateFormat), nil
+
+	case entity.CustomFieldSelect:
+		option, ok := value.(string)
+		if !ok {
+			return nil, appError.ErrInvalidCustomFieldValue
+		}
+		if option == "" {
+			return nil, nil
+		}
+		if !slices.Contains(definition.Options, option) {
+			return nil, appError.ErrInvalidCustomFieldValue
+		}
+		return option, nil
+
+	case entity.CustomFieldMultiSelect:
+		var items []any
+		switch v := value.(type) {
+		case []any:
+			items = v
+		case []string:
+			for _, item := range v {
+				items = append(items, item)
+			}
+		default:
+			return nil, appError.ErrInvalidCustomFieldValue
+		}
+		selected := []string{}
+		for _, item := range items {
+			option, ok := item.(string)
+			if !ok || !slices.Contains(definition.Options, option) {
+				return nil, appError.ErrInvalidCustomFieldValue
+			}
+			if !slices.Contains(selected, option) {
+				selected = append(selected, option)
+			}
+		}
+		if len(selected) == 0 {
+			return nil, nil
+		}
+		return selected, nil
+	}
+	return nil, appError.ErrInvalidCustomFieldValue
+}
+
+// parseCustomFieldFilter turns the custom field filters of the employee list, given as query
+// string values, into the JSON values stored for employees. Multi-select filters take a comma
+// separated list of options.
+func parseCustomFieldFilter(definitions []*entity.CustomFieldDefinition, filters map[string]any) (map[string]any, error) {
+	byKey := map[string]*entity.CustomFieldDefinition{}
+	for _, definition := range definitions {
+		byKey[definition.Key] = definition
+	}
+
+	parsed := map[string]any{}
+	for key, filter := range filters {
+		definition, ok := byKey[key]
+		if !ok {
+			return nil, appError.ErrUnknownCustomField
+		}
+		raw, ok := filter.(string)
+		if !ok || strings.TrimSpace(raw) == "" {
+			return nil, appError.ErrInvalidCustomFieldValue
+		}
+		raw = strings.TrimSpace(raw)
+
+		var value any = raw
+		switch definition.Type {
+		case entity.CustomFieldNumber:
+			number, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				return nil, appError.ErrInvalidCustomFieldValue
+			}
+			value = number
+		case entity.CustomFieldBoolean:
+			flag, err := strconv.ParseBool(raw)
+			if err != nil {
+				return nil, appError.ErrInvalidCustomFieldValue
+			}
+			value = flag
+		case entity.CustomFieldMultiSelect:
+			options := []any{}
+			for _, option := range strings.Split(raw, ",") {
+				options = append(options, strings.TrimSpace(option))
+			}
+			value = options
+		}
+
+		// Filtering on a value no employee can hold is a mistake rather than an empty result. Text
+		// filters are exempt from the validation rules, which may have changed since values were saved.
+		if definition.Type != entity.CustomFieldText {
+			normalized, err := normalizeCustomFieldValue(definition, value)
+			if err != nil {
+				return nil, err
+			}
+			value = normalized
+		}
+		parsed[key] = value
+	}
+	return parsed, nil
+}
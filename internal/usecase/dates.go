@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+// dateOnly strips the clock from t, keeping its calendar date in UTC.
+func dateOnly(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// today returns the current UTC date.
+func today() time.Time {
+	return dateOnly(time.Now())
+}
+
+// localDate returns the calendar date t falls on at the location with locationID, so that date-only
+// fields such as hire and effective dates turn over at local midnight. Employees without a location
+// use UTC.
+func localDate(ctx context.Context, locationRepository repository.LocationRepository, locationID *int,
+	t time.Time) (time.Time, error) {
+
+	zone, err := locationZone(ctx, locationRepository, locationID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return dateIn(t, zone), nil
+}
+
+// locationZone returns the time zone of the location with locationID. Employees without a location
+// use UTC.
+func locationZone(ctx context.Context, locationRepository repository.LocationRepository,
+	locationID *int) (*time.Location, error) {
+
+	if locationID == nil {
+		return time.UTC, nil
+	}
+	location, err := locationRepository.GetLocationById(ctx, *locationID)
+	if err != nil {
+		return nil, err
+	}
+	if location == nil {
+		return time.UTC, nil
+	}
+	return location.Zone(), nil
+}
+
+// dateIn returns the calendar date t falls on in zone, kept as a UTC midnight like dateOnly.
+func dateIn(t time.Time, zone *time.Location) time.Time {
+	year, month, day := t.In(zone).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// midnightIn returns the instant date starts in zone.
+func midnightIn(date time.Time, zone *time.Location) time.Time {
+	year, month, day := date.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, zone)
+}
+
+// isWeekend reports whether date falls on a Saturday or Sunday.
+func isWeekend(date time.Time) bool {
+	return date.Weekday() == time.Saturday || date.Weekday() == time.Sunday
+}
+
+// startOfWeek returns the Monday of the week containing the date t falls on in zone.
+func startOfWeek(t time.Time, zone *time.Location) time.Time {
+	date := dateIn(t, zone)
+	offset := (int(date.Weekday()) + 6) % 7
+	return date.AddDate(0, 0, -offset)
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *approvalUsecaseImpl) DecideTask(ctx context.Context, decision entity.ApprovalDecision) (*entity.ApprovalRequest, error) {
+	if decision.TaskID <= 0 {
+		return nil, appError.ErrInvalidApprovalTaskId
+	}
+	decision.Comment = strings.TrimSpace(decision.Comment)
+
+	task, err := u.approvalRepository.GetTaskById(ctx, decision.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	if task == nil {
+		return nil, appError.ErrApprovalTaskNotFound
+	}
+	if task.Status != entity.ApprovalTaskPending {
+		return nil, appError.ErrApprovalTaskClosed
+	}
+	if err := u.ensureCanDecide(ctx, decision.ApproverID, task.ID); err != nil {
+		return nil, err
+	}
+
+	request, err := u.approvalRepository.DecideTask(ctx, decision)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status == entity.ApprovalRejected {
+		u.rejectApproval(ctx, request)
+	}
+	if request.Status != entity.ApprovalApproved {
+		return request, nil
+	}
+
+	// The decision stands even when the change cannot be made; the request is marked failed so
+	// the change can be submitted again once the cause is fixed.
+	if err := u.applyApproval(ctx, request); err != nil {
+		log.Printf("[APPROVALS] failed to apply approved request %d: %v", request.ID, err)
+		if err := u.approvalRepository.SetRequestFailed(ctx, request.ID, failureReason(err)); err != nil {
+			return nil, err
+		}
+		return u.GetRequestById(ctx, request.ID)
+	}
+	return request, nil
+}
+
+// ensureCanDecide checks that taskID is in the inbox of approverID.
+func (u *approvalUsecaseImpl) ensureCanDecide(ctx context.Context, approverID, taskID int) error {
+	inbox, err := u.GetInbox(ctx, approverID)
+	if err != nil {
+		return err
+	}
+	for _, task := range inbox {
+		if task.ID == taskID {
+			return nil
+		}
+	}
+	return appError.ErrNotApprover
+}
+
+func (u *approvalUsecaseImpl) applyApproval(ctx context.Context, request *entity.ApprovalRequest) error {
+	handler, ok := u.handlers[request.Type]
+	if !ok {
+		return fmt.Errorf("no handler for %s requests", request.Type)
+	}
+	return handler.ApplyApproval(ctx, request)
+}
+
+// rejectApproval tells the handler of the request's type, if it wants to know, that the change will
+// not be made. The rejection or cancellation stands when the handler fails.
+func (u *approvalUsecaseImpl) rejectApproval(ctx context.Context, request *entity.ApprovalRequest) {
+	handler, ok := u.handlers[request.Type].(ApprovalRejectionHandler)
+	if !ok {
+		return
+	}
+	if err := handler.RejectApproval(ctx, request); err != nil {
+		log.Printf("[APPROVALS] failed to close %s request %d: %v", request.Status, request.ID, err)
+	}
+}
+
+// failureReason returns the public message of an application error, which says what was wrong
+// with the change, and a generic reason for anything else.
+func failureReason(err error) string {
+	var appErr *appError.AppError
+	if errors.As(err, &appErr) {
+		return appErr.PublicMsg
+	}
+	return "The change could not be made"
+}
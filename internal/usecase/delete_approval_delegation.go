@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *approvalUsecaseImpl) DeleteDelegation(ctx context.Context, delegatorID, id int) error {
+	if delegatorID <= 0 {
+		return appError.ErrInvalidEmployeeId
+	}
+	if id <= 0 {
+		return appError.ErrInvalidApprovalDelegationId
+	}
+
+	delegation, err := u.approvalRepository.GetDelegationById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if delegation == nil || delegation.DelegatorID != delegatorID {
+		return appError.ErrApprovalDelegationNotFound
+	}
+	return u.approvalRepository.DeleteDelegation(ctx, id)
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteWorkflow removes a workflow, so changes of its type no longer need approval. Requests
+// already submitted still go through their tasks.
+func (u *approvalUsecaseImpl) DeleteWorkflow(ctx context.Context, id int) error {
+	if id <= 0 {
+		return appError.ErrInvalidApprovalWorkflowId
+	}
+	return u.approvalRepository.DeleteWorkflow(ctx, id)
+}
@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteAsset removes an asset with its assignment history. Assets someone still holds have to be
+// returned first.
+func (u *assetUsecaseImpl) DeleteAsset(ctx context.Context, id int) error {
+	asset, err := u.GetAssetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if asset.Status == entity.AssetStatusAssigned {
+		return appError.ErrAssetAssigned
+	}
+	return u.assetRepository.DeleteAsset(ctx, id)
+}
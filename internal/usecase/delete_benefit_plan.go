@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *benefitUsecaseImpl) DeletePlan(ctx context.Context, id int) error {
+	if id <= 0 {
+		return appError.ErrInvalidBenefitPlanId
+	}
+	return u.benefitRepository.DeletePlan(ctx, id)
+}
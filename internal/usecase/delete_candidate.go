@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *recruitingUsecaseImpl) DeleteCandidate(ctx context.Context, id int) error {
+	if id <= 0 {
+		return appError.ErrInvalidCandidateId
+	}
+	return u.recruitingRepository.DeleteCandidate(ctx, id)
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *certificationUsecaseImpl) DeleteEvidence(ctx context.Context, certificationID, id int) error {
+	if certificationID <= 0 {
+		return appError.ErrInvalidCertificationId
+	}
+	if id <= 0 {
+		return appError.ErrInvalidEvidenceId
+	}
+	return u.certificationRepository.DeleteEvidence(ctx, certificationID, id)
+}
@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteTemplate removes a template. Checklists started from it are kept.
+func (u *checklistUsecaseImpl) DeleteTemplate(ctx context.Context, id int) error {
+	if id <= 0 {
+		return appError.ErrInvalidChecklistTemplateId
+	}
+	return u.checklistRepository.DeleteTemplate(ctx, id)
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *customFieldUsecaseImpl) DeleteDefinition(ctx context.Context, id int) error {
+	if id <= 0 {
+		return appError.ErrInvalidCustomFieldDefinitionId
+	}
+	if err := u.customFieldRepository.DeleteDefinition(ctx, id); err != nil {
+		return err
+	}
+
+	// Deleting the definition also removed its values from the employees.
+	invalidateEmployeesListCache(ctx, u.cache)
+	return nil
+}
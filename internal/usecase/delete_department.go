@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *departmentUsecaseImpl) DeleteDepartment(ctx context.Context, id int) error {
+	if id <= 0 {
+		return appError.ErrInvalidDepartment
+	}
+	return u.departmentRepository.DeleteDepartment(ctx, id)
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *benefitUsecaseImpl) DeleteDependent(ctx context.Context, employeeID, id int) error {
+	if employeeID <= 0 {
+		return appError.ErrInvalidEmployeeId
+	}
+	if id <= 0 {
+		return appError.ErrInvalidDependentId
+	}
+	return u.benefitRepository.DeleteDependent(ctx, employeeID, id)
+}
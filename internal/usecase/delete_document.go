@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+	"log"
+)
+
+// DeleteDocument removes the metadata first, so a document is never listed without its content;
+// a blob left behind by a storage failure is only logged.
+func (u *documentUsecaseImpl) DeleteDocument(ctx context.Context, employeeID, id int) error {
+	document, err := u.GetDocumentById(ctx, employeeID, id)
+	if err != nil {
+		return err
+	}
+	if err := u.documentRepository.DeleteDocument(ctx, employeeID, id); err != nil {
+		return err
+	}
+	if err := u.blobStorage.Delete(ctx, document.StorageKey); err != nil {
+		log.Printf("Error removing content of deleted document %d at %s: %v", document.ID, document.StorageKey, err)
+	}
+	return nil
+}
@@ -18,5 +18,7 @@ func (u *employeeUsecaseImpl) DeleteEmployee(ctx context.Context, id int) error
 		}
 		return err
 	}
+
+	invalidateEmployeesListCache(ctx, u.cache)
 	return nil
 }
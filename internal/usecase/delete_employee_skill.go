@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *skillUsecaseImpl) DeleteEmployeeSkill(ctx context.Context, employeeID, skillID int) error {
+	if employeeID <= 0 {
+		return appError.ErrInvalidEmployeeId
+	}
+	if skillID <= 0 {
+		return appError.ErrInvalidSkillId
+	}
+	return u.skillRepository.DeleteEmployeeSkill(ctx, employeeID, skillID)
+}
@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteWindow removes an enrollment window. Enrollments made during it keep their coverage.
+func (u *benefitUsecaseImpl) DeleteWindow(ctx context.Context, id int) error {
+	if id <= 0 {
+		return appError.ErrInvalidEnrollmentWindowId
+	}
+	return u.benefitRepository.DeleteWindow(ctx, id)
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+	"log"
+)
+
+// DeleteClaim removes a draft or rejected claim with its items and receipts. Receipt content left
+// behind by a storage failure is only logged.
+func (u *expenseUsecaseImpl) DeleteClaim(ctx context.Context, employeeID, id int) error {
+	claim, err := u.GetClaimById(ctx, employeeID, id)
+	if err != nil {
+		return err
+	}
+	receipts, err := u.expenseRepository.GetReceipts(ctx, claim.ID)
+	if err != nil {
+		return err
+	}
+	if err := u.expenseRepository.DeleteClaim(ctx, employeeID, id); err != nil {
+		return err
+	}
+	for _, receipt := range receipts {
+		if err := u.blobStorage.Delete(ctx, receipt.StorageKey); err != nil {
+			log.Printf("Error removing content of receipt %d of deleted expense claim %d at %s: %v",
+				receipt.ID, claim.ID, receipt.StorageKey, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"log"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// DeleteReceipt removes a receipt of a draft or rejected claim, the metadata first so a receipt is
+// never listed without its content.
+func (u *expenseUsecaseImpl) DeleteReceipt(ctx context.Context, employeeID, claimID, id int) error {
+	receipt, err := u.GetReceiptById(ctx, employeeID, claimID, id)
+	if err != nil {
+		return err
+	}
+	claim, err := u.GetClaimById(ctx, employeeID, claimID)
+	if err != nil {
+		return err
+	}
+	if !claim.Status.IsEditable() {
+		return appError.ErrExpenseClaimNotEditable
+	}
+
+	if err := u.expenseRepository.DeleteReceipt(ctx, claimID, id); err != nil {
+		return err
+	}
+	if err := u.blobStorage.Delete(ctx, receipt.StorageKey); err != nil {
+		log.Printf("Error removing content of deleted receipt %d at %s: %v", receipt.ID, receipt.StorageKey, err)
+	}
+	return nil
+}
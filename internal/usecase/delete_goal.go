@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *goalUsecaseImpl) DeleteGoal(ctx context.Context, id int) error {
+	if id <= 0 {
+		return appError.ErrInvalidGoalId
+	}
+	return u.goalRepository.DeleteGoal(ctx, id)
+}
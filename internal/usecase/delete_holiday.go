@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *holidayCalendarUsecaseImpl) DeleteHoliday(ctx context.Context, calendarID, id int) error {
+	if calendarID <= 0 {
+		return appError.ErrInvalidHolidayCalendarId
+	}
+	if id <= 0 {
+		return appError.ErrInvalidHolidayId
+	}
+	return u.holidayCalendarRepository.DeleteHoliday(ctx, calendarID, id)
+}
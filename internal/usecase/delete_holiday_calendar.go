@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *holidayCalendarUsecaseImpl) DeleteHolidayCalendar(ctx context.Context, id int) error {
+	if id <= 0 {
+		return appError.ErrInvalidHolidayCalendarId
+	}
+	return u.holidayCalendarRepository.DeleteHolidayCalendar(ctx, id)
+}
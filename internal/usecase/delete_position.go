@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *positionUsecaseImpl) DeletePosition(ctx context.Context, id int) error {
+	if id <= 0 {
+		return appError.ErrInvalidPosition
+	}
+	return u.positionRepository.DeletePosition(ctx, id)
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *shiftUsecaseImpl) DeleteShift(ctx context.Context, employeeID, id int) error {
+	if employeeID <= 0 {
+		return appError.ErrInvalidEmployeeId
+	}
+	if id <= 0 {
+		return appError.ErrInvalidShiftId
+	}
+	return u.shiftRepository.DeleteShift(ctx, employeeID, id)
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *shiftUsecaseImpl) DeleteShiftTemplate(ctx context.Context, id int) error {
+	if id <= 0 {
+		return appError.ErrInvalidShiftTemplateId
+	}
+	return u.shiftRepository.DeleteShiftTemplate(ctx, id)
+}
@@ -0,0 +1,18 @@
+package usecase
+
+import (
+	"context"
+)
+
+func (u *segmentUsecaseImpl) DeleteTag(ctx context.Context, tag string) error {
+	tags, err := normalizeTags([]string{tag})
+	if err != nil {
+		return err
+	}
+	if err := u.segmentRepository.DeleteTag(ctx, tags[0]); err != nil {
+		return err
+	}
+
+	invalidateEmployeesListCache(ctx, u.cache)
+	return nil
+}
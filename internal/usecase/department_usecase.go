@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type DepartmentUsecase interface {
+	CreateDepartment(ctx context.Context, department *entity.Department) (*entity.Department, error)
+	GetDepartmentById(ctx context.Context, id int) (*entity.Department, error)
+	GetAllDepartments(ctx context.Context) ([]*entity.Department, error)
+	UpdateDepartment(ctx context.Context, department *entity.Department) (*entity.Department, error)
+	DeleteDepartment(ctx context.Context, id int) error
+}
+
+type departmentUsecaseImpl struct {
+	departmentRepository repository.DepartmentRepository
+}
+
+func NewDepartmentUsecase(departmentRepository repository.DepartmentRepository) DepartmentUsecase {
+	return &departmentUsecaseImpl{departmentRepository: departmentRepository}
+}
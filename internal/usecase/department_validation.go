@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// validateDepartment checks a department.
+func validateDepartment(department *entity.Department) error {
+	department.Name = strings.TrimSpace(department.Name)
+	if len(department.Name) < 2 {
+		return appError.ErrInvalidDepartmentName
+	}
+	return nil
+}
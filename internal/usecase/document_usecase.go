@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"io"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/storage"
+)
+
+type DocumentUsecase interface {
+	// UploadDocument stores content in blob storage and records the document's metadata. The
+	// content type is sniffed from the content, which may be at most the configured size.
+	UploadDocument(ctx context.Context, document *entity.EmployeeDocument, content io.Reader) (*entity.EmployeeDocument, error)
+	GetEmployeeDocuments(ctx context.Context, employeeID int) ([]*entity.EmployeeDocument, error)
+	GetDocumentById(ctx context.Context, employeeID, id int) (*entity.EmployeeDocument, error)
+	// OpenDocument returns the document with a reader of its content, which the caller closes.
+	OpenDocument(ctx context.Context, employeeID, id int) (*entity.EmployeeDocument, io.ReadCloser, error)
+	DeleteDocument(ctx context.Context, employeeID, id int) error
+}
+
+type documentUsecaseImpl struct {
+	documentRepository repository.DocumentRepository
+	employeeRepository repository.EmployeeRepository
+	blobStorage        storage.BlobStorage
+	maxSize            int64
+}
+
+func NewDocumentUsecase(documentRepository repository.DocumentRepository,
+	employeeRepository repository.EmployeeRepository, blobStorage storage.BlobStorage, maxSize int64) DocumentUsecase {
+	return &documentUsecaseImpl{
+		documentRepository: documentRepository,
+		employeeRepository: employeeRepository,
+		blobStorage:        blobStorage,
+		maxSize:            maxSize,
+	}
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// documentContentTypes are the file types accepted as employee documents, as sniffed from their
+// contents.
+var documentContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/png":       true,
+	"image/jpeg":      true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"text/plain":      true,
+	docxContentType:   true,
+}
+
+// validateDocument checks the category and sniffed type of a document and fills in its content
+// type. The client's file name is kept without any directories.
+func validateDocument(document *entity.EmployeeDocument, content []byte) error {
+	if !document.Category.IsValid() {
+		return appError.ErrInvalidDocument
+	}
+	name, contentType, ok := validateUpload(document.FileName, "document", content, documentContentTypes)
+	if !ok {
+		return appError.ErrInvalidDocument
+	}
+	document.FileName = name
+	document.ContentType = contentType
+	return nil
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type EmergencyContactUsecase interface {
+	CreateEmergencyContact(ctx context.Context, contact *entity.EmergencyContact) (*entity.EmergencyContact, error)
+	GetEmergencyContactById(ctx context.Context, employeeID, id int) (*entity.EmergencyContact, error)
+	GetEmergencyContactsByEmployeeId(ctx context.Context, employeeID int) ([]*entity.EmergencyContact, error)
+	UpdateEmergencyContact(ctx context.Context, contact *entity.EmergencyContact) (*entity.EmergencyContact, error)
+	DeleteEmergencyContact(ctx context.Context, employeeID, id int) error
+}
+
+type emergencyContactUsecaseImpl struct {
+	emergencyContactRepository repository.EmergencyContactRepository
+	employeeRepository         repository.EmployeeRepository
+}
+
+func NewEmergencyContactUsecase(emergencyContactRepository repository.EmergencyContactRepository,
+	employeeRepository repository.EmployeeRepository) EmergencyContactUsecase {
+	return &emergencyContactUsecaseImpl{
+		emergencyContactRepository: emergencyContactRepository,
+		employeeRepository:         employeeRepository,
+	}
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/validation"
+)
+
+func validateEmergencyContact(contact *entity.EmergencyContact) error {
+	contact.Name = strings.TrimSpace(contact.Name)
+	contact.Relationship = strings.TrimSpace(contact.Relationship)
+	contact.Phone = strings.TrimSpace(contact.Phone)
+	contact.Email = strings.ToLower(strings.TrimSpace(contact.Email))
+
+	if len(contact.Name) < 2 {
+		return appError.ErrInvalidContactName
+	}
+	if contact.Relationship == "" {
+		return appError.ErrInvalidRelationship
+	}
+	if !validation.IsValidE164Phone(contact.Phone) {
+		return appError.ErrInvalidPhone
+	}
+	if contact.Email != "" && !validation.IsValidEmail(contact.Email) {
+		return appError.ErrInvalidEmail
+	}
+	return nil
+}
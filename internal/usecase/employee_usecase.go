@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	domaincache "github.com/mohamedfawas/employee_management_system/internal/domain/cache"
 	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
@@ -9,21 +10,56 @@ import (
 )
 
 type EmployeeUsecase interface {
+	// CreateEmployee saves a new employee and starts their onboarding checklist, when a template
+	// applies to them. Custom field values are checked against their definitions, here and on
+	// update.
 	CreateEmployee(ctx context.Context, employee *entity.Employee) (*entity.Employee, error)
 	GetEmployeeById(ctx context.Context, id int) (*entity.Employee, error)
-	GetAllEmployees(ctx context.Context) ([]*entity.Employee, error)
+	GetAllEmployees(ctx context.Context, filter entity.EmployeeFilter) ([]*entity.Employee, error)
 	UpdateEmployee(ctx context.Context, employee *entity.Employee) (*entity.Employee, error)
 	DeleteEmployee(ctx context.Context, id int) error
+
+	ChangeEmploymentStatus(ctx context.Context, change *entity.EmploymentStatusChange) (*entity.Employee, error)
+	// TerminateEmployee ends the employment and starts the offboarding checklist, when a template
+	// applies to the employee. The returned employee counts the company assets still to be returned.
+	TerminateEmployee(ctx context.Context, employeeID int, terminationDate time.Time, reason string) (*entity.Employee, error)
+	// RequestTermination submits the termination for approval and returns the pending request, or
+	// terminates the employee right away when terminations need no approval.
+	RequestTermination(ctx context.Context, employeeID int, terminationDate time.Time, reason string,
+		requestedBy *int) (*entity.Employee, *entity.ApprovalRequest, error)
+	// ApplyApproval terminates the employee of an approved termination request.
+	ApplyApproval(ctx context.Context, request *entity.ApprovalRequest) error
+	RehireEmployee(ctx context.Context, employeeID int, rehireDate time.Time, reason string) (*entity.Employee, error)
+	GetEmploymentStatusHistory(ctx context.Context, employeeID int) ([]*entity.EmploymentStatusChange, error)
 }
 
 type employeeUsecaseImpl struct {
-	employeeRepository repository.EmployeeRepository
-	cache              domaincache.Cache
+	employeeRepository    repository.EmployeeRepository
+	positionRepository    repository.PositionRepository
+	customFieldRepository repository.CustomFieldRepository
+	assetRepository       repository.AssetRepository
+	locationRepository    repository.LocationRepository
+	salaryBands           salaryBandChecker
+	salaryBandPolicy      entity.SalaryBandPolicy
+	cache                 domaincache.Cache
+	checklists            ChecklistUsecase
+	approvals             ApprovalUsecase
 }
 
-func NewEmployeeUsecase(employeeRepository repository.EmployeeRepository, cache domaincache.Cache) EmployeeUsecase {
+func NewEmployeeUsecase(employeeRepository repository.EmployeeRepository, positionRepository repository.PositionRepository,
+	exchangeRateUsecase ExchangeRateUsecase, salaryBandPolicy entity.SalaryBandPolicy, cache domaincache.Cache,
+	checklists ChecklistUsecase, approvals ApprovalUsecase, customFieldRepository repository.CustomFieldRepository,
+	assetRepository repository.AssetRepository, locationRepository repository.LocationRepository) EmployeeUsecase {
 	return &employeeUsecaseImpl{
-		employeeRepository: employeeRepository,
-		cache:              cache,
+		employeeRepository:    employeeRepository,
+		positionRepository:    positionRepository,
+		customFieldRepository: customFieldRepository,
+		assetRepository:       assetRepository,
+		locationRepository:    locationRepository,
+		salaryBands:           salaryBandChecker{exchangeRateUsecase: exchangeRateUsecase},
+		salaryBandPolicy:      salaryBandPolicy,
+		cache:                 cache,
+		checklists:            checklists,
+		approvals:             approvals,
 	}
 }
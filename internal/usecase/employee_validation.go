@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/validation"
+)
+
+// normalizeEmployeeProfile trims the contact fields and lower-cases emails,
+// so that uniqueness checks in the database are case-insensitive.
+func normalizeEmployeeProfile(employee *entity.Employee) {
+	employee.WorkEmail = strings.ToLower(strings.TrimSpace(employee.WorkEmail))
+	employee.PersonalEmail = strings.ToLower(strings.TrimSpace(employee.PersonalEmail))
+	employee.Phone = strings.TrimSpace(employee.Phone)
+
+	address := &employee.Address
+	address.Line1 = strings.TrimSpace(address.Line1)
+	address.Line2 = strings.TrimSpace(address.Line2)
+	address.City = strings.TrimSpace(address.City)
+	address.State = strings.TrimSpace(address.State)
+	address.PostalCode = strings.TrimSpace(address.PostalCode)
+	address.Country = strings.ToUpper(strings.TrimSpace(address.Country))
+}
+
+// validateSalary normalizes and checks the salary and pay period of an employee.
+// The pay period defaults to monthly.
+func validateSalary(employee *entity.Employee) error {
+	employee.Salary.Currency = strings.ToUpper(strings.TrimSpace(employee.Salary.Currency))
+	if employee.Salary.Amount <= 0 {
+		return appError.ErrInvalidSalary
+	}
+	if !entity.IsValidCurrency(employee.Salary.Currency) {
+		return appError.ErrInvalidCurrency
+	}
+
+	if employee.PayPeriod == "" {
+		employee.PayPeriod = entity.PayPeriodMonthly
+	}
+	if !employee.PayPeriod.IsValid() {
+		return appError.ErrInvalidPayPeriod
+	}
+	return nil
+}
+
+// assignPosition loads the employee's position and applies the salary band policy. Under the
+// flag policy an out-of-band salary is saved and marked instead of rejected.
+func (u *employeeUsecaseImpl) assignPosition(ctx context.Context, employee *entity.Employee) error {
+	if employee.PositionID <= 0 {
+		return appError.ErrInvalidPosition
+	}
+	position, err := u.positionRepository.GetPositionById(ctx, employee.PositionID)
+	if err != nil {
+		return err
+	}
+	if position == nil {
+		return appError.ErrPositionNotFound
+	}
+	employee.Position = position.Title
+
+	check, err := u.salaryBands.check(ctx, employee.Salary, employee.PayPeriod, position, today())
+	if err != nil {
+		return err
+	}
+	employee.SalaryOutOfBand = check != nil && !check.InBand()
+	if employee.SalaryOutOfBand && u.salaryBandPolicy == entity.SalaryBandPolicyReject {
+		return appError.ErrSalaryOutOfBand
+	}
+	return nil
+}
+
+// validateManager checks the employee's reporting line: the manager must be another, current
+// employee, and the line must not loop back to the employee. New employees (ID 0) cannot be
+// anyone's manager yet, so only updates need the loop check.
+func (u *employeeUsecaseImpl) validateManager(ctx context.Context, employee *entity.Employee) error {
+	if employee.ManagerID == nil {
+		return nil
+	}
+	if *employee.ManagerID <= 0 || *employee.ManagerID == employee.ID {
+		return appError.ErrInvalidManager
+	}
+
+	visited := map[int]bool{}
+	managerID := employee.ManagerID
+	for managerID != nil && !visited[*managerID] {
+		if *managerID == employee.ID {
+			return appError.ErrManagerCycle
+		}
+		visited[*managerID] = true
+
+		manager, err := u.employeeRepository.GetEmployeeById(ctx, *managerID)
+		if err != nil {
+			return err
+		}
+		if manager == nil {
+			return appError.ErrManagerNotFound
+		}
+		if len(visited) == 1 && manager.Status == entity.EmploymentStatusTerminated {
+			return appError.ErrInvalidManager
+		}
+		managerID = manager.ManagerID
+	}
+	return nil
+}
+
+// validateEmployeeProfile checks the optional contact details of an employee.
+// Empty fields are allowed; populated fields must be well formed.
+func validateEmployeeProfile(employee *entity.Employee) error {
+	if employee.WorkEmail != "" && !validation.IsValidEmail(employee.WorkEmail) {
+		return appError.ErrInvalidEmail
+	}
+	if employee.PersonalEmail != "" && !validation.IsValidEmail(employee.PersonalEmail) {
+		return appError.ErrInvalidEmail
+	}
+	if employee.Phone != "" && !validation.IsValidE164Phone(employee.Phone) {
+		return appError.ErrInvalidPhone
+	}
+
+	if !employee.Address.IsZero() {
+		address := employee.Address
+		if address.Line1 == "" || address.City == "" || address.PostalCode == "" ||
+			!validation.IsValidCountryCode(address.Country) {
+			return appError.ErrInvalidAddress
+		}
+	}
+
+	if employee.DateOfBirth != nil {
+		if !employee.DateOfBirth.Before(time.Now()) || !employee.DateOfBirth.Before(employee.HiredDate) {
+			return appError.ErrInvalidDateOfBirth
+		}
+	}
+	return nil
+}
+
+// ensureEmployeeExists returns ErrEmployeeNotFound when no employee has the given id.
+func ensureEmployeeExists(ctx context.Context, employeeRepository repository.EmployeeRepository, employeeID int) error {
+	if employeeID <= 0 {
+		return appError.ErrInvalidEmployeeId
+	}
+	employee, err := employeeRepository.GetEmployeeById(ctx, employeeID)
+	if err != nil {
+		return err
+	}
+	if employee == nil {
+		return appError.ErrEmployeeNotFound
+	}
+	return nil
+}
+
+// validateApprover checks that approverID may decide on a request of employee, such as leave or
+// a timesheet. When the employee has a manager only that manager may; otherwise any other
+// employee may.
+func validateApprover(ctx context.Context, employeeRepository repository.EmployeeRepository,
+	employee *entity.Employee, approverID int) error {
+
+	if approverID <= 0 || approverID == employee.ID {
+		return appError.ErrInvalidRequestApprover
+	}
+	if employee.ManagerID != nil {
+		if *employee.ManagerID != approverID {
+			return appError.ErrApproverNotManager
+		}
+		return nil
+	}
+	return ensureEmployeeExists(ctx, employeeRepository, approverID)
+}
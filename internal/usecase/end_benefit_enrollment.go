@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// EndEnrollment keeps the employee covered for the rest of the month, which payroll deducts in
+// full anyway.
+func (u *benefitUsecaseImpl) EndEnrollment(ctx context.Context, employeeID, id int) (*entity.BenefitEnrollment, error) {
+	enrollment, localToday, err := u.getEnrollmentToClose(ctx, employeeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if enrollment.CoverageStartsOn.After(localToday) {
+		return nil, appError.ErrBenefitCoverageNotStarted
+	}
+
+	endOfMonth := time.Date(localToday.Year(), localToday.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+	endedEnrollment, err := u.benefitRepository.EndEnrollment(ctx, employeeID, id, endOfMonth)
+	if err != nil {
+		return nil, err
+	}
+	if endedEnrollment == nil {
+		return nil, appError.ErrBenefitEnrollmentEnded
+	}
+	return endedEnrollment, nil
+}
+
+func (u *benefitUsecaseImpl) WithdrawEnrollment(ctx context.Context, employeeID, id int) error {
+	enrollment, localToday, err := u.getEnrollmentToClose(ctx, employeeID, id)
+	if err != nil {
+		return err
+	}
+	if !enrollment.CoverageStartsOn.After(localToday) {
+		return appError.ErrBenefitCoverageStarted
+	}
+	return u.benefitRepository.DeleteEnrollment(ctx, employeeID, id)
+}
+
+// getEnrollmentToClose returns an enrollment that has not ended with the current date at the
+// employee's location.
+func (u *benefitUsecaseImpl) getEnrollmentToClose(ctx context.Context, employeeID, id int) (*entity.BenefitEnrollment, time.Time, error) {
+	enrollment, err := u.GetEnrollmentById(ctx, employeeID, id)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if enrollment.CoverageEndsOn != nil {
+		return nil, time.Time{}, appError.ErrBenefitEnrollmentEnded
+	}
+	employee, err := u.getBenefitEmployee(ctx, employeeID)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	localToday, err := localDate(ctx, u.locationRepository, employee.LocationID, time.Now())
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return enrollment, localToday, nil
+}
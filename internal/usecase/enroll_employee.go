@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *trainingUsecaseImpl) EnrollEmployee(ctx context.Context, enrollment *entity.TrainingEnrollment) (*entity.TrainingEnrollment, error) {
+	if enrollment.CourseID <= 0 {
+		return nil, appError.ErrInvalidTrainingCourseId
+	}
+	if enrollment.EmployeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	employee, err := u.employeeRepository.GetEmployeeById(ctx, enrollment.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+	if employee.Status == entity.EmploymentStatusTerminated {
+		return nil, appError.ErrEmployeeTerminated
+	}
+	if err := validateEnrollment(enrollment); err != nil {
+		return nil, err
+	}
+	return u.trainingRepository.CreateEnrollment(ctx, enrollment)
+}
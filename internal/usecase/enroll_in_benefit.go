@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *benefitUsecaseImpl) Enroll(ctx context.Context, enrollment *entity.BenefitEnrollment) (*entity.BenefitEnrollment, error) {
+	employee, err := u.getBenefitEmployee(ctx, enrollment.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	if enrollment.PlanID <= 0 {
+		return nil, appError.ErrInvalidBenefitEnrollment
+	}
+	plan, err := u.GetPlanById(ctx, enrollment.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	localToday, err := localDate(ctx, u.locationRepository, employee.LocationID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := u.setCoverageStart(ctx, enrollment, employee, localToday); err != nil {
+		return nil, err
+	}
+	if !plan.Eligibility.IsEligible(employee, enrollment.CoverageStartsOn) {
+		return nil, appError.ErrNotEligibleForBenefit
+	}
+	if err := u.ensureSingleCoverage(ctx, employee.ID, plan, enrollment.CoverageStartsOn); err != nil {
+		return nil, err
+	}
+	if err := u.validateCoveredDependents(ctx, enrollment, plan); err != nil {
+		return nil, err
+	}
+
+	return u.benefitRepository.CreateEnrollment(ctx, enrollment)
+}
+
+// setCoverageStart starts coverage with the open enrollment window, or today for new hires
+// enrolling outside one.
+func (u *benefitUsecaseImpl) setCoverageStart(ctx context.Context, enrollment *entity.BenefitEnrollment,
+	employee *entity.Employee, localToday time.Time) error {
+
+	window, err := u.benefitRepository.GetOverlappingWindow(ctx, localToday, localToday)
+	if err != nil {
+		return err
+	}
+	if window != nil {
+		enrollment.WindowID = &window.ID
+		enrollment.CoverageStartsOn = window.CoverageStartsOn
+		if enrollment.CoverageStartsOn.Before(localToday) {
+			enrollment.CoverageStartsOn = localToday
+		}
+		return nil
+	}
+
+	hiredDate := dateOnly(employee.HiredDate)
+	if localToday.After(hiredDate.AddDate(0, 0, newHireEnrollmentDays)) {
+		return appError.ErrBenefitEnrollmentClosed
+	}
+	enrollment.WindowID = nil
+	enrollment.CoverageStartsOn = localToday
+	if hiredDate.After(localToday) {
+		enrollment.CoverageStartsOn = hiredDate
+	}
+	return nil
+}
+
+// ensureSingleCoverage checks that the employee holds no other plan of the plan's type from date
+// on. Plans of type other can be combined freely.
+func (u *benefitUsecaseImpl) ensureSingleCoverage(ctx context.Context, employeeID int, plan *entity.BenefitPlan,
+	date time.Time) error {
+
+	enrollments, err := u.benefitRepository.GetEnrollments(ctx, employeeID)
+	if err != nil {
+		return err
+	}
+	for _, enrollment := range enrollments {
+		if !enrollment.IsActive(date) {
+			continue
+		}
+		if enrollment.PlanID == plan.ID || (plan.Type != entity.BenefitPlanOther && enrollment.Plan.Type == plan.Type) {
+			return appError.ErrBenefitAlreadyEnrolled
+		}
+	}
+	return nil
+}
+
+// validateCoveredDependents checks that the dependents to cover belong to the employee and that the
+// plan covers dependents at all.
+func (u *benefitUsecaseImpl) validateCoveredDependents(ctx context.Context, enrollment *entity.BenefitEnrollment,
+	plan *entity.BenefitPlan) error {
+
+	if len(enrollment.DependentIDs) > 0 && !plan.CoversDependents {
+		return appError.ErrInvalidBenefitEnrollment
+	}
+	seen := map[int]bool{}
+	dependentIDs := []int{}
+	for _, dependentID := range enrollment.DependentIDs {
+		if dependentID <= 0 {
+			return appError.ErrInvalidDependentId
+		}
+		if seen[dependentID] {
+			continue
+		}
+		seen[dependentID] = true
+
+		dependent, err := u.benefitRepository.GetDependentById(ctx, enrollment.EmployeeID, dependentID)
+		if err != nil {
+			return err
+		}
+		if dependent == nil {
+			return appError.ErrDependentNotFound
+		}
+		dependentIDs = append(dependentIDs, dependentID)
+	}
+	enrollment.DependentIDs = dependentIDs
+	return nil
+}
+
+// getBenefitEmployee returns the employee an enrollment or eligibility check is about.
+func (u *benefitUsecaseImpl) getBenefitEmployee(ctx context.Context, employeeID int) (*entity.Employee, error) {
+	if employeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	employee, err := u.employeeRepository.GetEmployeeById(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+	return employee, nil
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/notification"
+)
+
+func (u *approvalUsecaseImpl) EscalateOverdueTasks(ctx context.Context, asOf time.Time) (int, error) {
+	tasks, err := u.approvalRepository.GetOverdueTasks(ctx, asOf)
+	if err != nil {
+		return 0, err
+	}
+
+	escalated := 0
+	for _, task := range tasks {
+		assignee, err := u.escalationTarget(ctx, task)
+		if err != nil {
+			// Keep going: one broken record must not hold back the other escalations.
+			log.Printf("[APPROVALS] failed to find whom to escalate task %d to: %v", task.ID, err)
+			continue
+		}
+
+		var assigneeID *int
+		if assignee != nil {
+			assigneeID = &assignee.ID
+		} else {
+			log.Printf("[APPROVALS] no one to escalate task %d of request %d to", task.ID, task.RequestID)
+		}
+		escalatedTask, err := u.approvalRepository.EscalateTask(ctx, task.ID, assigneeID)
+		if err != nil {
+			return escalated, err
+		}
+		if escalatedTask == nil || assignee == nil {
+			continue
+		}
+		escalated++
+
+		if assignee.WorkEmail == "" {
+			continue
+		}
+		if err := u.notifier.Notify(ctx, escalationNotice(escalatedTask, assignee)); err != nil {
+			log.Printf("[APPROVALS] failed to send escalation notice for task %d: %v", task.ID, err)
+		}
+	}
+	return escalated, nil
+}
+
+// escalationTarget returns the current employee an overdue task goes to: the step's escalation
+// contact unless the task is already theirs, otherwise the manager of its assignee. It returns
+// nil when there is no one.
+func (u *approvalUsecaseImpl) escalationTarget(ctx context.Context, task *entity.ApprovalTask) (*entity.Employee, error) {
+	candidateID := task.EscalateToID
+	if candidateID == nil || (task.AssigneeID != nil && *candidateID == *task.AssigneeID) {
+		if task.AssigneeID == nil {
+			return nil, nil
+		}
+		assignee, err := u.employeeRepository.GetEmployeeById(ctx, *task.AssigneeID)
+		if err != nil || assignee == nil {
+			return nil, err
+		}
+		candidateID = assignee.ManagerID
+	}
+	if candidateID == nil || *candidateID == task.EmployeeID {
+		return nil, nil
+	}
+
+	candidate, err := u.employeeRepository.GetEmployeeById(ctx, *candidateID)
+	if err != nil {
+		return nil, err
+	}
+	if candidate == nil || candidate.Status == entity.EmploymentStatusTerminated {
+		return nil, nil
+	}
+	return candidate, nil
+}
+
+func escalationNotice(task *entity.ApprovalTask, assignee *entity.Employee) notification.Message {
+	return notification.Message{
+		To:      []string{assignee.WorkEmail},
+		Subject: fmt.Sprintf("Approval request #%d needs your decision", task.RequestID),
+		Body: fmt.Sprintf("The approval of \"%s\" for %s was not decided in time and has been handed to you. "+
+			"It is waiting in your approvals inbox.", task.Summary, task.EmployeeName),
+	}
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+// SalaryConversion is an employee's salary expressed in another currency.
+type SalaryConversion struct {
+	EmployeeID int
+	Salary     entity.Money
+	PayPeriod  entity.PayPeriod
+	Converted  entity.Money
+	Rate       float64
+	RateDate   time.Time
+}
+
+type ExchangeRateUsecase interface {
+	CreateExchangeRate(ctx context.Context, rate *entity.ExchangeRate) (*entity.ExchangeRate, error)
+	GetExchangeRates(ctx context.Context) ([]*entity.ExchangeRate, error)
+
+	// ConvertMoney converts amount into targetCurrency with the rates effective on asOf.
+	// It returns the converted money, the applied rate and the date of the newest rate used.
+	ConvertMoney(ctx context.Context, amount entity.Money, targetCurrency string, asOf time.Time) (entity.Money, float64, time.Time, error)
+
+	// ConvertEmployeeSalary converts an employee's salary into targetCurrency, or into the
+	// reporting currency when targetCurrency is empty.
+	ConvertEmployeeSalary(ctx context.Context, employeeID int, targetCurrency string) (*SalaryConversion, error)
+}
+
+type exchangeRateUsecaseImpl struct {
+	exchangeRateRepository repository.ExchangeRateRepository
+	employeeRepository     repository.EmployeeRepository
+	reportingCurrency      string
+}
+
+func NewExchangeRateUsecase(exchangeRateRepository repository.ExchangeRateRepository,
+	employeeRepository repository.EmployeeRepository, reportingCurrency string) ExchangeRateUsecase {
+	return &exchangeRateUsecaseImpl{
+		exchangeRateRepository: exchangeRateRepository,
+		employeeRepository:     employeeRepository,
+		reportingCurrency:      reportingCurrency,
+	}
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/storage"
+)
+
+type ExpenseUsecase interface {
+	CreateCategory(ctx context.Context, category *entity.ExpenseCategory) (*entity.ExpenseCategory, error)
+	GetCategoryById(ctx context.Context, id int) (*entity.ExpenseCategory, error)
+	GetAllCategories(ctx context.Context) ([]*entity.ExpenseCategory, error)
+	UpdateCategory(ctx context.Context, category *entity.ExpenseCategory) (*entity.ExpenseCategory, error)
+	DeleteCategory(ctx context.Context, id int) error
+
+	// CreateClaim drafts a claim for the employee. Category limits are checked straight away, the
+	// receipts a category requires only once the claim is submitted.
+	CreateClaim(ctx context.Context, claim *entity.ExpenseClaim) (*entity.ExpenseClaim, error)
+	GetClaimById(ctx context.Context, employeeID, id int) (*entity.ExpenseClaim, error)
+	GetEmployeeClaims(ctx context.Context, employeeID int, status entity.ExpenseClaimStatus) ([]*entity.ExpenseClaim, error)
+	// GetClaims lists the claims of all employees. Payroll picks claims to reimburse up with
+	// status: approved.
+	GetClaims(ctx context.Context, filter entity.ExpenseClaimFilter) ([]*entity.ExpenseClaim, error)
+	// UpdateClaim replaces the details and items of a draft or rejected claim, which becomes a
+	// draft again.
+	UpdateClaim(ctx context.Context, claim *entity.ExpenseClaim) (*entity.ExpenseClaim, error)
+	DeleteClaim(ctx context.Context, employeeID, id int) error
+
+	// SubmitClaim sends a draft or rejected claim for approval. It goes through the expense
+	// approval workflow when there is one, and to the employee's manager otherwise.
+	SubmitClaim(ctx context.Context, employeeID, id int) (*entity.ExpenseClaim, error)
+	// ApproveClaim and RejectClaim record the manager's decision on a submitted claim that is not
+	// decided by an approval workflow.
+	ApproveClaim(ctx context.Context, employeeID, id, approverID int, comment string) (*entity.ExpenseClaim, error)
+	RejectClaim(ctx context.Context, employeeID, id, approverID int, comment string) (*entity.ExpenseClaim, error)
+	// ReimburseClaim marks an approved claim paid back, today unless reimbursedOn is set, optionally
+	// with the payroll run that paid it.
+	ReimburseClaim(ctx context.Context, employeeID, id int, reimbursedOn *time.Time, payrollRunID *int,
+		reference string) (*entity.ExpenseClaim, error)
+
+	// UploadReceipt stores content in blob storage and attaches it to a draft or rejected claim.
+	// The content type is sniffed from the content, which may be at most the configured size.
+	UploadReceipt(ctx context.Context, employeeID int, receipt *entity.ExpenseReceipt, content io.Reader) (*entity.ExpenseReceipt, error)
+	GetReceipts(ctx context.Context, employeeID, claimID int) ([]*entity.ExpenseReceipt, error)
+	GetReceiptById(ctx context.Context, employeeID, claimID, id int) (*entity.ExpenseReceipt, error)
+	// OpenReceipt returns the receipt with a reader of its content, which the caller closes.
+	OpenReceipt(ctx context.Context, employeeID, claimID, id int) (*entity.ExpenseReceipt, io.ReadCloser, error)
+	DeleteReceipt(ctx context.Context, employeeID, claimID, id int) error
+
+	// ApplyApproval approves the claim of an approved expense request.
+	ApplyApproval(ctx context.Context, request *entity.ApprovalRequest) error
+	// RejectApproval rejects the claim of a rejected expense request and takes the claim of a
+	// cancelled one back to draft.
+	RejectApproval(ctx context.Context, request *entity.ApprovalRequest) error
+
+	// GetReport totals approved and reimbursed expenses by department and month, in the reporting
+	// currency.
+	GetReport(ctx context.Context, filter entity.ExpenseReportFilter) ([]*entity.ExpenseReportRow, error)
+}
+
+type expenseUsecaseImpl struct {
+	expenseRepository   repository.ExpenseRepository
+	employeeRepository  repository.EmployeeRepository
+	payrollRepository   repository.PayrollRepository
+	exchangeRateUsecase ExchangeRateUsecase
+	approvals           ApprovalUsecase
+	blobStorage         storage.BlobStorage
+	maxSize             int64
+	reportingCurrency   string
+}
+
+func NewExpenseUsecase(expenseRepository repository.ExpenseRepository, employeeRepository repository.EmployeeRepository,
+	payrollRepository repository.PayrollRepository, exchangeRateUsecase ExchangeRateUsecase, approvals ApprovalUsecase,
+	blobStorage storage.BlobStorage, maxSize int64, reportingCurrency string) ExpenseUsecase {
+	return &expenseUsecaseImpl{
+		expenseRepository:   expenseRepository,
+		employeeRepository:  employeeRepository,
+		payrollRepository:   payrollRepository,
+		exchangeRateUsecase: exchangeRateUsecase,
+		approvals:           approvals,
+		blobStorage:         blobStorage,
+		maxSize:             maxSize,
+		reportingCurrency:   reportingCurrency,
+	}
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const maxExpenseItems = 50
+
+// expenseReceiptContentTypes are the file types accepted as receipts, as sniffed from their
+// contents.
+var expenseReceiptContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/png":       true,
+	"image/jpeg":      true,
+	"image/gif":       true,
+	"image/webp":      true,
+}
+
+func validateExpenseCategory(category *entity.ExpenseCategory) error {
+	category.Name = strings.TrimSpace(category.Name)
+	category.Description = strings.TrimSpace(category.Description)
+	if len(category.Name) < 2 {
+		return appError.ErrInvalidExpenseCategory
+	}
+	if !category.HasLimit() {
+		return nil
+	}
+	category.Limit.Currency = strings.ToUpper(strings.TrimSpace(category.Limit.Currency))
+	if !category.Limit.IsValid() {
+		return appError.ErrInvalidExpenseCategory
+	}
+	return nil
+}
+
+func validateExpenseClaim(claim *entity.ExpenseClaim) error {
+	claim.Title = strings.TrimSpace(claim.Title)
+	claim.Currency = strings.ToUpper(strings.TrimSpace(claim.Currency))
+	if claim.Title == "" || !entity.IsValidCurrency(claim.Currency) {
+		return appError.ErrInvalidExpenseClaim
+	}
+	if len(claim.Items) == 0 || len(claim.Items) > maxExpenseItems {
+		return appError.ErrInvalidExpenseClaim
+	}
+
+	for i := range claim.Items {
+		item := &claim.Items[i]
+		item.Description = strings.TrimSpace(item.Description)
+		item.ExpenseDate = dateOnly(item.ExpenseDate)
+		if item.CategoryID <= 0 || item.Description == "" || item.Amount.Amount <= 0 {
+			return appError.ErrInvalidExpenseClaim
+		}
+		if item.Amount.Currency != "" && !strings.EqualFold(item.Amount.Currency, claim.Currency) {
+			return appError.ErrInvalidExpenseClaim
+		}
+		if item.ExpenseDate.IsZero() || item.ExpenseDate.After(today()) {
+			return appError.ErrInvalidExpenseClaim
+		}
+		item.Amount.Currency = claim.Currency
+	}
+	return nil
+}
+
+// validateExpenseReceipt checks the sniffed type of a receipt and fills in its content type. The
+// client's file name is kept without any directories.
+func validateExpenseReceipt(receipt *entity.ExpenseReceipt, content []byte) error {
+	name, contentType, ok := validateUpload(receipt.FileName, "receipt", content, expenseReceiptContentTypes)
+	if !ok {
+		return appError.ErrInvalidExpenseReceipt
+	}
+	receipt.FileName = name
+	receipt.ContentType = contentType
+	return nil
+}
+
+// checkExpenseCategories makes sure the categories of the claim exist and that the claim holds no
+// more of a category than its limit, converting into the limit's currency at today's rates. With
+// requireReceipts set, claims with expenses of categories requiring receipts need one.
+func (u *expenseUsecaseImpl) checkExpenseCategories(ctx context.Context, claim *entity.ExpenseClaim,
+	requireReceipts bool) error {
+
+	totals := map[int]int64{}
+	categoryIDs := []int{}
+	for _, item := range claim.Items {
+		if _, ok := totals[item.CategoryID]; !ok {
+			categoryIDs = append(categoryIDs, item.CategoryID)
+		}
+		totals[item.CategoryID] += item.Amount.Amount
+	}
+
+	for _, categoryID := range categoryIDs {
+		category, err := u.GetCategoryById(ctx, categoryID)
+		if err != nil {
+			return err
+		}
+		if requireReceipts && category.ReceiptRequired && claim.ReceiptCount == 0 {
+			return appError.ErrExpenseReceiptRequired
+		}
+		if !category.HasLimit() {
+			continue
+		}
+
+		total := entity.Money{Amount: totals[categoryID], Currency: claim.Currency}
+		if total.Currency != category.Limit.Currency {
+			total, _, _, err = u.exchangeRateUsecase.ConvertMoney(ctx, total, category.Limit.Currency, today())
+			if err != nil {
+				return err
+			}
+		}
+		if total.Amount > category.Limit.Amount {
+			return appError.ErrExpenseLimitExceeded
+		}
+	}
+	return nil
+}
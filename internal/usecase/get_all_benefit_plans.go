@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *benefitUsecaseImpl) GetAllPlans(ctx context.Context) ([]*entity.BenefitPlan, error) {
+	return u.benefitRepository.GetAllPlans(ctx)
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *customFieldUsecaseImpl) GetAllDefinitions(ctx context.Context) ([]*entity.CustomFieldDefinition, error) {
+	return u.customFieldRepository.GetAllDefinitions(ctx)
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *departmentUsecaseImpl) GetAllDepartments(ctx context.Context) ([]*entity.Department, error) {
+	return u.departmentRepository.GetAllDepartments(ctx)
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	domaincache "github.com/mohamedfawas/employee_management_system/internal/domain/cache"
 	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
 )
 
@@ -15,7 +16,29 @@ const (
 	maxEmployeesToCache = 100
 )
 
-func (u *employeeUsecaseImpl) GetAllEmployees(ctx context.Context) ([]*entity.Employee, error) {
+func (u *employeeUsecaseImpl) GetAllEmployees(ctx context.Context, filter entity.EmployeeFilter) ([]*entity.Employee, error) {
+	if len(filter.Tags) > 0 {
+		tags, err := normalizeTags(filter.Tags)
+		if err != nil {
+			return nil, err
+		}
+		filter.Tags = tags
+	}
+	if len(filter.CustomFields) > 0 {
+		definitions, err := u.customFieldRepository.GetAllDefinitions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if filter.CustomFields, err = parseCustomFieldFilter(definitions, filter.CustomFields); err != nil {
+			return nil, err
+		}
+	}
+
+	// Only the unfiltered list is cached; filtered lists always come from the DB.
+	if !filter.IsEmpty() {
+		return u.employeeRepository.GetAllEmployees(ctx, filter)
+	}
+
 	// 1) Try cache
 	cached, err := u.cache.Get(ctx, employeesListKey)
 	if err == nil && cached != "" {
@@ -43,7 +66,7 @@ func (u *employeeUsecaseImpl) GetAllEmployees(ctx context.Context) ([]*entity.Em
 	}
 
 	// 2) Cache miss or error , then fetch from DB
-	employees, err := u.employeeRepository.GetAllEmployees(ctx)
+	employees, err := u.employeeRepository.GetAllEmployees(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -68,3 +91,11 @@ func (u *employeeUsecaseImpl) GetAllEmployees(ctx context.Context) ([]*entity.Em
 
 	return employees, nil
 }
+
+// invalidateEmployeesListCache drops the cached employee list after a write,
+// so the next GetAllEmployees call reads fresh data from the DB.
+func invalidateEmployeesListCache(ctx context.Context, cache domaincache.Cache) {
+	if err := cache.Del(ctx, employeesListKey); err != nil {
+		log.Printf("cache: failed to invalidate employees list: %v", err)
+	}
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *expenseUsecaseImpl) GetAllCategories(ctx context.Context) ([]*entity.ExpenseCategory, error) {
+	return u.expenseRepository.GetAllCategories(ctx)
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *holidayCalendarUsecaseImpl) GetAllHolidayCalendars(ctx context.Context) ([]*entity.HolidayCalendar, error) {
+	return u.holidayCalendarRepository.GetAllHolidayCalendars(ctx)
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *leaveUsecaseImpl) GetAllLeaveTypes(ctx context.Context) ([]*entity.LeaveType, error) {
+	return u.leaveRepository.GetAllLeaveTypes(ctx)
+}
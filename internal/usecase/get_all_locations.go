@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *locationUsecaseImpl) GetAllLocations(ctx context.Context) ([]*entity.Location, error) {
+	return u.locationRepository.GetAllLocations(ctx)
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *payrollUsecaseImpl) GetAllPayrollPeriods(ctx context.Context) ([]*entity.PayrollPeriod, error) {
+	return u.payrollRepository.GetAllPayrollPeriods(ctx)
+}
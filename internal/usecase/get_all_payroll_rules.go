@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *payrollUsecaseImpl) GetAllPayrollRules(ctx context.Context) ([]*entity.PayrollRule, error) {
+	return u.payrollRepository.GetAllPayrollRules(ctx)
+}
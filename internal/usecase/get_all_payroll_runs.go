@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *payrollUsecaseImpl) GetAllPayrollRuns(ctx context.Context) ([]*entity.PayrollRun, error) {
+	return u.payrollRepository.GetAllPayrollRuns(ctx)
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *positionUsecaseImpl) GetAllPositions(ctx context.Context) ([]*entity.Position, error) {
+	return u.positionRepository.GetAllPositions(ctx)
+}
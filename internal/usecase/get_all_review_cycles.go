@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *reviewUsecaseImpl) GetAllReviewCycles(ctx context.Context) ([]*entity.ReviewCycle, error) {
+	return u.reviewRepository.GetAllReviewCycles(ctx)
+}
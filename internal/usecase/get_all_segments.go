@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *segmentUsecaseImpl) GetAllSegments(ctx context.Context) ([]*entity.EmployeeSegment, error) {
+	return u.segmentRepository.GetAllSegments(ctx)
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *shiftUsecaseImpl) GetAllShiftTemplates(ctx context.Context) ([]*entity.ShiftTemplate, error) {
+	return u.shiftRepository.GetAllShiftTemplates(ctx)
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *skillUsecaseImpl) GetAllSkillCategories(ctx context.Context) ([]*entity.SkillCategory, error) {
+	return u.skillRepository.GetAllSkillCategories(ctx)
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *skillUsecaseImpl) GetAllSkills(ctx context.Context, categoryID int) ([]*entity.Skill, error) {
+	return u.skillRepository.GetAllSkills(ctx, categoryID)
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *segmentUsecaseImpl) GetAllTags(ctx context.Context) ([]*entity.TagCount, error) {
+	return u.segmentRepository.GetAllTags(ctx)
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *trainingUsecaseImpl) GetAllCourses(ctx context.Context) ([]*entity.TrainingCourse, error) {
+	return u.trainingRepository.GetAllCourses(ctx)
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *approvalUsecaseImpl) GetDelegations(ctx context.Context, delegatorID int) ([]*entity.ApprovalDelegation, error) {
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, delegatorID); err != nil {
+		return nil, err
+	}
+	return u.approvalRepository.GetDelegations(ctx, delegatorID)
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *approvalUsecaseImpl) GetInbox(ctx context.Context, approverID int) ([]*entity.ApprovalTask, error) {
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, approverID); err != nil {
+		return nil, err
+	}
+	return u.approvalRepository.GetInbox(ctx, approverID, today())
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *approvalUsecaseImpl) GetRequestById(ctx context.Context, id int) (*entity.ApprovalRequest, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidApprovalRequestId
+	}
+
+	request, err := u.approvalRepository.GetRequestById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, appError.ErrApprovalRequestNotFound
+	}
+	return request, nil
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *approvalUsecaseImpl) GetRequests(ctx context.Context, filter entity.ApprovalRequestFilter) ([]*entity.ApprovalRequest, error) {
+	if err := validateApprovalRequestFilter(filter); err != nil {
+		return nil, err
+	}
+	return u.approvalRepository.GetRequests(ctx, filter)
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *approvalUsecaseImpl) GetWorkflowById(ctx context.Context, id int) (*entity.ApprovalWorkflow, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidApprovalWorkflowId
+	}
+
+	workflow, err := u.approvalRepository.GetWorkflowById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if workflow == nil {
+		return nil, appError.ErrApprovalWorkflowNotFound
+	}
+	return workflow, nil
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *approvalUsecaseImpl) GetWorkflows(ctx context.Context) ([]*entity.ApprovalWorkflow, error) {
+	return u.approvalRepository.GetWorkflows(ctx)
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *assetUsecaseImpl) GetAssetAssignments(ctx context.Context, assetID int) ([]*entity.AssetAssignment, error) {
+	if _, err := u.GetAssetById(ctx, assetID); err != nil {
+		return nil, err
+	}
+	return u.assetRepository.GetAssignments(ctx, entity.AssetAssignmentFilter{AssetID: assetID})
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *assetUsecaseImpl) GetAssetById(ctx context.Context, id int) (*entity.Asset, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidAssetId
+	}
+
+	asset, err := u.assetRepository.GetAssetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if asset == nil {
+		return nil, appError.ErrAssetNotFound
+	}
+	return asset, nil
+}
@@ -0,0 +1,18 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *assetUsecaseImpl) GetAssets(ctx context.Context, filter entity.AssetFilter) ([]*entity.Asset, error) {
+	if filter.Type != "" && !filter.Type.IsValid() {
+		return nil, appError.ErrInvalidAssetType
+	}
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, appError.ErrInvalidAssetStatus
+	}
+	return u.assetRepository.GetAssets(ctx, filter)
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *attendanceUsecaseImpl) GetAttendanceEntries(ctx context.Context, employeeID int,
+	from, to time.Time) ([]*entity.AttendanceEntry, error) {
+
+	if from.IsZero() || to.IsZero() || to.Before(from) {
+		return nil, appError.ErrInvalidDateRange
+	}
+	employee, err := u.getEmployeeForAttendance(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	// The dates are days at the employee's location.
+	zone, err := u.employeeZone(ctx, employee)
+	if err != nil {
+		return nil, err
+	}
+	return u.attendanceRepository.GetAttendanceEntries(ctx, employeeID, midnightIn(from, zone),
+		midnightIn(dateOnly(to).AddDate(0, 0, 1), zone))
+}
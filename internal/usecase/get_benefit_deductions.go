@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *benefitUsecaseImpl) GetDeductions(ctx context.Context, year int, month time.Month,
+	employeeID int) ([]*entity.BenefitDeduction, error) {
+
+	if year < 1 || month < time.January || month > time.December || employeeID < 0 {
+		return nil, appError.ErrInvalidDeductionPeriod
+	}
+	if employeeID > 0 {
+		if err := ensureEmployeeExists(ctx, u.employeeRepository, employeeID); err != nil {
+			return nil, err
+		}
+	}
+
+	period := entity.PayrollPeriod{Year: year, Month: month}
+	return u.benefitRepository.GetDeductions(ctx, period.StartDate(), period.EndDate(), employeeID)
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *benefitUsecaseImpl) GetEnrollments(ctx context.Context, employeeID int) ([]*entity.BenefitEnrollment, error) {
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, employeeID); err != nil {
+		return nil, err
+	}
+	return u.benefitRepository.GetEnrollments(ctx, employeeID)
+}
+
+func (u *benefitUsecaseImpl) GetEnrollmentById(ctx context.Context, employeeID, id int) (*entity.BenefitEnrollment, error) {
+	if employeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	if id <= 0 {
+		return nil, appError.ErrInvalidBenefitEnrollmentId
+	}
+
+	enrollment, err := u.benefitRepository.GetEnrollmentById(ctx, employeeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if enrollment == nil {
+		return nil, appError.ErrBenefitEnrollmentNotFound
+	}
+	return enrollment, nil
+}
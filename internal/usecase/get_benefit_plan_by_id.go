@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *benefitUsecaseImpl) GetPlanById(ctx context.Context, id int) (*entity.BenefitPlan, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidBenefitPlanId
+	}
+
+	plan, err := u.benefitRepository.GetPlanById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if plan == nil {
+		return nil, appError.ErrBenefitPlanNotFound
+	}
+	return plan, nil
+}
@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *recruitingUsecaseImpl) GetCandidateById(ctx context.Context, id int) (*entity.Candidate, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidCandidateId
+	}
+	candidate, err := u.recruitingRepository.GetCandidateById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if candidate == nil {
+		return nil, appError.ErrCandidateNotFound
+	}
+	return candidate, nil
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *recruitingUsecaseImpl) GetCandidateInterviews(ctx context.Context, candidateID int) ([]*entity.Interview, error) {
+	if _, err := u.GetCandidateById(ctx, candidateID); err != nil {
+		return nil, err
+	}
+	return u.recruitingRepository.GetCandidateInterviews(ctx, candidateID)
+}
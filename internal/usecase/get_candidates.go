@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *recruitingUsecaseImpl) GetCandidates(ctx context.Context, filter entity.CandidateFilter) ([]*entity.Candidate, error) {
+	if filter.Stage != "" && !filter.Stage.IsValid() {
+		return nil, appError.ErrInvalidCandidateStage
+	}
+	if filter.RequisitionID > 0 {
+		if _, err := u.GetRequisitionById(ctx, filter.RequisitionID); err != nil {
+			return nil, err
+		}
+	}
+	return u.recruitingRepository.GetCandidates(ctx, filter)
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *certificationUsecaseImpl) GetCertificationById(ctx context.Context, id int) (*entity.Certification, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidCertificationId
+	}
+
+	certification, err := u.certificationRepository.GetCertificationById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if certification == nil {
+		return nil, appError.ErrCertificationNotFound
+	}
+	return certification, nil
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *certificationUsecaseImpl) GetEvidence(ctx context.Context, certificationID int) ([]*entity.CertificationEvidence, error) {
+	if _, err := u.GetCertificationById(ctx, certificationID); err != nil {
+		return nil, err
+	}
+	return u.certificationRepository.GetEvidence(ctx, certificationID)
+}
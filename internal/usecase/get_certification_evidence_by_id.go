@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *certificationUsecaseImpl) GetEvidenceById(ctx context.Context, certificationID, id int) (*entity.CertificationEvidence, error) {
+	if certificationID <= 0 {
+		return nil, appError.ErrInvalidCertificationId
+	}
+	if id <= 0 {
+		return nil, appError.ErrInvalidEvidenceId
+	}
+
+	evidence, err := u.certificationRepository.GetEvidenceById(ctx, certificationID, id)
+	if err != nil {
+		return nil, err
+	}
+	if evidence == nil {
+		return nil, appError.ErrEvidenceNotFound
+	}
+	return evidence, nil
+}
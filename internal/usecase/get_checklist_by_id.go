@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *checklistUsecaseImpl) GetChecklistById(ctx context.Context, id int) (*entity.Checklist, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidChecklistId
+	}
+
+	checklist, err := u.checklistRepository.GetChecklistById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if checklist == nil {
+		return nil, appError.ErrChecklistNotFound
+	}
+	return checklist, nil
+}
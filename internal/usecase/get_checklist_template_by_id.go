@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *checklistUsecaseImpl) GetTemplateById(ctx context.Context, id int) (*entity.ChecklistTemplate, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidChecklistTemplateId
+	}
+
+	template, err := u.checklistRepository.GetTemplateById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		return nil, appError.ErrChecklistTemplateNotFound
+	}
+	return template, nil
+}
@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *checklistUsecaseImpl) GetTemplates(ctx context.Context, kind entity.ChecklistKind) ([]*entity.ChecklistTemplate, error) {
+	if kind != "" && !kind.IsValid() {
+		return nil, appError.ErrInvalidChecklistKind
+	}
+	return u.checklistRepository.GetTemplates(ctx, kind)
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *checklistUsecaseImpl) GetChecklists(ctx context.Context, filter entity.ChecklistFilter) ([]*entity.Checklist, error) {
+	if err := validateChecklistFilter(filter); err != nil {
+		return nil, err
+	}
+	return u.checklistRepository.GetChecklists(ctx, filter)
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *goalUsecaseImpl) GetCompanyGoals(ctx context.Context) ([]*entity.Goal, error) {
+	return u.goalRepository.GetGoals(ctx, entity.GoalFilter{CompanyOnly: true})
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *compensationUsecaseImpl) GetCompensationHistory(ctx context.Context,
+	employeeID int) ([]*entity.CompensationChange, error) {
+
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, employeeID); err != nil {
+		return nil, err
+	}
+	return u.compensationRepository.GetChangesByEmployeeId(ctx, employeeID)
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *trainingUsecaseImpl) GetCourseEnrollments(ctx context.Context, courseID int) ([]*entity.TrainingEnrollment, error) {
+	if _, err := u.GetCourseById(ctx, courseID); err != nil {
+		return nil, err
+	}
+	return u.trainingRepository.GetEnrollments(ctx, entity.EnrollmentFilter{CourseID: courseID})
+}
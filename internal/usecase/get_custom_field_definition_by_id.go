@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *customFieldUsecaseImpl) GetDefinitionById(ctx context.Context, id int) (*entity.CustomFieldDefinition, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidCustomFieldDefinitionId
+	}
+
+	definition, err := u.customFieldRepository.GetDefinitionById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if definition == nil {
+		return nil, appError.ErrCustomFieldDefinitionNotFound
+	}
+	return definition, nil
+}
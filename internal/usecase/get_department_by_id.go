@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *departmentUsecaseImpl) GetDepartmentById(ctx context.Context, id int) (*entity.Department, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidDepartment
+	}
+
+	department, err := u.departmentRepository.GetDepartmentById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if department == nil {
+		return nil, appError.ErrDepartmentNotFound
+	}
+	return department, nil
+}
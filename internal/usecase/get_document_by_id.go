@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *documentUsecaseImpl) GetDocumentById(ctx context.Context, employeeID, id int) (*entity.EmployeeDocument, error) {
+	if employeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	if id <= 0 {
+		return nil, appError.ErrInvalidDocumentId
+	}
+
+	document, err := u.documentRepository.GetDocumentById(ctx, employeeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if document == nil {
+		return nil, appError.ErrDocumentNotFound
+	}
+	return document, nil
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *benefitUsecaseImpl) GetEligiblePlans(ctx context.Context, employeeID int) ([]*entity.BenefitPlan, error) {
+	employee, err := u.getBenefitEmployee(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	localToday, err := localDate(ctx, u.locationRepository, employee.LocationID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	plans, err := u.benefitRepository.GetAllPlans(ctx)
+	if err != nil {
+		return nil, err
+	}
+	eligiblePlans := []*entity.BenefitPlan{}
+	for _, plan := range plans {
+		if plan.Eligibility.IsEligible(employee, localToday) {
+			eligiblePlans = append(eligiblePlans, plan)
+		}
+	}
+	return eligiblePlans, nil
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *emergencyContactUsecaseImpl) GetEmergencyContactById(ctx context.Context,
+	employeeID, id int) (*entity.EmergencyContact, error) {
+
+	if employeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	if id <= 0 {
+		return nil, appError.ErrInvalidEmergencyContactId
+	}
+
+	contact, err := u.emergencyContactRepository.GetEmergencyContactById(ctx, employeeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if contact == nil {
+		return nil, appError.ErrEmergencyContactNotFound
+	}
+	return contact, nil
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *assetUsecaseImpl) GetEmployeeAssets(ctx context.Context, employeeID int, heldOnly bool) ([]*entity.AssetAssignment, error) {
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, employeeID); err != nil {
+		return nil, err
+	}
+	return u.assetRepository.GetAssignments(ctx, entity.AssetAssignmentFilter{
+		EmployeeID: employeeID,
+		OpenOnly:   heldOnly,
+	})
+}
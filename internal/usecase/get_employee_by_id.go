@@ -12,11 +12,11 @@ func (u *employeeUsecaseImpl) GetEmployeeById(ctx context.Context, id int) (*ent
 		return nil, appError.ErrInvalidEmployeeId
 	}
 	employee, err := u.employeeRepository.GetEmployeeById(ctx, id)
-	if employee == nil {
-		return nil, appError.ErrEmployeeNotFound
-	}
 	if err != nil {
 		return nil, err
 	}
+	if employee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
 	return employee, nil
 }
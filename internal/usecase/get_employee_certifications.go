@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *certificationUsecaseImpl) GetEmployeeCertifications(ctx context.Context, employeeID int) ([]*entity.Certification, error) {
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, employeeID); err != nil {
+		return nil, err
+	}
+	return u.certificationRepository.GetCertifications(ctx, entity.CertificationFilter{EmployeeID: employeeID})
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *documentUsecaseImpl) GetEmployeeDocuments(ctx context.Context, employeeID int) ([]*entity.EmployeeDocument, error) {
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, employeeID); err != nil {
+		return nil, err
+	}
+	return u.documentRepository.GetDocuments(ctx, employeeID)
+}
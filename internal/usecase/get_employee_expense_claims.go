@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *expenseUsecaseImpl) GetEmployeeClaims(ctx context.Context, employeeID int,
+	status entity.ExpenseClaimStatus) ([]*entity.ExpenseClaim, error) {
+
+	if status != "" && !status.IsValid() {
+		return nil, appError.ErrInvalidExpenseClaimFilter
+	}
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, employeeID); err != nil {
+		return nil, err
+	}
+	return u.expenseRepository.GetClaims(ctx, entity.ExpenseClaimFilter{EmployeeID: employeeID, Status: status})
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *goalUsecaseImpl) GetEmployeeGoals(ctx context.Context, employeeID int) ([]*entity.Goal, error) {
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, employeeID); err != nil {
+		return nil, err
+	}
+	return u.goalRepository.GetGoals(ctx, entity.GoalFilter{EmployeeIDs: []int{employeeID}})
+}
@@ -0,0 +1,12 @@
+package usecase
+
+import (
+	"context"
+)
+
+func (u *segmentUsecaseImpl) GetEmployeeTags(ctx context.Context, employeeID int) ([]string, error) {
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, employeeID); err != nil {
+		return nil, err
+	}
+	return u.segmentRepository.GetEmployeeTags(ctx, employeeID)
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *employeeUsecaseImpl) GetEmploymentStatusHistory(ctx context.Context,
+	employeeID int) ([]*entity.EmploymentStatusChange, error) {
+
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, employeeID); err != nil {
+		return nil, err
+	}
+	return u.employeeRepository.GetEmploymentStatusChanges(ctx, employeeID)
+}
@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+// This file is not named get_enrollment_windows.go, which Go would only build on Windows.
+
+func (u *benefitUsecaseImpl) GetWindows(ctx context.Context) ([]*entity.BenefitEnrollmentWindow, error) {
+	return u.benefitRepository.GetWindows(ctx)
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *exchangeRateUsecaseImpl) GetExchangeRates(ctx context.Context) ([]*entity.ExchangeRate, error) {
+	return u.exchangeRateRepository.GetExchangeRates(ctx)
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *expenseUsecaseImpl) GetCategoryById(ctx context.Context, id int) (*entity.ExpenseCategory, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidExpenseCategoryId
+	}
+
+	category, err := u.expenseRepository.GetCategoryById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, appError.ErrExpenseCategoryNotFound
+	}
+	return category, nil
+}
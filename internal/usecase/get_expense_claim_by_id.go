@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *expenseUsecaseImpl) GetClaimById(ctx context.Context, employeeID, id int) (*entity.ExpenseClaim, error) {
+	if employeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	if id <= 0 {
+		return nil, appError.ErrInvalidExpenseClaimId
+	}
+
+	claim, err := u.expenseRepository.GetClaimById(ctx, employeeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if claim == nil {
+		return nil, appError.ErrExpenseClaimNotFound
+	}
+	return claim, nil
+}
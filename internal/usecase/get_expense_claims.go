@@ -0,0 +1,18 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *expenseUsecaseImpl) GetClaims(ctx context.Context, filter entity.ExpenseClaimFilter) ([]*entity.ExpenseClaim, error) {
+	if filter.EmployeeID < 0 || filter.DepartmentID < 0 {
+		return nil, appError.ErrInvalidExpenseClaimFilter
+	}
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, appError.ErrInvalidExpenseClaimFilter
+	}
+	return u.expenseRepository.GetClaims(ctx, filter)
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *expenseUsecaseImpl) GetReceipts(ctx context.Context, employeeID, claimID int) ([]*entity.ExpenseReceipt, error) {
+	if _, err := u.GetClaimById(ctx, employeeID, claimID); err != nil {
+		return nil, err
+	}
+	return u.expenseRepository.GetReceipts(ctx, claimID)
+}
+
+func (u *expenseUsecaseImpl) GetReceiptById(ctx context.Context, employeeID, claimID, id int) (*entity.ExpenseReceipt, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidExpenseReceiptId
+	}
+	if _, err := u.GetClaimById(ctx, employeeID, claimID); err != nil {
+		return nil, err
+	}
+
+	receipt, err := u.expenseRepository.GetReceiptById(ctx, claimID, id)
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		return nil, appError.ErrExpenseReceiptNotFound
+	}
+	return receipt, nil
+}
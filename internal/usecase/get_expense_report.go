@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GetReport converts the totals of each month at the rates effective at the end of the month, or
+// today for the current month.
+func (u *expenseUsecaseImpl) GetReport(ctx context.Context, filter entity.ExpenseReportFilter) ([]*entity.ExpenseReportRow, error) {
+	if filter.From.IsZero() || filter.To.IsZero() || filter.DepartmentID < 0 {
+		return nil, appError.ErrInvalidExpenseReport
+	}
+	filter.From = dateOnly(filter.From)
+	filter.To = dateOnly(filter.To)
+	if filter.From.After(filter.To) {
+		return nil, appError.ErrInvalidExpenseReport
+	}
+
+	rows, err := u.expenseRepository.GetReport(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rows come by month and department, so those of one department and month are adjacent.
+	report := []*entity.ExpenseReportRow{}
+	var current *entity.ExpenseReportRow
+	for _, row := range rows {
+		total := row.Total
+		if total.Currency != u.reportingCurrency {
+			asOf := row.Period.AddDate(0, 1, -1)
+			if asOf.After(today()) {
+				asOf = today()
+			}
+			total, _, _, err = u.exchangeRateUsecase.ConvertMoney(ctx, total, u.reportingCurrency, asOf)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if current != nil && current.Period.Equal(row.Period) && sameDepartment(current.DepartmentID, row.DepartmentID) {
+			current.ClaimCount += row.ClaimCount
+			current.Total.Amount += total.Amount
+			continue
+		}
+		row.Total = total
+		current = row
+		report = append(report, current)
+	}
+	return report, nil
+}
+
+func sameDepartment(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *certificationUsecaseImpl) GetExpiringCertifications(ctx context.Context, asOf time.Time, withinDays int) ([]*entity.Certification, error) {
+	if withinDays == 0 {
+		withinDays = u.expiryWindowDays
+	}
+	if withinDays < 1 || withinDays > maxExpiryWindowDays {
+		return nil, appError.ErrInvalidExpiryWindow
+	}
+	return u.getExpiringCertifications(ctx, asOf, withinDays, false)
+}
+
+func (u *certificationUsecaseImpl) getExpiringCertifications(ctx context.Context, asOf time.Time, withinDays int,
+	notNotified bool) ([]*entity.Certification, error) {
+
+	from := dateOnly(asOf)
+	to := from.AddDate(0, 0, withinDays)
+	return u.certificationRepository.GetCertifications(ctx, entity.CertificationFilter{
+		ExpiresFrom: &from,
+		ExpiresTo:   &to,
+		NotNotified: notNotified,
+		Statuses:    certificationHolderStatuses,
+	})
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *goalUsecaseImpl) GetGoalById(ctx context.Context, id int) (*entity.Goal, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidGoalId
+	}
+
+	goal, err := u.goalRepository.GetGoalById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if goal == nil {
+		return nil, appError.ErrGoalNotFound
+	}
+	return goal, nil
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *goalUsecaseImpl) GetCheckIns(ctx context.Context, goalID int) ([]*entity.GoalCheckIn, error) {
+	if _, err := u.GetGoalById(ctx, goalID); err != nil {
+		return nil, err
+	}
+	return u.goalRepository.GetCheckIns(ctx, goalID)
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *holidayCalendarUsecaseImpl) GetHolidayCalendarById(ctx context.Context, id int) (*entity.HolidayCalendar, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidHolidayCalendarId
+	}
+
+	calendar, err := u.holidayCalendarRepository.GetHolidayCalendarById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if calendar == nil {
+		return nil, appError.ErrHolidayCalendarNotFound
+	}
+	return calendar, nil
+}
@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *holidayCalendarUsecaseImpl) GetHolidays(ctx context.Context, calendarID, year int) ([]*entity.Holiday, error) {
+	if year < 1900 || year > 9999 {
+		return nil, appError.ErrInvalidYear
+	}
+	if _, err := u.GetHolidayCalendarById(ctx, calendarID); err != nil {
+		return nil, err
+	}
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+	return u.holidayCalendarRepository.GetHolidays(ctx, calendarID, start, end)
+}
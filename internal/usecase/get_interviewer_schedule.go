@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *recruitingUsecaseImpl) GetInterviewerSchedule(ctx context.Context, interviewerID int) ([]*entity.Interview, error) {
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, interviewerID); err != nil {
+		return nil, err
+	}
+	return u.recruitingRepository.GetInterviewerSchedule(ctx, interviewerID, time.Now().UTC())
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *leaveUsecaseImpl) GetLeaveBalances(ctx context.Context, employeeID, year int) ([]*entity.LeaveBalance, error) {
+	if year < 1900 || year > 9999 {
+		return nil, appError.ErrInvalidYear
+	}
+	employee, err := u.getEmployeeForLeave(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	leaveTypes, err := u.leaveRepository.GetAllLeaveTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	asOf := today()
+	balances := []*entity.LeaveBalance{}
+	for _, leaveType := range leaveTypes {
+		if !leaveType.TracksBalance() {
+			continue
+		}
+		balance, err := u.refreshLeaveBalance(ctx, employee, leaveType, year, asOf)
+		if err != nil {
+			return nil, err
+		}
+		balances = append(balances, balance)
+	}
+	return balances, nil
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *leaveUsecaseImpl) GetLeaveRequestById(ctx context.Context, employeeID, id int) (*entity.LeaveRequest, error) {
+	if employeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	if id <= 0 {
+		return nil, appError.ErrInvalidLeaveRequestId
+	}
+
+	request, err := u.leaveRepository.GetLeaveRequestById(ctx, employeeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, appError.ErrLeaveRequestNotFound
+	}
+	return request, nil
+}
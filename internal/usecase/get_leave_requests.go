@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *leaveUsecaseImpl) GetLeaveRequests(ctx context.Context, employeeID int) ([]*entity.LeaveRequest, error) {
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, employeeID); err != nil {
+		return nil, err
+	}
+	return u.leaveRepository.GetLeaveRequestsByEmployeeId(ctx, employeeID)
+}
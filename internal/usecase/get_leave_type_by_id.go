@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *leaveUsecaseImpl) GetLeaveTypeById(ctx context.Context, id int) (*entity.LeaveType, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidLeaveTypeId
+	}
+
+	leaveType, err := u.leaveRepository.GetLeaveTypeById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if leaveType == nil {
+		return nil, appError.ErrLeaveTypeNotFound
+	}
+	return leaveType, nil
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *locationUsecaseImpl) GetLocationById(ctx context.Context, id int) (*entity.Location, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidLocation
+	}
+
+	location, err := u.locationRepository.GetLocationById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if location == nil {
+		return nil, appError.ErrLocationNotFound
+	}
+	return location, nil
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *locationUsecaseImpl) GetLocationHistory(ctx context.Context, employeeID int) ([]*entity.LocationAssignment, error) {
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, employeeID); err != nil {
+		return nil, err
+	}
+	return u.locationRepository.GetAssignmentsByEmployeeId(ctx, employeeID)
+}
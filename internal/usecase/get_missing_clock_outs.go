@@ -0,0 +1,12 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *attendanceUsecaseImpl) GetMissingClockOuts(ctx context.Context) ([]*entity.AttendanceEntry, error) {
+	return u.attendanceRepository.GetOpenAttendanceEntriesBefore(ctx, time.Now().UTC().Add(-u.maxShift))
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *positionUsecaseImpl) GetOutOfBandEmployees(ctx context.Context) ([]*OutOfBandEmployee, error) {
+	positions, err := u.positionRepository.GetAllPositions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	positionsByID := make(map[int]*entity.Position, len(positions))
+	for _, position := range positions {
+		positionsByID[position.ID] = position
+	}
+
+	employees, err := u.employeeRepository.GetAllEmployees(ctx, entity.EmployeeFilter{
+		Statuses: []entity.EmploymentStatus{
+			entity.EmploymentStatusCandidate,
+			entity.EmploymentStatusActive,
+			entity.EmploymentStatusOnLeave,
+			entity.EmploymentStatusSuspended,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	asOf := today()
+	outOfBand := []*OutOfBandEmployee{}
+	for _, employee := range employees {
+		position, ok := positionsByID[employee.PositionID]
+		if !ok {
+			continue
+		}
+		check, err := u.salaryBands.check(ctx, employee.Salary, employee.PayPeriod, position, asOf)
+		if err != nil {
+			return nil, err
+		}
+		if check == nil || check.InBand() {
+			continue
+		}
+		outOfBand = append(outOfBand, &OutOfBandEmployee{
+			Employee: employee,
+			Position: position,
+			Check:    *check,
+		})
+	}
+	return outOfBand, nil
+}
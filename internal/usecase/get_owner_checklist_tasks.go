@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *checklistUsecaseImpl) GetOwnerTasks(ctx context.Context, ownerID int) ([]*entity.ChecklistTask, error) {
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, ownerID); err != nil {
+		return nil, err
+	}
+	return u.checklistRepository.GetOwnerTasks(ctx, ownerID)
+}
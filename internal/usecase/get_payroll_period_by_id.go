@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *payrollUsecaseImpl) GetPayrollPeriodById(ctx context.Context, id int) (*entity.PayrollPeriod, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidPayrollPeriodId
+	}
+
+	period, err := u.payrollRepository.GetPayrollPeriodById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if period == nil {
+		return nil, appError.ErrPayrollPeriodNotFound
+	}
+	return period, nil
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *payrollUsecaseImpl) GetPayrollRuleById(ctx context.Context, id int) (*entity.PayrollRule, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidPayrollRuleId
+	}
+
+	rule, err := u.payrollRepository.GetPayrollRuleById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, appError.ErrPayrollRuleNotFound
+	}
+	return rule, nil
+}
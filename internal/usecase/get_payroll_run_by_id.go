@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *payrollUsecaseImpl) GetPayrollRunById(ctx context.Context, id int) (*entity.PayrollRun, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidPayrollRunId
+	}
+
+	run, err := u.payrollRepository.GetPayrollRunById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil {
+		return nil, appError.ErrPayrollRunNotFound
+	}
+	return run, nil
+}
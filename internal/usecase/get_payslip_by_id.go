@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *payrollUsecaseImpl) GetPayslipById(ctx context.Context, runID, id int) (*entity.Payslip, error) {
+	if runID <= 0 {
+		return nil, appError.ErrInvalidPayrollRunId
+	}
+	if id <= 0 {
+		return nil, appError.ErrInvalidPayslipId
+	}
+
+	payslip, err := u.payrollRepository.GetPayslipById(ctx, runID, id)
+	if err != nil {
+		return nil, err
+	}
+	if payslip == nil {
+		return nil, appError.ErrPayslipNotFound
+	}
+	return payslip, nil
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *payrollUsecaseImpl) GetPayslips(ctx context.Context, runID int) ([]*entity.Payslip, error) {
+	if _, err := u.GetPayrollRunById(ctx, runID); err != nil {
+		return nil, err
+	}
+	return u.payrollRepository.GetPayslips(ctx, runID)
+}
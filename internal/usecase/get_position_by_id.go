@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *positionUsecaseImpl) GetPositionById(ctx context.Context, id int) (*entity.Position, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidPosition
+	}
+
+	position, err := u.positionRepository.GetPositionById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if position == nil {
+		return nil, appError.ErrPositionNotFound
+	}
+	return position, nil
+}
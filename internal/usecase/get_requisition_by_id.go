@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *recruitingUsecaseImpl) GetRequisitionById(ctx context.Context, id int) (*entity.JobRequisition, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidRequisitionId
+	}
+	requisition, err := u.recruitingRepository.GetRequisitionById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if requisition == nil {
+		return nil, appError.ErrRequisitionNotFound
+	}
+	return requisition, nil
+}
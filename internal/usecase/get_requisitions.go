@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *recruitingUsecaseImpl) GetRequisitions(ctx context.Context,
+	status entity.RequisitionStatus) ([]*entity.JobRequisition, error) {
+
+	if status != "" && !status.IsValid() {
+		return nil, appError.ErrInvalidRequisitionStatus
+	}
+	return u.recruitingRepository.GetRequisitions(ctx, status)
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *reviewUsecaseImpl) GetReviewById(ctx context.Context, id int) (*entity.Review, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidReviewId
+	}
+
+	review, err := u.reviewRepository.GetReviewById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if review == nil {
+		return nil, appError.ErrReviewNotFound
+	}
+	return review, nil
+}
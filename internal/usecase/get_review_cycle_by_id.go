@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *reviewUsecaseImpl) GetReviewCycleById(ctx context.Context, id int) (*entity.ReviewCycle, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidReviewCycleId
+	}
+
+	cycle, err := u.reviewRepository.GetReviewCycleById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if cycle == nil {
+		return nil, appError.ErrReviewCycleNotFound
+	}
+	return cycle, nil
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *reviewUsecaseImpl) GetReviews(ctx context.Context, cycleID int) ([]*entity.Review, error) {
+	if _, err := u.GetReviewCycleById(ctx, cycleID); err != nil {
+		return nil, err
+	}
+	return u.reviewRepository.GetReviews(ctx, cycleID)
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *segmentUsecaseImpl) GetSegmentById(ctx context.Context, id int) (*entity.EmployeeSegment, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidSegmentId
+	}
+
+	segment, err := u.segmentRepository.GetSegmentById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if segment == nil {
+		return nil, appError.ErrSegmentNotFound
+	}
+	return segment, nil
+}
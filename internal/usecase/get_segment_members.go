@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *segmentUsecaseImpl) GetSegmentMembers(ctx context.Context, id int) ([]*entity.Employee, error) {
+	segment, err := u.GetSegmentById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Custom field filters are parsed against the definitions of today, which may have changed
+	// since the segment was saved.
+	filter := segment.Filter
+	if len(filter.CustomFields) > 0 {
+		definitions, err := u.customFieldRepository.GetAllDefinitions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if filter.CustomFields, err = parseCustomFieldFilter(definitions, filter.CustomFields); err != nil {
+			return nil, err
+		}
+	}
+
+	// Read past the cache, which holds no more than the first employees of the unfiltered list.
+	return u.employeeRepository.GetAllEmployees(ctx, filter)
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *shiftUsecaseImpl) GetShiftTemplateById(ctx context.Context, id int) (*entity.ShiftTemplate, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidShiftTemplateId
+	}
+
+	template, err := u.shiftRepository.GetShiftTemplateById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		return nil, appError.ErrShiftTemplateNotFound
+	}
+	return template, nil
+}
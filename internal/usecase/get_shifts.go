@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *shiftUsecaseImpl) GetShifts(ctx context.Context, employeeID int, from, to time.Time) ([]*entity.Shift, error) {
+	if from.IsZero() || to.IsZero() || dateOnly(to).Before(dateOnly(from)) {
+		return nil, appError.ErrInvalidDateRange
+	}
+	// The dates are days at the employee's location.
+	zone, err := u.employeeZone(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := midnightIn(from, zone), midnightIn(dateOnly(to).AddDate(0, 0, 1), zone)
+	shifts, err := u.shiftRepository.GetShifts(ctx, employeeID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	// The repository matches on overlap; keep the shifts starting within the dates.
+	startingShifts := []*entity.Shift{}
+	for _, shift := range shifts {
+		if !shift.StartsAt.Before(start) {
+			startingShifts = append(startingShifts, shift)
+		}
+	}
+	return startingShifts, nil
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *skillUsecaseImpl) GetSkillById(ctx context.Context, id int) (*entity.Skill, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidSkillId
+	}
+
+	skill, err := u.skillRepository.GetSkillById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if skill == nil {
+		return nil, appError.ErrSkillNotFound
+	}
+	return skill, nil
+}
@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+	"time"
+)
+
+func (u *attendanceUsecaseImpl) GetTimesheet(ctx context.Context, employeeID int, week time.Time) (*WeeklyTimesheet, error) {
+	employee, err := u.getEmployeeForAttendance(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	zone, err := u.employeeZone(ctx, employee)
+	if err != nil {
+		return nil, err
+	}
+	weekStart, err := parseTimesheetWeek(week, dateIn(time.Now(), zone))
+	if err != nil {
+		return nil, err
+	}
+	return u.buildWeeklyTimesheet(ctx, employee, zone, weekStart)
+}
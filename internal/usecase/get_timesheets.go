@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *attendanceUsecaseImpl) GetTimesheets(ctx context.Context, employeeID int) ([]*entity.Timesheet, error) {
+	if _, err := u.getEmployeeForAttendance(ctx, employeeID); err != nil {
+		return nil, err
+	}
+	return u.attendanceRepository.GetTimesheets(ctx, employeeID)
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *trainingUsecaseImpl) GetCourseById(ctx context.Context, id int) (*entity.TrainingCourse, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidTrainingCourseId
+	}
+
+	course, err := u.trainingRepository.GetCourseById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if course == nil {
+		return nil, appError.ErrTrainingCourseNotFound
+	}
+	return course, nil
+}
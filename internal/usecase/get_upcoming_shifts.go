@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"context"
+	"time"
+)
+
+func (u *shiftUsecaseImpl) GetUpcomingShifts(ctx context.Context, employeeID int) (*ShiftFeed, error) {
+	zone, err := u.employeeZone(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	shifts, err := u.shiftRepository.GetShifts(ctx, employeeID, now, now.Add(u.feedHorizon))
+	if err != nil {
+		return nil, err
+	}
+	return &ShiftFeed{Shifts: shifts, TimeZone: zone}, nil
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"math"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// GoalRollup is the goal progress of an employee and of the team reporting to them. Cancelled
+// goals are left out.
+type GoalRollup struct {
+	EmployeeID   int
+	EmployeeName string
+	Goals        int      // the employee's own goals
+	Progress     *float64 // average progress of the employee's own goals, nil without any
+	TeamGoals    int      // goals of the employee and everyone reporting to them
+	TeamProgress *float64 // average progress of TeamGoals, nil without any
+	Reports      []*GoalRollup
+
+	teamTotal float64
+}
+
+func (u *goalUsecaseImpl) GetGoalRollup(ctx context.Context, employeeID int) (*GoalRollup, error) {
+	if employeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	employee, err := u.employeeRepository.GetEmployeeById(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+	reports, err := u.employeeRepository.GetReports(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int{employee.ID}
+	reportsByManager := map[int][]*entity.Employee{}
+	for _, report := range reports {
+		ids = append(ids, report.ID)
+		reportsByManager[*report.ManagerID] = append(reportsByManager[*report.ManagerID], report)
+	}
+	goals, err := u.goalRepository.GetGoals(ctx, entity.GoalFilter{EmployeeIDs: ids})
+	if err != nil {
+		return nil, err
+	}
+	goalsByEmployee := map[int][]*entity.Goal{}
+	for _, goal := range goals {
+		if goal.Status != entity.GoalStatusCancelled {
+			goalsByEmployee[*goal.EmployeeID] = append(goalsByEmployee[*goal.EmployeeID], goal)
+		}
+	}
+
+	return rollUpGoals(employee, reportsByManager, goalsByEmployee, map[int]bool{}), nil
+}
+
+// rollUpGoals builds the roll-up of employee from the roll-ups of their reports. visited guards
+// against a loop in the management hierarchy.
+func rollUpGoals(employee *entity.Employee, reportsByManager map[int][]*entity.Employee,
+	goalsByEmployee map[int][]*entity.Goal, visited map[int]bool) *GoalRollup {
+
+	visited[employee.ID] = true
+	rollup := &GoalRollup{
+		EmployeeID:   employee.ID,
+		EmployeeName: employee.Name,
+		Reports:      []*GoalRollup{},
+	}
+
+	total := 0.0
+	for _, goal := range goalsByEmployee[employee.ID] {
+		total += goal.Progress()
+	}
+	rollup.Goals = len(goalsByEmployee[employee.ID])
+	rollup.Progress = averageProgress(total, rollup.Goals)
+	rollup.TeamGoals, rollup.teamTotal = rollup.Goals, total
+
+	for _, report := range reportsByManager[employee.ID] {
+		if visited[report.ID] {
+			continue
+		}
+		reportRollup := rollUpGoals(report, reportsByManager, goalsByEmployee, visited)
+		rollup.TeamGoals += reportRollup.TeamGoals
+		rollup.teamTotal += reportRollup.teamTotal
+		rollup.Reports = append(rollup.Reports, reportRollup)
+	}
+	rollup.TeamProgress = averageProgress(rollup.teamTotal, rollup.TeamGoals)
+	return rollup
+}
+
+func averageProgress(total float64, count int) *float64 {
+	if count == 0 {
+		return nil
+	}
+	average := math.Round(total/float64(count)*100) / 100
+	return &average
+}
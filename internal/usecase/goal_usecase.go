@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type GoalUsecase interface {
+	// CreateGoal adds a goal with its key results, owned by an employee or, without one, by the company.
+	CreateGoal(ctx context.Context, goal *entity.Goal) (*entity.Goal, error)
+	GetGoalById(ctx context.Context, id int) (*entity.Goal, error)
+	GetCompanyGoals(ctx context.Context) ([]*entity.Goal, error)
+	// GetAlignedGoals returns the goals aligned directly to a goal.
+	GetAlignedGoals(ctx context.Context, goalID int) ([]*entity.Goal, error)
+	GetEmployeeGoals(ctx context.Context, employeeID int) ([]*entity.Goal, error)
+	// UpdateGoal changes a goal's details, alignment and status. Its owner cannot change.
+	UpdateGoal(ctx context.Context, goal *entity.Goal) (*entity.Goal, error)
+	DeleteGoal(ctx context.Context, id int) error
+
+	AddKeyResult(ctx context.Context, keyResult *entity.KeyResult) (*entity.Goal, error)
+	// CreateCheckIn records progress on one of an active goal's key results.
+	CreateCheckIn(ctx context.Context, checkIn *entity.GoalCheckIn) (*entity.GoalCheckIn, error)
+	GetCheckIns(ctx context.Context, goalID int) ([]*entity.GoalCheckIn, error)
+
+	// GetGoalRollup returns the goal progress of an employee and everyone reporting to them,
+	// directly or indirectly, following the management hierarchy.
+	GetGoalRollup(ctx context.Context, employeeID int) (*GoalRollup, error)
+}
+
+type goalUsecaseImpl struct {
+	goalRepository     repository.GoalRepository
+	employeeRepository repository.EmployeeRepository
+}
+
+func NewGoalUsecase(goalRepository repository.GoalRepository,
+	employeeRepository repository.EmployeeRepository) GoalUsecase {
+	return &goalUsecaseImpl{
+		goalRepository:     goalRepository,
+		employeeRepository: employeeRepository,
+	}
+}
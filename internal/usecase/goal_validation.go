@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const maxKeyResults = 10
+
+// validateGoal checks a goal's title, dates and status. New goals also need their key results.
+func validateGoal(goal *entity.Goal, isNew bool) error {
+	goal.Title = strings.TrimSpace(goal.Title)
+	goal.Description = strings.TrimSpace(goal.Description)
+	if len(goal.Title) < 3 {
+		return appError.ErrInvalidGoal
+	}
+	goal.StartDate, goal.DueDate = dateOnly(goal.StartDate), dateOnly(goal.DueDate)
+	if goal.DueDate.Before(goal.StartDate) {
+		return appError.ErrInvalidGoal
+	}
+	if goal.Status == "" {
+		goal.Status = entity.GoalStatusActive
+	}
+	if !goal.Status.IsValid() {
+		return appError.ErrInvalidGoal
+	}
+
+	if !isNew {
+		return nil
+	}
+	if len(goal.KeyResults) == 0 || len(goal.KeyResults) > maxKeyResults {
+		return appError.ErrInvalidGoal
+	}
+	for i := range goal.KeyResults {
+		if err := validateKeyResult(&goal.KeyResults[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateKeyResult(keyResult *entity.KeyResult) error {
+	keyResult.Title = strings.TrimSpace(keyResult.Title)
+	keyResult.Unit = strings.TrimSpace(keyResult.Unit)
+	if len(keyResult.Title) < 3 || keyResult.TargetValue == keyResult.StartValue {
+		return appError.ErrInvalidKeyResult
+	}
+	return nil
+}
+
+// validateGoalParent checks that a goal is aligned to a company goal or, for an employee's goal,
+// to a goal of their manager, and that the alignment does not loop back to the goal itself.
+func (u *goalUsecaseImpl) validateGoalParent(ctx context.Context, goal *entity.Goal, owner *entity.Employee) error {
+	if goal.ParentID == nil {
+		return nil
+	}
+	if *goal.ParentID <= 0 || *goal.ParentID == goal.ID {
+		return appError.ErrInvalidGoalParent
+	}
+	parent, err := u.goalRepository.GetGoalById(ctx, *goal.ParentID)
+	if err != nil {
+		return err
+	}
+	if parent == nil {
+		return appError.ErrInvalidGoalParent
+	}
+	if parent.EmployeeID != nil {
+		if owner == nil || owner.ManagerID == nil || *owner.ManagerID != *parent.EmployeeID {
+			return appError.ErrInvalidGoalParent
+		}
+	}
+
+	// Existing goals may already have goals aligned to them; walk up from the new parent to make
+	// sure the goal is not one of its ancestors.
+	if goal.ID == 0 {
+		return nil
+	}
+	visited := map[int]bool{parent.ID: true}
+	for ancestor := parent; ancestor.ParentID != nil; {
+		if *ancestor.ParentID == goal.ID {
+			return appError.ErrInvalidGoalParent
+		}
+		if visited[*ancestor.ParentID] {
+			break
+		}
+		visited[*ancestor.ParentID] = true
+		ancestor, err = u.goalRepository.GetGoalById(ctx, *ancestor.ParentID)
+		if err != nil {
+			return err
+		}
+		if ancestor == nil {
+			break
+		}
+	}
+	return nil
+}
+
+// getGoalOwner returns the employee owning goal, or nil for a company goal.
+func (u *goalUsecaseImpl) getGoalOwner(ctx context.Context, goal *entity.Goal) (*entity.Employee, error) {
+	if goal.EmployeeID == nil {
+		return nil, nil
+	}
+	if *goal.EmployeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	owner, err := u.employeeRepository.GetEmployeeById(ctx, *goal.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	if owner == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+	return owner, nil
+}
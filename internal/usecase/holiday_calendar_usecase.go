@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"io"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type HolidayCalendarUsecase interface {
+	CreateHolidayCalendar(ctx context.Context, calendar *entity.HolidayCalendar) (*entity.HolidayCalendar, error)
+	GetHolidayCalendarById(ctx context.Context, id int) (*entity.HolidayCalendar, error)
+	GetAllHolidayCalendars(ctx context.Context) ([]*entity.HolidayCalendar, error)
+	UpdateHolidayCalendar(ctx context.Context, calendar *entity.HolidayCalendar) (*entity.HolidayCalendar, error)
+	DeleteHolidayCalendar(ctx context.Context, id int) error
+
+	CreateHoliday(ctx context.Context, holiday *entity.Holiday) (*entity.Holiday, error)
+	GetHolidays(ctx context.Context, calendarID, year int) ([]*entity.Holiday, error)
+	DeleteHoliday(ctx context.Context, calendarID, id int) error
+
+	// ImportHolidays adds the events of an iCalendar file to a calendar, one holiday per day
+	// covered. Yearly recurring events are expanded up to the end of next year. Dates already in
+	// the calendar are renamed rather than duplicated. It returns the number of holidays written.
+	ImportHolidays(ctx context.Context, calendarID int, ics io.Reader) (int, error)
+}
+
+type holidayCalendarUsecaseImpl struct {
+	holidayCalendarRepository repository.HolidayCalendarRepository
+}
+
+func NewHolidayCalendarUsecase(holidayCalendarRepository repository.HolidayCalendarRepository) HolidayCalendarUsecase {
+	return &holidayCalendarUsecaseImpl{holidayCalendarRepository: holidayCalendarRepository}
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/validation"
+)
+
+func validateHolidayCalendar(calendar *entity.HolidayCalendar) error {
+	calendar.Name = strings.TrimSpace(calendar.Name)
+	calendar.Country = strings.ToUpper(strings.TrimSpace(calendar.Country))
+	if len(calendar.Name) < 3 {
+		return appError.ErrInvalidHolidayCalendar
+	}
+	if calendar.Country != "" && !validation.IsValidCountryCode(calendar.Country) {
+		return appError.ErrInvalidHolidayCalendar
+	}
+	return nil
+}
+
+func validateHoliday(holiday *entity.Holiday) error {
+	holiday.Name = strings.TrimSpace(holiday.Name)
+	if holiday.Date.IsZero() || holiday.Name == "" {
+		return appError.ErrInvalidHoliday
+	}
+	holiday.Date = dateOnly(holiday.Date)
+	return nil
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/ical"
+)
+
+func (u *holidayCalendarUsecaseImpl) ImportHolidays(ctx context.Context, calendarID int, ics io.Reader) (int, error) {
+	if _, err := u.GetHolidayCalendarById(ctx, calendarID); err != nil {
+		return 0, err
+	}
+
+	events, err := ical.Parse(ics)
+	if err != nil {
+		return 0, appError.ErrInvalidICalendar
+	}
+
+	horizon := time.Date(today().Year()+1, time.December, 31, 0, 0, 0, 0, time.UTC)
+	holidays := []*entity.Holiday{}
+	seen := map[time.Time]bool{}
+	for _, event := range events {
+		dates, err := event.Dates(horizon)
+		if err != nil {
+			return 0, appError.ErrInvalidICalendar
+		}
+		name := strings.TrimSpace(event.Summary)
+		if name == "" {
+			name = "Holiday"
+		}
+		// Feeds sometimes list two observances on one day; the first one names the holiday.
+		for _, date := range dates {
+			if seen[date] {
+				continue
+			}
+			seen[date] = true
+			holidays = append(holidays, &entity.Holiday{CalendarID: calendarID, Date: date, Name: name})
+		}
+	}
+	if len(holidays) == 0 {
+		return 0, nil
+	}
+	return u.holidayCalendarRepository.UpsertHolidays(ctx, calendarID, holidays)
+}
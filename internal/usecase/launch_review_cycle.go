@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// reviewStatuses are the employment statuses of employees reviewed when a cycle is launched.
+var reviewStatuses = []entity.EmploymentStatus{
+	entity.EmploymentStatusActive,
+	entity.EmploymentStatusOnLeave,
+}
+
+func (u *reviewUsecaseImpl) LaunchReviewCycle(ctx context.Context, id int) (*entity.ReviewCycle, error) {
+	cycle, err := u.GetReviewCycleById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if cycle.Status != entity.ReviewCycleStatusDraft {
+		return nil, appError.ErrReviewCycleNotDraft
+	}
+
+	employees, err := u.employeeRepository.GetAllEmployees(ctx, entity.EmployeeFilter{Statuses: reviewStatuses})
+	if err != nil {
+		return nil, err
+	}
+	reviews := []*entity.Review{}
+	for _, employee := range employees {
+		// Employees hired after the period have nothing to be reviewed on yet.
+		if dateOnly(employee.HiredDate).After(cycle.PeriodEnd) {
+			continue
+		}
+		review := &entity.Review{
+			ReviewCycleID: cycle.ID,
+			EmployeeID:    employee.ID,
+			ManagerID:     employee.ManagerID,
+			Status:        entity.ReviewStatusInProgress,
+			Submissions: []entity.ReviewSubmission{
+				{ReviewerID: employee.ID, Role: entity.ReviewerRoleSelf},
+			},
+		}
+		if employee.ManagerID != nil {
+			review.Submissions = append(review.Submissions,
+				entity.ReviewSubmission{ReviewerID: *employee.ManagerID, Role: entity.ReviewerRoleManager})
+		}
+		reviews = append(reviews, review)
+	}
+
+	launchedCycle, err := u.reviewRepository.LaunchReviewCycle(ctx, id, reviews)
+	if err != nil {
+		return nil, err
+	}
+	if launchedCycle == nil {
+		// The cycle was launched concurrently.
+		return nil, appError.ErrReviewCycleNotDraft
+	}
+	return launchedCycle, nil
+}
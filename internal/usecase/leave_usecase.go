@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type LeaveUsecase interface {
+	CreateLeaveType(ctx context.Context, leaveType *entity.LeaveType) (*entity.LeaveType, error)
+	GetLeaveTypeById(ctx context.Context, id int) (*entity.LeaveType, error)
+	GetAllLeaveTypes(ctx context.Context) ([]*entity.LeaveType, error)
+	UpdateLeaveType(ctx context.Context, leaveType *entity.LeaveType) (*entity.LeaveType, error)
+	DeleteLeaveType(ctx context.Context, id int) error
+
+	// GetLeaveBalances brings the employee's balances for year up to date with the accrual
+	// policies and returns them, one per leave type that tracks a balance.
+	GetLeaveBalances(ctx context.Context, employeeID, year int) ([]*entity.LeaveBalance, error)
+
+	CreateLeaveRequest(ctx context.Context, request *entity.LeaveRequest) (*entity.LeaveRequest, error)
+	GetLeaveRequests(ctx context.Context, employeeID int) ([]*entity.LeaveRequest, error)
+	GetLeaveRequestById(ctx context.Context, employeeID, id int) (*entity.LeaveRequest, error)
+	ApproveLeaveRequest(ctx context.Context, employeeID, id, approverID int, comment string) (*entity.LeaveRequest, error)
+	RejectLeaveRequest(ctx context.Context, employeeID, id, approverID int, comment string) (*entity.LeaveRequest, error)
+	CancelLeaveRequest(ctx context.Context, employeeID, id int) (*entity.LeaveRequest, error)
+	// ApplyApproval approves the leave of an approved leave approval request, or hands it back to
+	// the employee's manager when it cannot be approved.
+	ApplyApproval(ctx context.Context, request *entity.ApprovalRequest) error
+
+	// ApplyLeaveStatusChanges puts employees on leave while an approved request covers asOf and
+	// makes them active again once it has ended or been cancelled. It returns the number of
+	// status changes made.
+	ApplyLeaveStatusChanges(ctx context.Context, asOf time.Time) (int, error)
+}
+
+type leaveUsecaseImpl struct {
+	leaveRepository    repository.LeaveRepository
+	employeeRepository repository.EmployeeRepository
+	employeeUsecase    EmployeeUsecase
+	workingDays        WorkingDayCalculator
+	approvals          ApprovalUsecase
+}
+
+func NewLeaveUsecase(leaveRepository repository.LeaveRepository, employeeRepository repository.EmployeeRepository,
+	employeeUsecase EmployeeUsecase, workingDays WorkingDayCalculator, approvals ApprovalUsecase) LeaveUsecase {
+	return &leaveUsecaseImpl{
+		leaveRepository:    leaveRepository,
+		employeeRepository: employeeRepository,
+		employeeUsecase:    employeeUsecase,
+		workingDays:        workingDays,
+		approvals:          approvals,
+	}
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func validateLeaveType(leaveType *entity.LeaveType) error {
+	leaveType.Name = strings.TrimSpace(leaveType.Name)
+	if len(leaveType.Name) < 2 || !leaveType.AccrualPolicy.IsValid() {
+		return appError.ErrInvalidLeaveType
+	}
+	if leaveType.DaysPerYear < 0 || leaveType.MaxCarryOverDays < 0 {
+		return appError.ErrInvalidLeaveType
+	}
+	// Without a balance there is nothing to accrue or carry over.
+	if !leaveType.TracksBalance() {
+		leaveType.DaysPerYear = 0
+		leaveType.MaxCarryOverDays = 0
+	}
+	return nil
+}
+
+// accruedLeaveDays returns the days of leaveType an employee hired on hiredDate has accrued in
+// year by asOf, rounded to hundredths of a day.
+func accruedLeaveDays(leaveType *entity.LeaveType, hiredDate time.Time, year int, asOf time.Time) float64 {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+	if hired := dateOnly(hiredDate); hired.After(start) {
+		start = hired
+	}
+	asOf = dateOnly(asOf)
+	if !start.Before(end) || asOf.Before(start) {
+		return 0
+	}
+
+	var months int
+	switch leaveType.AccrualPolicy {
+	case entity.LeaveAccrualAnnual:
+		// The whole allowance is granted upfront, prorated by the months left in the hiring year.
+		months = 12 - int(start.Month()) + 1
+	case entity.LeaveAccrualMonthly:
+		if asOf.Before(end) {
+			end = asOf
+		}
+		months = (end.Year()-start.Year())*12 + int(end.Month()) - int(start.Month())
+		if end.Day() < start.Day() {
+			months--
+		}
+		months = max(0, min(months, 12))
+	default:
+		return 0
+	}
+	return math.Round(leaveType.DaysPerYear*float64(months)/12*100) / 100
+}
+
+// refreshLeaveBalance recomputes the accrued and carried over days of the employee's balance
+// for leaveType and year, creating the balance when needed.
+func (u *leaveUsecaseImpl) refreshLeaveBalance(ctx context.Context, employee *entity.Employee,
+	leaveType *entity.LeaveType, year int, asOf time.Time) (*entity.LeaveBalance, error) {
+
+	balance := &entity.LeaveBalance{
+		EmployeeID:  employee.ID,
+		LeaveTypeID: leaveType.ID,
+		Year:        year,
+		AccruedDays: accruedLeaveDays(leaveType, employee.HiredDate, year, asOf),
+	}
+
+	if leaveType.MaxCarryOverDays > 0 {
+		previous, err := u.leaveRepository.GetLeaveBalance(ctx, employee.ID, leaveType.ID, year-1)
+		if err != nil {
+			return nil, err
+		}
+		remaining := accruedLeaveDays(leaveType, employee.HiredDate, year-1, asOf)
+		if previous != nil {
+			remaining += previous.CarriedOverDays - previous.UsedDays
+		}
+		balance.CarriedOverDays = math.Max(0, math.Min(remaining, leaveType.MaxCarryOverDays))
+	}
+
+	return u.leaveRepository.UpsertLeaveBalance(ctx, balance)
+}
+
+// getEmployeeForLeave loads an employee that leave actions apply to.
+func (u *leaveUsecaseImpl) getEmployeeForLeave(ctx context.Context, employeeID int) (*entity.Employee, error) {
+	if employeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	employee, err := u.employeeRepository.GetEmployeeById(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+	return employee, nil
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	domaincache "github.com/mohamedfawas/employee_management_system/internal/domain/cache"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type LocationUsecase interface {
+	CreateLocation(ctx context.Context, location *entity.Location) (*entity.Location, error)
+	GetLocationById(ctx context.Context, id int) (*entity.Location, error)
+	GetAllLocations(ctx context.Context) ([]*entity.Location, error)
+	UpdateLocation(ctx context.Context, location *entity.Location) (*entity.Location, error)
+	DeleteLocation(ctx context.Context, id int) error
+
+	// AssignEmployeeLocation moves an employee to a new primary location. Assignments effective
+	// today or earlier at the new location apply right away; later ones are scheduled.
+	AssignEmployeeLocation(ctx context.Context, assignment *entity.LocationAssignment) (*entity.LocationAssignment, error)
+	GetLocationHistory(ctx context.Context, employeeID int) ([]*entity.LocationAssignment, error)
+	CancelLocationAssignment(ctx context.Context, employeeID, id int) error
+	// ApplyDueLocationAssignments applies the scheduled assignments that are effective at their
+	// location as of asOf and returns how many were applied.
+	ApplyDueLocationAssignments(ctx context.Context, asOf time.Time) (int, error)
+}
+
+type locationUsecaseImpl struct {
+	locationRepository repository.LocationRepository
+	employeeRepository repository.EmployeeRepository
+	cache              domaincache.Cache
+}
+
+func NewLocationUsecase(locationRepository repository.LocationRepository, employeeRepository repository.EmployeeRepository,
+	cache domaincache.Cache) LocationUsecase {
+	return &locationUsecaseImpl{
+		locationRepository: locationRepository,
+		employeeRepository: employeeRepository,
+		cache:              cache,
+	}
+}
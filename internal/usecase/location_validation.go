@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/validation"
+)
+
+// validateLocation checks a location. Whether its holiday calendar exists is left to the
+// foreign key. The time zone defaults to UTC.
+func validateLocation(location *entity.Location) error {
+	location.Name = strings.TrimSpace(location.Name)
+	if len(location.Name) < 3 {
+		return appError.ErrInvalidLocationName
+	}
+	if location.HolidayCalendarID != nil && *location.HolidayCalendarID <= 0 {
+		return appError.ErrInvalidHolidayCalendarId
+	}
+
+	address := &location.Address
+	address.Line1 = strings.TrimSpace(address.Line1)
+	address.Line2 = strings.TrimSpace(address.Line2)
+	address.City = strings.TrimSpace(address.City)
+	address.State = strings.TrimSpace(address.State)
+	address.PostalCode = strings.TrimSpace(address.PostalCode)
+	address.Country = strings.ToUpper(strings.TrimSpace(address.Country))
+	if !address.IsZero() && (address.Line1 == "" || address.City == "" || address.PostalCode == "" ||
+		!validation.IsValidCountryCode(address.Country)) {
+		return appError.ErrInvalidLocationAddress
+	}
+
+	location.TimeZone = strings.TrimSpace(location.TimeZone)
+	if location.TimeZone == "" {
+		location.TimeZone = "UTC"
+	}
+	if _, err := time.LoadLocation(location.TimeZone); err != nil || strings.EqualFold(location.TimeZone, "Local") {
+		return appError.ErrInvalidTimeZone
+	}
+	return nil
+}
+
+func validateLocationAssignment(assignment *entity.LocationAssignment) error {
+	if assignment.EmployeeID <= 0 {
+		return appError.ErrInvalidEmployeeId
+	}
+	if assignment.LocationID <= 0 || assignment.EffectiveDate.IsZero() {
+		return appError.ErrInvalidLocationAssignment
+	}
+	assignment.EffectiveDate = dateOnly(assignment.EffectiveDate)
+	return nil
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *reviewUsecaseImpl) LockReview(ctx context.Context, id int) (*entity.Review, error) {
+	review, err := u.GetReviewById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if review.Status != entity.ReviewStatusCompleted {
+		return nil, appError.ErrReviewNotCompleted
+	}
+
+	lockedReview, err := u.reviewRepository.LockReview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if lockedReview == nil {
+		// The review was locked concurrently, e.g. by closing its cycle.
+		return nil, appError.ErrReviewNotCompleted
+	}
+	return lockedReview, nil
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *recruitingUsecaseImpl) MakeOffer(ctx context.Context, id int, offer *entity.JobOffer) (*entity.Candidate, error) {
+	if err := validateOffer(offer); err != nil {
+		return nil, err
+	}
+	candidate, err := u.GetCandidateById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if candidate.Stage != entity.CandidateOffer && !candidate.Stage.CanTransitionTo(entity.CandidateOffer) {
+		return nil, appError.ErrCandidateStageTransition
+	}
+	requisition, err := u.GetRequisitionById(ctx, candidate.RequisitionID)
+	if err != nil {
+		return nil, err
+	}
+	if requisition.Status != entity.RequisitionOpen {
+		return nil, appError.ErrRequisitionNotOpen
+	}
+
+	from := candidate.Stage
+	candidate.Stage = entity.CandidateOffer
+	candidate.Offer = offer
+	offeredCandidate, err := u.recruitingRepository.UpdateCandidateStage(ctx, candidate, from)
+	if err != nil {
+		return nil, err
+	}
+	if offeredCandidate == nil {
+		return nil, appError.ErrCandidateStageTransition
+	}
+	return offeredCandidate, nil
+}
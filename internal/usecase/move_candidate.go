@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *recruitingUsecaseImpl) MoveCandidate(ctx context.Context, id int,
+	stage entity.CandidateStage) (*entity.Candidate, error) {
+
+	if !stage.IsValid() {
+		return nil, appError.ErrInvalidCandidateStage
+	}
+	if stage == entity.CandidateOffer || stage == entity.CandidateHired {
+		return nil, appError.ErrCandidateStageTransition
+	}
+	candidate, err := u.GetCandidateById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !candidate.Stage.CanTransitionTo(stage) {
+		return nil, appError.ErrCandidateStageTransition
+	}
+
+	from := candidate.Stage
+	candidate.Stage = stage
+	movedCandidate, err := u.recruitingRepository.UpdateCandidateStage(ctx, candidate, from)
+	if err != nil {
+		return nil, err
+	}
+	if movedCandidate == nil {
+		// The candidate moved on concurrently.
+		return nil, appError.ErrCandidateStageTransition
+	}
+	return movedCandidate, nil
+}
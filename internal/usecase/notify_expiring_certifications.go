@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/notification"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+func (u *certificationUsecaseImpl) NotifyExpiringCertifications(ctx context.Context, asOf time.Time) (int, error) {
+	asOf = dateOnly(asOf)
+	certifications, err := u.getExpiringCertifications(ctx, asOf, u.expiryWindowDays, true)
+	if err != nil {
+		return 0, err
+	}
+
+	employees := map[int]*entity.Employee{}
+	notified := 0
+	for _, certification := range certifications {
+		recipients, err := u.expiryNoticeRecipients(ctx, certification.EmployeeID, employees)
+		if err != nil {
+			// Keep going: one broken record must not hold back the other notices.
+			log.Printf("[CERTIFICATIONS] failed to look up recipients for certification %d: %v", certification.ID, err)
+			continue
+		}
+		if len(recipients) > 0 {
+			if err := u.notifier.Notify(ctx, expiryNotice(certification, recipients, asOf)); err != nil {
+				log.Printf("[CERTIFICATIONS] failed to send expiry notice for certification %d: %v", certification.ID, err)
+				continue
+			}
+			notified++
+		} else {
+			log.Printf("[CERTIFICATIONS] no one to notify about certification %d of employee %d",
+				certification.ID, certification.EmployeeID)
+		}
+		if err := u.certificationRepository.MarkExpiryNotified(ctx, certification.ID, asOf); err != nil {
+			return notified, err
+		}
+	}
+	return notified, nil
+}
+
+// expiryNoticeRecipients returns the work emails of the employee and their manager, remembering
+// looked up employees in employees.
+func (u *certificationUsecaseImpl) expiryNoticeRecipients(ctx context.Context, employeeID int,
+	employees map[int]*entity.Employee) ([]string, error) {
+
+	recipients := []string{}
+	employee, err := u.lookUpEmployee(ctx, employeeID, employees)
+	if err != nil || employee == nil {
+		return nil, err
+	}
+	if employee.WorkEmail != "" {
+		recipients = append(recipients, employee.WorkEmail)
+	}
+	if employee.ManagerID != nil {
+		manager, err := u.lookUpEmployee(ctx, *employee.ManagerID, employees)
+		if err != nil {
+			return nil, err
+		}
+		if manager != nil && manager.WorkEmail != "" {
+			recipients = append(recipients, manager.WorkEmail)
+		}
+	}
+	return recipients, nil
+}
+
+func (u *certificationUsecaseImpl) lookUpEmployee(ctx context.Context, id int,
+	employees map[int]*entity.Employee) (*entity.Employee, error) {
+
+	if employee, ok := employees[id]; ok {
+		return employee, nil
+	}
+	employee, err := u.employeeRepository.GetEmployeeById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	employees[id] = employee
+	return employee, nil
+}
+
+func expiryNotice(certification *entity.Certification, recipients []string, asOf time.Time) notification.Message {
+	expiresOn := certification.ExpiresOn.Format(constants.DateFormat)
+	days := int(certification.ExpiresOn.Sub(asOf).Hours() / 24)
+
+	issuer := ""
+	if certification.Issuer != "" {
+		issuer = " issued by " + certification.Issuer
+	}
+	return notification.Message{
+		To:      recipients,
+		Subject: fmt.Sprintf("Certification %s expires on %s", certification.Name, expiresOn),
+		Body: fmt.Sprintf("The %s certification%s held by %s expires on %s, in %d day(s). "+
+			"Once it is renewed, update its expiry date in the employee's certifications.",
+			certification.Name, issuer, certification.EmployeeName, expiresOn, days),
+	}
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/storage"
+)
+
+func (u *documentUsecaseImpl) OpenDocument(ctx context.Context, employeeID, id int) (*entity.EmployeeDocument, io.ReadCloser, error) {
+	document, err := u.GetDocumentById(ctx, employeeID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := u.blobStorage.Get(ctx, document.StorageKey)
+	if err != nil {
+		// The metadata says the file exists, so a missing blob is a storage fault rather than a 404.
+		if errors.Is(err, storage.ErrBlobNotFound) {
+			return nil, nil, fmt.Errorf("document %d has no content at %s: %w", document.ID, document.StorageKey, err)
+		}
+		return nil, nil, fmt.Errorf("open document: %w", err)
+	}
+	return document, content, nil
+}
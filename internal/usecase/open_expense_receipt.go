@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/storage"
+)
+
+func (u *expenseUsecaseImpl) OpenReceipt(ctx context.Context, employeeID, claimID, id int) (*entity.ExpenseReceipt, io.ReadCloser, error) {
+	receipt, err := u.GetReceiptById(ctx, employeeID, claimID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := u.blobStorage.Get(ctx, receipt.StorageKey)
+	if err != nil {
+		// The metadata says the file exists, so a missing blob is a storage fault rather than a 404.
+		if errors.Is(err, storage.ErrBlobNotFound) {
+			return nil, nil, fmt.Errorf("receipt %d has no content at %s: %w", receipt.ID, receipt.StorageKey, err)
+		}
+		return nil, nil, fmt.Errorf("open receipt: %w", err)
+	}
+	return receipt, content, nil
+}
@@ -0,0 +1,244 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// payrollStatuses are the employment statuses paid by a payroll run. Terminated employees are
+// paid up to their termination date when it falls within the period.
+var payrollStatuses = []entity.EmploymentStatus{
+	entity.EmploymentStatusActive,
+	entity.EmploymentStatusOnLeave,
+	entity.EmploymentStatusTerminated,
+}
+
+// calculatePayslips calculates a payslip for every employee employed during the period of the run
+// with runID, or of a new run when runID is 0.
+func (u *payrollUsecaseImpl) calculatePayslips(ctx context.Context, runID int,
+	period *entity.PayrollPeriod) ([]*entity.Payslip, error) {
+	employees, err := u.employeeRepository.GetAllEmployees(ctx, entity.EmployeeFilter{Statuses: payrollStatuses})
+	if err != nil {
+		return nil, err
+	}
+	rules, err := u.payrollRepository.GetActivePayrollRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	benefitDeductions, err := u.benefitRepository.GetDeductions(ctx, period.StartDate(), period.EndDate(), 0)
+	if err != nil {
+		return nil, err
+	}
+	benefitDeductionsByEmployee := map[int][]*entity.BenefitDeduction{}
+	for _, deduction := range benefitDeductions {
+		benefitDeductionsByEmployee[deduction.EmployeeID] = append(benefitDeductionsByEmployee[deduction.EmployeeID], deduction)
+	}
+	claimsByEmployee, err := u.reimbursableClaims(ctx, runID, period)
+	if err != nil {
+		return nil, err
+	}
+
+	payslips := []*entity.Payslip{}
+	for _, employee := range employees {
+		if dateOnly(employee.HiredDate).After(period.EndDate()) {
+			continue
+		}
+		if employee.Status == entity.EmploymentStatusTerminated &&
+			(employee.TerminationDate == nil || dateOnly(*employee.TerminationDate).Before(period.StartDate())) {
+			continue
+		}
+		payslip, err := u.calculatePayslip(ctx, employee, period, rules, benefitDeductionsByEmployee[employee.ID],
+			claimsByEmployee[employee.ID])
+		if err != nil {
+			return nil, err
+		}
+		payslips = append(payslips, payslip)
+	}
+	return payslips, nil
+}
+
+// calculatePayslip applies the rules to the employee's base pay, prorated when they joined, left
+// or had a salary change during the period. Fixed rules are not prorated. Earnings are added to
+// the base pay to make the gross pay, deductions come off the gross pay and taxes off what
+// remains after deductions. The employee's contributions to their benefit enrollments are
+// deducted in full, after the deduction rules. Expense claims are paid back on top of what is left,
+// untaxed.
+func (u *payrollUsecaseImpl) calculatePayslip(ctx context.Context, employee *entity.Employee,
+	period *entity.PayrollPeriod, rules []*entity.PayrollRule, benefitDeductions []*entity.BenefitDeduction,
+	claims []*entity.ExpenseClaim) (*entity.Payslip, error) {
+
+	currency := employee.Salary.Currency
+	pay, err := u.compensationCalculator.CalculateMonthlyPay(ctx, employee, period.Year, period.Month, "")
+	if err != nil {
+		return nil, err
+	}
+	basePay := pay.Amount.Amount
+	payslip := &entity.Payslip{
+		EmployeeID:   employee.ID,
+		EmployeeName: employee.Name,
+		Position:     employee.Position,
+		Salary:       employee.Salary,
+		PayPeriod:    employee.PayPeriod,
+		BasePay:      pay.Amount,
+		Lines:        basePayLines(pay),
+	}
+
+	// Each kind is applied in turn since percentage deductions and taxes depend on the totals
+	// of the kinds before them.
+	var earnings, deductions, tax int64
+	for _, kind := range []entity.PayrollRuleKind{entity.PayrollRuleKindEarning, entity.PayrollRuleKindDeduction, entity.PayrollRuleKindTax} {
+		base := basePay
+		switch kind {
+		case entity.PayrollRuleKindDeduction:
+			base = basePay + earnings
+		case entity.PayrollRuleKindTax:
+			base = basePay + earnings - deductions
+		}
+
+		for _, rule := range rules {
+			if rule.Kind != kind {
+				continue
+			}
+			amount, err := u.payrollRuleAmount(ctx, rule, base, currency, period)
+			if err != nil {
+				return nil, err
+			}
+
+			switch kind {
+			case entity.PayrollRuleKindEarning:
+				earnings += amount
+			case entity.PayrollRuleKindDeduction:
+				deductions += amount
+			case entity.PayrollRuleKindTax:
+				tax += amount
+			}
+			ruleID := rule.ID
+			payslip.Lines = append(payslip.Lines, entity.PayslipLine{
+				PayrollRuleID: &ruleID,
+				Kind:          entity.PayslipLineKind(kind),
+				Name:          rule.Name,
+				Amount:        entity.Money{Amount: amount, Currency: currency},
+			})
+		}
+
+		if kind == entity.PayrollRuleKindDeduction {
+			for _, benefit := range benefitDeductions {
+				amount, err := u.convertToCurrency(ctx, benefit.EmployeeContribution, currency, period)
+				if err != nil {
+					return nil, err
+				}
+				if amount == 0 {
+					continue
+				}
+				deductions += amount
+				payslip.Lines = append(payslip.Lines, entity.PayslipLine{
+					Kind:   entity.PayslipLineKindDeduction,
+					Name:   benefit.PlanName,
+					Amount: entity.Money{Amount: amount, Currency: currency},
+				})
+			}
+		}
+	}
+
+	var reimbursements int64
+	for _, claim := range claims {
+		amount, err := u.convertToCurrency(ctx, claim.Total, currency, period)
+		if err != nil {
+			return nil, err
+		}
+		reimbursements += amount
+		claimID := claim.ID
+		payslip.Lines = append(payslip.Lines, entity.PayslipLine{
+			ExpenseClaimID: &claimID,
+			Kind:           entity.PayslipLineKindReimbursement,
+			Name:           "Expense claim: " + claim.Title,
+			Amount:         entity.Money{Amount: amount, Currency: currency},
+		})
+	}
+
+	payslip.GrossPay = entity.Money{Amount: basePay + earnings, Currency: currency}
+	payslip.TotalDeductions = entity.Money{Amount: deductions, Currency: currency}
+	payslip.TotalTax = entity.Money{Amount: tax, Currency: currency}
+	payslip.TotalReimbursements = entity.Money{Amount: reimbursements, Currency: currency}
+	payslip.NetPay = entity.Money{Amount: basePay + earnings - deductions - tax + reimbursements, Currency: currency}
+	return payslip, nil
+}
+
+// reimbursableClaims returns the approved expense claims the run with runID pays back, by
+// employee: those decided by the end of the period that no other run holds yet.
+func (u *payrollUsecaseImpl) reimbursableClaims(ctx context.Context, runID int,
+	period *entity.PayrollPeriod) (map[int][]*entity.ExpenseClaim, error) {
+
+	claims, err := u.expenseRepository.GetClaims(ctx, entity.ExpenseClaimFilter{Status: entity.ExpenseClaimApproved})
+	if err != nil {
+		return nil, err
+	}
+	claimsByEmployee := map[int][]*entity.ExpenseClaim{}
+	// Claims come latest first; pay them back oldest first.
+	for i := len(claims) - 1; i >= 0; i-- {
+		claim := claims[i]
+		if claim.PayrollRunID != nil && *claim.PayrollRunID != runID {
+			continue
+		}
+		if claim.DecidedAt == nil || dateOnly(*claim.DecidedAt).After(period.EndDate()) {
+			continue
+		}
+		claimsByEmployee[claim.EmployeeID] = append(claimsByEmployee[claim.EmployeeID], claim)
+	}
+	return claimsByEmployee, nil
+}
+
+// payrollRuleAmount is what a rule contributes in currency: its fixed amount, converted with the
+// rates at the end of the period, or its share of base.
+func (u *payrollUsecaseImpl) payrollRuleAmount(ctx context.Context, rule *entity.PayrollRule, base int64,
+	currency string, period *entity.PayrollPeriod) (int64, error) {
+
+	if rule.Method == entity.PayrollRuleMethodPercentage {
+		return int64(math.Round(float64(base) * rule.Rate / 100)), nil
+	}
+	return u.convertToCurrency(ctx, rule.Amount, currency, period)
+}
+
+// convertToCurrency is amount in currency, converted with the rates at the end of the period.
+func (u *payrollUsecaseImpl) convertToCurrency(ctx context.Context, amount entity.Money, currency string,
+	period *entity.PayrollPeriod) (int64, error) {
+
+	if amount.Currency == currency {
+		return amount.Amount, nil
+	}
+	converted, _, _, err := u.exchangeRates.ConvertMoney(ctx, amount, currency, period.EndDate())
+	if err != nil {
+		return 0, err
+	}
+	return converted.Amount, nil
+}
+
+// basePayLines is a single base pay line for a full month, or one line per salary segment with
+// the days it covers when the pay was prorated.
+func basePayLines(pay *ProratedPay) []entity.PayslipLine {
+	if !pay.IsProrated() {
+		return []entity.PayslipLine{{Kind: entity.PayslipLineKindBase, Name: "Base pay", Amount: pay.Amount}}
+	}
+
+	days := strings.ReplaceAll(string(pay.Basis), "_", " ")
+	lines := []entity.PayslipLine{}
+	for _, segment := range pay.Segments {
+		lines = append(lines, entity.PayslipLine{
+			Kind: entity.PayslipLineKindBase,
+			Name: fmt.Sprintf("Base pay %s to %s (%d/%d %s)", segment.Start.Format(constants.DateFormat),
+				segment.End.Format(constants.DateFormat), segment.Days, pay.MonthDays, days),
+			Amount: segment.Amount,
+		})
+	}
+	return lines
+}
+
+// monthlyPay is a month's share of a salary paid per pay period.
+func monthlyPay(salary entity.Money, payPeriod entity.PayPeriod) int64 {
+	return int64(math.Round(float64(salary.Amount*payPeriod.PeriodsPerYear()) / 12))
+}
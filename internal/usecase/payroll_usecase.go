@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type PayrollUsecase interface {
+	CreatePayrollRule(ctx context.Context, rule *entity.PayrollRule) (*entity.PayrollRule, error)
+	GetPayrollRuleById(ctx context.Context, id int) (*entity.PayrollRule, error)
+	GetAllPayrollRules(ctx context.Context) ([]*entity.PayrollRule, error)
+	// UpdatePayrollRule changes a rule for future calculations; existing payslips keep their lines.
+	UpdatePayrollRule(ctx context.Context, rule *entity.PayrollRule) (*entity.PayrollRule, error)
+	DeletePayrollRule(ctx context.Context, id int) error
+
+	CreatePayrollPeriod(ctx context.Context, period *entity.PayrollPeriod) (*entity.PayrollPeriod, error)
+	GetPayrollPeriodById(ctx context.Context, id int) (*entity.PayrollPeriod, error)
+	GetAllPayrollPeriods(ctx context.Context) ([]*entity.PayrollPeriod, error)
+
+	// CreatePayrollRun calculates a draft run of the period with a payslip for every employee
+	// employed during it. The payslips pay back the approved expense claims no other run holds.
+	CreatePayrollRun(ctx context.Context, periodID int) (*entity.PayrollRun, error)
+	GetPayrollRunById(ctx context.Context, id int) (*entity.PayrollRun, error)
+	GetAllPayrollRuns(ctx context.Context) ([]*entity.PayrollRun, error)
+	// RecalculatePayrollRun recalculates the payslips of a draft run with current salaries and rules.
+	RecalculatePayrollRun(ctx context.Context, id int) (*entity.PayrollRun, error)
+	FinalizePayrollRun(ctx context.Context, id int) (*entity.PayrollRun, error)
+	// ReopenPayrollRun turns a finalized run back into a draft.
+	ReopenPayrollRun(ctx context.Context, id int) (*entity.PayrollRun, error)
+	// LockPayrollRun closes a finalized run for good, e.g. once it has been paid. The expense
+	// claims it pays back are reimbursed.
+	LockPayrollRun(ctx context.Context, id int) (*entity.PayrollRun, error)
+	DeletePayrollRun(ctx context.Context, id int) error
+
+	GetPayslips(ctx context.Context, runID int) ([]*entity.Payslip, error)
+	GetPayslipById(ctx context.Context, runID, id int) (*entity.Payslip, error)
+	// GetEmployeePayslips lists the employee's payslips of finalized and locked runs.
+	GetEmployeePayslips(ctx context.Context, employeeID int) ([]*entity.Payslip, error)
+}
+
+type payrollUsecaseImpl struct {
+	payrollRepository      repository.PayrollRepository
+	employeeRepository     repository.EmployeeRepository
+	benefitRepository      repository.BenefitRepository
+	expenseRepository      repository.ExpenseRepository
+	exchangeRates          ExchangeRateUsecase
+	compensationCalculator CompensationCalculator
+}
+
+func NewPayrollUsecase(payrollRepository repository.PayrollRepository, employeeRepository repository.EmployeeRepository,
+	benefitRepository repository.BenefitRepository, expenseRepository repository.ExpenseRepository,
+	exchangeRates ExchangeRateUsecase, compensationCalculator CompensationCalculator) PayrollUsecase {
+	return &payrollUsecaseImpl{
+		payrollRepository:      payrollRepository,
+		employeeRepository:     employeeRepository,
+		benefitRepository:      benefitRepository,
+		expenseRepository:      expenseRepository,
+		exchangeRates:          exchangeRates,
+		compensationCalculator: compensationCalculator,
+	}
+}
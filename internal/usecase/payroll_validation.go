@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func validatePayrollRule(rule *entity.PayrollRule) error {
+	rule.Name = strings.TrimSpace(rule.Name)
+	if len(rule.Name) < 3 || !rule.Kind.IsValid() {
+		return appError.ErrInvalidPayrollRule
+	}
+	switch rule.Method {
+	case entity.PayrollRuleMethodFixed:
+		rule.Amount.Currency = strings.ToUpper(strings.TrimSpace(rule.Amount.Currency))
+		if !rule.Amount.IsValid() || rule.Rate != 0 {
+			return appError.ErrInvalidPayrollRule
+		}
+	case entity.PayrollRuleMethodPercentage:
+		if rule.Rate <= 0 || rule.Rate > 100 || !rule.Amount.IsZero() {
+			return appError.ErrInvalidPayrollRule
+		}
+	default:
+		return appError.ErrInvalidPayrollRule
+	}
+	return nil
+}
+
+func validatePayrollPeriod(period *entity.PayrollPeriod) error {
+	if period.Year < 2000 || period.Year > 9999 || period.Month < 1 || period.Month > 12 {
+		return appError.ErrInvalidPayrollPeriod
+	}
+	if period.PayDate.IsZero() {
+		return appError.ErrInvalidPayrollPeriod
+	}
+	period.PayDate = dateOnly(period.PayDate)
+	if period.PayDate.Before(period.StartDate()) {
+		return appError.ErrInvalidPayrollPeriod
+	}
+	return nil
+}
+
+// changePayrollRunStatus moves a run to the next status when its current status allows it.
+func (u *payrollUsecaseImpl) changePayrollRunStatus(ctx context.Context, id int,
+	next entity.PayrollRunStatus) (*entity.PayrollRun, error) {
+
+	run, err := u.GetPayrollRunById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !run.Status.CanTransitionTo(next) {
+		return nil, appError.ErrInvalidPayrollRunTransition
+	}
+
+	updatedRun, err := u.payrollRepository.UpdatePayrollRunStatus(ctx, id, run.Status, next)
+	if err != nil {
+		return nil, err
+	}
+	if updatedRun == nil {
+		// The run changed status concurrently.
+		return nil, appError.ErrInvalidPayrollRunTransition
+	}
+	return updatedRun, nil
+}
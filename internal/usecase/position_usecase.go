@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type PositionUsecase interface {
+	CreatePosition(ctx context.Context, position *entity.Position) (*entity.Position, error)
+	GetPositionById(ctx context.Context, id int) (*entity.Position, error)
+	GetAllPositions(ctx context.Context) ([]*entity.Position, error)
+	UpdatePosition(ctx context.Context, position *entity.Position) (*entity.Position, error)
+	DeletePosition(ctx context.Context, id int) error
+
+	// GetOutOfBandEmployees lists current (not terminated) employees whose salary today lies
+	// outside the band of their position.
+	GetOutOfBandEmployees(ctx context.Context) ([]*OutOfBandEmployee, error)
+}
+
+type positionUsecaseImpl struct {
+	positionRepository repository.PositionRepository
+	employeeRepository repository.EmployeeRepository
+	salaryBands        salaryBandChecker
+}
+
+func NewPositionUsecase(positionRepository repository.PositionRepository, employeeRepository repository.EmployeeRepository,
+	exchangeRateUsecase ExchangeRateUsecase) PositionUsecase {
+	return &positionUsecaseImpl{
+		positionRepository: positionRepository,
+		employeeRepository: employeeRepository,
+		salaryBands:        salaryBandChecker{exchangeRateUsecase: exchangeRateUsecase},
+	}
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// validatePosition normalizes and checks a position. The job level defaults to 1 and the band's
+// pay period to monthly; the band itself is optional but must be complete when given.
+func validatePosition(position *entity.Position) error {
+	position.Title = strings.TrimSpace(position.Title)
+	position.MinSalary.Currency = strings.ToUpper(strings.TrimSpace(position.MinSalary.Currency))
+	position.MaxSalary.Currency = strings.ToUpper(strings.TrimSpace(position.MaxSalary.Currency))
+
+	if len(position.Title) < 3 {
+		return appError.ErrInvalidPositionTitle
+	}
+
+	if position.JobLevel == 0 {
+		position.JobLevel = 1
+	}
+	if position.JobLevel < 0 {
+		return appError.ErrInvalidJobLevel
+	}
+
+	if position.PayPeriod == "" {
+		position.PayPeriod = entity.PayPeriodMonthly
+	}
+	if !position.PayPeriod.IsValid() {
+		return appError.ErrInvalidPayPeriod
+	}
+
+	if position.HasBand() {
+		if !position.MinSalary.IsValid() || !position.MaxSalary.IsValid() {
+			return appError.ErrInvalidSalaryBand
+		}
+		if position.MinSalary.Currency != position.MaxSalary.Currency ||
+			position.MinSalary.Amount > position.MaxSalary.Amount {
+			return appError.ErrInvalidSalaryBand
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *payrollUsecaseImpl) RecalculatePayrollRun(ctx context.Context, id int) (*entity.PayrollRun, error) {
+	run, err := u.GetPayrollRunById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if run.Status != entity.PayrollRunStatusDraft {
+		return nil, appError.ErrPayrollRunNotDraft
+	}
+	period, err := u.GetPayrollPeriodById(ctx, run.PayrollPeriodID)
+	if err != nil {
+		return nil, err
+	}
+
+	payslips, err := u.calculatePayslips(ctx, run.ID, period)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedRun, err := u.payrollRepository.ReplacePayslips(ctx, run.ID, payslips)
+	if err != nil {
+		return nil, err
+	}
+	if updatedRun == nil {
+		// The run was finalized concurrently.
+		return nil, appError.ErrPayrollRunNotDraft
+	}
+	return updatedRun, nil
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type RecruitingUsecase interface {
+	CreateRequisition(ctx context.Context, requisition *entity.JobRequisition) (*entity.JobRequisition, error)
+	GetRequisitionById(ctx context.Context, id int) (*entity.JobRequisition, error)
+	// GetRequisitions returns the requisitions with status, or all of them when status is empty.
+	GetRequisitions(ctx context.Context, status entity.RequisitionStatus) ([]*entity.JobRequisition, error)
+	UpdateRequisition(ctx context.Context, requisition *entity.JobRequisition) (*entity.JobRequisition, error)
+	DeleteRequisition(ctx context.Context, id int) error
+
+	// AddCandidate adds an applicant to an open requisition.
+	AddCandidate(ctx context.Context, candidate *entity.Candidate) (*entity.Candidate, error)
+	GetCandidateById(ctx context.Context, id int) (*entity.Candidate, error)
+	GetCandidates(ctx context.Context, filter entity.CandidateFilter) ([]*entity.Candidate, error)
+	UpdateCandidate(ctx context.Context, candidate *entity.Candidate) (*entity.Candidate, error)
+	DeleteCandidate(ctx context.Context, id int) error
+	// MoveCandidate moves a candidate along the pipeline. The offer and hired stages are reached
+	// through MakeOffer and AcceptOffer instead.
+	MoveCandidate(ctx context.Context, id int, stage entity.CandidateStage) (*entity.Candidate, error)
+	// MakeOffer makes, or revises, the offer of a candidate and moves them to the offer stage.
+	MakeOffer(ctx context.Context, id int, offer *entity.JobOffer) (*entity.Candidate, error)
+	// AcceptOffer hires a candidate on their offer: it creates the employee from the candidate and
+	// their requisition, marks the candidate hired and the requisition filled once it has no
+	// openings left.
+	AcceptOffer(ctx context.Context, acceptance entity.OfferAcceptance) (*entity.Employee, error)
+	// ActivateStartingHires makes active the hires created as candidates for a future start date,
+	// once the date asOf falls on at their location has reached it, and returns how many were
+	// activated. It is run periodically by a background job.
+	ActivateStartingHires(ctx context.Context, asOf time.Time) (int, error)
+
+	// ScheduleInterview schedules an interview, moving an applied or screened candidate to the
+	// interview stage.
+	ScheduleInterview(ctx context.Context, interview *entity.Interview) (*entity.Interview, error)
+	GetCandidateInterviews(ctx context.Context, candidateID int) ([]*entity.Interview, error)
+	// RescheduleInterview changes the interviewer, time or duration of a scheduled interview.
+	RescheduleInterview(ctx context.Context, interview *entity.Interview) (*entity.Interview, error)
+	// SubmitInterviewFeedback records the interviewer's rating, recommendation and feedback and
+	// completes the interview.
+	SubmitInterviewFeedback(ctx context.Context, feedback *entity.Interview) (*entity.Interview, error)
+	CancelInterview(ctx context.Context, id int) (*entity.Interview, error)
+	// GetInterviewerSchedule returns the upcoming interviews of an employee.
+	GetInterviewerSchedule(ctx context.Context, interviewerID int) ([]*entity.Interview, error)
+}
+
+type recruitingUsecaseImpl struct {
+	recruitingRepository repository.RecruitingRepository
+	employeeRepository   repository.EmployeeRepository
+	locationRepository   repository.LocationRepository
+	employees            EmployeeUsecase
+}
+
+// NewRecruitingUsecase hires candidates through employees, so that new hires get the same
+// validation, salary band policy and onboarding as employees created directly.
+func NewRecruitingUsecase(recruitingRepository repository.RecruitingRepository,
+	employeeRepository repository.EmployeeRepository, locationRepository repository.LocationRepository,
+	employees EmployeeUsecase) RecruitingUsecase {
+	return &recruitingUsecaseImpl{
+		recruitingRepository: recruitingRepository,
+		employeeRepository:   employeeRepository,
+		locationRepository:   locationRepository,
+		employees:            employees,
+	}
+}
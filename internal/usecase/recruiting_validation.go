@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/validation"
+)
+
+const (
+	minInterviewMinutes     = 15
+	maxInterviewMinutes     = 480
+	defaultInterviewMinutes = 60
+)
+
+func validateRequisition(requisition *entity.JobRequisition) error {
+	requisition.Title = strings.TrimSpace(requisition.Title)
+	requisition.Description = strings.TrimSpace(requisition.Description)
+	if requisition.Status == "" {
+		requisition.Status = entity.RequisitionOpen
+	}
+	if len(requisition.Title) < 3 || requisition.PositionID <= 0 || requisition.Openings < 1 ||
+		!requisition.Status.IsValid() {
+		return appError.ErrInvalidRequisition
+	}
+	if requisition.DepartmentID != nil && *requisition.DepartmentID <= 0 {
+		return appError.ErrInvalidDepartment
+	}
+	if requisition.LocationID != nil && *requisition.LocationID <= 0 {
+		return appError.ErrInvalidLocation
+	}
+	if requisition.HiringManagerID != nil && *requisition.HiringManagerID <= 0 {
+		return appError.ErrInvalidManager
+	}
+	return nil
+}
+
+func validateCandidate(candidate *entity.Candidate) error {
+	candidate.Name = strings.TrimSpace(candidate.Name)
+	candidate.Email = strings.ToLower(strings.TrimSpace(candidate.Email))
+	candidate.Phone = strings.TrimSpace(candidate.Phone)
+	candidate.Source = strings.TrimSpace(candidate.Source)
+	candidate.Notes = strings.TrimSpace(candidate.Notes)
+	if len(candidate.Name) < 3 || !validation.IsValidEmail(candidate.Email) {
+		return appError.ErrInvalidCandidate
+	}
+	if candidate.Phone != "" && !validation.IsValidE164Phone(candidate.Phone) {
+		return appError.ErrInvalidCandidate
+	}
+	return nil
+}
+
+// validateOffer normalizes and checks an offer. The pay period defaults to monthly.
+func validateOffer(offer *entity.JobOffer) error {
+	offer.Salary.Currency = strings.ToUpper(strings.TrimSpace(offer.Salary.Currency))
+	if offer.PayPeriod == "" {
+		offer.PayPeriod = entity.PayPeriodMonthly
+	}
+	if offer.Salary.Amount <= 0 || !entity.IsValidCurrency(offer.Salary.Currency) ||
+		!offer.PayPeriod.IsValid() || offer.StartDate.IsZero() {
+		return appError.ErrInvalidOffer
+	}
+	offer.StartDate = dateOnly(offer.StartDate)
+	return nil
+}
+
+// validateInterviewSchedule checks the interviewer and time of an interview and that the
+// interviewer is free then. The duration defaults to an hour.
+func (u *recruitingUsecaseImpl) validateInterviewSchedule(ctx context.Context, interview *entity.Interview) error {
+	if interview.DurationMinutes == 0 {
+		interview.DurationMinutes = defaultInterviewMinutes
+	}
+	now := time.Now().UTC()
+	if interview.InterviewerID <= 0 || !interview.ScheduledAt.After(now) ||
+		interview.DurationMinutes < minInterviewMinutes || interview.DurationMinutes > maxInterviewMinutes {
+		return appError.ErrInvalidInterview
+	}
+
+	interviewer, err := u.employeeRepository.GetEmployeeById(ctx, interview.InterviewerID)
+	if err != nil {
+		return err
+	}
+	if interviewer == nil || interviewer.Status == entity.EmploymentStatusTerminated {
+		return appError.ErrInvalidInterview
+	}
+
+	schedule, err := u.recruitingRepository.GetInterviewerSchedule(ctx, interview.InterviewerID, now)
+	if err != nil {
+		return err
+	}
+	for _, other := range schedule {
+		if other.ID != interview.ID && interview.Overlaps(other) {
+			return appError.ErrInterviewConflict
+		}
+	}
+	return nil
+}
+
+func validateInterviewFeedback(feedback *entity.Interview) error {
+	feedback.Feedback = strings.TrimSpace(feedback.Feedback)
+	if feedback.Rating == nil || *feedback.Rating < 1 || *feedback.Rating > 5 ||
+		!feedback.Recommendation.IsValid() || feedback.Feedback == "" {
+		return appError.ErrInvalidInterviewFeedback
+	}
+	return nil
+}
+
+// getOpenCandidate loads a candidate still in the pipeline.
+func (u *recruitingUsecaseImpl) getOpenCandidate(ctx context.Context, id int) (*entity.Candidate, error) {
+	candidate, err := u.GetCandidateById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if candidate.Stage.IsClosed() {
+		return nil, appError.ErrCandidateStageTransition
+	}
+	return candidate, nil
+}
+
+// getScheduledInterview loads an interview that has neither been completed nor cancelled.
+func (u *recruitingUsecaseImpl) getScheduledInterview(ctx context.Context, id int) (*entity.Interview, error) {
+	if id <= 0 {
+		return nil, appError.ErrInvalidInterviewId
+	}
+	interview, err := u.recruitingRepository.GetInterviewById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if interview == nil {
+		return nil, appError.ErrInterviewNotFound
+	}
+	if interview.Status != entity.InterviewScheduled {
+		return nil, appError.ErrInterviewClosed
+	}
+	return interview, nil
+}
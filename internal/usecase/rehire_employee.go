@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// RehireEmployee brings a terminated employee back as active. The rehire date becomes
+// the new hired date; the previous employment period stays in the status history.
+func (u *employeeUsecaseImpl) RehireEmployee(ctx context.Context, employeeID int,
+	rehireDate time.Time, reason string) (*entity.Employee, error) {
+
+	employee, err := u.GetEmployeeById(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee.Status != entity.EmploymentStatusTerminated {
+		return nil, appError.ErrInvalidStatusTransition
+	}
+	if rehireDate.IsZero() || (employee.TerminationDate != nil && !rehireDate.After(*employee.TerminationDate)) {
+		return nil, appError.ErrInvalidRehireDate
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		reason = "rehired"
+	}
+
+	employee.HiredDate = rehireDate
+	employee.TerminationDate = nil
+	employee.TerminationReason = ""
+
+	return u.transitionEmploymentStatus(ctx, employee, &entity.EmploymentStatusChange{
+		ToStatus:      entity.EmploymentStatusActive,
+		EffectiveDate: rehireDate,
+		Reason:        reason,
+	})
+}
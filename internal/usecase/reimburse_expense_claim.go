@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *expenseUsecaseImpl) ReimburseClaim(ctx context.Context, employeeID, id int, reimbursedOn *time.Time,
+	payrollRunID *int, reference string) (*entity.ExpenseClaim, error) {
+
+	claim, err := u.GetClaimById(ctx, employeeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if claim.Status != entity.ExpenseClaimApproved {
+		return nil, appError.ErrExpenseClaimNotApproved
+	}
+	if claim.PayrollRunID != nil {
+		return nil, appError.ErrExpenseClaimInPayrollRun
+	}
+
+	date := today()
+	if reimbursedOn != nil {
+		date = dateOnly(*reimbursedOn)
+	}
+	if date.After(today()) || (claim.DecidedAt != nil && date.Before(dateOnly(*claim.DecidedAt))) {
+		return nil, appError.ErrInvalidExpenseReimbursement
+	}
+	if payrollRunID != nil {
+		run, err := u.payrollRepository.GetPayrollRunById(ctx, *payrollRunID)
+		if err != nil {
+			return nil, err
+		}
+		if run == nil {
+			return nil, appError.ErrPayrollRunNotFound
+		}
+	}
+
+	claim.Status = entity.ExpenseClaimReimbursed
+	claim.ReimbursedOn = &date
+	claim.PayrollRunID = payrollRunID
+	claim.ReimbursementReference = strings.TrimSpace(reference)
+	reimbursedClaim, err := u.expenseRepository.UpdateClaimStatus(ctx, claim, entity.ExpenseClaimApproved)
+	if err != nil {
+		return nil, err
+	}
+	if reimbursedClaim == nil {
+		return nil, appError.ErrExpenseClaimNotApproved
+	}
+	return reimbursedClaim, nil
+}
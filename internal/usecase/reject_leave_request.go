@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *leaveUsecaseImpl) RejectLeaveRequest(ctx context.Context, employeeID, id, approverID int,
+	comment string) (*entity.LeaveRequest, error) {
+
+	request, err := u.GetLeaveRequestById(ctx, employeeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status != entity.LeaveRequestStatusPending {
+		return nil, appError.ErrLeaveRequestClosed
+	}
+	if request.ApprovalRequestID != nil {
+		return nil, appError.ErrLeaveRequestInApproval
+	}
+
+	employee, err := u.getEmployeeForLeave(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateApprover(ctx, u.employeeRepository, employee, approverID); err != nil {
+		return nil, err
+	}
+
+	request.DecidedBy = &approverID
+	request.DecisionComment = strings.TrimSpace(comment)
+	rejectedRequest, err := u.leaveRepository.RejectLeaveRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if rejectedRequest == nil {
+		return nil, appError.ErrLeaveRequestClosed
+	}
+	return rejectedRequest, nil
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *attendanceUsecaseImpl) RejectTimesheet(ctx context.Context, employeeID int, week time.Time,
+	approverID int, comment string) (*entity.Timesheet, error) {
+
+	return u.decideTimesheet(ctx, employeeID, week, approverID, comment, entity.TimesheetStatusRejected)
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *segmentUsecaseImpl) RemoveEmployeeTag(ctx context.Context, employeeID int, tag string) error {
+	if employeeID <= 0 {
+		return appError.ErrInvalidEmployeeId
+	}
+	tags, err := normalizeTags([]string{tag})
+	if err != nil {
+		return err
+	}
+	if err := u.segmentRepository.RemoveEmployeeTag(ctx, employeeID, tags[0]); err != nil {
+		return err
+	}
+
+	invalidateEmployeesListCache(ctx, u.cache)
+	return nil
+}
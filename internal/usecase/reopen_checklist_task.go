@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+// ReopenTask marks a completed task open again, reopening its checklist if it was complete. It
+// fails while the employee has another open checklist of the same kind.
+func (u *checklistUsecaseImpl) ReopenTask(ctx context.Context, id int) (*entity.Checklist, error) {
+	task, err := u.getChecklistTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !task.IsCompleted() {
+		return u.GetChecklistById(ctx, task.ChecklistID)
+	}
+	return u.checklistRepository.SetTaskCompletion(ctx, id, nil)
+}
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *payrollUsecaseImpl) ReopenPayrollRun(ctx context.Context, id int) (*entity.PayrollRun, error) {
+	return u.changePayrollRunStatus(ctx, id, entity.PayrollRunStatusDraft)
+}
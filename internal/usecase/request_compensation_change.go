@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// salaryChangeApproval is the payload of salary change approval requests.
+type salaryChangeApproval struct {
+	EffectiveDate string `json:"effective_date"`
+	Amount        int64  `json:"amount"`
+	Currency      string `json:"currency"`
+	Reason        string `json:"reason"`
+}
+
+func (u *compensationUsecaseImpl) RequestCompensationChange(ctx context.Context, change *entity.CompensationChange,
+	requestedBy *int) (*entity.CompensationChange, *entity.ApprovalRequest, error) {
+
+	if err := validateCompensationChange(change); err != nil {
+		return nil, nil, err
+	}
+	employee, err := u.compensationChangeEmployee(ctx, change)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Out-of-band salaries are turned down before anyone is asked to approve them.
+	if _, err := u.checkSalaryBand(ctx, employee, change.NewSalary); err != nil {
+		return nil, nil, err
+	}
+
+	effectiveDate := change.EffectiveDate.Format(constants.DateFormat)
+	payload, err := json.Marshal(salaryChangeApproval{
+		EffectiveDate: effectiveDate,
+		Amount:        change.NewSalary.Amount,
+		Currency:      change.NewSalary.Currency,
+		Reason:        change.Reason,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	request, err := u.approvals.Submit(ctx, &entity.ApprovalRequest{
+		Type:        entity.ApprovalTypeSalaryChange,
+		Reference:   fmt.Sprintf("employee:%d", employee.ID),
+		EmployeeID:  employee.ID,
+		RequestedBy: requestedBy,
+		Summary: fmt.Sprintf("Change the salary of %s from %s to %s effective %s: %s", employee.Name,
+			formatMoney(employee.Salary), formatMoney(change.NewSalary), effectiveDate, change.Reason),
+		Payload: payload,
+	})
+	if err != nil || request != nil {
+		return nil, request, err
+	}
+
+	// Without a workflow the caller's approver stands, as before approvals existed.
+	scheduledChange, err := u.ScheduleCompensationChange(ctx, change)
+	if err != nil {
+		return nil, nil, err
+	}
+	return scheduledChange, nil, nil
+}
+
+// formatMoney writes m in major units with its currency's decimals, e.g. 1234.50 USD.
+func formatMoney(m entity.Money) string {
+	exponent, _ := entity.CurrencyMinorUnits(m.Currency)
+	return fmt.Sprintf("%.*f %s", exponent, m.Major(), m.Currency)
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+	"github.com/mohamedfawas/employee_management_system/pkg/constants"
+)
+
+// terminationApproval is the payload of termination approval requests.
+type terminationApproval struct {
+	TerminationDate string `json:"termination_date"`
+	Reason          string `json:"reason"`
+}
+
+func (u *employeeUsecaseImpl) RequestTermination(ctx context.Context, employeeID int, terminationDate time.Time,
+	reason string, requestedBy *int) (*entity.Employee, *entity.ApprovalRequest, error) {
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, nil, appError.ErrInvalidTerminationReason
+	}
+
+	// The checks of TerminateEmployee run up front too, so a termination that could never be made
+	// is not sent around for approval.
+	employee, err := u.GetEmployeeById(ctx, employeeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if terminationDate.IsZero() || terminationDate.Before(employee.HiredDate) {
+		return nil, nil, appError.ErrInvalidTerminationDate
+	}
+	if !employee.Status.CanTransitionTo(entity.EmploymentStatusTerminated) {
+		return nil, nil, appError.ErrInvalidStatusTransition
+	}
+
+	held, err := u.heldAssets(ctx, employee.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	date := terminationDate.Format(constants.DateFormat)
+	summary := fmt.Sprintf("Terminate %s on %s: %s", employee.Name, date, reason)
+	if len(held) > 0 {
+		// Approvers see up front that the employee still has company assets to return.
+		summary += fmt.Sprintf(" (%d company asset(s) still to be returned)", len(held))
+	}
+	payload, err := json.Marshal(terminationApproval{TerminationDate: date, Reason: reason})
+	if err != nil {
+		return nil, nil, err
+	}
+	request, err := u.approvals.Submit(ctx, &entity.ApprovalRequest{
+		Type:        entity.ApprovalTypeTermination,
+		Reference:   fmt.Sprintf("employee:%d", employee.ID),
+		EmployeeID:  employee.ID,
+		RequestedBy: requestedBy,
+		Summary:     summary,
+		Payload:     payload,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if request != nil {
+		return employee, request, nil
+	}
+
+	terminatedEmployee, err := u.TerminateEmployee(ctx, employee.ID, terminationDate, reason)
+	if err != nil {
+		return nil, nil, err
+	}
+	return terminatedEmployee, nil, nil
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *recruitingUsecaseImpl) RescheduleInterview(ctx context.Context, interview *entity.Interview) (*entity.Interview, error) {
+	existing, err := u.getScheduledInterview(ctx, interview.ID)
+	if err != nil {
+		return nil, err
+	}
+	existing.InterviewerID = interview.InterviewerID
+	existing.ScheduledAt = interview.ScheduledAt
+	existing.DurationMinutes = interview.DurationMinutes
+	if err := u.validateInterviewSchedule(ctx, existing); err != nil {
+		return nil, err
+	}
+
+	updatedInterview, err := u.recruitingRepository.UpdateInterview(ctx, existing)
+	if err != nil {
+		return nil, err
+	}
+	if updatedInterview == nil {
+		return nil, appError.ErrInterviewNotFound
+	}
+	return updatedInterview, nil
+}
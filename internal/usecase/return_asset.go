@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *assetUsecaseImpl) ReturnAsset(ctx context.Context, assignment *entity.AssetAssignment,
+	status entity.AssetStatus) (*entity.AssetAssignment, error) {
+
+	status, err := validateReturnStatus(status)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.GetAssetById(ctx, assignment.AssetID); err != nil {
+		return nil, err
+	}
+	held, err := u.assetRepository.GetAssignments(ctx, entity.AssetAssignmentFilter{
+		AssetID:  assignment.AssetID,
+		OpenOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(held) == 0 {
+		return nil, appError.ErrAssetNotAssigned
+	}
+	openAssignment := held[0]
+
+	employee, err := u.employeeRepository.GetEmployeeById(ctx, openAssignment.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+	localToday, err := localDate(ctx, u.locationRepository, employee.LocationID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if assignment.ReturnedOn == nil {
+		assignment.ReturnedOn = &localToday
+	}
+	returnedOn := dateOnly(*assignment.ReturnedOn)
+	if returnedOn.After(localToday) || returnedOn.Before(openAssignment.AssignedOn) {
+		return nil, appError.ErrInvalidAssetAssignment
+	}
+	assignment.ReturnedOn = &returnedOn
+	assignment.ReturnNotes = strings.TrimSpace(assignment.ReturnNotes)
+
+	returnedAssignment, err := u.assetRepository.ReturnAsset(ctx, assignment, status)
+	if err != nil {
+		return nil, err
+	}
+	if returnedAssignment == nil {
+		return nil, appError.ErrAssetNotAssigned
+	}
+	return returnedAssignment, nil
+}
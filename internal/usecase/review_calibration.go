@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+// DepartmentCalibration summarises the ratings of one department's reviews in a cycle.
+type DepartmentCalibration struct {
+	DepartmentID         *int // nil for employees without a department
+	DepartmentName       string
+	Reviews              int
+	Completed            int      // completed or locked reviews
+	AverageSelfRating    *float64 // nil when no review has the rating yet
+	AveragePeerRating    *float64
+	AverageManagerRating *float64
+	// Distribution counts completed reviews by their manager rating rounded to the scale;
+	// Distribution[0] is rating 1.
+	Distribution []int
+}
+
+// ReviewCalibration is a cycle's ratings aggregated by department, used to compare how managers
+// rate before the reviews are locked.
+type ReviewCalibration struct {
+	ReviewCycleID int
+	RatingLabels  []string
+	Departments   []*DepartmentCalibration // ordered by name, employees without a department last
+	Total         *DepartmentCalibration   // all departments together
+}
+
+func (u *reviewUsecaseImpl) GetReviewCalibration(ctx context.Context, cycleID int) (*ReviewCalibration, error) {
+	cycle, err := u.GetReviewCycleById(ctx, cycleID)
+	if err != nil {
+		return nil, err
+	}
+	reviews, err := u.reviewRepository.GetReviews(ctx, cycleID)
+	if err != nil {
+		return nil, err
+	}
+
+	calibration := &ReviewCalibration{
+		ReviewCycleID: cycle.ID,
+		RatingLabels:  cycle.RatingLabels,
+		Departments:   []*DepartmentCalibration{},
+	}
+	byDepartment := map[int][]*entity.Review{}
+	names := map[int]string{}
+	for _, review := range reviews {
+		key := 0
+		if review.DepartmentID != nil {
+			key = *review.DepartmentID
+		}
+		byDepartment[key] = append(byDepartment[key], review)
+		names[key] = review.DepartmentName
+	}
+	for key, departmentReviews := range byDepartment {
+		department := calibrate(departmentReviews, cycle.MaxRating())
+		if key != 0 {
+			department.DepartmentID = &key
+			department.DepartmentName = names[key]
+		}
+		calibration.Departments = append(calibration.Departments, department)
+	}
+	sort.Slice(calibration.Departments, func(i, j int) bool {
+		a, b := calibration.Departments[i], calibration.Departments[j]
+		if (a.DepartmentID == nil) != (b.DepartmentID == nil) {
+			return b.DepartmentID == nil
+		}
+		return a.DepartmentName < b.DepartmentName
+	})
+	calibration.Total = calibrate(reviews, cycle.MaxRating())
+	return calibration, nil
+}
+
+// calibrate aggregates the ratings of reviews on a scale of 1 to maxRating.
+func calibrate(reviews []*entity.Review, maxRating int) *DepartmentCalibration {
+	department := &DepartmentCalibration{
+		Reviews:      len(reviews),
+		Distribution: make([]int, maxRating),
+	}
+	var self, peer, manager ratingAverage
+	for _, review := range reviews {
+		self.add(review.SelfRating)
+		peer.add(review.PeerRating)
+		manager.add(review.ManagerRating)
+
+		if review.Status == entity.ReviewStatusInProgress {
+			continue
+		}
+		department.Completed++
+		if review.ManagerRating != nil {
+			rating := int(math.Round(*review.ManagerRating))
+			rating = max(1, min(rating, maxRating))
+			department.Distribution[rating-1]++
+		}
+	}
+	department.AverageSelfRating = self.average()
+	department.AveragePeerRating = peer.average()
+	department.AverageManagerRating = manager.average()
+	return department
+}
+
+type ratingAverage struct {
+	sum   float64
+	count int
+}
+
+func (a *ratingAverage) add(rating *float64) {
+	if rating != nil {
+		a.sum += *rating
+		a.count++
+	}
+}
+
+// average is rounded to two decimals, or nil without any ratings.
+func (a *ratingAverage) average() *float64 {
+	if a.count == 0 {
+		return nil
+	}
+	average := math.Round(a.sum/float64(a.count)*100) / 100
+	return &average
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type ReviewUsecase interface {
+	CreateReviewCycle(ctx context.Context, cycle *entity.ReviewCycle) (*entity.ReviewCycle, error)
+	GetReviewCycleById(ctx context.Context, id int) (*entity.ReviewCycle, error)
+	GetAllReviewCycles(ctx context.Context) ([]*entity.ReviewCycle, error)
+	// UpdateReviewCycle replaces a draft cycle, including its questions.
+	UpdateReviewCycle(ctx context.Context, cycle *entity.ReviewCycle) (*entity.ReviewCycle, error)
+	DeleteReviewCycle(ctx context.Context, id int) error
+	// LaunchReviewCycle opens a review for every active or on-leave employee hired by the end of
+	// the cycle's period, asking them for a self review and their manager for a manager review.
+	LaunchReviewCycle(ctx context.Context, id int) (*entity.ReviewCycle, error)
+	// CloseReviewCycle ends an active cycle and locks its completed reviews. Reviews still in
+	// progress stay unfinished.
+	CloseReviewCycle(ctx context.Context, id int) (*entity.ReviewCycle, error)
+	GetReviews(ctx context.Context, cycleID int) ([]*entity.Review, error)
+	// GetReviewCalibration aggregates the ratings of a cycle's reviews by department.
+	GetReviewCalibration(ctx context.Context, cycleID int) (*ReviewCalibration, error)
+
+	GetReviewById(ctx context.Context, id int) (*entity.Review, error)
+	GetEmployeeReviews(ctx context.Context, employeeID int) ([]*entity.Review, error)
+	// AddPeerReviewers asks other employees for a peer review of an in-progress review.
+	AddPeerReviewers(ctx context.Context, reviewID int, reviewerIDs []int) (*entity.Review, error)
+	// SubmitReview records a reviewer's answers before the deadline of their stage. A manager
+	// review completes the review.
+	SubmitReview(ctx context.Context, submission *entity.ReviewSubmission) (*entity.Review, error)
+	// LockReview freezes a completed review, e.g. once it has been calibrated.
+	LockReview(ctx context.Context, id int) (*entity.Review, error)
+}
+
+type reviewUsecaseImpl struct {
+	reviewRepository   repository.ReviewRepository
+	employeeRepository repository.EmployeeRepository
+}
+
+func NewReviewUsecase(reviewRepository repository.ReviewRepository,
+	employeeRepository repository.EmployeeRepository) ReviewUsecase {
+	return &reviewUsecaseImpl{
+		reviewRepository:   reviewRepository,
+		employeeRepository: employeeRepository,
+	}
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const (
+	minRatingLabels    = 2
+	maxRatingLabels    = 10
+	maxReviewQuestions = 50
+)
+
+// validateReviewCycle checks a cycle's name, dates, rating scale and questions.
+func validateReviewCycle(cycle *entity.ReviewCycle) error {
+	cycle.Name = strings.TrimSpace(cycle.Name)
+	if len(cycle.Name) < 3 {
+		return appError.ErrInvalidReviewCycle
+	}
+
+	cycle.PeriodStart, cycle.PeriodEnd = dateOnly(cycle.PeriodStart), dateOnly(cycle.PeriodEnd)
+	cycle.SelfReviewDeadline = dateOnly(cycle.SelfReviewDeadline)
+	cycle.PeerReviewDeadline = dateOnly(cycle.PeerReviewDeadline)
+	cycle.ManagerReviewDeadline = dateOnly(cycle.ManagerReviewDeadline)
+	if cycle.PeriodEnd.Before(cycle.PeriodStart) ||
+		cycle.SelfReviewDeadline.Before(cycle.PeriodStart) ||
+		cycle.PeerReviewDeadline.Before(cycle.SelfReviewDeadline) ||
+		cycle.ManagerReviewDeadline.Before(cycle.PeerReviewDeadline) {
+		return appError.ErrInvalidReviewCycle
+	}
+
+	if len(cycle.RatingLabels) < minRatingLabels || len(cycle.RatingLabels) > maxRatingLabels {
+		return appError.ErrInvalidReviewCycle
+	}
+	for i, label := range cycle.RatingLabels {
+		cycle.RatingLabels[i] = strings.TrimSpace(label)
+		if cycle.RatingLabels[i] == "" {
+			return appError.ErrInvalidReviewCycle
+		}
+	}
+
+	if len(cycle.Questions) == 0 || len(cycle.Questions) > maxReviewQuestions {
+		return appError.ErrInvalidReviewCycle
+	}
+	for i := range cycle.Questions {
+		question := &cycle.Questions[i]
+		question.Text = strings.TrimSpace(question.Text)
+		if question.Text == "" || !question.Kind.IsValid() {
+			return appError.ErrInvalidReviewCycle
+		}
+	}
+	return nil
+}
+
+// validateReviewAnswers checks that every question of the cycle is answered exactly once, with a
+// rating on the cycle's scale for rating questions and a comment for text questions.
+func validateReviewAnswers(cycle *entity.ReviewCycle, answers []entity.ReviewAnswer) error {
+	questions := map[int]entity.ReviewQuestion{}
+	for _, question := range cycle.Questions {
+		questions[question.ID] = question
+	}
+	if len(answers) != len(questions) {
+		return appError.ErrInvalidReviewSubmission
+	}
+
+	answered := map[int]bool{}
+	for i := range answers {
+		answer := &answers[i]
+		question, ok := questions[answer.QuestionID]
+		if !ok || answered[answer.QuestionID] {
+			return appError.ErrInvalidReviewSubmission
+		}
+		answered[answer.QuestionID] = true
+
+		answer.Comment = strings.TrimSpace(answer.Comment)
+		switch question.Kind {
+		case entity.ReviewQuestionKindRating:
+			if answer.Rating == nil || *answer.Rating < 1 || *answer.Rating > cycle.MaxRating() {
+				return appError.ErrInvalidReviewSubmission
+			}
+		case entity.ReviewQuestionKindText:
+			if answer.Rating != nil || answer.Comment == "" {
+				return appError.ErrInvalidReviewSubmission
+			}
+		}
+	}
+	return nil
+}
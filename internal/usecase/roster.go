@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+// Roster assigns employees to a shift template on the selected weekdays from From to To
+// inclusive. No weekdays means every day.
+type Roster struct {
+	ShiftTemplateID int
+	EmployeeIDs     []int
+	From            time.Time
+	To              time.Time
+	Weekdays        []time.Weekday
+	// DryRun checks the roster for conflicts without creating its shifts.
+	DryRun bool
+}
+
+type ShiftConflictReason string
+
+const (
+	ShiftConflictNotEmployed ShiftConflictReason = "not_employed"
+	ShiftConflictOnLeave     ShiftConflictReason = "on_leave"
+	ShiftConflictOverlap     ShiftConflictReason = "overlapping_shift"
+	ShiftConflictRestPeriod  ShiftConflictReason = "insufficient_rest"
+)
+
+// ShiftConflict is a planned shift that cannot be rostered.
+type ShiftConflict struct {
+	EmployeeID int
+	Date       time.Time
+	Reason     ShiftConflictReason
+}
+
+// RosterResult holds the shifts of a roster, created unless it was a dry run, or the conflicts
+// that prevented creating them.
+type RosterResult struct {
+	Shifts    []*entity.Shift
+	Conflicts []ShiftConflict
+}
+
+// includes reports whether the roster plans a shift on date.
+func (r Roster) includes(date time.Time) bool {
+	if len(r.Weekdays) == 0 {
+		return true
+	}
+	for _, weekday := range r.Weekdays {
+		if date.Weekday() == weekday {
+			return true
+		}
+	}
+	return false
+}
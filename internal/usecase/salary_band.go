@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+// SalaryBandCheck compares a salary with a position's band. All amounts are annualized and
+// expressed in the band's currency so that monthly, annual and hourly pay can be compared.
+type SalaryBandCheck struct {
+	AnnualSalary entity.Money
+	AnnualMin    entity.Money
+	AnnualMax    entity.Money
+}
+
+func (c SalaryBandCheck) IsBelow() bool {
+	return c.AnnualSalary.Amount < c.AnnualMin.Amount
+}
+
+func (c SalaryBandCheck) IsAbove() bool {
+	return c.AnnualSalary.Amount > c.AnnualMax.Amount
+}
+
+func (c SalaryBandCheck) InBand() bool {
+	return !c.IsBelow() && !c.IsAbove()
+}
+
+// OutOfBandEmployee is an employee whose current salary lies outside their position's band.
+type OutOfBandEmployee struct {
+	Employee *entity.Employee
+	Position *entity.Position
+	Check    SalaryBandCheck
+}
+
+// salaryBandChecker evaluates salaries against position bands, converting currencies with the
+// stored exchange rates when the salary and the band differ.
+type salaryBandChecker struct {
+	exchangeRateUsecase ExchangeRateUsecase
+}
+
+// check returns nil when the position has no band.
+func (c salaryBandChecker) check(ctx context.Context, salary entity.Money, payPeriod entity.PayPeriod,
+	position *entity.Position, asOf time.Time) (*SalaryBandCheck, error) {
+
+	if !position.HasBand() {
+		return nil, nil
+	}
+
+	annualSalary := entity.Money{
+		Amount:   salary.Amount * payPeriod.PeriodsPerYear(),
+		Currency: salary.Currency,
+	}
+	bandCurrency := position.MinSalary.Currency
+	if annualSalary.Currency != bandCurrency {
+		converted, _, _, err := c.exchangeRateUsecase.ConvertMoney(ctx, annualSalary, bandCurrency, asOf)
+		if err != nil {
+			return nil, err
+		}
+		annualSalary = converted
+	}
+
+	periods := position.PayPeriod.PeriodsPerYear()
+	return &SalaryBandCheck{
+		AnnualSalary: annualSalary,
+		AnnualMin:    entity.Money{Amount: position.MinSalary.Amount * periods, Currency: bandCurrency},
+		AnnualMax:    entity.Money{Amount: position.MaxSalary.Amount * periods, Currency: bandCurrency},
+	}, nil
+}
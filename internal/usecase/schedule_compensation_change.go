@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *compensationUsecaseImpl) ScheduleCompensationChange(ctx context.Context,
+	change *entity.CompensationChange) (*entity.CompensationChange, error) {
+
+	if err := validateCompensationChange(change); err != nil {
+		return nil, err
+	}
+	change.ApprovedBy = strings.TrimSpace(change.ApprovedBy)
+	if change.ApprovedBy == "" {
+		return nil, appError.ErrInvalidApprover
+	}
+
+	employee, err := u.compensationChangeEmployee(ctx, change)
+	if err != nil {
+		return nil, err
+	}
+	outOfBand, err := u.checkSalaryBand(ctx, employee, change.NewSalary)
+	if err != nil {
+		return nil, err
+	}
+
+	today, err := localDate(ctx, u.locationRepository, employee.LocationID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if change.EffectiveDate.After(today) {
+		change.OldSalary = employee.Salary
+		return u.compensationRepository.CreateScheduledChange(ctx, change)
+	}
+
+	appliedChange, err := u.compensationRepository.ApplyChange(ctx, change, outOfBand)
+	if err != nil {
+		return nil, err
+	}
+	if appliedChange == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+
+	invalidateEmployeesListCache(ctx, u.cache)
+	return appliedChange, nil
+}
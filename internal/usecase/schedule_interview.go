@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func (u *recruitingUsecaseImpl) ScheduleInterview(ctx context.Context, interview *entity.Interview) (*entity.Interview, error) {
+	candidate, err := u.getOpenCandidate(ctx, interview.CandidateID)
+	if err != nil {
+		return nil, err
+	}
+	interview.ID = 0
+	if err := u.validateInterviewSchedule(ctx, interview); err != nil {
+		return nil, err
+	}
+
+	interview.Status = entity.InterviewScheduled
+	createdInterview, err := u.recruitingRepository.CreateInterview(ctx, interview)
+	if err != nil {
+		return nil, err
+	}
+
+	if candidate.Stage == entity.CandidateApplied || candidate.Stage == entity.CandidateScreening {
+		// A candidate moved on concurrently keeps their stage; the interview stands either way.
+		from := candidate.Stage
+		candidate.Stage = entity.CandidateInterview
+		if _, err := u.recruitingRepository.UpdateCandidateStage(ctx, candidate, from); err != nil {
+			return nil, err
+		}
+	}
+	return createdInterview, nil
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+
+	domaincache "github.com/mohamedfawas/employee_management_system/internal/domain/cache"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/notification"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type SegmentUsecase interface {
+	GetEmployeeTags(ctx context.Context, employeeID int) ([]string, error)
+	// AddEmployeeTags tags an employee and returns all their tags. Tags are trimmed and lower-cased;
+	// those the employee already has are skipped.
+	AddEmployeeTags(ctx context.Context, employeeID int, tags []string) ([]string, error)
+	RemoveEmployeeTag(ctx context.Context, employeeID int, tag string) error
+	GetAllTags(ctx context.Context) ([]*entity.TagCount, error)
+	// DeleteTag removes a tag from every employee.
+	DeleteTag(ctx context.Context, tag string) error
+
+	CreateSegment(ctx context.Context, segment *entity.EmployeeSegment) (*entity.EmployeeSegment, error)
+	GetSegmentById(ctx context.Context, id int) (*entity.EmployeeSegment, error)
+	GetAllSegments(ctx context.Context) ([]*entity.EmployeeSegment, error)
+	UpdateSegment(ctx context.Context, segment *entity.EmployeeSegment) (*entity.EmployeeSegment, error)
+	DeleteSegment(ctx context.Context, id int) error
+
+	// GetSegmentMembers evaluates a segment, returning the employees matching its filter now.
+	// Actions targeting a segment act on these employees.
+	GetSegmentMembers(ctx context.Context, id int) ([]*entity.Employee, error)
+	// TagSegmentMembers tags every current member of a segment and returns how many tags were
+	// added.
+	TagSegmentMembers(ctx context.Context, id int, tags []string) (int, error)
+	// AnnounceToSegment sends an announcement to the work email of every current member of a
+	// segment and returns how many members it went to. Members without a work email are skipped.
+	AnnounceToSegment(ctx context.Context, id int, subject, body string) (int, error)
+}
+
+type segmentUsecaseImpl struct {
+	segmentRepository     repository.SegmentRepository
+	employeeRepository    repository.EmployeeRepository
+	customFieldRepository repository.CustomFieldRepository
+	notifier              notification.Notifier
+	cache                 domaincache.Cache
+}
+
+func NewSegmentUsecase(segmentRepository repository.SegmentRepository, employeeRepository repository.EmployeeRepository,
+	customFieldRepository repository.CustomFieldRepository, notifier notification.Notifier,
+	cache domaincache.Cache) SegmentUsecase {
+	return &segmentUsecaseImpl{
+		segmentRepository:     segmentRepository,
+		employeeRepository:    employeeRepository,
+		customFieldRepository: customFieldRepository,
+		notifier:              notifier,
+		cache:                 cache,
+	}
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const maxTagLength = 50
+
+// normalizeTags trims and lower-cases tags and drops duplicates. Commas are rejected because the
+// employee list takes tags as a comma separated list.
+func normalizeTags(tags []string) ([]string, error) {
+	normalized := []string{}
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.Join(strings.Fields(tag), " "))
+		if tag == "" || utf8.RuneCountInString(tag) > maxTagLength || strings.Contains(tag, ",") {
+			return nil, appError.ErrInvalidTag
+		}
+		if !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized, nil
+}
+
+// validateSegment checks a segment's filter the way the employee list would, so that saved
+// segments can be evaluated. Custom field filters are kept as given and parsed on evaluation.
+func (u *segmentUsecaseImpl) validateSegment(ctx context.Context, segment *entity.EmployeeSegment) error {
+	segment.Name = strings.TrimSpace(segment.Name)
+	segment.Description = strings.TrimSpace(segment.Description)
+	if segment.Name == "" {
+		return appError.ErrInvalidSegment
+	}
+	if segment.CreatedBy != nil && *segment.CreatedBy <= 0 {
+		return appError.ErrInvalidSegment
+	}
+
+	filter := &segment.Filter
+	for _, status := range filter.Statuses {
+		if !status.IsValid() {
+			return appError.ErrInvalidSegment
+		}
+	}
+	if filter.ManagerID < 0 || filter.LocationID < 0 {
+		return appError.ErrInvalidSegment
+	}
+	tags, err := normalizeTags(filter.Tags)
+	if err != nil {
+		return err
+	}
+	filter.Tags = tags
+
+	if len(filter.CustomFields) > 0 {
+		definitions, err := u.customFieldRepository.GetAllDefinitions(ctx)
+		if err != nil {
+			return err
+		}
+		if _, err := parseCustomFieldFilter(definitions, filter.CustomFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
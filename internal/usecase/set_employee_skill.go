@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *skillUsecaseImpl) SetEmployeeSkill(ctx context.Context, employeeSkill *entity.EmployeeSkill) (*entity.EmployeeSkill, error) {
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, employeeSkill.EmployeeID); err != nil {
+		return nil, err
+	}
+	if employeeSkill.SkillID <= 0 {
+		return nil, appError.ErrInvalidSkillId
+	}
+	if err := validateEmployeeSkill(employeeSkill); err != nil {
+		return nil, err
+	}
+	return u.skillRepository.SetEmployeeSkill(ctx, employeeSkill)
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type ShiftUsecase interface {
+	CreateShiftTemplate(ctx context.Context, template *entity.ShiftTemplate) (*entity.ShiftTemplate, error)
+	GetShiftTemplateById(ctx context.Context, id int) (*entity.ShiftTemplate, error)
+	GetAllShiftTemplates(ctx context.Context) ([]*entity.ShiftTemplate, error)
+	// UpdateShiftTemplate changes a template; shifts already rostered keep their times.
+	UpdateShiftTemplate(ctx context.Context, template *entity.ShiftTemplate) (*entity.ShiftTemplate, error)
+	DeleteShiftTemplate(ctx context.Context, id int) error
+
+	// CreateRoster plans the roster's shifts. When any of them conflicts with approved leave,
+	// another shift or the minimum rest period, none are created and the result lists the
+	// conflicts instead.
+	CreateRoster(ctx context.Context, roster *Roster) (*RosterResult, error)
+	// GetShifts lists the employee's shifts starting from one date to another inclusive.
+	GetShifts(ctx context.Context, employeeID int, from, to time.Time) ([]*entity.Shift, error)
+	DeleteShift(ctx context.Context, employeeID, id int) error
+	// GetUpcomingShifts lists the employee's shifts that have not ended yet, up to the feed horizon.
+	GetUpcomingShifts(ctx context.Context, employeeID int) (*ShiftFeed, error)
+}
+
+// ShiftFeed is the upcoming shifts of an employee, with the time zone of their location to show
+// them in.
+type ShiftFeed struct {
+	Shifts   []*entity.Shift
+	TimeZone *time.Location
+}
+
+type shiftUsecaseImpl struct {
+	shiftRepository    repository.ShiftRepository
+	employeeRepository repository.EmployeeRepository
+	leaveRepository    repository.LeaveRepository
+	locationRepository repository.LocationRepository
+	minRest            time.Duration
+	feedHorizon        time.Duration
+}
+
+func NewShiftUsecase(shiftRepository repository.ShiftRepository, employeeRepository repository.EmployeeRepository,
+	leaveRepository repository.LeaveRepository, locationRepository repository.LocationRepository,
+	minRest, feedHorizon time.Duration) ShiftUsecase {
+	return &shiftUsecaseImpl{
+		shiftRepository:    shiftRepository,
+		employeeRepository: employeeRepository,
+		leaveRepository:    leaveRepository,
+		locationRepository: locationRepository,
+		minRest:            minRest,
+		feedHorizon:        feedHorizon,
+	}
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// maxRosterDays bounds the date range of a single roster.
+const maxRosterDays = 366
+
+func validateShiftTemplate(template *entity.ShiftTemplate) error {
+	template.Name = strings.TrimSpace(template.Name)
+	if len(template.Name) < 3 {
+		return appError.ErrInvalidShiftTemplate
+	}
+	if !template.StartTime.IsValid() || !template.EndTime.IsValid() {
+		return appError.ErrInvalidShiftTemplate
+	}
+	if template.BreakMinutes < 0 || time.Duration(template.BreakMinutes)*time.Minute >= template.Duration() {
+		return appError.ErrInvalidShiftTemplate
+	}
+	return nil
+}
+
+// validateRoster normalizes the roster's dates and drops repeated employees and weekdays.
+func validateRoster(roster *Roster) error {
+	if roster.ShiftTemplateID <= 0 {
+		return appError.ErrInvalidShiftTemplateId
+	}
+	if len(roster.EmployeeIDs) == 0 {
+		return appError.ErrInvalidRoster
+	}
+	if roster.From.IsZero() || roster.To.IsZero() {
+		return appError.ErrInvalidDateRange
+	}
+	roster.From, roster.To = dateOnly(roster.From), dateOnly(roster.To)
+	if roster.To.Before(roster.From) {
+		return appError.ErrInvalidDateRange
+	}
+	if roster.To.Sub(roster.From) >= maxRosterDays*24*time.Hour {
+		return appError.ErrInvalidRoster
+	}
+
+	employeeIDs := []int{}
+	seenEmployees := map[int]bool{}
+	for _, employeeID := range roster.EmployeeIDs {
+		if employeeID <= 0 {
+			return appError.ErrInvalidEmployeeId
+		}
+		if !seenEmployees[employeeID] {
+			seenEmployees[employeeID] = true
+			employeeIDs = append(employeeIDs, employeeID)
+		}
+	}
+	roster.EmployeeIDs = employeeIDs
+
+	weekdays := []time.Weekday{}
+	seenWeekdays := map[time.Weekday]bool{}
+	for _, weekday := range roster.Weekdays {
+		if weekday < time.Sunday || weekday > time.Saturday {
+			return appError.ErrInvalidRoster
+		}
+		if !seenWeekdays[weekday] {
+			seenWeekdays[weekday] = true
+			weekdays = append(weekdays, weekday)
+		}
+	}
+	roster.Weekdays = weekdays
+	return nil
+}
+
+// employeeZone returns the time zone of the employee's location, which shift times and dates
+// follow.
+func (u *shiftUsecaseImpl) employeeZone(ctx context.Context, employeeID int) (*time.Location, error) {
+	if employeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	employee, err := u.employeeRepository.GetEmployeeById(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+	return locationZone(ctx, u.locationRepository, employee.LocationID)
+}
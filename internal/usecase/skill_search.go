@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+const (
+	maxSkillRequirements   = 20
+	defaultSkillMatchLimit = 20
+	maxSkillMatchLimit     = 100
+)
+
+// skillSearchStatuses are the employment statuses of employees returned by a skill search.
+var skillSearchStatuses = []entity.EmploymentStatus{
+	entity.EmploymentStatusActive,
+	entity.EmploymentStatusOnLeave,
+}
+
+// SkillSearch asks for employees with a set of skills.
+type SkillSearch struct {
+	Requirements []entity.SkillRequirement
+	// VerifiedSince ignores levels last verified before this date, or never verified, when set.
+	VerifiedSince *time.Time
+	// FullMatchOnly leaves out employees who miss any requirement.
+	FullMatchOnly bool
+	Limit         int // defaults to 20, at most 100
+}
+
+// SkillMatch is an employee found by a skill search.
+type SkillMatch struct {
+	EmployeeID   int
+	EmployeeName string
+	Position     string
+	Matched      int // requirements met at or above their minimum level
+	Required     int
+	// Score is the average of how far each requirement is met as a percentage: a level below
+	// the minimum counts in proportion, a missing skill counts zero.
+	Score  float64
+	Skills []SkillMatchDetail // one per requirement, in the order asked
+
+	surplus int // levels above the minimums, breaks ties between equal scores
+}
+
+// SkillMatchDetail is how an employee meets one requirement.
+type SkillMatchDetail struct {
+	SkillID        int
+	SkillName      string
+	MinLevel       entity.ProficiencyLevel
+	Level          entity.ProficiencyLevel // zero when the employee lacks the skill
+	LastVerifiedOn *time.Time
+	Met            bool
+}
+
+func (u *skillUsecaseImpl) SearchEmployeesBySkills(ctx context.Context, search SkillSearch) ([]*SkillMatch, error) {
+	if err := validateSkillSearch(&search); err != nil {
+		return nil, err
+	}
+
+	skills, err := u.skillRepository.GetAllSkills(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	skillNames := map[int]string{}
+	for _, skill := range skills {
+		skillNames[skill.ID] = skill.Name
+	}
+	skillIDs := make([]int, 0, len(search.Requirements))
+	for _, requirement := range search.Requirements {
+		if _, ok := skillNames[requirement.SkillID]; !ok {
+			return nil, appError.ErrSkillNotFound
+		}
+		skillIDs = append(skillIDs, requirement.SkillID)
+	}
+
+	holders, err := u.skillRepository.GetSkillHolders(ctx, skillIDs, skillSearchStatuses)
+	if err != nil {
+		return nil, err
+	}
+	byEmployee := map[int]map[int]*entity.EmployeeSkill{}
+	matches := []*SkillMatch{}
+	for _, holder := range holders {
+		if search.VerifiedSince != nil &&
+			(holder.LastVerifiedOn == nil || holder.LastVerifiedOn.Before(*search.VerifiedSince)) {
+			continue
+		}
+		if byEmployee[holder.EmployeeID] == nil {
+			byEmployee[holder.EmployeeID] = map[int]*entity.EmployeeSkill{}
+			matches = append(matches, &SkillMatch{
+				EmployeeID:   holder.EmployeeID,
+				EmployeeName: holder.EmployeeName,
+				Position:     holder.Position,
+				Required:     len(search.Requirements),
+			})
+		}
+		byEmployee[holder.EmployeeID][holder.SkillID] = holder
+	}
+
+	ranked := []*SkillMatch{}
+	for _, match := range matches {
+		scoreSkillMatch(match, search.Requirements, byEmployee[match.EmployeeID], skillNames)
+		if search.FullMatchOnly && match.Matched < match.Required {
+			continue
+		}
+		ranked = append(ranked, match)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if a.Matched != b.Matched {
+			return a.Matched > b.Matched
+		}
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.surplus != b.surplus {
+			return a.surplus > b.surplus
+		}
+		if a.EmployeeName != b.EmployeeName {
+			return a.EmployeeName < b.EmployeeName
+		}
+		return a.EmployeeID < b.EmployeeID
+	})
+	if len(ranked) > search.Limit {
+		ranked = ranked[:search.Limit]
+	}
+	return ranked, nil
+}
+
+func validateSkillSearch(search *SkillSearch) error {
+	if len(search.Requirements) == 0 || len(search.Requirements) > maxSkillRequirements {
+		return appError.ErrInvalidSkillSearch
+	}
+	seen := map[int]bool{}
+	for _, requirement := range search.Requirements {
+		if requirement.SkillID <= 0 || seen[requirement.SkillID] || !requirement.MinLevel.IsValid() {
+			return appError.ErrInvalidSkillSearch
+		}
+		seen[requirement.SkillID] = true
+	}
+	if search.VerifiedSince != nil {
+		verifiedSince := dateOnly(*search.VerifiedSince)
+		search.VerifiedSince = &verifiedSince
+	}
+	if search.Limit <= 0 {
+		search.Limit = defaultSkillMatchLimit
+	}
+	search.Limit = min(search.Limit, maxSkillMatchLimit)
+	return nil
+}
+
+// scoreSkillMatch fills in how the employee's skills meet each requirement.
+func scoreSkillMatch(match *SkillMatch, requirements []entity.SkillRequirement,
+	held map[int]*entity.EmployeeSkill, skillNames map[int]string) {
+
+	credit := 0.0
+	for _, requirement := range requirements {
+		detail := SkillMatchDetail{
+			SkillID:   requirement.SkillID,
+			SkillName: skillNames[requirement.SkillID],
+			MinLevel:  requirement.MinLevel,
+		}
+		if skill, ok := held[requirement.SkillID]; ok {
+			detail.Level = skill.Level
+			detail.LastVerifiedOn = skill.LastVerifiedOn
+			detail.Met = skill.Level >= requirement.MinLevel
+			credit += math.Min(float64(skill.Level)/float64(requirement.MinLevel), 1)
+		}
+		if detail.Met {
+			match.Matched++
+			match.surplus += int(detail.Level - requirement.MinLevel)
+		}
+		match.Skills = append(match.Skills, detail)
+	}
+	match.Score = math.Round(credit/float64(len(requirements))*100*100) / 100
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type SkillUsecase interface {
+	CreateSkillCategory(ctx context.Context, category *entity.SkillCategory) (*entity.SkillCategory, error)
+	GetAllSkillCategories(ctx context.Context) ([]*entity.SkillCategory, error)
+	UpdateSkillCategory(ctx context.Context, category *entity.SkillCategory) (*entity.SkillCategory, error)
+	DeleteSkillCategory(ctx context.Context, id int) error
+
+	CreateSkill(ctx context.Context, skill *entity.Skill) (*entity.Skill, error)
+	GetSkillById(ctx context.Context, id int) (*entity.Skill, error)
+	// GetAllSkills lists the skills, only those of categoryID when it is positive.
+	GetAllSkills(ctx context.Context, categoryID int) ([]*entity.Skill, error)
+	UpdateSkill(ctx context.Context, skill *entity.Skill) (*entity.Skill, error)
+	DeleteSkill(ctx context.Context, id int) error
+
+	// SetEmployeeSkill records the employee's level in a skill, replacing any earlier level.
+	SetEmployeeSkill(ctx context.Context, employeeSkill *entity.EmployeeSkill) (*entity.EmployeeSkill, error)
+	GetEmployeeSkills(ctx context.Context, employeeID int) ([]*entity.EmployeeSkill, error)
+	DeleteEmployeeSkill(ctx context.Context, employeeID, skillID int) error
+
+	// SearchEmployeesBySkills finds active and on-leave employees holding any of the required
+	// skills, best matches first.
+	SearchEmployeesBySkills(ctx context.Context, search SkillSearch) ([]*SkillMatch, error)
+}
+
+type skillUsecaseImpl struct {
+	skillRepository    repository.SkillRepository
+	employeeRepository repository.EmployeeRepository
+}
+
+func NewSkillUsecase(skillRepository repository.SkillRepository,
+	employeeRepository repository.EmployeeRepository) SkillUsecase {
+	return &skillUsecaseImpl{
+		skillRepository:    skillRepository,
+		employeeRepository: employeeRepository,
+	}
+}
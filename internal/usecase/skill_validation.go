@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func validateSkillCategory(category *entity.SkillCategory) error {
+	category.Name = strings.TrimSpace(category.Name)
+	if len(category.Name) < 2 {
+		return appError.ErrInvalidSkillCategory
+	}
+	return nil
+}
+
+func validateSkill(skill *entity.Skill) error {
+	skill.Name = strings.TrimSpace(skill.Name)
+	skill.Description = strings.TrimSpace(skill.Description)
+	// Short names such as Go or R are real skills.
+	if skill.Name == "" || skill.CategoryID <= 0 {
+		return appError.ErrInvalidSkill
+	}
+	return nil
+}
+
+func validateEmployeeSkill(employeeSkill *entity.EmployeeSkill) error {
+	if !employeeSkill.Level.IsValid() {
+		return appError.ErrInvalidProficiencyLevel
+	}
+	if employeeSkill.LastVerifiedOn != nil {
+		verifiedOn := dateOnly(*employeeSkill.LastVerifiedOn)
+		if verifiedOn.After(today()) {
+			return appError.ErrInvalidProficiencyLevel
+		}
+		employeeSkill.LastVerifiedOn = &verifiedOn
+	}
+	return nil
+}
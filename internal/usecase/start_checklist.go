@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *checklistUsecaseImpl) StartChecklist(ctx context.Context, employeeID int,
+	kind entity.ChecklistKind) (*entity.Checklist, error) {
+
+	if employeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	if !kind.IsValid() {
+		return nil, appError.ErrInvalidChecklistKind
+	}
+	employee, err := u.employeeRepository.GetEmployeeById(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+	startDate, err := checklistStartDate(employee, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := u.checklistRepository.FindTemplate(ctx, kind, employee.PositionID, employee.DepartmentID)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		return nil, appError.ErrChecklistTemplateNotFound
+	}
+
+	checklist := &entity.Checklist{
+		EmployeeID: employee.ID,
+		TemplateID: &template.ID,
+		Kind:       kind,
+		Name:       template.Name,
+		StartDate:  startDate,
+	}
+	for _, templateTask := range template.Tasks {
+		checklist.Tasks = append(checklist.Tasks, entity.ChecklistTask{
+			Title:       templateTask.Title,
+			Description: templateTask.Description,
+			OwnerID:     checklistTaskOwner(templateTask, employee),
+			DueOn:       startDate.AddDate(0, 0, templateTask.DueDays),
+		})
+	}
+	return u.checklistRepository.CreateChecklist(ctx, checklist)
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *approvalUsecaseImpl) Submit(ctx context.Context, request *entity.ApprovalRequest) (*entity.ApprovalRequest, error) {
+	if request.EmployeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	if request.RequestedBy != nil {
+		if err := ensureEmployeeExists(ctx, u.employeeRepository, *request.RequestedBy); err != nil {
+			return nil, err
+		}
+	}
+
+	workflow, err := u.approvalRepository.GetWorkflowByType(ctx, request.Type)
+	if err != nil || workflow == nil {
+		return nil, err
+	}
+	employee, err := u.employeeRepository.GetEmployeeById(ctx, request.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+
+	tasks, firstStage := approvalTasks(workflow, employee)
+	if firstStage == 0 {
+		return nil, nil
+	}
+	request.Summary = strings.TrimSpace(request.Summary)
+	request.Status = entity.ApprovalPending
+	request.CurrentStage = firstStage
+	request.Tasks = tasks
+	return u.approvalRepository.CreateRequest(ctx, request)
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// expenseApproval is the payload of expense approval requests.
+type expenseApproval struct {
+	ClaimID int `json:"claim_id"`
+}
+
+func (u *expenseUsecaseImpl) SubmitClaim(ctx context.Context, employeeID, id int) (*entity.ExpenseClaim, error) {
+	claim, err := u.GetClaimById(ctx, employeeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !claim.Status.IsEditable() {
+		return nil, appError.ErrExpenseClaimNotEditable
+	}
+	if err := u.checkExpenseCategories(ctx, claim, true); err != nil {
+		return nil, err
+	}
+
+	// The claim is submitted before the approval request exists, so it cannot be changed while the
+	// request is being started.
+	from := claim.Status
+	submittedAt := time.Now().UTC()
+	claim.Status = entity.ExpenseClaimSubmitted
+	claim.SubmittedAt = &submittedAt
+	claim.ApprovalRequestID = nil
+	claim.DecidedBy = nil
+	claim.DecidedAt = nil
+	claim.DecisionComment = ""
+	submittedClaim, err := u.expenseRepository.UpdateClaimStatus(ctx, claim, from)
+	if err != nil {
+		return nil, err
+	}
+	if submittedClaim == nil {
+		return nil, appError.ErrExpenseClaimNotEditable
+	}
+
+	request, err := u.submitForApproval(ctx, submittedClaim)
+	if err != nil {
+		u.returnToDraft(ctx, submittedClaim)
+		return nil, err
+	}
+	if request == nil {
+		return submittedClaim, nil
+	}
+
+	submittedClaim.ApprovalRequestID = &request.ID
+	approvalClaim, err := u.expenseRepository.UpdateClaimStatus(ctx, submittedClaim, entity.ExpenseClaimSubmitted)
+	if err != nil {
+		return nil, err
+	}
+	if approvalClaim == nil {
+		// A workflow without pending steps may already have decided the claim.
+		return u.GetClaimById(ctx, employeeID, id)
+	}
+	return approvalClaim, nil
+}
+
+// submitForApproval starts the expense approval workflow for a submitted claim. It returns nil when
+// there is no workflow and the manager decides the claim.
+func (u *expenseUsecaseImpl) submitForApproval(ctx context.Context, claim *entity.ExpenseClaim) (*entity.ApprovalRequest, error) {
+	payload, err := json.Marshal(expenseApproval{ClaimID: claim.ID})
+	if err != nil {
+		return nil, err
+	}
+	return u.approvals.Submit(ctx, &entity.ApprovalRequest{
+		Type:        entity.ApprovalTypeExpense,
+		Reference:   fmt.Sprintf("expense:%d", claim.ID),
+		EmployeeID:  claim.EmployeeID,
+		RequestedBy: &claim.EmployeeID,
+		Summary:     fmt.Sprintf("Reimburse %s to %s for %s", formatMoney(claim.Total), claim.EmployeeName, claim.Title),
+		Payload:     payload,
+	})
+}
+
+// returnToDraft takes a submitted claim back to draft, after its approval request could not be
+// started or was cancelled.
+func (u *expenseUsecaseImpl) returnToDraft(ctx context.Context, claim *entity.ExpenseClaim) {
+	claim.Status = entity.ExpenseClaimDraft
+	claim.SubmittedAt = nil
+	claim.ApprovalRequestID = nil
+	if _, err := u.expenseRepository.UpdateClaimStatus(ctx, claim, entity.ExpenseClaimSubmitted); err != nil {
+		log.Printf("[EXPENSES] failed to return claim %d to draft: %v", claim.ID, err)
+	}
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *recruitingUsecaseImpl) SubmitInterviewFeedback(ctx context.Context,
+	feedback *entity.Interview) (*entity.Interview, error) {
+
+	if err := validateInterviewFeedback(feedback); err != nil {
+		return nil, err
+	}
+	interview, err := u.getScheduledInterview(ctx, feedback.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	interview.Status = entity.InterviewCompleted
+	interview.Rating = feedback.Rating
+	interview.Recommendation = feedback.Recommendation
+	interview.Feedback = feedback.Feedback
+	interview.FeedbackAt = &now
+	completedInterview, err := u.recruitingRepository.UpdateInterview(ctx, interview)
+	if err != nil {
+		return nil, err
+	}
+	if completedInterview == nil {
+		return nil, appError.ErrInterviewNotFound
+	}
+	return completedInterview, nil
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *reviewUsecaseImpl) SubmitReview(ctx context.Context, submission *entity.ReviewSubmission) (*entity.Review, error) {
+	review, err := u.GetReviewById(ctx, submission.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	cycle, err := u.GetReviewCycleById(ctx, review.ReviewCycleID)
+	if err != nil {
+		return nil, err
+	}
+	if cycle.Status != entity.ReviewCycleStatusActive {
+		return nil, appError.ErrReviewCycleNotActive
+	}
+	if review.Status != entity.ReviewStatusInProgress {
+		return nil, appError.ErrReviewNotInProgress
+	}
+
+	if !submission.Role.IsValid() || submission.ReviewerID <= 0 {
+		return nil, appError.ErrInvalidReviewSubmission
+	}
+	if err := u.validateReviewer(ctx, review, submission); err != nil {
+		return nil, err
+	}
+	if today().After(cycle.Deadline(submission.Role)) {
+		return nil, appError.ErrReviewStageClosed
+	}
+	if err := validateReviewAnswers(cycle, submission.Answers); err != nil {
+		return nil, err
+	}
+
+	submitted, err := u.reviewRepository.SubmitReview(ctx, submission)
+	if err != nil {
+		return nil, err
+	}
+	if !submitted {
+		review, err = u.GetReviewById(ctx, submission.ReviewID)
+		if err != nil {
+			return nil, err
+		}
+		if review.Status != entity.ReviewStatusInProgress {
+			// The manager completed the review concurrently.
+			return nil, appError.ErrReviewNotInProgress
+		}
+		return nil, appError.ErrReviewAlreadySubmitted
+	}
+	return u.GetReviewById(ctx, submission.ReviewID)
+}
+
+// validateReviewer checks that the reviewer may review in their role: employees review
+// themselves, their manager when the cycle was launched reviews them as manager, and peers must
+// have been asked first. Employees without a manager can be reviewed as manager by anyone else.
+func (u *reviewUsecaseImpl) validateReviewer(ctx context.Context, review *entity.Review,
+	submission *entity.ReviewSubmission) error {
+
+	switch submission.Role {
+	case entity.ReviewerRoleSelf:
+		if submission.ReviewerID != review.EmployeeID {
+			return appError.ErrReviewerNotAllowed
+		}
+	case entity.ReviewerRoleManager:
+		if review.ManagerID != nil {
+			if *review.ManagerID != submission.ReviewerID {
+				return appError.ErrReviewerNotAllowed
+			}
+			return nil
+		}
+		if submission.ReviewerID == review.EmployeeID {
+			return appError.ErrReviewerNotAllowed
+		}
+		return ensureEmployeeExists(ctx, u.employeeRepository, submission.ReviewerID)
+	case entity.ReviewerRolePeer:
+		for _, existing := range review.Submissions {
+			if existing.Role == entity.ReviewerRolePeer && existing.ReviewerID == submission.ReviewerID {
+				return nil
+			}
+		}
+		return appError.ErrReviewerNotAllowed
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// SubmitTimesheet submits a week for approval. Open shifts must be closed first; days without
+// punches are reported on the timesheet but do not block it.
+func (u *attendanceUsecaseImpl) SubmitTimesheet(ctx context.Context, employeeID int, week time.Time) (*WeeklyTimesheet, error) {
+	employee, err := u.getEmployeeForAttendance(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	zone, err := u.employeeZone(ctx, employee)
+	if err != nil {
+		return nil, err
+	}
+	weekStart, err := parseTimesheetWeek(week, dateIn(time.Now(), zone))
+	if err != nil {
+		return nil, err
+	}
+
+	timesheet, err := u.buildWeeklyTimesheet(ctx, employee, zone, weekStart)
+	if err != nil {
+		return nil, err
+	}
+	if timesheet.Submission != nil && timesheet.Submission.IsLocked() {
+		return nil, appError.ErrTimesheetLocked
+	}
+	if timesheet.OpenEntries > 0 {
+		return nil, appError.ErrTimesheetIncomplete
+	}
+
+	submission, err := u.attendanceRepository.SubmitTimesheet(ctx, &entity.Timesheet{
+		EmployeeID:      employeeID,
+		WeekStart:       weekStart,
+		TotalMinutes:    timesheet.TotalMinutes,
+		RegularMinutes:  timesheet.RegularMinutes,
+		OvertimeMinutes: timesheet.OvertimeMinutes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if submission == nil {
+		return nil, appError.ErrTimesheetLocked
+	}
+	timesheet.Submission = submission
+	return timesheet, nil
+}
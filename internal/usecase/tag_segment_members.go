@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *segmentUsecaseImpl) TagSegmentMembers(ctx context.Context, id int, tags []string) (int, error) {
+	tags, err := normalizeTags(tags)
+	if err != nil {
+		return 0, err
+	}
+	if len(tags) == 0 {
+		return 0, appError.ErrInvalidTag
+	}
+
+	members, err := u.GetSegmentMembers(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	if len(members) == 0 {
+		return 0, nil
+	}
+	employeeIDs := make([]int, 0, len(members))
+	for _, member := range members {
+		employeeIDs = append(employeeIDs, member.ID)
+	}
+
+	added, err := u.segmentRepository.AddEmployeeTags(ctx, employeeIDs, tags)
+	if err != nil {
+		return 0, err
+	}
+	invalidateEmployeesListCache(ctx, u.cache)
+	return added, nil
+}
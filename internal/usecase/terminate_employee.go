@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// TerminateEmployee ends the employment of an employee. The record is kept so that
+// terminated employees stay queryable.
+func (u *employeeUsecaseImpl) TerminateEmployee(ctx context.Context, employeeID int,
+	terminationDate time.Time, reason string) (*entity.Employee, error) {
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, appError.ErrInvalidTerminationReason
+	}
+
+	employee, err := u.GetEmployeeById(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if terminationDate.IsZero() || terminationDate.Before(employee.HiredDate) {
+		return nil, appError.ErrInvalidTerminationDate
+	}
+
+	employee.TerminationDate = &terminationDate
+	employee.TerminationReason = reason
+
+	terminatedEmployee, err := u.transitionEmploymentStatus(ctx, employee, &entity.EmploymentStatusChange{
+		ToStatus:      entity.EmploymentStatusTerminated,
+		EffectiveDate: terminationDate,
+		Reason:        reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.startChecklist(ctx, terminatedEmployee.ID, entity.ChecklistKindOffboarding)
+
+	// The termination stands either way; the count only tells the caller to chase the assets.
+	held, err := u.heldAssets(ctx, terminatedEmployee.ID)
+	if err != nil {
+		log.Printf("[EMPLOYEES] failed to check the assets of terminated employee %d: %v", terminatedEmployee.ID, err)
+	}
+	terminatedEmployee.UnreturnedAssets = len(held)
+	return terminatedEmployee, nil
+}
+
+// heldAssets returns the open asset assignments of an employee.
+func (u *employeeUsecaseImpl) heldAssets(ctx context.Context, employeeID int) ([]*entity.AssetAssignment, error) {
+	return u.assetRepository.GetAssignments(ctx, entity.AssetAssignmentFilter{
+		EmployeeID: employeeID,
+		OpenOnly:   true,
+	})
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+type TimesheetIssueKind string
+
+const (
+	// TimesheetIssueMissingClockOut is a shift still open after the maximum shift length.
+	TimesheetIssueMissingClockOut TimesheetIssueKind = "missing_clock_out"
+	// TimesheetIssueNoPunches is a past working day without any shift or approved leave.
+	TimesheetIssueNoPunches TimesheetIssueKind = "no_punches"
+)
+
+type TimesheetIssue struct {
+	Date    time.Time
+	Kind    TimesheetIssueKind
+	EntryID int // the open shift, for missing clock-outs
+}
+
+// TimesheetDay is one day of a weekly timesheet. Shifts count towards the day they were clocked
+// in, even when they run past midnight.
+type TimesheetDay struct {
+	Date            time.Time
+	RestDay         bool // weekend or public holiday
+	OnLeave         bool
+	Entries         int
+	WorkedMinutes   int
+	RegularMinutes  int
+	OvertimeMinutes int
+}
+
+// WeeklyTimesheet is a week of attendance, Monday to Sunday, computed from the punches.
+// Submission is nil until the week has been submitted.
+type WeeklyTimesheet struct {
+	EmployeeID      int
+	WeekStart       time.Time
+	Days            []*TimesheetDay
+	TotalMinutes    int
+	RegularMinutes  int
+	OvertimeMinutes int
+	OpenEntries     int
+	Issues          []TimesheetIssue
+	Submission      *entity.Timesheet
+}
+
+// buildWeeklyTimesheet aggregates the employee's punches for the week starting weekStart. Days
+// run from midnight to midnight in zone, the time zone of the employee's location.
+func (u *attendanceUsecaseImpl) buildWeeklyTimesheet(ctx context.Context, employee *entity.Employee,
+	zone *time.Location, weekStart time.Time) (*WeeklyTimesheet, error) {
+
+	weekEnd := weekStart.AddDate(0, 0, 6)
+	entries, err := u.attendanceRepository.GetAttendanceEntries(ctx, employee.ID, midnightIn(weekStart, zone),
+		midnightIn(weekEnd.AddDate(0, 0, 1), zone))
+	if err != nil {
+		return nil, err
+	}
+	workingDays, err := u.workingDays.GetWorkingDays(ctx, employee.ID, weekStart, weekEnd)
+	if err != nil {
+		return nil, err
+	}
+	leaveRequests, err := u.leaveRepository.GetLeaveRequestsByEmployeeId(ctx, employee.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	holidays := map[time.Time]bool{}
+	for _, holiday := range workingDays.Holidays {
+		holidays[dateOnly(holiday.Date)] = true
+	}
+
+	timesheet := &WeeklyTimesheet{EmployeeID: employee.ID, WeekStart: weekStart, Issues: []TimesheetIssue{}}
+	for date := weekStart; !date.After(weekEnd); date = date.AddDate(0, 0, 1) {
+		day := &TimesheetDay{Date: date, RestDay: isWeekend(date) || holidays[date]}
+		for _, request := range leaveRequests {
+			if request.Status == entity.LeaveRequestStatusApproved && request.Covers(date) {
+				day.OnLeave = true
+			}
+		}
+		timesheet.Days = append(timesheet.Days, day)
+	}
+
+	missingClockOutCutoff := time.Now().UTC().Add(-u.maxShift)
+	for _, entry := range entries {
+		day := timesheet.Days[int(dateIn(entry.ClockIn, zone).Sub(weekStart)/(24*time.Hour))]
+		day.Entries++
+		if entry.IsOpen() {
+			timesheet.OpenEntries++
+			if entry.ClockIn.Before(missingClockOutCutoff) {
+				timesheet.Issues = append(timesheet.Issues, TimesheetIssue{
+					Date:    day.Date,
+					Kind:    TimesheetIssueMissingClockOut,
+					EntryID: entry.ID,
+				})
+			}
+			continue
+		}
+		day.WorkedMinutes += entry.WorkedMinutes()
+	}
+
+	hiredDate := dateOnly(employee.HiredDate)
+	localToday := dateIn(time.Now(), zone)
+	for _, day := range timesheet.Days {
+		if day.RestDay || day.OnLeave || day.Entries > 0 || !day.Date.Before(localToday) || day.Date.Before(hiredDate) {
+			continue
+		}
+		timesheet.Issues = append(timesheet.Issues, TimesheetIssue{Date: day.Date, Kind: TimesheetIssueNoPunches})
+	}
+
+	applyOvertimeRules(u.overtimeRules, timesheet.Days)
+	for _, day := range timesheet.Days {
+		timesheet.TotalMinutes += day.WorkedMinutes
+		timesheet.RegularMinutes += day.RegularMinutes
+		timesheet.OvertimeMinutes += day.OvertimeMinutes
+	}
+
+	timesheet.Submission, err = u.attendanceRepository.GetTimesheet(ctx, employee.ID, weekStart)
+	if err != nil {
+		return nil, err
+	}
+	return timesheet, nil
+}
+
+// applyOvertimeRules splits each day's worked minutes into regular time and overtime. Time on
+// rest days and beyond the daily limit is overtime first; the remaining regular time then
+// counts towards the weekly limit in day order.
+func applyOvertimeRules(rules entity.OvertimeRules, days []*TimesheetDay) {
+	dailyLimit := int(rules.DailyLimit / time.Minute)
+	weeklyLimit := int(rules.WeeklyLimit / time.Minute)
+
+	weeklyRegular := 0
+	for _, day := range days {
+		regular := day.WorkedMinutes
+		if rules.RestDays && day.RestDay {
+			regular = 0
+		}
+		if dailyLimit > 0 && regular > dailyLimit {
+			regular = dailyLimit
+		}
+		if weeklyLimit > 0 && weeklyRegular+regular > weeklyLimit {
+			regular = max(weeklyLimit-weeklyRegular, 0)
+		}
+		weeklyRegular += regular
+		day.RegularMinutes = regular
+		day.OvertimeMinutes = day.WorkedMinutes - regular
+	}
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+)
+
+func TestApplyOvertimeRules(t *testing.T) {
+	type day struct {
+		worked  int
+		restDay bool
+	}
+	workweek := func(worked ...int) []day {
+		days := []day{}
+		for _, minutes := range worked {
+			days = append(days, day{worked: minutes})
+		}
+		return days
+	}
+
+	tests := []struct {
+		name         string
+		rules        entity.OvertimeRules
+		days         []day
+		wantRegular  []int
+		wantOvertime []int
+	}{
+		{
+			name:         "no limits",
+			days:         workweek(600, 600),
+			wantRegular:  []int{600, 600},
+			wantOvertime: []int{0, 0},
+		},
+		{
+			name:         "daily limit",
+			rules:        entity.OvertimeRules{DailyLimit: 8 * time.Hour},
+			days:         workweek(600, 480, 300),
+			wantRegular:  []int{480, 480, 300},
+			wantOvertime: []int{120, 0, 0},
+		},
+		{
+			name:         "weekly limit reached mid-week",
+			rules:        entity.OvertimeRules{WeeklyLimit: 40 * time.Hour},
+			days:         workweek(600, 600, 600, 500, 600),
+			wantRegular:  []int{600, 600, 600, 500, 100},
+			wantOvertime: []int{0, 0, 0, 0, 500},
+		},
+		{
+			name:         "only regular time counts towards the weekly limit",
+			rules:        entity.OvertimeRules{DailyLimit: 8 * time.Hour, WeeklyLimit: 40 * time.Hour},
+			days:         append(workweek(540, 540, 540, 540, 540), day{worked: 240, restDay: true}),
+			wantRegular:  []int{480, 480, 480, 480, 480, 0},
+			wantOvertime: []int{60, 60, 60, 60, 60, 240},
+		},
+		{
+			name:         "rest days all overtime",
+			rules:        entity.OvertimeRules{WeeklyLimit: 40 * time.Hour, RestDays: true},
+			days:         []day{{worked: 300, restDay: true}, {worked: 480}},
+			wantRegular:  []int{0, 480},
+			wantOvertime: []int{300, 0},
+		},
+		{
+			name:         "rest days regular without the rest day rule",
+			rules:        entity.OvertimeRules{DailyLimit: 8 * time.Hour},
+			days:         []day{{worked: 300, restDay: true}},
+			wantRegular:  []int{300},
+			wantOvertime: []int{0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			days := []*TimesheetDay{}
+			for _, d := range tt.days {
+				days = append(days, &TimesheetDay{WorkedMinutes: d.worked, RestDay: d.restDay})
+			}
+			applyOvertimeRules(tt.rules, days)
+			for i, d := range days {
+				if d.RegularMinutes != tt.wantRegular[i] || d.OvertimeMinutes != tt.wantOvertime[i] {
+					t.Errorf("day %d = %d regular, %d overtime, want %d, %d", i, d.RegularMinutes, d.OvertimeMinutes,
+						tt.wantRegular[i], tt.wantOvertime[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+)
+
+type TrainingUsecase interface {
+	CreateCourse(ctx context.Context, course *entity.TrainingCourse) (*entity.TrainingCourse, error)
+	GetCourseById(ctx context.Context, id int) (*entity.TrainingCourse, error)
+	GetAllCourses(ctx context.Context) ([]*entity.TrainingCourse, error)
+	UpdateCourse(ctx context.Context, course *entity.TrainingCourse) (*entity.TrainingCourse, error)
+	DeleteCourse(ctx context.Context, id int) error
+
+	// EnrollEmployee enrolls an employee in a course, today unless EnrolledOn is set.
+	EnrollEmployee(ctx context.Context, enrollment *entity.TrainingEnrollment) (*entity.TrainingEnrollment, error)
+	GetCourseEnrollments(ctx context.Context, courseID int) ([]*entity.TrainingEnrollment, error)
+	GetEmployeeEnrollments(ctx context.Context, employeeID int) ([]*entity.TrainingEnrollment, error)
+	// CompleteEnrollment records the completion of an open enrollment on completedOn and issues
+	// the course's certification, if it has one.
+	CompleteEnrollment(ctx context.Context, id int, completedOn time.Time) (*entity.TrainingEnrollment, error)
+	CancelEnrollment(ctx context.Context, id int) (*entity.TrainingEnrollment, error)
+}
+
+type trainingUsecaseImpl struct {
+	trainingRepository repository.TrainingRepository
+	employeeRepository repository.EmployeeRepository
+}
+
+func NewTrainingUsecase(trainingRepository repository.TrainingRepository,
+	employeeRepository repository.EmployeeRepository) TrainingUsecase {
+	return &trainingUsecaseImpl{
+		trainingRepository: trainingRepository,
+		employeeRepository: employeeRepository,
+	}
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// maxCertificationValidMonths caps how long a course certification can stay valid: ten years.
+const maxCertificationValidMonths = 120
+
+func validateTrainingCourse(course *entity.TrainingCourse) error {
+	course.Name = strings.TrimSpace(course.Name)
+	course.Description = strings.TrimSpace(course.Description)
+	course.Provider = strings.TrimSpace(course.Provider)
+	if len(course.Name) < 2 || course.DurationHours < 0 {
+		return appError.ErrInvalidTrainingCourse
+	}
+	if course.Certifies() &&
+		(*course.CertificationValidMonths < 0 || *course.CertificationValidMonths > maxCertificationValidMonths) {
+		return appError.ErrInvalidTrainingCourse
+	}
+	return nil
+}
+
+func validateEnrollment(enrollment *entity.TrainingEnrollment) error {
+	if enrollment.EnrolledOn.IsZero() {
+		enrollment.EnrolledOn = today()
+	}
+	enrollment.EnrolledOn = dateOnly(enrollment.EnrolledOn)
+	if enrollment.DueOn != nil {
+		dueOn := dateOnly(*enrollment.DueOn)
+		if dueOn.Before(enrollment.EnrolledOn) {
+			return appError.ErrInvalidEnrollment
+		}
+		enrollment.DueOn = &dueOn
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *approvalUsecaseImpl) UpdateWorkflow(ctx context.Context, workflow *entity.ApprovalWorkflow) (*entity.ApprovalWorkflow, error) {
+	if workflow.ID <= 0 {
+		return nil, appError.ErrInvalidApprovalWorkflowId
+	}
+	if err := validateApprovalWorkflow(workflow); err != nil {
+		return nil, err
+	}
+	if err := u.validateApprovalStepApprovers(ctx, workflow); err != nil {
+		return nil, err
+	}
+
+	updatedWorkflow, err := u.approvalRepository.UpdateWorkflow(ctx, workflow)
+	if err != nil {
+		return nil, err
+	}
+	if updatedWorkflow == nil {
+		return nil, appError.ErrApprovalWorkflowNotFound
+	}
+	return updatedWorkflow, nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *assetUsecaseImpl) UpdateAsset(ctx context.Context, asset *entity.Asset) (*entity.Asset, error) {
+	if err := validateAsset(asset); err != nil {
+		return nil, err
+	}
+	existing, err := u.GetAssetById(ctx, asset.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Assigned is not a stored status: an assigned asset stays available underneath until it is
+	// returned, and only an assignment makes an asset assigned.
+	if existing.Status == entity.AssetStatusAssigned {
+		if asset.Status != "" && asset.Status != entity.AssetStatusAssigned {
+			return nil, appError.ErrAssetAssigned
+		}
+		asset.Status = entity.AssetStatusAvailable
+	} else {
+		switch asset.Status {
+		case "":
+			asset.Status = existing.Status
+		case entity.AssetStatusAssigned:
+			return nil, appError.ErrInvalidAssetStatus
+		}
+	}
+
+	updatedAsset, err := u.assetRepository.UpdateAsset(ctx, asset)
+	if err != nil {
+		return nil, err
+	}
+	if updatedAsset == nil {
+		return nil, appError.ErrAssetNotFound
+	}
+	return updatedAsset, nil
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateAttendanceEntry corrects a shift. Neither its old nor its new week may have been
+// submitted or approved.
+func (u *attendanceUsecaseImpl) UpdateAttendanceEntry(ctx context.Context,
+	entry *entity.AttendanceEntry) (*entity.AttendanceEntry, error) {
+
+	if entry.ID <= 0 {
+		return nil, appError.ErrInvalidAttendanceEntryId
+	}
+	employee, err := u.getEmployeeForAttendance(ctx, entry.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	zone, err := u.employeeZone(ctx, employee)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := u.attendanceRepository.GetAttendanceEntryById(ctx, entry.EmployeeID, entry.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, appError.ErrAttendanceEntryNotFound
+	}
+
+	if entry.ClockIn.IsZero() {
+		return nil, appError.ErrInvalidPunchTime
+	}
+	entry.ClockIn = entry.ClockIn.UTC()
+	now := time.Now().UTC().Add(time.Minute)
+	if entry.ClockIn.After(now) {
+		return nil, appError.ErrInvalidPunchTime
+	}
+	if entry.ClockOut != nil {
+		clockOut := entry.ClockOut.UTC()
+		if !clockOut.After(entry.ClockIn) || clockOut.After(now) {
+			return nil, appError.ErrInvalidPunchTime
+		}
+		entry.ClockOut = &clockOut
+	}
+	entry.Note = strings.TrimSpace(entry.Note)
+
+	if err := u.ensureWeekUnlocked(ctx, entry.EmployeeID, zone, existing.ClockIn); err != nil {
+		return nil, err
+	}
+	if err := u.ensureWeekUnlocked(ctx, entry.EmployeeID, zone, entry.ClockIn); err != nil {
+		return nil, err
+	}
+	if err := u.ensureNoOverlap(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	updatedEntry, err := u.attendanceRepository.UpdateAttendanceEntry(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+	if updatedEntry == nil {
+		return nil, appError.ErrAttendanceEntryNotFound
+	}
+	return updatedEntry, nil
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *benefitUsecaseImpl) UpdatePlan(ctx context.Context, plan *entity.BenefitPlan) (*entity.BenefitPlan, error) {
+	if plan.ID <= 0 {
+		return nil, appError.ErrInvalidBenefitPlanId
+	}
+	if err := validateBenefitPlan(plan); err != nil {
+		return nil, err
+	}
+
+	updatedPlan, err := u.benefitRepository.UpdatePlan(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+	if updatedPlan == nil {
+		return nil, appError.ErrBenefitPlanNotFound
+	}
+	return updatedPlan, nil
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *recruitingUsecaseImpl) UpdateCandidate(ctx context.Context, candidate *entity.Candidate) (*entity.Candidate, error) {
+	if candidate.ID <= 0 {
+		return nil, appError.ErrInvalidCandidateId
+	}
+	if err := validateCandidate(candidate); err != nil {
+		return nil, err
+	}
+	updatedCandidate, err := u.recruitingRepository.UpdateCandidate(ctx, candidate)
+	if err != nil {
+		return nil, err
+	}
+	if updatedCandidate == nil {
+		return nil, appError.ErrCandidateNotFound
+	}
+	return updatedCandidate, nil
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateCertification changes the certification's details; renewing it with a new expiry date
+// makes it due for another expiry notice.
+func (u *certificationUsecaseImpl) UpdateCertification(ctx context.Context, certification *entity.Certification) (*entity.Certification, error) {
+	if certification.ID <= 0 {
+		return nil, appError.ErrInvalidCertificationId
+	}
+	if err := validateCertification(certification); err != nil {
+		return nil, err
+	}
+
+	updatedCertification, err := u.certificationRepository.UpdateCertification(ctx, certification)
+	if err != nil {
+		return nil, err
+	}
+	if updatedCertification == nil {
+		return nil, appError.ErrCertificationNotFound
+	}
+	return updatedCertification, nil
+}
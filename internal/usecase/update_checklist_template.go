@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *checklistUsecaseImpl) UpdateTemplate(ctx context.Context, template *entity.ChecklistTemplate) (*entity.ChecklistTemplate, error) {
+	if template.ID <= 0 {
+		return nil, appError.ErrInvalidChecklistTemplateId
+	}
+	if err := validateChecklistTemplate(template); err != nil {
+		return nil, err
+	}
+
+	updatedTemplate, err := u.checklistRepository.UpdateTemplate(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+	if updatedTemplate == nil {
+		return nil, appError.ErrChecklistTemplateNotFound
+	}
+	return updatedTemplate, nil
+}
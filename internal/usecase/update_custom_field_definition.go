@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *customFieldUsecaseImpl) UpdateDefinition(ctx context.Context,
+	definition *entity.CustomFieldDefinition) (*entity.CustomFieldDefinition, error) {
+
+	existing, err := u.GetDefinitionById(ctx, definition.ID)
+	if err != nil {
+		return nil, err
+	}
+	// Saved values are keyed by the key and shaped by the type, so neither can change.
+	definition.Key = existing.Key
+	definition.Type = existing.Type
+	if err := validateCustomFieldDefinition(definition); err != nil {
+		return nil, err
+	}
+
+	updatedDefinition, err := u.customFieldRepository.UpdateDefinition(ctx, definition)
+	if err != nil {
+		return nil, err
+	}
+	if updatedDefinition == nil {
+		return nil, appError.ErrCustomFieldDefinitionNotFound
+	}
+	return updatedDefinition, nil
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *departmentUsecaseImpl) UpdateDepartment(ctx context.Context, department *entity.Department) (*entity.Department, error) {
+	if department.ID <= 0 {
+		return nil, appError.ErrInvalidDepartment
+	}
+	if err := validateDepartment(department); err != nil {
+		return nil, err
+	}
+
+	updatedDepartment, err := u.departmentRepository.UpdateDepartment(ctx, department)
+	if err != nil {
+		return nil, err
+	}
+	if updatedDepartment == nil {
+		return nil, appError.ErrDepartmentNotFound
+	}
+	return updatedDepartment, nil
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *benefitUsecaseImpl) UpdateDependent(ctx context.Context, dependent *entity.Dependent) (*entity.Dependent, error) {
+	if dependent.EmployeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	if dependent.ID <= 0 {
+		return nil, appError.ErrInvalidDependentId
+	}
+	if err := validateDependent(dependent); err != nil {
+		return nil, err
+	}
+
+	updatedDependent, err := u.benefitRepository.UpdateDependent(ctx, dependent)
+	if err != nil {
+		return nil, err
+	}
+	if updatedDependent == nil {
+		return nil, appError.ErrDependentNotFound
+	}
+	return updatedDependent, nil
+}
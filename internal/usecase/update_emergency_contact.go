@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *emergencyContactUsecaseImpl) UpdateEmergencyContact(ctx context.Context,
+	contact *entity.EmergencyContact) (*entity.EmergencyContact, error) {
+
+	if contact.EmployeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	if contact.ID <= 0 {
+		return nil, appError.ErrInvalidEmergencyContactId
+	}
+	if err := validateEmergencyContact(contact); err != nil {
+		return nil, err
+	}
+
+	updatedContact, err := u.emergencyContactRepository.UpdateEmergencyContact(ctx, contact)
+	if err != nil {
+		return nil, err
+	}
+	if updatedContact == nil {
+		return nil, appError.ErrEmergencyContactNotFound
+	}
+	return updatedContact, nil
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
 	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
@@ -15,22 +16,66 @@ func (u *employeeUsecaseImpl) UpdateEmployee(ctx context.Context, employee *enti
 	if employee.Name == "" || len(employee.Name) < 3 {
 		return nil, appError.ErrInvalidName
 	}
-	if employee.Position == "" || len(employee.Position) < 3 {
-		return nil, appError.ErrInvalidPosition
-	}
 	if employee.HiredDate.IsZero() {
 		return nil, appError.ErrInvalidHiredDate
 	}
-	if employee.Salary <= 0 {
-		return nil, appError.ErrInvalidSalary
+	current, err := u.employeeRepository.GetEmployeeById(ctx, employee.ID)
+	if err != nil {
+		return nil, err
 	}
-
-	updatedEmployee, err := u.employeeRepository.UpdateEmployee(ctx, employee)
-	if updatedEmployee == nil {
+	if current == nil {
 		return nil, appError.ErrEmployeeNotFound
 	}
+	if employee.PayPeriod == "" {
+		employee.PayPeriod = current.PayPeriod
+	}
+	if err := validateSalary(employee); err != nil {
+		return nil, err
+	}
+	// Salary changes go through the compensation timeline, where they may need approval. The pay
+	// period is part of the salary, so it cannot change here either.
+	if employee.Salary != current.Salary || employee.PayPeriod != current.PayPeriod {
+		return nil, appError.ErrSalaryChangeNotAllowed
+	}
+
+	normalizeEmployeeProfile(employee)
+	if err := validateEmployeeProfile(employee); err != nil {
+		return nil, err
+	}
+	if err := u.validateManager(ctx, employee); err != nil {
+		return nil, err
+	}
+	if employee.LocationID != nil && *employee.LocationID <= 0 {
+		return nil, appError.ErrInvalidLocation
+	}
+	if employee.DepartmentID != nil && *employee.DepartmentID <= 0 {
+		return nil, appError.ErrInvalidDepartment
+	}
+	if err := u.assignPosition(ctx, employee); err != nil {
+		return nil, err
+	}
+	definitions, err := u.customFieldRepository.GetAllDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCustomFields(definitions, employee); err != nil {
+		return nil, err
+	}
+
+	// A move takes effect on the current date at the new location.
+	locationDate, err := localDate(ctx, u.locationRepository, employee.LocationID, time.Now())
 	if err != nil {
 		return nil, err
 	}
+
+	updatedEmployee, err := u.employeeRepository.UpdateEmployee(ctx, employee, locationDate)
+	if err != nil {
+		return nil, err
+	}
+	if updatedEmployee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+
+	invalidateEmployeesListCache(ctx, u.cache)
 	return updatedEmployee, nil
 }
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateCategory changes a category. Claims already submitted are not checked against a new limit.
+func (u *expenseUsecaseImpl) UpdateCategory(ctx context.Context, category *entity.ExpenseCategory) (*entity.ExpenseCategory, error) {
+	if category.ID <= 0 {
+		return nil, appError.ErrInvalidExpenseCategoryId
+	}
+	if err := validateExpenseCategory(category); err != nil {
+		return nil, err
+	}
+
+	updatedCategory, err := u.expenseRepository.UpdateCategory(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+	if updatedCategory == nil {
+		return nil, appError.ErrExpenseCategoryNotFound
+	}
+	return updatedCategory, nil
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *expenseUsecaseImpl) UpdateClaim(ctx context.Context, claim *entity.ExpenseClaim) (*entity.ExpenseClaim, error) {
+	existingClaim, err := u.GetClaimById(ctx, claim.EmployeeID, claim.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !existingClaim.Status.IsEditable() {
+		return nil, appError.ErrExpenseClaimNotEditable
+	}
+	if err := validateExpenseClaim(claim); err != nil {
+		return nil, err
+	}
+	if err := u.checkExpenseCategories(ctx, claim, false); err != nil {
+		return nil, err
+	}
+
+	updatedClaim, err := u.expenseRepository.UpdateClaim(ctx, claim)
+	if err != nil {
+		return nil, err
+	}
+	if updatedClaim == nil {
+		return nil, appError.ErrExpenseClaimNotEditable
+	}
+	return updatedClaim, nil
+}
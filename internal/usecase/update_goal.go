@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *goalUsecaseImpl) UpdateGoal(ctx context.Context, goal *entity.Goal) (*entity.Goal, error) {
+	existing, err := u.GetGoalById(ctx, goal.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateGoal(goal, false); err != nil {
+		return nil, err
+	}
+
+	goal.EmployeeID = existing.EmployeeID
+	owner, err := u.getGoalOwner(ctx, goal)
+	if err != nil {
+		return nil, err
+	}
+	// Alignments made under a previous manager stay valid until they are changed.
+	if !sameGoalParent(goal.ParentID, existing.ParentID) {
+		if err := u.validateGoalParent(ctx, goal, owner); err != nil {
+			return nil, err
+		}
+	}
+
+	updatedGoal, err := u.goalRepository.UpdateGoal(ctx, goal)
+	if err != nil {
+		return nil, err
+	}
+	if updatedGoal == nil {
+		return nil, appError.ErrGoalNotFound
+	}
+	return updatedGoal, nil
+}
+
+func sameGoalParent(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
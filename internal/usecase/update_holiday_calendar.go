@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *holidayCalendarUsecaseImpl) UpdateHolidayCalendar(ctx context.Context,
+	calendar *entity.HolidayCalendar) (*entity.HolidayCalendar, error) {
+
+	if calendar.ID <= 0 {
+		return nil, appError.ErrInvalidHolidayCalendarId
+	}
+	if err := validateHolidayCalendar(calendar); err != nil {
+		return nil, err
+	}
+
+	updatedCalendar, err := u.holidayCalendarRepository.UpdateHolidayCalendar(ctx, calendar)
+	if err != nil {
+		return nil, err
+	}
+	if updatedCalendar == nil {
+		return nil, appError.ErrHolidayCalendarNotFound
+	}
+	return updatedCalendar, nil
+}
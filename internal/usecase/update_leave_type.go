@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *leaveUsecaseImpl) UpdateLeaveType(ctx context.Context, leaveType *entity.LeaveType) (*entity.LeaveType, error) {
+	if leaveType.ID <= 0 {
+		return nil, appError.ErrInvalidLeaveTypeId
+	}
+	if err := validateLeaveType(leaveType); err != nil {
+		return nil, err
+	}
+
+	updatedType, err := u.leaveRepository.UpdateLeaveType(ctx, leaveType)
+	if err != nil {
+		return nil, err
+	}
+	if updatedType == nil {
+		return nil, appError.ErrLeaveTypeNotFound
+	}
+	return updatedType, nil
+}
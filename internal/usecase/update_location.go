@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *locationUsecaseImpl) UpdateLocation(ctx context.Context, location *entity.Location) (*entity.Location, error) {
+	if location.ID <= 0 {
+		return nil, appError.ErrInvalidLocation
+	}
+	if err := validateLocation(location); err != nil {
+		return nil, err
+	}
+
+	updatedLocation, err := u.locationRepository.UpdateLocation(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+	if updatedLocation == nil {
+		return nil, appError.ErrLocationNotFound
+	}
+	return updatedLocation, nil
+}
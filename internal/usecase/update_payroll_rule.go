@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *payrollUsecaseImpl) UpdatePayrollRule(ctx context.Context, rule *entity.PayrollRule) (*entity.PayrollRule, error) {
+	if rule.ID <= 0 {
+		return nil, appError.ErrInvalidPayrollRuleId
+	}
+	if err := validatePayrollRule(rule); err != nil {
+		return nil, err
+	}
+
+	updatedRule, err := u.payrollRepository.UpdatePayrollRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+	if updatedRule == nil {
+		return nil, appError.ErrPayrollRuleNotFound
+	}
+	return updatedRule, nil
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *positionUsecaseImpl) UpdatePosition(ctx context.Context, position *entity.Position) (*entity.Position, error) {
+	if position.ID <= 0 {
+		return nil, appError.ErrInvalidPosition
+	}
+	if err := validatePosition(position); err != nil {
+		return nil, err
+	}
+
+	updatedPosition, err := u.positionRepository.UpdatePosition(ctx, position)
+	if err != nil {
+		return nil, err
+	}
+	if updatedPosition == nil {
+		return nil, appError.ErrPositionNotFound
+	}
+	return updatedPosition, nil
+}
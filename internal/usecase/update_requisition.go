@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateRequisition changes the requisition for future hires. It cannot drop the openings below
+// the candidates already hired.
+func (u *recruitingUsecaseImpl) UpdateRequisition(ctx context.Context,
+	requisition *entity.JobRequisition) (*entity.JobRequisition, error) {
+
+	if err := validateRequisition(requisition); err != nil {
+		return nil, err
+	}
+	existing, err := u.GetRequisitionById(ctx, requisition.ID)
+	if err != nil {
+		return nil, err
+	}
+	if requisition.Openings < existing.Hired {
+		return nil, appError.ErrInvalidRequisition
+	}
+
+	updatedRequisition, err := u.recruitingRepository.UpdateRequisition(ctx, requisition)
+	if err != nil {
+		return nil, err
+	}
+	if updatedRequisition == nil {
+		return nil, appError.ErrRequisitionNotFound
+	}
+	return updatedRequisition, nil
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *reviewUsecaseImpl) UpdateReviewCycle(ctx context.Context, cycle *entity.ReviewCycle) (*entity.ReviewCycle, error) {
+	existing, err := u.GetReviewCycleById(ctx, cycle.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.Status != entity.ReviewCycleStatusDraft {
+		return nil, appError.ErrReviewCycleNotDraft
+	}
+	if err := validateReviewCycle(cycle); err != nil {
+		return nil, err
+	}
+
+	updatedCycle, err := u.reviewRepository.UpdateReviewCycle(ctx, cycle)
+	if err != nil {
+		return nil, err
+	}
+	if updatedCycle == nil {
+		// The cycle was launched or deleted concurrently.
+		return nil, appError.ErrReviewCycleNotDraft
+	}
+	return updatedCycle, nil
+}
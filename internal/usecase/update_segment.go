@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *segmentUsecaseImpl) UpdateSegment(ctx context.Context, segment *entity.EmployeeSegment) (*entity.EmployeeSegment, error) {
+	if segment.ID <= 0 {
+		return nil, appError.ErrInvalidSegmentId
+	}
+	if err := u.validateSegment(ctx, segment); err != nil {
+		return nil, err
+	}
+
+	updatedSegment, err := u.segmentRepository.UpdateSegment(ctx, segment)
+	if err != nil {
+		return nil, err
+	}
+	if updatedSegment == nil {
+		return nil, appError.ErrSegmentNotFound
+	}
+	return updatedSegment, nil
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *shiftUsecaseImpl) UpdateShiftTemplate(ctx context.Context, template *entity.ShiftTemplate) (*entity.ShiftTemplate, error) {
+	if template.ID <= 0 {
+		return nil, appError.ErrInvalidShiftTemplateId
+	}
+	if err := validateShiftTemplate(template); err != nil {
+		return nil, err
+	}
+
+	updatedTemplate, err := u.shiftRepository.UpdateShiftTemplate(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+	if updatedTemplate == nil {
+		return nil, appError.ErrShiftTemplateNotFound
+	}
+	return updatedTemplate, nil
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *skillUsecaseImpl) UpdateSkill(ctx context.Context, skill *entity.Skill) (*entity.Skill, error) {
+	if skill.ID <= 0 {
+		return nil, appError.ErrInvalidSkillId
+	}
+	if err := validateSkill(skill); err != nil {
+		return nil, err
+	}
+
+	updatedSkill, err := u.skillRepository.UpdateSkill(ctx, skill)
+	if err != nil {
+		return nil, err
+	}
+	if updatedSkill == nil {
+		return nil, appError.ErrSkillNotFound
+	}
+	return updatedSkill, nil
+}
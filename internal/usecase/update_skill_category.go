@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *skillUsecaseImpl) UpdateSkillCategory(ctx context.Context, category *entity.SkillCategory) (*entity.SkillCategory, error) {
+	if category.ID <= 0 {
+		return nil, appError.ErrInvalidSkillCategory
+	}
+	if err := validateSkillCategory(category); err != nil {
+		return nil, err
+	}
+
+	updatedCategory, err := u.skillRepository.UpdateSkillCategory(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+	if updatedCategory == nil {
+		return nil, appError.ErrSkillCategoryNotFound
+	}
+	return updatedCategory, nil
+}
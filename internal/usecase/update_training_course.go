@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// UpdateCourse changes the course for future completions; certifications already issued keep
+// their expiry date.
+func (u *trainingUsecaseImpl) UpdateCourse(ctx context.Context, course *entity.TrainingCourse) (*entity.TrainingCourse, error) {
+	if course.ID <= 0 {
+		return nil, appError.ErrInvalidTrainingCourseId
+	}
+	if err := validateTrainingCourse(course); err != nil {
+		return nil, err
+	}
+
+	updatedCourse, err := u.trainingRepository.UpdateCourse(ctx, course)
+	if err != nil {
+		return nil, err
+	}
+	if updatedCourse == nil {
+		return nil, appError.ErrTrainingCourseNotFound
+	}
+	return updatedCourse, nil
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *documentUsecaseImpl) UploadDocument(ctx context.Context, document *entity.EmployeeDocument,
+	content io.Reader) (*entity.EmployeeDocument, error) {
+
+	if err := ensureEmployeeExists(ctx, u.employeeRepository, document.EmployeeID); err != nil {
+		return nil, err
+	}
+
+	// One byte over the limit is enough to tell the document is too large.
+	data, err := io.ReadAll(io.LimitReader(content, u.maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read document: %w", err)
+	}
+	if int64(len(data)) > u.maxSize {
+		return nil, appError.ErrDocumentTooLarge
+	}
+	if err := validateDocument(document, data); err != nil {
+		return nil, err
+	}
+
+	checksum := sha256.Sum256(data)
+	document.Checksum = hex.EncodeToString(checksum[:])
+	document.Size = int64(len(data))
+	document.StorageKey = fmt.Sprintf("employees/%d/documents/%s", document.EmployeeID, uuid.NewString())
+
+	if err := u.blobStorage.Put(ctx, document.StorageKey, bytes.NewReader(data), document.Size, document.ContentType); err != nil {
+		return nil, fmt.Errorf("store document: %w", err)
+	}
+
+	created, err := u.documentRepository.CreateDocument(ctx, document)
+	if err != nil {
+		// Without its metadata the blob could never be reached again.
+		if deleteErr := u.blobStorage.Delete(ctx, document.StorageKey); deleteErr != nil {
+			log.Printf("Error removing orphaned document %s: %v", document.StorageKey, deleteErr)
+		}
+		return nil, err
+	}
+	return created, nil
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+func (u *expenseUsecaseImpl) UploadReceipt(ctx context.Context, employeeID int, receipt *entity.ExpenseReceipt,
+	content io.Reader) (*entity.ExpenseReceipt, error) {
+
+	claim, err := u.GetClaimById(ctx, employeeID, receipt.ClaimID)
+	if err != nil {
+		return nil, err
+	}
+	if !claim.Status.IsEditable() {
+		return nil, appError.ErrExpenseClaimNotEditable
+	}
+
+	// One byte over the limit is enough to tell the receipt is too large.
+	data, err := io.ReadAll(io.LimitReader(content, u.maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read receipt: %w", err)
+	}
+	if int64(len(data)) > u.maxSize {
+		return nil, appError.ErrExpenseReceiptTooLarge
+	}
+	if err := validateExpenseReceipt(receipt, data); err != nil {
+		return nil, err
+	}
+
+	checksum := sha256.Sum256(data)
+	receipt.Checksum = hex.EncodeToString(checksum[:])
+	receipt.Size = int64(len(data))
+	receipt.StorageKey = fmt.Sprintf("employees/%d/expenses/%s", employeeID, uuid.NewString())
+
+	if err := u.blobStorage.Put(ctx, receipt.StorageKey, bytes.NewReader(data), receipt.Size, receipt.ContentType); err != nil {
+		return nil, fmt.Errorf("store receipt: %w", err)
+	}
+
+	created, err := u.expenseRepository.CreateReceipt(ctx, receipt)
+	if err != nil {
+		// Without its metadata the blob could never be reached again.
+		if deleteErr := u.blobStorage.Delete(ctx, receipt.StorageKey); deleteErr != nil {
+			log.Printf("Error removing orphaned receipt %s: %v", receipt.StorageKey, deleteErr)
+		}
+		return nil, err
+	}
+	return created, nil
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+const docxContentType = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+
+// validateUpload checks an uploaded file against the content types allowed for it, as sniffed
+// from its contents rather than taken from the client. It returns the client's file name without
+// any directories, or fallbackName when nothing is left of it, and the sniffed content type; ok
+// is false for empty files and types that are not allowed.
+func validateUpload(fileName, fallbackName string, content []byte,
+	allowed map[string]bool) (name, contentType string, ok bool) {
+
+	if len(content) == 0 {
+		return "", "", false
+	}
+
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(fileName, `\`, "/")))
+	if name == "" || name == "." || name == "/" {
+		name = fallbackName
+	}
+
+	contentType, _, _ = strings.Cut(http.DetectContentType(content), ";")
+	// Word documents are zip archives to the sniffer, so the extension tells them apart.
+	if contentType == "application/zip" && strings.EqualFold(filepath.Ext(name), ".docx") {
+		contentType = docxContentType
+	}
+	if !allowed[contentType] {
+		return "", "", false
+	}
+	return name, contentType, true
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import "testing"
+
+func TestValidateUpload(t *testing.T) {
+	pdf := []byte("%PDF-1.7\n1 0 obj\n")
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	zip := []byte("PK\x03\x04\x14\x00\x06\x00")
+	allowed := map[string]bool{"application/pdf": true, "image/png": true, docxContentType: true}
+
+	tests := []struct {
+		name            string
+		fileName        string
+		content         []byte
+		wantName        string
+		wantContentType string
+		wantOK          bool
+	}{
+		{"pdf", "contract.pdf", pdf, "contract.pdf", "application/pdf", true},
+		{"type sniffed, not taken from the name", "scan.pdf", png, "scan.pdf", "image/png", true},
+		{"unix directories dropped", "../../etc/contract.pdf", pdf, "contract.pdf", "application/pdf", true},
+		{"windows directories dropped", `C:\Users\jane\contract.pdf`, pdf, "contract.pdf", "application/pdf", true},
+		{"blank name falls back", "  ", pdf, "upload", "application/pdf", true},
+		{"directory only falls back", "/", pdf, "upload", "application/pdf", true},
+		{"docx told apart from zip", "offer.DOCX", zip, "offer.DOCX", docxContentType, true},
+		{"other zip rejected", "archive.zip", zip, "", "", false},
+		{"type not allowed", "notes.txt", []byte("plain text"), "", "", false},
+		{"empty", "contract.pdf", nil, "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, contentType, ok := validateUpload(tt.fileName, "upload", tt.content, allowed)
+			if name != tt.wantName || contentType != tt.wantContentType || ok != tt.wantOK {
+				t.Errorf("validateUpload(%q) = %q, %q, %v, want %q, %q, %v", tt.fileName, name, contentType, ok,
+					tt.wantName, tt.wantContentType, tt.wantOK)
+			}
+		})
+	}
+}
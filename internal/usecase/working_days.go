@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/employee_management_system/internal/domain/entity"
+	"github.com/mohamedfawas/employee_management_system/internal/domain/repository"
+	appError "github.com/mohamedfawas/employee_management_system/pkg/apperror"
+)
+
+// WorkingDays breaks down the dates from Start to End inclusive for one employee.
+type WorkingDays struct {
+	EmployeeID   int
+	Start        time.Time
+	End          time.Time
+	CalendarDays int
+	WeekendDays  int
+	Holidays     []*entity.Holiday // holidays falling on weekdays, by date
+	WorkingDays  int
+}
+
+// WorkingDayCalculator counts business days: Monday to Friday, minus the public holidays of the
+// holiday calendar of the employee's location. Employees without a location, or at a location
+// without a calendar, only have weekends off.
+type WorkingDayCalculator interface {
+	CountWorkingDays(ctx context.Context, employeeID int, start, end time.Time) (int, error)
+	GetWorkingDays(ctx context.Context, employeeID int, start, end time.Time) (*WorkingDays, error)
+}
+
+type workingDayCalculatorImpl struct {
+	employeeRepository        repository.EmployeeRepository
+	locationRepository        repository.LocationRepository
+	holidayCalendarRepository repository.HolidayCalendarRepository
+}
+
+func NewWorkingDayCalculator(employeeRepository repository.EmployeeRepository, locationRepository repository.LocationRepository,
+	holidayCalendarRepository repository.HolidayCalendarRepository) WorkingDayCalculator {
+	return &workingDayCalculatorImpl{
+		employeeRepository:        employeeRepository,
+		locationRepository:        locationRepository,
+		holidayCalendarRepository: holidayCalendarRepository,
+	}
+}
+
+func (c *workingDayCalculatorImpl) CountWorkingDays(ctx context.Context, employeeID int, start, end time.Time) (int, error) {
+	workingDays, err := c.GetWorkingDays(ctx, employeeID, start, end)
+	if err != nil {
+		return 0, err
+	}
+	return workingDays.WorkingDays, nil
+}
+
+func (c *workingDayCalculatorImpl) GetWorkingDays(ctx context.Context, employeeID int, start, end time.Time) (*WorkingDays, error) {
+	if start.IsZero() || end.IsZero() {
+		return nil, appError.ErrInvalidDateRange
+	}
+	start, end = dateOnly(start), dateOnly(end)
+	if end.Before(start) {
+		return nil, appError.ErrInvalidDateRange
+	}
+
+	holidays, err := c.getEmployeeHolidays(ctx, employeeID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	holidayOn := map[time.Time]*entity.Holiday{}
+	for _, holiday := range holidays {
+		holidayOn[dateOnly(holiday.Date)] = holiday
+	}
+
+	workingDays := &WorkingDays{EmployeeID: employeeID, Start: start, End: end, Holidays: []*entity.Holiday{}}
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		workingDays.CalendarDays++
+		switch {
+		case isWeekend(day):
+			workingDays.WeekendDays++
+		case holidayOn[day] != nil:
+			workingDays.Holidays = append(workingDays.Holidays, holidayOn[day])
+		default:
+			workingDays.WorkingDays++
+		}
+	}
+	return workingDays, nil
+}
+
+// getEmployeeHolidays returns the holidays from start to end of the calendar that applies to the employee.
+func (c *workingDayCalculatorImpl) getEmployeeHolidays(ctx context.Context, employeeID int, start, end time.Time) ([]*entity.Holiday, error) {
+	if employeeID <= 0 {
+		return nil, appError.ErrInvalidEmployeeId
+	}
+	employee, err := c.employeeRepository.GetEmployeeById(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, appError.ErrEmployeeNotFound
+	}
+	if employee.LocationID == nil {
+		return nil, nil
+	}
+
+	location, err := c.locationRepository.GetLocationById(ctx, *employee.LocationID)
+	if err != nil {
+		return nil, err
+	}
+	if location == nil || location.HolidayCalendarID == nil {
+		return nil, nil
+	}
+	return c.holidayCalendarRepository.GetHolidays(ctx, *location.HolidayCalendarID, start, end)
+}
@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS emergency_contacts;
+
+ALTER TABLE employees
+    DROP CONSTRAINT IF EXISTS employees_personal_email_key,
+    DROP CONSTRAINT IF EXISTS employees_work_email_key,
+    DROP COLUMN IF EXISTS date_of_birth,
+    DROP COLUMN IF EXISTS country,
+    DROP COLUMN IF EXISTS postal_code,
+    DROP COLUMN IF EXISTS state,
+    DROP COLUMN IF EXISTS city,
+    DROP COLUMN IF EXISTS address_line2,
+    DROP COLUMN IF EXISTS address_line1,
+    DROP COLUMN IF EXISTS phone,
+    DROP COLUMN IF EXISTS personal_email,
+    DROP COLUMN IF EXISTS work_email;
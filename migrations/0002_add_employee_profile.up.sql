@@ -0,0 +1,27 @@
+ALTER TABLE employees
+    ADD COLUMN work_email VARCHAR,
+    ADD COLUMN personal_email VARCHAR,
+    ADD COLUMN phone VARCHAR,
+    ADD COLUMN address_line1 VARCHAR,
+    ADD COLUMN address_line2 VARCHAR,
+    ADD COLUMN city VARCHAR,
+    ADD COLUMN state VARCHAR,
+    ADD COLUMN postal_code VARCHAR,
+    ADD COLUMN country CHAR(2),
+    ADD COLUMN date_of_birth DATE,
+    ADD CONSTRAINT employees_work_email_key UNIQUE (work_email),
+    ADD CONSTRAINT employees_personal_email_key UNIQUE (personal_email);
+
+CREATE TABLE emergency_contacts (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    name VARCHAR NOT NULL,
+    relationship VARCHAR NOT NULL,
+    phone VARCHAR NOT NULL,
+    email VARCHAR,
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_emergency_contacts_employee_id ON emergency_contacts (employee_id);
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS employment_status_changes;
+
+DROP INDEX IF EXISTS idx_employees_status;
+
+ALTER TABLE employees
+    DROP COLUMN IF EXISTS termination_reason,
+    DROP COLUMN IF EXISTS termination_date,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,19 @@
+ALTER TABLE employees
+    ADD COLUMN status VARCHAR NOT NULL DEFAULT 'active'
+        CHECK (status IN ('candidate', 'active', 'on_leave', 'suspended', 'terminated')),
+    ADD COLUMN termination_date DATE,
+    ADD COLUMN termination_reason VARCHAR;
+
+CREATE INDEX idx_employees_status ON employees (status);
+
+CREATE TABLE employment_status_changes (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    from_status VARCHAR,
+    to_status VARCHAR NOT NULL,
+    effective_date DATE NOT NULL,
+    reason VARCHAR,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_employment_status_changes_employee_id ON employment_status_changes (employee_id);
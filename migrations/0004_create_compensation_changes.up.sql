@@ -0,0 +1,22 @@
+CREATE TABLE compensation_changes (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    effective_date DATE NOT NULL,
+    old_amount INTEGER,
+    new_amount INTEGER NOT NULL,
+    currency CHAR(3) NOT NULL,
+    reason VARCHAR NOT NULL,
+    approved_by VARCHAR,
+    status VARCHAR NOT NULL DEFAULT 'scheduled'
+        CHECK (status IN ('scheduled', 'applied', 'cancelled')),
+    applied_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_compensation_changes_employee_id ON compensation_changes (employee_id);
+CREATE INDEX idx_compensation_changes_due ON compensation_changes (effective_date) WHERE status = 'scheduled';
+
+-- Seed the timeline with the salary every existing employee currently has.
+INSERT INTO compensation_changes (employee_id, effective_date, new_amount, currency, reason, status, applied_at)
+SELECT id, hired_date, salary, 'USD', 'initial salary', 'applied', created_at
+FROM employees;
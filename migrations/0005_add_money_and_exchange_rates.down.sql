@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS exchange_rates;
+
+ALTER TABLE compensation_changes DROP COLUMN IF EXISTS old_currency;
+ALTER TABLE compensation_changes
+    ALTER COLUMN old_amount TYPE INTEGER USING (old_amount / 100)::INTEGER,
+    ALTER COLUMN new_amount TYPE INTEGER USING (new_amount / 100)::INTEGER;
+ALTER TABLE compensation_changes RENAME COLUMN new_currency TO currency;
+
+ALTER TABLE employees
+    DROP COLUMN IF EXISTS pay_period,
+    DROP COLUMN IF EXISTS salary_currency;
+ALTER TABLE employees ALTER COLUMN salary_amount TYPE INTEGER USING (salary_amount / 100)::INTEGER;
+ALTER TABLE employees RENAME COLUMN salary_amount TO salary;
@@ -0,0 +1,26 @@
+-- Salaries become money: an amount in minor units plus an ISO 4217 currency and a pay period.
+-- Existing salaries were whole USD amounts per month.
+ALTER TABLE employees RENAME COLUMN salary TO salary_amount;
+ALTER TABLE employees
+    ALTER COLUMN salary_amount TYPE BIGINT USING salary_amount::BIGINT * 100,
+    ADD COLUMN salary_currency CHAR(3) NOT NULL DEFAULT 'USD',
+    ADD COLUMN pay_period VARCHAR NOT NULL DEFAULT 'monthly'
+        CHECK (pay_period IN ('monthly', 'annual', 'hourly'));
+ALTER TABLE employees ALTER COLUMN salary_currency DROP DEFAULT;
+
+ALTER TABLE compensation_changes RENAME COLUMN currency TO new_currency;
+ALTER TABLE compensation_changes
+    ALTER COLUMN old_amount TYPE BIGINT USING old_amount::BIGINT * 100,
+    ALTER COLUMN new_amount TYPE BIGINT USING new_amount::BIGINT * 100,
+    ADD COLUMN old_currency CHAR(3);
+UPDATE compensation_changes SET old_currency = new_currency WHERE old_amount IS NOT NULL;
+
+CREATE TABLE exchange_rates (
+    id SERIAL PRIMARY KEY,
+    base_currency CHAR(3) NOT NULL,
+    quote_currency CHAR(3) NOT NULL,
+    rate NUMERIC(20, 10) NOT NULL CHECK (rate > 0),
+    effective_date DATE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT exchange_rates_pair_date_key UNIQUE (base_currency, quote_currency, effective_date)
+);
@@ -0,0 +1,13 @@
+ALTER TABLE employees ADD COLUMN position VARCHAR;
+
+UPDATE employees e
+SET position = p.title
+FROM positions p
+WHERE p.id = e.position_id;
+
+ALTER TABLE employees
+    ALTER COLUMN position SET NOT NULL,
+    DROP COLUMN salary_out_of_band,
+    DROP COLUMN position_id;
+
+DROP TABLE IF EXISTS positions;
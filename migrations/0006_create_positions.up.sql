@@ -0,0 +1,38 @@
+-- Positions catalog with salary bands. Bands are optional so that titles already in use
+-- can be imported before HR has defined their ranges.
+CREATE TABLE positions (
+    id SERIAL PRIMARY KEY,
+    title VARCHAR NOT NULL,
+    job_level INTEGER NOT NULL DEFAULT 1 CHECK (job_level > 0),
+    min_salary BIGINT CHECK (min_salary > 0),
+    max_salary BIGINT,
+    currency CHAR(3),
+    pay_period VARCHAR NOT NULL DEFAULT 'monthly'
+        CHECK (pay_period IN ('monthly', 'annual', 'hourly')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT positions_title_key UNIQUE (title),
+    CONSTRAINT positions_band_check CHECK (
+        (min_salary IS NULL AND max_salary IS NULL AND currency IS NULL)
+        OR (min_salary IS NOT NULL AND max_salary >= min_salary AND currency IS NOT NULL)
+    )
+);
+
+INSERT INTO positions (title)
+SELECT DISTINCT position FROM employees;
+
+ALTER TABLE employees
+    ADD COLUMN position_id INTEGER,
+    ADD COLUMN salary_out_of_band BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE employees e
+SET position_id = p.id
+FROM positions p
+WHERE p.title = e.position;
+
+ALTER TABLE employees
+    ALTER COLUMN position_id SET NOT NULL,
+    ADD CONSTRAINT employees_position_id_fkey FOREIGN KEY (position_id) REFERENCES positions (id),
+    DROP COLUMN position;
+
+CREATE INDEX idx_employees_position_id ON employees (position_id);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS leave_requests;
+DROP TABLE IF EXISTS leave_balances;
+DROP TABLE IF EXISTS leave_types;
+
+ALTER TABLE employees DROP COLUMN IF EXISTS manager_id;
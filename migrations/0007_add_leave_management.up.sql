@@ -0,0 +1,55 @@
+-- Reporting line, used to route leave approvals to the employee's manager.
+ALTER TABLE employees
+    ADD COLUMN manager_id INTEGER,
+    ADD CONSTRAINT employees_manager_id_fkey FOREIGN KEY (manager_id) REFERENCES employees (id) ON DELETE SET NULL,
+    ADD CONSTRAINT employees_manager_not_self CHECK (manager_id <> id);
+
+CREATE INDEX idx_employees_manager_id ON employees (manager_id);
+
+CREATE TABLE leave_types (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    accrual_policy VARCHAR NOT NULL CHECK (accrual_policy IN ('none', 'annual', 'monthly')),
+    days_per_year NUMERIC(6, 2) NOT NULL DEFAULT 0 CHECK (days_per_year >= 0),
+    max_carry_over_days NUMERIC(6, 2) NOT NULL DEFAULT 0 CHECK (max_carry_over_days >= 0),
+    is_paid BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT leave_types_name_key UNIQUE (name)
+);
+
+CREATE TABLE leave_balances (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    leave_type_id INTEGER NOT NULL REFERENCES leave_types(id) ON DELETE CASCADE,
+    year INTEGER NOT NULL,
+    accrued_days NUMERIC(6, 2) NOT NULL DEFAULT 0,
+    carried_over_days NUMERIC(6, 2) NOT NULL DEFAULT 0,
+    used_days NUMERIC(6, 2) NOT NULL DEFAULT 0 CHECK (used_days >= 0),
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT leave_balances_employee_type_year_key UNIQUE (employee_id, leave_type_id, year)
+);
+
+CREATE TABLE leave_requests (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    leave_type_id INTEGER NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    days NUMERIC(6, 2) NOT NULL CHECK (days > 0),
+    reason VARCHAR,
+    status VARCHAR NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected', 'cancelled')),
+    decided_by INTEGER REFERENCES employees(id) ON DELETE SET NULL,
+    decided_at TIMESTAMP,
+    decision_comment VARCHAR,
+    -- Set while this request is what put the employee on leave, so the status can be restored afterwards.
+    status_applied BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT leave_requests_leave_type_id_fkey FOREIGN KEY (leave_type_id) REFERENCES leave_types (id),
+    CHECK (end_date >= start_date)
+);
+
+CREATE INDEX idx_leave_requests_employee_id ON leave_requests (employee_id);
+CREATE INDEX idx_leave_requests_status_dates ON leave_requests (status, start_date, end_date);
@@ -0,0 +1,5 @@
+ALTER TABLE employees DROP COLUMN IF EXISTS location_id;
+
+DROP TABLE IF EXISTS locations;
+DROP TABLE IF EXISTS holidays;
+DROP TABLE IF EXISTS holiday_calendars;
@@ -0,0 +1,35 @@
+-- Public holiday calendars. Each office location follows one calendar; working days for an
+-- employee are the weekdays that are not holidays in their location's calendar.
+CREATE TABLE holiday_calendars (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    country CHAR(2),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT holiday_calendars_name_key UNIQUE (name)
+);
+
+CREATE TABLE holidays (
+    id SERIAL PRIMARY KEY,
+    calendar_id INTEGER NOT NULL REFERENCES holiday_calendars(id) ON DELETE CASCADE,
+    date DATE NOT NULL,
+    name VARCHAR NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT holidays_calendar_date_key UNIQUE (calendar_id, date)
+);
+
+CREATE TABLE locations (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    holiday_calendar_id INTEGER,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT locations_name_key UNIQUE (name),
+    CONSTRAINT locations_holiday_calendar_id_fkey FOREIGN KEY (holiday_calendar_id) REFERENCES holiday_calendars (id)
+);
+
+ALTER TABLE employees
+    ADD COLUMN location_id INTEGER,
+    ADD CONSTRAINT employees_location_id_fkey FOREIGN KEY (location_id) REFERENCES locations (id);
+
+CREATE INDEX idx_employees_location_id ON employees (location_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS timesheets;
+DROP TABLE IF EXISTS attendance_entries;
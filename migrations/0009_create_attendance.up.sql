@@ -0,0 +1,34 @@
+-- Clock-in/clock-out punches. An entry without clock_out is the employee's open shift; there
+-- can be at most one.
+CREATE TABLE attendance_entries (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    clock_in TIMESTAMP NOT NULL,
+    clock_out TIMESTAMP,
+    note VARCHAR,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (clock_out > clock_in)
+);
+
+CREATE UNIQUE INDEX attendance_entries_open_key ON attendance_entries (employee_id) WHERE clock_out IS NULL;
+CREATE INDEX idx_attendance_entries_employee_clock_in ON attendance_entries (employee_id, clock_in);
+
+-- Weekly timesheets submitted for approval. Totals are a snapshot taken at submission; weeks
+-- start on Monday.
+CREATE TABLE timesheets (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    week_start DATE NOT NULL CHECK (EXTRACT(ISODOW FROM week_start) = 1),
+    status VARCHAR NOT NULL DEFAULT 'submitted' CHECK (status IN ('submitted', 'approved', 'rejected')),
+    total_minutes INTEGER NOT NULL CHECK (total_minutes >= 0),
+    regular_minutes INTEGER NOT NULL CHECK (regular_minutes >= 0),
+    overtime_minutes INTEGER NOT NULL CHECK (overtime_minutes >= 0),
+    submitted_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    decided_by INTEGER REFERENCES employees(id) ON DELETE SET NULL,
+    decided_at TIMESTAMP,
+    decision_comment VARCHAR,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT timesheets_employee_week_key UNIQUE (employee_id, week_start)
+);
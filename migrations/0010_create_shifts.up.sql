@@ -0,0 +1,29 @@
+-- Reusable shift patterns. A template whose end_time is not after its start_time runs overnight.
+CREATE TABLE shift_templates (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    break_minutes INTEGER NOT NULL DEFAULT 0 CHECK (break_minutes >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT shift_templates_name_key UNIQUE (name)
+);
+
+-- Rostered shifts. Times are copied from the template when rostering, so later template changes
+-- do not move shifts already planned; date is the day the shift starts.
+CREATE TABLE shifts (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    shift_template_id INTEGER NOT NULL,
+    date DATE NOT NULL,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    break_minutes INTEGER NOT NULL DEFAULT 0 CHECK (break_minutes >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (ends_at > starts_at),
+    CONSTRAINT shifts_shift_template_id_fkey FOREIGN KEY (shift_template_id) REFERENCES shift_templates(id)
+);
+
+CREATE INDEX idx_shifts_employee_starts_at ON shifts (employee_id, starts_at);
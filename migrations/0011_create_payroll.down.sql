@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS payslip_lines;
+DROP TABLE IF EXISTS payslips;
+DROP TABLE IF EXISTS payroll_runs;
+DROP TABLE IF EXISTS payroll_periods;
+DROP TABLE IF EXISTS payroll_rules;
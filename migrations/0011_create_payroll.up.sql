@@ -0,0 +1,74 @@
+-- Earnings, deductions and taxes applied to every payslip. Fixed rules add an amount in their
+-- currency each period; percentage rules take a share of the base pay (earnings), the gross pay
+-- (deductions) or the gross pay after deductions (taxes).
+CREATE TABLE payroll_rules (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    kind VARCHAR NOT NULL CHECK (kind IN ('earning', 'deduction', 'tax')),
+    method VARCHAR NOT NULL CHECK (method IN ('fixed', 'percentage')),
+    amount BIGINT CHECK (amount > 0),
+    currency CHAR(3),
+    rate NUMERIC(7, 4) CHECK (rate > 0 AND rate <= 100),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT payroll_rules_name_key UNIQUE (name),
+    CHECK ((method = 'fixed' AND amount IS NOT NULL AND currency IS NOT NULL AND rate IS NULL)
+        OR (method = 'percentage' AND rate IS NOT NULL AND amount IS NULL AND currency IS NULL))
+);
+
+-- Monthly pay periods.
+CREATE TABLE payroll_periods (
+    id SERIAL PRIMARY KEY,
+    year INTEGER NOT NULL,
+    month INTEGER NOT NULL CHECK (month BETWEEN 1 AND 12),
+    pay_date DATE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT payroll_periods_year_month_key UNIQUE (year, month)
+);
+
+-- One payroll run per period. Drafts can be recalculated; finalized runs can be reopened until
+-- they are locked.
+CREATE TABLE payroll_runs (
+    id SERIAL PRIMARY KEY,
+    payroll_period_id INTEGER NOT NULL REFERENCES payroll_periods(id),
+    status VARCHAR NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'finalized', 'locked')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finalized_at TIMESTAMP,
+    locked_at TIMESTAMP,
+    CONSTRAINT payroll_runs_period_key UNIQUE (payroll_period_id)
+);
+
+-- Payslips snapshot the employee and their compensation at calculation time. Amounts are in
+-- minor units of the salary currency.
+CREATE TABLE payslips (
+    id SERIAL PRIMARY KEY,
+    payroll_run_id INTEGER NOT NULL REFERENCES payroll_runs(id) ON DELETE CASCADE,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    employee_name VARCHAR NOT NULL,
+    position VARCHAR,
+    salary_amount BIGINT NOT NULL,
+    currency CHAR(3) NOT NULL,
+    pay_period VARCHAR NOT NULL,
+    base_pay BIGINT NOT NULL,
+    gross_pay BIGINT NOT NULL,
+    total_deductions BIGINT NOT NULL,
+    total_tax BIGINT NOT NULL,
+    net_pay BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT payslips_run_employee_key UNIQUE (payroll_run_id, employee_id)
+);
+
+CREATE TABLE payslip_lines (
+    id SERIAL PRIMARY KEY,
+    payslip_id INTEGER NOT NULL REFERENCES payslips(id) ON DELETE CASCADE,
+    payroll_rule_id INTEGER REFERENCES payroll_rules(id) ON DELETE SET NULL,
+    kind VARCHAR NOT NULL CHECK (kind IN ('base', 'earning', 'deduction', 'tax')),
+    name VARCHAR NOT NULL,
+    amount BIGINT NOT NULL,
+    line_number INTEGER NOT NULL
+);
+
+CREATE INDEX idx_payslips_employee_id ON payslips (employee_id);
+CREATE INDEX idx_payslip_lines_payslip_id ON payslip_lines (payslip_id);
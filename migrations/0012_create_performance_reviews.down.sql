@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS review_answers;
+DROP TABLE IF EXISTS review_submissions;
+DROP TABLE IF EXISTS reviews;
+DROP TABLE IF EXISTS review_questions;
+DROP TABLE IF EXISTS review_cycles;
+
+ALTER TABLE employees DROP COLUMN IF EXISTS department_id;
+
+DROP TABLE IF EXISTS departments;
@@ -0,0 +1,81 @@
+-- Departments group employees for reporting, such as calibrating review ratings.
+CREATE TABLE departments (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT departments_name_key UNIQUE (name)
+);
+
+ALTER TABLE employees
+    ADD COLUMN department_id INTEGER,
+    ADD CONSTRAINT employees_department_id_fkey FOREIGN KEY (department_id) REFERENCES departments (id);
+
+-- A review cycle covers a period of work. Its questions and rating scale can be changed while it
+-- is a draft; launching it opens a review for every employee, closing it locks completed reviews.
+-- Each stage takes submissions until its deadline.
+CREATE TABLE review_cycles (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    period_start DATE NOT NULL,
+    period_end DATE NOT NULL,
+    self_review_deadline DATE NOT NULL,
+    peer_review_deadline DATE NOT NULL,
+    manager_review_deadline DATE NOT NULL,
+    rating_labels TEXT[] NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'active', 'closed')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    launched_at TIMESTAMP,
+    closed_at TIMESTAMP,
+    CONSTRAINT review_cycles_name_key UNIQUE (name),
+    CHECK (period_end >= period_start),
+    CHECK (self_review_deadline <= peer_review_deadline AND peer_review_deadline <= manager_review_deadline)
+);
+
+CREATE TABLE review_questions (
+    id SERIAL PRIMARY KEY,
+    review_cycle_id INTEGER NOT NULL REFERENCES review_cycles(id) ON DELETE CASCADE,
+    text VARCHAR NOT NULL,
+    kind VARCHAR NOT NULL CHECK (kind IN ('rating', 'text')),
+    question_number INTEGER NOT NULL
+);
+
+-- One review per employee and cycle. The manager is the one at launch time.
+CREATE TABLE reviews (
+    id SERIAL PRIMARY KEY,
+    review_cycle_id INTEGER NOT NULL REFERENCES review_cycles(id) ON DELETE CASCADE,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    manager_id INTEGER REFERENCES employees(id) ON DELETE SET NULL,
+    status VARCHAR NOT NULL DEFAULT 'in_progress' CHECK (status IN ('in_progress', 'completed', 'locked')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP,
+    locked_at TIMESTAMP,
+    CONSTRAINT reviews_cycle_employee_key UNIQUE (review_cycle_id, employee_id)
+);
+
+-- The reviewers of a review, one row per reviewer and role, with their answers once submitted.
+CREATE TABLE review_submissions (
+    id SERIAL PRIMARY KEY,
+    review_id INTEGER NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    reviewer_id INTEGER NOT NULL,
+    role VARCHAR NOT NULL CHECK (role IN ('self', 'peer', 'manager')),
+    submitted_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT review_submissions_review_reviewer_role_key UNIQUE (review_id, reviewer_id, role),
+    CONSTRAINT review_submissions_reviewer_id_fkey FOREIGN KEY (reviewer_id) REFERENCES employees (id) ON DELETE CASCADE
+);
+
+CREATE TABLE review_answers (
+    review_submission_id INTEGER NOT NULL REFERENCES review_submissions(id) ON DELETE CASCADE,
+    review_question_id INTEGER NOT NULL REFERENCES review_questions(id) ON DELETE CASCADE,
+    rating INTEGER,
+    comment TEXT,
+    PRIMARY KEY (review_submission_id, review_question_id)
+);
+
+CREATE INDEX idx_employees_department_id ON employees (department_id);
+CREATE INDEX idx_review_questions_review_cycle_id ON review_questions (review_cycle_id);
+CREATE INDEX idx_reviews_employee_id ON reviews (employee_id);
+CREATE INDEX idx_review_submissions_reviewer_id ON review_submissions (reviewer_id);
@@ -0,0 +1,50 @@
+-- Goals are owned by an employee, or by the company when employee_id is NULL. A goal can be
+-- aligned to a parent goal of the company or of the owner's manager; parents cannot be deleted
+-- while goals are aligned to them.
+CREATE TABLE goals (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER REFERENCES employees(id) ON DELETE CASCADE,
+    parent_id INTEGER,
+    title VARCHAR NOT NULL,
+    description TEXT,
+    start_date DATE NOT NULL,
+    due_date DATE NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'completed', 'cancelled')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT goals_parent_id_fkey FOREIGN KEY (parent_id) REFERENCES goals (id),
+    CHECK (due_date >= start_date),
+    CHECK (parent_id <> id)
+);
+
+CREATE INDEX idx_goals_employee ON goals (employee_id);
+CREATE INDEX idx_goals_parent ON goals (parent_id);
+
+-- Key results measure a goal, moving from start_value towards target_value (which may be lower,
+-- e.g. for reducing costs). current_value holds the latest check-in.
+CREATE TABLE key_results (
+    id SERIAL PRIMARY KEY,
+    goal_id INTEGER NOT NULL REFERENCES goals(id) ON DELETE CASCADE,
+    title VARCHAR NOT NULL,
+    unit VARCHAR,
+    start_value NUMERIC(14, 2) NOT NULL,
+    target_value NUMERIC(14, 2) NOT NULL,
+    current_value NUMERIC(14, 2) NOT NULL,
+    key_result_number INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (target_value <> start_value)
+);
+
+CREATE INDEX idx_key_results_goal ON key_results (goal_id);
+
+-- Check-ins record the progress of a key result over time.
+CREATE TABLE goal_check_ins (
+    id SERIAL PRIMARY KEY,
+    key_result_id INTEGER NOT NULL REFERENCES key_results(id) ON DELETE CASCADE,
+    value NUMERIC(14, 2) NOT NULL,
+    comment TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_goal_check_ins_key_result ON goal_check_ins (key_result_id, created_at);
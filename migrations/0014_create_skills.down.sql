@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS employee_skills;
+DROP TABLE IF EXISTS skills;
+DROP TABLE IF EXISTS skill_categories;
@@ -0,0 +1,36 @@
+-- The skills taxonomy: every skill belongs to a category, e.g. Go under Programming languages.
+CREATE TABLE skill_categories (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT skill_categories_name_key UNIQUE (name)
+);
+
+CREATE TABLE skills (
+    id SERIAL PRIMARY KEY,
+    skill_category_id INTEGER NOT NULL,
+    name VARCHAR NOT NULL,
+    description TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT skills_skill_category_id_fkey FOREIGN KEY (skill_category_id) REFERENCES skill_categories (id),
+    CONSTRAINT skills_name_key UNIQUE (name)
+);
+
+CREATE INDEX idx_skills_category ON skills (skill_category_id);
+
+-- An employee's proficiency in a skill, from 1 (novice) to 5 (expert). last_verified_on is when
+-- the level was last confirmed, e.g. by an assessment or the employee's manager.
+CREATE TABLE employee_skills (
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    skill_id INTEGER NOT NULL,
+    level INTEGER NOT NULL CHECK (level BETWEEN 1 AND 5),
+    last_verified_on DATE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (employee_id, skill_id),
+    CONSTRAINT employee_skills_skill_id_fkey FOREIGN KEY (skill_id) REFERENCES skills (id)
+);
+
+CREATE INDEX idx_employee_skills_skill ON employee_skills (skill_id, level);
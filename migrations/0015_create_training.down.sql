@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS certification_evidence;
+DROP TABLE IF EXISTS certifications;
+DROP TABLE IF EXISTS training_enrollments;
+DROP TABLE IF EXISTS training_courses;
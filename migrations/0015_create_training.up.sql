@@ -0,0 +1,69 @@
+-- Courses employees can be enrolled in. certification_valid_months makes completing the course
+-- issue a certification valid for that many months, 0 for one that never expires; NULL courses
+-- issue none.
+CREATE TABLE training_courses (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    description TEXT,
+    provider VARCHAR,
+    duration_hours NUMERIC(6, 2) NOT NULL DEFAULT 0 CHECK (duration_hours >= 0),
+    certification_valid_months INTEGER CHECK (certification_valid_months BETWEEN 0 AND 120),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT training_courses_name_key UNIQUE (name)
+);
+
+CREATE TABLE training_enrollments (
+    id SERIAL PRIMARY KEY,
+    course_id INTEGER NOT NULL,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    status VARCHAR NOT NULL DEFAULT 'enrolled' CHECK (status IN ('enrolled', 'completed', 'cancelled')),
+    enrolled_on DATE NOT NULL,
+    due_on DATE,
+    completed_on DATE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT training_enrollments_course_id_fkey FOREIGN KEY (course_id) REFERENCES training_courses (id),
+    CHECK (due_on IS NULL OR due_on >= enrolled_on),
+    CHECK ((status = 'completed') = (completed_on IS NOT NULL))
+);
+
+-- Employees can retake a course, but only have one open enrollment in it at a time.
+CREATE UNIQUE INDEX training_enrollments_open_key ON training_enrollments (course_id, employee_id)
+    WHERE status = 'enrolled';
+CREATE INDEX idx_training_enrollments_employee ON training_enrollments (employee_id);
+
+-- expiry_notified_on is when the expiry notice went out, cleared whenever expires_on changes so a
+-- renewed certification is announced again before it expires.
+CREATE TABLE certifications (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    name VARCHAR NOT NULL,
+    issuer VARCHAR,
+    credential_id VARCHAR,
+    issued_on DATE NOT NULL,
+    expires_on DATE,
+    enrollment_id INTEGER REFERENCES training_enrollments(id) ON DELETE SET NULL,
+    expiry_notified_on DATE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (expires_on IS NULL OR expires_on > issued_on)
+);
+
+CREATE INDEX idx_certifications_employee ON certifications (employee_id);
+CREATE INDEX idx_certifications_expires_on ON certifications (expires_on) WHERE expires_on IS NOT NULL;
+
+-- Scans of certificates and similar proof, kept with the certification.
+CREATE TABLE certification_evidence (
+    id SERIAL PRIMARY KEY,
+    certification_id INTEGER NOT NULL,
+    file_name VARCHAR NOT NULL,
+    content_type VARCHAR NOT NULL,
+    size_bytes INTEGER NOT NULL,
+    content BYTEA NOT NULL,
+    uploaded_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT certification_evidence_certification_id_fkey FOREIGN KEY (certification_id)
+        REFERENCES certifications (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_certification_evidence_certification ON certification_evidence (certification_id);
@@ -0,0 +1,17 @@
+-- Metadata of documents kept with an employee, such as contracts, IDs and offer letters. The files
+-- live in blob storage under storage_key; keys start with employees/<employee_id>/ so the files of
+-- a deleted employee can be found there.
+CREATE TABLE employee_documents (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    category VARCHAR NOT NULL DEFAULT 'other' CHECK (category IN ('contract', 'identity', 'offer_letter', 'other')),
+    file_name VARCHAR NOT NULL,
+    content_type VARCHAR NOT NULL,
+    size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+    checksum_sha256 CHAR(64) NOT NULL,
+    storage_key VARCHAR NOT NULL,
+    uploaded_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT employee_documents_storage_key_key UNIQUE (storage_key)
+);
+
+CREATE INDEX idx_employee_documents_employee ON employee_documents (employee_id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS checklist_tasks;
+DROP TABLE IF EXISTS checklists;
+DROP TABLE IF EXISTS checklist_template_tasks;
+DROP TABLE IF EXISTS checklist_templates;
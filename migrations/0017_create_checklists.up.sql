@@ -0,0 +1,62 @@
+-- Templates of the tasks to go through when someone joins or leaves. A template can be limited to
+-- a position, a department or both; the most specific match is used, position before department.
+CREATE TABLE checklist_templates (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    kind VARCHAR NOT NULL CHECK (kind IN ('onboarding', 'offboarding')),
+    position_id INTEGER REFERENCES positions(id) ON DELETE CASCADE,
+    department_id INTEGER REFERENCES departments(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX checklist_templates_scope_key
+    ON checklist_templates (kind, COALESCE(position_id, 0), COALESCE(department_id, 0));
+
+-- owner_role decides who a task is assigned to: the employee joining or leaving, their manager or
+-- the designated owner_id. due_days counts from the hire or termination date and may be negative.
+CREATE TABLE checklist_template_tasks (
+    id SERIAL PRIMARY KEY,
+    template_id INTEGER NOT NULL REFERENCES checklist_templates(id) ON DELETE CASCADE,
+    task_number INTEGER NOT NULL,
+    title VARCHAR NOT NULL,
+    description TEXT,
+    owner_role VARCHAR NOT NULL CHECK (owner_role IN ('employee', 'manager', 'designated')),
+    owner_id INTEGER REFERENCES employees(id) ON DELETE CASCADE,
+    due_days INTEGER NOT NULL DEFAULT 0 CHECK (due_days BETWEEN -365 AND 365),
+    CHECK ((owner_role = 'designated') = (owner_id IS NOT NULL))
+);
+
+CREATE INDEX idx_checklist_template_tasks_template ON checklist_template_tasks (template_id);
+
+-- Checklists are started from a template and keep a copy of its tasks, so later template changes
+-- do not affect them. completed_at is set once every task is done.
+CREATE TABLE checklists (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    template_id INTEGER REFERENCES checklist_templates(id) ON DELETE SET NULL,
+    kind VARCHAR NOT NULL CHECK (kind IN ('onboarding', 'offboarding')),
+    name VARCHAR NOT NULL,
+    start_date DATE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP
+);
+
+-- An employee has at most one open checklist of each kind.
+CREATE UNIQUE INDEX checklists_open_key ON checklists (employee_id, kind) WHERE completed_at IS NULL;
+
+CREATE TABLE checklist_tasks (
+    id SERIAL PRIMARY KEY,
+    checklist_id INTEGER NOT NULL REFERENCES checklists(id) ON DELETE CASCADE,
+    task_number INTEGER NOT NULL,
+    title VARCHAR NOT NULL,
+    description TEXT,
+    owner_id INTEGER REFERENCES employees(id) ON DELETE SET NULL,
+    due_on DATE NOT NULL,
+    completed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_checklist_tasks_checklist ON checklist_tasks (checklist_id);
+CREATE INDEX idx_checklist_tasks_owner ON checklist_tasks (owner_id) WHERE completed_at IS NULL;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS approval_delegations;
+DROP TABLE IF EXISTS approval_tasks;
+DROP TABLE IF EXISTS approval_requests;
+DROP TABLE IF EXISTS approval_steps;
+DROP TABLE IF EXISTS approval_workflows;
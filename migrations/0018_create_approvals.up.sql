@@ -0,0 +1,90 @@
+-- One approval workflow per request type. Steps run by stage: every step of a stage has to approve
+-- before the next stage starts, so steps sharing a stage run in parallel.
+CREATE TABLE approval_workflows (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    request_type VARCHAR NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT approval_workflows_request_type_key UNIQUE (request_type),
+    CONSTRAINT approval_workflows_request_type_check CHECK (request_type IN ('salary_change', 'termination'))
+);
+
+-- approver_id is the position of 'position' steps (anyone holding it may decide) and the employee
+-- of 'employee' steps; 'manager' steps go to the manager of the employee the request is about.
+-- Pending tasks older than timeout_hours are escalated to escalate_to_id, or else to the
+-- assignee's manager.
+CREATE TABLE approval_steps (
+    id SERIAL PRIMARY KEY,
+    workflow_id INTEGER NOT NULL REFERENCES approval_workflows(id) ON DELETE CASCADE,
+    step_number INTEGER NOT NULL,
+    stage INTEGER NOT NULL CHECK (stage >= 1),
+    approver_type VARCHAR NOT NULL CHECK (approver_type IN ('manager', 'position', 'employee')),
+    approver_id INTEGER,
+    timeout_hours INTEGER NOT NULL DEFAULT 0 CHECK (timeout_hours >= 0),
+    escalate_to_id INTEGER REFERENCES employees(id) ON DELETE SET NULL,
+    CHECK ((approver_type = 'manager') = (approver_id IS NULL))
+);
+
+CREATE INDEX idx_approval_steps_workflow ON approval_steps (workflow_id);
+
+-- reference names what a request changes, e.g. employee:12, so the same change cannot be pending
+-- twice. payload holds the change itself, made by the owning usecase once approved; failed
+-- requests were approved but the change could not be made.
+CREATE TABLE approval_requests (
+    id SERIAL PRIMARY KEY,
+    request_type VARCHAR NOT NULL,
+    reference VARCHAR NOT NULL,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    requested_by INTEGER REFERENCES employees(id) ON DELETE SET NULL,
+    summary TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected', 'cancelled', 'failed')),
+    current_stage INTEGER NOT NULL DEFAULT 0,
+    failure_reason TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    decided_at TIMESTAMP,
+    CONSTRAINT approval_requests_request_type_check CHECK (request_type IN ('salary_change', 'termination'))
+);
+
+CREATE UNIQUE INDEX approval_requests_pending_key ON approval_requests (request_type, reference)
+    WHERE status = 'pending';
+CREATE INDEX idx_approval_requests_employee ON approval_requests (employee_id);
+
+-- The steps of a workflow copied onto a request. Tasks of later stages wait until the earlier
+-- stages are approved; manager steps of employees without a manager are skipped.
+CREATE TABLE approval_tasks (
+    id SERIAL PRIMARY KEY,
+    request_id INTEGER NOT NULL REFERENCES approval_requests(id) ON DELETE CASCADE,
+    stage INTEGER NOT NULL,
+    approver_type VARCHAR NOT NULL,
+    assignee_id INTEGER REFERENCES employees(id) ON DELETE SET NULL,
+    position_id INTEGER REFERENCES positions(id) ON DELETE SET NULL,
+    status VARCHAR NOT NULL
+        CHECK (status IN ('waiting', 'pending', 'approved', 'rejected', 'skipped', 'cancelled')),
+    timeout_hours INTEGER NOT NULL DEFAULT 0,
+    escalate_to_id INTEGER REFERENCES employees(id) ON DELETE SET NULL,
+    due_at TIMESTAMP,
+    escalated_at TIMESTAMP,
+    decided_by INTEGER REFERENCES employees(id) ON DELETE SET NULL,
+    comment TEXT,
+    decided_at TIMESTAMP
+);
+
+CREATE INDEX idx_approval_tasks_request ON approval_tasks (request_id);
+CREATE INDEX idx_approval_tasks_pending ON approval_tasks (assignee_id) WHERE status = 'pending';
+
+-- While a delegation is in effect the delegate may decide everything the delegator may.
+CREATE TABLE approval_delegations (
+    id SERIAL PRIMARY KEY,
+    delegator_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    delegate_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (end_date >= start_date),
+    CHECK (delegate_id <> delegator_id)
+);
+
+CREATE INDEX idx_approval_delegations_delegate ON approval_delegations (delegate_id, start_date, end_date);
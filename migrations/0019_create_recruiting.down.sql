@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS interviews;
+DROP TABLE IF EXISTS candidates;
+DROP TABLE IF EXISTS job_requisitions;
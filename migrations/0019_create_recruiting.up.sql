@@ -0,0 +1,60 @@
+-- Openings to hire for. A requisition is filled once as many candidates were hired as it has
+-- openings.
+CREATE TABLE job_requisitions (
+    id SERIAL PRIMARY KEY,
+    title VARCHAR NOT NULL,
+    position_id INTEGER NOT NULL REFERENCES positions(id),
+    department_id INTEGER REFERENCES departments(id) ON DELETE SET NULL,
+    location_id INTEGER REFERENCES locations(id) ON DELETE SET NULL,
+    hiring_manager_id INTEGER REFERENCES employees(id) ON DELETE SET NULL,
+    openings INTEGER NOT NULL DEFAULT 1 CHECK (openings >= 1),
+    status VARCHAR NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'on_hold', 'filled', 'closed')),
+    description TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_job_requisitions_status ON job_requisitions (status);
+
+-- Applicants to a requisition, moving through the pipeline stages. The offer is recorded on the
+-- candidate and carried over to the employee created once it is accepted.
+CREATE TABLE candidates (
+    id SERIAL PRIMARY KEY,
+    requisition_id INTEGER NOT NULL REFERENCES job_requisitions(id),
+    name VARCHAR NOT NULL,
+    email VARCHAR NOT NULL,
+    phone VARCHAR,
+    source VARCHAR,
+    notes TEXT,
+    stage VARCHAR NOT NULL DEFAULT 'applied'
+        CHECK (stage IN ('applied', 'screening', 'interview', 'offer', 'hired', 'rejected', 'withdrawn')),
+    stage_changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    offer_salary_amount BIGINT CHECK (offer_salary_amount > 0),
+    offer_salary_currency CHAR(3),
+    offer_pay_period VARCHAR CHECK (offer_pay_period IN ('monthly', 'annual', 'hourly')),
+    offer_start_date DATE,
+    employee_id INTEGER REFERENCES employees(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX candidates_requisition_email_key ON candidates (requisition_id, email);
+CREATE INDEX idx_candidates_stage ON candidates (stage);
+
+CREATE TABLE interviews (
+    id SERIAL PRIMARY KEY,
+    candidate_id INTEGER NOT NULL REFERENCES candidates(id) ON DELETE CASCADE,
+    interviewer_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    scheduled_at TIMESTAMP NOT NULL,
+    duration_minutes INTEGER NOT NULL CHECK (duration_minutes BETWEEN 15 AND 480),
+    status VARCHAR NOT NULL DEFAULT 'scheduled' CHECK (status IN ('scheduled', 'completed', 'cancelled')),
+    rating INTEGER CHECK (rating BETWEEN 1 AND 5),
+    recommendation VARCHAR CHECK (recommendation IN ('strong_yes', 'yes', 'no', 'strong_no')),
+    feedback TEXT,
+    feedback_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_interviews_candidate ON interviews (candidate_id);
+CREATE INDEX idx_interviews_interviewer ON interviews (interviewer_id, scheduled_at);
@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS location_assignments;
+
+ALTER TABLE locations
+    DROP COLUMN IF EXISTS remote,
+    DROP COLUMN IF EXISTS time_zone,
+    DROP COLUMN IF EXISTS country,
+    DROP COLUMN IF EXISTS postal_code,
+    DROP COLUMN IF EXISTS state,
+    DROP COLUMN IF EXISTS city,
+    DROP COLUMN IF EXISTS address_line2,
+    DROP COLUMN IF EXISTS address_line1;
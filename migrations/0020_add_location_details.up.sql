@@ -0,0 +1,33 @@
+-- Offices have a postal address; remote locations group staff working from home and need none.
+-- The time zone decides which calendar date it is for the employees at the location.
+ALTER TABLE locations
+    ADD COLUMN address_line1 VARCHAR,
+    ADD COLUMN address_line2 VARCHAR,
+    ADD COLUMN city VARCHAR,
+    ADD COLUMN state VARCHAR,
+    ADD COLUMN postal_code VARCHAR,
+    ADD COLUMN country CHAR(2),
+    ADD COLUMN time_zone VARCHAR NOT NULL DEFAULT 'UTC',
+    ADD COLUMN remote BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Timeline of the primary location of every employee. Future-dated assignments stay scheduled
+-- until the effective date, when they are applied to employees.location_id.
+CREATE TABLE location_assignments (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    location_id INTEGER NOT NULL REFERENCES locations(id),
+    effective_date DATE NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'scheduled'
+        CHECK (status IN ('scheduled', 'applied', 'cancelled')),
+    applied_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_location_assignments_employee_id ON location_assignments (employee_id);
+CREATE INDEX idx_location_assignments_due ON location_assignments (effective_date) WHERE status = 'scheduled';
+
+-- Seed the timeline with the location every existing employee currently has.
+INSERT INTO location_assignments (employee_id, location_id, effective_date, status, applied_at)
+SELECT id, location_id, hired_date, 'applied', created_at
+FROM employees
+WHERE location_id IS NOT NULL;
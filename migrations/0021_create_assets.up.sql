@@ -0,0 +1,37 @@
+-- Company equipment handed out to employees. status never says 'assigned': an asset is assigned
+-- while it has an open assignment, so deleting the employee holding it frees it again.
+CREATE TABLE assets (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    type VARCHAR NOT NULL
+        CHECK (type IN ('laptop', 'desktop', 'monitor', 'phone', 'tablet', 'badge', 'key', 'other')),
+    serial_number VARCHAR NOT NULL,
+    purchase_date DATE,
+    status VARCHAR NOT NULL DEFAULT 'available' CHECK (status IN ('available', 'in_repair', 'lost', 'retired')),
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT assets_serial_number_key UNIQUE (serial_number)
+);
+
+CREATE INDEX idx_assets_status ON assets (status);
+
+-- Who held an asset when. The assignment is open until returned_on is set.
+CREATE TABLE asset_assignments (
+    id SERIAL PRIMARY KEY,
+    asset_id INTEGER NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    employee_id INTEGER NOT NULL,
+    assigned_on DATE NOT NULL,
+    returned_on DATE,
+    notes TEXT,
+    return_notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT asset_assignments_employee_id_fkey FOREIGN KEY (employee_id)
+        REFERENCES employees (id) ON DELETE CASCADE,
+    CHECK (returned_on IS NULL OR returned_on >= assigned_on)
+);
+
+-- An asset is held by one employee at a time.
+CREATE UNIQUE INDEX asset_assignments_open_key ON asset_assignments (asset_id) WHERE returned_on IS NULL;
+CREATE INDEX idx_asset_assignments_employee ON asset_assignments (employee_id);
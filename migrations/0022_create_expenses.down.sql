@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS expense_receipts;
+DROP TABLE IF EXISTS expense_items;
+DROP TABLE IF EXISTS expense_claims;
+DROP TABLE IF EXISTS expense_categories;
+
+DELETE FROM approval_requests WHERE request_type = 'expense';
+DELETE FROM approval_workflows WHERE request_type = 'expense';
+ALTER TABLE approval_requests DROP CONSTRAINT approval_requests_request_type_check;
+ALTER TABLE approval_requests ADD CONSTRAINT approval_requests_request_type_check
+    CHECK (request_type IN ('salary_change', 'termination'));
+ALTER TABLE approval_workflows DROP CONSTRAINT approval_workflows_request_type_check;
+ALTER TABLE approval_workflows ADD CONSTRAINT approval_workflows_request_type_check
+    CHECK (request_type IN ('salary_change', 'termination'));
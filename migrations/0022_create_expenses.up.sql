@@ -0,0 +1,79 @@
+-- Expense claims go through the approval workflow of their own request type.
+ALTER TABLE approval_workflows DROP CONSTRAINT approval_workflows_request_type_check;
+ALTER TABLE approval_workflows ADD CONSTRAINT approval_workflows_request_type_check
+    CHECK (request_type IN ('salary_change', 'termination', 'expense'));
+ALTER TABLE approval_requests DROP CONSTRAINT approval_requests_request_type_check;
+ALTER TABLE approval_requests ADD CONSTRAINT approval_requests_request_type_check
+    CHECK (request_type IN ('salary_change', 'termination', 'expense'));
+
+-- Kinds of expenses employees claim. limit_amount caps what a single claim may hold of the
+-- category; claims with items of receipt_required categories need a receipt to be submitted.
+CREATE TABLE expense_categories (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    description TEXT,
+    limit_amount BIGINT CHECK (limit_amount > 0),
+    limit_currency CHAR(3),
+    receipt_required BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT expense_categories_name_key UNIQUE (name),
+    CHECK ((limit_amount IS NULL) = (limit_currency IS NULL))
+);
+
+-- Claims are drafted by the employee, submitted for approval and reimbursed once approved, usually
+-- with a payroll run. approval_request_id is set while a workflow decides the claim; without one
+-- the employee's manager decides it directly.
+CREATE TABLE expense_claims (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    title VARCHAR NOT NULL,
+    currency CHAR(3) NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'draft'
+        CHECK (status IN ('draft', 'submitted', 'approved', 'rejected', 'reimbursed')),
+    submitted_at TIMESTAMP,
+    approval_request_id INTEGER REFERENCES approval_requests(id) ON DELETE SET NULL,
+    decided_by INTEGER REFERENCES employees(id) ON DELETE SET NULL,
+    decided_at TIMESTAMP,
+    decision_comment TEXT,
+    reimbursed_on DATE,
+    payroll_run_id INTEGER REFERENCES payroll_runs(id) ON DELETE SET NULL,
+    reimbursement_reference VARCHAR,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((status = 'reimbursed') = (reimbursed_on IS NOT NULL))
+);
+
+CREATE INDEX idx_expense_claims_employee ON expense_claims (employee_id);
+CREATE INDEX idx_expense_claims_status ON expense_claims (status);
+
+-- Amounts are in the currency of the claim.
+CREATE TABLE expense_items (
+    id SERIAL PRIMARY KEY,
+    claim_id INTEGER NOT NULL REFERENCES expense_claims(id) ON DELETE CASCADE,
+    item_number INTEGER NOT NULL,
+    category_id INTEGER NOT NULL,
+    expense_date DATE NOT NULL,
+    description VARCHAR NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    CONSTRAINT expense_items_claim_number_key UNIQUE (claim_id, item_number),
+    CONSTRAINT expense_items_category_id_fkey FOREIGN KEY (category_id) REFERENCES expense_categories (id)
+);
+
+CREATE INDEX idx_expense_items_expense_date ON expense_items (expense_date);
+
+-- Receipts of a claim. The files live in blob storage under storage_key, below
+-- employees/<employee_id>/ like employee documents.
+CREATE TABLE expense_receipts (
+    id SERIAL PRIMARY KEY,
+    claim_id INTEGER NOT NULL REFERENCES expense_claims(id) ON DELETE CASCADE,
+    file_name VARCHAR NOT NULL,
+    content_type VARCHAR NOT NULL,
+    size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+    checksum_sha256 CHAR(64) NOT NULL,
+    storage_key VARCHAR NOT NULL,
+    uploaded_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT expense_receipts_storage_key_key UNIQUE (storage_key)
+);
+
+CREATE INDEX idx_expense_receipts_claim ON expense_receipts (claim_id);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS benefit_enrollment_dependents;
+DROP TABLE IF EXISTS benefit_enrollments;
+DROP TABLE IF EXISTS benefit_dependents;
+DROP TABLE IF EXISTS benefit_enrollment_windows;
+DROP TABLE IF EXISTS benefit_plan_locations;
+DROP TABLE IF EXISTS benefit_plans;
@@ -0,0 +1,82 @@
+-- Benefits employees can enroll in. Contributions are monthly: the employee's share is deducted by
+-- payroll, plus dependent_contribution for every covered dependent; the employer's share is only
+-- reported. An employee is eligible when their status is one of eligible_statuses, they have been
+-- employed for min_tenure_months and, for plans limited to locations, work at one of them.
+CREATE TABLE benefit_plans (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    type VARCHAR NOT NULL
+        CHECK (type IN ('health', 'dental', 'vision', 'life', 'disability', 'pension', 'other')),
+    description TEXT,
+    currency CHAR(3) NOT NULL,
+    employee_contribution BIGINT NOT NULL DEFAULT 0 CHECK (employee_contribution >= 0),
+    employer_contribution BIGINT NOT NULL DEFAULT 0 CHECK (employer_contribution >= 0),
+    covers_dependents BOOLEAN NOT NULL DEFAULT FALSE,
+    dependent_contribution BIGINT NOT NULL DEFAULT 0 CHECK (dependent_contribution >= 0),
+    eligible_statuses TEXT[] NOT NULL,
+    min_tenure_months INTEGER NOT NULL DEFAULT 0 CHECK (min_tenure_months >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT benefit_plans_name_key UNIQUE (name)
+);
+
+-- Locations a plan is limited to; plans without any are open to every location.
+CREATE TABLE benefit_plan_locations (
+    plan_id INTEGER NOT NULL REFERENCES benefit_plans(id) ON DELETE CASCADE,
+    location_id INTEGER NOT NULL,
+    PRIMARY KEY (plan_id, location_id),
+    CONSTRAINT benefit_plan_locations_location_id_fkey FOREIGN KEY (location_id)
+        REFERENCES locations (id)
+);
+
+-- Periods employees may enroll in, with coverage starting on coverage_starts_on.
+CREATE TABLE benefit_enrollment_windows (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    starts_on DATE NOT NULL,
+    ends_on DATE NOT NULL,
+    coverage_starts_on DATE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (ends_on >= starts_on),
+    CHECK (coverage_starts_on >= starts_on)
+);
+
+-- Family members employees can cover through their benefits.
+CREATE TABLE benefit_dependents (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    name VARCHAR NOT NULL,
+    relationship VARCHAR NOT NULL CHECK (relationship IN ('spouse', 'partner', 'child', 'other')),
+    date_of_birth DATE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_benefit_dependents_employee ON benefit_dependents (employee_id);
+
+-- An employee's coverage by a plan. Coverage is open until coverage_ends_on is set, always the last
+-- day of a month.
+CREATE TABLE benefit_enrollments (
+    id SERIAL PRIMARY KEY,
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    plan_id INTEGER NOT NULL,
+    window_id INTEGER REFERENCES benefit_enrollment_windows(id) ON DELETE SET NULL,
+    coverage_starts_on DATE NOT NULL,
+    coverage_ends_on DATE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT benefit_enrollments_plan_id_fkey FOREIGN KEY (plan_id)
+        REFERENCES benefit_plans (id),
+    CHECK (coverage_ends_on IS NULL OR coverage_ends_on >= coverage_starts_on)
+);
+
+-- An employee is covered by a plan once at a time.
+CREATE UNIQUE INDEX benefit_enrollments_open_key ON benefit_enrollments (employee_id, plan_id)
+    WHERE coverage_ends_on IS NULL;
+CREATE INDEX idx_benefit_enrollments_plan ON benefit_enrollments (plan_id);
+
+CREATE TABLE benefit_enrollment_dependents (
+    enrollment_id INTEGER NOT NULL REFERENCES benefit_enrollments(id) ON DELETE CASCADE,
+    dependent_id INTEGER NOT NULL REFERENCES benefit_dependents(id) ON DELETE CASCADE,
+    PRIMARY KEY (enrollment_id, dependent_id)
+);
@@ -0,0 +1,25 @@
+-- Employee attributes defined at runtime. Each employee keeps their values in
+-- employees.custom_fields, keyed by the definition's key; the application checks them against the
+-- definitions, which the database cannot do for JSONB.
+CREATE TABLE custom_field_definitions (
+    id SERIAL PRIMARY KEY,
+    key VARCHAR NOT NULL,
+    label VARCHAR NOT NULL,
+    type VARCHAR NOT NULL
+        CHECK (type IN ('text', 'number', 'boolean', 'date', 'select', 'multi_select')),
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    options TEXT[] NOT NULL DEFAULT '{}',
+    min_length INTEGER CHECK (min_length >= 0),
+    max_length INTEGER CHECK (max_length >= 0),
+    pattern TEXT,
+    min_value DOUBLE PRECISION,
+    max_value DOUBLE PRECISION,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT custom_field_definitions_key_key UNIQUE (key)
+);
+
+ALTER TABLE employees ADD COLUMN custom_fields JSONB NOT NULL DEFAULT '{}';
+
+-- Serves the containment (@>) filters of the employee list.
+CREATE INDEX idx_employees_custom_fields ON employees USING GIN (custom_fields);
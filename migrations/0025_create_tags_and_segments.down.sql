@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS employee_segments;
+DROP TABLE IF EXISTS employee_tags;
@@ -0,0 +1,24 @@
+-- Free-form labels on employees, kept lower-case so that tags differing only in case are the same.
+CREATE TABLE employee_tags (
+    employee_id INTEGER NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    tag VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (employee_id, tag)
+);
+
+CREATE INDEX idx_employee_tags_tag ON employee_tags (tag);
+
+-- Saved employee list filters. Members are not stored: a segment is evaluated whenever it is used,
+-- so it always targets the employees matching its filter at the time.
+CREATE TABLE employee_segments (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    description TEXT,
+    filter JSONB NOT NULL DEFAULT '{}',
+    created_by INTEGER,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT employee_segments_name_key UNIQUE (name),
+    CONSTRAINT employee_segments_created_by_fkey FOREIGN KEY (created_by)
+        REFERENCES employees (id) ON DELETE SET NULL
+);
@@ -0,0 +1,10 @@
+ALTER TABLE leave_requests DROP COLUMN IF EXISTS approval_request_id;
+
+DELETE FROM approval_requests WHERE request_type = 'leave';
+DELETE FROM approval_workflows WHERE request_type = 'leave';
+ALTER TABLE approval_requests DROP CONSTRAINT approval_requests_request_type_check;
+ALTER TABLE approval_requests ADD CONSTRAINT approval_requests_request_type_check
+    CHECK (request_type IN ('salary_change', 'termination', 'expense'));
+ALTER TABLE approval_workflows DROP CONSTRAINT approval_workflows_request_type_check;
+ALTER TABLE approval_workflows ADD CONSTRAINT approval_workflows_request_type_check
+    CHECK (request_type IN ('salary_change', 'termination', 'expense'));
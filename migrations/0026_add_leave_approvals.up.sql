@@ -0,0 +1,9 @@
+ALTER TABLE approval_workflows DROP CONSTRAINT approval_workflows_request_type_check;
+ALTER TABLE approval_workflows ADD CONSTRAINT approval_workflows_request_type_check
+    CHECK (request_type IN ('salary_change', 'termination', 'expense', 'leave'));
+ALTER TABLE approval_requests DROP CONSTRAINT approval_requests_request_type_check;
+ALTER TABLE approval_requests ADD CONSTRAINT approval_requests_request_type_check
+    CHECK (request_type IN ('salary_change', 'termination', 'expense', 'leave'));
+
+ALTER TABLE leave_requests
+    ADD COLUMN approval_request_id INTEGER REFERENCES approval_requests(id) ON DELETE SET NULL;
@@ -0,0 +1,7 @@
+ALTER TABLE payslip_lines DROP COLUMN IF EXISTS expense_claim_id;
+DELETE FROM payslip_lines WHERE kind = 'reimbursement';
+ALTER TABLE payslip_lines DROP CONSTRAINT payslip_lines_kind_check;
+ALTER TABLE payslip_lines ADD CONSTRAINT payslip_lines_kind_check
+    CHECK (kind IN ('base', 'earning', 'deduction', 'tax'));
+
+ALTER TABLE payslips DROP COLUMN IF EXISTS total_reimbursements;
@@ -0,0 +1,9 @@
+-- Approved expense claims are paid back on payslips, on top of the net pay and outside the taxed
+-- gross pay. A claim is held by the run that pays it and marked reimbursed once the run is locked.
+ALTER TABLE payslips ADD COLUMN total_reimbursements BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE payslip_lines DROP CONSTRAINT payslip_lines_kind_check;
+ALTER TABLE payslip_lines ADD CONSTRAINT payslip_lines_kind_check
+    CHECK (kind IN ('base', 'earning', 'deduction', 'tax', 'reimbursement'));
+ALTER TABLE payslip_lines
+    ADD COLUMN expense_claim_id INTEGER REFERENCES expense_claims(id) ON DELETE SET NULL;
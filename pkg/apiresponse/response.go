@@ -36,6 +36,18 @@ func Success(c echo.Context, msg string, data interface{}) error {
 	})
 }
 
+// Accepted reports a request that was taken on but not carried out yet, e.g. a change waiting for
+// approval.
+func Accepted(c echo.Context, msg string, data interface{}) error {
+	return c.JSON(http.StatusAccepted, StandardResponse{
+		Success:   true,
+		Message:   msg,
+		Data:      data,
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		RequestID: getRequestID(c),
+	})
+}
+
 func DeletedResource(c echo.Context, msg string) error {
 	return c.JSON(http.StatusNoContent, StandardResponse{
 		Success:   true,
@@ -24,7 +24,7 @@ var (
 		Err:            errors.New("invalid position"),
 		Code:           constants.BadRequestError,
 		HTTPStatusCode: http.StatusBadRequest,
-		PublicMsg:      "Position is required and must be at least 3 characters long",
+		PublicMsg:      "Position ID is required and must be a valid number",
 	}
 	ErrInvalidHiredDate = &AppError{
 		Err:            errors.New("invalid hired date"),
@@ -36,7 +36,7 @@ var (
 		Err:            errors.New("invalid salary"),
 		Code:           constants.BadRequestError,
 		HTTPStatusCode: http.StatusBadRequest,
-		PublicMsg:      "Salary is required and must be a valid number",
+		PublicMsg:      "Salary is required and must be a positive amount in minor units",
 	}
 	ErrEmployeeNotFound = &AppError{
 		Err:            errors.New("employee not found"),
@@ -50,4 +50,1774 @@ var (
 		HTTPStatusCode: http.StatusBadRequest,
 		PublicMsg:      "Employee ID is required and must be a valid number",
 	}
+	ErrInvalidEmail = &AppError{
+		Err:            errors.New("invalid email"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Email must be a valid email address",
+	}
+	ErrInvalidPhone = &AppError{
+		Err:            errors.New("invalid phone"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Phone must be in E.164 format, e.g. +14155552671",
+	}
+	ErrInvalidAddress = &AppError{
+		Err:            errors.New("invalid address"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Address requires line1, city, postal code and a two-letter ISO country code",
+	}
+	ErrInvalidDateOfBirth = &AppError{
+		Err:            errors.New("invalid date of birth"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Date of birth must be in the past and before the hired date",
+	}
+	ErrWorkEmailAlreadyExists = &AppError{
+		Err:            errors.New("work email already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Work email is already used by another employee",
+	}
+	ErrPersonalEmailAlreadyExists = &AppError{
+		Err:            errors.New("personal email already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Personal email is already used by another employee",
+	}
+	ErrEmergencyContactNotFound = &AppError{
+		Err:            errors.New("emergency contact not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Emergency contact not found",
+	}
+	ErrInvalidEmergencyContactId = &AppError{
+		Err:            errors.New("invalid emergency contact id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Emergency contact ID is required and must be a valid number",
+	}
+	ErrInvalidContactName = &AppError{
+		Err:            errors.New("invalid contact name"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Contact name is required and must be at least 2 characters long",
+	}
+	ErrInvalidRelationship = &AppError{
+		Err:            errors.New("invalid relationship"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Relationship is required",
+	}
+	ErrInvalidEmploymentStatus = &AppError{
+		Err:            errors.New("invalid employment status"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Employment status must be one of candidate, active, on_leave, suspended or terminated",
+	}
+	ErrInvalidStatusTransition = &AppError{
+		Err:            errors.New("invalid employment status transition"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The employee cannot move from the current status to the requested one",
+	}
+	ErrEmploymentStatusChanged = &AppError{
+		Err:            errors.New("employment status changed concurrently"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The employee status was changed by another request, please retry",
+	}
+	ErrInvalidEffectiveDate = &AppError{
+		Err:            errors.New("invalid effective date"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Effective date is required and must be a valid date",
+	}
+	ErrInvalidTerminationDate = &AppError{
+		Err:            errors.New("invalid termination date"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Termination date is required and must not be before the hired date",
+	}
+	ErrInvalidTerminationReason = &AppError{
+		Err:            errors.New("invalid termination reason"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Termination reason is required",
+	}
+	ErrInvalidRehireDate = &AppError{
+		Err:            errors.New("invalid rehire date"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Rehire date is required and must be after the termination date",
+	}
+	ErrScheduledCompensationChangeNotFound = &AppError{
+		Err:            errors.New("scheduled compensation change not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Scheduled compensation change not found",
+	}
+	ErrInvalidCompensationChangeId = &AppError{
+		Err:            errors.New("invalid compensation change id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Compensation change ID is required and must be a valid number",
+	}
+	ErrInvalidCurrency = &AppError{
+		Err:            errors.New("invalid currency"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Currency must be an active ISO 4217 code, e.g. USD",
+	}
+	ErrInvalidCompensationReason = &AppError{
+		Err:            errors.New("invalid compensation reason"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Reason is required for a compensation change",
+	}
+	ErrInvalidApprover = &AppError{
+		Err:            errors.New("invalid approver"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Approver is required for a compensation change",
+	}
+	ErrInvalidPayPeriod = &AppError{
+		Err:            errors.New("invalid pay period"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Pay period must be one of monthly, annual or hourly",
+	}
+	ErrInvalidExchangeRate = &AppError{
+		Err:            errors.New("invalid exchange rate"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Exchange rate must be a positive number between two different currencies",
+	}
+	ErrExchangeRateAlreadyExists = &AppError{
+		Err:            errors.New("exchange rate already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "An exchange rate for this currency pair and date already exists",
+	}
+	ErrExchangeRateNotFound = &AppError{
+		Err:            errors.New("exchange rate not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "No exchange rate is available for the requested currencies",
+	}
+	ErrPositionNotFound = &AppError{
+		Err:            errors.New("position not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Position not found",
+	}
+	ErrInvalidPositionTitle = &AppError{
+		Err:            errors.New("invalid position title"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Position title is required and must be at least 3 characters long",
+	}
+	ErrInvalidJobLevel = &AppError{
+		Err:            errors.New("invalid job level"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Job level must be a positive number",
+	}
+	ErrInvalidSalaryBand = &AppError{
+		Err:            errors.New("invalid salary band"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Salary band needs positive minimum and maximum amounts in the same currency, with the minimum not above the maximum",
+	}
+	ErrPositionAlreadyExists = &AppError{
+		Err:            errors.New("position already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "A position with this title already exists",
+	}
+	ErrPositionInUse = &AppError{
+		Err:            errors.New("position in use"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Position is still assigned to employees",
+	}
+	ErrSalaryOutOfBand = &AppError{
+		Err:            errors.New("salary out of band"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Salary is outside the band of the position",
+	}
+	ErrInvalidManager = &AppError{
+		Err:            errors.New("invalid manager"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Manager must be another employee who has not been terminated",
+	}
+	ErrManagerNotFound = &AppError{
+		Err:            errors.New("manager not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Manager not found",
+	}
+	ErrManagerCycle = &AppError{
+		Err:            errors.New("manager cycle"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Manager assignment would make the employee report to themselves",
+	}
+	ErrLeaveTypeNotFound = &AppError{
+		Err:            errors.New("leave type not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Leave type not found",
+	}
+	ErrInvalidLeaveTypeId = &AppError{
+		Err:            errors.New("invalid leave type id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Leave type ID is required and must be a valid number",
+	}
+	ErrInvalidLeaveType = &AppError{
+		Err:            errors.New("invalid leave type"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Leave type needs a name, an accrual policy of none, annual or monthly, and non-negative day counts",
+	}
+	ErrLeaveTypeAlreadyExists = &AppError{
+		Err:            errors.New("leave type already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "A leave type with this name already exists",
+	}
+	ErrLeaveTypeInUse = &AppError{
+		Err:            errors.New("leave type in use"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Leave type is referenced by leave requests",
+	}
+	ErrInvalidYear = &AppError{
+		Err:            errors.New("invalid year"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Year must be a valid calendar year",
+	}
+	ErrInvalidLeaveDates = &AppError{
+		Err:            errors.New("invalid leave dates"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Leave must start on or after the hired date, end on or after its start within the same year, and include a working day",
+	}
+	ErrLeaveRequestOverlaps = &AppError{
+		Err:            errors.New("leave request overlaps"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Leave request overlaps another pending or approved request",
+	}
+	ErrInsufficientLeaveBalance = &AppError{
+		Err:            errors.New("insufficient leave balance"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Leave balance does not cover the requested days",
+	}
+	ErrLeaveRequestNotFound = &AppError{
+		Err:            errors.New("leave request not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Leave request not found",
+	}
+	ErrInvalidLeaveRequestId = &AppError{
+		Err:            errors.New("invalid leave request id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Leave request ID is required and must be a valid number",
+	}
+	ErrLeaveRequestClosed = &AppError{
+		Err:            errors.New("leave request closed"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Leave request has already been decided or cancelled",
+	}
+	ErrInvalidRequestApprover = &AppError{
+		Err:            errors.New("invalid request approver"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Approver is required and must be another employee",
+	}
+	ErrApproverNotManager = &AppError{
+		Err:            errors.New("approver is not the manager"),
+		Code:           constants.ForbiddenError,
+		HTTPStatusCode: http.StatusForbidden,
+		PublicMsg:      "Only the employee's manager can decide this request",
+	}
+	ErrEmployeeTerminated = &AppError{
+		Err:            errors.New("employee terminated"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "This action is not available for terminated employees",
+	}
+	ErrHolidayCalendarNotFound = &AppError{
+		Err:            errors.New("holiday calendar not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Holiday calendar not found",
+	}
+	ErrInvalidHolidayCalendarId = &AppError{
+		Err:            errors.New("invalid holiday calendar id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Holiday calendar ID is required and must be a valid number",
+	}
+	ErrInvalidHolidayCalendar = &AppError{
+		Err:            errors.New("invalid holiday calendar"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Holiday calendar name must be at least 3 characters and country a 2-letter ISO code",
+	}
+	ErrHolidayCalendarAlreadyExists = &AppError{
+		Err:            errors.New("holiday calendar already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "A holiday calendar with this name already exists",
+	}
+	ErrHolidayCalendarInUse = &AppError{
+		Err:            errors.New("holiday calendar in use"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Holiday calendar is still assigned to locations",
+	}
+	ErrHolidayNotFound = &AppError{
+		Err:            errors.New("holiday not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Holiday not found",
+	}
+	ErrInvalidHolidayId = &AppError{
+		Err:            errors.New("invalid holiday id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Holiday ID is required and must be a valid number",
+	}
+	ErrInvalidHoliday = &AppError{
+		Err:            errors.New("invalid holiday"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Holiday date and name are required",
+	}
+	ErrHolidayAlreadyExists = &AppError{
+		Err:            errors.New("holiday already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The calendar already has a holiday on this date",
+	}
+	ErrInvalidICalendar = &AppError{
+		Err:            errors.New("invalid icalendar file"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "File is not a valid iCalendar (.ics) file",
+	}
+	ErrLocationNotFound = &AppError{
+		Err:            errors.New("location not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Location not found",
+	}
+	ErrInvalidLocation = &AppError{
+		Err:            errors.New("invalid location id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Location ID must be a valid number",
+	}
+	ErrInvalidLocationName = &AppError{
+		Err:            errors.New("invalid location name"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Location name must be at least 3 characters",
+	}
+	ErrLocationAlreadyExists = &AppError{
+		Err:            errors.New("location already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "A location with this name already exists",
+	}
+	ErrLocationInUse = &AppError{
+		Err:            errors.New("location in use"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Location still has employees assigned or benefit plans limited to it",
+	}
+	ErrInvalidDateRange = &AppError{
+		Err:            errors.New("invalid date range"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "From and to dates are required and from must not be after to",
+	}
+	ErrEmployeeNotActive = &AppError{
+		Err:            errors.New("employee not active"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "This action is only available for active employees",
+	}
+	ErrAlreadyClockedIn = &AppError{
+		Err:            errors.New("already clocked in"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Employee is already clocked in",
+	}
+	ErrNotClockedIn = &AppError{
+		Err:            errors.New("not clocked in"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Employee is not clocked in",
+	}
+	ErrInvalidPunchTime = &AppError{
+		Err:            errors.New("invalid punch time"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Punch times must not be in the future and clock-out must be after clock-in",
+	}
+	ErrAttendanceOverlaps = &AppError{
+		Err:            errors.New("attendance overlaps"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The shift overlaps another attendance entry",
+	}
+	ErrAttendanceEntryNotFound = &AppError{
+		Err:            errors.New("attendance entry not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Attendance entry not found",
+	}
+	ErrInvalidAttendanceEntryId = &AppError{
+		Err:            errors.New("invalid attendance entry id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Attendance entry ID is required and must be a valid number",
+	}
+	ErrInvalidTimesheetWeek = &AppError{
+		Err:            errors.New("invalid timesheet week"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Week must be a valid date (YYYY-MM-DD) that is not in the future",
+	}
+	ErrTimesheetLocked = &AppError{
+		Err:            errors.New("timesheet locked"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The timesheet for this week has been submitted or approved",
+	}
+	ErrTimesheetIncomplete = &AppError{
+		Err:            errors.New("timesheet incomplete"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "The week has shifts without a clock-out; correct them before submitting",
+	}
+	ErrTimesheetNotFound = &AppError{
+		Err:            errors.New("timesheet not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Timesheet has not been submitted",
+	}
+	ErrTimesheetClosed = &AppError{
+		Err:            errors.New("timesheet closed"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Timesheet is not awaiting approval",
+	}
+	ErrShiftTemplateNotFound = &AppError{
+		Err:            errors.New("shift template not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Shift template not found",
+	}
+	ErrInvalidShiftTemplateId = &AppError{
+		Err:            errors.New("invalid shift template id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Shift template ID is required and must be a valid number",
+	}
+	ErrInvalidShiftTemplate = &AppError{
+		Err:            errors.New("invalid shift template"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Name must be at least 3 characters, times must be HH:MM and the break must be shorter than the shift",
+	}
+	ErrShiftTemplateAlreadyExists = &AppError{
+		Err:            errors.New("shift template already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "A shift template with this name already exists",
+	}
+	ErrShiftTemplateInUse = &AppError{
+		Err:            errors.New("shift template in use"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Shift template is used by rostered shifts",
+	}
+	ErrShiftNotFound = &AppError{
+		Err:            errors.New("shift not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Shift not found",
+	}
+	ErrInvalidShiftId = &AppError{
+		Err:            errors.New("invalid shift id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Shift ID is required and must be a valid number",
+	}
+	ErrShiftOverlaps = &AppError{
+		Err:            errors.New("shift overlaps"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The shift overlaps another shift of the employee",
+	}
+	ErrInvalidRoster = &AppError{
+		Err:            errors.New("invalid roster"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "A shift template, at least one employee, valid weekdays and a date range of at most a year are required",
+	}
+	ErrRosterConflicts = &AppError{
+		Err:            errors.New("roster conflicts"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Some shifts conflict with approved leave, other shifts or the minimum rest period",
+	}
+	ErrPayrollRuleNotFound = &AppError{
+		Err:            errors.New("payroll rule not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Payroll rule not found",
+	}
+	ErrInvalidPayrollRuleId = &AppError{
+		Err:            errors.New("invalid payroll rule id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Payroll rule ID is required and must be a valid number",
+	}
+	ErrInvalidPayrollRule = &AppError{
+		Err:            errors.New("invalid payroll rule"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Name must be at least 3 characters, kind one of earning, deduction or tax, and fixed rules need a positive amount in a known currency while percentage rules need a rate above 0 and up to 100",
+	}
+	ErrPayrollRuleAlreadyExists = &AppError{
+		Err:            errors.New("payroll rule already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "A payroll rule with this name already exists",
+	}
+	ErrPayrollPeriodNotFound = &AppError{
+		Err:            errors.New("payroll period not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Payroll period not found",
+	}
+	ErrInvalidPayrollPeriodId = &AppError{
+		Err:            errors.New("invalid payroll period id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Payroll period ID is required and must be a valid number",
+	}
+	ErrInvalidPayrollPeriod = &AppError{
+		Err:            errors.New("invalid payroll period"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Period must be a month (YYYY-MM) and the pay date must not be before the period starts",
+	}
+	ErrPayrollPeriodAlreadyExists = &AppError{
+		Err:            errors.New("payroll period already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "A payroll period for this month already exists",
+	}
+	ErrPayrollRunNotFound = &AppError{
+		Err:            errors.New("payroll run not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Payroll run not found",
+	}
+	ErrInvalidPayrollRunId = &AppError{
+		Err:            errors.New("invalid payroll run id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Payroll run ID is required and must be a valid number",
+	}
+	ErrPayrollRunAlreadyExists = &AppError{
+		Err:            errors.New("payroll run already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The payroll period already has a run",
+	}
+	ErrPayrollRunNotDraft = &AppError{
+		Err:            errors.New("payroll run not draft"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Only draft payroll runs can be recalculated or deleted",
+	}
+	ErrInvalidPayrollRunTransition = &AppError{
+		Err:            errors.New("invalid payroll run transition"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The payroll run cannot move to that status from its current one",
+	}
+	ErrPayslipNotFound = &AppError{
+		Err:            errors.New("payslip not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Payslip not found",
+	}
+	ErrInvalidPayslipId = &AppError{
+		Err:            errors.New("invalid payslip id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Payslip ID is required and must be a valid number",
+	}
+	ErrInvalidProrationBasis = &AppError{
+		Err:            errors.New("invalid proration basis"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Basis must be calendar_days or working_days",
+	}
+	ErrInvalidPayPreviewPeriod = &AppError{
+		Err:            errors.New("invalid pay preview period"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Period must be a month, expected format: YYYY-MM",
+	}
+	ErrDepartmentNotFound = &AppError{
+		Err:            errors.New("department not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Department not found",
+	}
+	ErrInvalidDepartment = &AppError{
+		Err:            errors.New("invalid department id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Department ID must be a valid number",
+	}
+	ErrInvalidDepartmentName = &AppError{
+		Err:            errors.New("invalid department name"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Department name must be at least 2 characters",
+	}
+	ErrDepartmentAlreadyExists = &AppError{
+		Err:            errors.New("department already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "A department with this name already exists",
+	}
+	ErrDepartmentInUse = &AppError{
+		Err:            errors.New("department in use"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Department still has employees assigned",
+	}
+	ErrReviewCycleNotFound = &AppError{
+		Err:            errors.New("review cycle not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Review cycle not found",
+	}
+	ErrInvalidReviewCycleId = &AppError{
+		Err:            errors.New("invalid review cycle id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Review cycle ID is required and must be a valid number",
+	}
+	ErrInvalidReviewCycle = &AppError{
+		Err:            errors.New("invalid review cycle"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Name must be at least 3 characters, the period must not end before it starts, deadlines must not be before the period starts and must run self, peer then manager, the rating scale needs 2 to 10 labels and there must be 1 to 50 questions of kind rating or text",
+	}
+	ErrReviewCycleAlreadyExists = &AppError{
+		Err:            errors.New("review cycle already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "A review cycle with this name already exists",
+	}
+	ErrReviewCycleNotDraft = &AppError{
+		Err:            errors.New("review cycle not draft"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Only a draft review cycle can be changed, launched or deleted",
+	}
+	ErrReviewCycleNotActive = &AppError{
+		Err:            errors.New("review cycle not active"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The review cycle is not active",
+	}
+	ErrReviewNotFound = &AppError{
+		Err:            errors.New("review not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Review not found",
+	}
+	ErrInvalidReviewId = &AppError{
+		Err:            errors.New("invalid review id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Review ID is required and must be a valid number",
+	}
+	ErrInvalidReviewSubmission = &AppError{
+		Err:            errors.New("invalid review submission"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Role must be self, peer or manager, and every question of the cycle must be answered once: rating questions with a rating on the cycle's scale, text questions with a comment",
+	}
+	ErrInvalidPeerReviewers = &AppError{
+		Err:            errors.New("invalid peer reviewers"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Peer reviewers must be valid employee IDs other than the employee and their manager",
+	}
+	ErrReviewerNotAllowed = &AppError{
+		Err:            errors.New("reviewer not allowed"),
+		Code:           constants.ForbiddenError,
+		HTTPStatusCode: http.StatusForbidden,
+		PublicMsg:      "The reviewer cannot review this employee in this role",
+	}
+	ErrReviewStageClosed = &AppError{
+		Err:            errors.New("review stage closed"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The deadline for this review stage has passed",
+	}
+	ErrReviewAlreadySubmitted = &AppError{
+		Err:            errors.New("review already submitted"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The reviewer has already submitted this review",
+	}
+	ErrReviewNotInProgress = &AppError{
+		Err:            errors.New("review not in progress"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The review has been completed or locked",
+	}
+	ErrReviewNotCompleted = &AppError{
+		Err:            errors.New("review not completed"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Only a completed review can be locked",
+	}
+	ErrGoalNotFound = &AppError{
+		Err:            errors.New("goal not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Goal not found",
+	}
+	ErrInvalidGoalId = &AppError{
+		Err:            errors.New("invalid goal id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Goal ID is required and must be a valid number",
+	}
+	ErrInvalidGoal = &AppError{
+		Err:            errors.New("invalid goal"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Title must be at least 3 characters, the due date must not be before the start date, status must be active, completed or cancelled and a new goal needs 1 to 10 key results",
+	}
+	ErrInvalidGoalParent = &AppError{
+		Err:            errors.New("invalid goal parent"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "A goal can only be aligned to a company goal or a goal of the owner's manager, and not to itself or a goal aligned to it",
+	}
+	ErrGoalHasAlignedGoals = &AppError{
+		Err:            errors.New("goal has aligned goals"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Goals are still aligned to this goal",
+	}
+	ErrGoalNotActive = &AppError{
+		Err:            errors.New("goal not active"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Only an active goal can be checked in on or get new key results",
+	}
+	ErrKeyResultNotFound = &AppError{
+		Err:            errors.New("key result not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Key result not found",
+	}
+	ErrInvalidKeyResult = &AppError{
+		Err:            errors.New("invalid key result"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Title must be at least 3 characters and the target value must differ from the start value",
+	}
+	ErrInvalidGoalCheckIn = &AppError{
+		Err:            errors.New("invalid goal check-in"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Key result ID is required",
+	}
+	ErrSkillCategoryNotFound = &AppError{
+		Err:            errors.New("skill category not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Skill category not found",
+	}
+	ErrInvalidSkillCategory = &AppError{
+		Err:            errors.New("invalid skill category"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Skill category ID must be a valid number and its name at least 2 characters long",
+	}
+	ErrSkillCategoryAlreadyExists = &AppError{
+		Err:            errors.New("skill category already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "A skill category with this name already exists",
+	}
+	ErrSkillCategoryInUse = &AppError{
+		Err:            errors.New("skill category in use"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Skill category still has skills",
+	}
+	ErrSkillNotFound = &AppError{
+		Err:            errors.New("skill not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Skill not found",
+	}
+	ErrInvalidSkillId = &AppError{
+		Err:            errors.New("invalid skill id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Skill ID is required and must be a valid number",
+	}
+	ErrInvalidSkill = &AppError{
+		Err:            errors.New("invalid skill"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Name is required and a valid skill category ID must be given",
+	}
+	ErrSkillAlreadyExists = &AppError{
+		Err:            errors.New("skill already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "A skill with this name already exists",
+	}
+	ErrSkillInUse = &AppError{
+		Err:            errors.New("skill in use"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Skill is still assigned to employees",
+	}
+	ErrInvalidProficiencyLevel = &AppError{
+		Err:            errors.New("invalid proficiency level"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Level must be between 1 (novice) and 5 (expert) and the verification date cannot be in the future",
+	}
+	ErrEmployeeSkillNotFound = &AppError{
+		Err:            errors.New("employee skill not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "The employee does not have this skill",
+	}
+	ErrInvalidSkillSearch = &AppError{
+		Err:            errors.New("invalid skill search"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Between 1 and 20 distinct skills are required, each with a minimum level between 1 and 5",
+	}
+	ErrTrainingCourseNotFound = &AppError{
+		Err:            errors.New("training course not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Training course not found",
+	}
+	ErrInvalidTrainingCourseId = &AppError{
+		Err:            errors.New("invalid training course id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid training course ID",
+	}
+	ErrInvalidTrainingCourse = &AppError{
+		Err:            errors.New("invalid training course"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Name must be at least 2 characters long, duration cannot be negative and certification validity must be between 0 and 120 months",
+	}
+	ErrTrainingCourseAlreadyExists = &AppError{
+		Err:            errors.New("training course already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "A training course with this name already exists",
+	}
+	ErrTrainingCourseInUse = &AppError{
+		Err:            errors.New("training course has enrollments"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The training course has enrollments and cannot be deleted",
+	}
+	ErrEnrollmentNotFound = &AppError{
+		Err:            errors.New("enrollment not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Enrollment not found",
+	}
+	ErrInvalidEnrollmentId = &AppError{
+		Err:            errors.New("invalid enrollment id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid enrollment ID",
+	}
+	ErrInvalidEnrollment = &AppError{
+		Err:            errors.New("invalid enrollment"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "The due date cannot be before the enrollment date and a completion date cannot be before enrollment or in the future",
+	}
+	ErrAlreadyEnrolled = &AppError{
+		Err:            errors.New("employee already enrolled"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The employee is already enrolled in this course",
+	}
+	ErrEnrollmentNotOpen = &AppError{
+		Err:            errors.New("enrollment is not open"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Only open enrollments can be completed or cancelled",
+	}
+	ErrCertificationNotFound = &AppError{
+		Err:            errors.New("certification not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Certification not found",
+	}
+	ErrInvalidCertificationId = &AppError{
+		Err:            errors.New("invalid certification id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid certification ID",
+	}
+	ErrInvalidCertification = &AppError{
+		Err:            errors.New("invalid certification"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Name is required, the issue date cannot be in the future and the expiry date must be after the issue date",
+	}
+	ErrEvidenceNotFound = &AppError{
+		Err:            errors.New("certification evidence not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Evidence not found",
+	}
+	ErrInvalidEvidenceId = &AppError{
+		Err:            errors.New("invalid evidence id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid evidence ID",
+	}
+	ErrInvalidEvidence = &AppError{
+		Err:            errors.New("invalid certification evidence"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Evidence must be a non-empty PDF, PNG or JPEG file",
+	}
+	ErrEvidenceTooLarge = &AppError{
+		Err:            errors.New("certification evidence too large"),
+		Code:           constants.PayloadTooLargeError,
+		HTTPStatusCode: http.StatusRequestEntityTooLarge,
+		PublicMsg:      "Evidence files cannot be larger than 5 MB",
+	}
+	ErrInvalidExpiryWindow = &AppError{
+		Err:            errors.New("invalid expiry window"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "The expiry window must be between 1 and 365 days",
+	}
+	ErrDocumentNotFound = &AppError{
+		Err:            errors.New("document not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Document not found",
+	}
+	ErrInvalidDocumentId = &AppError{
+		Err:            errors.New("invalid document id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid document ID",
+	}
+	ErrInvalidDocument = &AppError{
+		Err:            errors.New("invalid document"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Documents must be non-empty PDF, image, plain text or Word files and the category one of contract, identity, offer_letter or other",
+	}
+	ErrDocumentTooLarge = &AppError{
+		Err:            errors.New("document too large"),
+		Code:           constants.PayloadTooLargeError,
+		HTTPStatusCode: http.StatusRequestEntityTooLarge,
+		PublicMsg:      "The document is larger than the maximum upload size",
+	}
+	ErrChecklistTemplateNotFound = &AppError{
+		Err:            errors.New("checklist template not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Checklist template not found",
+	}
+	ErrInvalidChecklistTemplateId = &AppError{
+		Err:            errors.New("invalid checklist template id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid checklist template ID",
+	}
+	ErrInvalidChecklistTemplate = &AppError{
+		Err:            errors.New("invalid checklist template"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Checklist templates need a name of at least 3 characters, a kind of onboarding or offboarding and 1 to 50 tasks, each with a title of at least 3 characters, an owner role of employee, manager or designated (designated tasks with an owner_id) and due_days between -365 and 365",
+	}
+	ErrChecklistTemplateExists = &AppError{
+		Err:            errors.New("checklist template already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "A checklist template of this kind already exists for the position and department",
+	}
+	ErrChecklistNotFound = &AppError{
+		Err:            errors.New("checklist not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Checklist not found",
+	}
+	ErrInvalidChecklistId = &AppError{
+		Err:            errors.New("invalid checklist id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid checklist ID",
+	}
+	ErrInvalidChecklistKind = &AppError{
+		Err:            errors.New("invalid checklist kind"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Checklist kind must be onboarding or offboarding",
+	}
+	ErrChecklistAlreadyOpen = &AppError{
+		Err:            errors.New("checklist already open"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The employee already has an open checklist of this kind",
+	}
+	ErrChecklistNotApplicable = &AppError{
+		Err:            errors.New("checklist not applicable to employee"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Onboarding checklists are for current employees and offboarding checklists for terminated ones",
+	}
+	ErrChecklistTaskNotFound = &AppError{
+		Err:            errors.New("checklist task not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Checklist task not found",
+	}
+	ErrInvalidChecklistTaskId = &AppError{
+		Err:            errors.New("invalid checklist task id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid checklist task ID",
+	}
+	ErrInvalidChecklistTask = &AppError{
+		Err:            errors.New("invalid checklist task"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Tasks need a due date and, when assigned, an owner who is a current employee",
+	}
+	ErrInvalidChecklistFilter = &AppError{
+		Err:            errors.New("invalid checklist filter"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Checklists can be filtered by kind (onboarding or offboarding) and open (true or false)",
+	}
+	ErrApprovalWorkflowNotFound = &AppError{
+		Err:            errors.New("approval workflow not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Approval workflow not found",
+	}
+	ErrInvalidApprovalWorkflowId = &AppError{
+		Err:            errors.New("invalid approval workflow id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid approval workflow ID",
+	}
+	ErrInvalidApprovalWorkflow = &AppError{
+		Err:            errors.New("invalid approval workflow"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Approval workflows need a name of at least 3 characters, a request type of salary_change, termination, expense or leave and 1 to 20 steps, each with a stage of at least 1, an approver type of manager, position or employee (position and employee steps with an approver_id) and a timeout of 0 to 720 hours",
+	}
+	ErrApprovalWorkflowExists = &AppError{
+		Err:            errors.New("approval workflow already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "An approval workflow already exists for this request type",
+	}
+	ErrApprovalRequestNotFound = &AppError{
+		Err:            errors.New("approval request not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Approval request not found",
+	}
+	ErrInvalidApprovalRequestId = &AppError{
+		Err:            errors.New("invalid approval request id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid approval request ID",
+	}
+	ErrInvalidApprovalFilter = &AppError{
+		Err:            errors.New("invalid approval filter"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Approval requests can be filtered by type (salary_change, termination, expense or leave), status (pending, approved, rejected, cancelled or failed) and employee_id",
+	}
+	ErrApprovalAlreadyPending = &AppError{
+		Err:            errors.New("approval already pending"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The same change is already waiting for approval",
+	}
+	ErrApprovalRequestClosed = &AppError{
+		Err:            errors.New("approval request closed"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The approval request has already been decided or cancelled",
+	}
+	ErrApprovalTaskNotFound = &AppError{
+		Err:            errors.New("approval task not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Approval task not found",
+	}
+	ErrInvalidApprovalTaskId = &AppError{
+		Err:            errors.New("invalid approval task id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid approval task ID",
+	}
+	ErrApprovalTaskClosed = &AppError{
+		Err:            errors.New("approval task closed"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The approval task is not waiting for a decision",
+	}
+	ErrNotApprover = &AppError{
+		Err:            errors.New("not an approver of the task"),
+		Code:           constants.ForbiddenError,
+		HTTPStatusCode: http.StatusForbidden,
+		PublicMsg:      "The approver may not decide this task",
+	}
+	ErrApprovalDelegationNotFound = &AppError{
+		Err:            errors.New("approval delegation not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Approval delegation not found",
+	}
+	ErrInvalidApprovalDelegationId = &AppError{
+		Err:            errors.New("invalid approval delegation id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid approval delegation ID",
+	}
+	ErrInvalidApprovalDelegation = &AppError{
+		Err:            errors.New("invalid approval delegation"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Delegations need a current employee other than the delegator as delegate and an end date on or after the start date",
+	}
+	ErrRequisitionNotFound = &AppError{
+		Err:            errors.New("requisition not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Job requisition not found",
+	}
+	ErrInvalidRequisitionId = &AppError{
+		Err:            errors.New("invalid requisition id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid job requisition ID",
+	}
+	ErrInvalidRequisition = &AppError{
+		Err:            errors.New("invalid requisition"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Job requisitions need a title of at least 3 characters, a position, at least one opening and a status of open, on_hold, filled or closed",
+	}
+	ErrInvalidRequisitionStatus = &AppError{
+		Err:            errors.New("invalid requisition status"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Requisition status must be open, on_hold, filled or closed",
+	}
+	ErrRequisitionNotOpen = &AppError{
+		Err:            errors.New("requisition not open"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The job requisition is not open",
+	}
+	ErrRequisitionHasCandidates = &AppError{
+		Err:            errors.New("requisition has candidates"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Job requisitions with candidates cannot be deleted; close them instead",
+	}
+	ErrCandidateNotFound = &AppError{
+		Err:            errors.New("candidate not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Candidate not found",
+	}
+	ErrInvalidCandidateId = &AppError{
+		Err:            errors.New("invalid candidate id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid candidate ID",
+	}
+	ErrInvalidCandidate = &AppError{
+		Err:            errors.New("invalid candidate"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Candidates need a name of at least 3 characters, a valid email and, when given, an E.164 phone number",
+	}
+	ErrCandidateExists = &AppError{
+		Err:            errors.New("candidate already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "A candidate with this email already applied to the requisition",
+	}
+	ErrInvalidCandidateStage = &AppError{
+		Err:            errors.New("invalid candidate stage"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Candidate stage must be applied, screening, interview, offer, hired, rejected or withdrawn",
+	}
+	ErrCandidateStageTransition = &AppError{
+		Err:            errors.New("invalid candidate stage transition"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The candidate cannot move to this stage from their current one",
+	}
+	ErrInvalidOffer = &AppError{
+		Err:            errors.New("invalid offer"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Offers need a positive salary in a known currency, a pay period of monthly, annual or hourly and a start date",
+	}
+	ErrInterviewNotFound = &AppError{
+		Err:            errors.New("interview not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Interview not found",
+	}
+	ErrInvalidInterviewId = &AppError{
+		Err:            errors.New("invalid interview id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid interview ID",
+	}
+	ErrInvalidInterview = &AppError{
+		Err:            errors.New("invalid interview"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Interviews need a current employee as interviewer, a time in the future and a duration of 15 to 480 minutes",
+	}
+	ErrInterviewConflict = &AppError{
+		Err:            errors.New("interview conflict"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The interviewer already has an interview at that time",
+	}
+	ErrInterviewClosed = &AppError{
+		Err:            errors.New("interview closed"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The interview is no longer scheduled",
+	}
+	ErrInvalidInterviewFeedback = &AppError{
+		Err:            errors.New("invalid interview feedback"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Feedback needs a rating of 1 to 5, a recommendation of strong_yes, yes, no or strong_no and a written comment",
+	}
+	ErrInvalidLocationAddress = &AppError{
+		Err:            errors.New("invalid location address"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Location addresses need line 1, city, postal code and an ISO 3166-1 alpha-2 country",
+	}
+	ErrInvalidTimeZone = &AppError{
+		Err:            errors.New("invalid time zone"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Time zone must be an IANA time zone name, e.g. Asia/Kolkata",
+	}
+	ErrLocationAssignmentNotFound = &AppError{
+		Err:            errors.New("scheduled location assignment not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Scheduled location assignment not found",
+	}
+	ErrInvalidLocationAssignmentId = &AppError{
+		Err:            errors.New("invalid location assignment id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid location assignment ID",
+	}
+	ErrInvalidLocationAssignment = &AppError{
+		Err:            errors.New("invalid location assignment"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Location assignments need a location and an effective date on or after the hire date",
+	}
+	ErrInvalidAsset = &AppError{
+		Err:            errors.New("invalid asset"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Assets need a name, a serial number and a purchase date that is not in the future",
+	}
+	ErrInvalidAssetId = &AppError{
+		Err:            errors.New("invalid asset id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid asset ID",
+	}
+	ErrInvalidAssetType = &AppError{
+		Err:            errors.New("invalid asset type"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Asset type must be one of laptop, desktop, monitor, phone, tablet, badge, key or other",
+	}
+	ErrInvalidAssetStatus = &AppError{
+		Err:            errors.New("invalid asset status"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Asset status must be one of available, assigned, in_repair, lost or retired",
+	}
+	ErrAssetNotFound = &AppError{
+		Err:            errors.New("asset not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Asset not found",
+	}
+	ErrAssetAlreadyExists = &AppError{
+		Err:            errors.New("asset already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "An asset with this serial number already exists",
+	}
+	ErrAssetNotAvailable = &AppError{
+		Err:            errors.New("asset not available"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Only available assets can be assigned",
+	}
+	ErrAssetAssigned = &AppError{
+		Err:            errors.New("asset is assigned"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The asset is assigned to an employee, return it first",
+	}
+	ErrAssetNotAssigned = &AppError{
+		Err:            errors.New("asset is not assigned"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The asset is not assigned to anyone",
+	}
+	ErrInvalidAssetAssignment = &AppError{
+		Err:            errors.New("invalid asset assignment"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Assets are assigned and returned on or after the hire and assignment dates, never in the future",
+	}
+	ErrEmployeeHoldsAssets = &AppError{
+		Err:            errors.New("employee still holds assets"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The employee still holds company assets, return them before completing the offboarding",
+	}
+	ErrInvalidExpenseCategory = &AppError{
+		Err:            errors.New("invalid expense category"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Expense categories need a name of at least 2 characters and, when limited, a positive limit in an active currency",
+	}
+	ErrInvalidExpenseCategoryId = &AppError{
+		Err:            errors.New("invalid expense category id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid expense category ID",
+	}
+	ErrExpenseCategoryNotFound = &AppError{
+		Err:            errors.New("expense category not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Expense category not found",
+	}
+	ErrExpenseCategoryAlreadyExists = &AppError{
+		Err:            errors.New("expense category already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "An expense category with this name already exists",
+	}
+	ErrExpenseCategoryInUse = &AppError{
+		Err:            errors.New("expense category in use"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The expense category is used by expense claims",
+	}
+	ErrInvalidExpenseClaim = &AppError{
+		Err:            errors.New("invalid expense claim"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Expense claims need a title and 1 to 50 items, each with a category, a description, an expense date that is not in the future and a positive amount, all in one active currency",
+	}
+	ErrInvalidExpenseClaimId = &AppError{
+		Err:            errors.New("invalid expense claim id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid expense claim ID",
+	}
+	ErrInvalidExpenseClaimFilter = &AppError{
+		Err:            errors.New("invalid expense claim filter"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Expense claims can be filtered by status (draft, submitted, approved, rejected or reimbursed), employee_id and department_id",
+	}
+	ErrExpenseClaimNotFound = &AppError{
+		Err:            errors.New("expense claim not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Expense claim not found",
+	}
+	ErrExpenseClaimNotEditable = &AppError{
+		Err:            errors.New("expense claim not editable"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Only draft and rejected expense claims can be changed, submitted or deleted",
+	}
+	ErrExpenseClaimNotSubmitted = &AppError{
+		Err:            errors.New("expense claim not submitted"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Only submitted expense claims can be approved or rejected",
+	}
+	ErrExpenseClaimNotApproved = &AppError{
+		Err:            errors.New("expense claim not approved"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Only approved expense claims can be reimbursed",
+	}
+	ErrExpenseClaimInApproval = &AppError{
+		Err:            errors.New("expense claim decided by approval workflow"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The expense claim is decided through its approval request",
+	}
+	ErrExpenseLimitExceeded = &AppError{
+		Err:            errors.New("expense category limit exceeded"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "The claim holds more of an expense category than its limit allows",
+	}
+	ErrExpenseReceiptRequired = &AppError{
+		Err:            errors.New("expense receipt required"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Claims with expenses of categories that require receipts need a receipt before they are submitted",
+	}
+	ErrInvalidExpenseReceipt = &AppError{
+		Err:            errors.New("invalid expense receipt"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Receipts must be non-empty PDF or image files",
+	}
+	ErrInvalidExpenseReceiptId = &AppError{
+		Err:            errors.New("invalid expense receipt id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid expense receipt ID",
+	}
+	ErrExpenseReceiptNotFound = &AppError{
+		Err:            errors.New("expense receipt not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Expense receipt not found",
+	}
+	ErrExpenseReceiptTooLarge = &AppError{
+		Err:            errors.New("expense receipt too large"),
+		Code:           constants.PayloadTooLargeError,
+		HTTPStatusCode: http.StatusRequestEntityTooLarge,
+		PublicMsg:      "The receipt is larger than the maximum upload size",
+	}
+	ErrInvalidExpenseReimbursement = &AppError{
+		Err:            errors.New("invalid expense reimbursement"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Claims are reimbursed on or after the day they were approved, never in the future",
+	}
+	ErrInvalidExpenseReport = &AppError{
+		Err:            errors.New("invalid expense report"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Expense reports need a from and a to date, from not after to",
+	}
+	ErrInvalidBenefitPlan = &AppError{
+		Err:            errors.New("invalid benefit plan"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Benefit plans need a name of at least 2 characters, a type (health, dental, vision, life, disability, pension or other), an active currency, non-negative monthly contributions, valid employment statuses and a non-negative minimum tenure",
+	}
+	ErrInvalidBenefitPlanId = &AppError{
+		Err:            errors.New("invalid benefit plan id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid benefit plan ID",
+	}
+	ErrBenefitPlanNotFound = &AppError{
+		Err:            errors.New("benefit plan not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Benefit plan not found",
+	}
+	ErrBenefitPlanAlreadyExists = &AppError{
+		Err:            errors.New("benefit plan already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "A benefit plan with this name already exists",
+	}
+	ErrBenefitPlanInUse = &AppError{
+		Err:            errors.New("benefit plan in use"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Employees are enrolled in the benefit plan",
+	}
+	ErrInvalidEnrollmentWindow = &AppError{
+		Err:            errors.New("invalid enrollment window"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Enrollment windows need a name, an end date not before the start date and a coverage start date not before the start date",
+	}
+	ErrInvalidEnrollmentWindowId = &AppError{
+		Err:            errors.New("invalid enrollment window id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid enrollment window ID",
+	}
+	ErrEnrollmentWindowNotFound = &AppError{
+		Err:            errors.New("enrollment window not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Enrollment window not found",
+	}
+	ErrEnrollmentWindowOverlaps = &AppError{
+		Err:            errors.New("enrollment window overlaps"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The enrollment window overlaps another one",
+	}
+	ErrInvalidDependent = &AppError{
+		Err:            errors.New("invalid dependent"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Dependents need a name, a relationship (spouse, partner, child or other) and a date of birth that is not in the future",
+	}
+	ErrInvalidDependentId = &AppError{
+		Err:            errors.New("invalid dependent id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid dependent ID",
+	}
+	ErrDependentNotFound = &AppError{
+		Err:            errors.New("dependent not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Dependent not found",
+	}
+	ErrInvalidBenefitEnrollment = &AppError{
+		Err:            errors.New("invalid benefit enrollment"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Enrollments need a benefit plan and may only list dependents of the employee when the plan covers dependents",
+	}
+	ErrInvalidBenefitEnrollmentId = &AppError{
+		Err:            errors.New("invalid benefit enrollment id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Invalid benefit enrollment ID",
+	}
+	ErrBenefitEnrollmentNotFound = &AppError{
+		Err:            errors.New("benefit enrollment not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Benefit enrollment not found",
+	}
+	ErrBenefitEnrollmentClosed = &AppError{
+		Err:            errors.New("benefit enrollment closed"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Employees enroll during an open enrollment window or within 30 days of being hired",
+	}
+	ErrNotEligibleForBenefit = &AppError{
+		Err:            errors.New("not eligible for benefit"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The employee is not eligible for the benefit plan because of their employment status, tenure or location",
+	}
+	ErrBenefitAlreadyEnrolled = &AppError{
+		Err:            errors.New("benefit already enrolled"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The employee is already enrolled in a benefit plan of this type",
+	}
+	ErrBenefitEnrollmentEnded = &AppError{
+		Err:            errors.New("benefit enrollment ended"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The benefit enrollment has already ended",
+	}
+	ErrInvalidDeductionPeriod = &AppError{
+		Err:            errors.New("invalid deduction period"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Deductions are listed for a period in YYYY-MM form and an optional employee_id",
+	}
+	ErrBenefitCoverageStarted = &AppError{
+		Err:            errors.New("benefit coverage started"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Coverage has already started, end the enrollment instead",
+	}
+	ErrBenefitCoverageNotStarted = &AppError{
+		Err:            errors.New("benefit coverage not started"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "Coverage has not started yet, withdraw the enrollment instead",
+	}
+	ErrInvalidCustomFieldDefinition = &AppError{
+		Err:            errors.New("invalid custom field definition"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Custom fields need a snake_case key, a label and a type of text, number, boolean, date, select or multi_select; select fields need options and limits must fit the type",
+	}
+	ErrInvalidCustomFieldDefinitionId = &AppError{
+		Err:            errors.New("invalid custom field definition id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Custom field ID is required and must be a valid number",
+	}
+	ErrCustomFieldDefinitionNotFound = &AppError{
+		Err:            errors.New("custom field definition not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Custom field not found",
+	}
+	ErrCustomFieldDefinitionAlreadyExists = &AppError{
+		Err:            errors.New("custom field definition already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "A custom field with this key already exists",
+	}
+	ErrUnknownCustomField = &AppError{
+		Err:            errors.New("unknown custom field"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Custom fields must be defined before they can be set or filtered on",
+	}
+	ErrRequiredCustomFieldMissing = &AppError{
+		Err:            errors.New("required custom field missing"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "A required custom field has no value",
+	}
+	ErrInvalidCustomFieldValue = &AppError{
+		Err:            errors.New("invalid custom field value"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Custom field values must be of the field's type and within its options and validation limits",
+	}
+	ErrInvalidTag = &AppError{
+		Err:            errors.New("invalid tag"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Tags are 1 to 50 characters long and cannot contain commas",
+	}
+	ErrTagNotFound = &AppError{
+		Err:            errors.New("tag not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Tag not found",
+	}
+	ErrInvalidSegment = &AppError{
+		Err:            errors.New("invalid segment"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Segments need a name and a filter of valid employment statuses, IDs, tags and custom fields",
+	}
+	ErrInvalidSegmentId = &AppError{
+		Err:            errors.New("invalid segment id"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Segment ID is required and must be a valid number",
+	}
+	ErrSegmentNotFound = &AppError{
+		Err:            errors.New("segment not found"),
+		Code:           constants.NotFoundError,
+		HTTPStatusCode: http.StatusNotFound,
+		PublicMsg:      "Segment not found",
+	}
+	ErrSegmentAlreadyExists = &AppError{
+		Err:            errors.New("segment already exists"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "A segment with this name already exists",
+	}
+	ErrSalaryChangeNotAllowed = &AppError{
+		Err:            errors.New("salary change not allowed"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Salary and pay period cannot be changed by updating the employee, request salary changes through POST /employees/{id}/compensation",
+	}
+	ErrLeaveRequestInApproval = &AppError{
+		Err:            errors.New("leave request decided by approval workflow"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The leave request is decided through its approval request",
+	}
+	ErrRequestTooLarge = &AppError{
+		Err:            errors.New("request body too large"),
+		Code:           constants.PayloadTooLargeError,
+		HTTPStatusCode: http.StatusRequestEntityTooLarge,
+		PublicMsg:      "The request is larger than the maximum upload size",
+	}
+	ErrExpenseClaimInPayrollRun = &AppError{
+		Err:            errors.New("expense claim in payroll run"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "The expense claim is paid back through a payroll run",
+	}
+	ErrPayrollExpenseClaimTaken = &AppError{
+		Err:            errors.New("payroll expense claim taken"),
+		Code:           constants.ConflictError,
+		HTTPStatusCode: http.StatusConflict,
+		PublicMsg:      "An expense claim on the payslips was paid back elsewhere meanwhile, please calculate the run again",
+	}
+	ErrInvalidAnnouncement = &AppError{
+		Err:            errors.New("invalid announcement"),
+		Code:           constants.BadRequestError,
+		HTTPStatusCode: http.StatusBadRequest,
+		PublicMsg:      "Announcements need a subject and a body",
+	}
 )
@@ -7,5 +7,12 @@ const (
 	ContextKeyRequestID          = "request_id"
 	BadRequestError              = "BAD_REQUEST"
 	NotFoundError                = "NOT_FOUND"
+	ConflictError                = "CONFLICT"
+	ForbiddenError               = "FORBIDDEN"
+	PayloadTooLargeError         = "PAYLOAD_TOO_LARGE"
 	MissingRequiredFieldsMessage = "missing required fields"
+	DateFormat                   = "2006-01-02"
+	DateTimeFormat               = "2006-01-02 15:04:05"
+	TimeOfDayFormat              = "15:04"
+	MonthFormat                  = "2006-01"
 )
@@ -0,0 +1,234 @@
+// Package ical reads the events of iCalendar (RFC 5545) files, as published for public holidays,
+// and writes feeds of timed events. Only what date-based calendars need is read: all-day and
+// timed VEVENTs, EXDATE, and yearly recurrence rules. Times are reduced to their calendar date.
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidCalendar       = errors.New("ical: invalid calendar")
+	ErrUnsupportedRecurrence = errors.New("ical: unsupported recurrence rule")
+)
+
+// Event is a VEVENT. End is exclusive, so a single-day event ends the day after it starts.
+type Event struct {
+	UID          string
+	Summary      string
+	Start        time.Time
+	End          time.Time
+	Recurrence   *Recurrence
+	ExcludedDays map[time.Time]bool
+}
+
+// Recurrence is a parsed RRULE. Count and Until are zero when unbounded.
+type Recurrence struct {
+	Frequency string
+	Interval  int
+	Count     int
+	Until     time.Time
+}
+
+// Parse reads every VEVENT of the calendar in r.
+func Parse(r io.Reader) ([]Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []Event{}
+	var current *Event
+	sawCalendar := false
+	for number, line := range lines {
+		if line == "" {
+			continue
+		}
+		name, params, value, ok := splitProperty(line)
+		if !ok {
+			return nil, fmt.Errorf("%w: line %d is not a property", ErrInvalidCalendar, number+1)
+		}
+
+		switch {
+		case name == "BEGIN" && value == "VCALENDAR":
+			sawCalendar = true
+		case name == "BEGIN" && value == "VEVENT":
+			current = &Event{ExcludedDays: map[time.Time]bool{}}
+		case name == "END" && value == "VEVENT":
+			if current == nil || current.Start.IsZero() {
+				return nil, fmt.Errorf("%w: event ending on line %d has no DTSTART", ErrInvalidCalendar, number+1)
+			}
+			if current.End.IsZero() || !current.End.After(current.Start) {
+				current.End = current.Start.AddDate(0, 0, 1)
+			}
+			events = append(events, *current)
+			current = nil
+		case current == nil:
+			// Properties of the calendar itself or of other components (VTIMEZONE, VALARM...).
+		case name == "UID":
+			current.UID = value
+		case name == "SUMMARY":
+			current.Summary = unescapeText(value)
+		case name == "DTSTART", name == "DTEND":
+			date, err := parseDate(value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidCalendar, number+1, err)
+			}
+			if name == "DTSTART" {
+				current.Start = date
+			} else {
+				// A timed event ending later on the same day still covers that day.
+				if isDateTime(params, value) && !strings.HasSuffix(value, "T000000") && !strings.HasSuffix(value, "T000000Z") {
+					date = date.AddDate(0, 0, 1)
+				}
+				current.End = date
+			}
+		case name == "EXDATE":
+			for _, item := range strings.Split(value, ",") {
+				date, err := parseDate(item)
+				if err != nil {
+					return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidCalendar, number+1, err)
+				}
+				current.ExcludedDays[date] = true
+			}
+		case name == "RRULE":
+			recurrence, err := parseRecurrence(value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidCalendar, number+1, err)
+			}
+			current.Recurrence = recurrence
+		}
+	}
+
+	if !sawCalendar {
+		return nil, fmt.Errorf("%w: missing BEGIN:VCALENDAR", ErrInvalidCalendar)
+	}
+	if current != nil {
+		return nil, fmt.Errorf("%w: unterminated VEVENT", ErrInvalidCalendar)
+	}
+	return events, nil
+}
+
+// Dates lists every day the event covers, expanding recurrences up to and including until.
+func (e Event) Dates(until time.Time) ([]time.Time, error) {
+	span := int(e.End.Sub(e.Start).Hours() / 24)
+	occurrences := []time.Time{e.Start}
+
+	if e.Recurrence != nil {
+		if e.Recurrence.Frequency != "YEARLY" {
+			return nil, fmt.Errorf("%w: FREQ=%s", ErrUnsupportedRecurrence, e.Recurrence.Frequency)
+		}
+		for i := 1; e.Recurrence.Count == 0 || i < e.Recurrence.Count; i++ {
+			next := e.Start.AddDate(i*e.Recurrence.Interval, 0, 0)
+			if next.After(until) || (!e.Recurrence.Until.IsZero() && next.After(e.Recurrence.Until)) {
+				break
+			}
+			occurrences = append(occurrences, next)
+		}
+	}
+
+	dates := []time.Time{}
+	for _, occurrence := range occurrences {
+		if e.ExcludedDays[occurrence] {
+			continue
+		}
+		for day := 0; day < span; day++ {
+			date := occurrence.AddDate(0, 0, day)
+			if date.After(until) {
+				break
+			}
+			dates = append(dates, date)
+		}
+	}
+	return dates, nil
+}
+
+// unfold joins continuation lines (starting with a space or tab) onto the previous line.
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	lines := []string{}
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+// splitProperty splits "NAME;PARAM=x:value" into its upper-cased name, raw parameters and value.
+// Colons inside quoted parameter values do not end the parameters.
+func splitProperty(line string) (name, params, value string, ok bool) {
+	inQuotes := false
+	for i, r := range line {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+		case r == ':' && !inQuotes:
+			head := line[:i]
+			name, params, _ = strings.Cut(head, ";")
+			return strings.ToUpper(name), params, line[i+1:], name != ""
+		}
+	}
+	return "", "", "", false
+}
+
+func isDateTime(params, value string) bool {
+	return !strings.Contains(strings.ToUpper(params), "VALUE=DATE") || strings.Contains(value, "T")
+}
+
+// parseDate reads a DATE (20250101) or DATE-TIME (20250101T090000[Z]) value as a UTC date.
+func parseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if len(value) < 8 {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+	return time.Parse("20060102", value[:8])
+}
+
+func parseRecurrence(value string) (*Recurrence, error) {
+	recurrence := &Recurrence{Interval: 1}
+	for _, part := range strings.Split(value, ";") {
+		key, val, _ := strings.Cut(part, "=")
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			recurrence.Frequency = strings.ToUpper(val)
+		case "INTERVAL":
+			recurrence.Interval, err = strconv.Atoi(val)
+			if err == nil && recurrence.Interval <= 0 {
+				err = fmt.Errorf("invalid INTERVAL %q", val)
+			}
+		case "COUNT":
+			recurrence.Count, err = strconv.Atoi(val)
+		case "UNTIL":
+			recurrence.Until, err = parseDate(val)
+		case "BYMONTH", "BYMONTHDAY", "WKST":
+			// Yearly rules from holiday feeds repeat the month and day of DTSTART; nothing to do.
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedRecurrence, key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if recurrence.Frequency == "" {
+		return nil, errors.New("RRULE without FREQ")
+	}
+	return recurrence, nil
+}
+
+func unescapeText(value string) string {
+	replacer := strings.NewReplacer(`\\`, `\`, `\;`, `;`, `\,`, `,`, `\n`, "\n", `\N`, "\n")
+	return replacer.Replace(value)
+}
@@ -0,0 +1,91 @@
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	dateTimeFormat = "20060102T150405Z"
+	maxLineOctets  = 75
+)
+
+// TimedEvent is an event with exact start and end times, as written by Write.
+type TimedEvent struct {
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time
+	End         time.Time
+}
+
+// Write writes events as a calendar named name. Times are written in UTC, which pins them down
+// exactly; timeZone, an IANA name such as Europe/Berlin, tells calendar apps which zone to show
+// them in and is left out when empty.
+func Write(w io.Writer, name, timeZone string, events []TimedEvent) error {
+	buffered := bufio.NewWriter(w)
+	stamp := time.Now().UTC().Format(dateTimeFormat)
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//employee_management_system//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:" + escapeText(name),
+	}
+	if timeZone != "" {
+		lines = append(lines, "X-WR-TIMEZONE:"+timeZone)
+	}
+	for _, event := range events {
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+event.UID,
+			"DTSTAMP:"+stamp,
+			"DTSTART:"+event.Start.UTC().Format(dateTimeFormat),
+			"DTEND:"+event.End.UTC().Format(dateTimeFormat),
+			"SUMMARY:"+escapeText(event.Summary),
+		)
+		if event.Description != "" {
+			lines = append(lines, "DESCRIPTION:"+escapeText(event.Description))
+		}
+		lines = append(lines, "END:VEVENT")
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, line := range lines {
+		if _, err := buffered.WriteString(fold(line)); err != nil {
+			return err
+		}
+	}
+	return buffered.Flush()
+}
+
+// fold splits line into CRLF terminated lines of at most 75 octets, continuation lines starting
+// with a space, without breaking UTF-8 sequences.
+func fold(line string) string {
+	var folded strings.Builder
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		folded.WriteString(line[:cut])
+		folded.WriteString("\r\n ")
+		line = line[cut:]
+		// The leading space of a continuation line counts towards its length.
+		limit = maxLineOctets - 1
+	}
+	folded.WriteString(line)
+	folded.WriteString("\r\n")
+	return folded.String()
+}
+
+func escapeText(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, "\r\n", `\n`, "\n", `\n`)
+	return replacer.Replace(value)
+}
@@ -0,0 +1,145 @@
+// Package pdf writes simple text documents as PDF 1.4 files: pages of left-aligned text in the
+// standard Helvetica fonts, and horizontal rules. Text is encoded as WinAnsi; characters outside
+// it are replaced with '?'.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A4 page size in points.
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+type Font string
+
+const (
+	FontRegular Font = "F1"
+	FontBold    Font = "F2"
+)
+
+// Document is a PDF under construction. Coordinates are in points from the bottom left corner.
+type Document struct {
+	pages []*bytes.Buffer
+}
+
+func New() *Document {
+	return &Document{}
+}
+
+// AddPage starts a new page; following drawing goes to it.
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+}
+
+func (d *Document) current() *bytes.Buffer {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+	return d.pages[len(d.pages)-1]
+}
+
+// Text draws text with its baseline starting at x, y.
+func (d *Document) Text(x, y float64, font Font, size float64, text string) {
+	fmt.Fprintf(d.current(), "BT /%s %.2f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, y, escape(text))
+}
+
+// TextRight draws text ending at x, e.g. for right-aligned amounts.
+func (d *Document) TextRight(x, y float64, font Font, size float64, text string) {
+	d.Text(x-TextWidth(font, size, text), y, font, size, text)
+}
+
+// Line draws a horizontal rule from x1 to x2 at y.
+func (d *Document) Line(x1, x2, y float64) {
+	fmt.Fprintf(d.current(), "0.5 w %.2f %.2f m %.2f %.2f l S\n", x1, y, x2, y)
+}
+
+// WriteTo writes the document as a PDF file.
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+
+	// Objects: 1 catalog, 2 page tree, 3 and 4 fonts, then a page and its content per page.
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"", // page tree, filled in once the page objects are numbered
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+	}
+	kids := []string{}
+	for _, page := range d.pages {
+		pageNumber := len(objects) + 1
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageNumber))
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] "+
+				"/Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+				PageWidth, PageHeight, pageNumber+1),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()),
+		)
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages))
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	n, err := w.Write(out.Bytes())
+	return int64(n), err
+}
+
+// TextWidth approximates the width of text in points. Helvetica averages about half the font
+// size per character, bold slightly more; digits, which amounts are made of, are exactly 0.556.
+func TextWidth(font Font, size float64, text string) float64 {
+	width := 0.0
+	for _, r := range text {
+		switch {
+		case r >= '0' && r <= '9':
+			width += 0.556
+		case r == ' ' || r == '.' || r == ',':
+			width += 0.278
+		case font == FontBold:
+			width += 0.6
+		default:
+			width += 0.55
+		}
+	}
+	return width * size
+}
+
+// escape encodes text as a WinAnsi PDF string literal body.
+func escape(text string) string {
+	var escaped strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			escaped.WriteByte('\\')
+			escaped.WriteRune(r)
+		case r >= 0x20 && r < 0x7f:
+			escaped.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			// Latin-1 supplement matches WinAnsi; written as octal to keep the file ASCII.
+			fmt.Fprintf(&escaped, "\\%03o", r)
+		case r == '€':
+			escaped.WriteString("\\200")
+		default:
+			escaped.WriteByte('?')
+		}
+	}
+	return escaped.String()
+}
@@ -0,0 +1,32 @@
+package validation
+
+import (
+	"net/mail"
+	"regexp"
+)
+
+var (
+	// E.164: leading "+", country code without a leading zero, at most 15 digits in total.
+	e164Regex        = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+	countryCodeRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// IsValidEmail reports whether s is a bare email address such as "john@example.com".
+// Display names ("John <john@example.com>") are rejected.
+func IsValidEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	if err != nil {
+		return false
+	}
+	return addr.Address == s
+}
+
+// IsValidE164Phone reports whether s is a phone number in E.164 format, e.g. "+14155552671".
+func IsValidE164Phone(s string) bool {
+	return e164Regex.MatchString(s)
+}
+
+// IsValidCountryCode reports whether s looks like an ISO 3166-1 alpha-2 country code, e.g. "IN".
+func IsValidCountryCode(s string) bool {
+	return countryCodeRegex.MatchString(s)
+}